@@ -291,7 +291,11 @@ type UserAreaRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	LocationId int64 `protobuf:"varint,1,opt,name=locationId,proto3" json:"locationId,omitempty"`
+	LocationId int64  `protobuf:"varint,1,opt,name=locationId,proto3" json:"locationId,omitempty"`
+	Depth      int64  `protobuf:"varint,2,opt,name=depth,proto3" json:"depth,omitempty"`       // 查询层数，默认 1，最多 5
+	Cursor     int64  `protobuf:"varint,3,opt,name=cursor,proto3" json:"cursor,omitempty"`     // 直接下级的分页游标，上一页返回的 nextCursor
+	PageSize   int64  `protobuf:"varint,4,opt,name=pageSize,proto3" json:"pageSize,omitempty"` // 直接下级每页数量，默认 20，最多 100
+	Address    string `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`    // 在我的下级中按地址查找
 }
 
 func (x *UserAreaRequest) Reset() {
@@ -333,12 +337,42 @@ func (x *UserAreaRequest) GetLocationId() int64 {
 	return 0
 }
 
+func (x *UserAreaRequest) GetDepth() int64 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *UserAreaRequest) GetCursor() int64 {
+	if x != nil {
+		return x.Cursor
+	}
+	return 0
+}
+
+func (x *UserAreaRequest) GetPageSize() int64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *UserAreaRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
 type UserAreaReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Area []*UserAreaReply_List `protobuf:"bytes,52,rep,name=area,proto3" json:"area,omitempty"` // 购买记录
+	Area       []*UserAreaReply_List `protobuf:"bytes,52,rep,name=area,proto3" json:"area,omitempty"`              // 下级，按层级和 id 排列
+	Root       *UserAreaReply_List   `protobuf:"bytes,53,opt,name=root,proto3" json:"root,omitempty"`              // 查询的节点，countLow 为本页直接下级数量
+	NextCursor int64                 `protobuf:"varint,54,opt,name=nextCursor,proto3" json:"nextCursor,omitempty"` // 0 没有下一页
 }
 
 func (x *UserAreaReply) Reset() {
@@ -380,6 +414,20 @@ func (x *UserAreaReply) GetArea() []*UserAreaReply_List {
 	return nil
 }
 
+func (x *UserAreaReply) GetRoot() *UserAreaReply_List {
+	if x != nil {
+		return x.Root
+	}
+	return nil
+}
+
+func (x *UserAreaReply) GetNextCursor() int64 {
+	if x != nil {
+		return x.NextCursor
+	}
+	return 0
+}
+
 type UserInfoRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1199,7 +1247,12 @@ type RewardListRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Type     string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Cursor   int64  `protobuf:"varint,10,opt,name=cursor,proto3" json:"cursor,omitempty"`     // 上一页返回的 nextCursor
+	PageSize int64  `protobuf:"varint,11,opt,name=pageSize,proto3" json:"pageSize,omitempty"` // 默认 20，最多 100
+	Start    string `protobuf:"bytes,12,opt,name=start,proto3" json:"start,omitempty"`        // 开始业务日 2006-01-02
+	End      string `protobuf:"bytes,13,opt,name=end,proto3" json:"end,omitempty"`            // 结束业务日 2006-01-02，含当天
+	Sort     string `protobuf:"bytes,14,opt,name=sort,proto3" json:"sort,omitempty"`          // desc 默认，asc
 }
 
 func (x *RewardListRequest) Reset() {
@@ -1241,12 +1294,50 @@ func (x *RewardListRequest) GetType() string {
 	return ""
 }
 
+func (x *RewardListRequest) GetCursor() int64 {
+	if x != nil {
+		return x.Cursor
+	}
+	return 0
+}
+
+func (x *RewardListRequest) GetPageSize() int64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *RewardListRequest) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *RewardListRequest) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+func (x *RewardListRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
 type RewardListReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Rewards []*RewardListReply_List `protobuf:"bytes,1,rep,name=rewards,proto3" json:"rewards,omitempty"`
+	Rewards     []*RewardListReply_List `protobuf:"bytes,1,rep,name=rewards,proto3" json:"rewards,omitempty"`
+	NextCursor  int64                   `protobuf:"varint,10,opt,name=nextCursor,proto3" json:"nextCursor,omitempty"`  // 0 没有下一页
+	Total       int64                   `protobuf:"varint,11,opt,name=total,proto3" json:"total,omitempty"`            // 符合条件的总数
+	TotalAmount string                  `protobuf:"bytes,12,opt,name=totalAmount,proto3" json:"totalAmount,omitempty"` // 符合条件的总金额
 }
 
 func (x *RewardListReply) Reset() {
@@ -1288,6 +1379,27 @@ func (x *RewardListReply) GetRewards() []*RewardListReply_List {
 	return nil
 }
 
+func (x *RewardListReply) GetNextCursor() int64 {
+	if x != nil {
+		return x.NextCursor
+	}
+	return 0
+}
+
+func (x *RewardListReply) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *RewardListReply) GetTotalAmount() string {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return ""
+}
+
 type GetTradeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1394,6 +1506,12 @@ type RecommendRewardListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Cursor   int64  `protobuf:"varint,10,opt,name=cursor,proto3" json:"cursor,omitempty"`     // 上一页返回的 nextCursor
+	PageSize int64  `protobuf:"varint,11,opt,name=pageSize,proto3" json:"pageSize,omitempty"` // 默认 20，最多 100
+	Start    string `protobuf:"bytes,12,opt,name=start,proto3" json:"start,omitempty"`        // 开始业务日 2006-01-02
+	End      string `protobuf:"bytes,13,opt,name=end,proto3" json:"end,omitempty"`            // 结束业务日 2006-01-02，含当天
+	Sort     string `protobuf:"bytes,14,opt,name=sort,proto3" json:"sort,omitempty"`          // desc 默认，asc
 }
 
 func (x *RecommendRewardListRequest) Reset() {
@@ -1428,12 +1546,50 @@ func (*RecommendRewardListRequest) Descriptor() ([]byte, []int) {
 	return file_api_app_proto_rawDescGZIP(), []int{15}
 }
 
+func (x *RecommendRewardListRequest) GetCursor() int64 {
+	if x != nil {
+		return x.Cursor
+	}
+	return 0
+}
+
+func (x *RecommendRewardListRequest) GetPageSize() int64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *RecommendRewardListRequest) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *RecommendRewardListRequest) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+func (x *RecommendRewardListRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
 type RecommendRewardListReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Rewards []*RecommendRewardListReply_List `protobuf:"bytes,1,rep,name=rewards,proto3" json:"rewards,omitempty"`
+	Rewards     []*RecommendRewardListReply_List `protobuf:"bytes,1,rep,name=rewards,proto3" json:"rewards,omitempty"`
+	NextCursor  int64                            `protobuf:"varint,10,opt,name=nextCursor,proto3" json:"nextCursor,omitempty"`  // 0 没有下一页
+	Total       int64                            `protobuf:"varint,11,opt,name=total,proto3" json:"total,omitempty"`            // 符合条件的总数
+	TotalAmount string                           `protobuf:"bytes,12,opt,name=totalAmount,proto3" json:"totalAmount,omitempty"` // 符合条件的总金额
 }
 
 func (x *RecommendRewardListReply) Reset() {
@@ -1475,10 +1631,37 @@ func (x *RecommendRewardListReply) GetRewards() []*RecommendRewardListReply_List
 	return nil
 }
 
+func (x *RecommendRewardListReply) GetNextCursor() int64 {
+	if x != nil {
+		return x.NextCursor
+	}
+	return 0
+}
+
+func (x *RecommendRewardListReply) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *RecommendRewardListReply) GetTotalAmount() string {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return ""
+}
+
 type FeeRewardListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Cursor   int64  `protobuf:"varint,10,opt,name=cursor,proto3" json:"cursor,omitempty"`     // 上一页返回的 nextCursor
+	PageSize int64  `protobuf:"varint,11,opt,name=pageSize,proto3" json:"pageSize,omitempty"` // 默认 20，最多 100
+	Start    string `protobuf:"bytes,12,opt,name=start,proto3" json:"start,omitempty"`        // 开始业务日 2006-01-02
+	End      string `protobuf:"bytes,13,opt,name=end,proto3" json:"end,omitempty"`            // 结束业务日 2006-01-02，含当天
+	Sort     string `protobuf:"bytes,14,opt,name=sort,proto3" json:"sort,omitempty"`          // desc 默认，asc
 }
 
 func (x *FeeRewardListRequest) Reset() {
@@ -1513,12 +1696,50 @@ func (*FeeRewardListRequest) Descriptor() ([]byte, []int) {
 	return file_api_app_proto_rawDescGZIP(), []int{17}
 }
 
+func (x *FeeRewardListRequest) GetCursor() int64 {
+	if x != nil {
+		return x.Cursor
+	}
+	return 0
+}
+
+func (x *FeeRewardListRequest) GetPageSize() int64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *FeeRewardListRequest) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *FeeRewardListRequest) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+func (x *FeeRewardListRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
 type FeeRewardListReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Rewards []*FeeRewardListReply_List `protobuf:"bytes,1,rep,name=rewards,proto3" json:"rewards,omitempty"`
+	Rewards     []*FeeRewardListReply_List `protobuf:"bytes,1,rep,name=rewards,proto3" json:"rewards,omitempty"`
+	NextCursor  int64                      `protobuf:"varint,10,opt,name=nextCursor,proto3" json:"nextCursor,omitempty"`  // 0 没有下一页
+	Total       int64                      `protobuf:"varint,11,opt,name=total,proto3" json:"total,omitempty"`            // 符合条件的总数
+	TotalAmount string                     `protobuf:"bytes,12,opt,name=totalAmount,proto3" json:"totalAmount,omitempty"` // 符合条件的总金额
 }
 
 func (x *FeeRewardListReply) Reset() {
@@ -1560,12 +1781,39 @@ func (x *FeeRewardListReply) GetRewards() []*FeeRewardListReply_List {
 	return nil
 }
 
+func (x *FeeRewardListReply) GetNextCursor() int64 {
+	if x != nil {
+		return x.NextCursor
+	}
+	return 0
+}
+
+func (x *FeeRewardListReply) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *FeeRewardListReply) GetTotalAmount() string {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return ""
+}
+
 type WithdrawListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Type     string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Status   string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Cursor   int64  `protobuf:"varint,10,opt,name=cursor,proto3" json:"cursor,omitempty"`     // 上一页返回的 nextCursor
+	PageSize int64  `protobuf:"varint,11,opt,name=pageSize,proto3" json:"pageSize,omitempty"` // 默认 20，最多 100
+	Start    string `protobuf:"bytes,12,opt,name=start,proto3" json:"start,omitempty"`        // 开始业务日 2006-01-02
+	End      string `protobuf:"bytes,13,opt,name=end,proto3" json:"end,omitempty"`            // 结束业务日 2006-01-02，含当天
+	Sort     string `protobuf:"bytes,14,opt,name=sort,proto3" json:"sort,omitempty"`          // desc 默认，asc
 }
 
 func (x *WithdrawListRequest) Reset() {
@@ -1607,12 +1855,57 @@ func (x *WithdrawListRequest) GetType() string {
 	return ""
 }
 
+func (x *WithdrawListRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *WithdrawListRequest) GetCursor() int64 {
+	if x != nil {
+		return x.Cursor
+	}
+	return 0
+}
+
+func (x *WithdrawListRequest) GetPageSize() int64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *WithdrawListRequest) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *WithdrawListRequest) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+func (x *WithdrawListRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
 type WithdrawListReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Withdraw []*WithdrawListReply_List `protobuf:"bytes,1,rep,name=withdraw,proto3" json:"withdraw,omitempty"`
+	Withdraw    []*WithdrawListReply_List `protobuf:"bytes,1,rep,name=withdraw,proto3" json:"withdraw,omitempty"`
+	NextCursor  int64                     `protobuf:"varint,10,opt,name=nextCursor,proto3" json:"nextCursor,omitempty"`  // 0 没有下一页
+	Total       int64                     `protobuf:"varint,11,opt,name=total,proto3" json:"total,omitempty"`            // 符合条件的总数
+	TotalAmount string                    `protobuf:"bytes,12,opt,name=totalAmount,proto3" json:"totalAmount,omitempty"` // 符合条件的总金额
 }
 
 func (x *WithdrawListReply) Reset() {
@@ -1654,10 +1947,38 @@ func (x *WithdrawListReply) GetWithdraw() []*WithdrawListReply_List {
 	return nil
 }
 
+func (x *WithdrawListReply) GetNextCursor() int64 {
+	if x != nil {
+		return x.NextCursor
+	}
+	return 0
+}
+
+func (x *WithdrawListReply) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *WithdrawListReply) GetTotalAmount() string {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return ""
+}
+
 type TradeListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Status   string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Cursor   int64  `protobuf:"varint,10,opt,name=cursor,proto3" json:"cursor,omitempty"`     // 上一页返回的 nextCursor
+	PageSize int64  `protobuf:"varint,11,opt,name=pageSize,proto3" json:"pageSize,omitempty"` // 默认 20，最多 100
+	Start    string `protobuf:"bytes,12,opt,name=start,proto3" json:"start,omitempty"`        // 开始业务日 2006-01-02
+	End      string `protobuf:"bytes,13,opt,name=end,proto3" json:"end,omitempty"`            // 结束业务日 2006-01-02，含当天
+	Sort     string `protobuf:"bytes,14,opt,name=sort,proto3" json:"sort,omitempty"`          // desc 默认，asc
 }
 
 func (x *TradeListRequest) Reset() {
@@ -1692,12 +2013,57 @@ func (*TradeListRequest) Descriptor() ([]byte, []int) {
 	return file_api_app_proto_rawDescGZIP(), []int{21}
 }
 
+func (x *TradeListRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *TradeListRequest) GetCursor() int64 {
+	if x != nil {
+		return x.Cursor
+	}
+	return 0
+}
+
+func (x *TradeListRequest) GetPageSize() int64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *TradeListRequest) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *TradeListRequest) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+func (x *TradeListRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
 type TradeListReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Trade []*TradeListReply_List `protobuf:"bytes,1,rep,name=trade,proto3" json:"trade,omitempty"`
+	Trade       []*TradeListReply_List `protobuf:"bytes,1,rep,name=trade,proto3" json:"trade,omitempty"`
+	NextCursor  int64                  `protobuf:"varint,10,opt,name=nextCursor,proto3" json:"nextCursor,omitempty"`  // 0 没有下一页
+	Total       int64                  `protobuf:"varint,11,opt,name=total,proto3" json:"total,omitempty"`            // 符合条件的总数
+	TotalAmount string                 `protobuf:"bytes,12,opt,name=totalAmount,proto3" json:"totalAmount,omitempty"` // 符合条件的总金额
 }
 
 func (x *TradeListReply) Reset() {
@@ -1739,13 +2105,39 @@ func (x *TradeListReply) GetTrade() []*TradeListReply_List {
 	return nil
 }
 
+func (x *TradeListReply) GetNextCursor() int64 {
+	if x != nil {
+		return x.NextCursor
+	}
+	return 0
+}
+
+func (x *TradeListReply) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *TradeListReply) GetTotalAmount() string {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return ""
+}
+
 type TranListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
-	Tran string `protobuf:"bytes,2,opt,name=tran,proto3" json:"tran,omitempty"`
+	Type     string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Tran     string `protobuf:"bytes,2,opt,name=tran,proto3" json:"tran,omitempty"`
+	Cursor   int64  `protobuf:"varint,10,opt,name=cursor,proto3" json:"cursor,omitempty"`     // 上一页返回的 nextCursor
+	PageSize int64  `protobuf:"varint,11,opt,name=pageSize,proto3" json:"pageSize,omitempty"` // 默认 20，最多 100
+	Start    string `protobuf:"bytes,12,opt,name=start,proto3" json:"start,omitempty"`        // 开始业务日 2006-01-02
+	End      string `protobuf:"bytes,13,opt,name=end,proto3" json:"end,omitempty"`            // 结束业务日 2006-01-02，含当天
+	Sort     string `protobuf:"bytes,14,opt,name=sort,proto3" json:"sort,omitempty"`          // desc 默认，asc
 }
 
 func (x *TranListRequest) Reset() {
@@ -1794,12 +2186,50 @@ func (x *TranListRequest) GetTran() string {
 	return ""
 }
 
-type TranListReply struct {
+func (x *TranListRequest) GetCursor() int64 {
+	if x != nil {
+		return x.Cursor
+	}
+	return 0
+}
+
+func (x *TranListRequest) GetPageSize() int64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *TranListRequest) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *TranListRequest) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+func (x *TranListRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+type TranListReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Tran []*TranListReply_List `protobuf:"bytes,1,rep,name=tran,proto3" json:"tran,omitempty"`
+	Tran        []*TranListReply_List `protobuf:"bytes,1,rep,name=tran,proto3" json:"tran,omitempty"`
+	NextCursor  int64                 `protobuf:"varint,10,opt,name=nextCursor,proto3" json:"nextCursor,omitempty"`  // 0 没有下一页
+	Total       int64                 `protobuf:"varint,11,opt,name=total,proto3" json:"total,omitempty"`            // 符合条件的总数
+	TotalAmount string                `protobuf:"bytes,12,opt,name=totalAmount,proto3" json:"totalAmount,omitempty"` // 符合条件的总金额
 }
 
 func (x *TranListReply) Reset() {
@@ -1841,16 +2271,39 @@ func (x *TranListReply) GetTran() []*TranListReply_List {
 	return nil
 }
 
-type RecommendListRequest struct {
+func (x *TranListReply) GetNextCursor() int64 {
+	if x != nil {
+		return x.NextCursor
+	}
+	return 0
+}
+
+func (x *TranListReply) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *TranListReply) GetTotalAmount() string {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return ""
+}
+
+type FeePreviewRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Action string `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"` // withdraw trade
+	Coin   string `protobuf:"bytes,2,opt,name=coin,proto3" json:"coin,omitempty"`     // usdt dhb
+	Amount string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (x *RecommendListRequest) Reset() {
-	*x = RecommendListRequest{}
+func (x *FeePreviewRequest) Reset() {
+	*x = FeePreviewRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1858,13 +2311,13 @@ func (x *RecommendListRequest) Reset() {
 	}
 }
 
-func (x *RecommendListRequest) String() string {
+func (x *FeePreviewRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecommendListRequest) ProtoMessage() {}
+func (*FeePreviewRequest) ProtoMessage() {}
 
-func (x *RecommendListRequest) ProtoReflect() protoreflect.Message {
+func (x *FeePreviewRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1876,28 +2329,47 @@ func (x *RecommendListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecommendListRequest.ProtoReflect.Descriptor instead.
-func (*RecommendListRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use FeePreviewRequest.ProtoReflect.Descriptor instead.
+func (*FeePreviewRequest) Descriptor() ([]byte, []int) {
 	return file_api_app_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *RecommendListRequest) GetType() string {
+func (x *FeePreviewRequest) GetAction() string {
 	if x != nil {
-		return x.Type
+		return x.Action
 	}
 	return ""
 }
 
-type RecommendListReply struct {
+func (x *FeePreviewRequest) GetCoin() string {
+	if x != nil {
+		return x.Coin
+	}
+	return ""
+}
+
+func (x *FeePreviewRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type FeePreviewReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Recommends []*RecommendListReply_List `protobuf:"bytes,1,rep,name=recommends,proto3" json:"recommends,omitempty"`
+	Action string `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	Coin   string `protobuf:"bytes,2,opt,name=coin,proto3" json:"coin,omitempty"`
+	Amount string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Fee    string `protobuf:"bytes,4,opt,name=fee,proto3" json:"fee,omitempty"`   // 手续费
+	Burn   string `protobuf:"bytes,5,opt,name=burn,proto3" json:"burn,omitempty"` // 销毁
+	Net    string `protobuf:"bytes,6,opt,name=net,proto3" json:"net,omitempty"`   // 到账
 }
 
-func (x *RecommendListReply) Reset() {
-	*x = RecommendListReply{}
+func (x *FeePreviewReply) Reset() {
+	*x = FeePreviewReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1905,13 +2377,13 @@ func (x *RecommendListReply) Reset() {
 	}
 }
 
-func (x *RecommendListReply) String() string {
+func (x *FeePreviewReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecommendListReply) ProtoMessage() {}
+func (*FeePreviewReply) ProtoMessage() {}
 
-func (x *RecommendListReply) ProtoReflect() protoreflect.Message {
+func (x *FeePreviewReply) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1923,28 +2395,72 @@ func (x *RecommendListReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecommendListReply.ProtoReflect.Descriptor instead.
-func (*RecommendListReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use FeePreviewReply.ProtoReflect.Descriptor instead.
+func (*FeePreviewReply) Descriptor() ([]byte, []int) {
 	return file_api_app_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *RecommendListReply) GetRecommends() []*RecommendListReply_List {
+func (x *FeePreviewReply) GetAction() string {
 	if x != nil {
-		return x.Recommends
+		return x.Action
 	}
-	return nil
+	return ""
 }
 
-type ExchangeRequest struct {
+func (x *FeePreviewReply) GetCoin() string {
+	if x != nil {
+		return x.Coin
+	}
+	return ""
+}
+
+func (x *FeePreviewReply) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *FeePreviewReply) GetFee() string {
+	if x != nil {
+		return x.Fee
+	}
+	return ""
+}
+
+func (x *FeePreviewReply) GetBurn() string {
+	if x != nil {
+		return x.Burn
+	}
+	return ""
+}
+
+func (x *FeePreviewReply) GetNet() string {
+	if x != nil {
+		return x.Net
+	}
+	return ""
+}
+
+type HistoryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SendBody *ExchangeRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
-}
-
-func (x *ExchangeRequest) Reset() {
-	*x = ExchangeRequest{}
+	Kind     string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"` // reward withdraw balance trade
+	Type     string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Reason   string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`       // 仅 reward
+	Coin     string `protobuf:"bytes,4,opt,name=coin,proto3" json:"coin,omitempty"`           // withdraw balance
+	Status   string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`       // withdraw trade
+	Cursor   int64  `protobuf:"varint,10,opt,name=cursor,proto3" json:"cursor,omitempty"`     // 上一页返回的 nextCursor
+	PageSize int64  `protobuf:"varint,11,opt,name=pageSize,proto3" json:"pageSize,omitempty"` // 默认 20，最多 100
+	Start    string `protobuf:"bytes,12,opt,name=start,proto3" json:"start,omitempty"`        // 开始业务日 2006-01-02
+	End      string `protobuf:"bytes,13,opt,name=end,proto3" json:"end,omitempty"`            // 结束业务日 2006-01-02，含当天
+	Sort     string `protobuf:"bytes,14,opt,name=sort,proto3" json:"sort,omitempty"`          // desc 默认，asc
+}
+
+func (x *HistoryRequest) Reset() {
+	*x = HistoryRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1952,13 +2468,13 @@ func (x *ExchangeRequest) Reset() {
 	}
 }
 
-func (x *ExchangeRequest) String() string {
+func (x *HistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExchangeRequest) ProtoMessage() {}
+func (*HistoryRequest) ProtoMessage() {}
 
-func (x *ExchangeRequest) ProtoReflect() protoreflect.Message {
+func (x *HistoryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1970,28 +2486,94 @@ func (x *ExchangeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExchangeRequest.ProtoReflect.Descriptor instead.
-func (*ExchangeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use HistoryRequest.ProtoReflect.Descriptor instead.
+func (*HistoryRequest) Descriptor() ([]byte, []int) {
 	return file_api_app_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *ExchangeRequest) GetSendBody() *ExchangeRequest_SendBody {
+func (x *HistoryRequest) GetKind() string {
 	if x != nil {
-		return x.SendBody
+		return x.Kind
 	}
-	return nil
+	return ""
 }
 
-type ExchangeReply struct {
+func (x *HistoryRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *HistoryRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *HistoryRequest) GetCoin() string {
+	if x != nil {
+		return x.Coin
+	}
+	return ""
+}
+
+func (x *HistoryRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *HistoryRequest) GetCursor() int64 {
+	if x != nil {
+		return x.Cursor
+	}
+	return 0
+}
+
+func (x *HistoryRequest) GetPageSize() int64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *HistoryRequest) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *HistoryRequest) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+func (x *HistoryRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+type HistoryReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	List        []*HistoryReply_List `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	NextCursor  int64                `protobuf:"varint,10,opt,name=nextCursor,proto3" json:"nextCursor,omitempty"`  // 0 没有下一页
+	Total       int64                `protobuf:"varint,11,opt,name=total,proto3" json:"total,omitempty"`            // 符合条件的总数
+	TotalAmount string               `protobuf:"bytes,12,opt,name=totalAmount,proto3" json:"totalAmount,omitempty"` // 符合条件的总金额
 }
 
-func (x *ExchangeReply) Reset() {
-	*x = ExchangeReply{}
+func (x *HistoryReply) Reset() {
+	*x = HistoryReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1999,13 +2581,13 @@ func (x *ExchangeReply) Reset() {
 	}
 }
 
-func (x *ExchangeReply) String() string {
+func (x *HistoryReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExchangeReply) ProtoMessage() {}
+func (*HistoryReply) ProtoMessage() {}
 
-func (x *ExchangeReply) ProtoReflect() protoreflect.Message {
+func (x *HistoryReply) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2017,28 +2599,49 @@ func (x *ExchangeReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExchangeReply.ProtoReflect.Descriptor instead.
-func (*ExchangeReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use HistoryReply.ProtoReflect.Descriptor instead.
+func (*HistoryReply) Descriptor() ([]byte, []int) {
 	return file_api_app_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *ExchangeReply) GetStatus() string {
+func (x *HistoryReply) GetList() []*HistoryReply_List {
 	if x != nil {
-		return x.Status
+		return x.List
+	}
+	return nil
+}
+
+func (x *HistoryReply) GetNextCursor() int64 {
+	if x != nil {
+		return x.NextCursor
+	}
+	return 0
+}
+
+func (x *HistoryReply) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *HistoryReply) GetTotalAmount() string {
+	if x != nil {
+		return x.TotalAmount
 	}
 	return ""
 }
 
-type WithdrawRequest struct {
+type CreateStatementRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SendBody *WithdrawRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
+	SendBody *CreateStatementRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
 }
 
-func (x *WithdrawRequest) Reset() {
-	*x = WithdrawRequest{}
+func (x *CreateStatementRequest) Reset() {
+	*x = CreateStatementRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2046,13 +2649,13 @@ func (x *WithdrawRequest) Reset() {
 	}
 }
 
-func (x *WithdrawRequest) String() string {
+func (x *CreateStatementRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WithdrawRequest) ProtoMessage() {}
+func (*CreateStatementRequest) ProtoMessage() {}
 
-func (x *WithdrawRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateStatementRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2064,28 +2667,31 @@ func (x *WithdrawRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WithdrawRequest.ProtoReflect.Descriptor instead.
-func (*WithdrawRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateStatementRequest.ProtoReflect.Descriptor instead.
+func (*CreateStatementRequest) Descriptor() ([]byte, []int) {
 	return file_api_app_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *WithdrawRequest) GetSendBody() *WithdrawRequest_SendBody {
+func (x *CreateStatementRequest) GetSendBody() *CreateStatementRequest_SendBody {
 	if x != nil {
 		return x.SendBody
 	}
 	return nil
 }
 
-type WithdrawReply struct {
+type CreateStatementReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Id      int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status  string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // pending done failed
+	Url     string `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`       // done 时返回，有效期内可下载
+	Message string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *WithdrawReply) Reset() {
-	*x = WithdrawReply{}
+func (x *CreateStatementReply) Reset() {
+	*x = CreateStatementReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2093,13 +2699,13 @@ func (x *WithdrawReply) Reset() {
 	}
 }
 
-func (x *WithdrawReply) String() string {
+func (x *CreateStatementReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WithdrawReply) ProtoMessage() {}
+func (*CreateStatementReply) ProtoMessage() {}
 
-func (x *WithdrawReply) ProtoReflect() protoreflect.Message {
+func (x *CreateStatementReply) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2111,28 +2717,49 @@ func (x *WithdrawReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WithdrawReply.ProtoReflect.Descriptor instead.
-func (*WithdrawReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateStatementReply.ProtoReflect.Descriptor instead.
+func (*CreateStatementReply) Descriptor() ([]byte, []int) {
 	return file_api_app_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *WithdrawReply) GetStatus() string {
+func (x *CreateStatementReply) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CreateStatementReply) GetStatus() string {
 	if x != nil {
 		return x.Status
 	}
 	return ""
 }
 
-type PasswordChangeRequest struct {
+func (x *CreateStatementReply) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CreateStatementReply) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetStatementRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SendBody *PasswordChangeRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *PasswordChangeRequest) Reset() {
-	*x = PasswordChangeRequest{}
+func (x *GetStatementRequest) Reset() {
+	*x = GetStatementRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2140,13 +2767,13 @@ func (x *PasswordChangeRequest) Reset() {
 	}
 }
 
-func (x *PasswordChangeRequest) String() string {
+func (x *GetStatementRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PasswordChangeRequest) ProtoMessage() {}
+func (*GetStatementRequest) ProtoMessage() {}
 
-func (x *PasswordChangeRequest) ProtoReflect() protoreflect.Message {
+func (x *GetStatementRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2158,28 +2785,28 @@ func (x *PasswordChangeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PasswordChangeRequest.ProtoReflect.Descriptor instead.
-func (*PasswordChangeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetStatementRequest.ProtoReflect.Descriptor instead.
+func (*GetStatementRequest) Descriptor() ([]byte, []int) {
 	return file_api_app_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *PasswordChangeRequest) GetSendBody() *PasswordChangeRequest_SendBody {
+func (x *GetStatementRequest) GetId() int64 {
 	if x != nil {
-		return x.SendBody
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-type PasswordChangeReply struct {
+type RecommendListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Password string `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
 }
 
-func (x *PasswordChangeReply) Reset() {
-	*x = PasswordChangeReply{}
+func (x *RecommendListRequest) Reset() {
+	*x = RecommendListRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2187,13 +2814,13 @@ func (x *PasswordChangeReply) Reset() {
 	}
 }
 
-func (x *PasswordChangeReply) String() string {
+func (x *RecommendListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PasswordChangeReply) ProtoMessage() {}
+func (*RecommendListRequest) ProtoMessage() {}
 
-func (x *PasswordChangeReply) ProtoReflect() protoreflect.Message {
+func (x *RecommendListRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2205,28 +2832,28 @@ func (x *PasswordChangeReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PasswordChangeReply.ProtoReflect.Descriptor instead.
-func (*PasswordChangeReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use RecommendListRequest.ProtoReflect.Descriptor instead.
+func (*RecommendListRequest) Descriptor() ([]byte, []int) {
 	return file_api_app_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *PasswordChangeReply) GetPassword() string {
+func (x *RecommendListRequest) GetType() string {
 	if x != nil {
-		return x.Password
+		return x.Type
 	}
 	return ""
 }
 
-type TranRequest struct {
+type RecommendListReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SendBody *TranRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
+	Recommends []*RecommendListReply_List `protobuf:"bytes,1,rep,name=recommends,proto3" json:"recommends,omitempty"`
 }
 
-func (x *TranRequest) Reset() {
-	*x = TranRequest{}
+func (x *RecommendListReply) Reset() {
+	*x = RecommendListReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2234,13 +2861,13 @@ func (x *TranRequest) Reset() {
 	}
 }
 
-func (x *TranRequest) String() string {
+func (x *RecommendListReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TranRequest) ProtoMessage() {}
+func (*RecommendListReply) ProtoMessage() {}
 
-func (x *TranRequest) ProtoReflect() protoreflect.Message {
+func (x *RecommendListReply) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2252,28 +2879,28 @@ func (x *TranRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TranRequest.ProtoReflect.Descriptor instead.
-func (*TranRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RecommendListReply.ProtoReflect.Descriptor instead.
+func (*RecommendListReply) Descriptor() ([]byte, []int) {
 	return file_api_app_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *TranRequest) GetSendBody() *TranRequest_SendBody {
+func (x *RecommendListReply) GetRecommends() []*RecommendListReply_List {
 	if x != nil {
-		return x.SendBody
+		return x.Recommends
 	}
 	return nil
 }
 
-type TranReply struct {
+type ExchangeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	SendBody *ExchangeRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
 }
 
-func (x *TranReply) Reset() {
-	*x = TranReply{}
+func (x *ExchangeRequest) Reset() {
+	*x = ExchangeRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2281,13 +2908,13 @@ func (x *TranReply) Reset() {
 	}
 }
 
-func (x *TranReply) String() string {
+func (x *ExchangeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TranReply) ProtoMessage() {}
+func (*ExchangeRequest) ProtoMessage() {}
 
-func (x *TranReply) ProtoReflect() protoreflect.Message {
+func (x *ExchangeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2299,28 +2926,28 @@ func (x *TranReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TranReply.ProtoReflect.Descriptor instead.
-func (*TranReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExchangeRequest.ProtoReflect.Descriptor instead.
+func (*ExchangeRequest) Descriptor() ([]byte, []int) {
 	return file_api_app_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *TranReply) GetStatus() string {
+func (x *ExchangeRequest) GetSendBody() *ExchangeRequest_SendBody {
 	if x != nil {
-		return x.Status
+		return x.SendBody
 	}
-	return ""
+	return nil
 }
 
-type SetBalanceRewardRequest struct {
+type ExchangeReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SendBody *SetBalanceRewardRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *SetBalanceRewardRequest) Reset() {
-	*x = SetBalanceRewardRequest{}
+func (x *ExchangeReply) Reset() {
+	*x = ExchangeReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2328,13 +2955,13 @@ func (x *SetBalanceRewardRequest) Reset() {
 	}
 }
 
-func (x *SetBalanceRewardRequest) String() string {
+func (x *ExchangeReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetBalanceRewardRequest) ProtoMessage() {}
+func (*ExchangeReply) ProtoMessage() {}
 
-func (x *SetBalanceRewardRequest) ProtoReflect() protoreflect.Message {
+func (x *ExchangeReply) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2346,28 +2973,28 @@ func (x *SetBalanceRewardRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetBalanceRewardRequest.ProtoReflect.Descriptor instead.
-func (*SetBalanceRewardRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExchangeReply.ProtoReflect.Descriptor instead.
+func (*ExchangeReply) Descriptor() ([]byte, []int) {
 	return file_api_app_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *SetBalanceRewardRequest) GetSendBody() *SetBalanceRewardRequest_SendBody {
+func (x *ExchangeReply) GetStatus() string {
 	if x != nil {
-		return x.SendBody
+		return x.Status
 	}
-	return nil
+	return ""
 }
 
-type SetBalanceRewardReply struct {
+type BuyLocationRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	SendBody *BuyLocationRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
 }
 
-func (x *SetBalanceRewardReply) Reset() {
-	*x = SetBalanceRewardReply{}
+func (x *BuyLocationRequest) Reset() {
+	*x = BuyLocationRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2375,13 +3002,13 @@ func (x *SetBalanceRewardReply) Reset() {
 	}
 }
 
-func (x *SetBalanceRewardReply) String() string {
+func (x *BuyLocationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetBalanceRewardReply) ProtoMessage() {}
+func (*BuyLocationRequest) ProtoMessage() {}
 
-func (x *SetBalanceRewardReply) ProtoReflect() protoreflect.Message {
+func (x *BuyLocationRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2393,28 +3020,31 @@ func (x *SetBalanceRewardReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetBalanceRewardReply.ProtoReflect.Descriptor instead.
-func (*SetBalanceRewardReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use BuyLocationRequest.ProtoReflect.Descriptor instead.
+func (*BuyLocationRequest) Descriptor() ([]byte, []int) {
 	return file_api_app_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *SetBalanceRewardReply) GetStatus() string {
+func (x *BuyLocationRequest) GetSendBody() *BuyLocationRequest_SendBody {
 	if x != nil {
-		return x.Status
+		return x.SendBody
 	}
-	return ""
+	return nil
 }
 
-type DeleteBalanceRewardRequest struct {
+type BuyLocationReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SendBody *DeleteBalanceRewardRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
+	Status     string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	LocationId int64  `protobuf:"varint,2,opt,name=locationId,proto3" json:"locationId,omitempty"`
+	Usdt       string `protobuf:"bytes,3,opt,name=usdt,proto3" json:"usdt,omitempty"`
+	CurrentMax string `protobuf:"bytes,4,opt,name=currentMax,proto3" json:"currentMax,omitempty"`
 }
 
-func (x *DeleteBalanceRewardRequest) Reset() {
-	*x = DeleteBalanceRewardRequest{}
+func (x *BuyLocationReply) Reset() {
+	*x = BuyLocationReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2422,13 +3052,13 @@ func (x *DeleteBalanceRewardRequest) Reset() {
 	}
 }
 
-func (x *DeleteBalanceRewardRequest) String() string {
+func (x *BuyLocationReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteBalanceRewardRequest) ProtoMessage() {}
+func (*BuyLocationReply) ProtoMessage() {}
 
-func (x *DeleteBalanceRewardRequest) ProtoReflect() protoreflect.Message {
+func (x *BuyLocationReply) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2440,28 +3070,49 @@ func (x *DeleteBalanceRewardRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteBalanceRewardRequest.ProtoReflect.Descriptor instead.
-func (*DeleteBalanceRewardRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BuyLocationReply.ProtoReflect.Descriptor instead.
+func (*BuyLocationReply) Descriptor() ([]byte, []int) {
 	return file_api_app_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *DeleteBalanceRewardRequest) GetSendBody() *DeleteBalanceRewardRequest_SendBody {
+func (x *BuyLocationReply) GetStatus() string {
 	if x != nil {
-		return x.SendBody
+		return x.Status
 	}
-	return nil
+	return ""
 }
 
-type DeleteBalanceRewardReply struct {
+func (x *BuyLocationReply) GetLocationId() int64 {
+	if x != nil {
+		return x.LocationId
+	}
+	return 0
+}
+
+func (x *BuyLocationReply) GetUsdt() string {
+	if x != nil {
+		return x.Usdt
+	}
+	return ""
+}
+
+func (x *BuyLocationReply) GetCurrentMax() string {
+	if x != nil {
+		return x.CurrentMax
+	}
+	return ""
+}
+
+type WithdrawRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	SendBody *WithdrawRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
 }
 
-func (x *DeleteBalanceRewardReply) Reset() {
-	*x = DeleteBalanceRewardReply{}
+func (x *WithdrawRequest) Reset() {
+	*x = WithdrawRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2469,13 +3120,13 @@ func (x *DeleteBalanceRewardReply) Reset() {
 	}
 }
 
-func (x *DeleteBalanceRewardReply) String() string {
+func (x *WithdrawRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteBalanceRewardReply) ProtoMessage() {}
+func (*WithdrawRequest) ProtoMessage() {}
 
-func (x *DeleteBalanceRewardReply) ProtoReflect() protoreflect.Message {
+func (x *WithdrawRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2487,29 +3138,31 @@ func (x *DeleteBalanceRewardReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteBalanceRewardReply.ProtoReflect.Descriptor instead.
-func (*DeleteBalanceRewardReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use WithdrawRequest.ProtoReflect.Descriptor instead.
+func (*WithdrawRequest) Descriptor() ([]byte, []int) {
 	return file_api_app_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *DeleteBalanceRewardReply) GetStatus() string {
+func (x *WithdrawRequest) GetSendBody() *WithdrawRequest_SendBody {
 	if x != nil {
-		return x.Status
+		return x.SendBody
 	}
-	return ""
+	return nil
 }
 
-type AdminRewardListRequest struct {
+type WithdrawReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Page    int64  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Code   string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"` // 已废弃，提现被拒绝时返回错误，原因见错误的 reason
+	Fee    string `protobuf:"bytes,3,opt,name=fee,proto3" json:"fee,omitempty"`   // 手续费加销毁
+	Net    string `protobuf:"bytes,4,opt,name=net,proto3" json:"net,omitempty"`   // 到账
 }
 
-func (x *AdminRewardListRequest) Reset() {
-	*x = AdminRewardListRequest{}
+func (x *WithdrawReply) Reset() {
+	*x = WithdrawReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2517,13 +3170,13 @@ func (x *AdminRewardListRequest) Reset() {
 	}
 }
 
-func (x *AdminRewardListRequest) String() string {
+func (x *WithdrawReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminRewardListRequest) ProtoMessage() {}
+func (*WithdrawReply) ProtoMessage() {}
 
-func (x *AdminRewardListRequest) ProtoReflect() protoreflect.Message {
+func (x *WithdrawReply) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2535,36 +3188,49 @@ func (x *AdminRewardListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminRewardListRequest.ProtoReflect.Descriptor instead.
-func (*AdminRewardListRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use WithdrawReply.ProtoReflect.Descriptor instead.
+func (*WithdrawReply) Descriptor() ([]byte, []int) {
 	return file_api_app_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *AdminRewardListRequest) GetPage() int64 {
+func (x *WithdrawReply) GetStatus() string {
 	if x != nil {
-		return x.Page
+		return x.Status
 	}
-	return 0
+	return ""
 }
 
-func (x *AdminRewardListRequest) GetAddress() string {
+func (x *WithdrawReply) GetCode() string {
 	if x != nil {
-		return x.Address
+		return x.Code
 	}
 	return ""
 }
 
-type AdminRewardListReply struct {
+func (x *WithdrawReply) GetFee() string {
+	if x != nil {
+		return x.Fee
+	}
+	return ""
+}
+
+func (x *WithdrawReply) GetNet() string {
+	if x != nil {
+		return x.Net
+	}
+	return ""
+}
+
+type PasswordChangeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Rewards []*AdminRewardListReply_List `protobuf:"bytes,1,rep,name=rewards,proto3" json:"rewards,omitempty"`
-	Count   int64                        `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	SendBody *PasswordChangeRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
 }
 
-func (x *AdminRewardListReply) Reset() {
-	*x = AdminRewardListReply{}
+func (x *PasswordChangeRequest) Reset() {
+	*x = PasswordChangeRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2572,13 +3238,13 @@ func (x *AdminRewardListReply) Reset() {
 	}
 }
 
-func (x *AdminRewardListReply) String() string {
+func (x *PasswordChangeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminRewardListReply) ProtoMessage() {}
+func (*PasswordChangeRequest) ProtoMessage() {}
 
-func (x *AdminRewardListReply) ProtoReflect() protoreflect.Message {
+func (x *PasswordChangeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2590,36 +3256,28 @@ func (x *AdminRewardListReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminRewardListReply.ProtoReflect.Descriptor instead.
-func (*AdminRewardListReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use PasswordChangeRequest.ProtoReflect.Descriptor instead.
+func (*PasswordChangeRequest) Descriptor() ([]byte, []int) {
 	return file_api_app_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *AdminRewardListReply) GetRewards() []*AdminRewardListReply_List {
+func (x *PasswordChangeRequest) GetSendBody() *PasswordChangeRequest_SendBody {
 	if x != nil {
-		return x.Rewards
+		return x.SendBody
 	}
 	return nil
 }
 
-func (x *AdminRewardListReply) GetCount() int64 {
-	if x != nil {
-		return x.Count
-	}
-	return 0
-}
-
-type AdminUserListRequest struct {
+type PasswordChangeReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Page    int64  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Password string `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
 }
 
-func (x *AdminUserListRequest) Reset() {
-	*x = AdminUserListRequest{}
+func (x *PasswordChangeReply) Reset() {
+	*x = PasswordChangeReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2627,13 +3285,13 @@ func (x *AdminUserListRequest) Reset() {
 	}
 }
 
-func (x *AdminUserListRequest) String() string {
+func (x *PasswordChangeReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminUserListRequest) ProtoMessage() {}
+func (*PasswordChangeReply) ProtoMessage() {}
 
-func (x *AdminUserListRequest) ProtoReflect() protoreflect.Message {
+func (x *PasswordChangeReply) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2645,50 +3303,42 @@ func (x *AdminUserListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminUserListRequest.ProtoReflect.Descriptor instead.
-func (*AdminUserListRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PasswordChangeReply.ProtoReflect.Descriptor instead.
+func (*PasswordChangeReply) Descriptor() ([]byte, []int) {
 	return file_api_app_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *AdminUserListRequest) GetPage() int64 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
-}
-
-func (x *AdminUserListRequest) GetAddress() string {
+func (x *PasswordChangeReply) GetPassword() string {
 	if x != nil {
-		return x.Address
+		return x.Password
 	}
 	return ""
 }
 
-type AdminUserListReply struct {
+type TranRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Users []*AdminUserListReply_UserList `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
-	Count int64                          `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	SendBody *TranRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
 }
 
-func (x *AdminUserListReply) Reset() {
-	*x = AdminUserListReply{}
-	if protoimpl.UnsafeEnabled {
+func (x *TranRequest) Reset() {
+	*x = TranRequest{}
+	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminUserListReply) String() string {
+func (x *TranRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminUserListReply) ProtoMessage() {}
+func (*TranRequest) ProtoMessage() {}
 
-func (x *AdminUserListReply) ProtoReflect() protoreflect.Message {
+func (x *TranRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2700,36 +3350,28 @@ func (x *AdminUserListReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminUserListReply.ProtoReflect.Descriptor instead.
-func (*AdminUserListReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use TranRequest.ProtoReflect.Descriptor instead.
+func (*TranRequest) Descriptor() ([]byte, []int) {
 	return file_api_app_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *AdminUserListReply) GetUsers() []*AdminUserListReply_UserList {
+func (x *TranRequest) GetSendBody() *TranRequest_SendBody {
 	if x != nil {
-		return x.Users
+		return x.SendBody
 	}
 	return nil
 }
 
-func (x *AdminUserListReply) GetCount() int64 {
-	if x != nil {
-		return x.Count
-	}
-	return 0
-}
-
-type AdminLocationListRequest struct {
+type TranReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Page    int64  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *AdminLocationListRequest) Reset() {
-	*x = AdminLocationListRequest{}
+func (x *TranReply) Reset() {
+	*x = TranReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2737,13 +3379,13 @@ func (x *AdminLocationListRequest) Reset() {
 	}
 }
 
-func (x *AdminLocationListRequest) String() string {
+func (x *TranReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminLocationListRequest) ProtoMessage() {}
+func (*TranReply) ProtoMessage() {}
 
-func (x *AdminLocationListRequest) ProtoReflect() protoreflect.Message {
+func (x *TranReply) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2755,36 +3397,28 @@ func (x *AdminLocationListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminLocationListRequest.ProtoReflect.Descriptor instead.
-func (*AdminLocationListRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use TranReply.ProtoReflect.Descriptor instead.
+func (*TranReply) Descriptor() ([]byte, []int) {
 	return file_api_app_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *AdminLocationListRequest) GetPage() int64 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
-}
-
-func (x *AdminLocationListRequest) GetAddress() string {
+func (x *TranReply) GetStatus() string {
 	if x != nil {
-		return x.Address
+		return x.Status
 	}
 	return ""
 }
 
-type AdminLocationListReply struct {
+type SetBalanceRewardRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Locations []*AdminLocationListReply_LocationList `protobuf:"bytes,1,rep,name=locations,proto3" json:"locations,omitempty"`
-	Count     int64                                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	SendBody *SetBalanceRewardRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
 }
 
-func (x *AdminLocationListReply) Reset() {
-	*x = AdminLocationListReply{}
+func (x *SetBalanceRewardRequest) Reset() {
+	*x = SetBalanceRewardRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2792,13 +3426,13 @@ func (x *AdminLocationListReply) Reset() {
 	}
 }
 
-func (x *AdminLocationListReply) String() string {
+func (x *SetBalanceRewardRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminLocationListReply) ProtoMessage() {}
+func (*SetBalanceRewardRequest) ProtoMessage() {}
 
-func (x *AdminLocationListReply) ProtoReflect() protoreflect.Message {
+func (x *SetBalanceRewardRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2810,36 +3444,28 @@ func (x *AdminLocationListReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminLocationListReply.ProtoReflect.Descriptor instead.
-func (*AdminLocationListReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetBalanceRewardRequest.ProtoReflect.Descriptor instead.
+func (*SetBalanceRewardRequest) Descriptor() ([]byte, []int) {
 	return file_api_app_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *AdminLocationListReply) GetLocations() []*AdminLocationListReply_LocationList {
+func (x *SetBalanceRewardRequest) GetSendBody() *SetBalanceRewardRequest_SendBody {
 	if x != nil {
-		return x.Locations
+		return x.SendBody
 	}
 	return nil
 }
 
-func (x *AdminLocationListReply) GetCount() int64 {
-	if x != nil {
-		return x.Count
-	}
-	return 0
-}
-
-type AdminWithdrawListRequest struct {
+type SetBalanceRewardReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Page    int64  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *AdminWithdrawListRequest) Reset() {
-	*x = AdminWithdrawListRequest{}
+func (x *SetBalanceRewardReply) Reset() {
+	*x = SetBalanceRewardReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2847,13 +3473,13 @@ func (x *AdminWithdrawListRequest) Reset() {
 	}
 }
 
-func (x *AdminWithdrawListRequest) String() string {
+func (x *SetBalanceRewardReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminWithdrawListRequest) ProtoMessage() {}
+func (*SetBalanceRewardReply) ProtoMessage() {}
 
-func (x *AdminWithdrawListRequest) ProtoReflect() protoreflect.Message {
+func (x *SetBalanceRewardReply) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2865,36 +3491,28 @@ func (x *AdminWithdrawListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminWithdrawListRequest.ProtoReflect.Descriptor instead.
-func (*AdminWithdrawListRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetBalanceRewardReply.ProtoReflect.Descriptor instead.
+func (*SetBalanceRewardReply) Descriptor() ([]byte, []int) {
 	return file_api_app_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *AdminWithdrawListRequest) GetPage() int64 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
-}
-
-func (x *AdminWithdrawListRequest) GetAddress() string {
+func (x *SetBalanceRewardReply) GetStatus() string {
 	if x != nil {
-		return x.Address
+		return x.Status
 	}
 	return ""
 }
 
-type AdminWithdrawListReply struct {
+type DeleteBalanceRewardRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Withdraw []*AdminWithdrawListReply_List `protobuf:"bytes,1,rep,name=withdraw,proto3" json:"withdraw,omitempty"`
-	Count    int64                          `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	SendBody *DeleteBalanceRewardRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
 }
 
-func (x *AdminWithdrawListReply) Reset() {
-	*x = AdminWithdrawListReply{}
+func (x *DeleteBalanceRewardRequest) Reset() {
+	*x = DeleteBalanceRewardRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2902,13 +3520,13 @@ func (x *AdminWithdrawListReply) Reset() {
 	}
 }
 
-func (x *AdminWithdrawListReply) String() string {
+func (x *DeleteBalanceRewardRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminWithdrawListReply) ProtoMessage() {}
+func (*DeleteBalanceRewardRequest) ProtoMessage() {}
 
-func (x *AdminWithdrawListReply) ProtoReflect() protoreflect.Message {
+func (x *DeleteBalanceRewardRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2920,33 +3538,29 @@ func (x *AdminWithdrawListReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminWithdrawListReply.ProtoReflect.Descriptor instead.
-func (*AdminWithdrawListReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteBalanceRewardRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBalanceRewardRequest) Descriptor() ([]byte, []int) {
 	return file_api_app_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *AdminWithdrawListReply) GetWithdraw() []*AdminWithdrawListReply_List {
+func (x *DeleteBalanceRewardRequest) GetSendBody() *DeleteBalanceRewardRequest_SendBody {
 	if x != nil {
-		return x.Withdraw
+		return x.SendBody
 	}
 	return nil
 }
 
-func (x *AdminWithdrawListReply) GetCount() int64 {
-	if x != nil {
-		return x.Count
-	}
-	return 0
-}
-
-type AdminWithdrawRequest struct {
+type DeleteBalanceRewardReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Status    string                           `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Positions []*DeleteBalanceRewardReply_List `protobuf:"bytes,2,rep,name=positions,proto3" json:"positions,omitempty"` // 本次扣减的仓位
 }
 
-func (x *AdminWithdrawRequest) Reset() {
-	*x = AdminWithdrawRequest{}
+func (x *DeleteBalanceRewardReply) Reset() {
+	*x = DeleteBalanceRewardReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2954,13 +3568,13 @@ func (x *AdminWithdrawRequest) Reset() {
 	}
 }
 
-func (x *AdminWithdrawRequest) String() string {
+func (x *DeleteBalanceRewardReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminWithdrawRequest) ProtoMessage() {}
+func (*DeleteBalanceRewardReply) ProtoMessage() {}
 
-func (x *AdminWithdrawRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteBalanceRewardReply) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2972,19 +3586,33 @@ func (x *AdminWithdrawRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminWithdrawRequest.ProtoReflect.Descriptor instead.
-func (*AdminWithdrawRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteBalanceRewardReply.ProtoReflect.Descriptor instead.
+func (*DeleteBalanceRewardReply) Descriptor() ([]byte, []int) {
 	return file_api_app_proto_rawDescGZIP(), []int{47}
 }
 
-type AdminWithdrawReply struct {
+func (x *DeleteBalanceRewardReply) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DeleteBalanceRewardReply) GetPositions() []*DeleteBalanceRewardReply_List {
+	if x != nil {
+		return x.Positions
+	}
+	return nil
+}
+
+type BalanceRewardSummaryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *AdminWithdrawReply) Reset() {
-	*x = AdminWithdrawReply{}
+func (x *BalanceRewardSummaryRequest) Reset() {
+	*x = BalanceRewardSummaryRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2992,13 +3620,13 @@ func (x *AdminWithdrawReply) Reset() {
 	}
 }
 
-func (x *AdminWithdrawReply) String() string {
+func (x *BalanceRewardSummaryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminWithdrawReply) ProtoMessage() {}
+func (*BalanceRewardSummaryRequest) ProtoMessage() {}
 
-func (x *AdminWithdrawReply) ProtoReflect() protoreflect.Message {
+func (x *BalanceRewardSummaryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3010,19 +3638,25 @@ func (x *AdminWithdrawReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminWithdrawReply.ProtoReflect.Descriptor instead.
-func (*AdminWithdrawReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use BalanceRewardSummaryRequest.ProtoReflect.Descriptor instead.
+func (*BalanceRewardSummaryRequest) Descriptor() ([]byte, []int) {
 	return file_api_app_proto_rawDescGZIP(), []int{48}
 }
 
-type AdminWithdrawEthRequest struct {
+type BalanceRewardSummaryReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Principal      string                            `protobuf:"bytes,1,opt,name=principal,proto3" json:"principal,omitempty"` // 本金
+	Accrued        string                            `protobuf:"bytes,2,opt,name=accrued,proto3" json:"accrued,omitempty"`     // 累计收益
+	NextPayoutAt   string                            `protobuf:"bytes,3,opt,name=nextPayoutAt,proto3" json:"nextPayoutAt,omitempty"`
+	List           []*BalanceRewardSummaryReply_List `protobuf:"bytes,4,rep,name=list,proto3" json:"list,omitempty"`
+	PendingUnstake string                            `protobuf:"bytes,5,opt,name=pendingUnstake,proto3" json:"pendingUnstake,omitempty"` // 冷却中的赎回
 }
 
-func (x *AdminWithdrawEthRequest) Reset() {
-	*x = AdminWithdrawEthRequest{}
+func (x *BalanceRewardSummaryReply) Reset() {
+	*x = BalanceRewardSummaryReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3030,13 +3664,13 @@ func (x *AdminWithdrawEthRequest) Reset() {
 	}
 }
 
-func (x *AdminWithdrawEthRequest) String() string {
+func (x *BalanceRewardSummaryReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminWithdrawEthRequest) ProtoMessage() {}
+func (*BalanceRewardSummaryReply) ProtoMessage() {}
 
-func (x *AdminWithdrawEthRequest) ProtoReflect() protoreflect.Message {
+func (x *BalanceRewardSummaryReply) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3048,19 +3682,54 @@ func (x *AdminWithdrawEthRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminWithdrawEthRequest.ProtoReflect.Descriptor instead.
-func (*AdminWithdrawEthRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BalanceRewardSummaryReply.ProtoReflect.Descriptor instead.
+func (*BalanceRewardSummaryReply) Descriptor() ([]byte, []int) {
 	return file_api_app_proto_rawDescGZIP(), []int{49}
 }
 
-type AdminWithdrawEthReply struct {
+func (x *BalanceRewardSummaryReply) GetPrincipal() string {
+	if x != nil {
+		return x.Principal
+	}
+	return ""
+}
+
+func (x *BalanceRewardSummaryReply) GetAccrued() string {
+	if x != nil {
+		return x.Accrued
+	}
+	return ""
+}
+
+func (x *BalanceRewardSummaryReply) GetNextPayoutAt() string {
+	if x != nil {
+		return x.NextPayoutAt
+	}
+	return ""
+}
+
+func (x *BalanceRewardSummaryReply) GetList() []*BalanceRewardSummaryReply_List {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *BalanceRewardSummaryReply) GetPendingUnstake() string {
+	if x != nil {
+		return x.PendingUnstake
+	}
+	return ""
+}
+
+type WithdrawAddressListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *AdminWithdrawEthReply) Reset() {
-	*x = AdminWithdrawEthReply{}
+func (x *WithdrawAddressListRequest) Reset() {
+	*x = WithdrawAddressListRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3068,13 +3737,13 @@ func (x *AdminWithdrawEthReply) Reset() {
 	}
 }
 
-func (x *AdminWithdrawEthReply) String() string {
+func (x *WithdrawAddressListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminWithdrawEthReply) ProtoMessage() {}
+func (*WithdrawAddressListRequest) ProtoMessage() {}
 
-func (x *AdminWithdrawEthReply) ProtoReflect() protoreflect.Message {
+func (x *WithdrawAddressListRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3086,19 +3755,22 @@ func (x *AdminWithdrawEthReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminWithdrawEthReply.ProtoReflect.Descriptor instead.
-func (*AdminWithdrawEthReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use WithdrawAddressListRequest.ProtoReflect.Descriptor instead.
+func (*WithdrawAddressListRequest) Descriptor() ([]byte, []int) {
 	return file_api_app_proto_rawDescGZIP(), []int{50}
 }
 
-type AdminFeeRequest struct {
+type WithdrawAddressListReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	WhitelistOnly bool                             `protobuf:"varint,1,opt,name=whitelistOnly,proto3" json:"whitelistOnly,omitempty"` // 只能提现到地址簿
+	List          []*WithdrawAddressListReply_List `protobuf:"bytes,2,rep,name=list,proto3" json:"list,omitempty"`
 }
 
-func (x *AdminFeeRequest) Reset() {
-	*x = AdminFeeRequest{}
+func (x *WithdrawAddressListReply) Reset() {
+	*x = WithdrawAddressListReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3106,13 +3778,13 @@ func (x *AdminFeeRequest) Reset() {
 	}
 }
 
-func (x *AdminFeeRequest) String() string {
+func (x *WithdrawAddressListReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminFeeRequest) ProtoMessage() {}
+func (*WithdrawAddressListReply) ProtoMessage() {}
 
-func (x *AdminFeeRequest) ProtoReflect() protoreflect.Message {
+func (x *WithdrawAddressListReply) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3124,19 +3796,35 @@ func (x *AdminFeeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminFeeRequest.ProtoReflect.Descriptor instead.
-func (*AdminFeeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use WithdrawAddressListReply.ProtoReflect.Descriptor instead.
+func (*WithdrawAddressListReply) Descriptor() ([]byte, []int) {
 	return file_api_app_proto_rawDescGZIP(), []int{51}
 }
 
-type AdminFeeReply struct {
+func (x *WithdrawAddressListReply) GetWhitelistOnly() bool {
+	if x != nil {
+		return x.WhitelistOnly
+	}
+	return false
+}
+
+func (x *WithdrawAddressListReply) GetList() []*WithdrawAddressListReply_List {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+type WithdrawAddressCreateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	SendBody *WithdrawAddressCreateRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
 }
 
-func (x *AdminFeeReply) Reset() {
-	*x = AdminFeeReply{}
+func (x *WithdrawAddressCreateRequest) Reset() {
+	*x = WithdrawAddressCreateRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3144,13 +3832,13 @@ func (x *AdminFeeReply) Reset() {
 	}
 }
 
-func (x *AdminFeeReply) String() string {
+func (x *WithdrawAddressCreateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminFeeReply) ProtoMessage() {}
+func (*WithdrawAddressCreateRequest) ProtoMessage() {}
 
-func (x *AdminFeeReply) ProtoReflect() protoreflect.Message {
+func (x *WithdrawAddressCreateRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3162,19 +3850,29 @@ func (x *AdminFeeReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminFeeReply.ProtoReflect.Descriptor instead.
-func (*AdminFeeReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use WithdrawAddressCreateRequest.ProtoReflect.Descriptor instead.
+func (*WithdrawAddressCreateRequest) Descriptor() ([]byte, []int) {
 	return file_api_app_proto_rawDescGZIP(), []int{52}
 }
 
-type TokenWithdrawRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-}
-
-func (x *TokenWithdrawRequest) Reset() {
-	*x = TokenWithdrawRequest{}
+func (x *WithdrawAddressCreateRequest) GetSendBody() *WithdrawAddressCreateRequest_SendBody {
+	if x != nil {
+		return x.SendBody
+	}
+	return nil
+}
+
+type WithdrawAddressCreateReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ActiveAt string `protobuf:"bytes,2,opt,name=activeAt,proto3" json:"activeAt,omitempty"` // 生效时间
+}
+
+func (x *WithdrawAddressCreateReply) Reset() {
+	*x = WithdrawAddressCreateReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3182,13 +3880,13 @@ func (x *TokenWithdrawRequest) Reset() {
 	}
 }
 
-func (x *TokenWithdrawRequest) String() string {
+func (x *WithdrawAddressCreateReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TokenWithdrawRequest) ProtoMessage() {}
+func (*WithdrawAddressCreateReply) ProtoMessage() {}
 
-func (x *TokenWithdrawRequest) ProtoReflect() protoreflect.Message {
+func (x *WithdrawAddressCreateReply) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3200,19 +3898,35 @@ func (x *TokenWithdrawRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TokenWithdrawRequest.ProtoReflect.Descriptor instead.
-func (*TokenWithdrawRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use WithdrawAddressCreateReply.ProtoReflect.Descriptor instead.
+func (*WithdrawAddressCreateReply) Descriptor() ([]byte, []int) {
 	return file_api_app_proto_rawDescGZIP(), []int{53}
 }
 
-type TokenWithdrawReply struct {
+func (x *WithdrawAddressCreateReply) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *WithdrawAddressCreateReply) GetActiveAt() string {
+	if x != nil {
+		return x.ActiveAt
+	}
+	return ""
+}
+
+type WithdrawAddressDeleteRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	SendBody *WithdrawAddressDeleteRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
 }
 
-func (x *TokenWithdrawReply) Reset() {
-	*x = TokenWithdrawReply{}
+func (x *WithdrawAddressDeleteRequest) Reset() {
+	*x = WithdrawAddressDeleteRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3220,13 +3934,13 @@ func (x *TokenWithdrawReply) Reset() {
 	}
 }
 
-func (x *TokenWithdrawReply) String() string {
+func (x *WithdrawAddressDeleteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TokenWithdrawReply) ProtoMessage() {}
+func (*WithdrawAddressDeleteRequest) ProtoMessage() {}
 
-func (x *TokenWithdrawReply) ProtoReflect() protoreflect.Message {
+func (x *WithdrawAddressDeleteRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3238,19 +3952,28 @@ func (x *TokenWithdrawReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TokenWithdrawReply.ProtoReflect.Descriptor instead.
-func (*TokenWithdrawReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use WithdrawAddressDeleteRequest.ProtoReflect.Descriptor instead.
+func (*WithdrawAddressDeleteRequest) Descriptor() ([]byte, []int) {
 	return file_api_app_proto_rawDescGZIP(), []int{54}
 }
 
-type AdminAllRequest struct {
+func (x *WithdrawAddressDeleteRequest) GetSendBody() *WithdrawAddressDeleteRequest_SendBody {
+	if x != nil {
+		return x.SendBody
+	}
+	return nil
+}
+
+type WithdrawAddressDeleteReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *AdminAllRequest) Reset() {
-	*x = AdminAllRequest{}
+func (x *WithdrawAddressDeleteReply) Reset() {
+	*x = WithdrawAddressDeleteReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3258,13 +3981,13 @@ func (x *AdminAllRequest) Reset() {
 	}
 }
 
-func (x *AdminAllRequest) String() string {
+func (x *WithdrawAddressDeleteReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminAllRequest) ProtoMessage() {}
+func (*WithdrawAddressDeleteReply) ProtoMessage() {}
 
-func (x *AdminAllRequest) ProtoReflect() protoreflect.Message {
+func (x *WithdrawAddressDeleteReply) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3276,29 +3999,26 @@ func (x *AdminAllRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminAllRequest.ProtoReflect.Descriptor instead.
-func (*AdminAllRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use WithdrawAddressDeleteReply.ProtoReflect.Descriptor instead.
+func (*WithdrawAddressDeleteReply) Descriptor() ([]byte, []int) {
 	return file_api_app_proto_rawDescGZIP(), []int{55}
 }
 
-type AdminAllReply struct {
+func (x *WithdrawAddressDeleteReply) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type InviteCodeListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	TodayTotalUser        int64  `protobuf:"varint,1,opt,name=todayTotalUser,proto3" json:"todayTotalUser,omitempty"`
-	TotalUser             int64  `protobuf:"varint,2,opt,name=totalUser,proto3" json:"totalUser,omitempty"`
-	AllBalance            string `protobuf:"bytes,3,opt,name=allBalance,proto3" json:"allBalance,omitempty"`
-	TodayLocation         string `protobuf:"bytes,4,opt,name=todayLocation,proto3" json:"todayLocation,omitempty"`
-	AllLocation           string `protobuf:"bytes,5,opt,name=allLocation,proto3" json:"allLocation,omitempty"`
-	TodayWithdraw         string `protobuf:"bytes,6,opt,name=todayWithdraw,proto3" json:"todayWithdraw,omitempty"`
-	AllWithdraw           string `protobuf:"bytes,7,opt,name=allWithdraw,proto3" json:"allWithdraw,omitempty"`
-	AllReward             string `protobuf:"bytes,8,opt,name=allReward,proto3" json:"allReward,omitempty"`
-	AllSystemRewardAndFee string `protobuf:"bytes,9,opt,name=allSystemRewardAndFee,proto3" json:"allSystemRewardAndFee,omitempty"`
 }
 
-func (x *AdminAllReply) Reset() {
-	*x = AdminAllReply{}
+func (x *InviteCodeListRequest) Reset() {
+	*x = InviteCodeListRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3306,13 +4026,13 @@ func (x *AdminAllReply) Reset() {
 	}
 }
 
-func (x *AdminAllReply) String() string {
+func (x *InviteCodeListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminAllReply) ProtoMessage() {}
+func (*InviteCodeListRequest) ProtoMessage() {}
 
-func (x *AdminAllReply) ProtoReflect() protoreflect.Message {
+func (x *InviteCodeListRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3324,84 +4044,21 @@ func (x *AdminAllReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminAllReply.ProtoReflect.Descriptor instead.
-func (*AdminAllReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use InviteCodeListRequest.ProtoReflect.Descriptor instead.
+func (*InviteCodeListRequest) Descriptor() ([]byte, []int) {
 	return file_api_app_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *AdminAllReply) GetTodayTotalUser() int64 {
-	if x != nil {
-		return x.TodayTotalUser
-	}
-	return 0
-}
-
-func (x *AdminAllReply) GetTotalUser() int64 {
-	if x != nil {
-		return x.TotalUser
-	}
-	return 0
-}
-
-func (x *AdminAllReply) GetAllBalance() string {
-	if x != nil {
-		return x.AllBalance
-	}
-	return ""
-}
-
-func (x *AdminAllReply) GetTodayLocation() string {
-	if x != nil {
-		return x.TodayLocation
-	}
-	return ""
-}
-
-func (x *AdminAllReply) GetAllLocation() string {
-	if x != nil {
-		return x.AllLocation
-	}
-	return ""
-}
-
-func (x *AdminAllReply) GetTodayWithdraw() string {
-	if x != nil {
-		return x.TodayWithdraw
-	}
-	return ""
-}
-
-func (x *AdminAllReply) GetAllWithdraw() string {
-	if x != nil {
-		return x.AllWithdraw
-	}
-	return ""
-}
-
-func (x *AdminAllReply) GetAllReward() string {
-	if x != nil {
-		return x.AllReward
-	}
-	return ""
-}
-
-func (x *AdminAllReply) GetAllSystemRewardAndFee() string {
-	if x != nil {
-		return x.AllSystemRewardAndFee
-	}
-	return ""
-}
-
-type AdminUserRecommendRequest struct {
+type InviteCodeListReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Codes []*InviteCodeListReply_List `protobuf:"bytes,1,rep,name=codes,proto3" json:"codes,omitempty"`
 }
 
-func (x *AdminUserRecommendRequest) Reset() {
-	*x = AdminUserRecommendRequest{}
+func (x *InviteCodeListReply) Reset() {
+	*x = InviteCodeListReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3409,13 +4066,13 @@ func (x *AdminUserRecommendRequest) Reset() {
 	}
 }
 
-func (x *AdminUserRecommendRequest) String() string {
+func (x *InviteCodeListReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminUserRecommendRequest) ProtoMessage() {}
+func (*InviteCodeListReply) ProtoMessage() {}
 
-func (x *AdminUserRecommendRequest) ProtoReflect() protoreflect.Message {
+func (x *InviteCodeListReply) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3427,28 +4084,28 @@ func (x *AdminUserRecommendRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminUserRecommendRequest.ProtoReflect.Descriptor instead.
-func (*AdminUserRecommendRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use InviteCodeListReply.ProtoReflect.Descriptor instead.
+func (*InviteCodeListReply) Descriptor() ([]byte, []int) {
 	return file_api_app_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *AdminUserRecommendRequest) GetUserId() int64 {
+func (x *InviteCodeListReply) GetCodes() []*InviteCodeListReply_List {
 	if x != nil {
-		return x.UserId
+		return x.Codes
 	}
-	return 0
+	return nil
 }
 
-type AdminUserRecommendReply struct {
+type InviteCodeCreateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Users []*AdminUserRecommendReply_List `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	SendBody *InviteCodeCreateRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
 }
 
-func (x *AdminUserRecommendReply) Reset() {
-	*x = AdminUserRecommendReply{}
+func (x *InviteCodeCreateRequest) Reset() {
+	*x = InviteCodeCreateRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3456,13 +4113,13 @@ func (x *AdminUserRecommendReply) Reset() {
 	}
 }
 
-func (x *AdminUserRecommendReply) String() string {
+func (x *InviteCodeCreateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminUserRecommendReply) ProtoMessage() {}
+func (*InviteCodeCreateRequest) ProtoMessage() {}
 
-func (x *AdminUserRecommendReply) ProtoReflect() protoreflect.Message {
+func (x *InviteCodeCreateRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3474,29 +4131,28 @@ func (x *AdminUserRecommendReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminUserRecommendReply.ProtoReflect.Descriptor instead.
-func (*AdminUserRecommendReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use InviteCodeCreateRequest.ProtoReflect.Descriptor instead.
+func (*InviteCodeCreateRequest) Descriptor() ([]byte, []int) {
 	return file_api_app_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *AdminUserRecommendReply) GetUsers() []*AdminUserRecommendReply_List {
+func (x *InviteCodeCreateRequest) GetSendBody() *InviteCodeCreateRequest_SendBody {
 	if x != nil {
-		return x.Users
+		return x.SendBody
 	}
 	return nil
 }
 
-type AdminMonthRecommendRequest struct {
+type InviteCodeCreateReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Page    int64  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
 }
 
-func (x *AdminMonthRecommendRequest) Reset() {
-	*x = AdminMonthRecommendRequest{}
+func (x *InviteCodeCreateReply) Reset() {
+	*x = InviteCodeCreateReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3504,13 +4160,13 @@ func (x *AdminMonthRecommendRequest) Reset() {
 	}
 }
 
-func (x *AdminMonthRecommendRequest) String() string {
+func (x *InviteCodeCreateReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminMonthRecommendRequest) ProtoMessage() {}
+func (*InviteCodeCreateReply) ProtoMessage() {}
 
-func (x *AdminMonthRecommendRequest) ProtoReflect() protoreflect.Message {
+func (x *InviteCodeCreateReply) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3522,36 +4178,26 @@ func (x *AdminMonthRecommendRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminMonthRecommendRequest.ProtoReflect.Descriptor instead.
-func (*AdminMonthRecommendRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use InviteCodeCreateReply.ProtoReflect.Descriptor instead.
+func (*InviteCodeCreateReply) Descriptor() ([]byte, []int) {
 	return file_api_app_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *AdminMonthRecommendRequest) GetAddress() string {
+func (x *InviteCodeCreateReply) GetCode() string {
 	if x != nil {
-		return x.Address
+		return x.Code
 	}
 	return ""
 }
 
-func (x *AdminMonthRecommendRequest) GetPage() int64 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
-}
-
-type AdminMonthRecommendReply struct {
+type LocaleRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Users []*AdminMonthRecommendReply_List `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
-	Count int64                            `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *AdminMonthRecommendReply) Reset() {
-	*x = AdminMonthRecommendReply{}
+func (x *LocaleRequest) Reset() {
+	*x = LocaleRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3559,13 +4205,13 @@ func (x *AdminMonthRecommendReply) Reset() {
 	}
 }
 
-func (x *AdminMonthRecommendReply) String() string {
+func (x *LocaleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminMonthRecommendReply) ProtoMessage() {}
+func (*LocaleRequest) ProtoMessage() {}
 
-func (x *AdminMonthRecommendReply) ProtoReflect() protoreflect.Message {
+func (x *LocaleRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3577,36 +4223,23 @@ func (x *AdminMonthRecommendReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminMonthRecommendReply.ProtoReflect.Descriptor instead.
-func (*AdminMonthRecommendReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use LocaleRequest.ProtoReflect.Descriptor instead.
+func (*LocaleRequest) Descriptor() ([]byte, []int) {
 	return file_api_app_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *AdminMonthRecommendReply) GetUsers() []*AdminMonthRecommendReply_List {
-	if x != nil {
-		return x.Users
-	}
-	return nil
-}
-
-func (x *AdminMonthRecommendReply) GetCount() int64 {
-	if x != nil {
-		return x.Count
-	}
-	return 0
-}
-
-type AdminConfigRequest struct {
+type LocaleReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId int64 `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
-	Page   int64 `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Locale     string   `protobuf:"bytes,1,opt,name=locale,proto3" json:"locale,omitempty"`         // 当前请求使用的语言
+	Preference string   `protobuf:"bytes,2,opt,name=preference,proto3" json:"preference,omitempty"` // 用户设置的语言，未设置为空
+	Supported  []string `protobuf:"bytes,3,rep,name=supported,proto3" json:"supported,omitempty"`
 }
 
-func (x *AdminConfigRequest) Reset() {
-	*x = AdminConfigRequest{}
+func (x *LocaleReply) Reset() {
+	*x = LocaleReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3614,13 +4247,13 @@ func (x *AdminConfigRequest) Reset() {
 	}
 }
 
-func (x *AdminConfigRequest) String() string {
+func (x *LocaleReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminConfigRequest) ProtoMessage() {}
+func (*LocaleReply) ProtoMessage() {}
 
-func (x *AdminConfigRequest) ProtoReflect() protoreflect.Message {
+func (x *LocaleReply) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3632,36 +4265,42 @@ func (x *AdminConfigRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminConfigRequest.ProtoReflect.Descriptor instead.
-func (*AdminConfigRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use LocaleReply.ProtoReflect.Descriptor instead.
+func (*LocaleReply) Descriptor() ([]byte, []int) {
 	return file_api_app_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *AdminConfigRequest) GetUserId() int64 {
+func (x *LocaleReply) GetLocale() string {
 	if x != nil {
-		return x.UserId
+		return x.Locale
 	}
-	return 0
+	return ""
 }
 
-func (x *AdminConfigRequest) GetPage() int64 {
+func (x *LocaleReply) GetPreference() string {
 	if x != nil {
-		return x.Page
+		return x.Preference
 	}
-	return 0
+	return ""
 }
 
-type AdminConfigReply struct {
+func (x *LocaleReply) GetSupported() []string {
+	if x != nil {
+		return x.Supported
+	}
+	return nil
+}
+
+type LocaleChangeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Config []*AdminConfigReply_List `protobuf:"bytes,1,rep,name=config,proto3" json:"config,omitempty"`
-	Count  int64                    `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	SendBody *LocaleChangeRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
 }
 
-func (x *AdminConfigReply) Reset() {
-	*x = AdminConfigReply{}
+func (x *LocaleChangeRequest) Reset() {
+	*x = LocaleChangeRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3669,13 +4308,13 @@ func (x *AdminConfigReply) Reset() {
 	}
 }
 
-func (x *AdminConfigReply) String() string {
+func (x *LocaleChangeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminConfigReply) ProtoMessage() {}
+func (*LocaleChangeRequest) ProtoMessage() {}
 
-func (x *AdminConfigReply) ProtoReflect() protoreflect.Message {
+func (x *LocaleChangeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3687,35 +4326,29 @@ func (x *AdminConfigReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminConfigReply.ProtoReflect.Descriptor instead.
-func (*AdminConfigReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use LocaleChangeRequest.ProtoReflect.Descriptor instead.
+func (*LocaleChangeRequest) Descriptor() ([]byte, []int) {
 	return file_api_app_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *AdminConfigReply) GetConfig() []*AdminConfigReply_List {
+func (x *LocaleChangeRequest) GetSendBody() *LocaleChangeRequest_SendBody {
 	if x != nil {
-		return x.Config
+		return x.SendBody
 	}
 	return nil
 }
 
-func (x *AdminConfigReply) GetCount() int64 {
-	if x != nil {
-		return x.Count
-	}
-	return 0
-}
-
-type AdminConfigUpdateRequest struct {
+type LocaleChangeReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SendBody *AdminConfigUpdateRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Locale string `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"`
 }
 
-func (x *AdminConfigUpdateRequest) Reset() {
-	*x = AdminConfigUpdateRequest{}
+func (x *LocaleChangeReply) Reset() {
+	*x = LocaleChangeReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3723,13 +4356,13 @@ func (x *AdminConfigUpdateRequest) Reset() {
 	}
 }
 
-func (x *AdminConfigUpdateRequest) String() string {
+func (x *LocaleChangeReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminConfigUpdateRequest) ProtoMessage() {}
+func (*LocaleChangeReply) ProtoMessage() {}
 
-func (x *AdminConfigUpdateRequest) ProtoReflect() protoreflect.Message {
+func (x *LocaleChangeReply) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3741,26 +4374,35 @@ func (x *AdminConfigUpdateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminConfigUpdateRequest.ProtoReflect.Descriptor instead.
-func (*AdminConfigUpdateRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use LocaleChangeReply.ProtoReflect.Descriptor instead.
+func (*LocaleChangeReply) Descriptor() ([]byte, []int) {
 	return file_api_app_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *AdminConfigUpdateRequest) GetSendBody() *AdminConfigUpdateRequest_SendBody {
+func (x *LocaleChangeReply) GetStatus() string {
 	if x != nil {
-		return x.SendBody
+		return x.Status
 	}
-	return nil
+	return ""
 }
 
-type AdminConfigUpdateReply struct {
+func (x *LocaleChangeReply) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+type PushTicketRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	SendBody *PushTicketRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
 }
 
-func (x *AdminConfigUpdateReply) Reset() {
-	*x = AdminConfigUpdateReply{}
+func (x *PushTicketRequest) Reset() {
+	*x = PushTicketRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3768,13 +4410,13 @@ func (x *AdminConfigUpdateReply) Reset() {
 	}
 }
 
-func (x *AdminConfigUpdateReply) String() string {
+func (x *PushTicketRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminConfigUpdateReply) ProtoMessage() {}
+func (*PushTicketRequest) ProtoMessage() {}
 
-func (x *AdminConfigUpdateReply) ProtoReflect() protoreflect.Message {
+func (x *PushTicketRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3786,24 +4428,29 @@ func (x *AdminConfigUpdateReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminConfigUpdateReply.ProtoReflect.Descriptor instead.
-func (*AdminConfigUpdateReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use PushTicketRequest.ProtoReflect.Descriptor instead.
+func (*PushTicketRequest) Descriptor() ([]byte, []int) {
 	return file_api_app_proto_rawDescGZIP(), []int{64}
 }
 
-type EthAuthorizeRequest_SendBody struct {
+func (x *PushTicketRequest) GetSendBody() *PushTicketRequest_SendBody {
+	if x != nil {
+		return x.SendBody
+	}
+	return nil
+}
+
+type PushTicketReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address  string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Code     string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
-	Password string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
-	Sign     string `protobuf:"bytes,4,opt,name=sign,proto3" json:"sign,omitempty"`
+	Ticket    string `protobuf:"bytes,1,opt,name=ticket,proto3" json:"ticket,omitempty"`
+	ExpiresIn int64  `protobuf:"varint,2,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"` // 秒
 }
 
-func (x *EthAuthorizeRequest_SendBody) Reset() {
-	*x = EthAuthorizeRequest_SendBody{}
+func (x *PushTicketReply) Reset() {
+	*x = PushTicketReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3811,13 +4458,13 @@ func (x *EthAuthorizeRequest_SendBody) Reset() {
 	}
 }
 
-func (x *EthAuthorizeRequest_SendBody) String() string {
+func (x *PushTicketReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EthAuthorizeRequest_SendBody) ProtoMessage() {}
+func (*PushTicketReply) ProtoMessage() {}
 
-func (x *EthAuthorizeRequest_SendBody) ProtoReflect() protoreflect.Message {
+func (x *PushTicketReply) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3829,50 +4476,35 @@ func (x *EthAuthorizeRequest_SendBody) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EthAuthorizeRequest_SendBody.ProtoReflect.Descriptor instead.
-func (*EthAuthorizeRequest_SendBody) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{0, 0}
-}
-
-func (x *EthAuthorizeRequest_SendBody) GetAddress() string {
-	if x != nil {
-		return x.Address
-	}
-	return ""
-}
-
-func (x *EthAuthorizeRequest_SendBody) GetCode() string {
-	if x != nil {
-		return x.Code
-	}
-	return ""
+// Deprecated: Use PushTicketReply.ProtoReflect.Descriptor instead.
+func (*PushTicketReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *EthAuthorizeRequest_SendBody) GetPassword() string {
+func (x *PushTicketReply) GetTicket() string {
 	if x != nil {
-		return x.Password
+		return x.Ticket
 	}
 	return ""
 }
 
-func (x *EthAuthorizeRequest_SendBody) GetSign() string {
+func (x *PushTicketReply) GetExpiresIn() int64 {
 	if x != nil {
-		return x.Sign
+		return x.ExpiresIn
 	}
-	return ""
+	return 0
 }
 
-type RecommendUpdateRequest_SendBody struct {
+type AdminInviteCodeCreateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
-	Sign string `protobuf:"bytes,2,opt,name=sign,proto3" json:"sign,omitempty"`
+	SendBody *AdminInviteCodeCreateRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
 }
 
-func (x *RecommendUpdateRequest_SendBody) Reset() {
-	*x = RecommendUpdateRequest_SendBody{}
+func (x *AdminInviteCodeCreateRequest) Reset() {
+	*x = AdminInviteCodeCreateRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[66]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3880,13 +4512,13 @@ func (x *RecommendUpdateRequest_SendBody) Reset() {
 	}
 }
 
-func (x *RecommendUpdateRequest_SendBody) String() string {
+func (x *AdminInviteCodeCreateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecommendUpdateRequest_SendBody) ProtoMessage() {}
+func (*AdminInviteCodeCreateRequest) ProtoMessage() {}
 
-func (x *RecommendUpdateRequest_SendBody) ProtoReflect() protoreflect.Message {
+func (x *AdminInviteCodeCreateRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[66]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3898,37 +4530,28 @@ func (x *RecommendUpdateRequest_SendBody) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecommendUpdateRequest_SendBody.ProtoReflect.Descriptor instead.
-func (*RecommendUpdateRequest_SendBody) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{2, 0}
-}
-
-func (x *RecommendUpdateRequest_SendBody) GetCode() string {
-	if x != nil {
-		return x.Code
-	}
-	return ""
+// Deprecated: Use AdminInviteCodeCreateRequest.ProtoReflect.Descriptor instead.
+func (*AdminInviteCodeCreateRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *RecommendUpdateRequest_SendBody) GetSign() string {
+func (x *AdminInviteCodeCreateRequest) GetSendBody() *AdminInviteCodeCreateRequest_SendBody {
 	if x != nil {
-		return x.Sign
+		return x.SendBody
 	}
-	return ""
+	return nil
 }
 
-type UserAreaReply_List struct {
+type AdminInviteCodeCreateReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address    string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"` // 收益
-	LocationId int64  `protobuf:"varint,3,opt,name=locationId,proto3" json:"locationId,omitempty"`
-	CountLow   int64  `protobuf:"varint,4,opt,name=countLow,proto3" json:"countLow,omitempty"`
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
 }
 
-func (x *UserAreaReply_List) Reset() {
-	*x = UserAreaReply_List{}
+func (x *AdminInviteCodeCreateReply) Reset() {
+	*x = AdminInviteCodeCreateReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[67]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3936,13 +4559,13 @@ func (x *UserAreaReply_List) Reset() {
 	}
 }
 
-func (x *UserAreaReply_List) String() string {
+func (x *AdminInviteCodeCreateReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserAreaReply_List) ProtoMessage() {}
+func (*AdminInviteCodeCreateReply) ProtoMessage() {}
 
-func (x *UserAreaReply_List) ProtoReflect() protoreflect.Message {
+func (x *AdminInviteCodeCreateReply) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[67]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3954,44 +4577,28 @@ func (x *UserAreaReply_List) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserAreaReply_List.ProtoReflect.Descriptor instead.
-func (*UserAreaReply_List) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{7, 0}
+// Deprecated: Use AdminInviteCodeCreateReply.ProtoReflect.Descriptor instead.
+func (*AdminInviteCodeCreateReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *UserAreaReply_List) GetAddress() string {
+func (x *AdminInviteCodeCreateReply) GetCode() string {
 	if x != nil {
-		return x.Address
+		return x.Code
 	}
 	return ""
 }
 
-func (x *UserAreaReply_List) GetLocationId() int64 {
-	if x != nil {
-		return x.LocationId
-	}
-	return 0
-}
-
-func (x *UserAreaReply_List) GetCountLow() int64 {
-	if x != nil {
-		return x.CountLow
-	}
-	return 0
-}
-
-type UserInfoReply_List struct {
+type AdminPasswordUpdateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Current              string `protobuf:"bytes,2,opt,name=current,proto3" json:"current,omitempty"`                           // 收益
-	CurrentMaxSubCurrent string `protobuf:"bytes,4,opt,name=currentMaxSubCurrent,proto3" json:"currentMaxSubCurrent,omitempty"` // 挖矿
-	Amount               string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`                             // 节点
+	SendBody *AdminPasswordUpdateRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
 }
 
-func (x *UserInfoReply_List) Reset() {
-	*x = UserInfoReply_List{}
+func (x *AdminPasswordUpdateRequest) Reset() {
+	*x = AdminPasswordUpdateRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[68]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3999,13 +4606,13 @@ func (x *UserInfoReply_List) Reset() {
 	}
 }
 
-func (x *UserInfoReply_List) String() string {
+func (x *AdminPasswordUpdateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserInfoReply_List) ProtoMessage() {}
+func (*AdminPasswordUpdateRequest) ProtoMessage() {}
 
-func (x *UserInfoReply_List) ProtoReflect() protoreflect.Message {
+func (x *AdminPasswordUpdateRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[68]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4017,43 +4624,28 @@ func (x *UserInfoReply_List) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserInfoReply_List.ProtoReflect.Descriptor instead.
-func (*UserInfoReply_List) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{9, 0}
-}
-
-func (x *UserInfoReply_List) GetCurrent() string {
-	if x != nil {
-		return x.Current
-	}
-	return ""
-}
-
-func (x *UserInfoReply_List) GetCurrentMaxSubCurrent() string {
-	if x != nil {
-		return x.CurrentMaxSubCurrent
-	}
-	return ""
+// Deprecated: Use AdminPasswordUpdateRequest.ProtoReflect.Descriptor instead.
+func (*AdminPasswordUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *UserInfoReply_List) GetAmount() string {
+func (x *AdminPasswordUpdateRequest) GetSendBody() *AdminPasswordUpdateRequest_SendBody {
 	if x != nil {
-		return x.Amount
+		return x.SendBody
 	}
-	return ""
+	return nil
 }
 
-type UserInfoReply_ListWithdraw struct {
+type AdminPasswordUpdateReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Amount   string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`     // 提现金额
-	CreateAt string `protobuf:"bytes,1,opt,name=createAt,proto3" json:"createAt,omitempty"` // 提现时间
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *UserInfoReply_ListWithdraw) Reset() {
-	*x = UserInfoReply_ListWithdraw{}
+func (x *AdminPasswordUpdateReply) Reset() {
+	*x = AdminPasswordUpdateReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[69]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4061,13 +4653,13 @@ func (x *UserInfoReply_ListWithdraw) Reset() {
 	}
 }
 
-func (x *UserInfoReply_ListWithdraw) String() string {
+func (x *AdminPasswordUpdateReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserInfoReply_ListWithdraw) ProtoMessage() {}
+func (*AdminPasswordUpdateReply) ProtoMessage() {}
 
-func (x *UserInfoReply_ListWithdraw) ProtoReflect() protoreflect.Message {
+func (x *AdminPasswordUpdateReply) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[69]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4079,37 +4671,30 @@ func (x *UserInfoReply_ListWithdraw) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserInfoReply_ListWithdraw.ProtoReflect.Descriptor instead.
-func (*UserInfoReply_ListWithdraw) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{9, 1}
-}
-
-func (x *UserInfoReply_ListWithdraw) GetAmount() string {
-	if x != nil {
-		return x.Amount
-	}
-	return ""
+// Deprecated: Use AdminPasswordUpdateReply.ProtoReflect.Descriptor instead.
+func (*AdminPasswordUpdateReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *UserInfoReply_ListWithdraw) GetCreateAt() string {
+func (x *AdminPasswordUpdateReply) GetStatus() string {
 	if x != nil {
-		return x.CreateAt
+		return x.Status
 	}
 	return ""
 }
 
-type UserInfoReply_ListFour struct {
+type AdminCommissionSimulateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Amount   string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"` // 直推金额
-	Reward   string `protobuf:"bytes,3,opt,name=reward,proto3" json:"reward,omitempty"` // 奖励
-	Location string `protobuf:"bytes,4,opt,name=location,proto3" json:"location,omitempty"`
+	Type   string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"` // deposit trade withdraw
+	UserId int64  `protobuf:"varint,2,opt,name=userId,proto3" json:"userId,omitempty"`
+	Amount string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (x *UserInfoReply_ListFour) Reset() {
-	*x = UserInfoReply_ListFour{}
+func (x *AdminCommissionSimulateRequest) Reset() {
+	*x = AdminCommissionSimulateRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[70]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4117,13 +4702,13 @@ func (x *UserInfoReply_ListFour) Reset() {
 	}
 }
 
-func (x *UserInfoReply_ListFour) String() string {
+func (x *AdminCommissionSimulateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserInfoReply_ListFour) ProtoMessage() {}
+func (*AdminCommissionSimulateRequest) ProtoMessage() {}
 
-func (x *UserInfoReply_ListFour) ProtoReflect() protoreflect.Message {
+func (x *AdminCommissionSimulateRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[70]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4135,45 +4720,43 @@ func (x *UserInfoReply_ListFour) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserInfoReply_ListFour.ProtoReflect.Descriptor instead.
-func (*UserInfoReply_ListFour) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{9, 2}
+// Deprecated: Use AdminCommissionSimulateRequest.ProtoReflect.Descriptor instead.
+func (*AdminCommissionSimulateRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *UserInfoReply_ListFour) GetAmount() string {
+func (x *AdminCommissionSimulateRequest) GetType() string {
 	if x != nil {
-		return x.Amount
+		return x.Type
 	}
 	return ""
 }
 
-func (x *UserInfoReply_ListFour) GetReward() string {
+func (x *AdminCommissionSimulateRequest) GetUserId() int64 {
 	if x != nil {
-		return x.Reward
+		return x.UserId
 	}
-	return ""
+	return 0
 }
 
-func (x *UserInfoReply_ListFour) GetLocation() string {
+func (x *AdminCommissionSimulateRequest) GetAmount() string {
 	if x != nil {
-		return x.Location
+		return x.Amount
 	}
 	return ""
 }
 
-type UserInfoReply_ListReward struct {
+type AdminCommissionSimulateReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CreatedAt  string `protobuf:"bytes,1,opt,name=createdAt,proto3" json:"createdAt,omitempty"`   // 时间
-	Type       int64  `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"`            // 类型，挖矿收益 type=1,分享 type=2, 前四名type =3,矩阵type=4
-	Reward     string `protobuf:"bytes,3,opt,name=reward,proto3" json:"reward,omitempty"`         // 数量
-	RewardUsdt string `protobuf:"bytes,4,opt,name=rewardUsdt,proto3" json:"rewardUsdt,omitempty"` // 数量
+	Rewards []*AdminCommissionSimulateReply_List `protobuf:"bytes,1,rep,name=rewards,proto3" json:"rewards,omitempty"`
+	Total   string                               `protobuf:"bytes,2,opt,name=total,proto3" json:"total,omitempty"`
 }
 
-func (x *UserInfoReply_ListReward) Reset() {
-	*x = UserInfoReply_ListReward{}
+func (x *AdminCommissionSimulateReply) Reset() {
+	*x = AdminCommissionSimulateReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[71]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4181,13 +4764,13 @@ func (x *UserInfoReply_ListReward) Reset() {
 	}
 }
 
-func (x *UserInfoReply_ListReward) String() string {
+func (x *AdminCommissionSimulateReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserInfoReply_ListReward) ProtoMessage() {}
+func (*AdminCommissionSimulateReply) ProtoMessage() {}
 
-func (x *UserInfoReply_ListReward) ProtoReflect() protoreflect.Message {
+func (x *AdminCommissionSimulateReply) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[71]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4199,49 +4782,35 @@ func (x *UserInfoReply_ListReward) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserInfoReply_ListReward.ProtoReflect.Descriptor instead.
-func (*UserInfoReply_ListReward) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{9, 3}
-}
-
-func (x *UserInfoReply_ListReward) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return ""
-}
-
-func (x *UserInfoReply_ListReward) GetType() int64 {
-	if x != nil {
-		return x.Type
-	}
-	return 0
+// Deprecated: Use AdminCommissionSimulateReply.ProtoReflect.Descriptor instead.
+func (*AdminCommissionSimulateReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *UserInfoReply_ListReward) GetReward() string {
+func (x *AdminCommissionSimulateReply) GetRewards() []*AdminCommissionSimulateReply_List {
 	if x != nil {
-		return x.Reward
+		return x.Rewards
 	}
-	return ""
+	return nil
 }
 
-func (x *UserInfoReply_ListReward) GetRewardUsdt() string {
+func (x *AdminCommissionSimulateReply) GetTotal() string {
 	if x != nil {
-		return x.RewardUsdt
+		return x.Total
 	}
 	return ""
 }
 
-type UserInfoReply_ListRecommend struct {
+type AdminAreaLevelRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"` // 地址
+	SendBody *AdminAreaLevelRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
 }
 
-func (x *UserInfoReply_ListRecommend) Reset() {
-	*x = UserInfoReply_ListRecommend{}
+func (x *AdminAreaLevelRequest) Reset() {
+	*x = AdminAreaLevelRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[72]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4249,13 +4818,13 @@ func (x *UserInfoReply_ListRecommend) Reset() {
 	}
 }
 
-func (x *UserInfoReply_ListRecommend) String() string {
+func (x *AdminAreaLevelRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserInfoReply_ListRecommend) ProtoMessage() {}
+func (*AdminAreaLevelRequest) ProtoMessage() {}
 
-func (x *UserInfoReply_ListRecommend) ProtoReflect() protoreflect.Message {
+func (x *AdminAreaLevelRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[72]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4267,30 +4836,32 @@ func (x *UserInfoReply_ListRecommend) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserInfoReply_ListRecommend.ProtoReflect.Descriptor instead.
-func (*UserInfoReply_ListRecommend) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{9, 4}
+// Deprecated: Use AdminAreaLevelRequest.ProtoReflect.Descriptor instead.
+func (*AdminAreaLevelRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *UserInfoReply_ListRecommend) GetAddress() string {
+func (x *AdminAreaLevelRequest) GetSendBody() *AdminAreaLevelRequest_SendBody {
 	if x != nil {
-		return x.Address
+		return x.SendBody
 	}
-	return ""
+	return nil
 }
 
-type UserInfoReply_ListExchange struct {
+type AdminAreaLevelReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Amount     string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
-	UsdtAmount string `protobuf:"bytes,3,opt,name=usdtAmount,proto3" json:"usdtAmount,omitempty"`
-	Created    string `protobuf:"bytes,2,opt,name=created,proto3" json:"created,omitempty"`
+	UserNum    int64                       `protobuf:"varint,1,opt,name=userNum,proto3" json:"userNum,omitempty"`
+	Promotions int64                       `protobuf:"varint,2,opt,name=promotions,proto3" json:"promotions,omitempty"` // 升级人数
+	Demotions  int64                       `protobuf:"varint,3,opt,name=demotions,proto3" json:"demotions,omitempty"`   // 降级人数
+	BonusTotal string                      `protobuf:"bytes,4,opt,name=bonusTotal,proto3" json:"bonusTotal,omitempty"`  // 级差奖总额
+	Changes    []*AdminAreaLevelReply_List `protobuf:"bytes,5,rep,name=changes,proto3" json:"changes,omitempty"`
 }
 
-func (x *UserInfoReply_ListExchange) Reset() {
-	*x = UserInfoReply_ListExchange{}
+func (x *AdminAreaLevelReply) Reset() {
+	*x = AdminAreaLevelReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[73]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4298,13 +4869,13 @@ func (x *UserInfoReply_ListExchange) Reset() {
 	}
 }
 
-func (x *UserInfoReply_ListExchange) String() string {
+func (x *AdminAreaLevelReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserInfoReply_ListExchange) ProtoMessage() {}
+func (*AdminAreaLevelReply) ProtoMessage() {}
 
-func (x *UserInfoReply_ListExchange) ProtoReflect() protoreflect.Message {
+func (x *AdminAreaLevelReply) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[73]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4316,45 +4887,57 @@ func (x *UserInfoReply_ListExchange) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserInfoReply_ListExchange.ProtoReflect.Descriptor instead.
-func (*UserInfoReply_ListExchange) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{9, 5}
+// Deprecated: Use AdminAreaLevelReply.ProtoReflect.Descriptor instead.
+func (*AdminAreaLevelReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *UserInfoReply_ListExchange) GetAmount() string {
+func (x *AdminAreaLevelReply) GetUserNum() int64 {
 	if x != nil {
-		return x.Amount
+		return x.UserNum
 	}
-	return ""
+	return 0
 }
 
-func (x *UserInfoReply_ListExchange) GetUsdtAmount() string {
+func (x *AdminAreaLevelReply) GetPromotions() int64 {
 	if x != nil {
-		return x.UsdtAmount
+		return x.Promotions
 	}
-	return ""
+	return 0
 }
 
-func (x *UserInfoReply_ListExchange) GetCreated() string {
+func (x *AdminAreaLevelReply) GetDemotions() int64 {
 	if x != nil {
-		return x.Created
+		return x.Demotions
+	}
+	return 0
+}
+
+func (x *AdminAreaLevelReply) GetBonusTotal() string {
+	if x != nil {
+		return x.BonusTotal
 	}
 	return ""
 }
 
-type UserInfo1Reply_List struct {
+func (x *AdminAreaLevelReply) GetChanges() []*AdminAreaLevelReply_List {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type AdminJobRunListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Amount    string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	AmountMax string `protobuf:"bytes,4,opt,name=amountMax,proto3" json:"amountMax,omitempty"`
-	Num       int64  `protobuf:"varint,5,opt,name=Num,proto3" json:"Num,omitempty"`
+	Page int64  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *UserInfo1Reply_List) Reset() {
-	*x = UserInfo1Reply_List{}
+func (x *AdminJobRunListRequest) Reset() {
+	*x = AdminJobRunListRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[74]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4362,13 +4945,13 @@ func (x *UserInfo1Reply_List) Reset() {
 	}
 }
 
-func (x *UserInfo1Reply_List) String() string {
+func (x *AdminJobRunListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserInfo1Reply_List) ProtoMessage() {}
+func (*AdminJobRunListRequest) ProtoMessage() {}
 
-func (x *UserInfo1Reply_List) ProtoReflect() protoreflect.Message {
+func (x *AdminJobRunListRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[74]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4380,51 +4963,36 @@ func (x *UserInfo1Reply_List) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserInfo1Reply_List.ProtoReflect.Descriptor instead.
-func (*UserInfo1Reply_List) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{10, 0}
-}
-
-func (x *UserInfo1Reply_List) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return ""
+// Deprecated: Use AdminJobRunListRequest.ProtoReflect.Descriptor instead.
+func (*AdminJobRunListRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *UserInfo1Reply_List) GetAmount() string {
+func (x *AdminJobRunListRequest) GetPage() int64 {
 	if x != nil {
-		return x.Amount
+		return x.Page
 	}
-	return ""
+	return 0
 }
 
-func (x *UserInfo1Reply_List) GetAmountMax() string {
+func (x *AdminJobRunListRequest) GetName() string {
 	if x != nil {
-		return x.AmountMax
+		return x.Name
 	}
 	return ""
 }
 
-func (x *UserInfo1Reply_List) GetNum() int64 {
-	if x != nil {
-		return x.Num
-	}
-	return 0
-}
-
-type UserInfo1Reply_List22 struct {
+type AdminJobRunListReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Amount    string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	AmountMax string `protobuf:"bytes,4,opt,name=amountMax,proto3" json:"amountMax,omitempty"`
+	Runs  []*AdminJobRunListReply_List `protobuf:"bytes,1,rep,name=runs,proto3" json:"runs,omitempty"`
+	Count int64                        `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *UserInfo1Reply_List22) Reset() {
-	*x = UserInfo1Reply_List22{}
+func (x *AdminJobRunListReply) Reset() {
+	*x = AdminJobRunListReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[75]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4432,13 +5000,13 @@ func (x *UserInfo1Reply_List22) Reset() {
 	}
 }
 
-func (x *UserInfo1Reply_List22) String() string {
+func (x *AdminJobRunListReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserInfo1Reply_List22) ProtoMessage() {}
+func (*AdminJobRunListReply) ProtoMessage() {}
 
-func (x *UserInfo1Reply_List22) ProtoReflect() protoreflect.Message {
+func (x *AdminJobRunListReply) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[75]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4450,43 +5018,35 @@ func (x *UserInfo1Reply_List22) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserInfo1Reply_List22.ProtoReflect.Descriptor instead.
-func (*UserInfo1Reply_List22) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{10, 1}
-}
-
-func (x *UserInfo1Reply_List22) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return ""
+// Deprecated: Use AdminJobRunListReply.ProtoReflect.Descriptor instead.
+func (*AdminJobRunListReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *UserInfo1Reply_List22) GetAmount() string {
+func (x *AdminJobRunListReply) GetRuns() []*AdminJobRunListReply_List {
 	if x != nil {
-		return x.Amount
+		return x.Runs
 	}
-	return ""
+	return nil
 }
 
-func (x *UserInfo1Reply_List22) GetAmountMax() string {
+func (x *AdminJobRunListReply) GetCount() int64 {
 	if x != nil {
-		return x.AmountMax
+		return x.Count
 	}
-	return ""
+	return 0
 }
 
-type UserInfo1Reply_List2 struct {
+type AdminLocationTransitionListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Amount    string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	LocationId int64 `protobuf:"varint,1,opt,name=locationId,proto3" json:"locationId,omitempty"`
 }
 
-func (x *UserInfo1Reply_List2) Reset() {
-	*x = UserInfo1Reply_List2{}
+func (x *AdminLocationTransitionListRequest) Reset() {
+	*x = AdminLocationTransitionListRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[76]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4494,13 +5054,13 @@ func (x *UserInfo1Reply_List2) Reset() {
 	}
 }
 
-func (x *UserInfo1Reply_List2) String() string {
+func (x *AdminLocationTransitionListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserInfo1Reply_List2) ProtoMessage() {}
+func (*AdminLocationTransitionListRequest) ProtoMessage() {}
 
-func (x *UserInfo1Reply_List2) ProtoReflect() protoreflect.Message {
+func (x *AdminLocationTransitionListRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[76]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4512,36 +5072,28 @@ func (x *UserInfo1Reply_List2) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserInfo1Reply_List2.ProtoReflect.Descriptor instead.
-func (*UserInfo1Reply_List2) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{10, 2}
-}
-
-func (x *UserInfo1Reply_List2) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return ""
+// Deprecated: Use AdminLocationTransitionListRequest.ProtoReflect.Descriptor instead.
+func (*AdminLocationTransitionListRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *UserInfo1Reply_List2) GetAmount() string {
+func (x *AdminLocationTransitionListRequest) GetLocationId() int64 {
 	if x != nil {
-		return x.Amount
+		return x.LocationId
 	}
-	return ""
+	return 0
 }
 
-type UserInfo1Reply_List5 struct {
+type AdminLocationTransitionListReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Amount    string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Transitions []*AdminLocationTransitionListReply_List `protobuf:"bytes,1,rep,name=transitions,proto3" json:"transitions,omitempty"`
 }
 
-func (x *UserInfo1Reply_List5) Reset() {
-	*x = UserInfo1Reply_List5{}
+func (x *AdminLocationTransitionListReply) Reset() {
+	*x = AdminLocationTransitionListReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[77]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4549,13 +5101,13 @@ func (x *UserInfo1Reply_List5) Reset() {
 	}
 }
 
-func (x *UserInfo1Reply_List5) String() string {
+func (x *AdminLocationTransitionListReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserInfo1Reply_List5) ProtoMessage() {}
+func (*AdminLocationTransitionListReply) ProtoMessage() {}
 
-func (x *UserInfo1Reply_List5) ProtoReflect() protoreflect.Message {
+func (x *AdminLocationTransitionListReply) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[77]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4567,38 +5119,26 @@ func (x *UserInfo1Reply_List5) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserInfo1Reply_List5.ProtoReflect.Descriptor instead.
-func (*UserInfo1Reply_List5) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{10, 3}
-}
-
-func (x *UserInfo1Reply_List5) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return ""
+// Deprecated: Use AdminLocationTransitionListReply.ProtoReflect.Descriptor instead.
+func (*AdminLocationTransitionListReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *UserInfo1Reply_List5) GetAmount() string {
+func (x *AdminLocationTransitionListReply) GetTransitions() []*AdminLocationTransitionListReply_List {
 	if x != nil {
-		return x.Amount
+		return x.Transitions
 	}
-	return ""
+	return nil
 }
 
-type UserInfo1Reply_List7 struct {
+type AdminMatrixReplayRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Amount  string `protobuf:"bytes,2,opt,name=Amount,proto3" json:"Amount,omitempty"`
-	Usdt    string `protobuf:"bytes,3,opt,name=Usdt,proto3" json:"Usdt,omitempty"`
-	Vip     int64  `protobuf:"varint,4,opt,name=Vip,proto3" json:"Vip,omitempty"`
 }
 
-func (x *UserInfo1Reply_List7) Reset() {
-	*x = UserInfo1Reply_List7{}
+func (x *AdminMatrixReplayRequest) Reset() {
+	*x = AdminMatrixReplayRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[78]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4606,13 +5146,13 @@ func (x *UserInfo1Reply_List7) Reset() {
 	}
 }
 
-func (x *UserInfo1Reply_List7) String() string {
+func (x *AdminMatrixReplayRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserInfo1Reply_List7) ProtoMessage() {}
+func (*AdminMatrixReplayRequest) ProtoMessage() {}
 
-func (x *UserInfo1Reply_List7) ProtoReflect() protoreflect.Message {
+func (x *AdminMatrixReplayRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[78]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4624,52 +5164,25 @@ func (x *UserInfo1Reply_List7) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserInfo1Reply_List7.ProtoReflect.Descriptor instead.
-func (*UserInfo1Reply_List7) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{10, 4}
-}
-
-func (x *UserInfo1Reply_List7) GetAddress() string {
-	if x != nil {
-		return x.Address
-	}
-	return ""
-}
-
-func (x *UserInfo1Reply_List7) GetAmount() string {
-	if x != nil {
-		return x.Amount
-	}
-	return ""
-}
-
-func (x *UserInfo1Reply_List7) GetUsdt() string {
-	if x != nil {
-		return x.Usdt
-	}
-	return ""
-}
-
-func (x *UserInfo1Reply_List7) GetVip() int64 {
-	if x != nil {
-		return x.Vip
-	}
-	return 0
+// Deprecated: Use AdminMatrixReplayRequest.ProtoReflect.Descriptor instead.
+func (*AdminMatrixReplayRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{78}
 }
 
-type UserInfo1Reply_List11 struct {
+type AdminMatrixReplayReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Amount  string `protobuf:"bytes,2,opt,name=Amount,proto3" json:"Amount,omitempty"`
-	Usdt    string `protobuf:"bytes,3,opt,name=Usdt,proto3" json:"Usdt,omitempty"`
-	Vip     int64  `protobuf:"varint,4,opt,name=Vip,proto3" json:"Vip,omitempty"`
+	Events      int64    `protobuf:"varint,1,opt,name=events,proto3" json:"events,omitempty"`
+	Placed      int64    `protobuf:"varint,2,opt,name=placed,proto3" json:"placed,omitempty"`
+	Mismatches  []string `protobuf:"bytes,3,rep,name=mismatches,proto3" json:"mismatches,omitempty"`
+	Mode        string   `protobuf:"bytes,4,opt,name=mode,proto3" json:"mode,omitempty"`               // shift fill
+	Divergences []string `protobuf:"bytes,5,rep,name=divergences,proto3" json:"divergences,omitempty"` // fill 方式和原有 shift 方式排位不同的仓位
 }
 
-func (x *UserInfo1Reply_List11) Reset() {
-	*x = UserInfo1Reply_List11{}
+func (x *AdminMatrixReplayReply) Reset() {
+	*x = AdminMatrixReplayReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[79]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4677,13 +5190,13 @@ func (x *UserInfo1Reply_List11) Reset() {
 	}
 }
 
-func (x *UserInfo1Reply_List11) String() string {
+func (x *AdminMatrixReplayReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserInfo1Reply_List11) ProtoMessage() {}
+func (*AdminMatrixReplayReply) ProtoMessage() {}
 
-func (x *UserInfo1Reply_List11) ProtoReflect() protoreflect.Message {
+func (x *AdminMatrixReplayReply) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[79]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4695,50 +5208,54 @@ func (x *UserInfo1Reply_List11) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserInfo1Reply_List11.ProtoReflect.Descriptor instead.
-func (*UserInfo1Reply_List11) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{10, 5}
+// Deprecated: Use AdminMatrixReplayReply.ProtoReflect.Descriptor instead.
+func (*AdminMatrixReplayReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *UserInfo1Reply_List11) GetAddress() string {
+func (x *AdminMatrixReplayReply) GetEvents() int64 {
 	if x != nil {
-		return x.Address
+		return x.Events
 	}
-	return ""
+	return 0
 }
 
-func (x *UserInfo1Reply_List11) GetAmount() string {
+func (x *AdminMatrixReplayReply) GetPlaced() int64 {
 	if x != nil {
-		return x.Amount
+		return x.Placed
 	}
-	return ""
+	return 0
 }
 
-func (x *UserInfo1Reply_List11) GetUsdt() string {
+func (x *AdminMatrixReplayReply) GetMismatches() []string {
 	if x != nil {
-		return x.Usdt
+		return x.Mismatches
+	}
+	return nil
+}
+
+func (x *AdminMatrixReplayReply) GetMode() string {
+	if x != nil {
+		return x.Mode
 	}
 	return ""
 }
 
-func (x *UserInfo1Reply_List11) GetVip() int64 {
+func (x *AdminMatrixReplayReply) GetDivergences() []string {
 	if x != nil {
-		return x.Vip
+		return x.Divergences
 	}
-	return 0
+	return nil
 }
 
-type UserInfo1Reply_List9 struct {
+type AdminWebhookListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Amount    string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (x *UserInfo1Reply_List9) Reset() {
-	*x = UserInfo1Reply_List9{}
+func (x *AdminWebhookListRequest) Reset() {
+	*x = AdminWebhookListRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[80]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4746,13 +5263,13 @@ func (x *UserInfo1Reply_List9) Reset() {
 	}
 }
 
-func (x *UserInfo1Reply_List9) String() string {
+func (x *AdminWebhookListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserInfo1Reply_List9) ProtoMessage() {}
+func (*AdminWebhookListRequest) ProtoMessage() {}
 
-func (x *UserInfo1Reply_List9) ProtoReflect() protoreflect.Message {
+func (x *AdminWebhookListRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[80]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4764,36 +5281,22 @@ func (x *UserInfo1Reply_List9) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserInfo1Reply_List9.ProtoReflect.Descriptor instead.
-func (*UserInfo1Reply_List9) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{10, 6}
-}
-
-func (x *UserInfo1Reply_List9) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return ""
-}
-
-func (x *UserInfo1Reply_List9) GetAmount() string {
-	if x != nil {
-		return x.Amount
-	}
-	return ""
+// Deprecated: Use AdminWebhookListRequest.ProtoReflect.Descriptor instead.
+func (*AdminWebhookListRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{80}
 }
 
-type UserInfo1Reply_List13 struct {
+type AdminWebhookListReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Amount    string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	List   []*AdminWebhookListReply_List `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	Events []string                      `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"` // 可订阅的事件类型
 }
 
-func (x *UserInfo1Reply_List13) Reset() {
-	*x = UserInfo1Reply_List13{}
+func (x *AdminWebhookListReply) Reset() {
+	*x = AdminWebhookListReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[81]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4801,13 +5304,13 @@ func (x *UserInfo1Reply_List13) Reset() {
 	}
 }
 
-func (x *UserInfo1Reply_List13) String() string {
+func (x *AdminWebhookListReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserInfo1Reply_List13) ProtoMessage() {}
+func (*AdminWebhookListReply) ProtoMessage() {}
 
-func (x *UserInfo1Reply_List13) ProtoReflect() protoreflect.Message {
+func (x *AdminWebhookListReply) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[81]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4819,38 +5322,35 @@ func (x *UserInfo1Reply_List13) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserInfo1Reply_List13.ProtoReflect.Descriptor instead.
-func (*UserInfo1Reply_List13) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{10, 7}
+// Deprecated: Use AdminWebhookListReply.ProtoReflect.Descriptor instead.
+func (*AdminWebhookListReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{81}
 }
 
-func (x *UserInfo1Reply_List13) GetCreatedAt() string {
+func (x *AdminWebhookListReply) GetList() []*AdminWebhookListReply_List {
 	if x != nil {
-		return x.CreatedAt
+		return x.List
 	}
-	return ""
+	return nil
 }
 
-func (x *UserInfo1Reply_List13) GetAmount() string {
+func (x *AdminWebhookListReply) GetEvents() []string {
 	if x != nil {
-		return x.Amount
+		return x.Events
 	}
-	return ""
+	return nil
 }
 
-type RewardListReply_List struct {
+type AdminWebhookCreateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CreatedAt      string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Amount         string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	LocationStatus string `protobuf:"bytes,3,opt,name=locationStatus,proto3" json:"locationStatus,omitempty"`
-	Type           string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	SendBody *AdminWebhookCreateRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
 }
 
-func (x *RewardListReply_List) Reset() {
-	*x = RewardListReply_List{}
+func (x *AdminWebhookCreateRequest) Reset() {
+	*x = AdminWebhookCreateRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[82]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4858,13 +5358,13 @@ func (x *RewardListReply_List) Reset() {
 	}
 }
 
-func (x *RewardListReply_List) String() string {
+func (x *AdminWebhookCreateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RewardListReply_List) ProtoMessage() {}
+func (*AdminWebhookCreateRequest) ProtoMessage() {}
 
-func (x *RewardListReply_List) ProtoReflect() protoreflect.Message {
+func (x *AdminWebhookCreateRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[82]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4876,49 +5376,29 @@ func (x *RewardListReply_List) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RewardListReply_List.ProtoReflect.Descriptor instead.
-func (*RewardListReply_List) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{12, 0}
-}
-
-func (x *RewardListReply_List) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return ""
-}
-
-func (x *RewardListReply_List) GetAmount() string {
-	if x != nil {
-		return x.Amount
-	}
-	return ""
-}
-
-func (x *RewardListReply_List) GetLocationStatus() string {
-	if x != nil {
-		return x.LocationStatus
-	}
-	return ""
+// Deprecated: Use AdminWebhookCreateRequest.ProtoReflect.Descriptor instead.
+func (*AdminWebhookCreateRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{82}
 }
 
-func (x *RewardListReply_List) GetType() string {
+func (x *AdminWebhookCreateRequest) GetSendBody() *AdminWebhookCreateRequest_SendBody {
 	if x != nil {
-		return x.Type
+		return x.SendBody
 	}
-	return ""
+	return nil
 }
 
-type GetTradeRequest_SendBody struct {
+type AdminWebhookCreateReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Amount string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Id     int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Secret string `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
 }
 
-func (x *GetTradeRequest_SendBody) Reset() {
-	*x = GetTradeRequest_SendBody{}
+func (x *AdminWebhookCreateReply) Reset() {
+	*x = AdminWebhookCreateReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[83]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4926,13 +5406,13 @@ func (x *GetTradeRequest_SendBody) Reset() {
 	}
 }
 
-func (x *GetTradeRequest_SendBody) String() string {
+func (x *AdminWebhookCreateReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTradeRequest_SendBody) ProtoMessage() {}
+func (*AdminWebhookCreateReply) ProtoMessage() {}
 
-func (x *GetTradeRequest_SendBody) ProtoReflect() protoreflect.Message {
+func (x *AdminWebhookCreateReply) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[83]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4944,31 +5424,35 @@ func (x *GetTradeRequest_SendBody) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTradeRequest_SendBody.ProtoReflect.Descriptor instead.
-func (*GetTradeRequest_SendBody) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{13, 0}
+// Deprecated: Use AdminWebhookCreateReply.ProtoReflect.Descriptor instead.
+func (*AdminWebhookCreateReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{83}
 }
 
-func (x *GetTradeRequest_SendBody) GetAmount() string {
+func (x *AdminWebhookCreateReply) GetId() int64 {
 	if x != nil {
-		return x.Amount
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminWebhookCreateReply) GetSecret() string {
+	if x != nil {
+		return x.Secret
 	}
 	return ""
 }
 
-type RecommendRewardListReply_List struct {
+type AdminWebhookUpdateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Amount    string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	Type      string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
-	Reason    string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	SendBody *AdminWebhookUpdateRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
 }
 
-func (x *RecommendRewardListReply_List) Reset() {
-	*x = RecommendRewardListReply_List{}
+func (x *AdminWebhookUpdateRequest) Reset() {
+	*x = AdminWebhookUpdateRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[84]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4976,13 +5460,13 @@ func (x *RecommendRewardListReply_List) Reset() {
 	}
 }
 
-func (x *RecommendRewardListReply_List) String() string {
+func (x *AdminWebhookUpdateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecommendRewardListReply_List) ProtoMessage() {}
+func (*AdminWebhookUpdateRequest) ProtoMessage() {}
 
-func (x *RecommendRewardListReply_List) ProtoReflect() protoreflect.Message {
+func (x *AdminWebhookUpdateRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[84]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4994,50 +5478,28 @@ func (x *RecommendRewardListReply_List) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecommendRewardListReply_List.ProtoReflect.Descriptor instead.
-func (*RecommendRewardListReply_List) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{16, 0}
-}
-
-func (x *RecommendRewardListReply_List) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return ""
-}
-
-func (x *RecommendRewardListReply_List) GetAmount() string {
-	if x != nil {
-		return x.Amount
-	}
-	return ""
-}
-
-func (x *RecommendRewardListReply_List) GetType() string {
-	if x != nil {
-		return x.Type
-	}
-	return ""
+// Deprecated: Use AdminWebhookUpdateRequest.ProtoReflect.Descriptor instead.
+func (*AdminWebhookUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{84}
 }
 
-func (x *RecommendRewardListReply_List) GetReason() string {
+func (x *AdminWebhookUpdateRequest) GetSendBody() *AdminWebhookUpdateRequest_SendBody {
 	if x != nil {
-		return x.Reason
+		return x.SendBody
 	}
-	return ""
+	return nil
 }
 
-type FeeRewardListReply_List struct {
+type AdminWebhookUpdateReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Amount    string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *FeeRewardListReply_List) Reset() {
-	*x = FeeRewardListReply_List{}
+func (x *AdminWebhookUpdateReply) Reset() {
+	*x = AdminWebhookUpdateReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[85]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5045,13 +5507,13 @@ func (x *FeeRewardListReply_List) Reset() {
 	}
 }
 
-func (x *FeeRewardListReply_List) String() string {
+func (x *AdminWebhookUpdateReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FeeRewardListReply_List) ProtoMessage() {}
+func (*AdminWebhookUpdateReply) ProtoMessage() {}
 
-func (x *FeeRewardListReply_List) ProtoReflect() protoreflect.Message {
+func (x *AdminWebhookUpdateReply) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[85]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5063,38 +5525,30 @@ func (x *FeeRewardListReply_List) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FeeRewardListReply_List.ProtoReflect.Descriptor instead.
-func (*FeeRewardListReply_List) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{18, 0}
-}
-
-func (x *FeeRewardListReply_List) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return ""
+// Deprecated: Use AdminWebhookUpdateReply.ProtoReflect.Descriptor instead.
+func (*AdminWebhookUpdateReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{85}
 }
 
-func (x *FeeRewardListReply_List) GetAmount() string {
+func (x *AdminWebhookUpdateReply) GetStatus() string {
 	if x != nil {
-		return x.Amount
+		return x.Status
 	}
 	return ""
 }
 
-type WithdrawListReply_List struct {
+type AdminWebhookDeliveryListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Amount    string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	Type      string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
-	Status    string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Page         int64  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Status       string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // pending success dead
+	SubscriberId int64  `protobuf:"varint,3,opt,name=subscriberId,proto3" json:"subscriberId,omitempty"`
 }
 
-func (x *WithdrawListReply_List) Reset() {
-	*x = WithdrawListReply_List{}
+func (x *AdminWebhookDeliveryListRequest) Reset() {
+	*x = AdminWebhookDeliveryListRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[86]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5102,13 +5556,13 @@ func (x *WithdrawListReply_List) Reset() {
 	}
 }
 
-func (x *WithdrawListReply_List) String() string {
+func (x *AdminWebhookDeliveryListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WithdrawListReply_List) ProtoMessage() {}
+func (*AdminWebhookDeliveryListRequest) ProtoMessage() {}
 
-func (x *WithdrawListReply_List) ProtoReflect() protoreflect.Message {
+func (x *AdminWebhookDeliveryListRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[86]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5120,52 +5574,43 @@ func (x *WithdrawListReply_List) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WithdrawListReply_List.ProtoReflect.Descriptor instead.
-func (*WithdrawListReply_List) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{20, 0}
-}
-
-func (x *WithdrawListReply_List) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return ""
+// Deprecated: Use AdminWebhookDeliveryListRequest.ProtoReflect.Descriptor instead.
+func (*AdminWebhookDeliveryListRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{86}
 }
 
-func (x *WithdrawListReply_List) GetAmount() string {
+func (x *AdminWebhookDeliveryListRequest) GetPage() int64 {
 	if x != nil {
-		return x.Amount
+		return x.Page
 	}
-	return ""
+	return 0
 }
 
-func (x *WithdrawListReply_List) GetType() string {
+func (x *AdminWebhookDeliveryListRequest) GetStatus() string {
 	if x != nil {
-		return x.Type
+		return x.Status
 	}
 	return ""
 }
 
-func (x *WithdrawListReply_List) GetStatus() string {
+func (x *AdminWebhookDeliveryListRequest) GetSubscriberId() int64 {
 	if x != nil {
-		return x.Status
+		return x.SubscriberId
 	}
-	return ""
+	return 0
 }
 
-type TradeListReply_List struct {
+type AdminWebhookDeliveryListReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	AmountCsd string `protobuf:"bytes,2,opt,name=amountCsd,proto3" json:"amountCsd,omitempty"`
-	AmountHbs string `protobuf:"bytes,5,opt,name=amountHbs,proto3" json:"amountHbs,omitempty"`
-	Status    string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	List  []*AdminWebhookDeliveryListReply_List `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	Count int64                                 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *TradeListReply_List) Reset() {
-	*x = TradeListReply_List{}
+func (x *AdminWebhookDeliveryListReply) Reset() {
+	*x = AdminWebhookDeliveryListReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[87]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5173,13 +5618,13 @@ func (x *TradeListReply_List) Reset() {
 	}
 }
 
-func (x *TradeListReply_List) String() string {
+func (x *AdminWebhookDeliveryListReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TradeListReply_List) ProtoMessage() {}
+func (*AdminWebhookDeliveryListReply) ProtoMessage() {}
 
-func (x *TradeListReply_List) ProtoReflect() protoreflect.Message {
+func (x *AdminWebhookDeliveryListReply) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[87]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5191,50 +5636,35 @@ func (x *TradeListReply_List) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TradeListReply_List.ProtoReflect.Descriptor instead.
-func (*TradeListReply_List) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{22, 0}
-}
-
-func (x *TradeListReply_List) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return ""
-}
-
-func (x *TradeListReply_List) GetAmountCsd() string {
-	if x != nil {
-		return x.AmountCsd
-	}
-	return ""
+// Deprecated: Use AdminWebhookDeliveryListReply.ProtoReflect.Descriptor instead.
+func (*AdminWebhookDeliveryListReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{87}
 }
 
-func (x *TradeListReply_List) GetAmountHbs() string {
+func (x *AdminWebhookDeliveryListReply) GetList() []*AdminWebhookDeliveryListReply_List {
 	if x != nil {
-		return x.AmountHbs
+		return x.List
 	}
-	return ""
+	return nil
 }
 
-func (x *TradeListReply_List) GetStatus() string {
+func (x *AdminWebhookDeliveryListReply) GetCount() int64 {
 	if x != nil {
-		return x.Status
+		return x.Count
 	}
-	return ""
+	return 0
 }
 
-type TranListReply_List struct {
+type AdminWebhookReplayRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Amount    string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	SendBody *AdminWebhookReplayRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
 }
 
-func (x *TranListReply_List) Reset() {
-	*x = TranListReply_List{}
+func (x *AdminWebhookReplayRequest) Reset() {
+	*x = AdminWebhookReplayRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[88]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5242,13 +5672,13 @@ func (x *TranListReply_List) Reset() {
 	}
 }
 
-func (x *TranListReply_List) String() string {
+func (x *AdminWebhookReplayRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TranListReply_List) ProtoMessage() {}
+func (*AdminWebhookReplayRequest) ProtoMessage() {}
 
-func (x *TranListReply_List) ProtoReflect() protoreflect.Message {
+func (x *AdminWebhookReplayRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[88]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5260,36 +5690,28 @@ func (x *TranListReply_List) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TranListReply_List.ProtoReflect.Descriptor instead.
-func (*TranListReply_List) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{24, 0}
-}
-
-func (x *TranListReply_List) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return ""
+// Deprecated: Use AdminWebhookReplayRequest.ProtoReflect.Descriptor instead.
+func (*AdminWebhookReplayRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{88}
 }
 
-func (x *TranListReply_List) GetAmount() string {
+func (x *AdminWebhookReplayRequest) GetSendBody() *AdminWebhookReplayRequest_SendBody {
 	if x != nil {
-		return x.Amount
+		return x.SendBody
 	}
-	return ""
+	return nil
 }
 
-type RecommendListReply_List struct {
+type AdminWebhookReplayReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Amount string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	Status string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Deliveries int64 `protobuf:"varint,1,opt,name=deliveries,proto3" json:"deliveries,omitempty"`
 }
 
-func (x *RecommendListReply_List) Reset() {
-	*x = RecommendListReply_List{}
+func (x *AdminWebhookReplayReply) Reset() {
+	*x = AdminWebhookReplayReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[89]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5297,13 +5719,13 @@ func (x *RecommendListReply_List) Reset() {
 	}
 }
 
-func (x *RecommendListReply_List) String() string {
+func (x *AdminWebhookReplayReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecommendListReply_List) ProtoMessage() {}
+func (*AdminWebhookReplayReply) ProtoMessage() {}
 
-func (x *RecommendListReply_List) ProtoReflect() protoreflect.Message {
+func (x *AdminWebhookReplayReply) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[89]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5315,36 +5737,26 @@ func (x *RecommendListReply_List) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecommendListReply_List.ProtoReflect.Descriptor instead.
-func (*RecommendListReply_List) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{26, 0}
-}
-
-func (x *RecommendListReply_List) GetAmount() string {
-	if x != nil {
-		return x.Amount
-	}
-	return ""
+// Deprecated: Use AdminWebhookReplayReply.ProtoReflect.Descriptor instead.
+func (*AdminWebhookReplayReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{89}
 }
 
-func (x *RecommendListReply_List) GetStatus() string {
+func (x *AdminWebhookReplayReply) GetDeliveries() int64 {
 	if x != nil {
-		return x.Status
+		return x.Deliveries
 	}
-	return ""
+	return 0
 }
 
-type ExchangeRequest_SendBody struct {
+type AdminCacheStatsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Sign   string `protobuf:"bytes,2,opt,name=sign,proto3" json:"sign,omitempty"`
-	Amount string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (x *ExchangeRequest_SendBody) Reset() {
-	*x = ExchangeRequest_SendBody{}
+func (x *AdminCacheStatsRequest) Reset() {
+	*x = AdminCacheStatsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[90]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5352,13 +5764,13 @@ func (x *ExchangeRequest_SendBody) Reset() {
 	}
 }
 
-func (x *ExchangeRequest_SendBody) String() string {
+func (x *AdminCacheStatsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExchangeRequest_SendBody) ProtoMessage() {}
+func (*AdminCacheStatsRequest) ProtoMessage() {}
 
-func (x *ExchangeRequest_SendBody) ProtoReflect() protoreflect.Message {
+func (x *AdminCacheStatsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[90]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5370,38 +5782,22 @@ func (x *ExchangeRequest_SendBody) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExchangeRequest_SendBody.ProtoReflect.Descriptor instead.
-func (*ExchangeRequest_SendBody) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{27, 0}
-}
-
-func (x *ExchangeRequest_SendBody) GetSign() string {
-	if x != nil {
-		return x.Sign
-	}
-	return ""
-}
-
-func (x *ExchangeRequest_SendBody) GetAmount() string {
-	if x != nil {
-		return x.Amount
-	}
-	return ""
+// Deprecated: Use AdminCacheStatsRequest.ProtoReflect.Descriptor instead.
+func (*AdminCacheStatsRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{90}
 }
 
-type WithdrawRequest_SendBody struct {
+type AdminCacheStatsReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type     string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
-	Amount   string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
-	Password string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
-	Sign     string `protobuf:"bytes,4,opt,name=sign,proto3" json:"sign,omitempty"`
+	Enable bool                         `protobuf:"varint,1,opt,name=enable,proto3" json:"enable,omitempty"`
+	List   []*AdminCacheStatsReply_List `protobuf:"bytes,2,rep,name=list,proto3" json:"list,omitempty"`
 }
 
-func (x *WithdrawRequest_SendBody) Reset() {
-	*x = WithdrawRequest_SendBody{}
+func (x *AdminCacheStatsReply) Reset() {
+	*x = AdminCacheStatsReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[91]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5409,13 +5805,13 @@ func (x *WithdrawRequest_SendBody) Reset() {
 	}
 }
 
-func (x *WithdrawRequest_SendBody) String() string {
+func (x *AdminCacheStatsReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WithdrawRequest_SendBody) ProtoMessage() {}
+func (*AdminCacheStatsReply) ProtoMessage() {}
 
-func (x *WithdrawRequest_SendBody) ProtoReflect() protoreflect.Message {
+func (x *AdminCacheStatsReply) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[91]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5427,49 +5823,36 @@ func (x *WithdrawRequest_SendBody) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WithdrawRequest_SendBody.ProtoReflect.Descriptor instead.
-func (*WithdrawRequest_SendBody) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{29, 0}
-}
-
-func (x *WithdrawRequest_SendBody) GetType() string {
-	if x != nil {
-		return x.Type
-	}
-	return ""
-}
-
-func (x *WithdrawRequest_SendBody) GetAmount() string {
-	if x != nil {
-		return x.Amount
-	}
-	return ""
+// Deprecated: Use AdminCacheStatsReply.ProtoReflect.Descriptor instead.
+func (*AdminCacheStatsReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{91}
 }
 
-func (x *WithdrawRequest_SendBody) GetPassword() string {
+func (x *AdminCacheStatsReply) GetEnable() bool {
 	if x != nil {
-		return x.Password
+		return x.Enable
 	}
-	return ""
+	return false
 }
 
-func (x *WithdrawRequest_SendBody) GetSign() string {
+func (x *AdminCacheStatsReply) GetList() []*AdminCacheStatsReply_List {
 	if x != nil {
-		return x.Sign
+		return x.List
 	}
-	return ""
+	return nil
 }
 
-type PasswordChangeRequest_SendBody struct {
+type AdminRewardListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Page    int64  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (x *PasswordChangeRequest_SendBody) Reset() {
-	*x = PasswordChangeRequest_SendBody{}
+func (x *AdminRewardListRequest) Reset() {
+	*x = AdminRewardListRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[92]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5477,13 +5860,13 @@ func (x *PasswordChangeRequest_SendBody) Reset() {
 	}
 }
 
-func (x *PasswordChangeRequest_SendBody) String() string {
+func (x *AdminRewardListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PasswordChangeRequest_SendBody) ProtoMessage() {}
+func (*AdminRewardListRequest) ProtoMessage() {}
 
-func (x *PasswordChangeRequest_SendBody) ProtoReflect() protoreflect.Message {
+func (x *AdminRewardListRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[92]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5495,31 +5878,36 @@ func (x *PasswordChangeRequest_SendBody) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PasswordChangeRequest_SendBody.ProtoReflect.Descriptor instead.
-func (*PasswordChangeRequest_SendBody) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{31, 0}
+// Deprecated: Use AdminRewardListRequest.ProtoReflect.Descriptor instead.
+func (*AdminRewardListRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{92}
 }
 
-func (x *PasswordChangeRequest_SendBody) GetPassword() string {
+func (x *AdminRewardListRequest) GetPage() int64 {
 	if x != nil {
-		return x.Password
+		return x.Page
+	}
+	return 0
+}
+
+func (x *AdminRewardListRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
 	}
 	return ""
 }
 
-type TranRequest_SendBody struct {
+type AdminRewardListReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type     string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
-	Amount   string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
-	Address  string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
-	Password string `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+	Rewards []*AdminRewardListReply_List `protobuf:"bytes,1,rep,name=rewards,proto3" json:"rewards,omitempty"`
+	Count   int64                        `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *TranRequest_SendBody) Reset() {
-	*x = TranRequest_SendBody{}
+func (x *AdminRewardListReply) Reset() {
+	*x = AdminRewardListReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[93]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5527,13 +5915,13 @@ func (x *TranRequest_SendBody) Reset() {
 	}
 }
 
-func (x *TranRequest_SendBody) String() string {
+func (x *AdminRewardListReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TranRequest_SendBody) ProtoMessage() {}
+func (*AdminRewardListReply) ProtoMessage() {}
 
-func (x *TranRequest_SendBody) ProtoReflect() protoreflect.Message {
+func (x *AdminRewardListReply) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[93]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5545,49 +5933,36 @@ func (x *TranRequest_SendBody) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TranRequest_SendBody.ProtoReflect.Descriptor instead.
-func (*TranRequest_SendBody) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{33, 0}
-}
-
-func (x *TranRequest_SendBody) GetType() string {
-	if x != nil {
-		return x.Type
-	}
-	return ""
-}
-
-func (x *TranRequest_SendBody) GetAmount() string {
-	if x != nil {
-		return x.Amount
-	}
-	return ""
+// Deprecated: Use AdminRewardListReply.ProtoReflect.Descriptor instead.
+func (*AdminRewardListReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{93}
 }
 
-func (x *TranRequest_SendBody) GetAddress() string {
+func (x *AdminRewardListReply) GetRewards() []*AdminRewardListReply_List {
 	if x != nil {
-		return x.Address
+		return x.Rewards
 	}
-	return ""
+	return nil
 }
 
-func (x *TranRequest_SendBody) GetPassword() string {
+func (x *AdminRewardListReply) GetCount() int64 {
 	if x != nil {
-		return x.Password
+		return x.Count
 	}
-	return ""
+	return 0
 }
 
-type SetBalanceRewardRequest_SendBody struct {
+type AdminUserListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Amount string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Page    int64  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (x *SetBalanceRewardRequest_SendBody) Reset() {
-	*x = SetBalanceRewardRequest_SendBody{}
+func (x *AdminUserListRequest) Reset() {
+	*x = AdminUserListRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[94]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5595,13 +5970,13 @@ func (x *SetBalanceRewardRequest_SendBody) Reset() {
 	}
 }
 
-func (x *SetBalanceRewardRequest_SendBody) String() string {
+func (x *AdminUserListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetBalanceRewardRequest_SendBody) ProtoMessage() {}
+func (*AdminUserListRequest) ProtoMessage() {}
 
-func (x *SetBalanceRewardRequest_SendBody) ProtoReflect() protoreflect.Message {
+func (x *AdminUserListRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[94]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5613,28 +5988,36 @@ func (x *SetBalanceRewardRequest_SendBody) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetBalanceRewardRequest_SendBody.ProtoReflect.Descriptor instead.
-func (*SetBalanceRewardRequest_SendBody) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{35, 0}
+// Deprecated: Use AdminUserListRequest.ProtoReflect.Descriptor instead.
+func (*AdminUserListRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{94}
 }
 
-func (x *SetBalanceRewardRequest_SendBody) GetAmount() string {
+func (x *AdminUserListRequest) GetPage() int64 {
 	if x != nil {
-		return x.Amount
+		return x.Page
+	}
+	return 0
+}
+
+func (x *AdminUserListRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
 	}
 	return ""
 }
 
-type DeleteBalanceRewardRequest_SendBody struct {
+type AdminUserListReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Amount string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Users []*AdminUserListReply_UserList `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	Count int64                          `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *DeleteBalanceRewardRequest_SendBody) Reset() {
-	*x = DeleteBalanceRewardRequest_SendBody{}
+func (x *AdminUserListReply) Reset() {
+	*x = AdminUserListReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[95]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5642,13 +6025,13 @@ func (x *DeleteBalanceRewardRequest_SendBody) Reset() {
 	}
 }
 
-func (x *DeleteBalanceRewardRequest_SendBody) String() string {
+func (x *AdminUserListReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteBalanceRewardRequest_SendBody) ProtoMessage() {}
+func (*AdminUserListReply) ProtoMessage() {}
 
-func (x *DeleteBalanceRewardRequest_SendBody) ProtoReflect() protoreflect.Message {
+func (x *AdminUserListReply) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[95]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5660,32 +6043,36 @@ func (x *DeleteBalanceRewardRequest_SendBody) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteBalanceRewardRequest_SendBody.ProtoReflect.Descriptor instead.
-func (*DeleteBalanceRewardRequest_SendBody) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{37, 0}
+// Deprecated: Use AdminUserListReply.ProtoReflect.Descriptor instead.
+func (*AdminUserListReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{95}
 }
 
-func (x *DeleteBalanceRewardRequest_SendBody) GetAmount() string {
+func (x *AdminUserListReply) GetUsers() []*AdminUserListReply_UserList {
 	if x != nil {
-		return x.Amount
+		return x.Users
 	}
-	return ""
+	return nil
 }
 
-type AdminRewardListReply_List struct {
+func (x *AdminUserListReply) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type AdminLocationListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Amount    string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	Type      string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
-	Address   string `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
-	Reason    string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	Page    int64  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (x *AdminRewardListReply_List) Reset() {
-	*x = AdminRewardListReply_List{}
+func (x *AdminLocationListRequest) Reset() {
+	*x = AdminLocationListRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[96]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5693,13 +6080,13 @@ func (x *AdminRewardListReply_List) Reset() {
 	}
 }
 
-func (x *AdminRewardListReply_List) String() string {
+func (x *AdminLocationListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminRewardListReply_List) ProtoMessage() {}
+func (*AdminLocationListRequest) ProtoMessage() {}
 
-func (x *AdminRewardListReply_List) ProtoReflect() protoreflect.Message {
+func (x *AdminLocationListRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[96]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5711,63 +6098,36 @@ func (x *AdminRewardListReply_List) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminRewardListReply_List.ProtoReflect.Descriptor instead.
-func (*AdminRewardListReply_List) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{40, 0}
-}
-
-func (x *AdminRewardListReply_List) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return ""
-}
-
-func (x *AdminRewardListReply_List) GetAmount() string {
-	if x != nil {
-		return x.Amount
-	}
-	return ""
+// Deprecated: Use AdminLocationListRequest.ProtoReflect.Descriptor instead.
+func (*AdminLocationListRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{96}
 }
 
-func (x *AdminRewardListReply_List) GetType() string {
+func (x *AdminLocationListRequest) GetPage() int64 {
 	if x != nil {
-		return x.Type
+		return x.Page
 	}
-	return ""
+	return 0
 }
 
-func (x *AdminRewardListReply_List) GetAddress() string {
+func (x *AdminLocationListRequest) GetAddress() string {
 	if x != nil {
 		return x.Address
 	}
 	return ""
 }
 
-func (x *AdminRewardListReply_List) GetReason() string {
-	if x != nil {
-		return x.Reason
-	}
-	return ""
-}
-
-type AdminUserListReply_UserList struct {
+type AdminLocationListReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId           int64  `protobuf:"varint,8,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	CreatedAt        string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Address          string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	BalanceUsdt      string `protobuf:"bytes,3,opt,name=balanceUsdt,proto3" json:"balanceUsdt,omitempty"`
-	BalanceDhb       string `protobuf:"bytes,4,opt,name=balanceDhb,proto3" json:"balanceDhb,omitempty"`
-	Vip              int64  `protobuf:"varint,5,opt,name=vip,proto3" json:"vip,omitempty"`
-	MonthRecommend   int64  `protobuf:"varint,7,opt,name=monthRecommend,proto3" json:"monthRecommend,omitempty"`
-	HistoryRecommend int64  `protobuf:"varint,6,opt,name=historyRecommend,proto3" json:"historyRecommend,omitempty"`
+	Locations []*AdminLocationListReply_LocationList `protobuf:"bytes,1,rep,name=locations,proto3" json:"locations,omitempty"`
+	Count     int64                                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *AdminUserListReply_UserList) Reset() {
-	*x = AdminUserListReply_UserList{}
+func (x *AdminLocationListReply) Reset() {
+	*x = AdminLocationListReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[97]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5775,13 +6135,13 @@ func (x *AdminUserListReply_UserList) Reset() {
 	}
 }
 
-func (x *AdminUserListReply_UserList) String() string {
+func (x *AdminLocationListReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminUserListReply_UserList) ProtoMessage() {}
+func (*AdminLocationListReply) ProtoMessage() {}
 
-func (x *AdminUserListReply_UserList) ProtoReflect() protoreflect.Message {
+func (x *AdminLocationListReply) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[97]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5793,84 +6153,36 @@ func (x *AdminUserListReply_UserList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminUserListReply_UserList.ProtoReflect.Descriptor instead.
-func (*AdminUserListReply_UserList) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{42, 0}
+// Deprecated: Use AdminLocationListReply.ProtoReflect.Descriptor instead.
+func (*AdminLocationListReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{97}
 }
 
-func (x *AdminUserListReply_UserList) GetUserId() int64 {
+func (x *AdminLocationListReply) GetLocations() []*AdminLocationListReply_LocationList {
 	if x != nil {
-		return x.UserId
+		return x.Locations
 	}
-	return 0
+	return nil
 }
 
-func (x *AdminUserListReply_UserList) GetCreatedAt() string {
+func (x *AdminLocationListReply) GetCount() int64 {
 	if x != nil {
-		return x.CreatedAt
-	}
-	return ""
-}
-
-func (x *AdminUserListReply_UserList) GetAddress() string {
-	if x != nil {
-		return x.Address
-	}
-	return ""
-}
-
-func (x *AdminUserListReply_UserList) GetBalanceUsdt() string {
-	if x != nil {
-		return x.BalanceUsdt
-	}
-	return ""
-}
-
-func (x *AdminUserListReply_UserList) GetBalanceDhb() string {
-	if x != nil {
-		return x.BalanceDhb
-	}
-	return ""
-}
-
-func (x *AdminUserListReply_UserList) GetVip() int64 {
-	if x != nil {
-		return x.Vip
-	}
-	return 0
-}
-
-func (x *AdminUserListReply_UserList) GetMonthRecommend() int64 {
-	if x != nil {
-		return x.MonthRecommend
-	}
-	return 0
-}
-
-func (x *AdminUserListReply_UserList) GetHistoryRecommend() int64 {
-	if x != nil {
-		return x.HistoryRecommend
+		return x.Count
 	}
 	return 0
 }
 
-type AdminLocationListReply_LocationList struct {
+type AdminWithdrawListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CreatedAt    string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Address      string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	Row          int64  `protobuf:"varint,3,opt,name=row,proto3" json:"row,omitempty"`
-	Col          int64  `protobuf:"varint,4,opt,name=col,proto3" json:"col,omitempty"`
-	Status       string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
-	CurrentLevel int64  `protobuf:"varint,6,opt,name=currentLevel,proto3" json:"currentLevel,omitempty"`
-	Current      string `protobuf:"bytes,7,opt,name=current,proto3" json:"current,omitempty"`
-	CurrentMax   string `protobuf:"bytes,8,opt,name=currentMax,proto3" json:"currentMax,omitempty"`
+	Page    int64  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (x *AdminLocationListReply_LocationList) Reset() {
-	*x = AdminLocationListReply_LocationList{}
+func (x *AdminWithdrawListRequest) Reset() {
+	*x = AdminWithdrawListRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[98]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5878,13 +6190,13 @@ func (x *AdminLocationListReply_LocationList) Reset() {
 	}
 }
 
-func (x *AdminLocationListReply_LocationList) String() string {
+func (x *AdminWithdrawListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminLocationListReply_LocationList) ProtoMessage() {}
+func (*AdminWithdrawListRequest) ProtoMessage() {}
 
-func (x *AdminLocationListReply_LocationList) ProtoReflect() protoreflect.Message {
+func (x *AdminWithdrawListRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[98]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5896,83 +6208,36 @@ func (x *AdminLocationListReply_LocationList) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminLocationListReply_LocationList.ProtoReflect.Descriptor instead.
-func (*AdminLocationListReply_LocationList) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{44, 0}
-}
-
-func (x *AdminLocationListReply_LocationList) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return ""
-}
-
-func (x *AdminLocationListReply_LocationList) GetAddress() string {
-	if x != nil {
-		return x.Address
-	}
-	return ""
-}
-
-func (x *AdminLocationListReply_LocationList) GetRow() int64 {
-	if x != nil {
-		return x.Row
-	}
-	return 0
-}
-
-func (x *AdminLocationListReply_LocationList) GetCol() int64 {
-	if x != nil {
-		return x.Col
-	}
-	return 0
-}
-
-func (x *AdminLocationListReply_LocationList) GetStatus() string {
-	if x != nil {
-		return x.Status
-	}
-	return ""
+// Deprecated: Use AdminWithdrawListRequest.ProtoReflect.Descriptor instead.
+func (*AdminWithdrawListRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{98}
 }
 
-func (x *AdminLocationListReply_LocationList) GetCurrentLevel() int64 {
+func (x *AdminWithdrawListRequest) GetPage() int64 {
 	if x != nil {
-		return x.CurrentLevel
+		return x.Page
 	}
 	return 0
 }
 
-func (x *AdminLocationListReply_LocationList) GetCurrent() string {
-	if x != nil {
-		return x.Current
-	}
-	return ""
-}
-
-func (x *AdminLocationListReply_LocationList) GetCurrentMax() string {
+func (x *AdminWithdrawListRequest) GetAddress() string {
 	if x != nil {
-		return x.CurrentMax
+		return x.Address
 	}
 	return ""
 }
 
-type AdminWithdrawListReply_List struct {
+type AdminWithdrawListReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address   string `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
-	Id        int64  `protobuf:"varint,7,opt,name=id,proto3" json:"id,omitempty"`
-	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Amount    string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	RelAmount string `protobuf:"bytes,6,opt,name=relAmount,proto3" json:"relAmount,omitempty"`
-	Type      string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
-	Status    string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Withdraw []*AdminWithdrawListReply_List `protobuf:"bytes,1,rep,name=withdraw,proto3" json:"withdraw,omitempty"`
+	Count    int64                          `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *AdminWithdrawListReply_List) Reset() {
-	*x = AdminWithdrawListReply_List{}
+func (x *AdminWithdrawListReply) Reset() {
+	*x = AdminWithdrawListReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[99]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5980,13 +6245,13 @@ func (x *AdminWithdrawListReply_List) Reset() {
 	}
 }
 
-func (x *AdminWithdrawListReply_List) String() string {
+func (x *AdminWithdrawListReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminWithdrawListReply_List) ProtoMessage() {}
+func (*AdminWithdrawListReply) ProtoMessage() {}
 
-func (x *AdminWithdrawListReply_List) ProtoReflect() protoreflect.Message {
+func (x *AdminWithdrawListReply) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[99]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5998,73 +6263,35 @@ func (x *AdminWithdrawListReply_List) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminWithdrawListReply_List.ProtoReflect.Descriptor instead.
-func (*AdminWithdrawListReply_List) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{46, 0}
+// Deprecated: Use AdminWithdrawListReply.ProtoReflect.Descriptor instead.
+func (*AdminWithdrawListReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{99}
 }
 
-func (x *AdminWithdrawListReply_List) GetAddress() string {
+func (x *AdminWithdrawListReply) GetWithdraw() []*AdminWithdrawListReply_List {
 	if x != nil {
-		return x.Address
+		return x.Withdraw
 	}
-	return ""
+	return nil
 }
 
-func (x *AdminWithdrawListReply_List) GetId() int64 {
+func (x *AdminWithdrawListReply) GetCount() int64 {
 	if x != nil {
-		return x.Id
+		return x.Count
 	}
 	return 0
 }
 
-func (x *AdminWithdrawListReply_List) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return ""
-}
-
-func (x *AdminWithdrawListReply_List) GetAmount() string {
-	if x != nil {
-		return x.Amount
-	}
-	return ""
-}
-
-func (x *AdminWithdrawListReply_List) GetRelAmount() string {
-	if x != nil {
-		return x.RelAmount
-	}
-	return ""
-}
-
-func (x *AdminWithdrawListReply_List) GetType() string {
-	if x != nil {
-		return x.Type
-	}
-	return ""
-}
-
-func (x *AdminWithdrawListReply_List) GetStatus() string {
-	if x != nil {
-		return x.Status
-	}
-	return ""
-}
-
-type AdminUserRecommendReply_List struct {
+type AdminWithdrawRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId    int64  `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Address   string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
-	Id        int64  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
-	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	SendBody *AdminWithdrawRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
 }
 
-func (x *AdminUserRecommendReply_List) Reset() {
-	*x = AdminUserRecommendReply_List{}
+func (x *AdminWithdrawRequest) Reset() {
+	*x = AdminWithdrawRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[100]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6072,13 +6299,13 @@ func (x *AdminUserRecommendReply_List) Reset() {
 	}
 }
 
-func (x *AdminUserRecommendReply_List) String() string {
+func (x *AdminWithdrawRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminUserRecommendReply_List) ProtoMessage() {}
+func (*AdminWithdrawRequest) ProtoMessage() {}
 
-func (x *AdminUserRecommendReply_List) ProtoReflect() protoreflect.Message {
+func (x *AdminWithdrawRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[100]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6090,52 +6317,28 @@ func (x *AdminUserRecommendReply_List) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminUserRecommendReply_List.ProtoReflect.Descriptor instead.
-func (*AdminUserRecommendReply_List) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{58, 0}
-}
-
-func (x *AdminUserRecommendReply_List) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
-}
-
-func (x *AdminUserRecommendReply_List) GetAddress() string {
-	if x != nil {
-		return x.Address
-	}
-	return ""
-}
-
-func (x *AdminUserRecommendReply_List) GetId() int64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
+// Deprecated: Use AdminWithdrawRequest.ProtoReflect.Descriptor instead.
+func (*AdminWithdrawRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{100}
 }
 
-func (x *AdminUserRecommendReply_List) GetCreatedAt() string {
+func (x *AdminWithdrawRequest) GetSendBody() *AdminWithdrawRequest_SendBody {
 	if x != nil {
-		return x.CreatedAt
+		return x.SendBody
 	}
-	return ""
+	return nil
 }
 
-type AdminMonthRecommendReply_List struct {
+type AdminWithdrawReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address          string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
-	RecommendAddress string `protobuf:"bytes,4,opt,name=recommendAddress,proto3" json:"recommendAddress,omitempty"`
-	Id               int64  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
-	CreatedAt        string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *AdminMonthRecommendReply_List) Reset() {
-	*x = AdminMonthRecommendReply_List{}
+func (x *AdminWithdrawReply) Reset() {
+	*x = AdminWithdrawReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_app_proto_msgTypes[101]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6143,13 +6346,13 @@ func (x *AdminMonthRecommendReply_List) Reset() {
 	}
 }
 
-func (x *AdminMonthRecommendReply_List) String() string {
+func (x *AdminWithdrawReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminMonthRecommendReply_List) ProtoMessage() {}
+func (*AdminWithdrawReply) ProtoMessage() {}
 
-func (x *AdminMonthRecommendReply_List) ProtoReflect() protoreflect.Message {
+func (x *AdminWithdrawReply) ProtoReflect() protoreflect.Message {
 	mi := &file_api_app_proto_msgTypes[101]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6161,66 +6364,117 @@ func (x *AdminMonthRecommendReply_List) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminMonthRecommendReply_List.ProtoReflect.Descriptor instead.
-func (*AdminMonthRecommendReply_List) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{60, 0}
+// Deprecated: Use AdminWithdrawReply.ProtoReflect.Descriptor instead.
+func (*AdminWithdrawReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{101}
 }
 
-func (x *AdminMonthRecommendReply_List) GetAddress() string {
+func (x *AdminWithdrawReply) GetStatus() string {
 	if x != nil {
-		return x.Address
+		return x.Status
 	}
 	return ""
 }
 
-func (x *AdminMonthRecommendReply_List) GetRecommendAddress() string {
-	if x != nil {
-		return x.RecommendAddress
-	}
-	return ""
+type AdminWithdrawEthRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *AdminMonthRecommendReply_List) GetId() int64 {
-	if x != nil {
-		return x.Id
+func (x *AdminWithdrawEthRequest) Reset() {
+	*x = AdminWithdrawEthRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *AdminMonthRecommendReply_List) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
+func (x *AdminWithdrawEthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminWithdrawEthRequest) ProtoMessage() {}
+
+func (x *AdminWithdrawEthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-type AdminConfigReply_List struct {
+// Deprecated: Use AdminWithdrawEthRequest.ProtoReflect.Descriptor instead.
+func (*AdminWithdrawEthRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{102}
+}
+
+type AdminWithdrawEthReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+}
 
-	Id    int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name  string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+func (x *AdminWithdrawEthReply) Reset() {
+	*x = AdminWithdrawEthReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func (x *AdminConfigReply_List) Reset() {
-	*x = AdminConfigReply_List{}
+func (x *AdminWithdrawEthReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminWithdrawEthReply) ProtoMessage() {}
+
+func (x *AdminWithdrawEthReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminWithdrawEthReply.ProtoReflect.Descriptor instead.
+func (*AdminWithdrawEthReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{103}
+}
+
+type AdminFeeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AdminFeeRequest) Reset() {
+	*x = AdminFeeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_app_proto_msgTypes[102]
+		mi := &file_api_app_proto_msgTypes[104]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminConfigReply_List) String() string {
+func (x *AdminFeeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminConfigReply_List) ProtoMessage() {}
+func (*AdminFeeRequest) ProtoMessage() {}
 
-func (x *AdminConfigReply_List) ProtoReflect() protoreflect.Message {
-	mi := &file_api_app_proto_msgTypes[102]
+func (x *AdminFeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[104]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6231,1181 +6485,8175 @@ func (x *AdminConfigReply_List) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminConfigReply_List.ProtoReflect.Descriptor instead.
-func (*AdminConfigReply_List) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{62, 0}
+// Deprecated: Use AdminFeeRequest.ProtoReflect.Descriptor instead.
+func (*AdminFeeRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{104}
 }
 
-func (x *AdminConfigReply_List) GetId() int64 {
-	if x != nil {
-		return x.Id
+type AdminFeeReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AdminFeeReply) Reset() {
+	*x = AdminFeeReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *AdminConfigReply_List) GetName() string {
-	if x != nil {
-		return x.Name
+func (x *AdminFeeReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminFeeReply) ProtoMessage() {}
+
+func (x *AdminFeeReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *AdminConfigReply_List) GetValue() string {
-	if x != nil {
-		return x.Value
+// Deprecated: Use AdminFeeReply.ProtoReflect.Descriptor instead.
+func (*AdminFeeReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{105}
+}
+
+type TokenWithdrawRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *TokenWithdrawRequest) Reset() {
+	*x = TokenWithdrawRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-type AdminConfigUpdateRequest_SendBody struct {
+func (x *TokenWithdrawRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenWithdrawRequest) ProtoMessage() {}
+
+func (x *TokenWithdrawRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenWithdrawRequest.ProtoReflect.Descriptor instead.
+func (*TokenWithdrawRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{106}
+}
+
+type TokenWithdrawReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+}
 
-	Id    int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+func (x *TokenWithdrawReply) Reset() {
+	*x = TokenWithdrawReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func (x *AdminConfigUpdateRequest_SendBody) Reset() {
-	*x = AdminConfigUpdateRequest_SendBody{}
+func (x *TokenWithdrawReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenWithdrawReply) ProtoMessage() {}
+
+func (x *TokenWithdrawReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenWithdrawReply.ProtoReflect.Descriptor instead.
+func (*TokenWithdrawReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{107}
+}
+
+type AdminAllRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AdminAllRequest) Reset() {
+	*x = AdminAllRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_app_proto_msgTypes[103]
+		mi := &file_api_app_proto_msgTypes[108]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AdminConfigUpdateRequest_SendBody) String() string {
+func (x *AdminAllRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminConfigUpdateRequest_SendBody) ProtoMessage() {}
+func (*AdminAllRequest) ProtoMessage() {}
 
-func (x *AdminConfigUpdateRequest_SendBody) ProtoReflect() protoreflect.Message {
-	mi := &file_api_app_proto_msgTypes[103]
+func (x *AdminAllRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[108]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use AdminConfigUpdateRequest_SendBody.ProtoReflect.Descriptor instead.
-func (*AdminConfigUpdateRequest_SendBody) Descriptor() ([]byte, []int) {
-	return file_api_app_proto_rawDescGZIP(), []int{63, 0}
-}
-
-func (x *AdminConfigUpdateRequest_SendBody) GetId() int64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *AdminConfigUpdateRequest_SendBody) GetValue() string {
-	if x != nil {
-		return x.Value
-	}
-	return ""
-}
-
-var File_api_app_proto protoreflect.FileDescriptor
-
-var file_api_app_proto_rawDesc = []byte{
-	0x0a, 0x0d, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
-	0x03, 0x61, 0x70, 0x69, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xbf, 0x01, 0x0a, 0x13,
-	0x45, 0x74, 0x68, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x3e, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x74, 0x68,
-	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42,
-	0x6f, 0x64, 0x79, 0x1a, 0x68, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12,
-	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a,
-	0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x67,
-	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x69, 0x67, 0x6e, 0x22, 0x29, 0x0a,
-	0x11, 0x45, 0x74, 0x68, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x8f, 0x01, 0x0a, 0x16, 0x52, 0x65, 0x63,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x41, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x63,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65,
-	0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x32, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f,
-	0x64, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x67, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x69, 0x67, 0x6e, 0x22, 0x44, 0x0a, 0x14, 0x52, 0x65,
-	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x12, 0x2c, 0x0a, 0x11, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x69,
-	0x6e, 0x76, 0x69, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x22, 0x10, 0x0a, 0x0e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x22, 0x0e, 0x0a, 0x0c, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x22, 0x31, 0x0a, 0x0f, 0x55, 0x73, 0x65, 0x72, 0x41, 0x72, 0x65, 0x61, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x9a, 0x01, 0x0a, 0x0d, 0x55, 0x73, 0x65, 0x72, 0x41, 0x72,
-	0x65, 0x61, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2b, 0x0a, 0x04, 0x61, 0x72, 0x65, 0x61, 0x18,
-	0x34, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72,
-	0x41, 0x72, 0x65, 0x61, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x04,
-	0x61, 0x72, 0x65, 0x61, 0x1a, 0x5c, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6c, 0x6f, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4c,
-	0x6f, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4c,
-	0x6f, 0x77, 0x22, 0x11, 0x0a, 0x0f, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xa2, 0x14, 0x0a, 0x0d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x69, 0x77, 0x50, 0x72,
-	0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x62, 0x69, 0x77, 0x50, 0x72,
-	0x69, 0x63, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x42, 0x69,
-	0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
-	0x42, 0x69, 0x77, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x69, 0x77, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x69, 0x77, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x12,
-	0x1c, 0x0a, 0x09, 0x62, 0x75, 0x79, 0x4e, 0x75, 0x6d, 0x54, 0x77, 0x6f, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x09, 0x62, 0x75, 0x79, 0x4e, 0x75, 0x6d, 0x54, 0x77, 0x6f, 0x12, 0x20, 0x0a,
-	0x0b, 0x62, 0x75, 0x79, 0x4e, 0x75, 0x6d, 0x54, 0x68, 0x72, 0x65, 0x65, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x0b, 0x62, 0x75, 0x79, 0x4e, 0x75, 0x6d, 0x54, 0x68, 0x72, 0x65, 0x65, 0x12,
-	0x1e, 0x0a, 0x0a, 0x62, 0x75, 0x79, 0x4e, 0x75, 0x6d, 0x46, 0x6f, 0x75, 0x72, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0a, 0x62, 0x75, 0x79, 0x4e, 0x75, 0x6d, 0x46, 0x6f, 0x75, 0x72, 0x12,
-	0x1e, 0x0a, 0x0a, 0x62, 0x75, 0x79, 0x4e, 0x75, 0x6d, 0x46, 0x69, 0x76, 0x65, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0a, 0x62, 0x75, 0x79, 0x4e, 0x75, 0x6d, 0x46, 0x69, 0x76, 0x65, 0x12,
-	0x1c, 0x0a, 0x09, 0x62, 0x75, 0x79, 0x4e, 0x75, 0x6d, 0x4f, 0x6e, 0x65, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x09, 0x62, 0x75, 0x79, 0x4e, 0x75, 0x6d, 0x4f, 0x6e, 0x65, 0x12, 0x1c, 0x0a,
-	0x09, 0x62, 0x75, 0x79, 0x4e, 0x75, 0x6d, 0x53, 0x69, 0x78, 0x18, 0x5a, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x09, 0x62, 0x75, 0x79, 0x4e, 0x75, 0x6d, 0x53, 0x69, 0x78, 0x12, 0x1e, 0x0a, 0x0a, 0x73,
-	0x65, 0x6c, 0x6c, 0x4e, 0x75, 0x6d, 0x4f, 0x6e, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0a, 0x73, 0x65, 0x6c, 0x6c, 0x4e, 0x75, 0x6d, 0x4f, 0x6e, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x73,
-	0x65, 0x6c, 0x6c, 0x4e, 0x75, 0x6d, 0x54, 0x77, 0x6f, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0a, 0x73, 0x65, 0x6c, 0x6c, 0x4e, 0x75, 0x6d, 0x54, 0x77, 0x6f, 0x12, 0x22, 0x0a, 0x0c, 0x73,
-	0x65, 0x6c, 0x6c, 0x4e, 0x75, 0x6d, 0x54, 0x68, 0x72, 0x65, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x0c, 0x73, 0x65, 0x6c, 0x6c, 0x4e, 0x75, 0x6d, 0x54, 0x68, 0x72, 0x65, 0x65, 0x12,
-	0x20, 0x0a, 0x0b, 0x73, 0x65, 0x6c, 0x6c, 0x4e, 0x75, 0x6d, 0x46, 0x6f, 0x75, 0x72, 0x18, 0x0c,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73, 0x65, 0x6c, 0x6c, 0x4e, 0x75, 0x6d, 0x46, 0x6f, 0x75,
-	0x72, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x65, 0x6c, 0x6c, 0x4e, 0x75, 0x6d, 0x46, 0x69, 0x76, 0x65,
-	0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73, 0x65, 0x6c, 0x6c, 0x4e, 0x75, 0x6d, 0x46,
-	0x69, 0x76, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x65, 0x6c, 0x6c, 0x4e, 0x75, 0x6d, 0x53, 0x69,
-	0x78, 0x18, 0x5b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x65, 0x6c, 0x6c, 0x4e, 0x75, 0x6d,
-	0x53, 0x69, 0x78, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x5f, 0x72, 0x61, 0x74,
-	0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x52, 0x61,
-	0x74, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x62, 0x69, 0x77, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x53, 0x70,
-	0x65, 0x65, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x62, 0x69, 0x77, 0x44, 0x61,
-	0x69, 0x6c, 0x79, 0x53, 0x70, 0x65, 0x65, 0x64, 0x12, 0x2a, 0x0a, 0x10, 0x63, 0x75, 0x72, 0x72,
-	0x65, 0x6e, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x69, 0x77, 0x18, 0x10, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x10, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x42, 0x69, 0x77, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x64, 0x4e, 0x75, 0x6d, 0x18, 0x11, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x72, 0x65, 0x63, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65,
-	0x18, 0x12, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b,
-	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x73, 0x64, 0x74, 0x18, 0x13, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x73, 0x64, 0x74, 0x12, 0x14,
-	0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x55, 0x72,
-	0x6c, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x55,
-	0x72, 0x6c, 0x12, 0x2c, 0x0a, 0x11, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x69,
-	0x6e, 0x76, 0x69, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x12, 0x22, 0x0a, 0x0c, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65,
-	0x18, 0x3c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
-	0x52, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x4c, 0x65, 0x76, 0x65,
-	0x6c, 0x18, 0x3e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x4c, 0x65, 0x76,
-	0x65, 0x6c, 0x12, 0x3b, 0x0a, 0x0c, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69,
-	0x73, 0x74, 0x18, 0x34, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55,
-	0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x0c, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12,
-	0x43, 0x0a, 0x0c, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x18,
-	0x42, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x69,
-	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x0c, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
-	0x4c, 0x69, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x19, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6c, 0x6f,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x28, 0x0a, 0x0f,
-	0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18,
-	0x1a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64,
-	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x6f, 0x75, 0x72, 0x52, 0x65,
-	0x77, 0x61, 0x72, 0x64, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x6f, 0x75, 0x72,
-	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x72, 0x65, 0x61, 0x52, 0x65,
-	0x77, 0x61, 0x72, 0x64, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x72, 0x65, 0x61,
-	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x66, 0x6f, 0x75, 0x72, 0x52, 0x65,
-	0x77, 0x61, 0x72, 0x64, 0x50, 0x6f, 0x6f, 0x6c, 0x18, 0x17, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
-	0x66, 0x6f, 0x75, 0x72, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x2c,
-	0x0a, 0x11, 0x66, 0x6f, 0x75, 0x72, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x50, 0x6f, 0x6f, 0x6c,
-	0x59, 0x65, 0x73, 0x18, 0x18, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x66, 0x6f, 0x75, 0x72, 0x52,
-	0x65, 0x77, 0x61, 0x72, 0x64, 0x50, 0x6f, 0x6f, 0x6c, 0x59, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x04,
-	0x66, 0x6f, 0x75, 0x72, 0x18, 0x32, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x46, 0x6f, 0x75, 0x72, 0x52, 0x04, 0x66, 0x6f, 0x75, 0x72, 0x12, 0x18, 0x0a,
-	0x07, 0x61, 0x72, 0x65, 0x61, 0x4d, 0x61, 0x78, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
-	0x61, 0x72, 0x65, 0x61, 0x4d, 0x61, 0x78, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x72, 0x65, 0x61, 0x4d,
-	0x69, 0x6e, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x61, 0x72, 0x65, 0x61, 0x4d, 0x69,
-	0x6e, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x72, 0x65, 0x61, 0x41, 0x6c, 0x6c, 0x18, 0x20, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x07, 0x61, 0x72, 0x65, 0x61, 0x41, 0x6c, 0x6c, 0x12, 0x26, 0x0a, 0x0e, 0x72,
-	0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x21, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x6f,
-	0x74, 0x61, 0x6c, 0x12, 0x2c, 0x0a, 0x11, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64,
-	0x54, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6c, 0x6c, 0x18, 0x45, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11,
-	0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6c,
-	0x6c, 0x12, 0x22, 0x0a, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x73, 0x64,
-	0x74, 0x18, 0x22, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x55, 0x73, 0x64, 0x74, 0x12, 0x34, 0x0a, 0x15, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4d, 0x61, 0x78, 0x53, 0x75, 0x62, 0x18, 0x23,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x75,
-	0x72, 0x72, 0x65, 0x6e, 0x74, 0x4d, 0x61, 0x78, 0x53, 0x75, 0x62, 0x12, 0x2e, 0x0a, 0x12, 0x6c,
-	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x75,
-	0x62, 0x18, 0x24, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x62, 0x12, 0x24, 0x0a, 0x0d, 0x77,
-	0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x25, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0d, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x54, 0x6f, 0x74, 0x61,
-	0x6c, 0x12, 0x28, 0x0a, 0x0f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x73, 0x64,
-	0x74, 0x41, 0x6c, 0x6c, 0x18, 0x26, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6c, 0x6f, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x55, 0x73, 0x64, 0x74, 0x41, 0x6c, 0x6c, 0x12, 0x3d, 0x0a, 0x0a, 0x6c,
-	0x69, 0x73, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x27, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x0a,
-	0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x46, 0x0a, 0x0d, 0x6c, 0x69,
-	0x73, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x18, 0x28, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x64, 0x52, 0x0d, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x64, 0x12, 0x43, 0x0a, 0x0c, 0x6c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e,
-	0x67, 0x65, 0x18, 0x29, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55,
-	0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x0c, 0x6c, 0x69, 0x73, 0x74, 0x45,
-	0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x4f, 0x6e, 0x65, 0x18, 0x2d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x4f, 0x6e, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x54,
-	0x77, 0x6f, 0x18, 0x2e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x54, 0x77, 0x6f, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x54, 0x68, 0x72,
-	0x65, 0x65, 0x18, 0x2f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x54, 0x68, 0x72, 0x65, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46,
-	0x6f, 0x75, 0x72, 0x18, 0x30, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x46, 0x6f, 0x75, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
-	0x31, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x20, 0x0a,
-	0x0b, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4d, 0x69, 0x6e, 0x18, 0x36, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x0b, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4d, 0x69, 0x6e, 0x12,
-	0x1a, 0x0a, 0x08, 0x62, 0x75, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x37, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x08, 0x62, 0x75, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x1a, 0x6c, 0x0a, 0x04, 0x4c,
-	0x69, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x32, 0x0a,
-	0x14, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4d, 0x61, 0x78, 0x53, 0x75, 0x62, 0x43, 0x75,
-	0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x63, 0x75, 0x72,
-	0x72, 0x65, 0x6e, 0x74, 0x4d, 0x61, 0x78, 0x53, 0x75, 0x62, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e,
-	0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x42, 0x0a, 0x0c, 0x4c, 0x69, 0x73,
-	0x74, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x74, 0x1a, 0x56, 0x0a,
-	0x08, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x75, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x76, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x77,
-	0x61, 0x72, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
-	0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x1e, 0x0a,
-	0x0a, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x55, 0x73, 0x64, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x55, 0x73, 0x64, 0x74, 0x1a, 0x29, 0x0a,
-	0x0d, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x12, 0x18,
-	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x1a, 0x60, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74,
-	0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x73, 0x64, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x73, 0x64, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x22, 0x90, 0x10, 0x0a, 0x0e, 0x55,
-	0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x31, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a,
-	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x32, 0x0a, 0x14, 0x72, 0x65, 0x63, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18,
-	0x5c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64,
-	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x24, 0x0a, 0x0d, 0x4c,
-	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x0f, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0d, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x74, 0x61,
-	0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x73, 0x64, 0x74,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x55,
-	0x73, 0x64, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x41,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x77, 0x69, 0x74,
-	0x68, 0x64, 0x72, 0x61, 0x77, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x18, 0x0c,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x12, 0x1c, 0x0a,
-	0x09, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x55, 0x72, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x2c, 0x0a, 0x11, 0x69,
-	0x6e, 0x76, 0x69, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x55, 0x73,
-	0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x63,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0c, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x12, 0x14, 0x0a,
-	0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x65,
-	0x76, 0x65, 0x6c, 0x12, 0x38, 0x0a, 0x17, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64,
-	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x44, 0x68, 0x62, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52,
-	0x65, 0x77, 0x61, 0x72, 0x64, 0x44, 0x68, 0x62, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x18, 0x0a,
-	0x07, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x32, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x32, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x61, 0x6c, 0x61, 0x6e,
-	0x63, 0x65, 0x44, 0x68, 0x62, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x61, 0x6c,
-	0x61, 0x6e, 0x63, 0x65, 0x44, 0x68, 0x62, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65, 0x77, 0x61, 0x72,
-	0x64, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12,
-	0x22, 0x0a, 0x0c, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x73, 0x64, 0x74, 0x32, 0x18,
-	0x23, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x73,
-	0x64, 0x74, 0x32, 0x12, 0x28, 0x0a, 0x0f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x41,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x32, 0x18, 0x24, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x77, 0x69,
-	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x32, 0x12, 0x22, 0x0a,
-	0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x20, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69,
-	0x74, 0x12, 0x20, 0x0a, 0x0b, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x41, 0x6c, 0x6c,
-	0x18, 0x27, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
-	0x41, 0x6c, 0x6c, 0x12, 0x2a, 0x0a, 0x10, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64,
-	0x54, 0x65, 0x61, 0x6d, 0x4e, 0x75, 0x6d, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x72,
-	0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x65, 0x61, 0x6d, 0x4e, 0x75, 0x6d, 0x12,
-	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x48, 0x62,
-	0x73, 0x18, 0x62, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x48, 0x62,
-	0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x16, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3c, 0x0a, 0x0c, 0x4c,
-	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x34, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f,
-	0x31, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x0c, 0x4c, 0x6f, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x0d, 0x4c, 0x6f, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x32, 0x18, 0x31, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x31,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x32, 0x32, 0x52, 0x0d, 0x4c, 0x6f,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x32, 0x12, 0x49, 0x0a, 0x12, 0x6c,
-	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73,
-	0x74, 0x18, 0x35, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73,
-	0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x31, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x32, 0x52, 0x12, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x77, 0x61,
-	0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x4b, 0x0a, 0x13, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x38, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e,
-	0x66, 0x6f, 0x31, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x35, 0x52, 0x13,
-	0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c,
-	0x69, 0x73, 0x74, 0x12, 0x43, 0x0a, 0x0f, 0x74, 0x65, 0x61, 0x6d, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x41, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x31, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x37, 0x52, 0x0f, 0x74, 0x65, 0x61, 0x6d, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x4e, 0x0a, 0x14, 0x72, 0x65, 0x63, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74,
-	0x18, 0x42, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65,
-	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x31, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x31, 0x31, 0x52, 0x14, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x3f, 0x0a, 0x0d, 0x61, 0x6c, 0x6c, 0x52,
-	0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x43, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x31, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x39, 0x52, 0x0d, 0x61, 0x6c, 0x6c, 0x52,
-	0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x0b, 0x64, 0x65, 0x70,
-	0x6f, 0x73, 0x69, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x64, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x31, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x31, 0x33, 0x52, 0x0b, 0x64, 0x65, 0x70, 0x6f,
-	0x73, 0x69, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x77, 0x69, 0x74, 0x68, 0x64,
-	0x72, 0x61, 0x77, 0x52, 0x61, 0x74, 0x65, 0x18, 0x51, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x77,
-	0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x61, 0x74, 0x65, 0x1a, 0x6d, 0x0a, 0x04, 0x4c,
-	0x69, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
-	0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x78, 0x12, 0x10, 0x0a, 0x03, 0x4e, 0x75, 0x6d, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x4e, 0x75, 0x6d, 0x1a, 0x5d, 0x0a, 0x06, 0x4c, 0x69,
-	0x73, 0x74, 0x32, 0x32, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
-	0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x61,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x78, 0x1a, 0x3e, 0x0a, 0x05, 0x4c, 0x69, 0x73,
-	0x74, 0x32, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
-	0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x3e, 0x0a, 0x05, 0x4c, 0x69, 0x73,
-	0x74, 0x35, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
-	0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x5f, 0x0a, 0x05, 0x4c, 0x69, 0x73,
-	0x74, 0x37, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06,
-	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x41, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x55, 0x73, 0x64, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x55, 0x73, 0x64, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x56, 0x69, 0x70, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x56, 0x69, 0x70, 0x1a, 0x60, 0x0a, 0x06, 0x4c, 0x69,
-	0x73, 0x74, 0x31, 0x31, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16,
-	0x0a, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x55, 0x73, 0x64, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x55, 0x73, 0x64, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x56, 0x69,
-	0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x56, 0x69, 0x70, 0x1a, 0x3e, 0x0a, 0x05,
-	0x4c, 0x69, 0x73, 0x74, 0x39, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
-	0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x3f, 0x0a, 0x06,
-	0x4c, 0x69, 0x73, 0x74, 0x31, 0x33, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x27, 0x0a,
-	0x11, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0xc1, 0x01, 0x0a, 0x0f, 0x52, 0x65, 0x77, 0x61, 0x72,
-	0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x33, 0x0a, 0x07, 0x72, 0x65,
-	0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x1a,
-	0x79, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26,
-	0x0a, 0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x71, 0x0a, 0x0f, 0x47, 0x65,
-	0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a,
-	0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x52,
-	0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x22, 0x0a, 0x08, 0x53, 0x65, 0x6e,
-	0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x4b, 0x0a,
-	0x0d, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1c,
-	0x0a, 0x09, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x73, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x73, 0x64, 0x12, 0x1c, 0x0a, 0x09,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x48, 0x62, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x48, 0x62, 0x73, 0x22, 0x1c, 0x0a, 0x1a, 0x52, 0x65,
-	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xc3, 0x01, 0x0a, 0x18, 0x52, 0x65, 0x63,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3c, 0x0a, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x63,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x77, 0x61,
-	0x72, 0x64, 0x73, 0x1a, 0x69, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x16,
-	0x0a, 0x14, 0x46, 0x65, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x8b, 0x01, 0x0a, 0x12, 0x46, 0x65, 0x65, 0x52, 0x65,
-	0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x36, 0x0a,
-	0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x65, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69,
-	0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65,
-	0x77, 0x61, 0x72, 0x64, 0x73, 0x1a, 0x3d, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x0a,
-	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x22, 0x29, 0x0a, 0x13, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
-	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22,
-	0xb7, 0x01, 0x0a, 0x11, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4c, 0x69, 0x73, 0x74,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x37, 0x0a, 0x08, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
-	0x77, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x69,
-	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x52, 0x08, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x1a, 0x69,
-	0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a,
-	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70,
-	0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x12, 0x0a, 0x10, 0x54, 0x72, 0x61,
-	0x64, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xbb, 0x01,
-	0x0a, 0x0e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x12, 0x2e, 0x0a, 0x05, 0x74, 0x72, 0x61, 0x64, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x05, 0x74, 0x72, 0x61, 0x64, 0x65,
-	0x1a, 0x79, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x43, 0x73, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x43, 0x73, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x48,
-	0x62, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x48, 0x62, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x39, 0x0a, 0x0f, 0x54,
-	0x72, 0x61, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
-	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x72, 0x61, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x74, 0x72, 0x61, 0x6e, 0x22, 0x7b, 0x0a, 0x0d, 0x54, 0x72, 0x61, 0x6e, 0x4c, 0x69,
-	0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2b, 0x0a, 0x04, 0x74, 0x72, 0x61, 0x6e, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x72, 0x61, 0x6e,
-	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x04,
-	0x74, 0x72, 0x61, 0x6e, 0x1a, 0x3d, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x22, 0x2a, 0x0a, 0x14, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64,
-	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22,
-	0x8a, 0x01, 0x0a, 0x12, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3c, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x64, 0x73, 0x1a, 0x36, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x85, 0x01, 0x0a,
-	0x0f, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x3a, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e,
-	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f,
-	0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x36, 0x0a, 0x08,
-	0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x67, 0x6e,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x69, 0x67, 0x6e, 0x12, 0x16, 0x0a, 0x06,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x22, 0x27, 0x0a, 0x0d, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xb5, 0x01,
-	0x0a, 0x0f, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x3a, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64,
-	0x72, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42,
-	0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x66, 0x0a,
-	0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a,
-	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
-	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
-	0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x67, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x73, 0x69, 0x67, 0x6e, 0x22, 0x27, 0x0a, 0x0d, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
-	0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x81,
-	0x01, 0x0a, 0x15, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64,
-	0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79,
-	0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x26, 0x0a, 0x08, 0x53, 0x65,
-	0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
-	0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
-	0x72, 0x64, 0x22, 0x31, 0x0a, 0x13, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x43, 0x68,
-	0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73,
-	0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73,
-	0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0xb3, 0x01, 0x0a, 0x0b, 0x54, 0x72, 0x61, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f,
-	0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54,
-	0x72, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42,
-	0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x6c, 0x0a,
-	0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a,
-	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
-	0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x23, 0x0a, 0x09, 0x54,
-	0x72, 0x61, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x22, 0x81, 0x01, 0x0a, 0x17, 0x53, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52,
-	0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x42, 0x0a, 0x09,
-	0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
-	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65,
-	0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79,
-	0x1a, 0x22, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x16, 0x0a, 0x06,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x22, 0x2f, 0x0a, 0x15, 0x53, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e,
-	0x63, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x16, 0x0a,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x87, 0x01, 0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x45, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72,
-	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64,
-	0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x22, 0x0a, 0x08, 0x53,
-	0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22,
-	0x32, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
-	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x22, 0x46, 0x0a, 0x16, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x77, 0x61,
-	0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
-	0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x61, 0x67,
-	0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xec, 0x01, 0x0a, 0x14,
-	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x12, 0x38, 0x0a, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69,
-	0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x14,
-	0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x83, 0x01, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x0a,
-	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x44, 0x0a, 0x14, 0x41, 0x64,
-	0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x22, 0xe9, 0x02, 0x0a, 0x12, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69,
-	0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x36, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d,
-	0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e,
-	0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12,
-	0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x84, 0x02, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69,
-	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x55,
-	0x73, 0x64, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x61, 0x6c, 0x61, 0x6e,
-	0x63, 0x65, 0x55, 0x73, 0x64, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
-	0x65, 0x44, 0x68, 0x62, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x61, 0x6c, 0x61,
-	0x6e, 0x63, 0x65, 0x44, 0x68, 0x62, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x69, 0x70, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x03, 0x76, 0x69, 0x70, 0x12, 0x26, 0x0a, 0x0e, 0x6d, 0x6f, 0x6e, 0x74,
-	0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0e, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64,
-	0x12, 0x2a, 0x0a, 0x10, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x68, 0x69, 0x73, 0x74,
-	0x6f, 0x72, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x22, 0x48, 0x0a, 0x18,
-	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xda, 0x02, 0x0a, 0x16, 0x41, 0x64, 0x6d, 0x69, 0x6e,
-	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x12, 0x46, 0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e,
-	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x09,
-	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a,
-	0xe1, 0x01, 0x0a, 0x0c, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74,
-	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
-	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x6f, 0x77,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x72, 0x6f, 0x77, 0x12, 0x10, 0x0a, 0x03, 0x63,
-	0x6f, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x63, 0x6f, 0x6c, 0x12, 0x16, 0x0a,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
-	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x75, 0x72,
-	0x72, 0x65, 0x6e, 0x74, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x75, 0x72,
-	0x72, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x75, 0x72, 0x72,
-	0x65, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4d, 0x61,
-	0x78, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
-	0x4d, 0x61, 0x78, 0x22, 0x48, 0x0a, 0x18, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68,
-	0x64, 0x72, 0x61, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70,
-	0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xa0, 0x02,
-	0x0a, 0x16, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4c,
-	0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3c, 0x0a, 0x08, 0x77, 0x69, 0x74, 0x68,
-	0x64, 0x72, 0x61, 0x77, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4c, 0x69,
-	0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x08, 0x77, 0x69,
-	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0xb1, 0x01, 0x0a,
-	0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16,
-	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x6c, 0x41, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x6c, 0x41, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x22, 0x16, 0x0a, 0x14, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
-	0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x14, 0x0a, 0x12, 0x41, 0x64, 0x6d, 0x69,
-	0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x19,
-	0x0a, 0x17, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x45,
-	0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x17, 0x0a, 0x15, 0x41, 0x64, 0x6d,
-	0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x45, 0x74, 0x68, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x22, 0x11, 0x0a, 0x0f, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x0f, 0x0a, 0x0d, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x46, 0x65,
-	0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x16, 0x0a, 0x14, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x57,
-	0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x14,
-	0x0a, 0x12, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x22, 0x11, 0x0a, 0x0f, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x6c, 0x6c,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xd9, 0x02, 0x0a, 0x0d, 0x41, 0x64, 0x6d, 0x69,
-	0x6e, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x6f, 0x64,
-	0x61, 0x79, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x0e, 0x74, 0x6f, 0x64, 0x61, 0x79, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x55, 0x73, 0x65,
-	0x72, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x12,
-	0x1e, 0x0a, 0x0a, 0x61, 0x6c, 0x6c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x6c, 0x6c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12,
-	0x24, 0x0a, 0x0d, 0x74, 0x6f, 0x64, 0x61, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x6f, 0x64, 0x61, 0x79, 0x4c, 0x6f, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x6c, 0x6c, 0x4c, 0x6f, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x6c, 0x6c, 0x4c,
-	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x74, 0x6f, 0x64, 0x61, 0x79,
-	0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
-	0x74, 0x6f, 0x64, 0x61, 0x79, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x20, 0x0a,
-	0x0b, 0x61, 0x6c, 0x6c, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x61, 0x6c, 0x6c, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12,
-	0x1c, 0x0a, 0x09, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x34, 0x0a,
-	0x15, 0x61, 0x6c, 0x6c, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
-	0x41, 0x6e, 0x64, 0x46, 0x65, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x61, 0x6c,
-	0x6c, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x41, 0x6e, 0x64,
-	0x46, 0x65, 0x65, 0x22, 0x34, 0x0a, 0x19, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72,
-	0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0xbc, 0x01, 0x0a, 0x17, 0x41, 0x64,
-	0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x37, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e,
-	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x1a, 0x68,
-	0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
-	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x4a, 0x0a, 0x1a, 0x41, 0x64, 0x6d, 0x69,
-	0x6e, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04,
-	0x70, 0x61, 0x67, 0x65, 0x22, 0xe7, 0x01, 0x0a, 0x18, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4d, 0x6f,
-	0x6e, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x12, 0x38, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4d, 0x6f, 0x6e, 0x74,
-	0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x1a, 0x7b, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x72,
-	0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x40,
-	0x0a, 0x12, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04,
-	0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65,
-	0x22, 0x9e, 0x01, 0x0a, 0x10, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x32, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69,
-	0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a,
-	0x40, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x22, 0x91, 0x01, 0x0a, 0x18, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x43,
-	0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x26, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42,
-	0x6f, 0x64, 0x79, 0x1a, 0x30, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x18, 0x0a, 0x16, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x32,
-	0xe6, 0x13, 0x0a, 0x03, 0x41, 0x70, 0x70, 0x12, 0x72, 0x0a, 0x0c, 0x45, 0x74, 0x68, 0x41, 0x75,
-	0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x12, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x74,
-	0x68, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x74, 0x68, 0x41, 0x75, 0x74, 0x68, 0x6f,
-	0x72, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x2a, 0x3a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x1d, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x65, 0x74,
-	0x68, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x12, 0x7e, 0x0a, 0x0f, 0x52,
-	0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1b,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x33, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2d, 0x3a, 0x09,
-	0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x20, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x72, 0x65, 0x63, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x64, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x57, 0x0a, 0x08, 0x55,
-	0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73,
-	0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x5f,
-	0x69, 0x6e, 0x66, 0x6f, 0x12, 0x57, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x41, 0x72, 0x65, 0x61,
-	0x12, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x41, 0x72, 0x65, 0x61, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65,
-	0x72, 0x41, 0x72, 0x65, 0x61, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x1b, 0x12, 0x19, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x72, 0x65, 0x61, 0x12, 0x5f, 0x0a,
-	0x0a, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
-	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x1d, 0x12, 0x1b, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x2f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x84,
-	0x01, 0x0a, 0x13, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x77, 0x61,
-	0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x63,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65,
-	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x12, 0x25,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f,
-	0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64,
-	0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x6c, 0x0a, 0x0d, 0x46, 0x65, 0x65, 0x52, 0x65, 0x77, 0x61,
-	0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x65, 0x65,
-	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x65, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72,
-	0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x21, 0x12, 0x1f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x2f, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x6c,
-	0x69, 0x73, 0x74, 0x12, 0x67, 0x0a, 0x0c, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4c,
-	0x69, 0x73, 0x74, 0x12, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72,
-	0x61, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4c, 0x69, 0x73, 0x74,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x77,
-	0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x5b, 0x0a, 0x09,
-	0x54, 0x72, 0x61, 0x64, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x54, 0x72, 0x61, 0x64, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x4c, 0x69, 0x73, 0x74,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x74,
-	0x72, 0x61, 0x64, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x57, 0x0a, 0x08, 0x54, 0x72, 0x61,
-	0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x72, 0x61, 0x6e,
-	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
-	0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70,
-	0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x5f, 0x6c, 0x69,
-	0x73, 0x74, 0x12, 0x6b, 0x0a, 0x0d, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4c,
-	0x69, 0x73, 0x74, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4c, 0x69,
-	0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12,
-	0x1e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x2f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12,
-	0x7a, 0x0a, 0x0e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67,
-	0x65, 0x12, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
-	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x43, 0x68, 0x61, 0x6e,
-	0x67, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x32, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x3a,
-	0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x1f, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x70, 0x61, 0x73, 0x73,
-	0x77, 0x6f, 0x72, 0x64, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x61, 0x0a, 0x08, 0x57,
-	0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x69,
-	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x3a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f,
-	0x62, 0x6f, 0x64, 0x79, 0x22, 0x18, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x61,
-	0x0a, 0x08, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x14, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x3a, 0x09, 0x73, 0x65,
-	0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x18, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70,
-	0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67,
-	0x65, 0x12, 0x5b, 0x0a, 0x05, 0x54, 0x72, 0x61, 0x64, 0x65, 0x12, 0x14, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x3a, 0x09, 0x73, 0x65,
-	0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x15, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70,
-	0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x12, 0x51,
-	0x0a, 0x04, 0x54, 0x72, 0x61, 0x6e, 0x12, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x72, 0x61,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54,
-	0x72, 0x61, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21,
-	0x3a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x14, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x74, 0x72, 0x61,
-	0x6e, 0x12, 0x62, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x12, 0x14, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61,
-	0x64, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x3a,
-	0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x19, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x67, 0x65, 0x74, 0x5f,
-	0x74, 0x72, 0x61, 0x64, 0x65, 0x12, 0x83, 0x01, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x42, 0x61, 0x6c,
-	0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x1c, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x53, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72,
-	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53,
-	0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x22, 0x35, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x3a, 0x09, 0x73, 0x65,
-	0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x22, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70,
-	0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x73, 0x65, 0x74, 0x5f, 0x62, 0x61, 0x6c,
-	0x61, 0x6e, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x8f, 0x01, 0x0a, 0x13,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x77,
-	0x61, 0x72, 0x64, 0x12, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x22, 0x38, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x32, 0x3a, 0x09, 0x73, 0x65, 0x6e,
-	0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x25, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70,
-	0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x62,
-	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x51, 0x0a,
-	0x07, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44,
-	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61,
-	0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
-	0x12, 0x64, 0x0a, 0x0d, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
-	0x77, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74,
-	0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x77, 0x69,
-	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x71, 0x0a, 0x10, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57,
-	0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x45, 0x74, 0x68, 0x12, 0x1c, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x45, 0x74,
-	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41,
-	0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x45, 0x74, 0x68, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x77, 0x69, 0x74,
-	0x68, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x65, 0x74, 0x68, 0x12, 0x50, 0x0a, 0x08, 0x41, 0x64, 0x6d,
-	0x69, 0x6e, 0x46, 0x65, 0x65, 0x12, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69,
-	0x6e, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
-	0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64,
-	0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x66, 0x65, 0x65, 0x12, 0x6b, 0x0a, 0x0d, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x19, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61,
-	0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f,
-	0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x42, 0x11, 0x0a, 0x03, 0x61, 0x70, 0x69, 0x50,
-	0x01, 0x5a, 0x08, 0x2f, 0x61, 0x70, 0x69, 0x3b, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
-}
-
-var (
-	file_api_app_proto_rawDescOnce sync.Once
-	file_api_app_proto_rawDescData = file_api_app_proto_rawDesc
-)
-
-func file_api_app_proto_rawDescGZIP() []byte {
-	file_api_app_proto_rawDescOnce.Do(func() {
-		file_api_app_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_app_proto_rawDescData)
-	})
-	return file_api_app_proto_rawDescData
-}
-
-var file_api_app_proto_msgTypes = make([]protoimpl.MessageInfo, 104)
-var file_api_app_proto_goTypes = []interface{}{
-	(*EthAuthorizeRequest)(nil),                 // 0: api.EthAuthorizeRequest
-	(*EthAuthorizeReply)(nil),                   // 1: api.EthAuthorizeReply
-	(*RecommendUpdateRequest)(nil),              // 2: api.RecommendUpdateRequest
-	(*RecommendUpdateReply)(nil),                // 3: api.RecommendUpdateReply
-	(*DepositRequest)(nil),                      // 4: api.DepositRequest
-	(*DepositReply)(nil),                        // 5: api.DepositReply
-	(*UserAreaRequest)(nil),                     // 6: api.UserAreaRequest
-	(*UserAreaReply)(nil),                       // 7: api.UserAreaReply
-	(*UserInfoRequest)(nil),                     // 8: api.UserInfoRequest
-	(*UserInfoReply)(nil),                       // 9: api.UserInfoReply
-	(*UserInfo1Reply)(nil),                      // 10: api.UserInfo1Reply
-	(*RewardListRequest)(nil),                   // 11: api.RewardListRequest
-	(*RewardListReply)(nil),                     // 12: api.RewardListReply
-	(*GetTradeRequest)(nil),                     // 13: api.GetTradeRequest
-	(*GetTradeReply)(nil),                       // 14: api.GetTradeReply
-	(*RecommendRewardListRequest)(nil),          // 15: api.RecommendRewardListRequest
-	(*RecommendRewardListReply)(nil),            // 16: api.RecommendRewardListReply
-	(*FeeRewardListRequest)(nil),                // 17: api.FeeRewardListRequest
-	(*FeeRewardListReply)(nil),                  // 18: api.FeeRewardListReply
-	(*WithdrawListRequest)(nil),                 // 19: api.WithdrawListRequest
-	(*WithdrawListReply)(nil),                   // 20: api.WithdrawListReply
-	(*TradeListRequest)(nil),                    // 21: api.TradeListRequest
-	(*TradeListReply)(nil),                      // 22: api.TradeListReply
-	(*TranListRequest)(nil),                     // 23: api.TranListRequest
-	(*TranListReply)(nil),                       // 24: api.TranListReply
-	(*RecommendListRequest)(nil),                // 25: api.RecommendListRequest
-	(*RecommendListReply)(nil),                  // 26: api.RecommendListReply
-	(*ExchangeRequest)(nil),                     // 27: api.ExchangeRequest
-	(*ExchangeReply)(nil),                       // 28: api.ExchangeReply
-	(*WithdrawRequest)(nil),                     // 29: api.WithdrawRequest
-	(*WithdrawReply)(nil),                       // 30: api.WithdrawReply
-	(*PasswordChangeRequest)(nil),               // 31: api.PasswordChangeRequest
-	(*PasswordChangeReply)(nil),                 // 32: api.PasswordChangeReply
-	(*TranRequest)(nil),                         // 33: api.TranRequest
-	(*TranReply)(nil),                           // 34: api.TranReply
-	(*SetBalanceRewardRequest)(nil),             // 35: api.SetBalanceRewardRequest
-	(*SetBalanceRewardReply)(nil),               // 36: api.SetBalanceRewardReply
-	(*DeleteBalanceRewardRequest)(nil),          // 37: api.DeleteBalanceRewardRequest
-	(*DeleteBalanceRewardReply)(nil),            // 38: api.DeleteBalanceRewardReply
-	(*AdminRewardListRequest)(nil),              // 39: api.AdminRewardListRequest
-	(*AdminRewardListReply)(nil),                // 40: api.AdminRewardListReply
-	(*AdminUserListRequest)(nil),                // 41: api.AdminUserListRequest
-	(*AdminUserListReply)(nil),                  // 42: api.AdminUserListReply
-	(*AdminLocationListRequest)(nil),            // 43: api.AdminLocationListRequest
-	(*AdminLocationListReply)(nil),              // 44: api.AdminLocationListReply
-	(*AdminWithdrawListRequest)(nil),            // 45: api.AdminWithdrawListRequest
-	(*AdminWithdrawListReply)(nil),              // 46: api.AdminWithdrawListReply
-	(*AdminWithdrawRequest)(nil),                // 47: api.AdminWithdrawRequest
-	(*AdminWithdrawReply)(nil),                  // 48: api.AdminWithdrawReply
-	(*AdminWithdrawEthRequest)(nil),             // 49: api.AdminWithdrawEthRequest
-	(*AdminWithdrawEthReply)(nil),               // 50: api.AdminWithdrawEthReply
-	(*AdminFeeRequest)(nil),                     // 51: api.AdminFeeRequest
-	(*AdminFeeReply)(nil),                       // 52: api.AdminFeeReply
-	(*TokenWithdrawRequest)(nil),                // 53: api.TokenWithdrawRequest
-	(*TokenWithdrawReply)(nil),                  // 54: api.TokenWithdrawReply
-	(*AdminAllRequest)(nil),                     // 55: api.AdminAllRequest
-	(*AdminAllReply)(nil),                       // 56: api.AdminAllReply
-	(*AdminUserRecommendRequest)(nil),           // 57: api.AdminUserRecommendRequest
-	(*AdminUserRecommendReply)(nil),             // 58: api.AdminUserRecommendReply
-	(*AdminMonthRecommendRequest)(nil),          // 59: api.AdminMonthRecommendRequest
-	(*AdminMonthRecommendReply)(nil),            // 60: api.AdminMonthRecommendReply
-	(*AdminConfigRequest)(nil),                  // 61: api.AdminConfigRequest
-	(*AdminConfigReply)(nil),                    // 62: api.AdminConfigReply
-	(*AdminConfigUpdateRequest)(nil),            // 63: api.AdminConfigUpdateRequest
-	(*AdminConfigUpdateReply)(nil),              // 64: api.AdminConfigUpdateReply
-	(*EthAuthorizeRequest_SendBody)(nil),        // 65: api.EthAuthorizeRequest.SendBody
-	(*RecommendUpdateRequest_SendBody)(nil),     // 66: api.RecommendUpdateRequest.SendBody
-	(*UserAreaReply_List)(nil),                  // 67: api.UserAreaReply.List
-	(*UserInfoReply_List)(nil),                  // 68: api.UserInfoReply.List
-	(*UserInfoReply_ListWithdraw)(nil),          // 69: api.UserInfoReply.ListWithdraw
-	(*UserInfoReply_ListFour)(nil),              // 70: api.UserInfoReply.ListFour
-	(*UserInfoReply_ListReward)(nil),            // 71: api.UserInfoReply.ListReward
-	(*UserInfoReply_ListRecommend)(nil),         // 72: api.UserInfoReply.ListRecommend
-	(*UserInfoReply_ListExchange)(nil),          // 73: api.UserInfoReply.ListExchange
-	(*UserInfo1Reply_List)(nil),                 // 74: api.UserInfo1Reply.List
-	(*UserInfo1Reply_List22)(nil),               // 75: api.UserInfo1Reply.List22
-	(*UserInfo1Reply_List2)(nil),                // 76: api.UserInfo1Reply.List2
-	(*UserInfo1Reply_List5)(nil),                // 77: api.UserInfo1Reply.List5
-	(*UserInfo1Reply_List7)(nil),                // 78: api.UserInfo1Reply.List7
-	(*UserInfo1Reply_List11)(nil),               // 79: api.UserInfo1Reply.List11
-	(*UserInfo1Reply_List9)(nil),                // 80: api.UserInfo1Reply.List9
-	(*UserInfo1Reply_List13)(nil),               // 81: api.UserInfo1Reply.List13
-	(*RewardListReply_List)(nil),                // 82: api.RewardListReply.List
-	(*GetTradeRequest_SendBody)(nil),            // 83: api.GetTradeRequest.SendBody
-	(*RecommendRewardListReply_List)(nil),       // 84: api.RecommendRewardListReply.List
-	(*FeeRewardListReply_List)(nil),             // 85: api.FeeRewardListReply.List
-	(*WithdrawListReply_List)(nil),              // 86: api.WithdrawListReply.List
-	(*TradeListReply_List)(nil),                 // 87: api.TradeListReply.List
-	(*TranListReply_List)(nil),                  // 88: api.TranListReply.List
-	(*RecommendListReply_List)(nil),             // 89: api.RecommendListReply.List
-	(*ExchangeRequest_SendBody)(nil),            // 90: api.ExchangeRequest.SendBody
-	(*WithdrawRequest_SendBody)(nil),            // 91: api.WithdrawRequest.SendBody
-	(*PasswordChangeRequest_SendBody)(nil),      // 92: api.PasswordChangeRequest.SendBody
-	(*TranRequest_SendBody)(nil),                // 93: api.TranRequest.SendBody
-	(*SetBalanceRewardRequest_SendBody)(nil),    // 94: api.SetBalanceRewardRequest.SendBody
-	(*DeleteBalanceRewardRequest_SendBody)(nil), // 95: api.DeleteBalanceRewardRequest.SendBody
-	(*AdminRewardListReply_List)(nil),           // 96: api.AdminRewardListReply.List
-	(*AdminUserListReply_UserList)(nil),         // 97: api.AdminUserListReply.UserList
-	(*AdminLocationListReply_LocationList)(nil), // 98: api.AdminLocationListReply.LocationList
-	(*AdminWithdrawListReply_List)(nil),         // 99: api.AdminWithdrawListReply.List
-	(*AdminUserRecommendReply_List)(nil),        // 100: api.AdminUserRecommendReply.List
-	(*AdminMonthRecommendReply_List)(nil),       // 101: api.AdminMonthRecommendReply.List
-	(*AdminConfigReply_List)(nil),               // 102: api.AdminConfigReply.List
-	(*AdminConfigUpdateRequest_SendBody)(nil),   // 103: api.AdminConfigUpdateRequest.SendBody
-}
-var file_api_app_proto_depIdxs = []int32{
-	65,  // 0: api.EthAuthorizeRequest.send_body:type_name -> api.EthAuthorizeRequest.SendBody
-	66,  // 1: api.RecommendUpdateRequest.send_body:type_name -> api.RecommendUpdateRequest.SendBody
-	67,  // 2: api.UserAreaReply.area:type_name -> api.UserAreaReply.List
-	68,  // 3: api.UserInfoReply.LocationList:type_name -> api.UserInfoReply.List
-	69,  // 4: api.UserInfoReply.withdrawList:type_name -> api.UserInfoReply.ListWithdraw
-	70,  // 5: api.UserInfoReply.four:type_name -> api.UserInfoReply.ListFour
-	71,  // 6: api.UserInfoReply.listReward:type_name -> api.UserInfoReply.ListReward
-	72,  // 7: api.UserInfoReply.listRecommend:type_name -> api.UserInfoReply.ListRecommend
-	73,  // 8: api.UserInfoReply.listExchange:type_name -> api.UserInfoReply.ListExchange
-	74,  // 9: api.UserInfo1Reply.LocationList:type_name -> api.UserInfo1Reply.List
-	75,  // 10: api.UserInfo1Reply.LocationList2:type_name -> api.UserInfo1Reply.List22
-	76,  // 11: api.UserInfo1Reply.locationRewardList:type_name -> api.UserInfo1Reply.List2
-	77,  // 12: api.UserInfo1Reply.recommendRewardList:type_name -> api.UserInfo1Reply.List5
-	78,  // 13: api.UserInfo1Reply.teamAddressList:type_name -> api.UserInfo1Reply.List7
-	79,  // 14: api.UserInfo1Reply.recommendAddressList:type_name -> api.UserInfo1Reply.List11
-	80,  // 15: api.UserInfo1Reply.allRewardList:type_name -> api.UserInfo1Reply.List9
-	81,  // 16: api.UserInfo1Reply.depositList:type_name -> api.UserInfo1Reply.List13
-	82,  // 17: api.RewardListReply.rewards:type_name -> api.RewardListReply.List
-	83,  // 18: api.GetTradeRequest.send_body:type_name -> api.GetTradeRequest.SendBody
-	84,  // 19: api.RecommendRewardListReply.rewards:type_name -> api.RecommendRewardListReply.List
-	85,  // 20: api.FeeRewardListReply.rewards:type_name -> api.FeeRewardListReply.List
-	86,  // 21: api.WithdrawListReply.withdraw:type_name -> api.WithdrawListReply.List
-	87,  // 22: api.TradeListReply.trade:type_name -> api.TradeListReply.List
-	88,  // 23: api.TranListReply.tran:type_name -> api.TranListReply.List
-	89,  // 24: api.RecommendListReply.recommends:type_name -> api.RecommendListReply.List
-	90,  // 25: api.ExchangeRequest.send_body:type_name -> api.ExchangeRequest.SendBody
-	91,  // 26: api.WithdrawRequest.send_body:type_name -> api.WithdrawRequest.SendBody
-	92,  // 27: api.PasswordChangeRequest.send_body:type_name -> api.PasswordChangeRequest.SendBody
-	93,  // 28: api.TranRequest.send_body:type_name -> api.TranRequest.SendBody
-	94,  // 29: api.SetBalanceRewardRequest.send_body:type_name -> api.SetBalanceRewardRequest.SendBody
-	95,  // 30: api.DeleteBalanceRewardRequest.send_body:type_name -> api.DeleteBalanceRewardRequest.SendBody
-	96,  // 31: api.AdminRewardListReply.rewards:type_name -> api.AdminRewardListReply.List
-	97,  // 32: api.AdminUserListReply.users:type_name -> api.AdminUserListReply.UserList
-	98,  // 33: api.AdminLocationListReply.locations:type_name -> api.AdminLocationListReply.LocationList
-	99,  // 34: api.AdminWithdrawListReply.withdraw:type_name -> api.AdminWithdrawListReply.List
-	100, // 35: api.AdminUserRecommendReply.users:type_name -> api.AdminUserRecommendReply.List
-	101, // 36: api.AdminMonthRecommendReply.users:type_name -> api.AdminMonthRecommendReply.List
-	102, // 37: api.AdminConfigReply.config:type_name -> api.AdminConfigReply.List
-	103, // 38: api.AdminConfigUpdateRequest.send_body:type_name -> api.AdminConfigUpdateRequest.SendBody
-	0,   // 39: api.App.EthAuthorize:input_type -> api.EthAuthorizeRequest
-	2,   // 40: api.App.RecommendUpdate:input_type -> api.RecommendUpdateRequest
-	8,   // 41: api.App.UserInfo:input_type -> api.UserInfoRequest
-	6,   // 42: api.App.UserArea:input_type -> api.UserAreaRequest
-	11,  // 43: api.App.RewardList:input_type -> api.RewardListRequest
-	15,  // 44: api.App.RecommendRewardList:input_type -> api.RecommendRewardListRequest
-	17,  // 45: api.App.FeeRewardList:input_type -> api.FeeRewardListRequest
-	19,  // 46: api.App.WithdrawList:input_type -> api.WithdrawListRequest
-	21,  // 47: api.App.TradeList:input_type -> api.TradeListRequest
-	23,  // 48: api.App.TranList:input_type -> api.TranListRequest
-	25,  // 49: api.App.RecommendList:input_type -> api.RecommendListRequest
-	31,  // 50: api.App.PasswordChange:input_type -> api.PasswordChangeRequest
-	29,  // 51: api.App.Withdraw:input_type -> api.WithdrawRequest
-	27,  // 52: api.App.Exchange:input_type -> api.ExchangeRequest
-	29,  // 53: api.App.Trade:input_type -> api.WithdrawRequest
-	33,  // 54: api.App.Tran:input_type -> api.TranRequest
-	13,  // 55: api.App.GetTrade:input_type -> api.GetTradeRequest
-	35,  // 56: api.App.SetBalanceReward:input_type -> api.SetBalanceRewardRequest
-	37,  // 57: api.App.DeleteBalanceReward:input_type -> api.DeleteBalanceRewardRequest
-	4,   // 58: api.App.Deposit:input_type -> api.DepositRequest
-	47,  // 59: api.App.AdminWithdraw:input_type -> api.AdminWithdrawRequest
-	49,  // 60: api.App.AdminWithdrawEth:input_type -> api.AdminWithdrawEthRequest
-	51,  // 61: api.App.AdminFee:input_type -> api.AdminFeeRequest
-	53,  // 62: api.App.TokenWithdraw:input_type -> api.TokenWithdrawRequest
-	1,   // 63: api.App.EthAuthorize:output_type -> api.EthAuthorizeReply
-	3,   // 64: api.App.RecommendUpdate:output_type -> api.RecommendUpdateReply
-	9,   // 65: api.App.UserInfo:output_type -> api.UserInfoReply
-	7,   // 66: api.App.UserArea:output_type -> api.UserAreaReply
-	12,  // 67: api.App.RewardList:output_type -> api.RewardListReply
-	16,  // 68: api.App.RecommendRewardList:output_type -> api.RecommendRewardListReply
-	18,  // 69: api.App.FeeRewardList:output_type -> api.FeeRewardListReply
-	20,  // 70: api.App.WithdrawList:output_type -> api.WithdrawListReply
-	22,  // 71: api.App.TradeList:output_type -> api.TradeListReply
-	24,  // 72: api.App.TranList:output_type -> api.TranListReply
-	26,  // 73: api.App.RecommendList:output_type -> api.RecommendListReply
-	32,  // 74: api.App.PasswordChange:output_type -> api.PasswordChangeReply
-	30,  // 75: api.App.Withdraw:output_type -> api.WithdrawReply
-	28,  // 76: api.App.Exchange:output_type -> api.ExchangeReply
-	30,  // 77: api.App.Trade:output_type -> api.WithdrawReply
-	34,  // 78: api.App.Tran:output_type -> api.TranReply
-	14,  // 79: api.App.GetTrade:output_type -> api.GetTradeReply
-	36,  // 80: api.App.SetBalanceReward:output_type -> api.SetBalanceRewardReply
-	38,  // 81: api.App.DeleteBalanceReward:output_type -> api.DeleteBalanceRewardReply
-	5,   // 82: api.App.Deposit:output_type -> api.DepositReply
-	48,  // 83: api.App.AdminWithdraw:output_type -> api.AdminWithdrawReply
-	50,  // 84: api.App.AdminWithdrawEth:output_type -> api.AdminWithdrawEthReply
-	52,  // 85: api.App.AdminFee:output_type -> api.AdminFeeReply
-	54,  // 86: api.App.TokenWithdraw:output_type -> api.TokenWithdrawReply
-	63,  // [63:87] is the sub-list for method output_type
-	39,  // [39:63] is the sub-list for method input_type
-	39,  // [39:39] is the sub-list for extension type_name
-	39,  // [39:39] is the sub-list for extension extendee
-	0,   // [0:39] is the sub-list for field type_name
-}
-
-func init() { file_api_app_proto_init() }
-func file_api_app_proto_init() {
-	if File_api_app_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_api_app_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EthAuthorizeRequest); i {
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminAllRequest.ProtoReflect.Descriptor instead.
+func (*AdminAllRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{108}
+}
+
+type AdminAllReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TodayTotalUser        int64  `protobuf:"varint,1,opt,name=todayTotalUser,proto3" json:"todayTotalUser,omitempty"`
+	TotalUser             int64  `protobuf:"varint,2,opt,name=totalUser,proto3" json:"totalUser,omitempty"`
+	AllBalance            string `protobuf:"bytes,3,opt,name=allBalance,proto3" json:"allBalance,omitempty"`
+	TodayLocation         string `protobuf:"bytes,4,opt,name=todayLocation,proto3" json:"todayLocation,omitempty"`
+	AllLocation           string `protobuf:"bytes,5,opt,name=allLocation,proto3" json:"allLocation,omitempty"`
+	TodayWithdraw         string `protobuf:"bytes,6,opt,name=todayWithdraw,proto3" json:"todayWithdraw,omitempty"`
+	AllWithdraw           string `protobuf:"bytes,7,opt,name=allWithdraw,proto3" json:"allWithdraw,omitempty"`
+	AllReward             string `protobuf:"bytes,8,opt,name=allReward,proto3" json:"allReward,omitempty"`
+	AllSystemRewardAndFee string `protobuf:"bytes,9,opt,name=allSystemRewardAndFee,proto3" json:"allSystemRewardAndFee,omitempty"`
+}
+
+func (x *AdminAllReply) Reset() {
+	*x = AdminAllReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminAllReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminAllReply) ProtoMessage() {}
+
+func (x *AdminAllReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminAllReply.ProtoReflect.Descriptor instead.
+func (*AdminAllReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *AdminAllReply) GetTodayTotalUser() int64 {
+	if x != nil {
+		return x.TodayTotalUser
+	}
+	return 0
+}
+
+func (x *AdminAllReply) GetTotalUser() int64 {
+	if x != nil {
+		return x.TotalUser
+	}
+	return 0
+}
+
+func (x *AdminAllReply) GetAllBalance() string {
+	if x != nil {
+		return x.AllBalance
+	}
+	return ""
+}
+
+func (x *AdminAllReply) GetTodayLocation() string {
+	if x != nil {
+		return x.TodayLocation
+	}
+	return ""
+}
+
+func (x *AdminAllReply) GetAllLocation() string {
+	if x != nil {
+		return x.AllLocation
+	}
+	return ""
+}
+
+func (x *AdminAllReply) GetTodayWithdraw() string {
+	if x != nil {
+		return x.TodayWithdraw
+	}
+	return ""
+}
+
+func (x *AdminAllReply) GetAllWithdraw() string {
+	if x != nil {
+		return x.AllWithdraw
+	}
+	return ""
+}
+
+func (x *AdminAllReply) GetAllReward() string {
+	if x != nil {
+		return x.AllReward
+	}
+	return ""
+}
+
+func (x *AdminAllReply) GetAllSystemRewardAndFee() string {
+	if x != nil {
+		return x.AllSystemRewardAndFee
+	}
+	return ""
+}
+
+type AdminUserRecommendRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *AdminUserRecommendRequest) Reset() {
+	*x = AdminUserRecommendRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminUserRecommendRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminUserRecommendRequest) ProtoMessage() {}
+
+func (x *AdminUserRecommendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminUserRecommendRequest.ProtoReflect.Descriptor instead.
+func (*AdminUserRecommendRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *AdminUserRecommendRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type AdminUserRecommendReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Users []*AdminUserRecommendReply_List `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+}
+
+func (x *AdminUserRecommendReply) Reset() {
+	*x = AdminUserRecommendReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminUserRecommendReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminUserRecommendReply) ProtoMessage() {}
+
+func (x *AdminUserRecommendReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminUserRecommendReply.ProtoReflect.Descriptor instead.
+func (*AdminUserRecommendReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *AdminUserRecommendReply) GetUsers() []*AdminUserRecommendReply_List {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type AdminMonthRecommendRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Page    int64  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+}
+
+func (x *AdminMonthRecommendRequest) Reset() {
+	*x = AdminMonthRecommendRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminMonthRecommendRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminMonthRecommendRequest) ProtoMessage() {}
+
+func (x *AdminMonthRecommendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminMonthRecommendRequest.ProtoReflect.Descriptor instead.
+func (*AdminMonthRecommendRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *AdminMonthRecommendRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AdminMonthRecommendRequest) GetPage() int64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+type AdminMonthRecommendReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Users []*AdminMonthRecommendReply_List `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	Count int64                            `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *AdminMonthRecommendReply) Reset() {
+	*x = AdminMonthRecommendReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminMonthRecommendReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminMonthRecommendReply) ProtoMessage() {}
+
+func (x *AdminMonthRecommendReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminMonthRecommendReply.ProtoReflect.Descriptor instead.
+func (*AdminMonthRecommendReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *AdminMonthRecommendReply) GetUsers() []*AdminMonthRecommendReply_List {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *AdminMonthRecommendReply) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type AdminConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64 `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Page   int64 `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+}
+
+func (x *AdminConfigRequest) Reset() {
+	*x = AdminConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminConfigRequest) ProtoMessage() {}
+
+func (x *AdminConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminConfigRequest.ProtoReflect.Descriptor instead.
+func (*AdminConfigRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *AdminConfigRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AdminConfigRequest) GetPage() int64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+type AdminConfigReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Config []*AdminConfigReply_List `protobuf:"bytes,1,rep,name=config,proto3" json:"config,omitempty"`
+	Count  int64                    `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *AdminConfigReply) Reset() {
+	*x = AdminConfigReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminConfigReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminConfigReply) ProtoMessage() {}
+
+func (x *AdminConfigReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminConfigReply.ProtoReflect.Descriptor instead.
+func (*AdminConfigReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *AdminConfigReply) GetConfig() []*AdminConfigReply_List {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *AdminConfigReply) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type AdminConfigUpdateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SendBody *AdminConfigUpdateRequest_SendBody `protobuf:"bytes,1,opt,name=send_body,json=sendBody,proto3" json:"send_body,omitempty"`
+}
+
+func (x *AdminConfigUpdateRequest) Reset() {
+	*x = AdminConfigUpdateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminConfigUpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminConfigUpdateRequest) ProtoMessage() {}
+
+func (x *AdminConfigUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminConfigUpdateRequest.ProtoReflect.Descriptor instead.
+func (*AdminConfigUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *AdminConfigUpdateRequest) GetSendBody() *AdminConfigUpdateRequest_SendBody {
+	if x != nil {
+		return x.SendBody
+	}
+	return nil
+}
+
+type AdminConfigUpdateReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AdminConfigUpdateReply) Reset() {
+	*x = AdminConfigUpdateReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminConfigUpdateReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminConfigUpdateReply) ProtoMessage() {}
+
+func (x *AdminConfigUpdateReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminConfigUpdateReply.ProtoReflect.Descriptor instead.
+func (*AdminConfigUpdateReply) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{117}
+}
+
+type EthAuthorizeRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address  string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Code     string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Password string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	Sign     string `protobuf:"bytes,4,opt,name=sign,proto3" json:"sign,omitempty"`
+}
+
+func (x *EthAuthorizeRequest_SendBody) Reset() {
+	*x = EthAuthorizeRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[118]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EthAuthorizeRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EthAuthorizeRequest_SendBody) ProtoMessage() {}
+
+func (x *EthAuthorizeRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[118]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EthAuthorizeRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*EthAuthorizeRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *EthAuthorizeRequest_SendBody) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *EthAuthorizeRequest_SendBody) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *EthAuthorizeRequest_SendBody) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *EthAuthorizeRequest_SendBody) GetSign() string {
+	if x != nil {
+		return x.Sign
+	}
+	return ""
+}
+
+type RecommendUpdateRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Sign string `protobuf:"bytes,2,opt,name=sign,proto3" json:"sign,omitempty"`
+}
+
+func (x *RecommendUpdateRequest_SendBody) Reset() {
+	*x = RecommendUpdateRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[119]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecommendUpdateRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecommendUpdateRequest_SendBody) ProtoMessage() {}
+
+func (x *RecommendUpdateRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[119]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecommendUpdateRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*RecommendUpdateRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{2, 0}
+}
+
+func (x *RecommendUpdateRequest_SendBody) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *RecommendUpdateRequest_SendBody) GetSign() string {
+	if x != nil {
+		return x.Sign
+	}
+	return ""
+}
+
+type UserAreaReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address    string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"` // 收益
+	LocationId int64  `protobuf:"varint,3,opt,name=locationId,proto3" json:"locationId,omitempty"`
+	CountLow   int64  `protobuf:"varint,4,opt,name=countLow,proto3" json:"countLow,omitempty"` // 直接下级数量
+	Status     string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	Active     bool   `protobuf:"varint,6,opt,name=active,proto3" json:"active,omitempty"`
+	Volume     string `protobuf:"bytes,7,opt,name=volume,proto3" json:"volume,omitempty"` // 自己和下级的仓位金额
+	Depth      int64  `protobuf:"varint,8,opt,name=depth,proto3" json:"depth,omitempty"`
+	Top        int64  `protobuf:"varint,9,opt,name=top,proto3" json:"top,omitempty"` // 上级仓位
+}
+
+func (x *UserAreaReply_List) Reset() {
+	*x = UserAreaReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[120]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserAreaReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserAreaReply_List) ProtoMessage() {}
+
+func (x *UserAreaReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[120]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserAreaReply_List.ProtoReflect.Descriptor instead.
+func (*UserAreaReply_List) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{7, 0}
+}
+
+func (x *UserAreaReply_List) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *UserAreaReply_List) GetLocationId() int64 {
+	if x != nil {
+		return x.LocationId
+	}
+	return 0
+}
+
+func (x *UserAreaReply_List) GetCountLow() int64 {
+	if x != nil {
+		return x.CountLow
+	}
+	return 0
+}
+
+func (x *UserAreaReply_List) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *UserAreaReply_List) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *UserAreaReply_List) GetVolume() string {
+	if x != nil {
+		return x.Volume
+	}
+	return ""
+}
+
+func (x *UserAreaReply_List) GetDepth() int64 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *UserAreaReply_List) GetTop() int64 {
+	if x != nil {
+		return x.Top
+	}
+	return 0
+}
+
+type UserInfoReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Current              string `protobuf:"bytes,2,opt,name=current,proto3" json:"current,omitempty"`                           // 收益
+	CurrentMaxSubCurrent string `protobuf:"bytes,4,opt,name=currentMaxSubCurrent,proto3" json:"currentMaxSubCurrent,omitempty"` // 挖矿
+	Amount               string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`                             // 节点
+}
+
+func (x *UserInfoReply_List) Reset() {
+	*x = UserInfoReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[121]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserInfoReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserInfoReply_List) ProtoMessage() {}
+
+func (x *UserInfoReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[121]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserInfoReply_List.ProtoReflect.Descriptor instead.
+func (*UserInfoReply_List) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{9, 0}
+}
+
+func (x *UserInfoReply_List) GetCurrent() string {
+	if x != nil {
+		return x.Current
+	}
+	return ""
+}
+
+func (x *UserInfoReply_List) GetCurrentMaxSubCurrent() string {
+	if x != nil {
+		return x.CurrentMaxSubCurrent
+	}
+	return ""
+}
+
+func (x *UserInfoReply_List) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type UserInfoReply_ListWithdraw struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Amount   string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`     // 提现金额
+	CreateAt string `protobuf:"bytes,1,opt,name=createAt,proto3" json:"createAt,omitempty"` // 提现时间
+}
+
+func (x *UserInfoReply_ListWithdraw) Reset() {
+	*x = UserInfoReply_ListWithdraw{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[122]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserInfoReply_ListWithdraw) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserInfoReply_ListWithdraw) ProtoMessage() {}
+
+func (x *UserInfoReply_ListWithdraw) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[122]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserInfoReply_ListWithdraw.ProtoReflect.Descriptor instead.
+func (*UserInfoReply_ListWithdraw) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{9, 1}
+}
+
+func (x *UserInfoReply_ListWithdraw) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *UserInfoReply_ListWithdraw) GetCreateAt() string {
+	if x != nil {
+		return x.CreateAt
+	}
+	return ""
+}
+
+type UserInfoReply_ListFour struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Amount   string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"` // 直推金额
+	Reward   string `protobuf:"bytes,3,opt,name=reward,proto3" json:"reward,omitempty"` // 奖励
+	Location string `protobuf:"bytes,4,opt,name=location,proto3" json:"location,omitempty"`
+}
+
+func (x *UserInfoReply_ListFour) Reset() {
+	*x = UserInfoReply_ListFour{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[123]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserInfoReply_ListFour) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserInfoReply_ListFour) ProtoMessage() {}
+
+func (x *UserInfoReply_ListFour) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[123]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserInfoReply_ListFour.ProtoReflect.Descriptor instead.
+func (*UserInfoReply_ListFour) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{9, 2}
+}
+
+func (x *UserInfoReply_ListFour) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *UserInfoReply_ListFour) GetReward() string {
+	if x != nil {
+		return x.Reward
+	}
+	return ""
+}
+
+func (x *UserInfoReply_ListFour) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+type UserInfoReply_ListReward struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreatedAt  string `protobuf:"bytes,1,opt,name=createdAt,proto3" json:"createdAt,omitempty"`   // 时间
+	Type       int64  `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"`            // 类型，挖矿收益 type=1,分享 type=2, 前四名type =3,矩阵type=4
+	Reward     string `protobuf:"bytes,3,opt,name=reward,proto3" json:"reward,omitempty"`         // 数量
+	RewardUsdt string `protobuf:"bytes,4,opt,name=rewardUsdt,proto3" json:"rewardUsdt,omitempty"` // 数量
+}
+
+func (x *UserInfoReply_ListReward) Reset() {
+	*x = UserInfoReply_ListReward{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[124]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserInfoReply_ListReward) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserInfoReply_ListReward) ProtoMessage() {}
+
+func (x *UserInfoReply_ListReward) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[124]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserInfoReply_ListReward.ProtoReflect.Descriptor instead.
+func (*UserInfoReply_ListReward) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{9, 3}
+}
+
+func (x *UserInfoReply_ListReward) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *UserInfoReply_ListReward) GetType() int64 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *UserInfoReply_ListReward) GetReward() string {
+	if x != nil {
+		return x.Reward
+	}
+	return ""
+}
+
+func (x *UserInfoReply_ListReward) GetRewardUsdt() string {
+	if x != nil {
+		return x.RewardUsdt
+	}
+	return ""
+}
+
+type UserInfoReply_ListRecommend struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"` // 地址
+}
+
+func (x *UserInfoReply_ListRecommend) Reset() {
+	*x = UserInfoReply_ListRecommend{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[125]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserInfoReply_ListRecommend) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserInfoReply_ListRecommend) ProtoMessage() {}
+
+func (x *UserInfoReply_ListRecommend) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[125]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserInfoReply_ListRecommend.ProtoReflect.Descriptor instead.
+func (*UserInfoReply_ListRecommend) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{9, 4}
+}
+
+func (x *UserInfoReply_ListRecommend) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type UserInfoReply_ListExchange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Amount     string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	UsdtAmount string `protobuf:"bytes,3,opt,name=usdtAmount,proto3" json:"usdtAmount,omitempty"`
+	Created    string `protobuf:"bytes,2,opt,name=created,proto3" json:"created,omitempty"`
+}
+
+func (x *UserInfoReply_ListExchange) Reset() {
+	*x = UserInfoReply_ListExchange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[126]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserInfoReply_ListExchange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserInfoReply_ListExchange) ProtoMessage() {}
+
+func (x *UserInfoReply_ListExchange) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[126]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserInfoReply_ListExchange.ProtoReflect.Descriptor instead.
+func (*UserInfoReply_ListExchange) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{9, 5}
+}
+
+func (x *UserInfoReply_ListExchange) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *UserInfoReply_ListExchange) GetUsdtAmount() string {
+	if x != nil {
+		return x.UsdtAmount
+	}
+	return ""
+}
+
+func (x *UserInfoReply_ListExchange) GetCreated() string {
+	if x != nil {
+		return x.Created
+	}
+	return ""
+}
+
+type UserInfo1Reply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Amount    string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	AmountMax string `protobuf:"bytes,4,opt,name=amountMax,proto3" json:"amountMax,omitempty"`
+	Num       int64  `protobuf:"varint,5,opt,name=Num,proto3" json:"Num,omitempty"`
+}
+
+func (x *UserInfo1Reply_List) Reset() {
+	*x = UserInfo1Reply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[127]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserInfo1Reply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserInfo1Reply_List) ProtoMessage() {}
+
+func (x *UserInfo1Reply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[127]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserInfo1Reply_List.ProtoReflect.Descriptor instead.
+func (*UserInfo1Reply_List) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{10, 0}
+}
+
+func (x *UserInfo1Reply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *UserInfo1Reply_List) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *UserInfo1Reply_List) GetAmountMax() string {
+	if x != nil {
+		return x.AmountMax
+	}
+	return ""
+}
+
+func (x *UserInfo1Reply_List) GetNum() int64 {
+	if x != nil {
+		return x.Num
+	}
+	return 0
+}
+
+type UserInfo1Reply_List22 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Amount    string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	AmountMax string `protobuf:"bytes,4,opt,name=amountMax,proto3" json:"amountMax,omitempty"`
+}
+
+func (x *UserInfo1Reply_List22) Reset() {
+	*x = UserInfo1Reply_List22{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[128]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserInfo1Reply_List22) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserInfo1Reply_List22) ProtoMessage() {}
+
+func (x *UserInfo1Reply_List22) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[128]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserInfo1Reply_List22.ProtoReflect.Descriptor instead.
+func (*UserInfo1Reply_List22) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{10, 1}
+}
+
+func (x *UserInfo1Reply_List22) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *UserInfo1Reply_List22) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *UserInfo1Reply_List22) GetAmountMax() string {
+	if x != nil {
+		return x.AmountMax
+	}
+	return ""
+}
+
+type UserInfo1Reply_List2 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Amount    string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *UserInfo1Reply_List2) Reset() {
+	*x = UserInfo1Reply_List2{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[129]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserInfo1Reply_List2) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserInfo1Reply_List2) ProtoMessage() {}
+
+func (x *UserInfo1Reply_List2) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[129]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserInfo1Reply_List2.ProtoReflect.Descriptor instead.
+func (*UserInfo1Reply_List2) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{10, 2}
+}
+
+func (x *UserInfo1Reply_List2) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *UserInfo1Reply_List2) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type UserInfo1Reply_List5 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Amount    string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *UserInfo1Reply_List5) Reset() {
+	*x = UserInfo1Reply_List5{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[130]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserInfo1Reply_List5) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserInfo1Reply_List5) ProtoMessage() {}
+
+func (x *UserInfo1Reply_List5) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[130]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserInfo1Reply_List5.ProtoReflect.Descriptor instead.
+func (*UserInfo1Reply_List5) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{10, 3}
+}
+
+func (x *UserInfo1Reply_List5) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *UserInfo1Reply_List5) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type UserInfo1Reply_List7 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Amount  string `protobuf:"bytes,2,opt,name=Amount,proto3" json:"Amount,omitempty"`
+	Usdt    string `protobuf:"bytes,3,opt,name=Usdt,proto3" json:"Usdt,omitempty"`
+	Vip     int64  `protobuf:"varint,4,opt,name=Vip,proto3" json:"Vip,omitempty"`
+}
+
+func (x *UserInfo1Reply_List7) Reset() {
+	*x = UserInfo1Reply_List7{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[131]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserInfo1Reply_List7) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserInfo1Reply_List7) ProtoMessage() {}
+
+func (x *UserInfo1Reply_List7) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[131]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserInfo1Reply_List7.ProtoReflect.Descriptor instead.
+func (*UserInfo1Reply_List7) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{10, 4}
+}
+
+func (x *UserInfo1Reply_List7) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *UserInfo1Reply_List7) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *UserInfo1Reply_List7) GetUsdt() string {
+	if x != nil {
+		return x.Usdt
+	}
+	return ""
+}
+
+func (x *UserInfo1Reply_List7) GetVip() int64 {
+	if x != nil {
+		return x.Vip
+	}
+	return 0
+}
+
+type UserInfo1Reply_List11 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Amount  string `protobuf:"bytes,2,opt,name=Amount,proto3" json:"Amount,omitempty"`
+	Usdt    string `protobuf:"bytes,3,opt,name=Usdt,proto3" json:"Usdt,omitempty"`
+	Vip     int64  `protobuf:"varint,4,opt,name=Vip,proto3" json:"Vip,omitempty"`
+}
+
+func (x *UserInfo1Reply_List11) Reset() {
+	*x = UserInfo1Reply_List11{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[132]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserInfo1Reply_List11) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserInfo1Reply_List11) ProtoMessage() {}
+
+func (x *UserInfo1Reply_List11) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[132]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserInfo1Reply_List11.ProtoReflect.Descriptor instead.
+func (*UserInfo1Reply_List11) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{10, 5}
+}
+
+func (x *UserInfo1Reply_List11) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *UserInfo1Reply_List11) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *UserInfo1Reply_List11) GetUsdt() string {
+	if x != nil {
+		return x.Usdt
+	}
+	return ""
+}
+
+func (x *UserInfo1Reply_List11) GetVip() int64 {
+	if x != nil {
+		return x.Vip
+	}
+	return 0
+}
+
+type UserInfo1Reply_List9 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Amount    string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *UserInfo1Reply_List9) Reset() {
+	*x = UserInfo1Reply_List9{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[133]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserInfo1Reply_List9) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserInfo1Reply_List9) ProtoMessage() {}
+
+func (x *UserInfo1Reply_List9) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[133]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserInfo1Reply_List9.ProtoReflect.Descriptor instead.
+func (*UserInfo1Reply_List9) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{10, 6}
+}
+
+func (x *UserInfo1Reply_List9) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *UserInfo1Reply_List9) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type UserInfo1Reply_List13 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Amount    string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *UserInfo1Reply_List13) Reset() {
+	*x = UserInfo1Reply_List13{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[134]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserInfo1Reply_List13) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserInfo1Reply_List13) ProtoMessage() {}
+
+func (x *UserInfo1Reply_List13) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[134]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserInfo1Reply_List13.ProtoReflect.Descriptor instead.
+func (*UserInfo1Reply_List13) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{10, 7}
+}
+
+func (x *UserInfo1Reply_List13) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *UserInfo1Reply_List13) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type RewardListReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreatedAt      string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Amount         string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	LocationStatus string `protobuf:"bytes,3,opt,name=locationStatus,proto3" json:"locationStatus,omitempty"`
+	Type           string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+}
+
+func (x *RewardListReply_List) Reset() {
+	*x = RewardListReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[135]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RewardListReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RewardListReply_List) ProtoMessage() {}
+
+func (x *RewardListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[135]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RewardListReply_List.ProtoReflect.Descriptor instead.
+func (*RewardListReply_List) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{12, 0}
+}
+
+func (x *RewardListReply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *RewardListReply_List) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *RewardListReply_List) GetLocationStatus() string {
+	if x != nil {
+		return x.LocationStatus
+	}
+	return ""
+}
+
+func (x *RewardListReply_List) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+type GetTradeRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Amount string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *GetTradeRequest_SendBody) Reset() {
+	*x = GetTradeRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[136]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTradeRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTradeRequest_SendBody) ProtoMessage() {}
+
+func (x *GetTradeRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[136]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTradeRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*GetTradeRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{13, 0}
+}
+
+func (x *GetTradeRequest_SendBody) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type RecommendRewardListReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Amount    string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Type      string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Reason    string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *RecommendRewardListReply_List) Reset() {
+	*x = RecommendRewardListReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[137]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecommendRewardListReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecommendRewardListReply_List) ProtoMessage() {}
+
+func (x *RecommendRewardListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[137]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecommendRewardListReply_List.ProtoReflect.Descriptor instead.
+func (*RecommendRewardListReply_List) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{16, 0}
+}
+
+func (x *RecommendRewardListReply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *RecommendRewardListReply_List) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *RecommendRewardListReply_List) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *RecommendRewardListReply_List) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type FeeRewardListReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Amount    string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *FeeRewardListReply_List) Reset() {
+	*x = FeeRewardListReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[138]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeeRewardListReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeeRewardListReply_List) ProtoMessage() {}
+
+func (x *FeeRewardListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[138]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeeRewardListReply_List.ProtoReflect.Descriptor instead.
+func (*FeeRewardListReply_List) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{18, 0}
+}
+
+func (x *FeeRewardListReply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *FeeRewardListReply_List) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type WithdrawListReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Amount    string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Type      string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Status    string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	RelAmount string `protobuf:"bytes,5,opt,name=rel_amount,json=relAmount,proto3" json:"rel_amount,omitempty"` // 实际到账
+	Fee       string `protobuf:"bytes,6,opt,name=fee,proto3" json:"fee,omitempty"`                              // 手续费，含分佣
+	Burn      string `protobuf:"bytes,7,opt,name=burn,proto3" json:"burn,omitempty"`                            // 销毁
+}
+
+func (x *WithdrawListReply_List) Reset() {
+	*x = WithdrawListReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[139]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WithdrawListReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithdrawListReply_List) ProtoMessage() {}
+
+func (x *WithdrawListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[139]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WithdrawListReply_List.ProtoReflect.Descriptor instead.
+func (*WithdrawListReply_List) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{20, 0}
+}
+
+func (x *WithdrawListReply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *WithdrawListReply_List) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *WithdrawListReply_List) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *WithdrawListReply_List) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *WithdrawListReply_List) GetRelAmount() string {
+	if x != nil {
+		return x.RelAmount
+	}
+	return ""
+}
+
+func (x *WithdrawListReply_List) GetFee() string {
+	if x != nil {
+		return x.Fee
+	}
+	return ""
+}
+
+func (x *WithdrawListReply_List) GetBurn() string {
+	if x != nil {
+		return x.Burn
+	}
+	return ""
+}
+
+type TradeListReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	AmountCsd string `protobuf:"bytes,2,opt,name=amountCsd,proto3" json:"amountCsd,omitempty"`
+	AmountHbs string `protobuf:"bytes,5,opt,name=amountHbs,proto3" json:"amountHbs,omitempty"`
+	Status    string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *TradeListReply_List) Reset() {
+	*x = TradeListReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[140]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TradeListReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TradeListReply_List) ProtoMessage() {}
+
+func (x *TradeListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[140]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TradeListReply_List.ProtoReflect.Descriptor instead.
+func (*TradeListReply_List) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{22, 0}
+}
+
+func (x *TradeListReply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *TradeListReply_List) GetAmountCsd() string {
+	if x != nil {
+		return x.AmountCsd
+	}
+	return ""
+}
+
+func (x *TradeListReply_List) GetAmountHbs() string {
+	if x != nil {
+		return x.AmountHbs
+	}
+	return ""
+}
+
+func (x *TradeListReply_List) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type TranListReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Amount    string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *TranListReply_List) Reset() {
+	*x = TranListReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[141]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TranListReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TranListReply_List) ProtoMessage() {}
+
+func (x *TranListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[141]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TranListReply_List.ProtoReflect.Descriptor instead.
+func (*TranListReply_List) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{24, 0}
+}
+
+func (x *TranListReply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *TranListReply_List) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type HistoryReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type      string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Reason    string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Coin      string `protobuf:"bytes,4,opt,name=coin,proto3" json:"coin,omitempty"`
+	Status    string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	Amount    string `protobuf:"bytes,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	AmountB   string `protobuf:"bytes,7,opt,name=amountB,proto3" json:"amountB,omitempty"` // reward: amount_b，withdraw: 实际到账，balance: 余额，trade: hbs
+	CreatedAt string `protobuf:"bytes,8,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	Address   string `protobuf:"bytes,9,opt,name=address,proto3" json:"address,omitempty"` // withdraw: 提现地址
+	Fee       string `protobuf:"bytes,10,opt,name=fee,proto3" json:"fee,omitempty"`        // withdraw: 手续费，含分佣
+	Burn      string `protobuf:"bytes,11,opt,name=burn,proto3" json:"burn,omitempty"`      // withdraw: 销毁
+}
+
+func (x *HistoryReply_List) Reset() {
+	*x = HistoryReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[142]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HistoryReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HistoryReply_List) ProtoMessage() {}
+
+func (x *HistoryReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[142]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HistoryReply_List.ProtoReflect.Descriptor instead.
+func (*HistoryReply_List) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{28, 0}
+}
+
+func (x *HistoryReply_List) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *HistoryReply_List) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *HistoryReply_List) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *HistoryReply_List) GetCoin() string {
+	if x != nil {
+		return x.Coin
+	}
+	return ""
+}
+
+func (x *HistoryReply_List) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *HistoryReply_List) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *HistoryReply_List) GetAmountB() string {
+	if x != nil {
+		return x.AmountB
+	}
+	return ""
+}
+
+func (x *HistoryReply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *HistoryReply_List) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *HistoryReply_List) GetFee() string {
+	if x != nil {
+		return x.Fee
+	}
+	return ""
+}
+
+func (x *HistoryReply_List) GetBurn() string {
+	if x != nil {
+		return x.Burn
+	}
+	return ""
+}
+
+type CreateStatementRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Start  string `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`   // 开始业务日 2006-01-02
+	End    string `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`       // 结束业务日 2006-01-02，含当天
+	Format string `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"` // csv pdf
+}
+
+func (x *CreateStatementRequest_SendBody) Reset() {
+	*x = CreateStatementRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[143]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateStatementRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateStatementRequest_SendBody) ProtoMessage() {}
+
+func (x *CreateStatementRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[143]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateStatementRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*CreateStatementRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{29, 0}
+}
+
+func (x *CreateStatementRequest_SendBody) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *CreateStatementRequest_SendBody) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+func (x *CreateStatementRequest_SendBody) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+type RecommendListReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Amount string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Status string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *RecommendListReply_List) Reset() {
+	*x = RecommendListReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[144]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecommendListReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecommendListReply_List) ProtoMessage() {}
+
+func (x *RecommendListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[144]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecommendListReply_List.ProtoReflect.Descriptor instead.
+func (*RecommendListReply_List) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{33, 0}
+}
+
+func (x *RecommendListReply_List) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *RecommendListReply_List) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ExchangeRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sign   string `protobuf:"bytes,2,opt,name=sign,proto3" json:"sign,omitempty"`
+	Amount string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *ExchangeRequest_SendBody) Reset() {
+	*x = ExchangeRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[145]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExchangeRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExchangeRequest_SendBody) ProtoMessage() {}
+
+func (x *ExchangeRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[145]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExchangeRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*ExchangeRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{34, 0}
+}
+
+func (x *ExchangeRequest_SendBody) GetSign() string {
+	if x != nil {
+		return x.Sign
+	}
+	return ""
+}
+
+func (x *ExchangeRequest_SendBody) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type BuyLocationRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sign     string `protobuf:"bytes,1,opt,name=sign,proto3" json:"sign,omitempty"`
+	Num      int64  `protobuf:"varint,2,opt,name=num,proto3" json:"num,omitempty"` // 套餐编号 1-6，复投时不传则沿用原套餐
+	Reinvest bool   `protobuf:"varint,3,opt,name=reinvest,proto3" json:"reinvest,omitempty"`
+}
+
+func (x *BuyLocationRequest_SendBody) Reset() {
+	*x = BuyLocationRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[146]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuyLocationRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuyLocationRequest_SendBody) ProtoMessage() {}
+
+func (x *BuyLocationRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[146]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuyLocationRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*BuyLocationRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{36, 0}
+}
+
+func (x *BuyLocationRequest_SendBody) GetSign() string {
+	if x != nil {
+		return x.Sign
+	}
+	return ""
+}
+
+func (x *BuyLocationRequest_SendBody) GetNum() int64 {
+	if x != nil {
+		return x.Num
+	}
+	return 0
+}
+
+func (x *BuyLocationRequest_SendBody) GetReinvest() bool {
+	if x != nil {
+		return x.Reinvest
+	}
+	return false
+}
+
+type WithdrawRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type      string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Amount    string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Password  string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	Sign      string `protobuf:"bytes,4,opt,name=sign,proto3" json:"sign,omitempty"`
+	AddressId int64  `protobuf:"varint,5,opt,name=addressId,proto3" json:"addressId,omitempty"` // 地址簿id，0 提现到登录地址
+}
+
+func (x *WithdrawRequest_SendBody) Reset() {
+	*x = WithdrawRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[147]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WithdrawRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithdrawRequest_SendBody) ProtoMessage() {}
+
+func (x *WithdrawRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[147]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WithdrawRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*WithdrawRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{38, 0}
+}
+
+func (x *WithdrawRequest_SendBody) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *WithdrawRequest_SendBody) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *WithdrawRequest_SendBody) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *WithdrawRequest_SendBody) GetSign() string {
+	if x != nil {
+		return x.Sign
+	}
+	return ""
+}
+
+func (x *WithdrawRequest_SendBody) GetAddressId() int64 {
+	if x != nil {
+		return x.AddressId
+	}
+	return 0
+}
+
+type PasswordChangeRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+}
+
+func (x *PasswordChangeRequest_SendBody) Reset() {
+	*x = PasswordChangeRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[148]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PasswordChangeRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PasswordChangeRequest_SendBody) ProtoMessage() {}
+
+func (x *PasswordChangeRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[148]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PasswordChangeRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*PasswordChangeRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{40, 0}
+}
+
+func (x *PasswordChangeRequest_SendBody) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type TranRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type     string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Amount   string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Address  string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Password string `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+}
+
+func (x *TranRequest_SendBody) Reset() {
+	*x = TranRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[149]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TranRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TranRequest_SendBody) ProtoMessage() {}
+
+func (x *TranRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[149]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TranRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*TranRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{42, 0}
+}
+
+func (x *TranRequest_SendBody) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *TranRequest_SendBody) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *TranRequest_SendBody) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *TranRequest_SendBody) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type SetBalanceRewardRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Amount string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *SetBalanceRewardRequest_SendBody) Reset() {
+	*x = SetBalanceRewardRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[150]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetBalanceRewardRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetBalanceRewardRequest_SendBody) ProtoMessage() {}
+
+func (x *SetBalanceRewardRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[150]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetBalanceRewardRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*SetBalanceRewardRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{44, 0}
+}
+
+func (x *SetBalanceRewardRequest_SendBody) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type DeleteBalanceRewardRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Amount string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *DeleteBalanceRewardRequest_SendBody) Reset() {
+	*x = DeleteBalanceRewardRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[151]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteBalanceRewardRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBalanceRewardRequest_SendBody) ProtoMessage() {}
+
+func (x *DeleteBalanceRewardRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[151]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBalanceRewardRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*DeleteBalanceRewardRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{46, 0}
+}
+
+func (x *DeleteBalanceRewardRequest_SendBody) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type DeleteBalanceRewardReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Amount   string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Penalty  string `protobuf:"bytes,3,opt,name=penalty,proto3" json:"penalty,omitempty"`
+	Status   string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"` // pending 冷却中 done 已到账
+	MatureAt string `protobuf:"bytes,5,opt,name=matureAt,proto3" json:"matureAt,omitempty"`
+}
+
+func (x *DeleteBalanceRewardReply_List) Reset() {
+	*x = DeleteBalanceRewardReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[152]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteBalanceRewardReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBalanceRewardReply_List) ProtoMessage() {}
+
+func (x *DeleteBalanceRewardReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[152]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBalanceRewardReply_List.ProtoReflect.Descriptor instead.
+func (*DeleteBalanceRewardReply_List) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{47, 0}
+}
+
+func (x *DeleteBalanceRewardReply_List) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeleteBalanceRewardReply_List) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *DeleteBalanceRewardReply_List) GetPenalty() string {
+	if x != nil {
+		return x.Penalty
+	}
+	return ""
+}
+
+func (x *DeleteBalanceRewardReply_List) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DeleteBalanceRewardReply_List) GetMatureAt() string {
+	if x != nil {
+		return x.MatureAt
+	}
+	return ""
+}
+
+type BalanceRewardSummaryReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Amount         string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Rate           int64  `protobuf:"varint,3,opt,name=rate,proto3" json:"rate,omitempty"` // 当前日利率千分比
+	SetDate        string `protobuf:"bytes,4,opt,name=setDate,proto3" json:"setDate,omitempty"`
+	LastRewardDate string `protobuf:"bytes,5,opt,name=lastRewardDate,proto3" json:"lastRewardDate,omitempty"`
+	NextPayoutAt   string `protobuf:"bytes,6,opt,name=nextPayoutAt,proto3" json:"nextPayoutAt,omitempty"`
+}
+
+func (x *BalanceRewardSummaryReply_List) Reset() {
+	*x = BalanceRewardSummaryReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[153]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BalanceRewardSummaryReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BalanceRewardSummaryReply_List) ProtoMessage() {}
+
+func (x *BalanceRewardSummaryReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[153]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BalanceRewardSummaryReply_List.ProtoReflect.Descriptor instead.
+func (*BalanceRewardSummaryReply_List) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{49, 0}
+}
+
+func (x *BalanceRewardSummaryReply_List) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BalanceRewardSummaryReply_List) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *BalanceRewardSummaryReply_List) GetRate() int64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *BalanceRewardSummaryReply_List) GetSetDate() string {
+	if x != nil {
+		return x.SetDate
+	}
+	return ""
+}
+
+func (x *BalanceRewardSummaryReply_List) GetLastRewardDate() string {
+	if x != nil {
+		return x.LastRewardDate
+	}
+	return ""
+}
+
+func (x *BalanceRewardSummaryReply_List) GetNextPayoutAt() string {
+	if x != nil {
+		return x.NextPayoutAt
+	}
+	return ""
+}
+
+type WithdrawAddressListReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Label     string `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Address   string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Active    bool   `protobuf:"varint,4,opt,name=active,proto3" json:"active,omitempty"` // 是否已生效
+	ActiveAt  string `protobuf:"bytes,5,opt,name=activeAt,proto3" json:"activeAt,omitempty"`
+	CreatedAt string `protobuf:"bytes,6,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+}
+
+func (x *WithdrawAddressListReply_List) Reset() {
+	*x = WithdrawAddressListReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[154]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WithdrawAddressListReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithdrawAddressListReply_List) ProtoMessage() {}
+
+func (x *WithdrawAddressListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[154]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WithdrawAddressListReply_List.ProtoReflect.Descriptor instead.
+func (*WithdrawAddressListReply_List) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{51, 0}
+}
+
+func (x *WithdrawAddressListReply_List) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *WithdrawAddressListReply_List) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *WithdrawAddressListReply_List) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *WithdrawAddressListReply_List) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *WithdrawAddressListReply_List) GetActiveAt() string {
+	if x != nil {
+		return x.ActiveAt
+	}
+	return ""
+}
+
+func (x *WithdrawAddressListReply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type WithdrawAddressCreateRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Label   string `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Sign    string `protobuf:"bytes,3,opt,name=sign,proto3" json:"sign,omitempty"`
+}
+
+func (x *WithdrawAddressCreateRequest_SendBody) Reset() {
+	*x = WithdrawAddressCreateRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[155]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WithdrawAddressCreateRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithdrawAddressCreateRequest_SendBody) ProtoMessage() {}
+
+func (x *WithdrawAddressCreateRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[155]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WithdrawAddressCreateRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*WithdrawAddressCreateRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{52, 0}
+}
+
+func (x *WithdrawAddressCreateRequest_SendBody) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *WithdrawAddressCreateRequest_SendBody) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *WithdrawAddressCreateRequest_SendBody) GetSign() string {
+	if x != nil {
+		return x.Sign
+	}
+	return ""
+}
+
+type WithdrawAddressDeleteRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Sign string `protobuf:"bytes,2,opt,name=sign,proto3" json:"sign,omitempty"`
+}
+
+func (x *WithdrawAddressDeleteRequest_SendBody) Reset() {
+	*x = WithdrawAddressDeleteRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[156]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WithdrawAddressDeleteRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithdrawAddressDeleteRequest_SendBody) ProtoMessage() {}
+
+func (x *WithdrawAddressDeleteRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[156]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WithdrawAddressDeleteRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*WithdrawAddressDeleteRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{54, 0}
+}
+
+func (x *WithdrawAddressDeleteRequest_SendBody) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *WithdrawAddressDeleteRequest_SendBody) GetSign() string {
+	if x != nil {
+		return x.Sign
+	}
+	return ""
+}
+
+type InviteCodeListReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code       string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Name       string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Status     string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	UsedNum    int64  `protobuf:"varint,4,opt,name=usedNum,proto3" json:"usedNum,omitempty"`    // 已使用次数
+	MaxUse     int64  `protobuf:"varint,5,opt,name=maxUse,proto3" json:"maxUse,omitempty"`      // 使用上限，0不限制
+	ExpiredAt  string `protobuf:"bytes,6,opt,name=expiredAt,proto3" json:"expiredAt,omitempty"` // 过期时间，空不过期
+	LastUsedAt string `protobuf:"bytes,7,opt,name=lastUsedAt,proto3" json:"lastUsedAt,omitempty"`
+	CreatedAt  string `protobuf:"bytes,8,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+}
+
+func (x *InviteCodeListReply_List) Reset() {
+	*x = InviteCodeListReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[157]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InviteCodeListReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteCodeListReply_List) ProtoMessage() {}
+
+func (x *InviteCodeListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[157]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteCodeListReply_List.ProtoReflect.Descriptor instead.
+func (*InviteCodeListReply_List) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{57, 0}
+}
+
+func (x *InviteCodeListReply_List) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *InviteCodeListReply_List) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InviteCodeListReply_List) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *InviteCodeListReply_List) GetUsedNum() int64 {
+	if x != nil {
+		return x.UsedNum
+	}
+	return 0
+}
+
+func (x *InviteCodeListReply_List) GetMaxUse() int64 {
+	if x != nil {
+		return x.MaxUse
+	}
+	return 0
+}
+
+func (x *InviteCodeListReply_List) GetExpiredAt() string {
+	if x != nil {
+		return x.ExpiredAt
+	}
+	return ""
+}
+
+func (x *InviteCodeListReply_List) GetLastUsedAt() string {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return ""
+}
+
+func (x *InviteCodeListReply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type InviteCodeCreateRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name       string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	MaxUse     int64  `protobuf:"varint,2,opt,name=maxUse,proto3" json:"maxUse,omitempty"`
+	ExpireDays int64  `protobuf:"varint,3,opt,name=expireDays,proto3" json:"expireDays,omitempty"`
+}
+
+func (x *InviteCodeCreateRequest_SendBody) Reset() {
+	*x = InviteCodeCreateRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[158]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InviteCodeCreateRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteCodeCreateRequest_SendBody) ProtoMessage() {}
+
+func (x *InviteCodeCreateRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[158]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteCodeCreateRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*InviteCodeCreateRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{58, 0}
+}
+
+func (x *InviteCodeCreateRequest_SendBody) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InviteCodeCreateRequest_SendBody) GetMaxUse() int64 {
+	if x != nil {
+		return x.MaxUse
+	}
+	return 0
+}
+
+func (x *InviteCodeCreateRequest_SendBody) GetExpireDays() int64 {
+	if x != nil {
+		return x.ExpireDays
+	}
+	return 0
+}
+
+type LocaleChangeRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Locale string `protobuf:"bytes,1,opt,name=locale,proto3" json:"locale,omitempty"`
+}
+
+func (x *LocaleChangeRequest_SendBody) Reset() {
+	*x = LocaleChangeRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[159]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LocaleChangeRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocaleChangeRequest_SendBody) ProtoMessage() {}
+
+func (x *LocaleChangeRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[159]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocaleChangeRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*LocaleChangeRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{62, 0}
+}
+
+func (x *LocaleChangeRequest_SendBody) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+type PushTicketRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PushTicketRequest_SendBody) Reset() {
+	*x = PushTicketRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[160]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PushTicketRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushTicketRequest_SendBody) ProtoMessage() {}
+
+func (x *PushTicketRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[160]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushTicketRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*PushTicketRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{64, 0}
+}
+
+type AdminInviteCodeCreateRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name       string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	MaxUse     int64  `protobuf:"varint,2,opt,name=maxUse,proto3" json:"maxUse,omitempty"`
+	ExpireDays int64  `protobuf:"varint,3,opt,name=expireDays,proto3" json:"expireDays,omitempty"`
+}
+
+func (x *AdminInviteCodeCreateRequest_SendBody) Reset() {
+	*x = AdminInviteCodeCreateRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[161]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminInviteCodeCreateRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminInviteCodeCreateRequest_SendBody) ProtoMessage() {}
+
+func (x *AdminInviteCodeCreateRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[161]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminInviteCodeCreateRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*AdminInviteCodeCreateRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{66, 0}
+}
+
+func (x *AdminInviteCodeCreateRequest_SendBody) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AdminInviteCodeCreateRequest_SendBody) GetMaxUse() int64 {
+	if x != nil {
+		return x.MaxUse
+	}
+	return 0
+}
+
+func (x *AdminInviteCodeCreateRequest_SendBody) GetExpireDays() int64 {
+	if x != nil {
+		return x.ExpireDays
+	}
+	return 0
+}
+
+type AdminPasswordUpdateRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId   int64  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+}
+
+func (x *AdminPasswordUpdateRequest_SendBody) Reset() {
+	*x = AdminPasswordUpdateRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[162]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminPasswordUpdateRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminPasswordUpdateRequest_SendBody) ProtoMessage() {}
+
+func (x *AdminPasswordUpdateRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[162]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminPasswordUpdateRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*AdminPasswordUpdateRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{68, 0}
+}
+
+func (x *AdminPasswordUpdateRequest_SendBody) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AdminPasswordUpdateRequest_SendBody) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type AdminCommissionSimulateReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId  int64  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Level   int64  `protobuf:"varint,3,opt,name=level,proto3" json:"level,omitempty"`
+	Amount  string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Reason  string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	Planned string `protobuf:"bytes,6,opt,name=planned,proto3" json:"planned,omitempty"` // 按比例应得的金额
+}
+
+func (x *AdminCommissionSimulateReply_List) Reset() {
+	*x = AdminCommissionSimulateReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[163]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminCommissionSimulateReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminCommissionSimulateReply_List) ProtoMessage() {}
+
+func (x *AdminCommissionSimulateReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[163]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminCommissionSimulateReply_List.ProtoReflect.Descriptor instead.
+func (*AdminCommissionSimulateReply_List) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{71, 0}
+}
+
+func (x *AdminCommissionSimulateReply_List) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AdminCommissionSimulateReply_List) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AdminCommissionSimulateReply_List) GetLevel() int64 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *AdminCommissionSimulateReply_List) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *AdminCommissionSimulateReply_List) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *AdminCommissionSimulateReply_List) GetPlanned() string {
+	if x != nil {
+		return x.Planned
+	}
+	return ""
+}
+
+type AdminAreaLevelRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BusinessDate string `protobuf:"bytes,1,opt,name=businessDate,proto3" json:"businessDate,omitempty"` // 为空时评定上一个业务日
+}
+
+func (x *AdminAreaLevelRequest_SendBody) Reset() {
+	*x = AdminAreaLevelRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[164]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminAreaLevelRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminAreaLevelRequest_SendBody) ProtoMessage() {}
+
+func (x *AdminAreaLevelRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[164]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminAreaLevelRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*AdminAreaLevelRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{72, 0}
+}
+
+func (x *AdminAreaLevelRequest_SendBody) GetBusinessDate() string {
+	if x != nil {
+		return x.BusinessDate
+	}
+	return ""
+}
+
+type AdminAreaLevelReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId      int64  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Address     string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	OriginLevel int64  `protobuf:"varint,3,opt,name=originLevel,proto3" json:"originLevel,omitempty"`
+	Level       int64  `protobuf:"varint,4,opt,name=level,proto3" json:"level,omitempty"`
+	BigAmount   string `protobuf:"bytes,5,opt,name=bigAmount,proto3" json:"bigAmount,omitempty"`
+	SmallAmount string `protobuf:"bytes,6,opt,name=smallAmount,proto3" json:"smallAmount,omitempty"`
+}
+
+func (x *AdminAreaLevelReply_List) Reset() {
+	*x = AdminAreaLevelReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[165]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminAreaLevelReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminAreaLevelReply_List) ProtoMessage() {}
+
+func (x *AdminAreaLevelReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[165]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminAreaLevelReply_List.ProtoReflect.Descriptor instead.
+func (*AdminAreaLevelReply_List) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{73, 0}
+}
+
+func (x *AdminAreaLevelReply_List) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AdminAreaLevelReply_List) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AdminAreaLevelReply_List) GetOriginLevel() int64 {
+	if x != nil {
+		return x.OriginLevel
+	}
+	return 0
+}
+
+func (x *AdminAreaLevelReply_List) GetLevel() int64 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *AdminAreaLevelReply_List) GetBigAmount() string {
+	if x != nil {
+		return x.BigAmount
+	}
+	return ""
+}
+
+func (x *AdminAreaLevelReply_List) GetSmallAmount() string {
+	if x != nil {
+		return x.SmallAmount
+	}
+	return ""
+}
+
+type AdminJobRunListReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name         string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	BusinessDate string `protobuf:"bytes,3,opt,name=businessDate,proto3" json:"businessDate,omitempty"`
+	Owner        string `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
+	Status       string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	Message      string `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+	StartAt      string `protobuf:"bytes,7,opt,name=startAt,proto3" json:"startAt,omitempty"`
+	EndAt        string `protobuf:"bytes,8,opt,name=endAt,proto3" json:"endAt,omitempty"`
+}
+
+func (x *AdminJobRunListReply_List) Reset() {
+	*x = AdminJobRunListReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[166]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminJobRunListReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminJobRunListReply_List) ProtoMessage() {}
+
+func (x *AdminJobRunListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[166]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminJobRunListReply_List.ProtoReflect.Descriptor instead.
+func (*AdminJobRunListReply_List) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{75, 0}
+}
+
+func (x *AdminJobRunListReply_List) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminJobRunListReply_List) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AdminJobRunListReply_List) GetBusinessDate() string {
+	if x != nil {
+		return x.BusinessDate
+	}
+	return ""
+}
+
+func (x *AdminJobRunListReply_List) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *AdminJobRunListReply_List) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AdminJobRunListReply_List) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *AdminJobRunListReply_List) GetStartAt() string {
+	if x != nil {
+		return x.StartAt
+	}
+	return ""
+}
+
+func (x *AdminJobRunListReply_List) GetEndAt() string {
+	if x != nil {
+		return x.EndAt
+	}
+	return ""
+}
+
+type AdminLocationTransitionListReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LocationId int64  `protobuf:"varint,1,opt,name=locationId,proto3" json:"locationId,omitempty"`
+	UserId     int64  `protobuf:"varint,2,opt,name=userId,proto3" json:"userId,omitempty"`
+	From       string `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	To         string `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	Reason     string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedAt  string `protobuf:"bytes,6,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+}
+
+func (x *AdminLocationTransitionListReply_List) Reset() {
+	*x = AdminLocationTransitionListReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[167]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminLocationTransitionListReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminLocationTransitionListReply_List) ProtoMessage() {}
+
+func (x *AdminLocationTransitionListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[167]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminLocationTransitionListReply_List.ProtoReflect.Descriptor instead.
+func (*AdminLocationTransitionListReply_List) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{77, 0}
+}
+
+func (x *AdminLocationTransitionListReply_List) GetLocationId() int64 {
+	if x != nil {
+		return x.LocationId
+	}
+	return 0
+}
+
+func (x *AdminLocationTransitionListReply_List) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AdminLocationTransitionListReply_List) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *AdminLocationTransitionListReply_List) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *AdminLocationTransitionListReply_List) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *AdminLocationTransitionListReply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type AdminWebhookListReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Url       string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Secret    string `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`
+	Events    string `protobuf:"bytes,4,opt,name=events,proto3" json:"events,omitempty"`
+	Status    string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt string `protobuf:"bytes,6,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+}
+
+func (x *AdminWebhookListReply_List) Reset() {
+	*x = AdminWebhookListReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[168]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminWebhookListReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminWebhookListReply_List) ProtoMessage() {}
+
+func (x *AdminWebhookListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[168]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminWebhookListReply_List.ProtoReflect.Descriptor instead.
+func (*AdminWebhookListReply_List) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{81, 0}
+}
+
+func (x *AdminWebhookListReply_List) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminWebhookListReply_List) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *AdminWebhookListReply_List) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *AdminWebhookListReply_List) GetEvents() string {
+	if x != nil {
+		return x.Events
+	}
+	return ""
+}
+
+func (x *AdminWebhookListReply_List) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AdminWebhookListReply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type AdminWebhookCreateRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Url    string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Secret string `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"` // 为空时生成
+	Events string `protobuf:"bytes,3,opt,name=events,proto3" json:"events,omitempty"` // 逗号分隔，支持 * 和 withdraw.*
+}
+
+func (x *AdminWebhookCreateRequest_SendBody) Reset() {
+	*x = AdminWebhookCreateRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[169]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminWebhookCreateRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminWebhookCreateRequest_SendBody) ProtoMessage() {}
+
+func (x *AdminWebhookCreateRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[169]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminWebhookCreateRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*AdminWebhookCreateRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{82, 0}
+}
+
+func (x *AdminWebhookCreateRequest_SendBody) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *AdminWebhookCreateRequest_SendBody) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *AdminWebhookCreateRequest_SendBody) GetEvents() string {
+	if x != nil {
+		return x.Events
+	}
+	return ""
+}
+
+type AdminWebhookUpdateRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Events string `protobuf:"bytes,2,opt,name=events,proto3" json:"events,omitempty"`
+	Status string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"` // enable disable
+}
+
+func (x *AdminWebhookUpdateRequest_SendBody) Reset() {
+	*x = AdminWebhookUpdateRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[170]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminWebhookUpdateRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminWebhookUpdateRequest_SendBody) ProtoMessage() {}
+
+func (x *AdminWebhookUpdateRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[170]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminWebhookUpdateRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*AdminWebhookUpdateRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{84, 0}
+}
+
+func (x *AdminWebhookUpdateRequest_SendBody) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminWebhookUpdateRequest_SendBody) GetEvents() string {
+	if x != nil {
+		return x.Events
+	}
+	return ""
+}
+
+func (x *AdminWebhookUpdateRequest_SendBody) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type AdminWebhookDeliveryListReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	EventId      int64  `protobuf:"varint,2,opt,name=eventId,proto3" json:"eventId,omitempty"`
+	Event        string `protobuf:"bytes,3,opt,name=event,proto3" json:"event,omitempty"`
+	Payload      string `protobuf:"bytes,4,opt,name=payload,proto3" json:"payload,omitempty"`
+	SubscriberId int64  `protobuf:"varint,5,opt,name=subscriberId,proto3" json:"subscriberId,omitempty"`
+	Status       string `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	Attempts     int64  `protobuf:"varint,7,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	ResponseCode int64  `protobuf:"varint,8,opt,name=responseCode,proto3" json:"responseCode,omitempty"`
+	LastError    string `protobuf:"bytes,9,opt,name=lastError,proto3" json:"lastError,omitempty"`
+	NextAt       string `protobuf:"bytes,10,opt,name=nextAt,proto3" json:"nextAt,omitempty"`
+	CreatedAt    string `protobuf:"bytes,11,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+}
+
+func (x *AdminWebhookDeliveryListReply_List) Reset() {
+	*x = AdminWebhookDeliveryListReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[171]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminWebhookDeliveryListReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminWebhookDeliveryListReply_List) ProtoMessage() {}
+
+func (x *AdminWebhookDeliveryListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[171]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminWebhookDeliveryListReply_List.ProtoReflect.Descriptor instead.
+func (*AdminWebhookDeliveryListReply_List) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{87, 0}
+}
+
+func (x *AdminWebhookDeliveryListReply_List) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminWebhookDeliveryListReply_List) GetEventId() int64 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+func (x *AdminWebhookDeliveryListReply_List) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+func (x *AdminWebhookDeliveryListReply_List) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+func (x *AdminWebhookDeliveryListReply_List) GetSubscriberId() int64 {
+	if x != nil {
+		return x.SubscriberId
+	}
+	return 0
+}
+
+func (x *AdminWebhookDeliveryListReply_List) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AdminWebhookDeliveryListReply_List) GetAttempts() int64 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *AdminWebhookDeliveryListReply_List) GetResponseCode() int64 {
+	if x != nil {
+		return x.ResponseCode
+	}
+	return 0
+}
+
+func (x *AdminWebhookDeliveryListReply_List) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *AdminWebhookDeliveryListReply_List) GetNextAt() string {
+	if x != nil {
+		return x.NextAt
+	}
+	return ""
+}
+
+func (x *AdminWebhookDeliveryListReply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type AdminWebhookReplayRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeliveryId int64 `protobuf:"varint,1,opt,name=deliveryId,proto3" json:"deliveryId,omitempty"`
+	EventId    int64 `protobuf:"varint,2,opt,name=eventId,proto3" json:"eventId,omitempty"`
+}
+
+func (x *AdminWebhookReplayRequest_SendBody) Reset() {
+	*x = AdminWebhookReplayRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[172]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminWebhookReplayRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminWebhookReplayRequest_SendBody) ProtoMessage() {}
+
+func (x *AdminWebhookReplayRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[172]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminWebhookReplayRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*AdminWebhookReplayRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{88, 0}
+}
+
+func (x *AdminWebhookReplayRequest_SendBody) GetDeliveryId() int64 {
+	if x != nil {
+		return x.DeliveryId
+	}
+	return 0
+}
+
+func (x *AdminWebhookReplayRequest_SendBody) GetEventId() int64 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+type AdminCacheStatsReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Hits     int64  `protobuf:"varint,2,opt,name=hits,proto3" json:"hits,omitempty"`
+	Misses   int64  `protobuf:"varint,3,opt,name=misses,proto3" json:"misses,omitempty"`
+	Errors   int64  `protobuf:"varint,4,opt,name=errors,proto3" json:"errors,omitempty"`
+	HitRatio string `protobuf:"bytes,5,opt,name=hit_ratio,json=hitRatio,proto3" json:"hit_ratio,omitempty"`
+}
+
+func (x *AdminCacheStatsReply_List) Reset() {
+	*x = AdminCacheStatsReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[173]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminCacheStatsReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminCacheStatsReply_List) ProtoMessage() {}
+
+func (x *AdminCacheStatsReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[173]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminCacheStatsReply_List.ProtoReflect.Descriptor instead.
+func (*AdminCacheStatsReply_List) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{91, 0}
+}
+
+func (x *AdminCacheStatsReply_List) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AdminCacheStatsReply_List) GetHits() int64 {
+	if x != nil {
+		return x.Hits
+	}
+	return 0
+}
+
+func (x *AdminCacheStatsReply_List) GetMisses() int64 {
+	if x != nil {
+		return x.Misses
+	}
+	return 0
+}
+
+func (x *AdminCacheStatsReply_List) GetErrors() int64 {
+	if x != nil {
+		return x.Errors
+	}
+	return 0
+}
+
+func (x *AdminCacheStatsReply_List) GetHitRatio() string {
+	if x != nil {
+		return x.HitRatio
+	}
+	return ""
+}
+
+type AdminRewardListReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Amount    string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Type      string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Address   string `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
+	Reason    string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *AdminRewardListReply_List) Reset() {
+	*x = AdminRewardListReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[174]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminRewardListReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminRewardListReply_List) ProtoMessage() {}
+
+func (x *AdminRewardListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[174]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminRewardListReply_List.ProtoReflect.Descriptor instead.
+func (*AdminRewardListReply_List) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{93, 0}
+}
+
+func (x *AdminRewardListReply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *AdminRewardListReply_List) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *AdminRewardListReply_List) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *AdminRewardListReply_List) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AdminRewardListReply_List) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type AdminUserListReply_UserList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId           int64  `protobuf:"varint,8,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CreatedAt        string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Address          string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	BalanceUsdt      string `protobuf:"bytes,3,opt,name=balanceUsdt,proto3" json:"balanceUsdt,omitempty"`
+	BalanceDhb       string `protobuf:"bytes,4,opt,name=balanceDhb,proto3" json:"balanceDhb,omitempty"`
+	Vip              int64  `protobuf:"varint,5,opt,name=vip,proto3" json:"vip,omitempty"`
+	MonthRecommend   int64  `protobuf:"varint,7,opt,name=monthRecommend,proto3" json:"monthRecommend,omitempty"`
+	HistoryRecommend int64  `protobuf:"varint,6,opt,name=historyRecommend,proto3" json:"historyRecommend,omitempty"`
+}
+
+func (x *AdminUserListReply_UserList) Reset() {
+	*x = AdminUserListReply_UserList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[175]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminUserListReply_UserList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminUserListReply_UserList) ProtoMessage() {}
+
+func (x *AdminUserListReply_UserList) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[175]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminUserListReply_UserList.ProtoReflect.Descriptor instead.
+func (*AdminUserListReply_UserList) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{95, 0}
+}
+
+func (x *AdminUserListReply_UserList) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AdminUserListReply_UserList) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *AdminUserListReply_UserList) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AdminUserListReply_UserList) GetBalanceUsdt() string {
+	if x != nil {
+		return x.BalanceUsdt
+	}
+	return ""
+}
+
+func (x *AdminUserListReply_UserList) GetBalanceDhb() string {
+	if x != nil {
+		return x.BalanceDhb
+	}
+	return ""
+}
+
+func (x *AdminUserListReply_UserList) GetVip() int64 {
+	if x != nil {
+		return x.Vip
+	}
+	return 0
+}
+
+func (x *AdminUserListReply_UserList) GetMonthRecommend() int64 {
+	if x != nil {
+		return x.MonthRecommend
+	}
+	return 0
+}
+
+func (x *AdminUserListReply_UserList) GetHistoryRecommend() int64 {
+	if x != nil {
+		return x.HistoryRecommend
+	}
+	return 0
+}
+
+type AdminLocationListReply_LocationList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreatedAt    string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Address      string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Row          int64  `protobuf:"varint,3,opt,name=row,proto3" json:"row,omitempty"`
+	Col          int64  `protobuf:"varint,4,opt,name=col,proto3" json:"col,omitempty"`
+	Status       string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	CurrentLevel int64  `protobuf:"varint,6,opt,name=currentLevel,proto3" json:"currentLevel,omitempty"`
+	Current      string `protobuf:"bytes,7,opt,name=current,proto3" json:"current,omitempty"`
+	CurrentMax   string `protobuf:"bytes,8,opt,name=currentMax,proto3" json:"currentMax,omitempty"`
+}
+
+func (x *AdminLocationListReply_LocationList) Reset() {
+	*x = AdminLocationListReply_LocationList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[176]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminLocationListReply_LocationList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminLocationListReply_LocationList) ProtoMessage() {}
+
+func (x *AdminLocationListReply_LocationList) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[176]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminLocationListReply_LocationList.ProtoReflect.Descriptor instead.
+func (*AdminLocationListReply_LocationList) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{97, 0}
+}
+
+func (x *AdminLocationListReply_LocationList) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *AdminLocationListReply_LocationList) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AdminLocationListReply_LocationList) GetRow() int64 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *AdminLocationListReply_LocationList) GetCol() int64 {
+	if x != nil {
+		return x.Col
+	}
+	return 0
+}
+
+func (x *AdminLocationListReply_LocationList) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AdminLocationListReply_LocationList) GetCurrentLevel() int64 {
+	if x != nil {
+		return x.CurrentLevel
+	}
+	return 0
+}
+
+func (x *AdminLocationListReply_LocationList) GetCurrent() string {
+	if x != nil {
+		return x.Current
+	}
+	return ""
+}
+
+func (x *AdminLocationListReply_LocationList) GetCurrentMax() string {
+	if x != nil {
+		return x.CurrentMax
+	}
+	return ""
+}
+
+type AdminWithdrawListReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address   string `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
+	Id        int64  `protobuf:"varint,7,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Amount    string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	RelAmount string `protobuf:"bytes,6,opt,name=relAmount,proto3" json:"relAmount,omitempty"`
+	Type      string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Status    string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *AdminWithdrawListReply_List) Reset() {
+	*x = AdminWithdrawListReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[177]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminWithdrawListReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminWithdrawListReply_List) ProtoMessage() {}
+
+func (x *AdminWithdrawListReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[177]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminWithdrawListReply_List.ProtoReflect.Descriptor instead.
+func (*AdminWithdrawListReply_List) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{99, 0}
+}
+
+func (x *AdminWithdrawListReply_List) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AdminWithdrawListReply_List) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminWithdrawListReply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *AdminWithdrawListReply_List) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *AdminWithdrawListReply_List) GetRelAmount() string {
+	if x != nil {
+		return x.RelAmount
+	}
+	return ""
+}
+
+func (x *AdminWithdrawListReply_List) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *AdminWithdrawListReply_List) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type AdminWithdrawRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // pass 审核通过，success 打款成功，fail 打款失败
+}
+
+func (x *AdminWithdrawRequest_SendBody) Reset() {
+	*x = AdminWithdrawRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[178]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminWithdrawRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminWithdrawRequest_SendBody) ProtoMessage() {}
+
+func (x *AdminWithdrawRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[178]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminWithdrawRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*AdminWithdrawRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{100, 0}
+}
+
+func (x *AdminWithdrawRequest_SendBody) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminWithdrawRequest_SendBody) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type AdminUserRecommendReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId    int64  `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Address   string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Id        int64  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *AdminUserRecommendReply_List) Reset() {
+	*x = AdminUserRecommendReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[179]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminUserRecommendReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminUserRecommendReply_List) ProtoMessage() {}
+
+func (x *AdminUserRecommendReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[179]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminUserRecommendReply_List.ProtoReflect.Descriptor instead.
+func (*AdminUserRecommendReply_List) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{111, 0}
+}
+
+func (x *AdminUserRecommendReply_List) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AdminUserRecommendReply_List) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AdminUserRecommendReply_List) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminUserRecommendReply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type AdminMonthRecommendReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address          string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	RecommendAddress string `protobuf:"bytes,4,opt,name=recommendAddress,proto3" json:"recommendAddress,omitempty"`
+	Id               int64  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt        string `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *AdminMonthRecommendReply_List) Reset() {
+	*x = AdminMonthRecommendReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[180]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminMonthRecommendReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminMonthRecommendReply_List) ProtoMessage() {}
+
+func (x *AdminMonthRecommendReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[180]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminMonthRecommendReply_List.ProtoReflect.Descriptor instead.
+func (*AdminMonthRecommendReply_List) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{113, 0}
+}
+
+func (x *AdminMonthRecommendReply_List) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AdminMonthRecommendReply_List) GetRecommendAddress() string {
+	if x != nil {
+		return x.RecommendAddress
+	}
+	return ""
+}
+
+func (x *AdminMonthRecommendReply_List) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminMonthRecommendReply_List) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type AdminConfigReply_List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name  string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *AdminConfigReply_List) Reset() {
+	*x = AdminConfigReply_List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[181]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminConfigReply_List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminConfigReply_List) ProtoMessage() {}
+
+func (x *AdminConfigReply_List) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[181]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminConfigReply_List.ProtoReflect.Descriptor instead.
+func (*AdminConfigReply_List) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{115, 0}
+}
+
+func (x *AdminConfigReply_List) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminConfigReply_List) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AdminConfigReply_List) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type AdminConfigUpdateRequest_SendBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *AdminConfigUpdateRequest_SendBody) Reset() {
+	*x = AdminConfigUpdateRequest_SendBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_app_proto_msgTypes[182]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminConfigUpdateRequest_SendBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminConfigUpdateRequest_SendBody) ProtoMessage() {}
+
+func (x *AdminConfigUpdateRequest_SendBody) ProtoReflect() protoreflect.Message {
+	mi := &file_api_app_proto_msgTypes[182]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminConfigUpdateRequest_SendBody.ProtoReflect.Descriptor instead.
+func (*AdminConfigUpdateRequest_SendBody) Descriptor() ([]byte, []int) {
+	return file_api_app_proto_rawDescGZIP(), []int{116, 0}
+}
+
+func (x *AdminConfigUpdateRequest_SendBody) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminConfigUpdateRequest_SendBody) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+var File_api_app_proto protoreflect.FileDescriptor
+
+var file_api_app_proto_rawDesc = []byte{
+	0x0a, 0x0d, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x03, 0x61, 0x70, 0x69, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xbf, 0x01, 0x0a, 0x13,
+	0x45, 0x74, 0x68, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x3e, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x74, 0x68,
+	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42,
+	0x6f, 0x64, 0x79, 0x1a, 0x68, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x67,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x69, 0x67, 0x6e, 0x22, 0x29, 0x0a,
+	0x11, 0x45, 0x74, 0x68, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x8f, 0x01, 0x0a, 0x16, 0x52, 0x65, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x41, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65,
+	0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x32, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f,
+	0x64, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x67, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x69, 0x67, 0x6e, 0x22, 0x44, 0x0a, 0x14, 0x52, 0x65,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x2c, 0x0a, 0x11, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x69,
+	0x6e, 0x76, 0x69, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x22, 0x10, 0x0a, 0x0e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x0e, 0x0a, 0x0c, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x95, 0x01, 0x0a, 0x0f, 0x55, 0x73, 0x65, 0x72, 0x41, 0x72, 0x65, 0x61, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06,
+	0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x63, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xd8, 0x02, 0x0a, 0x0d, 0x55,
+	0x73, 0x65, 0x72, 0x41, 0x72, 0x65, 0x61, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2b, 0x0a, 0x04,
+	0x61, 0x72, 0x65, 0x61, 0x18, 0x34, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x41, 0x72, 0x65, 0x61, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x04, 0x61, 0x72, 0x65, 0x61, 0x12, 0x2b, 0x0a, 0x04, 0x72, 0x6f, 0x6f,
+	0x74, 0x18, 0x35, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x41, 0x72, 0x65, 0x61, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x18, 0x36, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74,
+	0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x1a, 0xcc, 0x01, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x4c, 0x6f, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x4c, 0x6f, 0x77, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x64, 0x65,
+	0x70, 0x74, 0x68, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x6f, 0x70, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x03, 0x74, 0x6f, 0x70, 0x22, 0x11, 0x0a, 0x0f, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xa2, 0x14, 0x0a, 0x0d, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x69,
+	0x77, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x62, 0x69,
+	0x77, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x42, 0x69, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x42, 0x69, 0x77, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x69, 0x77, 0x44, 0x61, 0x69,
+	0x6c, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x69, 0x77, 0x44, 0x61, 0x69,
+	0x6c, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x75, 0x79, 0x4e, 0x75, 0x6d, 0x54, 0x77, 0x6f, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x62, 0x75, 0x79, 0x4e, 0x75, 0x6d, 0x54, 0x77, 0x6f,
+	0x12, 0x20, 0x0a, 0x0b, 0x62, 0x75, 0x79, 0x4e, 0x75, 0x6d, 0x54, 0x68, 0x72, 0x65, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x75, 0x79, 0x4e, 0x75, 0x6d, 0x54, 0x68, 0x72,
+	0x65, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x75, 0x79, 0x4e, 0x75, 0x6d, 0x46, 0x6f, 0x75, 0x72,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x62, 0x75, 0x79, 0x4e, 0x75, 0x6d, 0x46, 0x6f,
+	0x75, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x75, 0x79, 0x4e, 0x75, 0x6d, 0x46, 0x69, 0x76, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x62, 0x75, 0x79, 0x4e, 0x75, 0x6d, 0x46, 0x69,
+	0x76, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x75, 0x79, 0x4e, 0x75, 0x6d, 0x4f, 0x6e, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x62, 0x75, 0x79, 0x4e, 0x75, 0x6d, 0x4f, 0x6e, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x62, 0x75, 0x79, 0x4e, 0x75, 0x6d, 0x53, 0x69, 0x78, 0x18, 0x5a, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x62, 0x75, 0x79, 0x4e, 0x75, 0x6d, 0x53, 0x69, 0x78, 0x12, 0x1e,
+	0x0a, 0x0a, 0x73, 0x65, 0x6c, 0x6c, 0x4e, 0x75, 0x6d, 0x4f, 0x6e, 0x65, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x73, 0x65, 0x6c, 0x6c, 0x4e, 0x75, 0x6d, 0x4f, 0x6e, 0x65, 0x12, 0x1e,
+	0x0a, 0x0a, 0x73, 0x65, 0x6c, 0x6c, 0x4e, 0x75, 0x6d, 0x54, 0x77, 0x6f, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x73, 0x65, 0x6c, 0x6c, 0x4e, 0x75, 0x6d, 0x54, 0x77, 0x6f, 0x12, 0x22,
+	0x0a, 0x0c, 0x73, 0x65, 0x6c, 0x6c, 0x4e, 0x75, 0x6d, 0x54, 0x68, 0x72, 0x65, 0x65, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x73, 0x65, 0x6c, 0x6c, 0x4e, 0x75, 0x6d, 0x54, 0x68, 0x72,
+	0x65, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x65, 0x6c, 0x6c, 0x4e, 0x75, 0x6d, 0x46, 0x6f, 0x75,
+	0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73, 0x65, 0x6c, 0x6c, 0x4e, 0x75, 0x6d,
+	0x46, 0x6f, 0x75, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x65, 0x6c, 0x6c, 0x4e, 0x75, 0x6d, 0x46,
+	0x69, 0x76, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73, 0x65, 0x6c, 0x6c, 0x4e,
+	0x75, 0x6d, 0x46, 0x69, 0x76, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x65, 0x6c, 0x6c, 0x4e, 0x75,
+	0x6d, 0x53, 0x69, 0x78, 0x18, 0x5b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x65, 0x6c, 0x6c,
+	0x4e, 0x75, 0x6d, 0x53, 0x69, 0x78, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x5f,
+	0x72, 0x61, 0x74, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x64, 0x61, 0x69, 0x6c,
+	0x79, 0x52, 0x61, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x62, 0x69, 0x77, 0x44, 0x61, 0x69, 0x6c,
+	0x79, 0x53, 0x70, 0x65, 0x65, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x62, 0x69,
+	0x77, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x53, 0x70, 0x65, 0x65, 0x64, 0x12, 0x2a, 0x0a, 0x10, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x69, 0x77, 0x18,
+	0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x41, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x42, 0x69, 0x77, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x18, 0x11, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x72,
+	0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12,
+	0x20, 0x0a, 0x0b, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x73, 0x64, 0x74, 0x18, 0x13,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x73, 0x64,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x76, 0x69, 0x74,
+	0x65, 0x55, 0x72, 0x6c, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x76, 0x69,
+	0x74, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x2c, 0x0a, 0x11, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x55,
+	0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x11, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52,
+	0x61, 0x74, 0x65, 0x18, 0x3c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x65, 0x78, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x4c,
+	0x65, 0x76, 0x65, 0x6c, 0x18, 0x3e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74,
+	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x3b, 0x0a, 0x0c, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x34, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x0c, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x43, 0x0a, 0x0c, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4c, 0x69,
+	0x73, 0x74, 0x18, 0x42, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x0c, 0x77, 0x69, 0x74, 0x68, 0x64,
+	0x72, 0x61, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x19, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12,
+	0x28, 0x0a, 0x0f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x6f, 0x75,
+	0x72, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66,
+	0x6f, 0x75, 0x72, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x72, 0x65,
+	0x61, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61,
+	0x72, 0x65, 0x61, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x66, 0x6f, 0x75,
+	0x72, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x50, 0x6f, 0x6f, 0x6c, 0x18, 0x17, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x66, 0x6f, 0x75, 0x72, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x50, 0x6f, 0x6f,
+	0x6c, 0x12, 0x2c, 0x0a, 0x11, 0x66, 0x6f, 0x75, 0x72, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x50,
+	0x6f, 0x6f, 0x6c, 0x59, 0x65, 0x73, 0x18, 0x18, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x66, 0x6f,
+	0x75, 0x72, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x50, 0x6f, 0x6f, 0x6c, 0x59, 0x65, 0x73, 0x12,
+	0x2f, 0x0a, 0x04, 0x66, 0x6f, 0x75, 0x72, 0x18, 0x32, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x75, 0x72, 0x52, 0x04, 0x66, 0x6f, 0x75, 0x72,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x72, 0x65, 0x61, 0x4d, 0x61, 0x78, 0x18, 0x1e, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x07, 0x61, 0x72, 0x65, 0x61, 0x4d, 0x61, 0x78, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x72,
+	0x65, 0x61, 0x4d, 0x69, 0x6e, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x61, 0x72, 0x65,
+	0x61, 0x4d, 0x69, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x72, 0x65, 0x61, 0x41, 0x6c, 0x6c, 0x18,
+	0x20, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x61, 0x72, 0x65, 0x61, 0x41, 0x6c, 0x6c, 0x12, 0x26,
+	0x0a, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x6f, 0x74, 0x61, 0x6c,
+	0x18, 0x21, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x64, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x2c, 0x0a, 0x11, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x64, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6c, 0x6c, 0x18, 0x45, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x11, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x6f, 0x74, 0x61,
+	0x6c, 0x41, 0x6c, 0x6c, 0x12, 0x22, 0x0a, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x55, 0x73, 0x64, 0x74, 0x18, 0x22, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x55, 0x73, 0x64, 0x74, 0x12, 0x34, 0x0a, 0x15, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4d, 0x61, 0x78, 0x53, 0x75,
+	0x62, 0x18, 0x23, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4d, 0x61, 0x78, 0x53, 0x75, 0x62, 0x12, 0x2e,
+	0x0a, 0x12, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x53, 0x75, 0x62, 0x18, 0x24, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x62, 0x12, 0x24,
+	0x0a, 0x0d, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18,
+	0x25, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x54,
+	0x6f, 0x74, 0x61, 0x6c, 0x12, 0x28, 0x0a, 0x0f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x55, 0x73, 0x64, 0x74, 0x41, 0x6c, 0x6c, 0x18, 0x26, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x73, 0x64, 0x74, 0x41, 0x6c, 0x6c, 0x12, 0x3d,
+	0x0a, 0x0a, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x27, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x52, 0x0a, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x46, 0x0a,
+	0x0d, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x18, 0x28,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x0d, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x12, 0x43, 0x0a, 0x0c, 0x6c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x29, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x0c, 0x6c, 0x69,
+	0x73, 0x74, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x4f, 0x6e, 0x65, 0x18, 0x2d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4f, 0x6e, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x54, 0x77, 0x6f, 0x18, 0x2e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x54, 0x77, 0x6f, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x54, 0x68, 0x72, 0x65, 0x65, 0x18, 0x2f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x54, 0x68, 0x72, 0x65, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x46, 0x6f, 0x75, 0x72, 0x18, 0x30, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x46, 0x6f, 0x75, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x31, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x20, 0x0a, 0x0b, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4d, 0x69, 0x6e, 0x18,
+	0x36, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4d,
+	0x69, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x75, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x37,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x62, 0x75, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x1a, 0x6c,
+	0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x12, 0x32, 0x0a, 0x14, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4d, 0x61, 0x78, 0x53, 0x75,
+	0x62, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4d, 0x61, 0x78, 0x53, 0x75, 0x62, 0x43, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x42, 0x0a, 0x0c,
+	0x4c, 0x69, 0x73, 0x74, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x74,
+	0x1a, 0x56, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x75, 0x72, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x76, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x55, 0x73, 0x64, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x55, 0x73, 0x64, 0x74,
+	0x1a, 0x29, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x1a, 0x60, 0x0a, 0x0c, 0x4c,
+	0x69, 0x73, 0x74, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x73, 0x64, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x73, 0x64, 0x74, 0x41, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x22, 0x90, 0x10,
+	0x0a, 0x0e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x31, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x32, 0x0a, 0x14, 0x72, 0x65,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x54, 0x6f, 0x74,
+	0x61, 0x6c, 0x18, 0x5c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x24,
+	0x0a, 0x0d, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x6f, 0x74, 0x61, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x55,
+	0x73, 0x64, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x55, 0x73, 0x64, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72,
+	0x61, 0x77, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x42, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42,
+	0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x55, 0x72, 0x6c, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x2c,
+	0x0a, 0x11, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x69, 0x6e, 0x76, 0x69, 0x74,
+	0x65, 0x55, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x22, 0x0a, 0x0c,
+	0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0c, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4e, 0x75, 0x6d,
+	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x38, 0x0a, 0x17, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x44, 0x68, 0x62, 0x54, 0x6f, 0x74, 0x61,
+	0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x44, 0x68, 0x62, 0x54, 0x6f, 0x74, 0x61, 0x6c,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x32, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x32, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x68, 0x62, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x68, 0x62, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x18, 0x1b, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72,
+	0x61, 0x77, 0x12, 0x22, 0x0a, 0x0c, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x73, 0x64,
+	0x74, 0x32, 0x18, 0x23, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x55, 0x73, 0x64, 0x74, 0x32, 0x12, 0x28, 0x0a, 0x0f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72,
+	0x61, 0x77, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x32, 0x18, 0x24, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x32,
+	0x12, 0x22, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x18, 0x20, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x44, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
+	0x41, 0x6c, 0x6c, 0x18, 0x27, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x77, 0x69, 0x74, 0x68, 0x64,
+	0x72, 0x61, 0x77, 0x41, 0x6c, 0x6c, 0x12, 0x2a, 0x0a, 0x10, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x64, 0x54, 0x65, 0x61, 0x6d, 0x4e, 0x75, 0x6d, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x10, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x54, 0x65, 0x61, 0x6d, 0x4e,
+	0x75, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x48, 0x62, 0x73, 0x18, 0x62, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x48, 0x62, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x16, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3c,
+	0x0a, 0x0c, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x34,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x6e, 0x66, 0x6f, 0x31, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x0c,
+	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x0d,
+	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x32, 0x18, 0x31, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e,
+	0x66, 0x6f, 0x31, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x32, 0x32, 0x52,
+	0x0d, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x32, 0x12, 0x49,
+	0x0a, 0x12, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x4c, 0x69, 0x73, 0x74, 0x18, 0x35, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x31, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x32, 0x52, 0x12, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x4b, 0x0a, 0x13, 0x72, 0x65, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74,
+	0x18, 0x38, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x31, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x35, 0x52, 0x13, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x43, 0x0a, 0x0f, 0x74, 0x65, 0x61, 0x6d, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x41, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x31, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x37, 0x52, 0x0f, 0x74, 0x65, 0x61, 0x6d,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x4e, 0x0a, 0x14, 0x72,
+	0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4c,
+	0x69, 0x73, 0x74, 0x18, 0x42, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x31, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x31, 0x31, 0x52, 0x14, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x3f, 0x0a, 0x0d, 0x61,
+	0x6c, 0x6c, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x43, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66,
+	0x6f, 0x31, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x39, 0x52, 0x0d, 0x61,
+	0x6c, 0x6c, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x0b,
+	0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x64, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f,
+	0x31, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x31, 0x33, 0x52, 0x0b, 0x64,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x77, 0x69,
+	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x61, 0x74, 0x65, 0x18, 0x51, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0c, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x61, 0x74, 0x65, 0x1a, 0x6d,
+	0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a,
+	0x09, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x78, 0x12, 0x10, 0x0a, 0x03, 0x4e,
+	0x75, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x4e, 0x75, 0x6d, 0x1a, 0x5d, 0x0a,
+	0x06, 0x4c, 0x69, 0x73, 0x74, 0x32, 0x32, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x78, 0x1a, 0x3e, 0x0a, 0x05,
+	0x4c, 0x69, 0x73, 0x74, 0x32, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x3e, 0x0a, 0x05,
+	0x4c, 0x69, 0x73, 0x74, 0x35, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x5f, 0x0a, 0x05,
+	0x4c, 0x69, 0x73, 0x74, 0x37, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x55, 0x73, 0x64, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x55, 0x73, 0x64, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x56,
+	0x69, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x56, 0x69, 0x70, 0x1a, 0x60, 0x0a,
+	0x06, 0x4c, 0x69, 0x73, 0x74, 0x31, 0x31, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x55, 0x73, 0x64,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x55, 0x73, 0x64, 0x74, 0x12, 0x10, 0x0a,
+	0x03, 0x56, 0x69, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x56, 0x69, 0x70, 0x1a,
+	0x3e, 0x0a, 0x05, 0x4c, 0x69, 0x73, 0x74, 0x39, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x1a,
+	0x3f, 0x0a, 0x06, 0x4c, 0x69, 0x73, 0x74, 0x31, 0x33, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0x97, 0x01, 0x0a, 0x11, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x22, 0x99, 0x02, 0x0a, 0x0f, 0x52,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x33,
+	0x0a, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x79, 0x0a, 0x04, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x71, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61,
+	0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x09, 0x73, 0x65, 0x6e,
+	0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e,
+	0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x22, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64,
+	0x79, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x4b, 0x0a, 0x0d, 0x47, 0x65, 0x74,
+	0x54, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x43, 0x73, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x73, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x48, 0x62, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x48, 0x62, 0x73, 0x22, 0x8c, 0x01, 0x0a, 0x1a, 0x52, 0x65, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12,
+	0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x65, 0x6e,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x73, 0x6f, 0x72, 0x74, 0x22, 0x9b, 0x02, 0x0a, 0x18, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x3c, 0x0a, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73,
+	0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x69, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x22, 0x86, 0x01, 0x0a, 0x14, 0x46, 0x65, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x63, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x22, 0xe3, 0x01, 0x0a,
+	0x12, 0x46, 0x65, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x36, 0x0a, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x65, 0x65, 0x52, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x6e,
+	0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x1a, 0x3d, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x22, 0xb1, 0x01, 0x0a, 0x13, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x65,
+	0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x22, 0xd5, 0x02, 0x0a, 0x11, 0x57, 0x69, 0x74, 0x68, 0x64,
+	0x72, 0x61, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x37, 0x0a, 0x08,
+	0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x08, 0x77, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0xae, 0x01,
+	0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x6c,
+	0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72,
+	0x65, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x66, 0x65, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x75,
+	0x72, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x75, 0x72, 0x6e, 0x22, 0x9a,
+	0x01, 0x0a, 0x10, 0x54, 0x72, 0x61, 0x64, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x63,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x63, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x22, 0x93, 0x02, 0x0a, 0x0e,
+	0x54, 0x72, 0x61, 0x64, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2e,
+	0x0a, 0x05, 0x74, 0x72, 0x61, 0x64, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x05, 0x74, 0x72, 0x61, 0x64, 0x65, 0x12, 0x1e,
+	0x0a, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x79, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a,
+	0x09, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x73, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x73, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x48, 0x62, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x48, 0x62, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x22, 0xa9, 0x01, 0x0a, 0x0f, 0x54, 0x72, 0x61, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x72, 0x61,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x72, 0x61, 0x6e, 0x12, 0x16, 0x0a,
+	0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x63,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a,
+	0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6f, 0x72,
+	0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x22, 0xd3, 0x01,
+	0x0a, 0x0d, 0x54, 0x72, 0x61, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x2b, 0x0a, 0x04, 0x74, 0x72, 0x61, 0x6e, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x04, 0x74, 0x72, 0x61, 0x6e, 0x12, 0x1e, 0x0a, 0x0a,
+	0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x3d, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0x57, 0x0a, 0x11, 0x46, 0x65, 0x65, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x63, 0x6f, 0x69, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x8d, 0x01, 0x0a,
+	0x0f, 0x46, 0x65, 0x65, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x69, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x69, 0x6e, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x66, 0x65, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x75, 0x72, 0x6e, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x75, 0x72, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6e, 0x65,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6e, 0x65, 0x74, 0x22, 0xec, 0x01, 0x0a,
+	0x0e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b,
+	0x69, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12,
+	0x12, 0x0a, 0x04, 0x63, 0x6f, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63,
+	0x6f, 0x69, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x63,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x63, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x22, 0x93, 0x03, 0x0a, 0x0c,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2a, 0x0a, 0x04,
+	0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x65, 0x78, 0x74,
+	0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6e, 0x65,
+	0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x20,
+	0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x1a, 0xfe, 0x01, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x69, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x69, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x42, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x42, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x66,
+	0x65, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x66, 0x65, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x62, 0x75, 0x72, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x75, 0x72,
+	0x6e, 0x22, 0xa7, 0x01, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x41, 0x0a, 0x09,
+	0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e,
+	0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a,
+	0x4a, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x65, 0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x6a, 0x0a, 0x14, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x75,
+	0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x25, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x2a,
+	0x0a, 0x14, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x8a, 0x01, 0x0a, 0x12, 0x52,
+	0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x3c, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x73, 0x1a,
+	0x36, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x85, 0x01, 0x0a, 0x0f, 0x45, 0x78, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x09, 0x73,
+	0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73,
+	0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x36, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42,
+	0x6f, 0x64, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x67, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x73, 0x69, 0x67, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0x27, 0x0a, 0x0d, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xa1, 0x01, 0x0a, 0x12, 0x42, 0x75, 0x79,
+	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x3d, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x75, 0x79, 0x4c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64,
+	0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x4c,
+	0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69,
+	0x67, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x69, 0x67, 0x6e, 0x12, 0x10,
+	0x0a, 0x03, 0x6e, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6e, 0x75, 0x6d,
+	0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x22, 0x7e, 0x0a, 0x10,
+	0x42, 0x75, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x64, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x64, 0x74, 0x12, 0x1e, 0x0a, 0x0a,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4d, 0x61, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4d, 0x61, 0x78, 0x22, 0xd4, 0x01, 0x0a,
+	0x0f, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x3a, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72,
+	0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f,
+	0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x84, 0x01, 0x0a,
+	0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x67, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x73, 0x69, 0x67, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x49, 0x64, 0x22, 0x5f, 0x0a, 0x0d, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x12, 0x10, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x66,
+	0x65, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6e, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6e, 0x65, 0x74, 0x22, 0x81, 0x01, 0x0a, 0x15, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40,
+	0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65,
+	0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79,
+	0x1a, 0x26, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x1a, 0x0a, 0x08,
+	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x31, 0x0a, 0x13, 0x50, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0xb3, 0x01, 0x0a, 0x0b,
+	0x54, 0x72, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x09, 0x73,
+	0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42,
+	0x6f, 0x64, 0x79, 0x1a, 0x6c, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x22, 0x23, 0x0a, 0x09, 0x54, 0x72, 0x61, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x81, 0x01, 0x0a, 0x17, 0x53, 0x65, 0x74, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x42, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x74, 0x42,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65,
+	0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x22, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f,
+	0x64, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x2f, 0x0a, 0x15, 0x53, 0x65,
+	0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x87, 0x01, 0x0a, 0x1a,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x45, 0x0a, 0x09, 0x73, 0x65,
+	0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53,
+	0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64,
+	0x79, 0x1a, 0x22, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x16, 0x0a,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xf2, 0x01, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x40, 0x0a, 0x09, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x09, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x7c, 0x0a, 0x04,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70,
+	0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a,
+	0x0a, 0x08, 0x6d, 0x61, 0x74, 0x75, 0x72, 0x65, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x6d, 0x61, 0x74, 0x75, 0x72, 0x65, 0x41, 0x74, 0x22, 0x1d, 0x0a, 0x1b, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x53, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x83, 0x03, 0x0a, 0x19, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x53, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63,
+	0x69, 0x70, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x69, 0x6e,
+	0x63, 0x69, 0x70, 0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x72, 0x75, 0x65, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x72, 0x75, 0x65, 0x64, 0x12,
+	0x22, 0x0a, 0x0c, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x41, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x79, 0x6f, 0x75,
+	0x74, 0x41, 0x74, 0x12, 0x37, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e,
+	0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x55, 0x6e, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x55, 0x6e, 0x73,
+	0x74, 0x61, 0x6b, 0x65, 0x1a, 0xa8, 0x01, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x74,
+	0x44, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x74, 0x44,
+	0x61, 0x74, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6c, 0x61, 0x73,
+	0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x6e,
+	0x65, 0x78, 0x74, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x41, 0x74, 0x22,
+	0x1c, 0x0a, 0x1a, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x93, 0x02,
+	0x0a, 0x18, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x24, 0x0a, 0x0d, 0x77, 0x68,
+	0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x4f, 0x6e, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0d, 0x77, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x4f, 0x6e, 0x6c, 0x79,
+	0x12, 0x36, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x1a, 0x98, 0x01, 0x0a, 0x04, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x22, 0xb7, 0x01, 0x0a, 0x1c, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x47, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x69,
+	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42,
+	0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x4e, 0x0a,
+	0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62,
+	0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x67,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x69, 0x67, 0x6e, 0x22, 0x48, 0x0a,
+	0x1a, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x41, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x41, 0x74, 0x22, 0x97, 0x01, 0x0a, 0x1c, 0x57, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x47, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64,
+	0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53,
+	0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64,
+	0x79, 0x1a, 0x2e, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x73, 0x69, 0x67, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x69, 0x67,
+	0x6e, 0x22, 0x34, 0x0a, 0x1a, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x17, 0x0a, 0x15, 0x49, 0x6e, 0x76, 0x69, 0x74,
+	0x65, 0x43, 0x6f, 0x64, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0xa1, 0x02, 0x0a, 0x13, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x33, 0x0a, 0x05, 0x63, 0x6f, 0x64, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x49, 0x6e,
+	0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x05, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x1a, 0xd4, 0x01,
+	0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x64, 0x4e, 0x75,
+	0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x75, 0x73, 0x65, 0x64, 0x4e, 0x75, 0x6d,
+	0x12, 0x16, 0x0a, 0x06, 0x6d, 0x61, 0x78, 0x55, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x6d, 0x61, 0x78, 0x55, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x64, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x73,
+	0x65, 0x64, 0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74,
+	0x55, 0x73, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x22, 0xb5, 0x01, 0x0a, 0x17, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43,
+	0x6f, 0x64, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x42, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65,
+	0x43, 0x6f, 0x64, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64,
+	0x42, 0x6f, 0x64, 0x79, 0x1a, 0x56, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x61, 0x78, 0x55, 0x73, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6d, 0x61, 0x78, 0x55, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x44, 0x61, 0x79, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x44, 0x61, 0x79, 0x73, 0x22, 0x2b, 0x0a, 0x15,
+	0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x0f, 0x0a, 0x0d, 0x4c, 0x6f, 0x63,
+	0x61, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x63, 0x0a, 0x0b, 0x4c, 0x6f,
+	0x63, 0x61, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63,
+	0x61, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
+	0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x22,
+	0x79, 0x0a, 0x13, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3e, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62,
+	0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65,
+	0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x22, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f,
+	0x64, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x22, 0x43, 0x0a, 0x11, 0x4c, 0x6f,
+	0x63, 0x61, 0x6c, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x22,
+	0x5d, 0x0a, 0x11, 0x50, 0x75, 0x73, 0x68, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x75,
+	0x73, 0x68, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
+	0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f,
+	0x64, 0x79, 0x1a, 0x0a, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x22, 0x48,
+	0x0a, 0x0f, 0x50, 0x75, 0x73, 0x68, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x73, 0x5f, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x49, 0x6e, 0x22, 0xbf, 0x01, 0x0a, 0x1c, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x47, 0x0a, 0x09, 0x73, 0x65, 0x6e,
+	0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f,
+	0x64, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
+	0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f,
+	0x64, 0x79, 0x1a, 0x56, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x61, 0x78, 0x55, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x6d, 0x61, 0x78, 0x55, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x44, 0x61, 0x79, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x44, 0x61, 0x79, 0x73, 0x22, 0x30, 0x0a, 0x1a, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0xa3, 0x01, 0x0a,
+	0x1a, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x45, 0x0a, 0x09, 0x73,
+	0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
+	0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f,
+	0x64, 0x79, 0x1a, 0x3e, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x16,
+	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x22, 0x32, 0x0a, 0x18, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x50, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x64, 0x0a, 0x1e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x91, 0x02, 0x0a,
+	0x1c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x40, 0x0a,
+	0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x1a, 0x98, 0x01, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65,
+	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x64,
+	0x22, 0x89, 0x01, 0x0a, 0x15, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x72, 0x65, 0x61, 0x4c, 0x65,
+	0x76, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x09, 0x73, 0x65,
+	0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x72, 0x65, 0x61, 0x4c, 0x65, 0x76,
+	0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f,
+	0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x2e, 0x0a, 0x08,
+	0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x22, 0x0a, 0x0c, 0x62, 0x75, 0x73, 0x69,
+	0x6e, 0x65, 0x73, 0x73, 0x44, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x44, 0x61, 0x74, 0x65, 0x22, 0xf9, 0x02, 0x0a,
+	0x13, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x72, 0x65, 0x61, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x75, 0x6d, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x75, 0x6d, 0x12, 0x1e,
+	0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x64, 0x65, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x64, 0x65, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1e, 0x0a, 0x0a,
+	0x62, 0x6f, 0x6e, 0x75, 0x73, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x62, 0x6f, 0x6e, 0x75, 0x73, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x37, 0x0a, 0x07,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x72, 0x65, 0x61, 0x4c, 0x65, 0x76,
+	0x65, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x07, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x73, 0x1a, 0xb0, 0x01, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x4c, 0x65, 0x76,
+	0x65, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x69, 0x67, 0x41,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x69, 0x67,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x6d, 0x61, 0x6c, 0x6c, 0x41,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x6d, 0x61,
+	0x6c, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x40, 0x0a, 0x16, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x4a, 0x6f, 0x62, 0x52, 0x75, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xa9, 0x02, 0x0a, 0x14, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x4a, 0x6f, 0x62, 0x52, 0x75, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x32, 0x0a, 0x04, 0x72, 0x75, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4a, 0x6f, 0x62,
+	0x52, 0x75, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x04, 0x72, 0x75, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0xc6, 0x01,
+	0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x62, 0x75,
+	0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x44, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x44, 0x61, 0x74, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f,
+	0x77, 0x6e, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x41,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x41, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x6e, 0x64, 0x41, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x6e, 0x64, 0x41, 0x74, 0x22, 0x44, 0x0a, 0x22, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x8b, 0x02, 0x0a,
+	0x20, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x4c, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a,
+	0x98, 0x01, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x74, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x1a, 0x0a, 0x18, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x9e, 0x01, 0x0a, 0x16, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6c, 0x61,
+	0x63, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x63, 0x65,
+	0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65,
+	0x73, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x69, 0x76, 0x65, 0x72, 0x67, 0x65,
+	0x6e, 0x63, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x76, 0x65,
+	0x72, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x22, 0x19, 0x0a, 0x17, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0xf5, 0x01, 0x0a, 0x15, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x65, 0x62, 0x68,
+	0x6f, 0x6f, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x33, 0x0a, 0x04,
+	0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x04, 0x6c, 0x69, 0x73,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x1a, 0x8e, 0x01, 0x0a, 0x04, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x75, 0x72, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xaf, 0x01, 0x0a, 0x19, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x44, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64,
+	0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64,
+	0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x4c,
+	0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x41, 0x0a, 0x17,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22,
+	0xad, 0x01, 0x0a, 0x19, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x44, 0x0a,
+	0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x65, 0x62, 0x68,
+	0x6f, 0x6f, 0x6b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42,
+	0x6f, 0x64, 0x79, 0x1a, 0x4a, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
+	0x31, 0x0a, 0x17, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x22, 0x71, 0x0a, 0x1f, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x65, 0x62, 0x68, 0x6f,
+	0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x49,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x72, 0x49, 0x64, 0x22, 0xa5, 0x03, 0x0a, 0x1d, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57,
+	0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3b, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x04,
+	0x6c, 0x69, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0xb0, 0x02, 0x0a, 0x04, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x22, 0x0a,
+	0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x49, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74,
+	0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x61, 0x74, 0x74,
+	0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x61, 0x73,
+	0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x61,
+	0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x65, 0x78, 0x74, 0x41,
+	0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x65, 0x78, 0x74, 0x41, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xa7, 0x01,
+	0x0a, 0x19, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65,
+	0x70, 0x6c, 0x61, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x44, 0x0a, 0x09, 0x73,
+	0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53,
+	0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64,
+	0x79, 0x1a, 0x44, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x1e, 0x0a,
+	0x0a, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x49, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x39, 0x0a, 0x17, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69,
+	0x65, 0x73, 0x22, 0x18, 0x0a, 0x16, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x61, 0x63, 0x68, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xdf, 0x01, 0x0a,
+	0x14, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x61, 0x63, 0x68, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x32, 0x0a,
+	0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x61, 0x63, 0x68, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x04, 0x6c, 0x69, 0x73,
+	0x74, 0x1a, 0x7b, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x68, 0x69, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x68, 0x69, 0x74,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x73, 0x12, 0x1b, 0x0a, 0x09, 0x68, 0x69, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x69, 0x74, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x22, 0x46,
+	0x0a, 0x16, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xec, 0x01, 0x0a, 0x14, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x38, 0x0a, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a,
+	0x83, 0x01, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x44, 0x0a, 0x14, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73,
+	0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x61, 0x67,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xe9, 0x02, 0x0a, 0x12,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x36, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65,
+	0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x1a, 0x84, 0x02, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x17, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x20, 0x0a, 0x0b, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x73, 0x64, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x73, 0x64,
+	0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x68, 0x62, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x68,
+	0x62, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x69, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03,
+	0x76, 0x69, 0x70, 0x12, 0x26, 0x0a, 0x0e, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6d, 0x6f, 0x6e,
+	0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x12, 0x2a, 0x0a, 0x10, 0x68,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x22, 0x48, 0x0a, 0x18, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x22, 0xda, 0x02, 0x0a, 0x16, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x46, 0x0a, 0x09,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x28, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0xe1, 0x01, 0x0a, 0x0c, 0x4c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x6f, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x03, 0x72, 0x6f, 0x77, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x6f, 0x6c, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x03, 0x63, 0x6f, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x22, 0x0a, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4c, 0x65, 0x76, 0x65, 0x6c,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4c,
+	0x65, 0x76, 0x65, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x1e,
+	0x0a, 0x0a, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4d, 0x61, 0x78, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4d, 0x61, 0x78, 0x22, 0x48,
+	0x0a, 0x18, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
+	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xa0, 0x02, 0x0a, 0x16, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x3c, 0x0a, 0x08, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x08, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
+	0x77, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0xb1, 0x01, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x8b, 0x01, 0x0a, 0x14,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x3f, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e,
+	0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x32, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64,
+	0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x2c, 0x0a, 0x12, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x19, 0x0a, 0x17, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x45, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x17, 0x0a, 0x15, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64,
+	0x72, 0x61, 0x77, 0x45, 0x74, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x11, 0x0a, 0x0f, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x0f,
+	0x0a, 0x0d, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x16, 0x0a, 0x14, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x14, 0x0a, 0x12, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x11, 0x0a,
+	0x0f, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0xd9, 0x02, 0x0a, 0x0d, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x6f, 0x64, 0x61, 0x79, 0x54, 0x6f, 0x74, 0x61, 0x6c,
+	0x55, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x74, 0x6f, 0x64, 0x61,
+	0x79, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x6c, 0x6c, 0x42,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x6c,
+	0x6c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x74, 0x6f, 0x64, 0x61,
+	0x79, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x74, 0x6f, 0x64, 0x61, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20,
+	0x0a, 0x0b, 0x61, 0x6c, 0x6c, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x6c, 0x6c, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x24, 0x0a, 0x0d, 0x74, 0x6f, 0x64, 0x61, 0x79, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
+	0x77, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x6f, 0x64, 0x61, 0x79, 0x57, 0x69,
+	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x6c, 0x6c, 0x57, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x6c, 0x6c,
+	0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6c, 0x6c, 0x52,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x6c, 0x6c,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x34, 0x0a, 0x15, 0x61, 0x6c, 0x6c, 0x53, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x41, 0x6e, 0x64, 0x46, 0x65, 0x65, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x61, 0x6c, 0x6c, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x41, 0x6e, 0x64, 0x46, 0x65, 0x65, 0x22, 0x34, 0x0a, 0x19,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x22, 0xbc, 0x01, 0x0a, 0x17, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x37,
+	0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x1a, 0x68, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x22, 0x4a, 0x0a, 0x1a, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x52,
+	0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x22, 0xe7, 0x01,
+	0x0a, 0x18, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x38, 0x0a, 0x05, 0x75, 0x73,
+	0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x05, 0x75,
+	0x73, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x7b, 0x0a, 0x04, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2a, 0x0a, 0x10,
+	0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x40, 0x0a, 0x12, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x22, 0x9e, 0x01, 0x0a, 0x10, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x32,
+	0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x40, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x91, 0x01, 0x0a, 0x18, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x43, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f,
+	0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f,
+	0x64, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x1a, 0x30, 0x0a, 0x08,
+	0x53, 0x65, 0x6e, 0x64, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x18,
+	0x0a, 0x16, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x32, 0x9e, 0x2e, 0x0a, 0x03, 0x41, 0x70, 0x70,
+	0x12, 0x72, 0x0a, 0x0c, 0x45, 0x74, 0x68, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65,
+	0x12, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x74, 0x68, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x69, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x45, 0x74, 0x68, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x3a, 0x09, 0x73, 0x65, 0x6e, 0x64,
+	0x5f, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x1d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x65, 0x74, 0x68, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x7a, 0x65, 0x12, 0x7e, 0x0a, 0x0f, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x33, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2d, 0x3a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f,
+	0x64, 0x79, 0x22, 0x20, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x5f, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x12, 0x57, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x1b, 0x12, 0x19, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x57, 0x0a,
+	0x08, 0x55, 0x73, 0x65, 0x72, 0x41, 0x72, 0x65, 0x61, 0x12, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x41, 0x72, 0x65, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x41, 0x72, 0x65, 0x61, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x61, 0x72, 0x65, 0x61, 0x12, 0x5f, 0x0a, 0x0a, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x72, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x84, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x52,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x12, 0x25, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70,
+	0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x64, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x6c,
+	0x0a, 0x0d, 0x46, 0x65, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x65, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x46, 0x65, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x66, 0x65, 0x65,
+	0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x67, 0x0a, 0x0c,
+	0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x18, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x25,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
+	0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x5b, 0x0a, 0x09, 0x54, 0x72, 0x61, 0x64, 0x65, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x54, 0x72, 0x61, 0x64, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x22,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x6c, 0x69,
+	0x73, 0x74, 0x12, 0x77, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x2c, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x26, 0x3a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79,
+	0x22, 0x19, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x66, 0x0a, 0x0c, 0x47,
+	0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61,
+	0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x52, 0x0a, 0x07, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x13,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f,
+	0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x5f, 0x0a, 0x0a, 0x46, 0x65, 0x65, 0x50, 0x72,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x65, 0x65, 0x50,
+	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x46, 0x65, 0x65, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x66, 0x65, 0x65,
+	0x5f, 0x70, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x57, 0x0a, 0x08, 0x54, 0x72, 0x61, 0x6e,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x54, 0x72, 0x61, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x21,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x5f, 0x6c, 0x69, 0x73,
+	0x74, 0x12, 0x6b, 0x0a, 0x0d, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f,
+	0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x7a,
+	0x0a, 0x0e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x12, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x32, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x3a, 0x09,
+	0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x1f, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x70, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x6e, 0x0a, 0x0b, 0x42, 0x75,
+	0x79, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x42, 0x75, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x75, 0x79, 0x4c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x2f, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x29, 0x3a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x1c, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x62, 0x75,
+	0x79, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x61, 0x0a, 0x08, 0x57, 0x69,
+	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x3a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62,
+	0x6f, 0x64, 0x79, 0x22, 0x18, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x61, 0x0a,
+	0x08, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x3a, 0x09, 0x73, 0x65, 0x6e,
+	0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x18, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x12, 0x5b, 0x0a, 0x05, 0x54, 0x72, 0x61, 0x64, 0x65, 0x12, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x3a, 0x09, 0x73, 0x65, 0x6e,
+	0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x15, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x12, 0x51, 0x0a,
+	0x04, 0x54, 0x72, 0x61, 0x6e, 0x12, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x72, 0x61, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x72,
+	0x61, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x3a,
+	0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x14, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x74, 0x72, 0x61, 0x6e,
+	0x12, 0x62, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x12, 0x14, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x64,
+	0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x3a, 0x09,
+	0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x19, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x67, 0x65, 0x74, 0x5f, 0x74,
+	0x72, 0x61, 0x64, 0x65, 0x12, 0x83, 0x01, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x53, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65,
+	0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x35, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x3a, 0x09, 0x73, 0x65, 0x6e,
+	0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x22, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x73, 0x65, 0x74, 0x5f, 0x62, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x8f, 0x01, 0x0a, 0x13, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x12, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x38, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x32, 0x3a, 0x09, 0x73, 0x65, 0x6e, 0x64,
+	0x5f, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x25, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x62, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x88, 0x01, 0x0a,
+	0x14, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x53, 0x75,
+	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x53, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x12,
+	0x26, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f,
+	0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x84, 0x01, 0x0a, 0x13, 0x57, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x12, 0x25, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70,
+	0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
+	0x77, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x97,
+	0x01, 0x0a, 0x15, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57,
+	0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x3a, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x34, 0x3a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x22,
+	0x27, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x97, 0x01, 0x0a, 0x15, 0x57, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x12, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
+	0x77, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x3a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x34, 0x3a, 0x09,
+	0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x27, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x77, 0x69, 0x74, 0x68, 0x64,
+	0x72, 0x61, 0x77, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x64, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x12, 0x70, 0x0a, 0x0e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74,
+	0x65, 0x43, 0x6f, 0x64, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64,
+	0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x22, 0x12, 0x20, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2f, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x5f,
+	0x6c, 0x69, 0x73, 0x74, 0x12, 0x83, 0x01, 0x0a, 0x10, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43,
+	0x6f, 0x64, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x49, 0x6e,
+	0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x35, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x3a, 0x09, 0x73, 0x65, 0x6e,
+	0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x22, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x5f, 0x63,
+	0x6f, 0x64, 0x65, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x4e, 0x0a, 0x06, 0x4c, 0x6f,
+	0x63, 0x61, 0x6c, 0x65, 0x12, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c,
+	0x6f, 0x63, 0x61, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x18, 0x12, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x12, 0x72, 0x0a, 0x0c, 0x4c, 0x6f,
+	0x63, 0x61, 0x6c, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x18, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c,
+	0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x30, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x2a, 0x3a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x22,
+	0x1d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x6a,
+	0x0a, 0x0a, 0x50, 0x75, 0x73, 0x68, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x16, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x54,
+	0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x2e, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x28, 0x3a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x1b, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x70,
+	0x75, 0x73, 0x68, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x91, 0x01, 0x0a, 0x15, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x12, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e,
+	0x3a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x21, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x69, 0x6e, 0x76, 0x69,
+	0x74, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x88,
+	0x01, 0x0a, 0x13, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x3a, 0x09,
+	0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x1e, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x8d, 0x01, 0x0a, 0x17, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x69, 0x6d,
+	0x75, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x69, 0x6d, 0x75, 0x6c,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x2a, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x24, 0x12, 0x22, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x73, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x74, 0x0a, 0x0e, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x41, 0x72, 0x65, 0x61, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x1a, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x72, 0x65, 0x61, 0x4c, 0x65, 0x76, 0x65, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x41, 0x72, 0x65, 0x61, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x3a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f,
+	0x62, 0x6f, 0x64, 0x79, 0x22, 0x19, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x5f, 0x64, 0x68, 0x62, 0x2f, 0x61, 0x72, 0x65, 0x61, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12,
+	0x6e, 0x0a, 0x0f, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4a, 0x6f, 0x62, 0x52, 0x75, 0x6e, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4a, 0x6f,
+	0x62, 0x52, 0x75, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4a, 0x6f, 0x62, 0x52, 0x75,
+	0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64,
+	0x68, 0x62, 0x2f, 0x6a, 0x6f, 0x62, 0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12,
+	0x9e, 0x01, 0x0a, 0x1b, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x2f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x12, 0x27, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x69, 0x73, 0x74,
+	0x12, 0x75, 0x0a, 0x11, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x52,
+	0x65, 0x70, 0x6c, 0x61, 0x79, 0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78,
+	0x5f, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x12, 0x71, 0x0a, 0x10, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1c, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x77, 0x65,
+	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x84, 0x01, 0x0a, 0x12, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x12, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x65, 0x62,
+	0x68, 0x6f, 0x6f, 0x6b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x65, 0x62,
+	0x68, 0x6f, 0x6f, 0x6b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x3a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f,
+	0x64, 0x79, 0x22, 0x1d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64,
+	0x68, 0x62, 0x2f, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x12, 0x84, 0x01, 0x0a, 0x12, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x65, 0x62, 0x68, 0x6f,
+	0x6f, 0x6b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x3a, 0x09,
+	0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x1d, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x92, 0x01, 0x0a, 0x18, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x79, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x12, 0x24, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x5f,
+	0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x84, 0x01,
+	0x0a, 0x12, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65,
+	0x70, 0x6c, 0x61, 0x79, 0x12, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x3a, 0x09, 0x73, 0x65, 0x6e, 0x64,
+	0x5f, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x1d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x72, 0x65,
+	0x70, 0x6c, 0x61, 0x79, 0x12, 0x6d, 0x0a, 0x0f, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x61, 0x63,
+	0x68, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x43, 0x61, 0x63, 0x68, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x43, 0x61, 0x63, 0x68, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x73, 0x12, 0x51, 0x0a, 0x07, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x13,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x64,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x6f, 0x0a, 0x0d, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57,
+	0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69,
+	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x2a, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x24, 0x3a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x17,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x77,
+	0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x71, 0x0a, 0x10, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x45, 0x74, 0x68, 0x12, 0x1c, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x45,
+	0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x45, 0x74, 0x68,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x77, 0x69,
+	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x65, 0x74, 0x68, 0x12, 0x50, 0x0a, 0x08, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x12, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x68, 0x62, 0x2f, 0x66, 0x65, 0x65, 0x12, 0x6b, 0x0a, 0x0d,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x19, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
+	0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x5f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x42, 0x11, 0x0a, 0x03, 0x61, 0x70, 0x69,
+	0x50, 0x01, 0x5a, 0x08, 0x2f, 0x61, 0x70, 0x69, 0x3b, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_api_app_proto_rawDescOnce sync.Once
+	file_api_app_proto_rawDescData = file_api_app_proto_rawDesc
+)
+
+func file_api_app_proto_rawDescGZIP() []byte {
+	file_api_app_proto_rawDescOnce.Do(func() {
+		file_api_app_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_app_proto_rawDescData)
+	})
+	return file_api_app_proto_rawDescData
+}
+
+var file_api_app_proto_msgTypes = make([]protoimpl.MessageInfo, 183)
+var file_api_app_proto_goTypes = []interface{}{
+	(*EthAuthorizeRequest)(nil),                   // 0: api.EthAuthorizeRequest
+	(*EthAuthorizeReply)(nil),                     // 1: api.EthAuthorizeReply
+	(*RecommendUpdateRequest)(nil),                // 2: api.RecommendUpdateRequest
+	(*RecommendUpdateReply)(nil),                  // 3: api.RecommendUpdateReply
+	(*DepositRequest)(nil),                        // 4: api.DepositRequest
+	(*DepositReply)(nil),                          // 5: api.DepositReply
+	(*UserAreaRequest)(nil),                       // 6: api.UserAreaRequest
+	(*UserAreaReply)(nil),                         // 7: api.UserAreaReply
+	(*UserInfoRequest)(nil),                       // 8: api.UserInfoRequest
+	(*UserInfoReply)(nil),                         // 9: api.UserInfoReply
+	(*UserInfo1Reply)(nil),                        // 10: api.UserInfo1Reply
+	(*RewardListRequest)(nil),                     // 11: api.RewardListRequest
+	(*RewardListReply)(nil),                       // 12: api.RewardListReply
+	(*GetTradeRequest)(nil),                       // 13: api.GetTradeRequest
+	(*GetTradeReply)(nil),                         // 14: api.GetTradeReply
+	(*RecommendRewardListRequest)(nil),            // 15: api.RecommendRewardListRequest
+	(*RecommendRewardListReply)(nil),              // 16: api.RecommendRewardListReply
+	(*FeeRewardListRequest)(nil),                  // 17: api.FeeRewardListRequest
+	(*FeeRewardListReply)(nil),                    // 18: api.FeeRewardListReply
+	(*WithdrawListRequest)(nil),                   // 19: api.WithdrawListRequest
+	(*WithdrawListReply)(nil),                     // 20: api.WithdrawListReply
+	(*TradeListRequest)(nil),                      // 21: api.TradeListRequest
+	(*TradeListReply)(nil),                        // 22: api.TradeListReply
+	(*TranListRequest)(nil),                       // 23: api.TranListRequest
+	(*TranListReply)(nil),                         // 24: api.TranListReply
+	(*FeePreviewRequest)(nil),                     // 25: api.FeePreviewRequest
+	(*FeePreviewReply)(nil),                       // 26: api.FeePreviewReply
+	(*HistoryRequest)(nil),                        // 27: api.HistoryRequest
+	(*HistoryReply)(nil),                          // 28: api.HistoryReply
+	(*CreateStatementRequest)(nil),                // 29: api.CreateStatementRequest
+	(*CreateStatementReply)(nil),                  // 30: api.CreateStatementReply
+	(*GetStatementRequest)(nil),                   // 31: api.GetStatementRequest
+	(*RecommendListRequest)(nil),                  // 32: api.RecommendListRequest
+	(*RecommendListReply)(nil),                    // 33: api.RecommendListReply
+	(*ExchangeRequest)(nil),                       // 34: api.ExchangeRequest
+	(*ExchangeReply)(nil),                         // 35: api.ExchangeReply
+	(*BuyLocationRequest)(nil),                    // 36: api.BuyLocationRequest
+	(*BuyLocationReply)(nil),                      // 37: api.BuyLocationReply
+	(*WithdrawRequest)(nil),                       // 38: api.WithdrawRequest
+	(*WithdrawReply)(nil),                         // 39: api.WithdrawReply
+	(*PasswordChangeRequest)(nil),                 // 40: api.PasswordChangeRequest
+	(*PasswordChangeReply)(nil),                   // 41: api.PasswordChangeReply
+	(*TranRequest)(nil),                           // 42: api.TranRequest
+	(*TranReply)(nil),                             // 43: api.TranReply
+	(*SetBalanceRewardRequest)(nil),               // 44: api.SetBalanceRewardRequest
+	(*SetBalanceRewardReply)(nil),                 // 45: api.SetBalanceRewardReply
+	(*DeleteBalanceRewardRequest)(nil),            // 46: api.DeleteBalanceRewardRequest
+	(*DeleteBalanceRewardReply)(nil),              // 47: api.DeleteBalanceRewardReply
+	(*BalanceRewardSummaryRequest)(nil),           // 48: api.BalanceRewardSummaryRequest
+	(*BalanceRewardSummaryReply)(nil),             // 49: api.BalanceRewardSummaryReply
+	(*WithdrawAddressListRequest)(nil),            // 50: api.WithdrawAddressListRequest
+	(*WithdrawAddressListReply)(nil),              // 51: api.WithdrawAddressListReply
+	(*WithdrawAddressCreateRequest)(nil),          // 52: api.WithdrawAddressCreateRequest
+	(*WithdrawAddressCreateReply)(nil),            // 53: api.WithdrawAddressCreateReply
+	(*WithdrawAddressDeleteRequest)(nil),          // 54: api.WithdrawAddressDeleteRequest
+	(*WithdrawAddressDeleteReply)(nil),            // 55: api.WithdrawAddressDeleteReply
+	(*InviteCodeListRequest)(nil),                 // 56: api.InviteCodeListRequest
+	(*InviteCodeListReply)(nil),                   // 57: api.InviteCodeListReply
+	(*InviteCodeCreateRequest)(nil),               // 58: api.InviteCodeCreateRequest
+	(*InviteCodeCreateReply)(nil),                 // 59: api.InviteCodeCreateReply
+	(*LocaleRequest)(nil),                         // 60: api.LocaleRequest
+	(*LocaleReply)(nil),                           // 61: api.LocaleReply
+	(*LocaleChangeRequest)(nil),                   // 62: api.LocaleChangeRequest
+	(*LocaleChangeReply)(nil),                     // 63: api.LocaleChangeReply
+	(*PushTicketRequest)(nil),                     // 64: api.PushTicketRequest
+	(*PushTicketReply)(nil),                       // 65: api.PushTicketReply
+	(*AdminInviteCodeCreateRequest)(nil),          // 66: api.AdminInviteCodeCreateRequest
+	(*AdminInviteCodeCreateReply)(nil),            // 67: api.AdminInviteCodeCreateReply
+	(*AdminPasswordUpdateRequest)(nil),            // 68: api.AdminPasswordUpdateRequest
+	(*AdminPasswordUpdateReply)(nil),              // 69: api.AdminPasswordUpdateReply
+	(*AdminCommissionSimulateRequest)(nil),        // 70: api.AdminCommissionSimulateRequest
+	(*AdminCommissionSimulateReply)(nil),          // 71: api.AdminCommissionSimulateReply
+	(*AdminAreaLevelRequest)(nil),                 // 72: api.AdminAreaLevelRequest
+	(*AdminAreaLevelReply)(nil),                   // 73: api.AdminAreaLevelReply
+	(*AdminJobRunListRequest)(nil),                // 74: api.AdminJobRunListRequest
+	(*AdminJobRunListReply)(nil),                  // 75: api.AdminJobRunListReply
+	(*AdminLocationTransitionListRequest)(nil),    // 76: api.AdminLocationTransitionListRequest
+	(*AdminLocationTransitionListReply)(nil),      // 77: api.AdminLocationTransitionListReply
+	(*AdminMatrixReplayRequest)(nil),              // 78: api.AdminMatrixReplayRequest
+	(*AdminMatrixReplayReply)(nil),                // 79: api.AdminMatrixReplayReply
+	(*AdminWebhookListRequest)(nil),               // 80: api.AdminWebhookListRequest
+	(*AdminWebhookListReply)(nil),                 // 81: api.AdminWebhookListReply
+	(*AdminWebhookCreateRequest)(nil),             // 82: api.AdminWebhookCreateRequest
+	(*AdminWebhookCreateReply)(nil),               // 83: api.AdminWebhookCreateReply
+	(*AdminWebhookUpdateRequest)(nil),             // 84: api.AdminWebhookUpdateRequest
+	(*AdminWebhookUpdateReply)(nil),               // 85: api.AdminWebhookUpdateReply
+	(*AdminWebhookDeliveryListRequest)(nil),       // 86: api.AdminWebhookDeliveryListRequest
+	(*AdminWebhookDeliveryListReply)(nil),         // 87: api.AdminWebhookDeliveryListReply
+	(*AdminWebhookReplayRequest)(nil),             // 88: api.AdminWebhookReplayRequest
+	(*AdminWebhookReplayReply)(nil),               // 89: api.AdminWebhookReplayReply
+	(*AdminCacheStatsRequest)(nil),                // 90: api.AdminCacheStatsRequest
+	(*AdminCacheStatsReply)(nil),                  // 91: api.AdminCacheStatsReply
+	(*AdminRewardListRequest)(nil),                // 92: api.AdminRewardListRequest
+	(*AdminRewardListReply)(nil),                  // 93: api.AdminRewardListReply
+	(*AdminUserListRequest)(nil),                  // 94: api.AdminUserListRequest
+	(*AdminUserListReply)(nil),                    // 95: api.AdminUserListReply
+	(*AdminLocationListRequest)(nil),              // 96: api.AdminLocationListRequest
+	(*AdminLocationListReply)(nil),                // 97: api.AdminLocationListReply
+	(*AdminWithdrawListRequest)(nil),              // 98: api.AdminWithdrawListRequest
+	(*AdminWithdrawListReply)(nil),                // 99: api.AdminWithdrawListReply
+	(*AdminWithdrawRequest)(nil),                  // 100: api.AdminWithdrawRequest
+	(*AdminWithdrawReply)(nil),                    // 101: api.AdminWithdrawReply
+	(*AdminWithdrawEthRequest)(nil),               // 102: api.AdminWithdrawEthRequest
+	(*AdminWithdrawEthReply)(nil),                 // 103: api.AdminWithdrawEthReply
+	(*AdminFeeRequest)(nil),                       // 104: api.AdminFeeRequest
+	(*AdminFeeReply)(nil),                         // 105: api.AdminFeeReply
+	(*TokenWithdrawRequest)(nil),                  // 106: api.TokenWithdrawRequest
+	(*TokenWithdrawReply)(nil),                    // 107: api.TokenWithdrawReply
+	(*AdminAllRequest)(nil),                       // 108: api.AdminAllRequest
+	(*AdminAllReply)(nil),                         // 109: api.AdminAllReply
+	(*AdminUserRecommendRequest)(nil),             // 110: api.AdminUserRecommendRequest
+	(*AdminUserRecommendReply)(nil),               // 111: api.AdminUserRecommendReply
+	(*AdminMonthRecommendRequest)(nil),            // 112: api.AdminMonthRecommendRequest
+	(*AdminMonthRecommendReply)(nil),              // 113: api.AdminMonthRecommendReply
+	(*AdminConfigRequest)(nil),                    // 114: api.AdminConfigRequest
+	(*AdminConfigReply)(nil),                      // 115: api.AdminConfigReply
+	(*AdminConfigUpdateRequest)(nil),              // 116: api.AdminConfigUpdateRequest
+	(*AdminConfigUpdateReply)(nil),                // 117: api.AdminConfigUpdateReply
+	(*EthAuthorizeRequest_SendBody)(nil),          // 118: api.EthAuthorizeRequest.SendBody
+	(*RecommendUpdateRequest_SendBody)(nil),       // 119: api.RecommendUpdateRequest.SendBody
+	(*UserAreaReply_List)(nil),                    // 120: api.UserAreaReply.List
+	(*UserInfoReply_List)(nil),                    // 121: api.UserInfoReply.List
+	(*UserInfoReply_ListWithdraw)(nil),            // 122: api.UserInfoReply.ListWithdraw
+	(*UserInfoReply_ListFour)(nil),                // 123: api.UserInfoReply.ListFour
+	(*UserInfoReply_ListReward)(nil),              // 124: api.UserInfoReply.ListReward
+	(*UserInfoReply_ListRecommend)(nil),           // 125: api.UserInfoReply.ListRecommend
+	(*UserInfoReply_ListExchange)(nil),            // 126: api.UserInfoReply.ListExchange
+	(*UserInfo1Reply_List)(nil),                   // 127: api.UserInfo1Reply.List
+	(*UserInfo1Reply_List22)(nil),                 // 128: api.UserInfo1Reply.List22
+	(*UserInfo1Reply_List2)(nil),                  // 129: api.UserInfo1Reply.List2
+	(*UserInfo1Reply_List5)(nil),                  // 130: api.UserInfo1Reply.List5
+	(*UserInfo1Reply_List7)(nil),                  // 131: api.UserInfo1Reply.List7
+	(*UserInfo1Reply_List11)(nil),                 // 132: api.UserInfo1Reply.List11
+	(*UserInfo1Reply_List9)(nil),                  // 133: api.UserInfo1Reply.List9
+	(*UserInfo1Reply_List13)(nil),                 // 134: api.UserInfo1Reply.List13
+	(*RewardListReply_List)(nil),                  // 135: api.RewardListReply.List
+	(*GetTradeRequest_SendBody)(nil),              // 136: api.GetTradeRequest.SendBody
+	(*RecommendRewardListReply_List)(nil),         // 137: api.RecommendRewardListReply.List
+	(*FeeRewardListReply_List)(nil),               // 138: api.FeeRewardListReply.List
+	(*WithdrawListReply_List)(nil),                // 139: api.WithdrawListReply.List
+	(*TradeListReply_List)(nil),                   // 140: api.TradeListReply.List
+	(*TranListReply_List)(nil),                    // 141: api.TranListReply.List
+	(*HistoryReply_List)(nil),                     // 142: api.HistoryReply.List
+	(*CreateStatementRequest_SendBody)(nil),       // 143: api.CreateStatementRequest.SendBody
+	(*RecommendListReply_List)(nil),               // 144: api.RecommendListReply.List
+	(*ExchangeRequest_SendBody)(nil),              // 145: api.ExchangeRequest.SendBody
+	(*BuyLocationRequest_SendBody)(nil),           // 146: api.BuyLocationRequest.SendBody
+	(*WithdrawRequest_SendBody)(nil),              // 147: api.WithdrawRequest.SendBody
+	(*PasswordChangeRequest_SendBody)(nil),        // 148: api.PasswordChangeRequest.SendBody
+	(*TranRequest_SendBody)(nil),                  // 149: api.TranRequest.SendBody
+	(*SetBalanceRewardRequest_SendBody)(nil),      // 150: api.SetBalanceRewardRequest.SendBody
+	(*DeleteBalanceRewardRequest_SendBody)(nil),   // 151: api.DeleteBalanceRewardRequest.SendBody
+	(*DeleteBalanceRewardReply_List)(nil),         // 152: api.DeleteBalanceRewardReply.List
+	(*BalanceRewardSummaryReply_List)(nil),        // 153: api.BalanceRewardSummaryReply.List
+	(*WithdrawAddressListReply_List)(nil),         // 154: api.WithdrawAddressListReply.List
+	(*WithdrawAddressCreateRequest_SendBody)(nil), // 155: api.WithdrawAddressCreateRequest.SendBody
+	(*WithdrawAddressDeleteRequest_SendBody)(nil), // 156: api.WithdrawAddressDeleteRequest.SendBody
+	(*InviteCodeListReply_List)(nil),              // 157: api.InviteCodeListReply.List
+	(*InviteCodeCreateRequest_SendBody)(nil),      // 158: api.InviteCodeCreateRequest.SendBody
+	(*LocaleChangeRequest_SendBody)(nil),          // 159: api.LocaleChangeRequest.SendBody
+	(*PushTicketRequest_SendBody)(nil),            // 160: api.PushTicketRequest.SendBody
+	(*AdminInviteCodeCreateRequest_SendBody)(nil), // 161: api.AdminInviteCodeCreateRequest.SendBody
+	(*AdminPasswordUpdateRequest_SendBody)(nil),   // 162: api.AdminPasswordUpdateRequest.SendBody
+	(*AdminCommissionSimulateReply_List)(nil),     // 163: api.AdminCommissionSimulateReply.List
+	(*AdminAreaLevelRequest_SendBody)(nil),        // 164: api.AdminAreaLevelRequest.SendBody
+	(*AdminAreaLevelReply_List)(nil),              // 165: api.AdminAreaLevelReply.List
+	(*AdminJobRunListReply_List)(nil),             // 166: api.AdminJobRunListReply.List
+	(*AdminLocationTransitionListReply_List)(nil), // 167: api.AdminLocationTransitionListReply.List
+	(*AdminWebhookListReply_List)(nil),            // 168: api.AdminWebhookListReply.List
+	(*AdminWebhookCreateRequest_SendBody)(nil),    // 169: api.AdminWebhookCreateRequest.SendBody
+	(*AdminWebhookUpdateRequest_SendBody)(nil),    // 170: api.AdminWebhookUpdateRequest.SendBody
+	(*AdminWebhookDeliveryListReply_List)(nil),    // 171: api.AdminWebhookDeliveryListReply.List
+	(*AdminWebhookReplayRequest_SendBody)(nil),    // 172: api.AdminWebhookReplayRequest.SendBody
+	(*AdminCacheStatsReply_List)(nil),             // 173: api.AdminCacheStatsReply.List
+	(*AdminRewardListReply_List)(nil),             // 174: api.AdminRewardListReply.List
+	(*AdminUserListReply_UserList)(nil),           // 175: api.AdminUserListReply.UserList
+	(*AdminLocationListReply_LocationList)(nil),   // 176: api.AdminLocationListReply.LocationList
+	(*AdminWithdrawListReply_List)(nil),           // 177: api.AdminWithdrawListReply.List
+	(*AdminWithdrawRequest_SendBody)(nil),         // 178: api.AdminWithdrawRequest.SendBody
+	(*AdminUserRecommendReply_List)(nil),          // 179: api.AdminUserRecommendReply.List
+	(*AdminMonthRecommendReply_List)(nil),         // 180: api.AdminMonthRecommendReply.List
+	(*AdminConfigReply_List)(nil),                 // 181: api.AdminConfigReply.List
+	(*AdminConfigUpdateRequest_SendBody)(nil),     // 182: api.AdminConfigUpdateRequest.SendBody
+}
+var file_api_app_proto_depIdxs = []int32{
+	118, // 0: api.EthAuthorizeRequest.send_body:type_name -> api.EthAuthorizeRequest.SendBody
+	119, // 1: api.RecommendUpdateRequest.send_body:type_name -> api.RecommendUpdateRequest.SendBody
+	120, // 2: api.UserAreaReply.area:type_name -> api.UserAreaReply.List
+	120, // 3: api.UserAreaReply.root:type_name -> api.UserAreaReply.List
+	121, // 4: api.UserInfoReply.LocationList:type_name -> api.UserInfoReply.List
+	122, // 5: api.UserInfoReply.withdrawList:type_name -> api.UserInfoReply.ListWithdraw
+	123, // 6: api.UserInfoReply.four:type_name -> api.UserInfoReply.ListFour
+	124, // 7: api.UserInfoReply.listReward:type_name -> api.UserInfoReply.ListReward
+	125, // 8: api.UserInfoReply.listRecommend:type_name -> api.UserInfoReply.ListRecommend
+	126, // 9: api.UserInfoReply.listExchange:type_name -> api.UserInfoReply.ListExchange
+	127, // 10: api.UserInfo1Reply.LocationList:type_name -> api.UserInfo1Reply.List
+	128, // 11: api.UserInfo1Reply.LocationList2:type_name -> api.UserInfo1Reply.List22
+	129, // 12: api.UserInfo1Reply.locationRewardList:type_name -> api.UserInfo1Reply.List2
+	130, // 13: api.UserInfo1Reply.recommendRewardList:type_name -> api.UserInfo1Reply.List5
+	131, // 14: api.UserInfo1Reply.teamAddressList:type_name -> api.UserInfo1Reply.List7
+	132, // 15: api.UserInfo1Reply.recommendAddressList:type_name -> api.UserInfo1Reply.List11
+	133, // 16: api.UserInfo1Reply.allRewardList:type_name -> api.UserInfo1Reply.List9
+	134, // 17: api.UserInfo1Reply.depositList:type_name -> api.UserInfo1Reply.List13
+	135, // 18: api.RewardListReply.rewards:type_name -> api.RewardListReply.List
+	136, // 19: api.GetTradeRequest.send_body:type_name -> api.GetTradeRequest.SendBody
+	137, // 20: api.RecommendRewardListReply.rewards:type_name -> api.RecommendRewardListReply.List
+	138, // 21: api.FeeRewardListReply.rewards:type_name -> api.FeeRewardListReply.List
+	139, // 22: api.WithdrawListReply.withdraw:type_name -> api.WithdrawListReply.List
+	140, // 23: api.TradeListReply.trade:type_name -> api.TradeListReply.List
+	141, // 24: api.TranListReply.tran:type_name -> api.TranListReply.List
+	142, // 25: api.HistoryReply.list:type_name -> api.HistoryReply.List
+	143, // 26: api.CreateStatementRequest.send_body:type_name -> api.CreateStatementRequest.SendBody
+	144, // 27: api.RecommendListReply.recommends:type_name -> api.RecommendListReply.List
+	145, // 28: api.ExchangeRequest.send_body:type_name -> api.ExchangeRequest.SendBody
+	146, // 29: api.BuyLocationRequest.send_body:type_name -> api.BuyLocationRequest.SendBody
+	147, // 30: api.WithdrawRequest.send_body:type_name -> api.WithdrawRequest.SendBody
+	148, // 31: api.PasswordChangeRequest.send_body:type_name -> api.PasswordChangeRequest.SendBody
+	149, // 32: api.TranRequest.send_body:type_name -> api.TranRequest.SendBody
+	150, // 33: api.SetBalanceRewardRequest.send_body:type_name -> api.SetBalanceRewardRequest.SendBody
+	151, // 34: api.DeleteBalanceRewardRequest.send_body:type_name -> api.DeleteBalanceRewardRequest.SendBody
+	152, // 35: api.DeleteBalanceRewardReply.positions:type_name -> api.DeleteBalanceRewardReply.List
+	153, // 36: api.BalanceRewardSummaryReply.list:type_name -> api.BalanceRewardSummaryReply.List
+	154, // 37: api.WithdrawAddressListReply.list:type_name -> api.WithdrawAddressListReply.List
+	155, // 38: api.WithdrawAddressCreateRequest.send_body:type_name -> api.WithdrawAddressCreateRequest.SendBody
+	156, // 39: api.WithdrawAddressDeleteRequest.send_body:type_name -> api.WithdrawAddressDeleteRequest.SendBody
+	157, // 40: api.InviteCodeListReply.codes:type_name -> api.InviteCodeListReply.List
+	158, // 41: api.InviteCodeCreateRequest.send_body:type_name -> api.InviteCodeCreateRequest.SendBody
+	159, // 42: api.LocaleChangeRequest.send_body:type_name -> api.LocaleChangeRequest.SendBody
+	160, // 43: api.PushTicketRequest.send_body:type_name -> api.PushTicketRequest.SendBody
+	161, // 44: api.AdminInviteCodeCreateRequest.send_body:type_name -> api.AdminInviteCodeCreateRequest.SendBody
+	162, // 45: api.AdminPasswordUpdateRequest.send_body:type_name -> api.AdminPasswordUpdateRequest.SendBody
+	163, // 46: api.AdminCommissionSimulateReply.rewards:type_name -> api.AdminCommissionSimulateReply.List
+	164, // 47: api.AdminAreaLevelRequest.send_body:type_name -> api.AdminAreaLevelRequest.SendBody
+	165, // 48: api.AdminAreaLevelReply.changes:type_name -> api.AdminAreaLevelReply.List
+	166, // 49: api.AdminJobRunListReply.runs:type_name -> api.AdminJobRunListReply.List
+	167, // 50: api.AdminLocationTransitionListReply.transitions:type_name -> api.AdminLocationTransitionListReply.List
+	168, // 51: api.AdminWebhookListReply.list:type_name -> api.AdminWebhookListReply.List
+	169, // 52: api.AdminWebhookCreateRequest.send_body:type_name -> api.AdminWebhookCreateRequest.SendBody
+	170, // 53: api.AdminWebhookUpdateRequest.send_body:type_name -> api.AdminWebhookUpdateRequest.SendBody
+	171, // 54: api.AdminWebhookDeliveryListReply.list:type_name -> api.AdminWebhookDeliveryListReply.List
+	172, // 55: api.AdminWebhookReplayRequest.send_body:type_name -> api.AdminWebhookReplayRequest.SendBody
+	173, // 56: api.AdminCacheStatsReply.list:type_name -> api.AdminCacheStatsReply.List
+	174, // 57: api.AdminRewardListReply.rewards:type_name -> api.AdminRewardListReply.List
+	175, // 58: api.AdminUserListReply.users:type_name -> api.AdminUserListReply.UserList
+	176, // 59: api.AdminLocationListReply.locations:type_name -> api.AdminLocationListReply.LocationList
+	177, // 60: api.AdminWithdrawListReply.withdraw:type_name -> api.AdminWithdrawListReply.List
+	178, // 61: api.AdminWithdrawRequest.send_body:type_name -> api.AdminWithdrawRequest.SendBody
+	179, // 62: api.AdminUserRecommendReply.users:type_name -> api.AdminUserRecommendReply.List
+	180, // 63: api.AdminMonthRecommendReply.users:type_name -> api.AdminMonthRecommendReply.List
+	181, // 64: api.AdminConfigReply.config:type_name -> api.AdminConfigReply.List
+	182, // 65: api.AdminConfigUpdateRequest.send_body:type_name -> api.AdminConfigUpdateRequest.SendBody
+	0,   // 66: api.App.EthAuthorize:input_type -> api.EthAuthorizeRequest
+	2,   // 67: api.App.RecommendUpdate:input_type -> api.RecommendUpdateRequest
+	8,   // 68: api.App.UserInfo:input_type -> api.UserInfoRequest
+	6,   // 69: api.App.UserArea:input_type -> api.UserAreaRequest
+	11,  // 70: api.App.RewardList:input_type -> api.RewardListRequest
+	15,  // 71: api.App.RecommendRewardList:input_type -> api.RecommendRewardListRequest
+	17,  // 72: api.App.FeeRewardList:input_type -> api.FeeRewardListRequest
+	19,  // 73: api.App.WithdrawList:input_type -> api.WithdrawListRequest
+	21,  // 74: api.App.TradeList:input_type -> api.TradeListRequest
+	29,  // 75: api.App.CreateStatement:input_type -> api.CreateStatementRequest
+	31,  // 76: api.App.GetStatement:input_type -> api.GetStatementRequest
+	27,  // 77: api.App.History:input_type -> api.HistoryRequest
+	25,  // 78: api.App.FeePreview:input_type -> api.FeePreviewRequest
+	23,  // 79: api.App.TranList:input_type -> api.TranListRequest
+	32,  // 80: api.App.RecommendList:input_type -> api.RecommendListRequest
+	40,  // 81: api.App.PasswordChange:input_type -> api.PasswordChangeRequest
+	36,  // 82: api.App.BuyLocation:input_type -> api.BuyLocationRequest
+	38,  // 83: api.App.Withdraw:input_type -> api.WithdrawRequest
+	34,  // 84: api.App.Exchange:input_type -> api.ExchangeRequest
+	38,  // 85: api.App.Trade:input_type -> api.WithdrawRequest
+	42,  // 86: api.App.Tran:input_type -> api.TranRequest
+	13,  // 87: api.App.GetTrade:input_type -> api.GetTradeRequest
+	44,  // 88: api.App.SetBalanceReward:input_type -> api.SetBalanceRewardRequest
+	46,  // 89: api.App.DeleteBalanceReward:input_type -> api.DeleteBalanceRewardRequest
+	48,  // 90: api.App.BalanceRewardSummary:input_type -> api.BalanceRewardSummaryRequest
+	50,  // 91: api.App.WithdrawAddressList:input_type -> api.WithdrawAddressListRequest
+	52,  // 92: api.App.WithdrawAddressCreate:input_type -> api.WithdrawAddressCreateRequest
+	54,  // 93: api.App.WithdrawAddressDelete:input_type -> api.WithdrawAddressDeleteRequest
+	56,  // 94: api.App.InviteCodeList:input_type -> api.InviteCodeListRequest
+	58,  // 95: api.App.InviteCodeCreate:input_type -> api.InviteCodeCreateRequest
+	60,  // 96: api.App.Locale:input_type -> api.LocaleRequest
+	62,  // 97: api.App.LocaleChange:input_type -> api.LocaleChangeRequest
+	64,  // 98: api.App.PushTicket:input_type -> api.PushTicketRequest
+	66,  // 99: api.App.AdminInviteCodeCreate:input_type -> api.AdminInviteCodeCreateRequest
+	68,  // 100: api.App.AdminPasswordUpdate:input_type -> api.AdminPasswordUpdateRequest
+	70,  // 101: api.App.AdminCommissionSimulate:input_type -> api.AdminCommissionSimulateRequest
+	72,  // 102: api.App.AdminAreaLevel:input_type -> api.AdminAreaLevelRequest
+	74,  // 103: api.App.AdminJobRunList:input_type -> api.AdminJobRunListRequest
+	76,  // 104: api.App.AdminLocationTransitionList:input_type -> api.AdminLocationTransitionListRequest
+	78,  // 105: api.App.AdminMatrixReplay:input_type -> api.AdminMatrixReplayRequest
+	80,  // 106: api.App.AdminWebhookList:input_type -> api.AdminWebhookListRequest
+	82,  // 107: api.App.AdminWebhookCreate:input_type -> api.AdminWebhookCreateRequest
+	84,  // 108: api.App.AdminWebhookUpdate:input_type -> api.AdminWebhookUpdateRequest
+	86,  // 109: api.App.AdminWebhookDeliveryList:input_type -> api.AdminWebhookDeliveryListRequest
+	88,  // 110: api.App.AdminWebhookReplay:input_type -> api.AdminWebhookReplayRequest
+	90,  // 111: api.App.AdminCacheStats:input_type -> api.AdminCacheStatsRequest
+	4,   // 112: api.App.Deposit:input_type -> api.DepositRequest
+	100, // 113: api.App.AdminWithdraw:input_type -> api.AdminWithdrawRequest
+	102, // 114: api.App.AdminWithdrawEth:input_type -> api.AdminWithdrawEthRequest
+	104, // 115: api.App.AdminFee:input_type -> api.AdminFeeRequest
+	106, // 116: api.App.TokenWithdraw:input_type -> api.TokenWithdrawRequest
+	1,   // 117: api.App.EthAuthorize:output_type -> api.EthAuthorizeReply
+	3,   // 118: api.App.RecommendUpdate:output_type -> api.RecommendUpdateReply
+	9,   // 119: api.App.UserInfo:output_type -> api.UserInfoReply
+	7,   // 120: api.App.UserArea:output_type -> api.UserAreaReply
+	12,  // 121: api.App.RewardList:output_type -> api.RewardListReply
+	16,  // 122: api.App.RecommendRewardList:output_type -> api.RecommendRewardListReply
+	18,  // 123: api.App.FeeRewardList:output_type -> api.FeeRewardListReply
+	20,  // 124: api.App.WithdrawList:output_type -> api.WithdrawListReply
+	22,  // 125: api.App.TradeList:output_type -> api.TradeListReply
+	30,  // 126: api.App.CreateStatement:output_type -> api.CreateStatementReply
+	30,  // 127: api.App.GetStatement:output_type -> api.CreateStatementReply
+	28,  // 128: api.App.History:output_type -> api.HistoryReply
+	26,  // 129: api.App.FeePreview:output_type -> api.FeePreviewReply
+	24,  // 130: api.App.TranList:output_type -> api.TranListReply
+	33,  // 131: api.App.RecommendList:output_type -> api.RecommendListReply
+	41,  // 132: api.App.PasswordChange:output_type -> api.PasswordChangeReply
+	37,  // 133: api.App.BuyLocation:output_type -> api.BuyLocationReply
+	39,  // 134: api.App.Withdraw:output_type -> api.WithdrawReply
+	35,  // 135: api.App.Exchange:output_type -> api.ExchangeReply
+	39,  // 136: api.App.Trade:output_type -> api.WithdrawReply
+	43,  // 137: api.App.Tran:output_type -> api.TranReply
+	14,  // 138: api.App.GetTrade:output_type -> api.GetTradeReply
+	45,  // 139: api.App.SetBalanceReward:output_type -> api.SetBalanceRewardReply
+	47,  // 140: api.App.DeleteBalanceReward:output_type -> api.DeleteBalanceRewardReply
+	49,  // 141: api.App.BalanceRewardSummary:output_type -> api.BalanceRewardSummaryReply
+	51,  // 142: api.App.WithdrawAddressList:output_type -> api.WithdrawAddressListReply
+	53,  // 143: api.App.WithdrawAddressCreate:output_type -> api.WithdrawAddressCreateReply
+	55,  // 144: api.App.WithdrawAddressDelete:output_type -> api.WithdrawAddressDeleteReply
+	57,  // 145: api.App.InviteCodeList:output_type -> api.InviteCodeListReply
+	59,  // 146: api.App.InviteCodeCreate:output_type -> api.InviteCodeCreateReply
+	61,  // 147: api.App.Locale:output_type -> api.LocaleReply
+	63,  // 148: api.App.LocaleChange:output_type -> api.LocaleChangeReply
+	65,  // 149: api.App.PushTicket:output_type -> api.PushTicketReply
+	67,  // 150: api.App.AdminInviteCodeCreate:output_type -> api.AdminInviteCodeCreateReply
+	69,  // 151: api.App.AdminPasswordUpdate:output_type -> api.AdminPasswordUpdateReply
+	71,  // 152: api.App.AdminCommissionSimulate:output_type -> api.AdminCommissionSimulateReply
+	73,  // 153: api.App.AdminAreaLevel:output_type -> api.AdminAreaLevelReply
+	75,  // 154: api.App.AdminJobRunList:output_type -> api.AdminJobRunListReply
+	77,  // 155: api.App.AdminLocationTransitionList:output_type -> api.AdminLocationTransitionListReply
+	79,  // 156: api.App.AdminMatrixReplay:output_type -> api.AdminMatrixReplayReply
+	81,  // 157: api.App.AdminWebhookList:output_type -> api.AdminWebhookListReply
+	83,  // 158: api.App.AdminWebhookCreate:output_type -> api.AdminWebhookCreateReply
+	85,  // 159: api.App.AdminWebhookUpdate:output_type -> api.AdminWebhookUpdateReply
+	87,  // 160: api.App.AdminWebhookDeliveryList:output_type -> api.AdminWebhookDeliveryListReply
+	89,  // 161: api.App.AdminWebhookReplay:output_type -> api.AdminWebhookReplayReply
+	91,  // 162: api.App.AdminCacheStats:output_type -> api.AdminCacheStatsReply
+	5,   // 163: api.App.Deposit:output_type -> api.DepositReply
+	101, // 164: api.App.AdminWithdraw:output_type -> api.AdminWithdrawReply
+	103, // 165: api.App.AdminWithdrawEth:output_type -> api.AdminWithdrawEthReply
+	105, // 166: api.App.AdminFee:output_type -> api.AdminFeeReply
+	107, // 167: api.App.TokenWithdraw:output_type -> api.TokenWithdrawReply
+	117, // [117:168] is the sub-list for method output_type
+	66,  // [66:117] is the sub-list for method input_type
+	66,  // [66:66] is the sub-list for extension type_name
+	66,  // [66:66] is the sub-list for extension extendee
+	0,   // [0:66] is the sub-list for field type_name
+}
+
+func init() { file_api_app_proto_init() }
+func file_api_app_proto_init() {
+	if File_api_app_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_api_app_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EthAuthorizeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EthAuthorizeReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecommendUpdateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecommendUpdateReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DepositRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DepositReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserAreaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserAreaReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserInfoReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserInfo1Reply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RewardListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RewardListReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTradeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTradeReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecommendRewardListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecommendRewardListReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeeRewardListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeeRewardListReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WithdrawListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WithdrawListReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TradeListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TradeListReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TranListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TranListReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeePreviewRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeePreviewReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HistoryReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateStatementRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateStatementReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStatementRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecommendListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecommendListReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExchangeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExchangeReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuyLocationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuyLocationReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WithdrawRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WithdrawReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PasswordChangeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PasswordChangeReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TranRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TranReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetBalanceRewardRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetBalanceRewardReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteBalanceRewardRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteBalanceRewardReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BalanceRewardSummaryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BalanceRewardSummaryReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WithdrawAddressListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WithdrawAddressListReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WithdrawAddressCreateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WithdrawAddressCreateReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WithdrawAddressDeleteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WithdrawAddressDeleteReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InviteCodeListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InviteCodeListReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InviteCodeCreateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InviteCodeCreateReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LocaleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LocaleReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LocaleChangeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LocaleChangeReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PushTicketRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PushTicketReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminInviteCodeCreateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminInviteCodeCreateReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminPasswordUpdateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminPasswordUpdateReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminCommissionSimulateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminCommissionSimulateReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminAreaLevelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminAreaLevelReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminJobRunListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminJobRunListReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7416,8 +14664,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EthAuthorizeReply); i {
+		file_api_app_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminLocationTransitionListRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7428,8 +14676,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RecommendUpdateRequest); i {
+		file_api_app_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminLocationTransitionListReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7440,8 +14688,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RecommendUpdateReply); i {
+		file_api_app_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminMatrixReplayRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7452,8 +14700,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DepositRequest); i {
+		file_api_app_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminMatrixReplayReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7464,8 +14712,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DepositReply); i {
+		file_api_app_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWebhookListRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7476,8 +14724,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserAreaRequest); i {
+		file_api_app_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWebhookListReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7488,8 +14736,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserAreaReply); i {
+		file_api_app_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWebhookCreateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7500,8 +14748,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserInfoRequest); i {
+		file_api_app_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWebhookCreateReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7512,8 +14760,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserInfoReply); i {
+		file_api_app_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWebhookUpdateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7524,8 +14772,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserInfo1Reply); i {
+		file_api_app_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWebhookUpdateReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7536,8 +14784,56 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RewardListRequest); i {
+		file_api_app_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWebhookDeliveryListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWebhookDeliveryListReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWebhookReplayRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWebhookReplayReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_app_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminCacheStatsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7548,8 +14844,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RewardListReply); i {
+		file_api_app_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminCacheStatsReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7560,8 +14856,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTradeRequest); i {
+		file_api_app_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminRewardListRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7572,8 +14868,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTradeReply); i {
+		file_api_app_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminRewardListReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7584,8 +14880,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RecommendRewardListRequest); i {
+		file_api_app_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminUserListRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7596,8 +14892,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RecommendRewardListReply); i {
+		file_api_app_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminUserListReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7608,8 +14904,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FeeRewardListRequest); i {
+		file_api_app_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminLocationListRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7620,8 +14916,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FeeRewardListReply); i {
+		file_api_app_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminLocationListReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7632,8 +14928,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WithdrawListRequest); i {
+		file_api_app_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawListRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7644,8 +14940,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WithdrawListReply); i {
+		file_api_app_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawListReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7656,8 +14952,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TradeListRequest); i {
+		file_api_app_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7668,8 +14964,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TradeListReply); i {
+		file_api_app_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7680,8 +14976,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TranListRequest); i {
+		file_api_app_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawEthRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7692,8 +14988,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TranListReply); i {
+		file_api_app_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawEthReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7704,8 +15000,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RecommendListRequest); i {
+		file_api_app_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminFeeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7716,8 +15012,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RecommendListReply); i {
+		file_api_app_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminFeeReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7728,8 +15024,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExchangeRequest); i {
+		file_api_app_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TokenWithdrawRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7740,8 +15036,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExchangeReply); i {
+		file_api_app_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TokenWithdrawReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7752,8 +15048,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WithdrawRequest); i {
+		file_api_app_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminAllRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7764,8 +15060,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WithdrawReply); i {
+		file_api_app_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminAllReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7776,8 +15072,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PasswordChangeRequest); i {
+		file_api_app_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminUserRecommendRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7788,8 +15084,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PasswordChangeReply); i {
+		file_api_app_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminUserRecommendReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7800,8 +15096,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TranRequest); i {
+		file_api_app_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminMonthRecommendRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7812,8 +15108,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TranReply); i {
+		file_api_app_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminMonthRecommendReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7824,8 +15120,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetBalanceRewardRequest); i {
+		file_api_app_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminConfigRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7836,8 +15132,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetBalanceRewardReply); i {
+		file_api_app_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminConfigReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7848,8 +15144,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteBalanceRewardRequest); i {
+		file_api_app_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminConfigUpdateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7860,8 +15156,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteBalanceRewardReply); i {
+		file_api_app_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminConfigUpdateReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7872,8 +15168,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminRewardListRequest); i {
+		file_api_app_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EthAuthorizeRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7884,8 +15180,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminRewardListReply); i {
+		file_api_app_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecommendUpdateRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7896,8 +15192,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminUserListRequest); i {
+		file_api_app_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserAreaReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7908,8 +15204,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminUserListReply); i {
+		file_api_app_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserInfoReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7920,8 +15216,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminLocationListRequest); i {
+		file_api_app_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserInfoReply_ListWithdraw); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7932,8 +15228,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminLocationListReply); i {
+		file_api_app_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserInfoReply_ListFour); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7944,8 +15240,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminWithdrawListRequest); i {
+		file_api_app_proto_msgTypes[124].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserInfoReply_ListReward); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7956,8 +15252,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminWithdrawListReply); i {
+		file_api_app_proto_msgTypes[125].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserInfoReply_ListRecommend); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7968,8 +15264,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminWithdrawRequest); i {
+		file_api_app_proto_msgTypes[126].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserInfoReply_ListExchange); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7980,8 +15276,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminWithdrawReply); i {
+		file_api_app_proto_msgTypes[127].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserInfo1Reply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7992,8 +15288,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminWithdrawEthRequest); i {
+		file_api_app_proto_msgTypes[128].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserInfo1Reply_List22); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8004,8 +15300,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminWithdrawEthReply); i {
+		file_api_app_proto_msgTypes[129].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserInfo1Reply_List2); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8016,8 +15312,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminFeeRequest); i {
+		file_api_app_proto_msgTypes[130].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserInfo1Reply_List5); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8028,8 +15324,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminFeeReply); i {
+		file_api_app_proto_msgTypes[131].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserInfo1Reply_List7); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8040,8 +15336,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TokenWithdrawRequest); i {
+		file_api_app_proto_msgTypes[132].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserInfo1Reply_List11); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8052,8 +15348,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TokenWithdrawReply); i {
+		file_api_app_proto_msgTypes[133].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserInfo1Reply_List9); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8064,8 +15360,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminAllRequest); i {
+		file_api_app_proto_msgTypes[134].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserInfo1Reply_List13); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8076,8 +15372,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminAllReply); i {
+		file_api_app_proto_msgTypes[135].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RewardListReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8088,8 +15384,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminUserRecommendRequest); i {
+		file_api_app_proto_msgTypes[136].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTradeRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8100,8 +15396,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminUserRecommendReply); i {
+		file_api_app_proto_msgTypes[137].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecommendRewardListReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8112,8 +15408,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminMonthRecommendRequest); i {
+		file_api_app_proto_msgTypes[138].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeeRewardListReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8124,8 +15420,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminMonthRecommendReply); i {
+		file_api_app_proto_msgTypes[139].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WithdrawListReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8136,8 +15432,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminConfigRequest); i {
+		file_api_app_proto_msgTypes[140].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TradeListReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8148,8 +15444,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminConfigReply); i {
+		file_api_app_proto_msgTypes[141].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TranListReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8160,8 +15456,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminConfigUpdateRequest); i {
+		file_api_app_proto_msgTypes[142].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HistoryReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8172,8 +15468,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminConfigUpdateReply); i {
+		file_api_app_proto_msgTypes[143].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateStatementRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8184,8 +15480,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EthAuthorizeRequest_SendBody); i {
+		file_api_app_proto_msgTypes[144].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecommendListReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8196,8 +15492,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RecommendUpdateRequest_SendBody); i {
+		file_api_app_proto_msgTypes[145].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExchangeRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8208,8 +15504,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserAreaReply_List); i {
+		file_api_app_proto_msgTypes[146].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuyLocationRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8220,8 +15516,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserInfoReply_List); i {
+		file_api_app_proto_msgTypes[147].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WithdrawRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8232,8 +15528,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserInfoReply_ListWithdraw); i {
+		file_api_app_proto_msgTypes[148].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PasswordChangeRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8244,8 +15540,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserInfoReply_ListFour); i {
+		file_api_app_proto_msgTypes[149].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TranRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8256,8 +15552,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserInfoReply_ListReward); i {
+		file_api_app_proto_msgTypes[150].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetBalanceRewardRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8268,8 +15564,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserInfoReply_ListRecommend); i {
+		file_api_app_proto_msgTypes[151].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteBalanceRewardRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8280,8 +15576,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserInfoReply_ListExchange); i {
+		file_api_app_proto_msgTypes[152].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteBalanceRewardReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8292,8 +15588,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserInfo1Reply_List); i {
+		file_api_app_proto_msgTypes[153].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BalanceRewardSummaryReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8304,8 +15600,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserInfo1Reply_List22); i {
+		file_api_app_proto_msgTypes[154].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WithdrawAddressListReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8316,8 +15612,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserInfo1Reply_List2); i {
+		file_api_app_proto_msgTypes[155].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WithdrawAddressCreateRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8328,8 +15624,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserInfo1Reply_List5); i {
+		file_api_app_proto_msgTypes[156].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WithdrawAddressDeleteRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8340,8 +15636,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserInfo1Reply_List7); i {
+		file_api_app_proto_msgTypes[157].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InviteCodeListReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8352,8 +15648,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserInfo1Reply_List11); i {
+		file_api_app_proto_msgTypes[158].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InviteCodeCreateRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8364,8 +15660,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserInfo1Reply_List9); i {
+		file_api_app_proto_msgTypes[159].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LocaleChangeRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8376,8 +15672,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserInfo1Reply_List13); i {
+		file_api_app_proto_msgTypes[160].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PushTicketRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8388,8 +15684,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RewardListReply_List); i {
+		file_api_app_proto_msgTypes[161].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminInviteCodeCreateRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8400,8 +15696,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTradeRequest_SendBody); i {
+		file_api_app_proto_msgTypes[162].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminPasswordUpdateRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8412,8 +15708,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RecommendRewardListReply_List); i {
+		file_api_app_proto_msgTypes[163].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminCommissionSimulateReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8424,8 +15720,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FeeRewardListReply_List); i {
+		file_api_app_proto_msgTypes[164].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminAreaLevelRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8436,8 +15732,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WithdrawListReply_List); i {
+		file_api_app_proto_msgTypes[165].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminAreaLevelReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8448,8 +15744,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TradeListReply_List); i {
+		file_api_app_proto_msgTypes[166].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminJobRunListReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8460,8 +15756,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TranListReply_List); i {
+		file_api_app_proto_msgTypes[167].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminLocationTransitionListReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8472,8 +15768,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RecommendListReply_List); i {
+		file_api_app_proto_msgTypes[168].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWebhookListReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8484,8 +15780,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExchangeRequest_SendBody); i {
+		file_api_app_proto_msgTypes[169].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWebhookCreateRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8496,8 +15792,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WithdrawRequest_SendBody); i {
+		file_api_app_proto_msgTypes[170].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWebhookUpdateRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8508,8 +15804,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PasswordChangeRequest_SendBody); i {
+		file_api_app_proto_msgTypes[171].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWebhookDeliveryListReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8520,8 +15816,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TranRequest_SendBody); i {
+		file_api_app_proto_msgTypes[172].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWebhookReplayRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8532,8 +15828,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetBalanceRewardRequest_SendBody); i {
+		file_api_app_proto_msgTypes[173].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminCacheStatsReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8544,8 +15840,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteBalanceRewardRequest_SendBody); i {
+		file_api_app_proto_msgTypes[174].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminRewardListReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8556,8 +15852,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminRewardListReply_List); i {
+		file_api_app_proto_msgTypes[175].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminUserListReply_UserList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8568,8 +15864,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminUserListReply_UserList); i {
+		file_api_app_proto_msgTypes[176].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminLocationListReply_LocationList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8580,8 +15876,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminLocationListReply_LocationList); i {
+		file_api_app_proto_msgTypes[177].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawListReply_List); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8592,8 +15888,8 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminWithdrawListReply_List); i {
+		file_api_app_proto_msgTypes[178].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminWithdrawRequest_SendBody); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8604,7 +15900,7 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+		file_api_app_proto_msgTypes[179].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AdminUserRecommendReply_List); i {
 			case 0:
 				return &v.state
@@ -8616,7 +15912,7 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+		file_api_app_proto_msgTypes[180].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AdminMonthRecommendReply_List); i {
 			case 0:
 				return &v.state
@@ -8628,7 +15924,7 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+		file_api_app_proto_msgTypes[181].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AdminConfigReply_List); i {
 			case 0:
 				return &v.state
@@ -8640,7 +15936,7 @@ func file_api_app_proto_init() {
 				return nil
 			}
 		}
-		file_api_app_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+		file_api_app_proto_msgTypes[182].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AdminConfigUpdateRequest_SendBody); i {
 			case 0:
 				return &v.state
@@ -8659,7 +15955,7 @@ func file_api_app_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_app_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   104,
+			NumMessages:   183,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -728,6 +728,14 @@ func (m *UserAreaRequest) validate(all bool) error {
 
 	// no validation rules for LocationId
 
+	// no validation rules for Depth
+
+	// no validation rules for Cursor
+
+	// no validation rules for PageSize
+
+	// no validation rules for Address
+
 	if len(errors) > 0 {
 		return UserAreaRequestMultiError(errors)
 	}
@@ -862,6 +870,37 @@ func (m *UserAreaReply) validate(all bool) error {
 
 	}
 
+	if all {
+		switch v := interface{}(m.GetRoot()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserAreaReplyValidationError{
+					field:  "Root",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserAreaReplyValidationError{
+					field:  "Root",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRoot()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserAreaReplyValidationError{
+				field:  "Root",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for NextCursor
+
 	if len(errors) > 0 {
 		return UserAreaReplyMultiError(errors)
 	}
@@ -1886,6 +1925,16 @@ func (m *RewardListRequest) validate(all bool) error {
 
 	// no validation rules for Type
 
+	// no validation rules for Cursor
+
+	// no validation rules for PageSize
+
+	// no validation rules for Start
+
+	// no validation rules for End
+
+	// no validation rules for Sort
+
 	if len(errors) > 0 {
 		return RewardListRequestMultiError(errors)
 	}
@@ -2022,6 +2071,12 @@ func (m *RewardListReply) validate(all bool) error {
 
 	}
 
+	// no validation rules for NextCursor
+
+	// no validation rules for Total
+
+	// no validation rules for TotalAmount
+
 	if len(errors) > 0 {
 		return RewardListReplyMultiError(errors)
 	}
@@ -2355,6 +2410,16 @@ func (m *RecommendRewardListRequest) validate(all bool) error {
 
 	var errors []error
 
+	// no validation rules for Cursor
+
+	// no validation rules for PageSize
+
+	// no validation rules for Start
+
+	// no validation rules for End
+
+	// no validation rules for Sort
+
 	if len(errors) > 0 {
 		return RecommendRewardListRequestMultiError(errors)
 	}
@@ -2491,6 +2556,12 @@ func (m *RecommendRewardListReply) validate(all bool) error {
 
 	}
 
+	// no validation rules for NextCursor
+
+	// no validation rules for Total
+
+	// no validation rules for TotalAmount
+
 	if len(errors) > 0 {
 		return RecommendRewardListReplyMultiError(errors)
 	}
@@ -2593,6 +2664,16 @@ func (m *FeeRewardListRequest) validate(all bool) error {
 
 	var errors []error
 
+	// no validation rules for Cursor
+
+	// no validation rules for PageSize
+
+	// no validation rules for Start
+
+	// no validation rules for End
+
+	// no validation rules for Sort
+
 	if len(errors) > 0 {
 		return FeeRewardListRequestMultiError(errors)
 	}
@@ -2729,6 +2810,12 @@ func (m *FeeRewardListReply) validate(all bool) error {
 
 	}
 
+	// no validation rules for NextCursor
+
+	// no validation rules for Total
+
+	// no validation rules for TotalAmount
+
 	if len(errors) > 0 {
 		return FeeRewardListReplyMultiError(errors)
 	}
@@ -2833,6 +2920,18 @@ func (m *WithdrawListRequest) validate(all bool) error {
 
 	// no validation rules for Type
 
+	// no validation rules for Status
+
+	// no validation rules for Cursor
+
+	// no validation rules for PageSize
+
+	// no validation rules for Start
+
+	// no validation rules for End
+
+	// no validation rules for Sort
+
 	if len(errors) > 0 {
 		return WithdrawListRequestMultiError(errors)
 	}
@@ -2969,6 +3068,12 @@ func (m *WithdrawListReply) validate(all bool) error {
 
 	}
 
+	// no validation rules for NextCursor
+
+	// no validation rules for Total
+
+	// no validation rules for TotalAmount
+
 	if len(errors) > 0 {
 		return WithdrawListReplyMultiError(errors)
 	}
@@ -3071,6 +3176,18 @@ func (m *TradeListRequest) validate(all bool) error {
 
 	var errors []error
 
+	// no validation rules for Status
+
+	// no validation rules for Cursor
+
+	// no validation rules for PageSize
+
+	// no validation rules for Start
+
+	// no validation rules for End
+
+	// no validation rules for Sort
+
 	if len(errors) > 0 {
 		return TradeListRequestMultiError(errors)
 	}
@@ -3205,6 +3322,12 @@ func (m *TradeListReply) validate(all bool) error {
 
 	}
 
+	// no validation rules for NextCursor
+
+	// no validation rules for Total
+
+	// no validation rules for TotalAmount
+
 	if len(errors) > 0 {
 		return TradeListReplyMultiError(errors)
 	}
@@ -3309,6 +3432,16 @@ func (m *TranListRequest) validate(all bool) error {
 
 	// no validation rules for Tran
 
+	// no validation rules for Cursor
+
+	// no validation rules for PageSize
+
+	// no validation rules for Start
+
+	// no validation rules for End
+
+	// no validation rules for Sort
+
 	if len(errors) > 0 {
 		return TranListRequestMultiError(errors)
 	}
@@ -3443,6 +3576,12 @@ func (m *TranListReply) validate(all bool) error {
 
 	}
 
+	// no validation rules for NextCursor
+
+	// no validation rules for Total
+
+	// no validation rules for TotalAmount
+
 	if len(errors) > 0 {
 		return TranListReplyMultiError(errors)
 	}
@@ -3521,44 +3660,48 @@ var _ interface {
 	ErrorName() string
 } = TranListReplyValidationError{}
 
-// Validate checks the field values on RecommendListRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RecommendListRequest) Validate() error {
+// Validate checks the field values on FeePreviewRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *FeePreviewRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RecommendListRequest with the rules
+// ValidateAll checks the field values on FeePreviewRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RecommendListRequestMultiError, or nil if none found.
-func (m *RecommendListRequest) ValidateAll() error {
+// FeePreviewRequestMultiError, or nil if none found.
+func (m *FeePreviewRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RecommendListRequest) validate(all bool) error {
+func (m *FeePreviewRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Type
+	// no validation rules for Action
+
+	// no validation rules for Coin
+
+	// no validation rules for Amount
 
 	if len(errors) > 0 {
-		return RecommendListRequestMultiError(errors)
+		return FeePreviewRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// RecommendListRequestMultiError is an error wrapping multiple validation
-// errors returned by RecommendListRequest.ValidateAll() if the designated
-// constraints aren't met.
-type RecommendListRequestMultiError []error
+// FeePreviewRequestMultiError is an error wrapping multiple validation errors
+// returned by FeePreviewRequest.ValidateAll() if the designated constraints
+// aren't met.
+type FeePreviewRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RecommendListRequestMultiError) Error() string {
+func (m FeePreviewRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3567,11 +3710,11 @@ func (m RecommendListRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RecommendListRequestMultiError) AllErrors() []error { return m }
+func (m FeePreviewRequestMultiError) AllErrors() []error { return m }
 
-// RecommendListRequestValidationError is the validation error returned by
-// RecommendListRequest.Validate if the designated constraints aren't met.
-type RecommendListRequestValidationError struct {
+// FeePreviewRequestValidationError is the validation error returned by
+// FeePreviewRequest.Validate if the designated constraints aren't met.
+type FeePreviewRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3579,24 +3722,24 @@ type RecommendListRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RecommendListRequestValidationError) Field() string { return e.field }
+func (e FeePreviewRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RecommendListRequestValidationError) Reason() string { return e.reason }
+func (e FeePreviewRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RecommendListRequestValidationError) Cause() error { return e.cause }
+func (e FeePreviewRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RecommendListRequestValidationError) Key() bool { return e.key }
+func (e FeePreviewRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RecommendListRequestValidationError) ErrorName() string {
-	return "RecommendListRequestValidationError"
+func (e FeePreviewRequestValidationError) ErrorName() string {
+	return "FeePreviewRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RecommendListRequestValidationError) Error() string {
+func (e FeePreviewRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3608,14 +3751,14 @@ func (e RecommendListRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRecommendListRequest.%s: %s%s",
+		"invalid %sFeePreviewRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RecommendListRequestValidationError{}
+var _ error = FeePreviewRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3623,78 +3766,56 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RecommendListRequestValidationError{}
+} = FeePreviewRequestValidationError{}
 
-// Validate checks the field values on RecommendListReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RecommendListReply) Validate() error {
+// Validate checks the field values on FeePreviewReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *FeePreviewReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RecommendListReply with the rules
+// ValidateAll checks the field values on FeePreviewReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RecommendListReplyMultiError, or nil if none found.
-func (m *RecommendListReply) ValidateAll() error {
+// FeePreviewReplyMultiError, or nil if none found.
+func (m *FeePreviewReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RecommendListReply) validate(all bool) error {
+func (m *FeePreviewReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetRecommends() {
-		_, _ = idx, item
+	// no validation rules for Action
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, RecommendListReplyValidationError{
-						field:  fmt.Sprintf("Recommends[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, RecommendListReplyValidationError{
-						field:  fmt.Sprintf("Recommends[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return RecommendListReplyValidationError{
-					field:  fmt.Sprintf("Recommends[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for Coin
 
-	}
+	// no validation rules for Amount
+
+	// no validation rules for Fee
+
+	// no validation rules for Burn
+
+	// no validation rules for Net
 
 	if len(errors) > 0 {
-		return RecommendListReplyMultiError(errors)
+		return FeePreviewReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// RecommendListReplyMultiError is an error wrapping multiple validation errors
-// returned by RecommendListReply.ValidateAll() if the designated constraints
+// FeePreviewReplyMultiError is an error wrapping multiple validation errors
+// returned by FeePreviewReply.ValidateAll() if the designated constraints
 // aren't met.
-type RecommendListReplyMultiError []error
+type FeePreviewReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RecommendListReplyMultiError) Error() string {
+func (m FeePreviewReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3703,11 +3824,11 @@ func (m RecommendListReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RecommendListReplyMultiError) AllErrors() []error { return m }
+func (m FeePreviewReplyMultiError) AllErrors() []error { return m }
 
-// RecommendListReplyValidationError is the validation error returned by
-// RecommendListReply.Validate if the designated constraints aren't met.
-type RecommendListReplyValidationError struct {
+// FeePreviewReplyValidationError is the validation error returned by
+// FeePreviewReply.Validate if the designated constraints aren't met.
+type FeePreviewReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3715,24 +3836,22 @@ type RecommendListReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e RecommendListReplyValidationError) Field() string { return e.field }
+func (e FeePreviewReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RecommendListReplyValidationError) Reason() string { return e.reason }
+func (e FeePreviewReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RecommendListReplyValidationError) Cause() error { return e.cause }
+func (e FeePreviewReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RecommendListReplyValidationError) Key() bool { return e.key }
+func (e FeePreviewReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RecommendListReplyValidationError) ErrorName() string {
-	return "RecommendListReplyValidationError"
-}
+func (e FeePreviewReplyValidationError) ErrorName() string { return "FeePreviewReplyValidationError" }
 
 // Error satisfies the builtin error interface
-func (e RecommendListReplyValidationError) Error() string {
+func (e FeePreviewReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3744,14 +3863,14 @@ func (e RecommendListReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRecommendListReply.%s: %s%s",
+		"invalid %sFeePreviewReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RecommendListReplyValidationError{}
+var _ error = FeePreviewReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -3759,73 +3878,64 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RecommendListReplyValidationError{}
+} = FeePreviewReplyValidationError{}
 
-// Validate checks the field values on ExchangeRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *ExchangeRequest) Validate() error {
+// Validate checks the field values on HistoryRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *HistoryRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ExchangeRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ExchangeRequestMultiError, or nil if none found.
-func (m *ExchangeRequest) ValidateAll() error {
+// ValidateAll checks the field values on HistoryRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in HistoryRequestMultiError,
+// or nil if none found.
+func (m *HistoryRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ExchangeRequest) validate(all bool) error {
+func (m *HistoryRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetSendBody()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ExchangeRequestValidationError{
-					field:  "SendBody",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ExchangeRequestValidationError{
-					field:  "SendBody",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ExchangeRequestValidationError{
-				field:  "SendBody",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Kind
+
+	// no validation rules for Type
+
+	// no validation rules for Reason
+
+	// no validation rules for Coin
+
+	// no validation rules for Status
+
+	// no validation rules for Cursor
+
+	// no validation rules for PageSize
+
+	// no validation rules for Start
+
+	// no validation rules for End
+
+	// no validation rules for Sort
 
 	if len(errors) > 0 {
-		return ExchangeRequestMultiError(errors)
+		return HistoryRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ExchangeRequestMultiError is an error wrapping multiple validation errors
-// returned by ExchangeRequest.ValidateAll() if the designated constraints
+// HistoryRequestMultiError is an error wrapping multiple validation errors
+// returned by HistoryRequest.ValidateAll() if the designated constraints
 // aren't met.
-type ExchangeRequestMultiError []error
+type HistoryRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ExchangeRequestMultiError) Error() string {
+func (m HistoryRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3834,11 +3944,11 @@ func (m ExchangeRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ExchangeRequestMultiError) AllErrors() []error { return m }
+func (m HistoryRequestMultiError) AllErrors() []error { return m }
 
-// ExchangeRequestValidationError is the validation error returned by
-// ExchangeRequest.Validate if the designated constraints aren't met.
-type ExchangeRequestValidationError struct {
+// HistoryRequestValidationError is the validation error returned by
+// HistoryRequest.Validate if the designated constraints aren't met.
+type HistoryRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3846,22 +3956,22 @@ type ExchangeRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ExchangeRequestValidationError) Field() string { return e.field }
+func (e HistoryRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ExchangeRequestValidationError) Reason() string { return e.reason }
+func (e HistoryRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ExchangeRequestValidationError) Cause() error { return e.cause }
+func (e HistoryRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ExchangeRequestValidationError) Key() bool { return e.key }
+func (e HistoryRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ExchangeRequestValidationError) ErrorName() string { return "ExchangeRequestValidationError" }
+func (e HistoryRequestValidationError) ErrorName() string { return "HistoryRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ExchangeRequestValidationError) Error() string {
+func (e HistoryRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3873,14 +3983,14 @@ func (e ExchangeRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sExchangeRequest.%s: %s%s",
+		"invalid %sHistoryRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ExchangeRequestValidationError{}
+var _ error = HistoryRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3888,46 +3998,83 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ExchangeRequestValidationError{}
+} = HistoryRequestValidationError{}
 
-// Validate checks the field values on ExchangeReply with the rules defined in
+// Validate checks the field values on HistoryReply with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *ExchangeReply) Validate() error {
+func (m *HistoryReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ExchangeReply with the rules defined
+// ValidateAll checks the field values on HistoryReply with the rules defined
 // in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in ExchangeReplyMultiError, or
+// result is a list of violation errors wrapped in HistoryReplyMultiError, or
 // nil if none found.
-func (m *ExchangeReply) ValidateAll() error {
+func (m *HistoryReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ExchangeReply) validate(all bool) error {
+func (m *HistoryReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Status
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, HistoryReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, HistoryReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return HistoryReplyValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextCursor
+
+	// no validation rules for Total
+
+	// no validation rules for TotalAmount
 
 	if len(errors) > 0 {
-		return ExchangeReplyMultiError(errors)
+		return HistoryReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// ExchangeReplyMultiError is an error wrapping multiple validation errors
-// returned by ExchangeReply.ValidateAll() if the designated constraints
-// aren't met.
-type ExchangeReplyMultiError []error
+// HistoryReplyMultiError is an error wrapping multiple validation errors
+// returned by HistoryReply.ValidateAll() if the designated constraints aren't met.
+type HistoryReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ExchangeReplyMultiError) Error() string {
+func (m HistoryReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3936,11 +4083,11 @@ func (m ExchangeReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ExchangeReplyMultiError) AllErrors() []error { return m }
+func (m HistoryReplyMultiError) AllErrors() []error { return m }
 
-// ExchangeReplyValidationError is the validation error returned by
-// ExchangeReply.Validate if the designated constraints aren't met.
-type ExchangeReplyValidationError struct {
+// HistoryReplyValidationError is the validation error returned by
+// HistoryReply.Validate if the designated constraints aren't met.
+type HistoryReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3948,22 +4095,22 @@ type ExchangeReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e ExchangeReplyValidationError) Field() string { return e.field }
+func (e HistoryReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ExchangeReplyValidationError) Reason() string { return e.reason }
+func (e HistoryReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ExchangeReplyValidationError) Cause() error { return e.cause }
+func (e HistoryReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ExchangeReplyValidationError) Key() bool { return e.key }
+func (e HistoryReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ExchangeReplyValidationError) ErrorName() string { return "ExchangeReplyValidationError" }
+func (e HistoryReplyValidationError) ErrorName() string { return "HistoryReplyValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ExchangeReplyValidationError) Error() string {
+func (e HistoryReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3975,14 +4122,14 @@ func (e ExchangeReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sExchangeReply.%s: %s%s",
+		"invalid %sHistoryReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ExchangeReplyValidationError{}
+var _ error = HistoryReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -3990,24 +4137,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ExchangeReplyValidationError{}
+} = HistoryReplyValidationError{}
 
-// Validate checks the field values on WithdrawRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *WithdrawRequest) Validate() error {
+// Validate checks the field values on CreateStatementRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateStatementRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on WithdrawRequest with the rules
+// ValidateAll checks the field values on CreateStatementRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// WithdrawRequestMultiError, or nil if none found.
-func (m *WithdrawRequest) ValidateAll() error {
+// CreateStatementRequestMultiError, or nil if none found.
+func (m *CreateStatementRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *WithdrawRequest) validate(all bool) error {
+func (m *CreateStatementRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -4018,7 +4165,7 @@ func (m *WithdrawRequest) validate(all bool) error {
 		switch v := interface{}(m.GetSendBody()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, WithdrawRequestValidationError{
+				errors = append(errors, CreateStatementRequestValidationError{
 					field:  "SendBody",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -4026,7 +4173,7 @@ func (m *WithdrawRequest) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, WithdrawRequestValidationError{
+				errors = append(errors, CreateStatementRequestValidationError{
 					field:  "SendBody",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -4035,7 +4182,7 @@ func (m *WithdrawRequest) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return WithdrawRequestValidationError{
+			return CreateStatementRequestValidationError{
 				field:  "SendBody",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -4044,19 +4191,19 @@ func (m *WithdrawRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return WithdrawRequestMultiError(errors)
+		return CreateStatementRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// WithdrawRequestMultiError is an error wrapping multiple validation errors
-// returned by WithdrawRequest.ValidateAll() if the designated constraints
-// aren't met.
-type WithdrawRequestMultiError []error
+// CreateStatementRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateStatementRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateStatementRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m WithdrawRequestMultiError) Error() string {
+func (m CreateStatementRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4065,11 +4212,11 @@ func (m WithdrawRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m WithdrawRequestMultiError) AllErrors() []error { return m }
+func (m CreateStatementRequestMultiError) AllErrors() []error { return m }
 
-// WithdrawRequestValidationError is the validation error returned by
-// WithdrawRequest.Validate if the designated constraints aren't met.
-type WithdrawRequestValidationError struct {
+// CreateStatementRequestValidationError is the validation error returned by
+// CreateStatementRequest.Validate if the designated constraints aren't met.
+type CreateStatementRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4077,22 +4224,24 @@ type WithdrawRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e WithdrawRequestValidationError) Field() string { return e.field }
+func (e CreateStatementRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e WithdrawRequestValidationError) Reason() string { return e.reason }
+func (e CreateStatementRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e WithdrawRequestValidationError) Cause() error { return e.cause }
+func (e CreateStatementRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e WithdrawRequestValidationError) Key() bool { return e.key }
+func (e CreateStatementRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e WithdrawRequestValidationError) ErrorName() string { return "WithdrawRequestValidationError" }
+func (e CreateStatementRequestValidationError) ErrorName() string {
+	return "CreateStatementRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e WithdrawRequestValidationError) Error() string {
+func (e CreateStatementRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4104,14 +4253,14 @@ func (e WithdrawRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sWithdrawRequest.%s: %s%s",
+		"invalid %sCreateStatementRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = WithdrawRequestValidationError{}
+var _ error = CreateStatementRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4119,46 +4268,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = WithdrawRequestValidationError{}
+} = CreateStatementRequestValidationError{}
 
-// Validate checks the field values on WithdrawReply with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *WithdrawReply) Validate() error {
+// Validate checks the field values on CreateStatementReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateStatementReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on WithdrawReply with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in WithdrawReplyMultiError, or
-// nil if none found.
-func (m *WithdrawReply) ValidateAll() error {
+// ValidateAll checks the field values on CreateStatementReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateStatementReplyMultiError, or nil if none found.
+func (m *CreateStatementReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *WithdrawReply) validate(all bool) error {
+func (m *CreateStatementReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Id
+
 	// no validation rules for Status
 
+	// no validation rules for Url
+
+	// no validation rules for Message
+
 	if len(errors) > 0 {
-		return WithdrawReplyMultiError(errors)
+		return CreateStatementReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// WithdrawReplyMultiError is an error wrapping multiple validation errors
-// returned by WithdrawReply.ValidateAll() if the designated constraints
-// aren't met.
-type WithdrawReplyMultiError []error
+// CreateStatementReplyMultiError is an error wrapping multiple validation
+// errors returned by CreateStatementReply.ValidateAll() if the designated
+// constraints aren't met.
+type CreateStatementReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m WithdrawReplyMultiError) Error() string {
+func (m CreateStatementReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4167,11 +4322,11 @@ func (m WithdrawReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m WithdrawReplyMultiError) AllErrors() []error { return m }
+func (m CreateStatementReplyMultiError) AllErrors() []error { return m }
 
-// WithdrawReplyValidationError is the validation error returned by
-// WithdrawReply.Validate if the designated constraints aren't met.
-type WithdrawReplyValidationError struct {
+// CreateStatementReplyValidationError is the validation error returned by
+// CreateStatementReply.Validate if the designated constraints aren't met.
+type CreateStatementReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4179,22 +4334,24 @@ type WithdrawReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e WithdrawReplyValidationError) Field() string { return e.field }
+func (e CreateStatementReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e WithdrawReplyValidationError) Reason() string { return e.reason }
+func (e CreateStatementReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e WithdrawReplyValidationError) Cause() error { return e.cause }
+func (e CreateStatementReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e WithdrawReplyValidationError) Key() bool { return e.key }
+func (e CreateStatementReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e WithdrawReplyValidationError) ErrorName() string { return "WithdrawReplyValidationError" }
+func (e CreateStatementReplyValidationError) ErrorName() string {
+	return "CreateStatementReplyValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e WithdrawReplyValidationError) Error() string {
+func (e CreateStatementReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4206,14 +4363,14 @@ func (e WithdrawReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sWithdrawReply.%s: %s%s",
+		"invalid %sCreateStatementReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = WithdrawReplyValidationError{}
+var _ error = CreateStatementReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -4221,73 +4378,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = WithdrawReplyValidationError{}
+} = CreateStatementReplyValidationError{}
 
-// Validate checks the field values on PasswordChangeRequest with the rules
+// Validate checks the field values on GetStatementRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *PasswordChangeRequest) Validate() error {
+func (m *GetStatementRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PasswordChangeRequest with the rules
+// ValidateAll checks the field values on GetStatementRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// PasswordChangeRequestMultiError, or nil if none found.
-func (m *PasswordChangeRequest) ValidateAll() error {
+// GetStatementRequestMultiError, or nil if none found.
+func (m *GetStatementRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PasswordChangeRequest) validate(all bool) error {
+func (m *GetStatementRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetSendBody()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, PasswordChangeRequestValidationError{
-					field:  "SendBody",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, PasswordChangeRequestValidationError{
-					field:  "SendBody",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return PasswordChangeRequestValidationError{
-				field:  "SendBody",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Id
 
 	if len(errors) > 0 {
-		return PasswordChangeRequestMultiError(errors)
+		return GetStatementRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// PasswordChangeRequestMultiError is an error wrapping multiple validation
-// errors returned by PasswordChangeRequest.ValidateAll() if the designated
+// GetStatementRequestMultiError is an error wrapping multiple validation
+// errors returned by GetStatementRequest.ValidateAll() if the designated
 // constraints aren't met.
-type PasswordChangeRequestMultiError []error
+type GetStatementRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PasswordChangeRequestMultiError) Error() string {
+func (m GetStatementRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4296,11 +4426,11 @@ func (m PasswordChangeRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PasswordChangeRequestMultiError) AllErrors() []error { return m }
+func (m GetStatementRequestMultiError) AllErrors() []error { return m }
 
-// PasswordChangeRequestValidationError is the validation error returned by
-// PasswordChangeRequest.Validate if the designated constraints aren't met.
-type PasswordChangeRequestValidationError struct {
+// GetStatementRequestValidationError is the validation error returned by
+// GetStatementRequest.Validate if the designated constraints aren't met.
+type GetStatementRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4308,24 +4438,24 @@ type PasswordChangeRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e PasswordChangeRequestValidationError) Field() string { return e.field }
+func (e GetStatementRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PasswordChangeRequestValidationError) Reason() string { return e.reason }
+func (e GetStatementRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PasswordChangeRequestValidationError) Cause() error { return e.cause }
+func (e GetStatementRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PasswordChangeRequestValidationError) Key() bool { return e.key }
+func (e GetStatementRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PasswordChangeRequestValidationError) ErrorName() string {
-	return "PasswordChangeRequestValidationError"
+func (e GetStatementRequestValidationError) ErrorName() string {
+	return "GetStatementRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PasswordChangeRequestValidationError) Error() string {
+func (e GetStatementRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4337,14 +4467,14 @@ func (e PasswordChangeRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPasswordChangeRequest.%s: %s%s",
+		"invalid %sGetStatementRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PasswordChangeRequestValidationError{}
+var _ error = GetStatementRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4352,46 +4482,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PasswordChangeRequestValidationError{}
+} = GetStatementRequestValidationError{}
 
-// Validate checks the field values on PasswordChangeReply with the rules
+// Validate checks the field values on RecommendListRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *PasswordChangeReply) Validate() error {
+func (m *RecommendListRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PasswordChangeReply with the rules
+// ValidateAll checks the field values on RecommendListRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// PasswordChangeReplyMultiError, or nil if none found.
-func (m *PasswordChangeReply) ValidateAll() error {
+// RecommendListRequestMultiError, or nil if none found.
+func (m *RecommendListRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PasswordChangeReply) validate(all bool) error {
+func (m *RecommendListRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Password
+	// no validation rules for Type
 
 	if len(errors) > 0 {
-		return PasswordChangeReplyMultiError(errors)
+		return RecommendListRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// PasswordChangeReplyMultiError is an error wrapping multiple validation
-// errors returned by PasswordChangeReply.ValidateAll() if the designated
+// RecommendListRequestMultiError is an error wrapping multiple validation
+// errors returned by RecommendListRequest.ValidateAll() if the designated
 // constraints aren't met.
-type PasswordChangeReplyMultiError []error
+type RecommendListRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PasswordChangeReplyMultiError) Error() string {
+func (m RecommendListRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4400,11 +4530,11 @@ func (m PasswordChangeReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PasswordChangeReplyMultiError) AllErrors() []error { return m }
+func (m RecommendListRequestMultiError) AllErrors() []error { return m }
 
-// PasswordChangeReplyValidationError is the validation error returned by
-// PasswordChangeReply.Validate if the designated constraints aren't met.
-type PasswordChangeReplyValidationError struct {
+// RecommendListRequestValidationError is the validation error returned by
+// RecommendListRequest.Validate if the designated constraints aren't met.
+type RecommendListRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4412,24 +4542,24 @@ type PasswordChangeReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e PasswordChangeReplyValidationError) Field() string { return e.field }
+func (e RecommendListRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PasswordChangeReplyValidationError) Reason() string { return e.reason }
+func (e RecommendListRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PasswordChangeReplyValidationError) Cause() error { return e.cause }
+func (e RecommendListRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PasswordChangeReplyValidationError) Key() bool { return e.key }
+func (e RecommendListRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PasswordChangeReplyValidationError) ErrorName() string {
-	return "PasswordChangeReplyValidationError"
+func (e RecommendListRequestValidationError) ErrorName() string {
+	return "RecommendListRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PasswordChangeReplyValidationError) Error() string {
+func (e RecommendListRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4441,14 +4571,14 @@ func (e PasswordChangeReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPasswordChangeReply.%s: %s%s",
+		"invalid %sRecommendListRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PasswordChangeReplyValidationError{}
+var _ error = RecommendListRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4456,24 +4586,160 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PasswordChangeReplyValidationError{}
+} = RecommendListRequestValidationError{}
 
-// Validate checks the field values on TranRequest with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *TranRequest) Validate() error {
+// Validate checks the field values on RecommendListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RecommendListReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TranRequest with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in TranRequestMultiError, or
-// nil if none found.
-func (m *TranRequest) ValidateAll() error {
+// ValidateAll checks the field values on RecommendListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RecommendListReplyMultiError, or nil if none found.
+func (m *RecommendListReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TranRequest) validate(all bool) error {
+func (m *RecommendListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRecommends() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RecommendListReplyValidationError{
+						field:  fmt.Sprintf("Recommends[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RecommendListReplyValidationError{
+						field:  fmt.Sprintf("Recommends[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RecommendListReplyValidationError{
+					field:  fmt.Sprintf("Recommends[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return RecommendListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// RecommendListReplyMultiError is an error wrapping multiple validation errors
+// returned by RecommendListReply.ValidateAll() if the designated constraints
+// aren't met.
+type RecommendListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RecommendListReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RecommendListReplyMultiError) AllErrors() []error { return m }
+
+// RecommendListReplyValidationError is the validation error returned by
+// RecommendListReply.Validate if the designated constraints aren't met.
+type RecommendListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RecommendListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RecommendListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RecommendListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RecommendListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RecommendListReplyValidationError) ErrorName() string {
+	return "RecommendListReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RecommendListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRecommendListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RecommendListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RecommendListReplyValidationError{}
+
+// Validate checks the field values on ExchangeRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ExchangeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExchangeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExchangeRequestMultiError, or nil if none found.
+func (m *ExchangeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExchangeRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -4484,7 +4750,7 @@ func (m *TranRequest) validate(all bool) error {
 		switch v := interface{}(m.GetSendBody()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, TranRequestValidationError{
+				errors = append(errors, ExchangeRequestValidationError{
 					field:  "SendBody",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -4492,7 +4758,7 @@ func (m *TranRequest) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, TranRequestValidationError{
+				errors = append(errors, ExchangeRequestValidationError{
 					field:  "SendBody",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -4501,7 +4767,7 @@ func (m *TranRequest) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return TranRequestValidationError{
+			return ExchangeRequestValidationError{
 				field:  "SendBody",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -4510,18 +4776,19 @@ func (m *TranRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return TranRequestMultiError(errors)
+		return ExchangeRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// TranRequestMultiError is an error wrapping multiple validation errors
-// returned by TranRequest.ValidateAll() if the designated constraints aren't met.
-type TranRequestMultiError []error
+// ExchangeRequestMultiError is an error wrapping multiple validation errors
+// returned by ExchangeRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ExchangeRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TranRequestMultiError) Error() string {
+func (m ExchangeRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4530,11 +4797,11 @@ func (m TranRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TranRequestMultiError) AllErrors() []error { return m }
+func (m ExchangeRequestMultiError) AllErrors() []error { return m }
 
-// TranRequestValidationError is the validation error returned by
-// TranRequest.Validate if the designated constraints aren't met.
-type TranRequestValidationError struct {
+// ExchangeRequestValidationError is the validation error returned by
+// ExchangeRequest.Validate if the designated constraints aren't met.
+type ExchangeRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4542,22 +4809,22 @@ type TranRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e TranRequestValidationError) Field() string { return e.field }
+func (e ExchangeRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TranRequestValidationError) Reason() string { return e.reason }
+func (e ExchangeRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TranRequestValidationError) Cause() error { return e.cause }
+func (e ExchangeRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TranRequestValidationError) Key() bool { return e.key }
+func (e ExchangeRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TranRequestValidationError) ErrorName() string { return "TranRequestValidationError" }
+func (e ExchangeRequestValidationError) ErrorName() string { return "ExchangeRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e TranRequestValidationError) Error() string {
+func (e ExchangeRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4569,14 +4836,14 @@ func (e TranRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTranRequest.%s: %s%s",
+		"invalid %sExchangeRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TranRequestValidationError{}
+var _ error = ExchangeRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4584,24 +4851,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TranRequestValidationError{}
+} = ExchangeRequestValidationError{}
 
-// Validate checks the field values on TranReply with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on ExchangeReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *TranReply) Validate() error {
+func (m *ExchangeReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TranReply with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in TranReplyMultiError, or nil
-// if none found.
-func (m *TranReply) ValidateAll() error {
+// ValidateAll checks the field values on ExchangeReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ExchangeReplyMultiError, or
+// nil if none found.
+func (m *ExchangeReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TranReply) validate(all bool) error {
+func (m *ExchangeReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -4611,18 +4878,19 @@ func (m *TranReply) validate(all bool) error {
 	// no validation rules for Status
 
 	if len(errors) > 0 {
-		return TranReplyMultiError(errors)
+		return ExchangeReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// TranReplyMultiError is an error wrapping multiple validation errors returned
-// by TranReply.ValidateAll() if the designated constraints aren't met.
-type TranReplyMultiError []error
+// ExchangeReplyMultiError is an error wrapping multiple validation errors
+// returned by ExchangeReply.ValidateAll() if the designated constraints
+// aren't met.
+type ExchangeReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TranReplyMultiError) Error() string {
+func (m ExchangeReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4631,11 +4899,11 @@ func (m TranReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TranReplyMultiError) AllErrors() []error { return m }
+func (m ExchangeReplyMultiError) AllErrors() []error { return m }
 
-// TranReplyValidationError is the validation error returned by
-// TranReply.Validate if the designated constraints aren't met.
-type TranReplyValidationError struct {
+// ExchangeReplyValidationError is the validation error returned by
+// ExchangeReply.Validate if the designated constraints aren't met.
+type ExchangeReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4643,22 +4911,22 @@ type TranReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e TranReplyValidationError) Field() string { return e.field }
+func (e ExchangeReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TranReplyValidationError) Reason() string { return e.reason }
+func (e ExchangeReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TranReplyValidationError) Cause() error { return e.cause }
+func (e ExchangeReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TranReplyValidationError) Key() bool { return e.key }
+func (e ExchangeReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TranReplyValidationError) ErrorName() string { return "TranReplyValidationError" }
+func (e ExchangeReplyValidationError) ErrorName() string { return "ExchangeReplyValidationError" }
 
 // Error satisfies the builtin error interface
-func (e TranReplyValidationError) Error() string {
+func (e ExchangeReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4670,14 +4938,14 @@ func (e TranReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTranReply.%s: %s%s",
+		"invalid %sExchangeReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TranReplyValidationError{}
+var _ error = ExchangeReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -4685,24 +4953,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TranReplyValidationError{}
+} = ExchangeReplyValidationError{}
 
-// Validate checks the field values on SetBalanceRewardRequest with the rules
+// Validate checks the field values on BuyLocationRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SetBalanceRewardRequest) Validate() error {
+func (m *BuyLocationRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SetBalanceRewardRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on BuyLocationRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SetBalanceRewardRequestMultiError, or nil if none found.
-func (m *SetBalanceRewardRequest) ValidateAll() error {
+// BuyLocationRequestMultiError, or nil if none found.
+func (m *BuyLocationRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SetBalanceRewardRequest) validate(all bool) error {
+func (m *BuyLocationRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -4713,7 +4981,7 @@ func (m *SetBalanceRewardRequest) validate(all bool) error {
 		switch v := interface{}(m.GetSendBody()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SetBalanceRewardRequestValidationError{
+				errors = append(errors, BuyLocationRequestValidationError{
 					field:  "SendBody",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -4721,7 +4989,7 @@ func (m *SetBalanceRewardRequest) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, SetBalanceRewardRequestValidationError{
+				errors = append(errors, BuyLocationRequestValidationError{
 					field:  "SendBody",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -4730,7 +4998,7 @@ func (m *SetBalanceRewardRequest) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return SetBalanceRewardRequestValidationError{
+			return BuyLocationRequestValidationError{
 				field:  "SendBody",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -4739,19 +5007,19 @@ func (m *SetBalanceRewardRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return SetBalanceRewardRequestMultiError(errors)
+		return BuyLocationRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// SetBalanceRewardRequestMultiError is an error wrapping multiple validation
-// errors returned by SetBalanceRewardRequest.ValidateAll() if the designated
-// constraints aren't met.
-type SetBalanceRewardRequestMultiError []error
+// BuyLocationRequestMultiError is an error wrapping multiple validation errors
+// returned by BuyLocationRequest.ValidateAll() if the designated constraints
+// aren't met.
+type BuyLocationRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SetBalanceRewardRequestMultiError) Error() string {
+func (m BuyLocationRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4760,11 +5028,11 @@ func (m SetBalanceRewardRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SetBalanceRewardRequestMultiError) AllErrors() []error { return m }
+func (m BuyLocationRequestMultiError) AllErrors() []error { return m }
 
-// SetBalanceRewardRequestValidationError is the validation error returned by
-// SetBalanceRewardRequest.Validate if the designated constraints aren't met.
-type SetBalanceRewardRequestValidationError struct {
+// BuyLocationRequestValidationError is the validation error returned by
+// BuyLocationRequest.Validate if the designated constraints aren't met.
+type BuyLocationRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4772,24 +5040,24 @@ type SetBalanceRewardRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e SetBalanceRewardRequestValidationError) Field() string { return e.field }
+func (e BuyLocationRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SetBalanceRewardRequestValidationError) Reason() string { return e.reason }
+func (e BuyLocationRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SetBalanceRewardRequestValidationError) Cause() error { return e.cause }
+func (e BuyLocationRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SetBalanceRewardRequestValidationError) Key() bool { return e.key }
+func (e BuyLocationRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SetBalanceRewardRequestValidationError) ErrorName() string {
-	return "SetBalanceRewardRequestValidationError"
+func (e BuyLocationRequestValidationError) ErrorName() string {
+	return "BuyLocationRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SetBalanceRewardRequestValidationError) Error() string {
+func (e BuyLocationRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4801,14 +5069,14 @@ func (e SetBalanceRewardRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSetBalanceRewardRequest.%s: %s%s",
+		"invalid %sBuyLocationRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SetBalanceRewardRequestValidationError{}
+var _ error = BuyLocationRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4816,24 +5084,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SetBalanceRewardRequestValidationError{}
+} = BuyLocationRequestValidationError{}
 
-// Validate checks the field values on SetBalanceRewardReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SetBalanceRewardReply) Validate() error {
+// Validate checks the field values on BuyLocationReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *BuyLocationReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SetBalanceRewardReply with the rules
+// ValidateAll checks the field values on BuyLocationReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SetBalanceRewardReplyMultiError, or nil if none found.
-func (m *SetBalanceRewardReply) ValidateAll() error {
+// BuyLocationReplyMultiError, or nil if none found.
+func (m *BuyLocationReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SetBalanceRewardReply) validate(all bool) error {
+func (m *BuyLocationReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -4842,20 +5110,26 @@ func (m *SetBalanceRewardReply) validate(all bool) error {
 
 	// no validation rules for Status
 
+	// no validation rules for LocationId
+
+	// no validation rules for Usdt
+
+	// no validation rules for CurrentMax
+
 	if len(errors) > 0 {
-		return SetBalanceRewardReplyMultiError(errors)
+		return BuyLocationReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// SetBalanceRewardReplyMultiError is an error wrapping multiple validation
-// errors returned by SetBalanceRewardReply.ValidateAll() if the designated
-// constraints aren't met.
-type SetBalanceRewardReplyMultiError []error
+// BuyLocationReplyMultiError is an error wrapping multiple validation errors
+// returned by BuyLocationReply.ValidateAll() if the designated constraints
+// aren't met.
+type BuyLocationReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SetBalanceRewardReplyMultiError) Error() string {
+func (m BuyLocationReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4864,11 +5138,11 @@ func (m SetBalanceRewardReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SetBalanceRewardReplyMultiError) AllErrors() []error { return m }
+func (m BuyLocationReplyMultiError) AllErrors() []error { return m }
 
-// SetBalanceRewardReplyValidationError is the validation error returned by
-// SetBalanceRewardReply.Validate if the designated constraints aren't met.
-type SetBalanceRewardReplyValidationError struct {
+// BuyLocationReplyValidationError is the validation error returned by
+// BuyLocationReply.Validate if the designated constraints aren't met.
+type BuyLocationReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4876,24 +5150,22 @@ type SetBalanceRewardReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e SetBalanceRewardReplyValidationError) Field() string { return e.field }
+func (e BuyLocationReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SetBalanceRewardReplyValidationError) Reason() string { return e.reason }
+func (e BuyLocationReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SetBalanceRewardReplyValidationError) Cause() error { return e.cause }
+func (e BuyLocationReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SetBalanceRewardReplyValidationError) Key() bool { return e.key }
+func (e BuyLocationReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SetBalanceRewardReplyValidationError) ErrorName() string {
-	return "SetBalanceRewardReplyValidationError"
-}
+func (e BuyLocationReplyValidationError) ErrorName() string { return "BuyLocationReplyValidationError" }
 
 // Error satisfies the builtin error interface
-func (e SetBalanceRewardReplyValidationError) Error() string {
+func (e BuyLocationReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4905,14 +5177,14 @@ func (e SetBalanceRewardReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSetBalanceRewardReply.%s: %s%s",
+		"invalid %sBuyLocationReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SetBalanceRewardReplyValidationError{}
+var _ error = BuyLocationReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -4920,24 +5192,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SetBalanceRewardReplyValidationError{}
+} = BuyLocationReplyValidationError{}
 
-// Validate checks the field values on DeleteBalanceRewardRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteBalanceRewardRequest) Validate() error {
+// Validate checks the field values on WithdrawRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *WithdrawRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteBalanceRewardRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on WithdrawRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteBalanceRewardRequestMultiError, or nil if none found.
-func (m *DeleteBalanceRewardRequest) ValidateAll() error {
+// WithdrawRequestMultiError, or nil if none found.
+func (m *WithdrawRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteBalanceRewardRequest) validate(all bool) error {
+func (m *WithdrawRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -4948,7 +5220,7 @@ func (m *DeleteBalanceRewardRequest) validate(all bool) error {
 		switch v := interface{}(m.GetSendBody()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, DeleteBalanceRewardRequestValidationError{
+				errors = append(errors, WithdrawRequestValidationError{
 					field:  "SendBody",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -4956,7 +5228,7 @@ func (m *DeleteBalanceRewardRequest) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, DeleteBalanceRewardRequestValidationError{
+				errors = append(errors, WithdrawRequestValidationError{
 					field:  "SendBody",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -4965,7 +5237,7 @@ func (m *DeleteBalanceRewardRequest) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return DeleteBalanceRewardRequestValidationError{
+			return WithdrawRequestValidationError{
 				field:  "SendBody",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -4974,19 +5246,19 @@ func (m *DeleteBalanceRewardRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return DeleteBalanceRewardRequestMultiError(errors)
+		return WithdrawRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteBalanceRewardRequestMultiError is an error wrapping multiple
-// validation errors returned by DeleteBalanceRewardRequest.ValidateAll() if
-// the designated constraints aren't met.
-type DeleteBalanceRewardRequestMultiError []error
+// WithdrawRequestMultiError is an error wrapping multiple validation errors
+// returned by WithdrawRequest.ValidateAll() if the designated constraints
+// aren't met.
+type WithdrawRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteBalanceRewardRequestMultiError) Error() string {
+func (m WithdrawRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4995,11 +5267,11 @@ func (m DeleteBalanceRewardRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteBalanceRewardRequestMultiError) AllErrors() []error { return m }
+func (m WithdrawRequestMultiError) AllErrors() []error { return m }
 
-// DeleteBalanceRewardRequestValidationError is the validation error returned
-// by DeleteBalanceRewardRequest.Validate if the designated constraints aren't met.
-type DeleteBalanceRewardRequestValidationError struct {
+// WithdrawRequestValidationError is the validation error returned by
+// WithdrawRequest.Validate if the designated constraints aren't met.
+type WithdrawRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5007,24 +5279,22 @@ type DeleteBalanceRewardRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteBalanceRewardRequestValidationError) Field() string { return e.field }
+func (e WithdrawRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteBalanceRewardRequestValidationError) Reason() string { return e.reason }
+func (e WithdrawRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteBalanceRewardRequestValidationError) Cause() error { return e.cause }
+func (e WithdrawRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteBalanceRewardRequestValidationError) Key() bool { return e.key }
+func (e WithdrawRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteBalanceRewardRequestValidationError) ErrorName() string {
-	return "DeleteBalanceRewardRequestValidationError"
-}
+func (e WithdrawRequestValidationError) ErrorName() string { return "WithdrawRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e DeleteBalanceRewardRequestValidationError) Error() string {
+func (e WithdrawRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5036,14 +5306,14 @@ func (e DeleteBalanceRewardRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteBalanceRewardRequest.%s: %s%s",
+		"invalid %sWithdrawRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteBalanceRewardRequestValidationError{}
+var _ error = WithdrawRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -5051,24 +5321,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteBalanceRewardRequestValidationError{}
+} = WithdrawRequestValidationError{}
 
-// Validate checks the field values on DeleteBalanceRewardReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteBalanceRewardReply) Validate() error {
+// Validate checks the field values on WithdrawReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *WithdrawReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteBalanceRewardReply with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// DeleteBalanceRewardReplyMultiError, or nil if none found.
-func (m *DeleteBalanceRewardReply) ValidateAll() error {
+// ValidateAll checks the field values on WithdrawReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in WithdrawReplyMultiError, or
+// nil if none found.
+func (m *WithdrawReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteBalanceRewardReply) validate(all bool) error {
+func (m *WithdrawReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -5077,20 +5347,26 @@ func (m *DeleteBalanceRewardReply) validate(all bool) error {
 
 	// no validation rules for Status
 
+	// no validation rules for Code
+
+	// no validation rules for Fee
+
+	// no validation rules for Net
+
 	if len(errors) > 0 {
-		return DeleteBalanceRewardReplyMultiError(errors)
+		return WithdrawReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteBalanceRewardReplyMultiError is an error wrapping multiple validation
-// errors returned by DeleteBalanceRewardReply.ValidateAll() if the designated
-// constraints aren't met.
-type DeleteBalanceRewardReplyMultiError []error
+// WithdrawReplyMultiError is an error wrapping multiple validation errors
+// returned by WithdrawReply.ValidateAll() if the designated constraints
+// aren't met.
+type WithdrawReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteBalanceRewardReplyMultiError) Error() string {
+func (m WithdrawReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5099,11 +5375,11 @@ func (m DeleteBalanceRewardReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteBalanceRewardReplyMultiError) AllErrors() []error { return m }
+func (m WithdrawReplyMultiError) AllErrors() []error { return m }
 
-// DeleteBalanceRewardReplyValidationError is the validation error returned by
-// DeleteBalanceRewardReply.Validate if the designated constraints aren't met.
-type DeleteBalanceRewardReplyValidationError struct {
+// WithdrawReplyValidationError is the validation error returned by
+// WithdrawReply.Validate if the designated constraints aren't met.
+type WithdrawReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5111,24 +5387,22 @@ type DeleteBalanceRewardReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteBalanceRewardReplyValidationError) Field() string { return e.field }
+func (e WithdrawReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteBalanceRewardReplyValidationError) Reason() string { return e.reason }
+func (e WithdrawReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteBalanceRewardReplyValidationError) Cause() error { return e.cause }
+func (e WithdrawReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteBalanceRewardReplyValidationError) Key() bool { return e.key }
+func (e WithdrawReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteBalanceRewardReplyValidationError) ErrorName() string {
-	return "DeleteBalanceRewardReplyValidationError"
-}
+func (e WithdrawReplyValidationError) ErrorName() string { return "WithdrawReplyValidationError" }
 
 // Error satisfies the builtin error interface
-func (e DeleteBalanceRewardReplyValidationError) Error() string {
+func (e WithdrawReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5140,14 +5414,14 @@ func (e DeleteBalanceRewardReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteBalanceRewardReply.%s: %s%s",
+		"invalid %sWithdrawReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteBalanceRewardReplyValidationError{}
+var _ error = WithdrawReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -5155,48 +5429,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteBalanceRewardReplyValidationError{}
+} = WithdrawReplyValidationError{}
 
-// Validate checks the field values on AdminRewardListRequest with the rules
+// Validate checks the field values on PasswordChangeRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminRewardListRequest) Validate() error {
+func (m *PasswordChangeRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminRewardListRequest with the rules
+// ValidateAll checks the field values on PasswordChangeRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminRewardListRequestMultiError, or nil if none found.
-func (m *AdminRewardListRequest) ValidateAll() error {
+// PasswordChangeRequestMultiError, or nil if none found.
+func (m *PasswordChangeRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminRewardListRequest) validate(all bool) error {
+func (m *PasswordChangeRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Page
-
-	// no validation rules for Address
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PasswordChangeRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PasswordChangeRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PasswordChangeRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return AdminRewardListRequestMultiError(errors)
+		return PasswordChangeRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminRewardListRequestMultiError is an error wrapping multiple validation
-// errors returned by AdminRewardListRequest.ValidateAll() if the designated
+// PasswordChangeRequestMultiError is an error wrapping multiple validation
+// errors returned by PasswordChangeRequest.ValidateAll() if the designated
 // constraints aren't met.
-type AdminRewardListRequestMultiError []error
+type PasswordChangeRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminRewardListRequestMultiError) Error() string {
+func (m PasswordChangeRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5205,11 +5504,11 @@ func (m AdminRewardListRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminRewardListRequestMultiError) AllErrors() []error { return m }
+func (m PasswordChangeRequestMultiError) AllErrors() []error { return m }
 
-// AdminRewardListRequestValidationError is the validation error returned by
-// AdminRewardListRequest.Validate if the designated constraints aren't met.
-type AdminRewardListRequestValidationError struct {
+// PasswordChangeRequestValidationError is the validation error returned by
+// PasswordChangeRequest.Validate if the designated constraints aren't met.
+type PasswordChangeRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5217,24 +5516,24 @@ type AdminRewardListRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminRewardListRequestValidationError) Field() string { return e.field }
+func (e PasswordChangeRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminRewardListRequestValidationError) Reason() string { return e.reason }
+func (e PasswordChangeRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminRewardListRequestValidationError) Cause() error { return e.cause }
+func (e PasswordChangeRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminRewardListRequestValidationError) Key() bool { return e.key }
+func (e PasswordChangeRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminRewardListRequestValidationError) ErrorName() string {
-	return "AdminRewardListRequestValidationError"
+func (e PasswordChangeRequestValidationError) ErrorName() string {
+	return "PasswordChangeRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminRewardListRequestValidationError) Error() string {
+func (e PasswordChangeRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5246,14 +5545,14 @@ func (e AdminRewardListRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminRewardListRequest.%s: %s%s",
+		"invalid %sPasswordChangeRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminRewardListRequestValidationError{}
+var _ error = PasswordChangeRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -5261,80 +5560,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminRewardListRequestValidationError{}
+} = PasswordChangeRequestValidationError{}
 
-// Validate checks the field values on AdminRewardListReply with the rules
+// Validate checks the field values on PasswordChangeReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminRewardListReply) Validate() error {
+func (m *PasswordChangeReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminRewardListReply with the rules
+// ValidateAll checks the field values on PasswordChangeReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminRewardListReplyMultiError, or nil if none found.
-func (m *AdminRewardListReply) ValidateAll() error {
+// PasswordChangeReplyMultiError, or nil if none found.
+func (m *PasswordChangeReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminRewardListReply) validate(all bool) error {
+func (m *PasswordChangeReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetRewards() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AdminRewardListReplyValidationError{
-						field:  fmt.Sprintf("Rewards[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, AdminRewardListReplyValidationError{
-						field:  fmt.Sprintf("Rewards[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return AdminRewardListReplyValidationError{
-					field:  fmt.Sprintf("Rewards[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	// no validation rules for Count
+	// no validation rules for Password
 
 	if len(errors) > 0 {
-		return AdminRewardListReplyMultiError(errors)
+		return PasswordChangeReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminRewardListReplyMultiError is an error wrapping multiple validation
-// errors returned by AdminRewardListReply.ValidateAll() if the designated
+// PasswordChangeReplyMultiError is an error wrapping multiple validation
+// errors returned by PasswordChangeReply.ValidateAll() if the designated
 // constraints aren't met.
-type AdminRewardListReplyMultiError []error
+type PasswordChangeReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminRewardListReplyMultiError) Error() string {
+func (m PasswordChangeReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5343,11 +5608,11 @@ func (m AdminRewardListReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminRewardListReplyMultiError) AllErrors() []error { return m }
+func (m PasswordChangeReplyMultiError) AllErrors() []error { return m }
 
-// AdminRewardListReplyValidationError is the validation error returned by
-// AdminRewardListReply.Validate if the designated constraints aren't met.
-type AdminRewardListReplyValidationError struct {
+// PasswordChangeReplyValidationError is the validation error returned by
+// PasswordChangeReply.Validate if the designated constraints aren't met.
+type PasswordChangeReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5355,24 +5620,24 @@ type AdminRewardListReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminRewardListReplyValidationError) Field() string { return e.field }
+func (e PasswordChangeReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminRewardListReplyValidationError) Reason() string { return e.reason }
+func (e PasswordChangeReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminRewardListReplyValidationError) Cause() error { return e.cause }
+func (e PasswordChangeReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminRewardListReplyValidationError) Key() bool { return e.key }
+func (e PasswordChangeReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminRewardListReplyValidationError) ErrorName() string {
-	return "AdminRewardListReplyValidationError"
+func (e PasswordChangeReplyValidationError) ErrorName() string {
+	return "PasswordChangeReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminRewardListReplyValidationError) Error() string {
+func (e PasswordChangeReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5384,14 +5649,14 @@ func (e AdminRewardListReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminRewardListReply.%s: %s%s",
+		"invalid %sPasswordChangeReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminRewardListReplyValidationError{}
+var _ error = PasswordChangeReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -5399,48 +5664,72 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminRewardListReplyValidationError{}
+} = PasswordChangeReplyValidationError{}
 
-// Validate checks the field values on AdminUserListRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminUserListRequest) Validate() error {
+// Validate checks the field values on TranRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TranRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminUserListRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AdminUserListRequestMultiError, or nil if none found.
-func (m *AdminUserListRequest) ValidateAll() error {
+// ValidateAll checks the field values on TranRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TranRequestMultiError, or
+// nil if none found.
+func (m *TranRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminUserListRequest) validate(all bool) error {
+func (m *TranRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Page
-
-	// no validation rules for Address
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TranRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TranRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TranRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return AdminUserListRequestMultiError(errors)
+		return TranRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminUserListRequestMultiError is an error wrapping multiple validation
-// errors returned by AdminUserListRequest.ValidateAll() if the designated
-// constraints aren't met.
-type AdminUserListRequestMultiError []error
+// TranRequestMultiError is an error wrapping multiple validation errors
+// returned by TranRequest.ValidateAll() if the designated constraints aren't met.
+type TranRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminUserListRequestMultiError) Error() string {
+func (m TranRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5449,11 +5738,11 @@ func (m AdminUserListRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminUserListRequestMultiError) AllErrors() []error { return m }
+func (m TranRequestMultiError) AllErrors() []error { return m }
 
-// AdminUserListRequestValidationError is the validation error returned by
-// AdminUserListRequest.Validate if the designated constraints aren't met.
-type AdminUserListRequestValidationError struct {
+// TranRequestValidationError is the validation error returned by
+// TranRequest.Validate if the designated constraints aren't met.
+type TranRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5461,24 +5750,22 @@ type AdminUserListRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminUserListRequestValidationError) Field() string { return e.field }
+func (e TranRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminUserListRequestValidationError) Reason() string { return e.reason }
+func (e TranRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminUserListRequestValidationError) Cause() error { return e.cause }
+func (e TranRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminUserListRequestValidationError) Key() bool { return e.key }
+func (e TranRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminUserListRequestValidationError) ErrorName() string {
-	return "AdminUserListRequestValidationError"
-}
+func (e TranRequestValidationError) ErrorName() string { return "TranRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e AdminUserListRequestValidationError) Error() string {
+func (e TranRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5490,14 +5777,14 @@ func (e AdminUserListRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminUserListRequest.%s: %s%s",
+		"invalid %sTranRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminUserListRequestValidationError{}
+var _ error = TranRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -5505,80 +5792,9115 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminUserListRequestValidationError{}
+} = TranRequestValidationError{}
 
-// Validate checks the field values on AdminUserListReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminUserListReply) Validate() error {
+// Validate checks the field values on TranReply with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TranReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminUserListReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AdminUserListReplyMultiError, or nil if none found.
-func (m *AdminUserListReply) ValidateAll() error {
+// ValidateAll checks the field values on TranReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TranReplyMultiError, or nil
+// if none found.
+func (m *TranReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminUserListReply) validate(all bool) error {
+func (m *TranReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetUsers() {
-		_, _ = idx, item
+	// no validation rules for Status
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AdminUserListReplyValidationError{
-						field:  fmt.Sprintf("Users[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, AdminUserListReplyValidationError{
-						field:  fmt.Sprintf("Users[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return AdminUserListReplyValidationError{
-					field:  fmt.Sprintf("Users[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	if len(errors) > 0 {
+		return TranReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// TranReplyMultiError is an error wrapping multiple validation errors returned
+// by TranReply.ValidateAll() if the designated constraints aren't met.
+type TranReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TranReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TranReplyMultiError) AllErrors() []error { return m }
+
+// TranReplyValidationError is the validation error returned by
+// TranReply.Validate if the designated constraints aren't met.
+type TranReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TranReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TranReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TranReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TranReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TranReplyValidationError) ErrorName() string { return "TranReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TranReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTranReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TranReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TranReplyValidationError{}
+
+// Validate checks the field values on SetBalanceRewardRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetBalanceRewardRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetBalanceRewardRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetBalanceRewardRequestMultiError, or nil if none found.
+func (m *SetBalanceRewardRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetBalanceRewardRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetBalanceRewardRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetBalanceRewardRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetBalanceRewardRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SetBalanceRewardRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetBalanceRewardRequestMultiError is an error wrapping multiple validation
+// errors returned by SetBalanceRewardRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetBalanceRewardRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetBalanceRewardRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetBalanceRewardRequestMultiError) AllErrors() []error { return m }
+
+// SetBalanceRewardRequestValidationError is the validation error returned by
+// SetBalanceRewardRequest.Validate if the designated constraints aren't met.
+type SetBalanceRewardRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetBalanceRewardRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetBalanceRewardRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetBalanceRewardRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetBalanceRewardRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetBalanceRewardRequestValidationError) ErrorName() string {
+	return "SetBalanceRewardRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetBalanceRewardRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetBalanceRewardRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetBalanceRewardRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetBalanceRewardRequestValidationError{}
+
+// Validate checks the field values on SetBalanceRewardReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetBalanceRewardReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetBalanceRewardReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetBalanceRewardReplyMultiError, or nil if none found.
+func (m *SetBalanceRewardReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetBalanceRewardReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return SetBalanceRewardReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetBalanceRewardReplyMultiError is an error wrapping multiple validation
+// errors returned by SetBalanceRewardReply.ValidateAll() if the designated
+// constraints aren't met.
+type SetBalanceRewardReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetBalanceRewardReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetBalanceRewardReplyMultiError) AllErrors() []error { return m }
+
+// SetBalanceRewardReplyValidationError is the validation error returned by
+// SetBalanceRewardReply.Validate if the designated constraints aren't met.
+type SetBalanceRewardReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetBalanceRewardReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetBalanceRewardReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetBalanceRewardReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetBalanceRewardReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetBalanceRewardReplyValidationError) ErrorName() string {
+	return "SetBalanceRewardReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetBalanceRewardReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetBalanceRewardReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetBalanceRewardReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetBalanceRewardReplyValidationError{}
+
+// Validate checks the field values on DeleteBalanceRewardRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteBalanceRewardRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteBalanceRewardRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteBalanceRewardRequestMultiError, or nil if none found.
+func (m *DeleteBalanceRewardRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteBalanceRewardRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DeleteBalanceRewardRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DeleteBalanceRewardRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DeleteBalanceRewardRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DeleteBalanceRewardRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteBalanceRewardRequestMultiError is an error wrapping multiple
+// validation errors returned by DeleteBalanceRewardRequest.ValidateAll() if
+// the designated constraints aren't met.
+type DeleteBalanceRewardRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteBalanceRewardRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteBalanceRewardRequestMultiError) AllErrors() []error { return m }
+
+// DeleteBalanceRewardRequestValidationError is the validation error returned
+// by DeleteBalanceRewardRequest.Validate if the designated constraints aren't met.
+type DeleteBalanceRewardRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteBalanceRewardRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteBalanceRewardRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteBalanceRewardRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteBalanceRewardRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteBalanceRewardRequestValidationError) ErrorName() string {
+	return "DeleteBalanceRewardRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteBalanceRewardRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteBalanceRewardRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteBalanceRewardRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteBalanceRewardRequestValidationError{}
+
+// Validate checks the field values on DeleteBalanceRewardReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteBalanceRewardReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteBalanceRewardReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteBalanceRewardReplyMultiError, or nil if none found.
+func (m *DeleteBalanceRewardReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteBalanceRewardReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	for idx, item := range m.GetPositions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DeleteBalanceRewardReplyValidationError{
+						field:  fmt.Sprintf("Positions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DeleteBalanceRewardReplyValidationError{
+						field:  fmt.Sprintf("Positions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DeleteBalanceRewardReplyValidationError{
+					field:  fmt.Sprintf("Positions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return DeleteBalanceRewardReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteBalanceRewardReplyMultiError is an error wrapping multiple validation
+// errors returned by DeleteBalanceRewardReply.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteBalanceRewardReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteBalanceRewardReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteBalanceRewardReplyMultiError) AllErrors() []error { return m }
+
+// DeleteBalanceRewardReplyValidationError is the validation error returned by
+// DeleteBalanceRewardReply.Validate if the designated constraints aren't met.
+type DeleteBalanceRewardReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteBalanceRewardReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteBalanceRewardReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteBalanceRewardReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteBalanceRewardReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteBalanceRewardReplyValidationError) ErrorName() string {
+	return "DeleteBalanceRewardReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteBalanceRewardReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteBalanceRewardReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteBalanceRewardReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteBalanceRewardReplyValidationError{}
+
+// Validate checks the field values on BalanceRewardSummaryRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BalanceRewardSummaryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BalanceRewardSummaryRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BalanceRewardSummaryRequestMultiError, or nil if none found.
+func (m *BalanceRewardSummaryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BalanceRewardSummaryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return BalanceRewardSummaryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BalanceRewardSummaryRequestMultiError is an error wrapping multiple
+// validation errors returned by BalanceRewardSummaryRequest.ValidateAll() if
+// the designated constraints aren't met.
+type BalanceRewardSummaryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BalanceRewardSummaryRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BalanceRewardSummaryRequestMultiError) AllErrors() []error { return m }
+
+// BalanceRewardSummaryRequestValidationError is the validation error returned
+// by BalanceRewardSummaryRequest.Validate if the designated constraints
+// aren't met.
+type BalanceRewardSummaryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BalanceRewardSummaryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BalanceRewardSummaryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BalanceRewardSummaryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BalanceRewardSummaryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BalanceRewardSummaryRequestValidationError) ErrorName() string {
+	return "BalanceRewardSummaryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BalanceRewardSummaryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBalanceRewardSummaryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BalanceRewardSummaryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BalanceRewardSummaryRequestValidationError{}
+
+// Validate checks the field values on BalanceRewardSummaryReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BalanceRewardSummaryReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BalanceRewardSummaryReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BalanceRewardSummaryReplyMultiError, or nil if none found.
+func (m *BalanceRewardSummaryReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BalanceRewardSummaryReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Principal
+
+	// no validation rules for Accrued
+
+	// no validation rules for NextPayoutAt
+
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BalanceRewardSummaryReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BalanceRewardSummaryReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BalanceRewardSummaryReplyValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for PendingUnstake
+
+	if len(errors) > 0 {
+		return BalanceRewardSummaryReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// BalanceRewardSummaryReplyMultiError is an error wrapping multiple validation
+// errors returned by BalanceRewardSummaryReply.ValidateAll() if the
+// designated constraints aren't met.
+type BalanceRewardSummaryReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BalanceRewardSummaryReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BalanceRewardSummaryReplyMultiError) AllErrors() []error { return m }
+
+// BalanceRewardSummaryReplyValidationError is the validation error returned by
+// BalanceRewardSummaryReply.Validate if the designated constraints aren't met.
+type BalanceRewardSummaryReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BalanceRewardSummaryReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BalanceRewardSummaryReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BalanceRewardSummaryReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BalanceRewardSummaryReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BalanceRewardSummaryReplyValidationError) ErrorName() string {
+	return "BalanceRewardSummaryReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BalanceRewardSummaryReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBalanceRewardSummaryReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BalanceRewardSummaryReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BalanceRewardSummaryReplyValidationError{}
+
+// Validate checks the field values on WithdrawAddressListRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WithdrawAddressListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WithdrawAddressListRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WithdrawAddressListRequestMultiError, or nil if none found.
+func (m *WithdrawAddressListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WithdrawAddressListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return WithdrawAddressListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// WithdrawAddressListRequestMultiError is an error wrapping multiple
+// validation errors returned by WithdrawAddressListRequest.ValidateAll() if
+// the designated constraints aren't met.
+type WithdrawAddressListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WithdrawAddressListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WithdrawAddressListRequestMultiError) AllErrors() []error { return m }
+
+// WithdrawAddressListRequestValidationError is the validation error returned
+// by WithdrawAddressListRequest.Validate if the designated constraints aren't met.
+type WithdrawAddressListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WithdrawAddressListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WithdrawAddressListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WithdrawAddressListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WithdrawAddressListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WithdrawAddressListRequestValidationError) ErrorName() string {
+	return "WithdrawAddressListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WithdrawAddressListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWithdrawAddressListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WithdrawAddressListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WithdrawAddressListRequestValidationError{}
+
+// Validate checks the field values on WithdrawAddressListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WithdrawAddressListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WithdrawAddressListReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WithdrawAddressListReplyMultiError, or nil if none found.
+func (m *WithdrawAddressListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WithdrawAddressListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for WhitelistOnly
+
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, WithdrawAddressListReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, WithdrawAddressListReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return WithdrawAddressListReplyValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return WithdrawAddressListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// WithdrawAddressListReplyMultiError is an error wrapping multiple validation
+// errors returned by WithdrawAddressListReply.ValidateAll() if the designated
+// constraints aren't met.
+type WithdrawAddressListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WithdrawAddressListReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WithdrawAddressListReplyMultiError) AllErrors() []error { return m }
+
+// WithdrawAddressListReplyValidationError is the validation error returned by
+// WithdrawAddressListReply.Validate if the designated constraints aren't met.
+type WithdrawAddressListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WithdrawAddressListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WithdrawAddressListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WithdrawAddressListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WithdrawAddressListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WithdrawAddressListReplyValidationError) ErrorName() string {
+	return "WithdrawAddressListReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WithdrawAddressListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWithdrawAddressListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WithdrawAddressListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WithdrawAddressListReplyValidationError{}
+
+// Validate checks the field values on WithdrawAddressCreateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WithdrawAddressCreateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WithdrawAddressCreateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WithdrawAddressCreateRequestMultiError, or nil if none found.
+func (m *WithdrawAddressCreateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WithdrawAddressCreateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WithdrawAddressCreateRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WithdrawAddressCreateRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WithdrawAddressCreateRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return WithdrawAddressCreateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// WithdrawAddressCreateRequestMultiError is an error wrapping multiple
+// validation errors returned by WithdrawAddressCreateRequest.ValidateAll() if
+// the designated constraints aren't met.
+type WithdrawAddressCreateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WithdrawAddressCreateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WithdrawAddressCreateRequestMultiError) AllErrors() []error { return m }
+
+// WithdrawAddressCreateRequestValidationError is the validation error returned
+// by WithdrawAddressCreateRequest.Validate if the designated constraints
+// aren't met.
+type WithdrawAddressCreateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WithdrawAddressCreateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WithdrawAddressCreateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WithdrawAddressCreateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WithdrawAddressCreateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WithdrawAddressCreateRequestValidationError) ErrorName() string {
+	return "WithdrawAddressCreateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WithdrawAddressCreateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWithdrawAddressCreateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WithdrawAddressCreateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WithdrawAddressCreateRequestValidationError{}
+
+// Validate checks the field values on WithdrawAddressCreateReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WithdrawAddressCreateReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WithdrawAddressCreateReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WithdrawAddressCreateReplyMultiError, or nil if none found.
+func (m *WithdrawAddressCreateReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WithdrawAddressCreateReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for ActiveAt
+
+	if len(errors) > 0 {
+		return WithdrawAddressCreateReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// WithdrawAddressCreateReplyMultiError is an error wrapping multiple
+// validation errors returned by WithdrawAddressCreateReply.ValidateAll() if
+// the designated constraints aren't met.
+type WithdrawAddressCreateReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WithdrawAddressCreateReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WithdrawAddressCreateReplyMultiError) AllErrors() []error { return m }
+
+// WithdrawAddressCreateReplyValidationError is the validation error returned
+// by WithdrawAddressCreateReply.Validate if the designated constraints aren't met.
+type WithdrawAddressCreateReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WithdrawAddressCreateReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WithdrawAddressCreateReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WithdrawAddressCreateReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WithdrawAddressCreateReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WithdrawAddressCreateReplyValidationError) ErrorName() string {
+	return "WithdrawAddressCreateReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WithdrawAddressCreateReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWithdrawAddressCreateReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WithdrawAddressCreateReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WithdrawAddressCreateReplyValidationError{}
+
+// Validate checks the field values on WithdrawAddressDeleteRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WithdrawAddressDeleteRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WithdrawAddressDeleteRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WithdrawAddressDeleteRequestMultiError, or nil if none found.
+func (m *WithdrawAddressDeleteRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WithdrawAddressDeleteRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WithdrawAddressDeleteRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WithdrawAddressDeleteRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WithdrawAddressDeleteRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return WithdrawAddressDeleteRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// WithdrawAddressDeleteRequestMultiError is an error wrapping multiple
+// validation errors returned by WithdrawAddressDeleteRequest.ValidateAll() if
+// the designated constraints aren't met.
+type WithdrawAddressDeleteRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WithdrawAddressDeleteRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WithdrawAddressDeleteRequestMultiError) AllErrors() []error { return m }
+
+// WithdrawAddressDeleteRequestValidationError is the validation error returned
+// by WithdrawAddressDeleteRequest.Validate if the designated constraints
+// aren't met.
+type WithdrawAddressDeleteRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WithdrawAddressDeleteRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WithdrawAddressDeleteRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WithdrawAddressDeleteRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WithdrawAddressDeleteRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WithdrawAddressDeleteRequestValidationError) ErrorName() string {
+	return "WithdrawAddressDeleteRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WithdrawAddressDeleteRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWithdrawAddressDeleteRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WithdrawAddressDeleteRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WithdrawAddressDeleteRequestValidationError{}
+
+// Validate checks the field values on WithdrawAddressDeleteReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WithdrawAddressDeleteReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WithdrawAddressDeleteReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WithdrawAddressDeleteReplyMultiError, or nil if none found.
+func (m *WithdrawAddressDeleteReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WithdrawAddressDeleteReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return WithdrawAddressDeleteReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// WithdrawAddressDeleteReplyMultiError is an error wrapping multiple
+// validation errors returned by WithdrawAddressDeleteReply.ValidateAll() if
+// the designated constraints aren't met.
+type WithdrawAddressDeleteReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WithdrawAddressDeleteReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WithdrawAddressDeleteReplyMultiError) AllErrors() []error { return m }
+
+// WithdrawAddressDeleteReplyValidationError is the validation error returned
+// by WithdrawAddressDeleteReply.Validate if the designated constraints aren't met.
+type WithdrawAddressDeleteReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WithdrawAddressDeleteReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WithdrawAddressDeleteReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WithdrawAddressDeleteReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WithdrawAddressDeleteReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WithdrawAddressDeleteReplyValidationError) ErrorName() string {
+	return "WithdrawAddressDeleteReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WithdrawAddressDeleteReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWithdrawAddressDeleteReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WithdrawAddressDeleteReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WithdrawAddressDeleteReplyValidationError{}
+
+// Validate checks the field values on InviteCodeListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InviteCodeListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InviteCodeListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InviteCodeListRequestMultiError, or nil if none found.
+func (m *InviteCodeListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InviteCodeListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return InviteCodeListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InviteCodeListRequestMultiError is an error wrapping multiple validation
+// errors returned by InviteCodeListRequest.ValidateAll() if the designated
+// constraints aren't met.
+type InviteCodeListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InviteCodeListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InviteCodeListRequestMultiError) AllErrors() []error { return m }
+
+// InviteCodeListRequestValidationError is the validation error returned by
+// InviteCodeListRequest.Validate if the designated constraints aren't met.
+type InviteCodeListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InviteCodeListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InviteCodeListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InviteCodeListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InviteCodeListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InviteCodeListRequestValidationError) ErrorName() string {
+	return "InviteCodeListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InviteCodeListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInviteCodeListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InviteCodeListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InviteCodeListRequestValidationError{}
+
+// Validate checks the field values on InviteCodeListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InviteCodeListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InviteCodeListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InviteCodeListReplyMultiError, or nil if none found.
+func (m *InviteCodeListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InviteCodeListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetCodes() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, InviteCodeListReplyValidationError{
+						field:  fmt.Sprintf("Codes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, InviteCodeListReplyValidationError{
+						field:  fmt.Sprintf("Codes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InviteCodeListReplyValidationError{
+					field:  fmt.Sprintf("Codes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return InviteCodeListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// InviteCodeListReplyMultiError is an error wrapping multiple validation
+// errors returned by InviteCodeListReply.ValidateAll() if the designated
+// constraints aren't met.
+type InviteCodeListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InviteCodeListReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InviteCodeListReplyMultiError) AllErrors() []error { return m }
+
+// InviteCodeListReplyValidationError is the validation error returned by
+// InviteCodeListReply.Validate if the designated constraints aren't met.
+type InviteCodeListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InviteCodeListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InviteCodeListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InviteCodeListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InviteCodeListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InviteCodeListReplyValidationError) ErrorName() string {
+	return "InviteCodeListReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InviteCodeListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInviteCodeListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InviteCodeListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InviteCodeListReplyValidationError{}
+
+// Validate checks the field values on InviteCodeCreateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InviteCodeCreateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InviteCodeCreateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InviteCodeCreateRequestMultiError, or nil if none found.
+func (m *InviteCodeCreateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InviteCodeCreateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InviteCodeCreateRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InviteCodeCreateRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InviteCodeCreateRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return InviteCodeCreateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InviteCodeCreateRequestMultiError is an error wrapping multiple validation
+// errors returned by InviteCodeCreateRequest.ValidateAll() if the designated
+// constraints aren't met.
+type InviteCodeCreateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InviteCodeCreateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InviteCodeCreateRequestMultiError) AllErrors() []error { return m }
+
+// InviteCodeCreateRequestValidationError is the validation error returned by
+// InviteCodeCreateRequest.Validate if the designated constraints aren't met.
+type InviteCodeCreateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InviteCodeCreateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InviteCodeCreateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InviteCodeCreateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InviteCodeCreateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InviteCodeCreateRequestValidationError) ErrorName() string {
+	return "InviteCodeCreateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InviteCodeCreateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInviteCodeCreateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InviteCodeCreateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InviteCodeCreateRequestValidationError{}
+
+// Validate checks the field values on InviteCodeCreateReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InviteCodeCreateReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InviteCodeCreateReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InviteCodeCreateReplyMultiError, or nil if none found.
+func (m *InviteCodeCreateReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InviteCodeCreateReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	if len(errors) > 0 {
+		return InviteCodeCreateReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// InviteCodeCreateReplyMultiError is an error wrapping multiple validation
+// errors returned by InviteCodeCreateReply.ValidateAll() if the designated
+// constraints aren't met.
+type InviteCodeCreateReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InviteCodeCreateReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InviteCodeCreateReplyMultiError) AllErrors() []error { return m }
+
+// InviteCodeCreateReplyValidationError is the validation error returned by
+// InviteCodeCreateReply.Validate if the designated constraints aren't met.
+type InviteCodeCreateReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InviteCodeCreateReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InviteCodeCreateReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InviteCodeCreateReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InviteCodeCreateReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InviteCodeCreateReplyValidationError) ErrorName() string {
+	return "InviteCodeCreateReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InviteCodeCreateReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInviteCodeCreateReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InviteCodeCreateReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InviteCodeCreateReplyValidationError{}
+
+// Validate checks the field values on LocaleRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *LocaleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LocaleRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in LocaleRequestMultiError, or
+// nil if none found.
+func (m *LocaleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LocaleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return LocaleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// LocaleRequestMultiError is an error wrapping multiple validation errors
+// returned by LocaleRequest.ValidateAll() if the designated constraints
+// aren't met.
+type LocaleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LocaleRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LocaleRequestMultiError) AllErrors() []error { return m }
+
+// LocaleRequestValidationError is the validation error returned by
+// LocaleRequest.Validate if the designated constraints aren't met.
+type LocaleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LocaleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LocaleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LocaleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LocaleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LocaleRequestValidationError) ErrorName() string { return "LocaleRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LocaleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLocaleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LocaleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LocaleRequestValidationError{}
+
+// Validate checks the field values on LocaleReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *LocaleReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LocaleReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in LocaleReplyMultiError, or
+// nil if none found.
+func (m *LocaleReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LocaleReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Locale
+
+	// no validation rules for Preference
+
+	if len(errors) > 0 {
+		return LocaleReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// LocaleReplyMultiError is an error wrapping multiple validation errors
+// returned by LocaleReply.ValidateAll() if the designated constraints aren't met.
+type LocaleReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LocaleReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LocaleReplyMultiError) AllErrors() []error { return m }
+
+// LocaleReplyValidationError is the validation error returned by
+// LocaleReply.Validate if the designated constraints aren't met.
+type LocaleReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LocaleReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LocaleReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LocaleReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LocaleReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LocaleReplyValidationError) ErrorName() string { return "LocaleReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LocaleReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLocaleReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LocaleReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LocaleReplyValidationError{}
+
+// Validate checks the field values on LocaleChangeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *LocaleChangeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LocaleChangeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LocaleChangeRequestMultiError, or nil if none found.
+func (m *LocaleChangeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LocaleChangeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, LocaleChangeRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, LocaleChangeRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LocaleChangeRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return LocaleChangeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// LocaleChangeRequestMultiError is an error wrapping multiple validation
+// errors returned by LocaleChangeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type LocaleChangeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LocaleChangeRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LocaleChangeRequestMultiError) AllErrors() []error { return m }
+
+// LocaleChangeRequestValidationError is the validation error returned by
+// LocaleChangeRequest.Validate if the designated constraints aren't met.
+type LocaleChangeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LocaleChangeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LocaleChangeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LocaleChangeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LocaleChangeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LocaleChangeRequestValidationError) ErrorName() string {
+	return "LocaleChangeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e LocaleChangeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLocaleChangeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LocaleChangeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LocaleChangeRequestValidationError{}
+
+// Validate checks the field values on LocaleChangeReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *LocaleChangeReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LocaleChangeReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LocaleChangeReplyMultiError, or nil if none found.
+func (m *LocaleChangeReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LocaleChangeReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	// no validation rules for Locale
+
+	if len(errors) > 0 {
+		return LocaleChangeReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// LocaleChangeReplyMultiError is an error wrapping multiple validation errors
+// returned by LocaleChangeReply.ValidateAll() if the designated constraints
+// aren't met.
+type LocaleChangeReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LocaleChangeReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LocaleChangeReplyMultiError) AllErrors() []error { return m }
+
+// LocaleChangeReplyValidationError is the validation error returned by
+// LocaleChangeReply.Validate if the designated constraints aren't met.
+type LocaleChangeReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LocaleChangeReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LocaleChangeReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LocaleChangeReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LocaleChangeReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LocaleChangeReplyValidationError) ErrorName() string {
+	return "LocaleChangeReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e LocaleChangeReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLocaleChangeReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LocaleChangeReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LocaleChangeReplyValidationError{}
+
+// Validate checks the field values on PushTicketRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PushTicketRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PushTicketRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PushTicketRequestMultiError, or nil if none found.
+func (m *PushTicketRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PushTicketRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PushTicketRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PushTicketRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PushTicketRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PushTicketRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PushTicketRequestMultiError is an error wrapping multiple validation errors
+// returned by PushTicketRequest.ValidateAll() if the designated constraints
+// aren't met.
+type PushTicketRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PushTicketRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PushTicketRequestMultiError) AllErrors() []error { return m }
+
+// PushTicketRequestValidationError is the validation error returned by
+// PushTicketRequest.Validate if the designated constraints aren't met.
+type PushTicketRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PushTicketRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PushTicketRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PushTicketRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PushTicketRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PushTicketRequestValidationError) ErrorName() string {
+	return "PushTicketRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PushTicketRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPushTicketRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PushTicketRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PushTicketRequestValidationError{}
+
+// Validate checks the field values on PushTicketReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PushTicketReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PushTicketReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PushTicketReplyMultiError, or nil if none found.
+func (m *PushTicketReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PushTicketReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Ticket
+
+	// no validation rules for ExpiresIn
+
+	if len(errors) > 0 {
+		return PushTicketReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// PushTicketReplyMultiError is an error wrapping multiple validation errors
+// returned by PushTicketReply.ValidateAll() if the designated constraints
+// aren't met.
+type PushTicketReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PushTicketReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PushTicketReplyMultiError) AllErrors() []error { return m }
+
+// PushTicketReplyValidationError is the validation error returned by
+// PushTicketReply.Validate if the designated constraints aren't met.
+type PushTicketReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PushTicketReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PushTicketReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PushTicketReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PushTicketReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PushTicketReplyValidationError) ErrorName() string { return "PushTicketReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PushTicketReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPushTicketReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PushTicketReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PushTicketReplyValidationError{}
+
+// Validate checks the field values on AdminInviteCodeCreateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminInviteCodeCreateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminInviteCodeCreateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminInviteCodeCreateRequestMultiError, or nil if none found.
+func (m *AdminInviteCodeCreateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminInviteCodeCreateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminInviteCodeCreateRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminInviteCodeCreateRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminInviteCodeCreateRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AdminInviteCodeCreateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminInviteCodeCreateRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminInviteCodeCreateRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AdminInviteCodeCreateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminInviteCodeCreateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminInviteCodeCreateRequestMultiError) AllErrors() []error { return m }
+
+// AdminInviteCodeCreateRequestValidationError is the validation error returned
+// by AdminInviteCodeCreateRequest.Validate if the designated constraints
+// aren't met.
+type AdminInviteCodeCreateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminInviteCodeCreateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminInviteCodeCreateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminInviteCodeCreateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminInviteCodeCreateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminInviteCodeCreateRequestValidationError) ErrorName() string {
+	return "AdminInviteCodeCreateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminInviteCodeCreateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminInviteCodeCreateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminInviteCodeCreateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminInviteCodeCreateRequestValidationError{}
+
+// Validate checks the field values on AdminInviteCodeCreateReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminInviteCodeCreateReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminInviteCodeCreateReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminInviteCodeCreateReplyMultiError, or nil if none found.
+func (m *AdminInviteCodeCreateReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminInviteCodeCreateReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	if len(errors) > 0 {
+		return AdminInviteCodeCreateReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminInviteCodeCreateReplyMultiError is an error wrapping multiple
+// validation errors returned by AdminInviteCodeCreateReply.ValidateAll() if
+// the designated constraints aren't met.
+type AdminInviteCodeCreateReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminInviteCodeCreateReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminInviteCodeCreateReplyMultiError) AllErrors() []error { return m }
+
+// AdminInviteCodeCreateReplyValidationError is the validation error returned
+// by AdminInviteCodeCreateReply.Validate if the designated constraints aren't met.
+type AdminInviteCodeCreateReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminInviteCodeCreateReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminInviteCodeCreateReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminInviteCodeCreateReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminInviteCodeCreateReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminInviteCodeCreateReplyValidationError) ErrorName() string {
+	return "AdminInviteCodeCreateReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminInviteCodeCreateReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminInviteCodeCreateReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminInviteCodeCreateReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminInviteCodeCreateReplyValidationError{}
+
+// Validate checks the field values on AdminPasswordUpdateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminPasswordUpdateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminPasswordUpdateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminPasswordUpdateRequestMultiError, or nil if none found.
+func (m *AdminPasswordUpdateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminPasswordUpdateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminPasswordUpdateRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminPasswordUpdateRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminPasswordUpdateRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AdminPasswordUpdateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminPasswordUpdateRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminPasswordUpdateRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AdminPasswordUpdateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminPasswordUpdateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminPasswordUpdateRequestMultiError) AllErrors() []error { return m }
+
+// AdminPasswordUpdateRequestValidationError is the validation error returned
+// by AdminPasswordUpdateRequest.Validate if the designated constraints aren't met.
+type AdminPasswordUpdateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminPasswordUpdateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminPasswordUpdateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminPasswordUpdateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminPasswordUpdateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminPasswordUpdateRequestValidationError) ErrorName() string {
+	return "AdminPasswordUpdateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminPasswordUpdateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminPasswordUpdateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminPasswordUpdateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminPasswordUpdateRequestValidationError{}
+
+// Validate checks the field values on AdminPasswordUpdateReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminPasswordUpdateReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminPasswordUpdateReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminPasswordUpdateReplyMultiError, or nil if none found.
+func (m *AdminPasswordUpdateReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminPasswordUpdateReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return AdminPasswordUpdateReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminPasswordUpdateReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminPasswordUpdateReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminPasswordUpdateReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminPasswordUpdateReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminPasswordUpdateReplyMultiError) AllErrors() []error { return m }
+
+// AdminPasswordUpdateReplyValidationError is the validation error returned by
+// AdminPasswordUpdateReply.Validate if the designated constraints aren't met.
+type AdminPasswordUpdateReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminPasswordUpdateReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminPasswordUpdateReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminPasswordUpdateReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminPasswordUpdateReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminPasswordUpdateReplyValidationError) ErrorName() string {
+	return "AdminPasswordUpdateReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminPasswordUpdateReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminPasswordUpdateReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminPasswordUpdateReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminPasswordUpdateReplyValidationError{}
+
+// Validate checks the field values on AdminCommissionSimulateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminCommissionSimulateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminCommissionSimulateRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminCommissionSimulateRequestMultiError, or nil if none found.
+func (m *AdminCommissionSimulateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminCommissionSimulateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Type
+
+	// no validation rules for UserId
+
+	// no validation rules for Amount
+
+	if len(errors) > 0 {
+		return AdminCommissionSimulateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminCommissionSimulateRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminCommissionSimulateRequest.ValidateAll()
+// if the designated constraints aren't met.
+type AdminCommissionSimulateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminCommissionSimulateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminCommissionSimulateRequestMultiError) AllErrors() []error { return m }
+
+// AdminCommissionSimulateRequestValidationError is the validation error
+// returned by AdminCommissionSimulateRequest.Validate if the designated
+// constraints aren't met.
+type AdminCommissionSimulateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminCommissionSimulateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminCommissionSimulateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminCommissionSimulateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminCommissionSimulateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminCommissionSimulateRequestValidationError) ErrorName() string {
+	return "AdminCommissionSimulateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminCommissionSimulateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminCommissionSimulateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminCommissionSimulateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminCommissionSimulateRequestValidationError{}
+
+// Validate checks the field values on AdminCommissionSimulateReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminCommissionSimulateReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminCommissionSimulateReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminCommissionSimulateReplyMultiError, or nil if none found.
+func (m *AdminCommissionSimulateReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminCommissionSimulateReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRewards() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminCommissionSimulateReplyValidationError{
+						field:  fmt.Sprintf("Rewards[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminCommissionSimulateReplyValidationError{
+						field:  fmt.Sprintf("Rewards[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminCommissionSimulateReplyValidationError{
+					field:  fmt.Sprintf("Rewards[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	if len(errors) > 0 {
+		return AdminCommissionSimulateReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminCommissionSimulateReplyMultiError is an error wrapping multiple
+// validation errors returned by AdminCommissionSimulateReply.ValidateAll() if
+// the designated constraints aren't met.
+type AdminCommissionSimulateReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminCommissionSimulateReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminCommissionSimulateReplyMultiError) AllErrors() []error { return m }
+
+// AdminCommissionSimulateReplyValidationError is the validation error returned
+// by AdminCommissionSimulateReply.Validate if the designated constraints
+// aren't met.
+type AdminCommissionSimulateReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminCommissionSimulateReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminCommissionSimulateReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminCommissionSimulateReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminCommissionSimulateReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminCommissionSimulateReplyValidationError) ErrorName() string {
+	return "AdminCommissionSimulateReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminCommissionSimulateReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminCommissionSimulateReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminCommissionSimulateReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminCommissionSimulateReplyValidationError{}
+
+// Validate checks the field values on AdminAreaLevelRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminAreaLevelRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminAreaLevelRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminAreaLevelRequestMultiError, or nil if none found.
+func (m *AdminAreaLevelRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminAreaLevelRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminAreaLevelRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminAreaLevelRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminAreaLevelRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AdminAreaLevelRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminAreaLevelRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminAreaLevelRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminAreaLevelRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminAreaLevelRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminAreaLevelRequestMultiError) AllErrors() []error { return m }
+
+// AdminAreaLevelRequestValidationError is the validation error returned by
+// AdminAreaLevelRequest.Validate if the designated constraints aren't met.
+type AdminAreaLevelRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminAreaLevelRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminAreaLevelRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminAreaLevelRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminAreaLevelRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminAreaLevelRequestValidationError) ErrorName() string {
+	return "AdminAreaLevelRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminAreaLevelRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminAreaLevelRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminAreaLevelRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminAreaLevelRequestValidationError{}
+
+// Validate checks the field values on AdminAreaLevelReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminAreaLevelReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminAreaLevelReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminAreaLevelReplyMultiError, or nil if none found.
+func (m *AdminAreaLevelReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminAreaLevelReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserNum
+
+	// no validation rules for Promotions
+
+	// no validation rules for Demotions
+
+	// no validation rules for BonusTotal
+
+	for idx, item := range m.GetChanges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminAreaLevelReplyValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminAreaLevelReplyValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminAreaLevelReplyValidationError{
+					field:  fmt.Sprintf("Changes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AdminAreaLevelReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminAreaLevelReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminAreaLevelReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminAreaLevelReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminAreaLevelReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminAreaLevelReplyMultiError) AllErrors() []error { return m }
+
+// AdminAreaLevelReplyValidationError is the validation error returned by
+// AdminAreaLevelReply.Validate if the designated constraints aren't met.
+type AdminAreaLevelReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminAreaLevelReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminAreaLevelReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminAreaLevelReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminAreaLevelReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminAreaLevelReplyValidationError) ErrorName() string {
+	return "AdminAreaLevelReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminAreaLevelReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminAreaLevelReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminAreaLevelReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminAreaLevelReplyValidationError{}
+
+// Validate checks the field values on AdminJobRunListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminJobRunListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminJobRunListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminJobRunListRequestMultiError, or nil if none found.
+func (m *AdminJobRunListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminJobRunListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Page
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return AdminJobRunListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminJobRunListRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminJobRunListRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminJobRunListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminJobRunListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminJobRunListRequestMultiError) AllErrors() []error { return m }
+
+// AdminJobRunListRequestValidationError is the validation error returned by
+// AdminJobRunListRequest.Validate if the designated constraints aren't met.
+type AdminJobRunListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminJobRunListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminJobRunListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminJobRunListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminJobRunListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminJobRunListRequestValidationError) ErrorName() string {
+	return "AdminJobRunListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminJobRunListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminJobRunListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminJobRunListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminJobRunListRequestValidationError{}
+
+// Validate checks the field values on AdminJobRunListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminJobRunListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminJobRunListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminJobRunListReplyMultiError, or nil if none found.
+func (m *AdminJobRunListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminJobRunListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRuns() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminJobRunListReplyValidationError{
+						field:  fmt.Sprintf("Runs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminJobRunListReplyValidationError{
+						field:  fmt.Sprintf("Runs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminJobRunListReplyValidationError{
+					field:  fmt.Sprintf("Runs[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return AdminJobRunListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminJobRunListReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminJobRunListReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminJobRunListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminJobRunListReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminJobRunListReplyMultiError) AllErrors() []error { return m }
+
+// AdminJobRunListReplyValidationError is the validation error returned by
+// AdminJobRunListReply.Validate if the designated constraints aren't met.
+type AdminJobRunListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminJobRunListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminJobRunListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminJobRunListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminJobRunListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminJobRunListReplyValidationError) ErrorName() string {
+	return "AdminJobRunListReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminJobRunListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminJobRunListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminJobRunListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminJobRunListReplyValidationError{}
+
+// Validate checks the field values on AdminLocationTransitionListRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *AdminLocationTransitionListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminLocationTransitionListRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// AdminLocationTransitionListRequestMultiError, or nil if none found.
+func (m *AdminLocationTransitionListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminLocationTransitionListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for LocationId
+
+	if len(errors) > 0 {
+		return AdminLocationTransitionListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminLocationTransitionListRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// AdminLocationTransitionListRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminLocationTransitionListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminLocationTransitionListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminLocationTransitionListRequestMultiError) AllErrors() []error { return m }
+
+// AdminLocationTransitionListRequestValidationError is the validation error
+// returned by AdminLocationTransitionListRequest.Validate if the designated
+// constraints aren't met.
+type AdminLocationTransitionListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminLocationTransitionListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminLocationTransitionListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminLocationTransitionListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminLocationTransitionListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminLocationTransitionListRequestValidationError) ErrorName() string {
+	return "AdminLocationTransitionListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminLocationTransitionListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminLocationTransitionListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminLocationTransitionListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminLocationTransitionListRequestValidationError{}
+
+// Validate checks the field values on AdminLocationTransitionListReply with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *AdminLocationTransitionListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminLocationTransitionListReply with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminLocationTransitionListReplyMultiError, or nil if none found.
+func (m *AdminLocationTransitionListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminLocationTransitionListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTransitions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminLocationTransitionListReplyValidationError{
+						field:  fmt.Sprintf("Transitions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminLocationTransitionListReplyValidationError{
+						field:  fmt.Sprintf("Transitions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminLocationTransitionListReplyValidationError{
+					field:  fmt.Sprintf("Transitions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AdminLocationTransitionListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminLocationTransitionListReplyMultiError is an error wrapping multiple
+// validation errors returned by
+// AdminLocationTransitionListReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminLocationTransitionListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminLocationTransitionListReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminLocationTransitionListReplyMultiError) AllErrors() []error { return m }
+
+// AdminLocationTransitionListReplyValidationError is the validation error
+// returned by AdminLocationTransitionListReply.Validate if the designated
+// constraints aren't met.
+type AdminLocationTransitionListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminLocationTransitionListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminLocationTransitionListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminLocationTransitionListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminLocationTransitionListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminLocationTransitionListReplyValidationError) ErrorName() string {
+	return "AdminLocationTransitionListReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminLocationTransitionListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminLocationTransitionListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminLocationTransitionListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminLocationTransitionListReplyValidationError{}
+
+// Validate checks the field values on AdminMatrixReplayRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminMatrixReplayRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminMatrixReplayRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminMatrixReplayRequestMultiError, or nil if none found.
+func (m *AdminMatrixReplayRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminMatrixReplayRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AdminMatrixReplayRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminMatrixReplayRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminMatrixReplayRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminMatrixReplayRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminMatrixReplayRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminMatrixReplayRequestMultiError) AllErrors() []error { return m }
+
+// AdminMatrixReplayRequestValidationError is the validation error returned by
+// AdminMatrixReplayRequest.Validate if the designated constraints aren't met.
+type AdminMatrixReplayRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminMatrixReplayRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminMatrixReplayRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminMatrixReplayRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminMatrixReplayRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminMatrixReplayRequestValidationError) ErrorName() string {
+	return "AdminMatrixReplayRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminMatrixReplayRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminMatrixReplayRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminMatrixReplayRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminMatrixReplayRequestValidationError{}
+
+// Validate checks the field values on AdminMatrixReplayReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminMatrixReplayReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminMatrixReplayReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminMatrixReplayReplyMultiError, or nil if none found.
+func (m *AdminMatrixReplayReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminMatrixReplayReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Events
+
+	// no validation rules for Placed
+
+	// no validation rules for Mode
+
+	if len(errors) > 0 {
+		return AdminMatrixReplayReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminMatrixReplayReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminMatrixReplayReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminMatrixReplayReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminMatrixReplayReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminMatrixReplayReplyMultiError) AllErrors() []error { return m }
+
+// AdminMatrixReplayReplyValidationError is the validation error returned by
+// AdminMatrixReplayReply.Validate if the designated constraints aren't met.
+type AdminMatrixReplayReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminMatrixReplayReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminMatrixReplayReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminMatrixReplayReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminMatrixReplayReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminMatrixReplayReplyValidationError) ErrorName() string {
+	return "AdminMatrixReplayReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminMatrixReplayReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminMatrixReplayReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminMatrixReplayReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminMatrixReplayReplyValidationError{}
+
+// Validate checks the field values on AdminWebhookListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminWebhookListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminWebhookListRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminWebhookListRequestMultiError, or nil if none found.
+func (m *AdminWebhookListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminWebhookListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AdminWebhookListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminWebhookListRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminWebhookListRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminWebhookListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminWebhookListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminWebhookListRequestMultiError) AllErrors() []error { return m }
+
+// AdminWebhookListRequestValidationError is the validation error returned by
+// AdminWebhookListRequest.Validate if the designated constraints aren't met.
+type AdminWebhookListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminWebhookListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminWebhookListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminWebhookListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminWebhookListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminWebhookListRequestValidationError) ErrorName() string {
+	return "AdminWebhookListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminWebhookListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminWebhookListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminWebhookListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminWebhookListRequestValidationError{}
+
+// Validate checks the field values on AdminWebhookListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminWebhookListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminWebhookListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminWebhookListReplyMultiError, or nil if none found.
+func (m *AdminWebhookListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminWebhookListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminWebhookListReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminWebhookListReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminWebhookListReplyValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AdminWebhookListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminWebhookListReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminWebhookListReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminWebhookListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminWebhookListReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminWebhookListReplyMultiError) AllErrors() []error { return m }
+
+// AdminWebhookListReplyValidationError is the validation error returned by
+// AdminWebhookListReply.Validate if the designated constraints aren't met.
+type AdminWebhookListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminWebhookListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminWebhookListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminWebhookListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminWebhookListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminWebhookListReplyValidationError) ErrorName() string {
+	return "AdminWebhookListReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminWebhookListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminWebhookListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminWebhookListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminWebhookListReplyValidationError{}
+
+// Validate checks the field values on AdminWebhookCreateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminWebhookCreateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminWebhookCreateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminWebhookCreateRequestMultiError, or nil if none found.
+func (m *AdminWebhookCreateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminWebhookCreateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminWebhookCreateRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminWebhookCreateRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminWebhookCreateRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AdminWebhookCreateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminWebhookCreateRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminWebhookCreateRequest.ValidateAll() if the
+// designated constraints aren't met.
+type AdminWebhookCreateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminWebhookCreateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminWebhookCreateRequestMultiError) AllErrors() []error { return m }
+
+// AdminWebhookCreateRequestValidationError is the validation error returned by
+// AdminWebhookCreateRequest.Validate if the designated constraints aren't met.
+type AdminWebhookCreateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminWebhookCreateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminWebhookCreateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminWebhookCreateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminWebhookCreateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminWebhookCreateRequestValidationError) ErrorName() string {
+	return "AdminWebhookCreateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminWebhookCreateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminWebhookCreateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminWebhookCreateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminWebhookCreateRequestValidationError{}
+
+// Validate checks the field values on AdminWebhookCreateReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminWebhookCreateReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminWebhookCreateReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminWebhookCreateReplyMultiError, or nil if none found.
+func (m *AdminWebhookCreateReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminWebhookCreateReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Secret
+
+	if len(errors) > 0 {
+		return AdminWebhookCreateReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminWebhookCreateReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminWebhookCreateReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminWebhookCreateReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminWebhookCreateReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminWebhookCreateReplyMultiError) AllErrors() []error { return m }
+
+// AdminWebhookCreateReplyValidationError is the validation error returned by
+// AdminWebhookCreateReply.Validate if the designated constraints aren't met.
+type AdminWebhookCreateReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminWebhookCreateReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminWebhookCreateReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminWebhookCreateReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminWebhookCreateReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminWebhookCreateReplyValidationError) ErrorName() string {
+	return "AdminWebhookCreateReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminWebhookCreateReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminWebhookCreateReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminWebhookCreateReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminWebhookCreateReplyValidationError{}
+
+// Validate checks the field values on AdminWebhookUpdateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminWebhookUpdateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminWebhookUpdateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminWebhookUpdateRequestMultiError, or nil if none found.
+func (m *AdminWebhookUpdateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminWebhookUpdateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminWebhookUpdateRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminWebhookUpdateRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminWebhookUpdateRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AdminWebhookUpdateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminWebhookUpdateRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminWebhookUpdateRequest.ValidateAll() if the
+// designated constraints aren't met.
+type AdminWebhookUpdateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminWebhookUpdateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminWebhookUpdateRequestMultiError) AllErrors() []error { return m }
+
+// AdminWebhookUpdateRequestValidationError is the validation error returned by
+// AdminWebhookUpdateRequest.Validate if the designated constraints aren't met.
+type AdminWebhookUpdateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminWebhookUpdateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminWebhookUpdateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminWebhookUpdateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminWebhookUpdateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminWebhookUpdateRequestValidationError) ErrorName() string {
+	return "AdminWebhookUpdateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminWebhookUpdateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminWebhookUpdateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminWebhookUpdateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminWebhookUpdateRequestValidationError{}
+
+// Validate checks the field values on AdminWebhookUpdateReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminWebhookUpdateReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminWebhookUpdateReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminWebhookUpdateReplyMultiError, or nil if none found.
+func (m *AdminWebhookUpdateReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminWebhookUpdateReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return AdminWebhookUpdateReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminWebhookUpdateReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminWebhookUpdateReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminWebhookUpdateReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminWebhookUpdateReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminWebhookUpdateReplyMultiError) AllErrors() []error { return m }
+
+// AdminWebhookUpdateReplyValidationError is the validation error returned by
+// AdminWebhookUpdateReply.Validate if the designated constraints aren't met.
+type AdminWebhookUpdateReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminWebhookUpdateReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminWebhookUpdateReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminWebhookUpdateReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminWebhookUpdateReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminWebhookUpdateReplyValidationError) ErrorName() string {
+	return "AdminWebhookUpdateReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminWebhookUpdateReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminWebhookUpdateReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminWebhookUpdateReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminWebhookUpdateReplyValidationError{}
+
+// Validate checks the field values on AdminWebhookDeliveryListRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminWebhookDeliveryListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminWebhookDeliveryListRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminWebhookDeliveryListRequestMultiError, or nil if none found.
+func (m *AdminWebhookDeliveryListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminWebhookDeliveryListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Page
+
+	// no validation rules for Status
+
+	// no validation rules for SubscriberId
+
+	if len(errors) > 0 {
+		return AdminWebhookDeliveryListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminWebhookDeliveryListRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminWebhookDeliveryListRequest.ValidateAll()
+// if the designated constraints aren't met.
+type AdminWebhookDeliveryListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminWebhookDeliveryListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminWebhookDeliveryListRequestMultiError) AllErrors() []error { return m }
+
+// AdminWebhookDeliveryListRequestValidationError is the validation error
+// returned by AdminWebhookDeliveryListRequest.Validate if the designated
+// constraints aren't met.
+type AdminWebhookDeliveryListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminWebhookDeliveryListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminWebhookDeliveryListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminWebhookDeliveryListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminWebhookDeliveryListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminWebhookDeliveryListRequestValidationError) ErrorName() string {
+	return "AdminWebhookDeliveryListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminWebhookDeliveryListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminWebhookDeliveryListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminWebhookDeliveryListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminWebhookDeliveryListRequestValidationError{}
+
+// Validate checks the field values on AdminWebhookDeliveryListReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminWebhookDeliveryListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminWebhookDeliveryListReply with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminWebhookDeliveryListReplyMultiError, or nil if none found.
+func (m *AdminWebhookDeliveryListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminWebhookDeliveryListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminWebhookDeliveryListReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminWebhookDeliveryListReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminWebhookDeliveryListReplyValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return AdminWebhookDeliveryListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminWebhookDeliveryListReplyMultiError is an error wrapping multiple
+// validation errors returned by AdminWebhookDeliveryListReply.ValidateAll()
+// if the designated constraints aren't met.
+type AdminWebhookDeliveryListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminWebhookDeliveryListReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminWebhookDeliveryListReplyMultiError) AllErrors() []error { return m }
+
+// AdminWebhookDeliveryListReplyValidationError is the validation error
+// returned by AdminWebhookDeliveryListReply.Validate if the designated
+// constraints aren't met.
+type AdminWebhookDeliveryListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminWebhookDeliveryListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminWebhookDeliveryListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminWebhookDeliveryListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminWebhookDeliveryListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminWebhookDeliveryListReplyValidationError) ErrorName() string {
+	return "AdminWebhookDeliveryListReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminWebhookDeliveryListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminWebhookDeliveryListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminWebhookDeliveryListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminWebhookDeliveryListReplyValidationError{}
+
+// Validate checks the field values on AdminWebhookReplayRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminWebhookReplayRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminWebhookReplayRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminWebhookReplayRequestMultiError, or nil if none found.
+func (m *AdminWebhookReplayRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminWebhookReplayRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminWebhookReplayRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminWebhookReplayRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminWebhookReplayRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AdminWebhookReplayRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminWebhookReplayRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminWebhookReplayRequest.ValidateAll() if the
+// designated constraints aren't met.
+type AdminWebhookReplayRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminWebhookReplayRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminWebhookReplayRequestMultiError) AllErrors() []error { return m }
+
+// AdminWebhookReplayRequestValidationError is the validation error returned by
+// AdminWebhookReplayRequest.Validate if the designated constraints aren't met.
+type AdminWebhookReplayRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminWebhookReplayRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminWebhookReplayRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminWebhookReplayRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminWebhookReplayRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminWebhookReplayRequestValidationError) ErrorName() string {
+	return "AdminWebhookReplayRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminWebhookReplayRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminWebhookReplayRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminWebhookReplayRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminWebhookReplayRequestValidationError{}
+
+// Validate checks the field values on AdminWebhookReplayReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminWebhookReplayReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminWebhookReplayReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminWebhookReplayReplyMultiError, or nil if none found.
+func (m *AdminWebhookReplayReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminWebhookReplayReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Deliveries
+
+	if len(errors) > 0 {
+		return AdminWebhookReplayReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminWebhookReplayReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminWebhookReplayReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminWebhookReplayReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminWebhookReplayReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminWebhookReplayReplyMultiError) AllErrors() []error { return m }
+
+// AdminWebhookReplayReplyValidationError is the validation error returned by
+// AdminWebhookReplayReply.Validate if the designated constraints aren't met.
+type AdminWebhookReplayReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminWebhookReplayReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminWebhookReplayReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminWebhookReplayReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminWebhookReplayReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminWebhookReplayReplyValidationError) ErrorName() string {
+	return "AdminWebhookReplayReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminWebhookReplayReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminWebhookReplayReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminWebhookReplayReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminWebhookReplayReplyValidationError{}
+
+// Validate checks the field values on AdminCacheStatsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminCacheStatsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminCacheStatsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminCacheStatsRequestMultiError, or nil if none found.
+func (m *AdminCacheStatsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminCacheStatsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AdminCacheStatsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminCacheStatsRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminCacheStatsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminCacheStatsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminCacheStatsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminCacheStatsRequestMultiError) AllErrors() []error { return m }
+
+// AdminCacheStatsRequestValidationError is the validation error returned by
+// AdminCacheStatsRequest.Validate if the designated constraints aren't met.
+type AdminCacheStatsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminCacheStatsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminCacheStatsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminCacheStatsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminCacheStatsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminCacheStatsRequestValidationError) ErrorName() string {
+	return "AdminCacheStatsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminCacheStatsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminCacheStatsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminCacheStatsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminCacheStatsRequestValidationError{}
+
+// Validate checks the field values on AdminCacheStatsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminCacheStatsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminCacheStatsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminCacheStatsReplyMultiError, or nil if none found.
+func (m *AdminCacheStatsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminCacheStatsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Enable
+
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminCacheStatsReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminCacheStatsReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminCacheStatsReplyValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AdminCacheStatsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminCacheStatsReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminCacheStatsReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminCacheStatsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminCacheStatsReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminCacheStatsReplyMultiError) AllErrors() []error { return m }
+
+// AdminCacheStatsReplyValidationError is the validation error returned by
+// AdminCacheStatsReply.Validate if the designated constraints aren't met.
+type AdminCacheStatsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminCacheStatsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminCacheStatsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminCacheStatsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminCacheStatsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminCacheStatsReplyValidationError) ErrorName() string {
+	return "AdminCacheStatsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminCacheStatsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminCacheStatsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminCacheStatsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminCacheStatsReplyValidationError{}
+
+// Validate checks the field values on AdminRewardListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminRewardListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminRewardListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminRewardListRequestMultiError, or nil if none found.
+func (m *AdminRewardListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminRewardListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Page
+
+	// no validation rules for Address
+
+	if len(errors) > 0 {
+		return AdminRewardListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminRewardListRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminRewardListRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminRewardListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminRewardListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminRewardListRequestMultiError) AllErrors() []error { return m }
+
+// AdminRewardListRequestValidationError is the validation error returned by
+// AdminRewardListRequest.Validate if the designated constraints aren't met.
+type AdminRewardListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminRewardListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminRewardListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminRewardListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminRewardListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminRewardListRequestValidationError) ErrorName() string {
+	return "AdminRewardListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminRewardListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminRewardListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminRewardListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminRewardListRequestValidationError{}
+
+// Validate checks the field values on AdminRewardListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminRewardListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminRewardListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminRewardListReplyMultiError, or nil if none found.
+func (m *AdminRewardListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminRewardListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRewards() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminRewardListReplyValidationError{
+						field:  fmt.Sprintf("Rewards[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminRewardListReplyValidationError{
+						field:  fmt.Sprintf("Rewards[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminRewardListReplyValidationError{
+					field:  fmt.Sprintf("Rewards[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return AdminRewardListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminRewardListReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminRewardListReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminRewardListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminRewardListReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminRewardListReplyMultiError) AllErrors() []error { return m }
+
+// AdminRewardListReplyValidationError is the validation error returned by
+// AdminRewardListReply.Validate if the designated constraints aren't met.
+type AdminRewardListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminRewardListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminRewardListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminRewardListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminRewardListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminRewardListReplyValidationError) ErrorName() string {
+	return "AdminRewardListReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminRewardListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminRewardListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminRewardListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminRewardListReplyValidationError{}
+
+// Validate checks the field values on AdminUserListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminUserListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminUserListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminUserListRequestMultiError, or nil if none found.
+func (m *AdminUserListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminUserListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Page
+
+	// no validation rules for Address
+
+	if len(errors) > 0 {
+		return AdminUserListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminUserListRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminUserListRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminUserListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminUserListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminUserListRequestMultiError) AllErrors() []error { return m }
+
+// AdminUserListRequestValidationError is the validation error returned by
+// AdminUserListRequest.Validate if the designated constraints aren't met.
+type AdminUserListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminUserListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminUserListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminUserListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminUserListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminUserListRequestValidationError) ErrorName() string {
+	return "AdminUserListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminUserListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminUserListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminUserListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminUserListRequestValidationError{}
+
+// Validate checks the field values on AdminUserListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminUserListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminUserListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminUserListReplyMultiError, or nil if none found.
+func (m *AdminUserListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminUserListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetUsers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminUserListReplyValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminUserListReplyValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminUserListReplyValidationError{
+					field:  fmt.Sprintf("Users[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return AdminUserListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminUserListReplyMultiError is an error wrapping multiple validation errors
+// returned by AdminUserListReply.ValidateAll() if the designated constraints
+// aren't met.
+type AdminUserListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminUserListReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminUserListReplyMultiError) AllErrors() []error { return m }
+
+// AdminUserListReplyValidationError is the validation error returned by
+// AdminUserListReply.Validate if the designated constraints aren't met.
+type AdminUserListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminUserListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminUserListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminUserListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminUserListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminUserListReplyValidationError) ErrorName() string {
+	return "AdminUserListReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminUserListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminUserListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminUserListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminUserListReplyValidationError{}
+
+// Validate checks the field values on AdminLocationListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminLocationListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminLocationListRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminLocationListRequestMultiError, or nil if none found.
+func (m *AdminLocationListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminLocationListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Page
+
+	// no validation rules for Address
+
+	if len(errors) > 0 {
+		return AdminLocationListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminLocationListRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminLocationListRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminLocationListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminLocationListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminLocationListRequestMultiError) AllErrors() []error { return m }
+
+// AdminLocationListRequestValidationError is the validation error returned by
+// AdminLocationListRequest.Validate if the designated constraints aren't met.
+type AdminLocationListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminLocationListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminLocationListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminLocationListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminLocationListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminLocationListRequestValidationError) ErrorName() string {
+	return "AdminLocationListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminLocationListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminLocationListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminLocationListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminLocationListRequestValidationError{}
+
+// Validate checks the field values on AdminLocationListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminLocationListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminLocationListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminLocationListReplyMultiError, or nil if none found.
+func (m *AdminLocationListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminLocationListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetLocations() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminLocationListReplyValidationError{
+						field:  fmt.Sprintf("Locations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminLocationListReplyValidationError{
+						field:  fmt.Sprintf("Locations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminLocationListReplyValidationError{
+					field:  fmt.Sprintf("Locations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return AdminLocationListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminLocationListReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminLocationListReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminLocationListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminLocationListReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminLocationListReplyMultiError) AllErrors() []error { return m }
+
+// AdminLocationListReplyValidationError is the validation error returned by
+// AdminLocationListReply.Validate if the designated constraints aren't met.
+type AdminLocationListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminLocationListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminLocationListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminLocationListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminLocationListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminLocationListReplyValidationError) ErrorName() string {
+	return "AdminLocationListReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminLocationListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminLocationListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminLocationListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminLocationListReplyValidationError{}
+
+// Validate checks the field values on AdminWithdrawListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminWithdrawListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminWithdrawListRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminWithdrawListRequestMultiError, or nil if none found.
+func (m *AdminWithdrawListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminWithdrawListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Page
+
+	// no validation rules for Address
+
+	if len(errors) > 0 {
+		return AdminWithdrawListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminWithdrawListRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminWithdrawListRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminWithdrawListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminWithdrawListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminWithdrawListRequestMultiError) AllErrors() []error { return m }
+
+// AdminWithdrawListRequestValidationError is the validation error returned by
+// AdminWithdrawListRequest.Validate if the designated constraints aren't met.
+type AdminWithdrawListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminWithdrawListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminWithdrawListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminWithdrawListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminWithdrawListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminWithdrawListRequestValidationError) ErrorName() string {
+	return "AdminWithdrawListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminWithdrawListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminWithdrawListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminWithdrawListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminWithdrawListRequestValidationError{}
+
+// Validate checks the field values on AdminWithdrawListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminWithdrawListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminWithdrawListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminWithdrawListReplyMultiError, or nil if none found.
+func (m *AdminWithdrawListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminWithdrawListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetWithdraw() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminWithdrawListReplyValidationError{
+						field:  fmt.Sprintf("Withdraw[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminWithdrawListReplyValidationError{
+						field:  fmt.Sprintf("Withdraw[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminWithdrawListReplyValidationError{
+					field:  fmt.Sprintf("Withdraw[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return AdminWithdrawListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminWithdrawListReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminWithdrawListReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminWithdrawListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminWithdrawListReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminWithdrawListReplyMultiError) AllErrors() []error { return m }
+
+// AdminWithdrawListReplyValidationError is the validation error returned by
+// AdminWithdrawListReply.Validate if the designated constraints aren't met.
+type AdminWithdrawListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminWithdrawListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminWithdrawListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminWithdrawListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminWithdrawListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminWithdrawListReplyValidationError) ErrorName() string {
+	return "AdminWithdrawListReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminWithdrawListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminWithdrawListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminWithdrawListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminWithdrawListReplyValidationError{}
+
+// Validate checks the field values on AdminWithdrawRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminWithdrawRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminWithdrawRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminWithdrawRequestMultiError, or nil if none found.
+func (m *AdminWithdrawRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminWithdrawRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminWithdrawRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminWithdrawRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminWithdrawRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AdminWithdrawRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminWithdrawRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminWithdrawRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminWithdrawRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminWithdrawRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminWithdrawRequestMultiError) AllErrors() []error { return m }
+
+// AdminWithdrawRequestValidationError is the validation error returned by
+// AdminWithdrawRequest.Validate if the designated constraints aren't met.
+type AdminWithdrawRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminWithdrawRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminWithdrawRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminWithdrawRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminWithdrawRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminWithdrawRequestValidationError) ErrorName() string {
+	return "AdminWithdrawRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminWithdrawRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminWithdrawRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminWithdrawRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminWithdrawRequestValidationError{}
+
+// Validate checks the field values on AdminWithdrawReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminWithdrawReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminWithdrawReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminWithdrawReplyMultiError, or nil if none found.
+func (m *AdminWithdrawReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminWithdrawReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return AdminWithdrawReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminWithdrawReplyMultiError is an error wrapping multiple validation errors
+// returned by AdminWithdrawReply.ValidateAll() if the designated constraints
+// aren't met.
+type AdminWithdrawReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminWithdrawReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminWithdrawReplyMultiError) AllErrors() []error { return m }
+
+// AdminWithdrawReplyValidationError is the validation error returned by
+// AdminWithdrawReply.Validate if the designated constraints aren't met.
+type AdminWithdrawReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminWithdrawReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminWithdrawReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminWithdrawReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminWithdrawReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminWithdrawReplyValidationError) ErrorName() string {
+	return "AdminWithdrawReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminWithdrawReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminWithdrawReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminWithdrawReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminWithdrawReplyValidationError{}
+
+// Validate checks the field values on AdminWithdrawEthRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminWithdrawEthRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminWithdrawEthRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminWithdrawEthRequestMultiError, or nil if none found.
+func (m *AdminWithdrawEthRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminWithdrawEthRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AdminWithdrawEthRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminWithdrawEthRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminWithdrawEthRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminWithdrawEthRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminWithdrawEthRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminWithdrawEthRequestMultiError) AllErrors() []error { return m }
+
+// AdminWithdrawEthRequestValidationError is the validation error returned by
+// AdminWithdrawEthRequest.Validate if the designated constraints aren't met.
+type AdminWithdrawEthRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminWithdrawEthRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminWithdrawEthRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminWithdrawEthRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminWithdrawEthRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminWithdrawEthRequestValidationError) ErrorName() string {
+	return "AdminWithdrawEthRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminWithdrawEthRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminWithdrawEthRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminWithdrawEthRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminWithdrawEthRequestValidationError{}
+
+// Validate checks the field values on AdminWithdrawEthReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminWithdrawEthReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminWithdrawEthReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminWithdrawEthReplyMultiError, or nil if none found.
+func (m *AdminWithdrawEthReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminWithdrawEthReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AdminWithdrawEthReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminWithdrawEthReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminWithdrawEthReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminWithdrawEthReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminWithdrawEthReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminWithdrawEthReplyMultiError) AllErrors() []error { return m }
+
+// AdminWithdrawEthReplyValidationError is the validation error returned by
+// AdminWithdrawEthReply.Validate if the designated constraints aren't met.
+type AdminWithdrawEthReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminWithdrawEthReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminWithdrawEthReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminWithdrawEthReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminWithdrawEthReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminWithdrawEthReplyValidationError) ErrorName() string {
+	return "AdminWithdrawEthReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminWithdrawEthReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminWithdrawEthReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminWithdrawEthReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminWithdrawEthReplyValidationError{}
+
+// Validate checks the field values on AdminFeeRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AdminFeeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminFeeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminFeeRequestMultiError, or nil if none found.
+func (m *AdminFeeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminFeeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AdminFeeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminFeeRequestMultiError is an error wrapping multiple validation errors
+// returned by AdminFeeRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AdminFeeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminFeeRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminFeeRequestMultiError) AllErrors() []error { return m }
+
+// AdminFeeRequestValidationError is the validation error returned by
+// AdminFeeRequest.Validate if the designated constraints aren't met.
+type AdminFeeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminFeeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminFeeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminFeeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminFeeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminFeeRequestValidationError) ErrorName() string { return "AdminFeeRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AdminFeeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminFeeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminFeeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminFeeRequestValidationError{}
+
+// Validate checks the field values on AdminFeeReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AdminFeeReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminFeeReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AdminFeeReplyMultiError, or
+// nil if none found.
+func (m *AdminFeeReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminFeeReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AdminFeeReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminFeeReplyMultiError is an error wrapping multiple validation errors
+// returned by AdminFeeReply.ValidateAll() if the designated constraints
+// aren't met.
+type AdminFeeReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminFeeReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminFeeReplyMultiError) AllErrors() []error { return m }
+
+// AdminFeeReplyValidationError is the validation error returned by
+// AdminFeeReply.Validate if the designated constraints aren't met.
+type AdminFeeReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminFeeReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminFeeReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminFeeReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminFeeReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminFeeReplyValidationError) ErrorName() string { return "AdminFeeReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AdminFeeReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminFeeReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminFeeReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminFeeReplyValidationError{}
+
+// Validate checks the field values on TokenWithdrawRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TokenWithdrawRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TokenWithdrawRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TokenWithdrawRequestMultiError, or nil if none found.
+func (m *TokenWithdrawRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TokenWithdrawRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return TokenWithdrawRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// TokenWithdrawRequestMultiError is an error wrapping multiple validation
+// errors returned by TokenWithdrawRequest.ValidateAll() if the designated
+// constraints aren't met.
+type TokenWithdrawRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TokenWithdrawRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TokenWithdrawRequestMultiError) AllErrors() []error { return m }
+
+// TokenWithdrawRequestValidationError is the validation error returned by
+// TokenWithdrawRequest.Validate if the designated constraints aren't met.
+type TokenWithdrawRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TokenWithdrawRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TokenWithdrawRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TokenWithdrawRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TokenWithdrawRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TokenWithdrawRequestValidationError) ErrorName() string {
+	return "TokenWithdrawRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TokenWithdrawRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTokenWithdrawRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TokenWithdrawRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TokenWithdrawRequestValidationError{}
+
+// Validate checks the field values on TokenWithdrawReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TokenWithdrawReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TokenWithdrawReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TokenWithdrawReplyMultiError, or nil if none found.
+func (m *TokenWithdrawReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TokenWithdrawReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return TokenWithdrawReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// TokenWithdrawReplyMultiError is an error wrapping multiple validation errors
+// returned by TokenWithdrawReply.ValidateAll() if the designated constraints
+// aren't met.
+type TokenWithdrawReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TokenWithdrawReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TokenWithdrawReplyMultiError) AllErrors() []error { return m }
+
+// TokenWithdrawReplyValidationError is the validation error returned by
+// TokenWithdrawReply.Validate if the designated constraints aren't met.
+type TokenWithdrawReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TokenWithdrawReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TokenWithdrawReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TokenWithdrawReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TokenWithdrawReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TokenWithdrawReplyValidationError) ErrorName() string {
+	return "TokenWithdrawReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TokenWithdrawReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTokenWithdrawReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TokenWithdrawReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TokenWithdrawReplyValidationError{}
+
+// Validate checks the field values on AdminAllRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AdminAllRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminAllRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminAllRequestMultiError, or nil if none found.
+func (m *AdminAllRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminAllRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AdminAllRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminAllRequestMultiError is an error wrapping multiple validation errors
+// returned by AdminAllRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AdminAllRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminAllRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminAllRequestMultiError) AllErrors() []error { return m }
+
+// AdminAllRequestValidationError is the validation error returned by
+// AdminAllRequest.Validate if the designated constraints aren't met.
+type AdminAllRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminAllRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminAllRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminAllRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminAllRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminAllRequestValidationError) ErrorName() string { return "AdminAllRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AdminAllRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminAllRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminAllRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminAllRequestValidationError{}
+
+// Validate checks the field values on AdminAllReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AdminAllReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminAllReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AdminAllReplyMultiError, or
+// nil if none found.
+func (m *AdminAllReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminAllReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TodayTotalUser
+
+	// no validation rules for TotalUser
+
+	// no validation rules for AllBalance
+
+	// no validation rules for TodayLocation
+
+	// no validation rules for AllLocation
+
+	// no validation rules for TodayWithdraw
+
+	// no validation rules for AllWithdraw
+
+	// no validation rules for AllReward
+
+	// no validation rules for AllSystemRewardAndFee
+
+	if len(errors) > 0 {
+		return AdminAllReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminAllReplyMultiError is an error wrapping multiple validation errors
+// returned by AdminAllReply.ValidateAll() if the designated constraints
+// aren't met.
+type AdminAllReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminAllReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminAllReplyMultiError) AllErrors() []error { return m }
+
+// AdminAllReplyValidationError is the validation error returned by
+// AdminAllReply.Validate if the designated constraints aren't met.
+type AdminAllReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminAllReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminAllReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminAllReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminAllReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminAllReplyValidationError) ErrorName() string { return "AdminAllReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AdminAllReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminAllReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminAllReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminAllReplyValidationError{}
+
+// Validate checks the field values on AdminUserRecommendRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminUserRecommendRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminUserRecommendRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminUserRecommendRequestMultiError, or nil if none found.
+func (m *AdminUserRecommendRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminUserRecommendRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	if len(errors) > 0 {
+		return AdminUserRecommendRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminUserRecommendRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminUserRecommendRequest.ValidateAll() if the
+// designated constraints aren't met.
+type AdminUserRecommendRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminUserRecommendRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminUserRecommendRequestMultiError) AllErrors() []error { return m }
+
+// AdminUserRecommendRequestValidationError is the validation error returned by
+// AdminUserRecommendRequest.Validate if the designated constraints aren't met.
+type AdminUserRecommendRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminUserRecommendRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminUserRecommendRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminUserRecommendRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminUserRecommendRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminUserRecommendRequestValidationError) ErrorName() string {
+	return "AdminUserRecommendRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminUserRecommendRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminUserRecommendRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminUserRecommendRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminUserRecommendRequestValidationError{}
+
+// Validate checks the field values on AdminUserRecommendReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminUserRecommendReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminUserRecommendReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminUserRecommendReplyMultiError, or nil if none found.
+func (m *AdminUserRecommendReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminUserRecommendReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetUsers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminUserRecommendReplyValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminUserRecommendReplyValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminUserRecommendReplyValidationError{
+					field:  fmt.Sprintf("Users[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AdminUserRecommendReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminUserRecommendReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminUserRecommendReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminUserRecommendReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminUserRecommendReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminUserRecommendReplyMultiError) AllErrors() []error { return m }
+
+// AdminUserRecommendReplyValidationError is the validation error returned by
+// AdminUserRecommendReply.Validate if the designated constraints aren't met.
+type AdminUserRecommendReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminUserRecommendReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminUserRecommendReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminUserRecommendReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminUserRecommendReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminUserRecommendReplyValidationError) ErrorName() string {
+	return "AdminUserRecommendReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminUserRecommendReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminUserRecommendReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminUserRecommendReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminUserRecommendReplyValidationError{}
+
+// Validate checks the field values on AdminMonthRecommendRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminMonthRecommendRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminMonthRecommendRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminMonthRecommendRequestMultiError, or nil if none found.
+func (m *AdminMonthRecommendRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminMonthRecommendRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Address
+
+	// no validation rules for Page
+
+	if len(errors) > 0 {
+		return AdminMonthRecommendRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminMonthRecommendRequestMultiError is an error wrapping multiple
+// validation errors returned by AdminMonthRecommendRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AdminMonthRecommendRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminMonthRecommendRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminMonthRecommendRequestMultiError) AllErrors() []error { return m }
+
+// AdminMonthRecommendRequestValidationError is the validation error returned
+// by AdminMonthRecommendRequest.Validate if the designated constraints aren't met.
+type AdminMonthRecommendRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminMonthRecommendRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminMonthRecommendRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminMonthRecommendRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminMonthRecommendRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminMonthRecommendRequestValidationError) ErrorName() string {
+	return "AdminMonthRecommendRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminMonthRecommendRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminMonthRecommendRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminMonthRecommendRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminMonthRecommendRequestValidationError{}
+
+// Validate checks the field values on AdminMonthRecommendReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminMonthRecommendReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminMonthRecommendReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminMonthRecommendReplyMultiError, or nil if none found.
+func (m *AdminMonthRecommendReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminMonthRecommendReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetUsers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminMonthRecommendReplyValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminMonthRecommendReplyValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminMonthRecommendReplyValidationError{
+					field:  fmt.Sprintf("Users[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return AdminMonthRecommendReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminMonthRecommendReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminMonthRecommendReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminMonthRecommendReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminMonthRecommendReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminMonthRecommendReplyMultiError) AllErrors() []error { return m }
+
+// AdminMonthRecommendReplyValidationError is the validation error returned by
+// AdminMonthRecommendReply.Validate if the designated constraints aren't met.
+type AdminMonthRecommendReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminMonthRecommendReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminMonthRecommendReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminMonthRecommendReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminMonthRecommendReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminMonthRecommendReplyValidationError) ErrorName() string {
+	return "AdminMonthRecommendReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminMonthRecommendReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminMonthRecommendReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminMonthRecommendReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminMonthRecommendReplyValidationError{}
+
+// Validate checks the field values on AdminConfigRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminConfigRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminConfigRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminConfigRequestMultiError, or nil if none found.
+func (m *AdminConfigRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminConfigRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for Page
+
+	if len(errors) > 0 {
+		return AdminConfigRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminConfigRequestMultiError is an error wrapping multiple validation errors
+// returned by AdminConfigRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AdminConfigRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminConfigRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminConfigRequestMultiError) AllErrors() []error { return m }
+
+// AdminConfigRequestValidationError is the validation error returned by
+// AdminConfigRequest.Validate if the designated constraints aren't met.
+type AdminConfigRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminConfigRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminConfigRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminConfigRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminConfigRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminConfigRequestValidationError) ErrorName() string {
+	return "AdminConfigRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminConfigRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminConfigRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminConfigRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminConfigRequestValidationError{}
+
+// Validate checks the field values on AdminConfigReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AdminConfigReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminConfigReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminConfigReplyMultiError, or nil if none found.
+func (m *AdminConfigReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminConfigReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetConfig() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminConfigReplyValidationError{
+						field:  fmt.Sprintf("Config[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminConfigReplyValidationError{
+						field:  fmt.Sprintf("Config[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminConfigReplyValidationError{
+					field:  fmt.Sprintf("Config[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return AdminConfigReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminConfigReplyMultiError is an error wrapping multiple validation errors
+// returned by AdminConfigReply.ValidateAll() if the designated constraints
+// aren't met.
+type AdminConfigReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminConfigReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminConfigReplyMultiError) AllErrors() []error { return m }
+
+// AdminConfigReplyValidationError is the validation error returned by
+// AdminConfigReply.Validate if the designated constraints aren't met.
+type AdminConfigReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminConfigReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminConfigReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminConfigReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminConfigReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminConfigReplyValidationError) ErrorName() string { return "AdminConfigReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AdminConfigReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminConfigReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminConfigReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminConfigReplyValidationError{}
+
+// Validate checks the field values on AdminConfigUpdateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminConfigUpdateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminConfigUpdateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminConfigUpdateRequestMultiError, or nil if none found.
+func (m *AdminConfigUpdateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminConfigUpdateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSendBody()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminConfigUpdateRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminConfigUpdateRequestValidationError{
+					field:  "SendBody",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminConfigUpdateRequestValidationError{
+				field:  "SendBody",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AdminConfigUpdateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminConfigUpdateRequestMultiError is an error wrapping multiple validation
+// errors returned by AdminConfigUpdateRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdminConfigUpdateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminConfigUpdateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminConfigUpdateRequestMultiError) AllErrors() []error { return m }
+
+// AdminConfigUpdateRequestValidationError is the validation error returned by
+// AdminConfigUpdateRequest.Validate if the designated constraints aren't met.
+type AdminConfigUpdateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminConfigUpdateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminConfigUpdateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminConfigUpdateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminConfigUpdateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminConfigUpdateRequestValidationError) ErrorName() string {
+	return "AdminConfigUpdateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminConfigUpdateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminConfigUpdateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminConfigUpdateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminConfigUpdateRequestValidationError{}
+
+// Validate checks the field values on AdminConfigUpdateReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminConfigUpdateReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminConfigUpdateReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminConfigUpdateReplyMultiError, or nil if none found.
+func (m *AdminConfigUpdateReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminConfigUpdateReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AdminConfigUpdateReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminConfigUpdateReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminConfigUpdateReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminConfigUpdateReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminConfigUpdateReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminConfigUpdateReplyMultiError) AllErrors() []error { return m }
+
+// AdminConfigUpdateReplyValidationError is the validation error returned by
+// AdminConfigUpdateReply.Validate if the designated constraints aren't met.
+type AdminConfigUpdateReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminConfigUpdateReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminConfigUpdateReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminConfigUpdateReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminConfigUpdateReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminConfigUpdateReplyValidationError) ErrorName() string {
+	return "AdminConfigUpdateReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminConfigUpdateReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminConfigUpdateReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminConfigUpdateReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminConfigUpdateReplyValidationError{}
+
+// Validate checks the field values on EthAuthorizeRequest_SendBody with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EthAuthorizeRequest_SendBody) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EthAuthorizeRequest_SendBody with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EthAuthorizeRequest_SendBodyMultiError, or nil if none found.
+func (m *EthAuthorizeRequest_SendBody) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EthAuthorizeRequest_SendBody) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Address
+
+	// no validation rules for Code
+
+	// no validation rules for Password
+
+	// no validation rules for Sign
+
+	if len(errors) > 0 {
+		return EthAuthorizeRequest_SendBodyMultiError(errors)
+	}
+
+	return nil
+}
+
+// EthAuthorizeRequest_SendBodyMultiError is an error wrapping multiple
+// validation errors returned by EthAuthorizeRequest_SendBody.ValidateAll() if
+// the designated constraints aren't met.
+type EthAuthorizeRequest_SendBodyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EthAuthorizeRequest_SendBodyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EthAuthorizeRequest_SendBodyMultiError) AllErrors() []error { return m }
+
+// EthAuthorizeRequest_SendBodyValidationError is the validation error returned
+// by EthAuthorizeRequest_SendBody.Validate if the designated constraints
+// aren't met.
+type EthAuthorizeRequest_SendBodyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EthAuthorizeRequest_SendBodyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EthAuthorizeRequest_SendBodyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EthAuthorizeRequest_SendBodyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EthAuthorizeRequest_SendBodyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EthAuthorizeRequest_SendBodyValidationError) ErrorName() string {
+	return "EthAuthorizeRequest_SendBodyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EthAuthorizeRequest_SendBodyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEthAuthorizeRequest_SendBody.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EthAuthorizeRequest_SendBodyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EthAuthorizeRequest_SendBodyValidationError{}
+
+// Validate checks the field values on RecommendUpdateRequest_SendBody with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RecommendUpdateRequest_SendBody) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RecommendUpdateRequest_SendBody with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RecommendUpdateRequest_SendBodyMultiError, or nil if none found.
+func (m *RecommendUpdateRequest_SendBody) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RecommendUpdateRequest_SendBody) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Sign
+
+	if len(errors) > 0 {
+		return RecommendUpdateRequest_SendBodyMultiError(errors)
+	}
+
+	return nil
+}
+
+// RecommendUpdateRequest_SendBodyMultiError is an error wrapping multiple
+// validation errors returned by RecommendUpdateRequest_SendBody.ValidateAll()
+// if the designated constraints aren't met.
+type RecommendUpdateRequest_SendBodyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RecommendUpdateRequest_SendBodyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RecommendUpdateRequest_SendBodyMultiError) AllErrors() []error { return m }
+
+// RecommendUpdateRequest_SendBodyValidationError is the validation error
+// returned by RecommendUpdateRequest_SendBody.Validate if the designated
+// constraints aren't met.
+type RecommendUpdateRequest_SendBodyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RecommendUpdateRequest_SendBodyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RecommendUpdateRequest_SendBodyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RecommendUpdateRequest_SendBodyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RecommendUpdateRequest_SendBodyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RecommendUpdateRequest_SendBodyValidationError) ErrorName() string {
+	return "RecommendUpdateRequest_SendBodyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RecommendUpdateRequest_SendBodyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRecommendUpdateRequest_SendBody.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RecommendUpdateRequest_SendBodyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RecommendUpdateRequest_SendBodyValidationError{}
+
+// Validate checks the field values on UserAreaReply_List with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UserAreaReply_List) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserAreaReply_List with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UserAreaReply_ListMultiError, or nil if none found.
+func (m *UserAreaReply_List) ValidateAll() error {
+	return m.validate(true)
+}
 
+func (m *UserAreaReply_List) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
-	// no validation rules for Count
+	var errors []error
+
+	// no validation rules for Address
+
+	// no validation rules for LocationId
+
+	// no validation rules for CountLow
+
+	// no validation rules for Status
+
+	// no validation rules for Active
+
+	// no validation rules for Volume
+
+	// no validation rules for Depth
+
+	// no validation rules for Top
 
 	if len(errors) > 0 {
-		return AdminUserListReplyMultiError(errors)
+		return UserAreaReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminUserListReplyMultiError is an error wrapping multiple validation errors
-// returned by AdminUserListReply.ValidateAll() if the designated constraints
+// UserAreaReply_ListMultiError is an error wrapping multiple validation errors
+// returned by UserAreaReply_List.ValidateAll() if the designated constraints
 // aren't met.
-type AdminUserListReplyMultiError []error
+type UserAreaReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminUserListReplyMultiError) Error() string {
+func (m UserAreaReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5587,11 +14909,11 @@ func (m AdminUserListReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminUserListReplyMultiError) AllErrors() []error { return m }
+func (m UserAreaReply_ListMultiError) AllErrors() []error { return m }
 
-// AdminUserListReplyValidationError is the validation error returned by
-// AdminUserListReply.Validate if the designated constraints aren't met.
-type AdminUserListReplyValidationError struct {
+// UserAreaReply_ListValidationError is the validation error returned by
+// UserAreaReply_List.Validate if the designated constraints aren't met.
+type UserAreaReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5599,24 +14921,24 @@ type AdminUserListReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminUserListReplyValidationError) Field() string { return e.field }
+func (e UserAreaReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminUserListReplyValidationError) Reason() string { return e.reason }
+func (e UserAreaReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminUserListReplyValidationError) Cause() error { return e.cause }
+func (e UserAreaReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminUserListReplyValidationError) Key() bool { return e.key }
+func (e UserAreaReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminUserListReplyValidationError) ErrorName() string {
-	return "AdminUserListReplyValidationError"
+func (e UserAreaReply_ListValidationError) ErrorName() string {
+	return "UserAreaReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminUserListReplyValidationError) Error() string {
+func (e UserAreaReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5628,14 +14950,14 @@ func (e AdminUserListReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminUserListReply.%s: %s%s",
+		"invalid %sUserAreaReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminUserListReplyValidationError{}
+var _ error = UserAreaReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -5643,48 +14965,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminUserListReplyValidationError{}
+} = UserAreaReply_ListValidationError{}
 
-// Validate checks the field values on AdminLocationListRequest with the rules
+// Validate checks the field values on UserInfoReply_List with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminLocationListRequest) Validate() error {
+func (m *UserInfoReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminLocationListRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on UserInfoReply_List with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminLocationListRequestMultiError, or nil if none found.
-func (m *AdminLocationListRequest) ValidateAll() error {
+// UserInfoReply_ListMultiError, or nil if none found.
+func (m *UserInfoReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminLocationListRequest) validate(all bool) error {
+func (m *UserInfoReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Page
+	// no validation rules for Current
 
-	// no validation rules for Address
+	// no validation rules for CurrentMaxSubCurrent
+
+	// no validation rules for Amount
 
 	if len(errors) > 0 {
-		return AdminLocationListRequestMultiError(errors)
+		return UserInfoReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminLocationListRequestMultiError is an error wrapping multiple validation
-// errors returned by AdminLocationListRequest.ValidateAll() if the designated
-// constraints aren't met.
-type AdminLocationListRequestMultiError []error
+// UserInfoReply_ListMultiError is an error wrapping multiple validation errors
+// returned by UserInfoReply_List.ValidateAll() if the designated constraints
+// aren't met.
+type UserInfoReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminLocationListRequestMultiError) Error() string {
+func (m UserInfoReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5693,11 +15017,11 @@ func (m AdminLocationListRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminLocationListRequestMultiError) AllErrors() []error { return m }
+func (m UserInfoReply_ListMultiError) AllErrors() []error { return m }
 
-// AdminLocationListRequestValidationError is the validation error returned by
-// AdminLocationListRequest.Validate if the designated constraints aren't met.
-type AdminLocationListRequestValidationError struct {
+// UserInfoReply_ListValidationError is the validation error returned by
+// UserInfoReply_List.Validate if the designated constraints aren't met.
+type UserInfoReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5705,24 +15029,24 @@ type AdminLocationListRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminLocationListRequestValidationError) Field() string { return e.field }
+func (e UserInfoReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminLocationListRequestValidationError) Reason() string { return e.reason }
+func (e UserInfoReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminLocationListRequestValidationError) Cause() error { return e.cause }
+func (e UserInfoReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminLocationListRequestValidationError) Key() bool { return e.key }
+func (e UserInfoReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminLocationListRequestValidationError) ErrorName() string {
-	return "AdminLocationListRequestValidationError"
+func (e UserInfoReply_ListValidationError) ErrorName() string {
+	return "UserInfoReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminLocationListRequestValidationError) Error() string {
+func (e UserInfoReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5734,14 +15058,14 @@ func (e AdminLocationListRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminLocationListRequest.%s: %s%s",
+		"invalid %sUserInfoReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminLocationListRequestValidationError{}
+var _ error = UserInfoReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -5749,80 +15073,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminLocationListRequestValidationError{}
+} = UserInfoReply_ListValidationError{}
 
-// Validate checks the field values on AdminLocationListReply with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on UserInfoReply_ListWithdraw with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminLocationListReply) Validate() error {
+func (m *UserInfoReply_ListWithdraw) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminLocationListReply with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on UserInfoReply_ListWithdraw with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminLocationListReplyMultiError, or nil if none found.
-func (m *AdminLocationListReply) ValidateAll() error {
+// UserInfoReply_ListWithdrawMultiError, or nil if none found.
+func (m *UserInfoReply_ListWithdraw) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminLocationListReply) validate(all bool) error {
+func (m *UserInfoReply_ListWithdraw) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetLocations() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AdminLocationListReplyValidationError{
-						field:  fmt.Sprintf("Locations[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, AdminLocationListReplyValidationError{
-						field:  fmt.Sprintf("Locations[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return AdminLocationListReplyValidationError{
-					field:  fmt.Sprintf("Locations[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
+	// no validation rules for Amount
 
-	// no validation rules for Count
+	// no validation rules for CreateAt
 
 	if len(errors) > 0 {
-		return AdminLocationListReplyMultiError(errors)
+		return UserInfoReply_ListWithdrawMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminLocationListReplyMultiError is an error wrapping multiple validation
-// errors returned by AdminLocationListReply.ValidateAll() if the designated
-// constraints aren't met.
-type AdminLocationListReplyMultiError []error
+// UserInfoReply_ListWithdrawMultiError is an error wrapping multiple
+// validation errors returned by UserInfoReply_ListWithdraw.ValidateAll() if
+// the designated constraints aren't met.
+type UserInfoReply_ListWithdrawMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminLocationListReplyMultiError) Error() string {
+func (m UserInfoReply_ListWithdrawMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5831,11 +15123,11 @@ func (m AdminLocationListReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminLocationListReplyMultiError) AllErrors() []error { return m }
+func (m UserInfoReply_ListWithdrawMultiError) AllErrors() []error { return m }
 
-// AdminLocationListReplyValidationError is the validation error returned by
-// AdminLocationListReply.Validate if the designated constraints aren't met.
-type AdminLocationListReplyValidationError struct {
+// UserInfoReply_ListWithdrawValidationError is the validation error returned
+// by UserInfoReply_ListWithdraw.Validate if the designated constraints aren't met.
+type UserInfoReply_ListWithdrawValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5843,24 +15135,24 @@ type AdminLocationListReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminLocationListReplyValidationError) Field() string { return e.field }
+func (e UserInfoReply_ListWithdrawValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminLocationListReplyValidationError) Reason() string { return e.reason }
+func (e UserInfoReply_ListWithdrawValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminLocationListReplyValidationError) Cause() error { return e.cause }
+func (e UserInfoReply_ListWithdrawValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminLocationListReplyValidationError) Key() bool { return e.key }
+func (e UserInfoReply_ListWithdrawValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminLocationListReplyValidationError) ErrorName() string {
-	return "AdminLocationListReplyValidationError"
+func (e UserInfoReply_ListWithdrawValidationError) ErrorName() string {
+	return "UserInfoReply_ListWithdrawValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminLocationListReplyValidationError) Error() string {
+func (e UserInfoReply_ListWithdrawValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5872,14 +15164,14 @@ func (e AdminLocationListReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminLocationListReply.%s: %s%s",
+		"invalid %sUserInfoReply_ListWithdraw.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminLocationListReplyValidationError{}
+var _ error = UserInfoReply_ListWithdrawValidationError{}
 
 var _ interface {
 	Field() string
@@ -5887,48 +15179,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminLocationListReplyValidationError{}
+} = UserInfoReply_ListWithdrawValidationError{}
 
-// Validate checks the field values on AdminWithdrawListRequest with the rules
+// Validate checks the field values on UserInfoReply_ListFour with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminWithdrawListRequest) Validate() error {
+func (m *UserInfoReply_ListFour) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminWithdrawListRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on UserInfoReply_ListFour with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminWithdrawListRequestMultiError, or nil if none found.
-func (m *AdminWithdrawListRequest) ValidateAll() error {
+// UserInfoReply_ListFourMultiError, or nil if none found.
+func (m *UserInfoReply_ListFour) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminWithdrawListRequest) validate(all bool) error {
+func (m *UserInfoReply_ListFour) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Page
+	// no validation rules for Amount
 
-	// no validation rules for Address
+	// no validation rules for Reward
+
+	// no validation rules for Location
 
 	if len(errors) > 0 {
-		return AdminWithdrawListRequestMultiError(errors)
+		return UserInfoReply_ListFourMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminWithdrawListRequestMultiError is an error wrapping multiple validation
-// errors returned by AdminWithdrawListRequest.ValidateAll() if the designated
+// UserInfoReply_ListFourMultiError is an error wrapping multiple validation
+// errors returned by UserInfoReply_ListFour.ValidateAll() if the designated
 // constraints aren't met.
-type AdminWithdrawListRequestMultiError []error
+type UserInfoReply_ListFourMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminWithdrawListRequestMultiError) Error() string {
+func (m UserInfoReply_ListFourMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5937,11 +15231,11 @@ func (m AdminWithdrawListRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminWithdrawListRequestMultiError) AllErrors() []error { return m }
-
-// AdminWithdrawListRequestValidationError is the validation error returned by
-// AdminWithdrawListRequest.Validate if the designated constraints aren't met.
-type AdminWithdrawListRequestValidationError struct {
+func (m UserInfoReply_ListFourMultiError) AllErrors() []error { return m }
+
+// UserInfoReply_ListFourValidationError is the validation error returned by
+// UserInfoReply_ListFour.Validate if the designated constraints aren't met.
+type UserInfoReply_ListFourValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5949,24 +15243,24 @@ type AdminWithdrawListRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminWithdrawListRequestValidationError) Field() string { return e.field }
+func (e UserInfoReply_ListFourValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminWithdrawListRequestValidationError) Reason() string { return e.reason }
+func (e UserInfoReply_ListFourValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminWithdrawListRequestValidationError) Cause() error { return e.cause }
+func (e UserInfoReply_ListFourValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminWithdrawListRequestValidationError) Key() bool { return e.key }
+func (e UserInfoReply_ListFourValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminWithdrawListRequestValidationError) ErrorName() string {
-	return "AdminWithdrawListRequestValidationError"
+func (e UserInfoReply_ListFourValidationError) ErrorName() string {
+	return "UserInfoReply_ListFourValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminWithdrawListRequestValidationError) Error() string {
+func (e UserInfoReply_ListFourValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5978,14 +15272,14 @@ func (e AdminWithdrawListRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminWithdrawListRequest.%s: %s%s",
+		"invalid %sUserInfoReply_ListFour.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminWithdrawListRequestValidationError{}
+var _ error = UserInfoReply_ListFourValidationError{}
 
 var _ interface {
 	Field() string
@@ -5993,80 +15287,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminWithdrawListRequestValidationError{}
+} = UserInfoReply_ListFourValidationError{}
 
-// Validate checks the field values on AdminWithdrawListReply with the rules
+// Validate checks the field values on UserInfoReply_ListReward with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminWithdrawListReply) Validate() error {
+func (m *UserInfoReply_ListReward) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminWithdrawListReply with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on UserInfoReply_ListReward with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminWithdrawListReplyMultiError, or nil if none found.
-func (m *AdminWithdrawListReply) ValidateAll() error {
+// UserInfoReply_ListRewardMultiError, or nil if none found.
+func (m *UserInfoReply_ListReward) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminWithdrawListReply) validate(all bool) error {
+func (m *UserInfoReply_ListReward) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetWithdraw() {
-		_, _ = idx, item
+	// no validation rules for CreatedAt
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AdminWithdrawListReplyValidationError{
-						field:  fmt.Sprintf("Withdraw[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, AdminWithdrawListReplyValidationError{
-						field:  fmt.Sprintf("Withdraw[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return AdminWithdrawListReplyValidationError{
-					field:  fmt.Sprintf("Withdraw[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for Type
 
-	}
+	// no validation rules for Reward
 
-	// no validation rules for Count
+	// no validation rules for RewardUsdt
 
 	if len(errors) > 0 {
-		return AdminWithdrawListReplyMultiError(errors)
+		return UserInfoReply_ListRewardMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminWithdrawListReplyMultiError is an error wrapping multiple validation
-// errors returned by AdminWithdrawListReply.ValidateAll() if the designated
+// UserInfoReply_ListRewardMultiError is an error wrapping multiple validation
+// errors returned by UserInfoReply_ListReward.ValidateAll() if the designated
 // constraints aren't met.
-type AdminWithdrawListReplyMultiError []error
+type UserInfoReply_ListRewardMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminWithdrawListReplyMultiError) Error() string {
+func (m UserInfoReply_ListRewardMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6075,11 +15341,11 @@ func (m AdminWithdrawListReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminWithdrawListReplyMultiError) AllErrors() []error { return m }
+func (m UserInfoReply_ListRewardMultiError) AllErrors() []error { return m }
 
-// AdminWithdrawListReplyValidationError is the validation error returned by
-// AdminWithdrawListReply.Validate if the designated constraints aren't met.
-type AdminWithdrawListReplyValidationError struct {
+// UserInfoReply_ListRewardValidationError is the validation error returned by
+// UserInfoReply_ListReward.Validate if the designated constraints aren't met.
+type UserInfoReply_ListRewardValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6087,24 +15353,24 @@ type AdminWithdrawListReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminWithdrawListReplyValidationError) Field() string { return e.field }
+func (e UserInfoReply_ListRewardValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminWithdrawListReplyValidationError) Reason() string { return e.reason }
+func (e UserInfoReply_ListRewardValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminWithdrawListReplyValidationError) Cause() error { return e.cause }
+func (e UserInfoReply_ListRewardValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminWithdrawListReplyValidationError) Key() bool { return e.key }
+func (e UserInfoReply_ListRewardValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminWithdrawListReplyValidationError) ErrorName() string {
-	return "AdminWithdrawListReplyValidationError"
+func (e UserInfoReply_ListRewardValidationError) ErrorName() string {
+	return "UserInfoReply_ListRewardValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminWithdrawListReplyValidationError) Error() string {
+func (e UserInfoReply_ListRewardValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6116,14 +15382,14 @@ func (e AdminWithdrawListReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminWithdrawListReply.%s: %s%s",
+		"invalid %sUserInfoReply_ListReward.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminWithdrawListReplyValidationError{}
+var _ error = UserInfoReply_ListRewardValidationError{}
 
 var _ interface {
 	Field() string
@@ -6131,44 +15397,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminWithdrawListReplyValidationError{}
+} = UserInfoReply_ListRewardValidationError{}
 
-// Validate checks the field values on AdminWithdrawRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on UserInfoReply_ListRecommend with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminWithdrawRequest) Validate() error {
+func (m *UserInfoReply_ListRecommend) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminWithdrawRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on UserInfoReply_ListRecommend with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminWithdrawRequestMultiError, or nil if none found.
-func (m *AdminWithdrawRequest) ValidateAll() error {
+// UserInfoReply_ListRecommendMultiError, or nil if none found.
+func (m *UserInfoReply_ListRecommend) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminWithdrawRequest) validate(all bool) error {
+func (m *UserInfoReply_ListRecommend) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Address
+
 	if len(errors) > 0 {
-		return AdminWithdrawRequestMultiError(errors)
+		return UserInfoReply_ListRecommendMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminWithdrawRequestMultiError is an error wrapping multiple validation
-// errors returned by AdminWithdrawRequest.ValidateAll() if the designated
-// constraints aren't met.
-type AdminWithdrawRequestMultiError []error
+// UserInfoReply_ListRecommendMultiError is an error wrapping multiple
+// validation errors returned by UserInfoReply_ListRecommend.ValidateAll() if
+// the designated constraints aren't met.
+type UserInfoReply_ListRecommendMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminWithdrawRequestMultiError) Error() string {
+func (m UserInfoReply_ListRecommendMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6177,11 +15445,12 @@ func (m AdminWithdrawRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminWithdrawRequestMultiError) AllErrors() []error { return m }
+func (m UserInfoReply_ListRecommendMultiError) AllErrors() []error { return m }
 
-// AdminWithdrawRequestValidationError is the validation error returned by
-// AdminWithdrawRequest.Validate if the designated constraints aren't met.
-type AdminWithdrawRequestValidationError struct {
+// UserInfoReply_ListRecommendValidationError is the validation error returned
+// by UserInfoReply_ListRecommend.Validate if the designated constraints
+// aren't met.
+type UserInfoReply_ListRecommendValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6189,24 +15458,24 @@ type AdminWithdrawRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminWithdrawRequestValidationError) Field() string { return e.field }
+func (e UserInfoReply_ListRecommendValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminWithdrawRequestValidationError) Reason() string { return e.reason }
+func (e UserInfoReply_ListRecommendValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminWithdrawRequestValidationError) Cause() error { return e.cause }
+func (e UserInfoReply_ListRecommendValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminWithdrawRequestValidationError) Key() bool { return e.key }
+func (e UserInfoReply_ListRecommendValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminWithdrawRequestValidationError) ErrorName() string {
-	return "AdminWithdrawRequestValidationError"
+func (e UserInfoReply_ListRecommendValidationError) ErrorName() string {
+	return "UserInfoReply_ListRecommendValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminWithdrawRequestValidationError) Error() string {
+func (e UserInfoReply_ListRecommendValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6218,14 +15487,14 @@ func (e AdminWithdrawRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminWithdrawRequest.%s: %s%s",
+		"invalid %sUserInfoReply_ListRecommend.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminWithdrawRequestValidationError{}
+var _ error = UserInfoReply_ListRecommendValidationError{}
 
 var _ interface {
 	Field() string
@@ -6233,44 +15502,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminWithdrawRequestValidationError{}
+} = UserInfoReply_ListRecommendValidationError{}
 
-// Validate checks the field values on AdminWithdrawReply with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on UserInfoReply_ListExchange with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminWithdrawReply) Validate() error {
+func (m *UserInfoReply_ListExchange) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminWithdrawReply with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on UserInfoReply_ListExchange with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminWithdrawReplyMultiError, or nil if none found.
-func (m *AdminWithdrawReply) ValidateAll() error {
+// UserInfoReply_ListExchangeMultiError, or nil if none found.
+func (m *UserInfoReply_ListExchange) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminWithdrawReply) validate(all bool) error {
+func (m *UserInfoReply_ListExchange) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Amount
+
+	// no validation rules for UsdtAmount
+
+	// no validation rules for Created
+
 	if len(errors) > 0 {
-		return AdminWithdrawReplyMultiError(errors)
+		return UserInfoReply_ListExchangeMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminWithdrawReplyMultiError is an error wrapping multiple validation errors
-// returned by AdminWithdrawReply.ValidateAll() if the designated constraints
-// aren't met.
-type AdminWithdrawReplyMultiError []error
+// UserInfoReply_ListExchangeMultiError is an error wrapping multiple
+// validation errors returned by UserInfoReply_ListExchange.ValidateAll() if
+// the designated constraints aren't met.
+type UserInfoReply_ListExchangeMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminWithdrawReplyMultiError) Error() string {
+func (m UserInfoReply_ListExchangeMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6279,11 +15554,11 @@ func (m AdminWithdrawReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminWithdrawReplyMultiError) AllErrors() []error { return m }
+func (m UserInfoReply_ListExchangeMultiError) AllErrors() []error { return m }
 
-// AdminWithdrawReplyValidationError is the validation error returned by
-// AdminWithdrawReply.Validate if the designated constraints aren't met.
-type AdminWithdrawReplyValidationError struct {
+// UserInfoReply_ListExchangeValidationError is the validation error returned
+// by UserInfoReply_ListExchange.Validate if the designated constraints aren't met.
+type UserInfoReply_ListExchangeValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6291,24 +15566,24 @@ type AdminWithdrawReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminWithdrawReplyValidationError) Field() string { return e.field }
+func (e UserInfoReply_ListExchangeValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminWithdrawReplyValidationError) Reason() string { return e.reason }
+func (e UserInfoReply_ListExchangeValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminWithdrawReplyValidationError) Cause() error { return e.cause }
+func (e UserInfoReply_ListExchangeValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminWithdrawReplyValidationError) Key() bool { return e.key }
+func (e UserInfoReply_ListExchangeValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminWithdrawReplyValidationError) ErrorName() string {
-	return "AdminWithdrawReplyValidationError"
+func (e UserInfoReply_ListExchangeValidationError) ErrorName() string {
+	return "UserInfoReply_ListExchangeValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminWithdrawReplyValidationError) Error() string {
+func (e UserInfoReply_ListExchangeValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6320,14 +15595,14 @@ func (e AdminWithdrawReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminWithdrawReply.%s: %s%s",
+		"invalid %sUserInfoReply_ListExchange.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminWithdrawReplyValidationError{}
+var _ error = UserInfoReply_ListExchangeValidationError{}
 
 var _ interface {
 	Field() string
@@ -6335,44 +15610,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminWithdrawReplyValidationError{}
+} = UserInfoReply_ListExchangeValidationError{}
 
-// Validate checks the field values on AdminWithdrawEthRequest with the rules
+// Validate checks the field values on UserInfo1Reply_List with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminWithdrawEthRequest) Validate() error {
+func (m *UserInfo1Reply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminWithdrawEthRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on UserInfo1Reply_List with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminWithdrawEthRequestMultiError, or nil if none found.
-func (m *AdminWithdrawEthRequest) ValidateAll() error {
+// UserInfo1Reply_ListMultiError, or nil if none found.
+func (m *UserInfo1Reply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminWithdrawEthRequest) validate(all bool) error {
-	if m == nil {
-		return nil
-	}
+func (m *UserInfo1Reply_List) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for Amount
+
+	// no validation rules for AmountMax
 
-	var errors []error
+	// no validation rules for Num
 
 	if len(errors) > 0 {
-		return AdminWithdrawEthRequestMultiError(errors)
+		return UserInfo1Reply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminWithdrawEthRequestMultiError is an error wrapping multiple validation
-// errors returned by AdminWithdrawEthRequest.ValidateAll() if the designated
+// UserInfo1Reply_ListMultiError is an error wrapping multiple validation
+// errors returned by UserInfo1Reply_List.ValidateAll() if the designated
 // constraints aren't met.
-type AdminWithdrawEthRequestMultiError []error
+type UserInfo1Reply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminWithdrawEthRequestMultiError) Error() string {
+func (m UserInfo1Reply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6381,11 +15664,11 @@ func (m AdminWithdrawEthRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminWithdrawEthRequestMultiError) AllErrors() []error { return m }
+func (m UserInfo1Reply_ListMultiError) AllErrors() []error { return m }
 
-// AdminWithdrawEthRequestValidationError is the validation error returned by
-// AdminWithdrawEthRequest.Validate if the designated constraints aren't met.
-type AdminWithdrawEthRequestValidationError struct {
+// UserInfo1Reply_ListValidationError is the validation error returned by
+// UserInfo1Reply_List.Validate if the designated constraints aren't met.
+type UserInfo1Reply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6393,24 +15676,24 @@ type AdminWithdrawEthRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminWithdrawEthRequestValidationError) Field() string { return e.field }
+func (e UserInfo1Reply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminWithdrawEthRequestValidationError) Reason() string { return e.reason }
+func (e UserInfo1Reply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminWithdrawEthRequestValidationError) Cause() error { return e.cause }
+func (e UserInfo1Reply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminWithdrawEthRequestValidationError) Key() bool { return e.key }
+func (e UserInfo1Reply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminWithdrawEthRequestValidationError) ErrorName() string {
-	return "AdminWithdrawEthRequestValidationError"
+func (e UserInfo1Reply_ListValidationError) ErrorName() string {
+	return "UserInfo1Reply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminWithdrawEthRequestValidationError) Error() string {
+func (e UserInfo1Reply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6422,14 +15705,14 @@ func (e AdminWithdrawEthRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminWithdrawEthRequest.%s: %s%s",
+		"invalid %sUserInfo1Reply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminWithdrawEthRequestValidationError{}
+var _ error = UserInfo1Reply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -6437,44 +15720,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminWithdrawEthRequestValidationError{}
+} = UserInfo1Reply_ListValidationError{}
 
-// Validate checks the field values on AdminWithdrawEthReply with the rules
+// Validate checks the field values on UserInfo1Reply_List22 with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminWithdrawEthReply) Validate() error {
+func (m *UserInfo1Reply_List22) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminWithdrawEthReply with the rules
+// ValidateAll checks the field values on UserInfo1Reply_List22 with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminWithdrawEthReplyMultiError, or nil if none found.
-func (m *AdminWithdrawEthReply) ValidateAll() error {
+// UserInfo1Reply_List22MultiError, or nil if none found.
+func (m *UserInfo1Reply_List22) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminWithdrawEthReply) validate(all bool) error {
+func (m *UserInfo1Reply_List22) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for CreatedAt
+
+	// no validation rules for Amount
+
+	// no validation rules for AmountMax
+
 	if len(errors) > 0 {
-		return AdminWithdrawEthReplyMultiError(errors)
+		return UserInfo1Reply_List22MultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminWithdrawEthReplyMultiError is an error wrapping multiple validation
-// errors returned by AdminWithdrawEthReply.ValidateAll() if the designated
+// UserInfo1Reply_List22MultiError is an error wrapping multiple validation
+// errors returned by UserInfo1Reply_List22.ValidateAll() if the designated
 // constraints aren't met.
-type AdminWithdrawEthReplyMultiError []error
+type UserInfo1Reply_List22MultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminWithdrawEthReplyMultiError) Error() string {
+func (m UserInfo1Reply_List22MultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6483,11 +15772,11 @@ func (m AdminWithdrawEthReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminWithdrawEthReplyMultiError) AllErrors() []error { return m }
+func (m UserInfo1Reply_List22MultiError) AllErrors() []error { return m }
 
-// AdminWithdrawEthReplyValidationError is the validation error returned by
-// AdminWithdrawEthReply.Validate if the designated constraints aren't met.
-type AdminWithdrawEthReplyValidationError struct {
+// UserInfo1Reply_List22ValidationError is the validation error returned by
+// UserInfo1Reply_List22.Validate if the designated constraints aren't met.
+type UserInfo1Reply_List22ValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6495,24 +15784,24 @@ type AdminWithdrawEthReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminWithdrawEthReplyValidationError) Field() string { return e.field }
+func (e UserInfo1Reply_List22ValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminWithdrawEthReplyValidationError) Reason() string { return e.reason }
+func (e UserInfo1Reply_List22ValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminWithdrawEthReplyValidationError) Cause() error { return e.cause }
+func (e UserInfo1Reply_List22ValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminWithdrawEthReplyValidationError) Key() bool { return e.key }
+func (e UserInfo1Reply_List22ValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminWithdrawEthReplyValidationError) ErrorName() string {
-	return "AdminWithdrawEthReplyValidationError"
+func (e UserInfo1Reply_List22ValidationError) ErrorName() string {
+	return "UserInfo1Reply_List22ValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminWithdrawEthReplyValidationError) Error() string {
+func (e UserInfo1Reply_List22ValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6524,14 +15813,14 @@ func (e AdminWithdrawEthReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminWithdrawEthReply.%s: %s%s",
+		"invalid %sUserInfo1Reply_List22.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminWithdrawEthReplyValidationError{}
+var _ error = UserInfo1Reply_List22ValidationError{}
 
 var _ interface {
 	Field() string
@@ -6539,44 +15828,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminWithdrawEthReplyValidationError{}
+} = UserInfo1Reply_List22ValidationError{}
 
-// Validate checks the field values on AdminFeeRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *AdminFeeRequest) Validate() error {
+// Validate checks the field values on UserInfo1Reply_List2 with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UserInfo1Reply_List2) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminFeeRequest with the rules
+// ValidateAll checks the field values on UserInfo1Reply_List2 with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminFeeRequestMultiError, or nil if none found.
-func (m *AdminFeeRequest) ValidateAll() error {
+// UserInfo1Reply_List2MultiError, or nil if none found.
+func (m *UserInfo1Reply_List2) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminFeeRequest) validate(all bool) error {
+func (m *UserInfo1Reply_List2) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for CreatedAt
+
+	// no validation rules for Amount
+
 	if len(errors) > 0 {
-		return AdminFeeRequestMultiError(errors)
+		return UserInfo1Reply_List2MultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminFeeRequestMultiError is an error wrapping multiple validation errors
-// returned by AdminFeeRequest.ValidateAll() if the designated constraints
-// aren't met.
-type AdminFeeRequestMultiError []error
+// UserInfo1Reply_List2MultiError is an error wrapping multiple validation
+// errors returned by UserInfo1Reply_List2.ValidateAll() if the designated
+// constraints aren't met.
+type UserInfo1Reply_List2MultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminFeeRequestMultiError) Error() string {
+func (m UserInfo1Reply_List2MultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6585,11 +15878,11 @@ func (m AdminFeeRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminFeeRequestMultiError) AllErrors() []error { return m }
+func (m UserInfo1Reply_List2MultiError) AllErrors() []error { return m }
 
-// AdminFeeRequestValidationError is the validation error returned by
-// AdminFeeRequest.Validate if the designated constraints aren't met.
-type AdminFeeRequestValidationError struct {
+// UserInfo1Reply_List2ValidationError is the validation error returned by
+// UserInfo1Reply_List2.Validate if the designated constraints aren't met.
+type UserInfo1Reply_List2ValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6597,22 +15890,24 @@ type AdminFeeRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminFeeRequestValidationError) Field() string { return e.field }
+func (e UserInfo1Reply_List2ValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminFeeRequestValidationError) Reason() string { return e.reason }
+func (e UserInfo1Reply_List2ValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminFeeRequestValidationError) Cause() error { return e.cause }
+func (e UserInfo1Reply_List2ValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminFeeRequestValidationError) Key() bool { return e.key }
+func (e UserInfo1Reply_List2ValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminFeeRequestValidationError) ErrorName() string { return "AdminFeeRequestValidationError" }
+func (e UserInfo1Reply_List2ValidationError) ErrorName() string {
+	return "UserInfo1Reply_List2ValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e AdminFeeRequestValidationError) Error() string {
+func (e UserInfo1Reply_List2ValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6624,14 +15919,14 @@ func (e AdminFeeRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminFeeRequest.%s: %s%s",
+		"invalid %sUserInfo1Reply_List2.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminFeeRequestValidationError{}
+var _ error = UserInfo1Reply_List2ValidationError{}
 
 var _ interface {
 	Field() string
@@ -6639,44 +15934,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminFeeRequestValidationError{}
+} = UserInfo1Reply_List2ValidationError{}
 
-// Validate checks the field values on AdminFeeReply with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *AdminFeeReply) Validate() error {
+// Validate checks the field values on UserInfo1Reply_List5 with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UserInfo1Reply_List5) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminFeeReply with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in AdminFeeReplyMultiError, or
-// nil if none found.
-func (m *AdminFeeReply) ValidateAll() error {
+// ValidateAll checks the field values on UserInfo1Reply_List5 with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UserInfo1Reply_List5MultiError, or nil if none found.
+func (m *UserInfo1Reply_List5) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminFeeReply) validate(all bool) error {
+func (m *UserInfo1Reply_List5) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for CreatedAt
+
+	// no validation rules for Amount
+
 	if len(errors) > 0 {
-		return AdminFeeReplyMultiError(errors)
+		return UserInfo1Reply_List5MultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminFeeReplyMultiError is an error wrapping multiple validation errors
-// returned by AdminFeeReply.ValidateAll() if the designated constraints
-// aren't met.
-type AdminFeeReplyMultiError []error
+// UserInfo1Reply_List5MultiError is an error wrapping multiple validation
+// errors returned by UserInfo1Reply_List5.ValidateAll() if the designated
+// constraints aren't met.
+type UserInfo1Reply_List5MultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminFeeReplyMultiError) Error() string {
+func (m UserInfo1Reply_List5MultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6685,11 +15984,11 @@ func (m AdminFeeReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminFeeReplyMultiError) AllErrors() []error { return m }
+func (m UserInfo1Reply_List5MultiError) AllErrors() []error { return m }
 
-// AdminFeeReplyValidationError is the validation error returned by
-// AdminFeeReply.Validate if the designated constraints aren't met.
-type AdminFeeReplyValidationError struct {
+// UserInfo1Reply_List5ValidationError is the validation error returned by
+// UserInfo1Reply_List5.Validate if the designated constraints aren't met.
+type UserInfo1Reply_List5ValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6697,22 +15996,24 @@ type AdminFeeReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminFeeReplyValidationError) Field() string { return e.field }
+func (e UserInfo1Reply_List5ValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminFeeReplyValidationError) Reason() string { return e.reason }
+func (e UserInfo1Reply_List5ValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminFeeReplyValidationError) Cause() error { return e.cause }
+func (e UserInfo1Reply_List5ValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminFeeReplyValidationError) Key() bool { return e.key }
+func (e UserInfo1Reply_List5ValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminFeeReplyValidationError) ErrorName() string { return "AdminFeeReplyValidationError" }
+func (e UserInfo1Reply_List5ValidationError) ErrorName() string {
+	return "UserInfo1Reply_List5ValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e AdminFeeReplyValidationError) Error() string {
+func (e UserInfo1Reply_List5ValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6724,14 +16025,14 @@ func (e AdminFeeReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminFeeReply.%s: %s%s",
+		"invalid %sUserInfo1Reply_List5.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminFeeReplyValidationError{}
+var _ error = UserInfo1Reply_List5ValidationError{}
 
 var _ interface {
 	Field() string
@@ -6739,44 +16040,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminFeeReplyValidationError{}
+} = UserInfo1Reply_List5ValidationError{}
 
-// Validate checks the field values on TokenWithdrawRequest with the rules
+// Validate checks the field values on UserInfo1Reply_List7 with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TokenWithdrawRequest) Validate() error {
+func (m *UserInfo1Reply_List7) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TokenWithdrawRequest with the rules
+// ValidateAll checks the field values on UserInfo1Reply_List7 with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TokenWithdrawRequestMultiError, or nil if none found.
-func (m *TokenWithdrawRequest) ValidateAll() error {
+// UserInfo1Reply_List7MultiError, or nil if none found.
+func (m *UserInfo1Reply_List7) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TokenWithdrawRequest) validate(all bool) error {
+func (m *UserInfo1Reply_List7) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Address
+
+	// no validation rules for Amount
+
+	// no validation rules for Usdt
+
+	// no validation rules for Vip
+
 	if len(errors) > 0 {
-		return TokenWithdrawRequestMultiError(errors)
+		return UserInfo1Reply_List7MultiError(errors)
 	}
 
 	return nil
 }
 
-// TokenWithdrawRequestMultiError is an error wrapping multiple validation
-// errors returned by TokenWithdrawRequest.ValidateAll() if the designated
+// UserInfo1Reply_List7MultiError is an error wrapping multiple validation
+// errors returned by UserInfo1Reply_List7.ValidateAll() if the designated
 // constraints aren't met.
-type TokenWithdrawRequestMultiError []error
+type UserInfo1Reply_List7MultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TokenWithdrawRequestMultiError) Error() string {
+func (m UserInfo1Reply_List7MultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6785,11 +16094,11 @@ func (m TokenWithdrawRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TokenWithdrawRequestMultiError) AllErrors() []error { return m }
+func (m UserInfo1Reply_List7MultiError) AllErrors() []error { return m }
 
-// TokenWithdrawRequestValidationError is the validation error returned by
-// TokenWithdrawRequest.Validate if the designated constraints aren't met.
-type TokenWithdrawRequestValidationError struct {
+// UserInfo1Reply_List7ValidationError is the validation error returned by
+// UserInfo1Reply_List7.Validate if the designated constraints aren't met.
+type UserInfo1Reply_List7ValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6797,24 +16106,24 @@ type TokenWithdrawRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e TokenWithdrawRequestValidationError) Field() string { return e.field }
+func (e UserInfo1Reply_List7ValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TokenWithdrawRequestValidationError) Reason() string { return e.reason }
+func (e UserInfo1Reply_List7ValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TokenWithdrawRequestValidationError) Cause() error { return e.cause }
+func (e UserInfo1Reply_List7ValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TokenWithdrawRequestValidationError) Key() bool { return e.key }
+func (e UserInfo1Reply_List7ValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TokenWithdrawRequestValidationError) ErrorName() string {
-	return "TokenWithdrawRequestValidationError"
+func (e UserInfo1Reply_List7ValidationError) ErrorName() string {
+	return "UserInfo1Reply_List7ValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e TokenWithdrawRequestValidationError) Error() string {
+func (e UserInfo1Reply_List7ValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6826,14 +16135,14 @@ func (e TokenWithdrawRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTokenWithdrawRequest.%s: %s%s",
+		"invalid %sUserInfo1Reply_List7.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TokenWithdrawRequestValidationError{}
+var _ error = UserInfo1Reply_List7ValidationError{}
 
 var _ interface {
 	Field() string
@@ -6841,44 +16150,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TokenWithdrawRequestValidationError{}
+} = UserInfo1Reply_List7ValidationError{}
 
-// Validate checks the field values on TokenWithdrawReply with the rules
+// Validate checks the field values on UserInfo1Reply_List11 with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TokenWithdrawReply) Validate() error {
+func (m *UserInfo1Reply_List11) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TokenWithdrawReply with the rules
+// ValidateAll checks the field values on UserInfo1Reply_List11 with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TokenWithdrawReplyMultiError, or nil if none found.
-func (m *TokenWithdrawReply) ValidateAll() error {
+// UserInfo1Reply_List11MultiError, or nil if none found.
+func (m *UserInfo1Reply_List11) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TokenWithdrawReply) validate(all bool) error {
+func (m *UserInfo1Reply_List11) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Address
+
+	// no validation rules for Amount
+
+	// no validation rules for Usdt
+
+	// no validation rules for Vip
+
 	if len(errors) > 0 {
-		return TokenWithdrawReplyMultiError(errors)
+		return UserInfo1Reply_List11MultiError(errors)
 	}
 
 	return nil
 }
 
-// TokenWithdrawReplyMultiError is an error wrapping multiple validation errors
-// returned by TokenWithdrawReply.ValidateAll() if the designated constraints
-// aren't met.
-type TokenWithdrawReplyMultiError []error
+// UserInfo1Reply_List11MultiError is an error wrapping multiple validation
+// errors returned by UserInfo1Reply_List11.ValidateAll() if the designated
+// constraints aren't met.
+type UserInfo1Reply_List11MultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TokenWithdrawReplyMultiError) Error() string {
+func (m UserInfo1Reply_List11MultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6887,11 +16204,11 @@ func (m TokenWithdrawReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TokenWithdrawReplyMultiError) AllErrors() []error { return m }
+func (m UserInfo1Reply_List11MultiError) AllErrors() []error { return m }
 
-// TokenWithdrawReplyValidationError is the validation error returned by
-// TokenWithdrawReply.Validate if the designated constraints aren't met.
-type TokenWithdrawReplyValidationError struct {
+// UserInfo1Reply_List11ValidationError is the validation error returned by
+// UserInfo1Reply_List11.Validate if the designated constraints aren't met.
+type UserInfo1Reply_List11ValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6899,24 +16216,24 @@ type TokenWithdrawReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e TokenWithdrawReplyValidationError) Field() string { return e.field }
+func (e UserInfo1Reply_List11ValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TokenWithdrawReplyValidationError) Reason() string { return e.reason }
+func (e UserInfo1Reply_List11ValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TokenWithdrawReplyValidationError) Cause() error { return e.cause }
+func (e UserInfo1Reply_List11ValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TokenWithdrawReplyValidationError) Key() bool { return e.key }
+func (e UserInfo1Reply_List11ValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TokenWithdrawReplyValidationError) ErrorName() string {
-	return "TokenWithdrawReplyValidationError"
+func (e UserInfo1Reply_List11ValidationError) ErrorName() string {
+	return "UserInfo1Reply_List11ValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e TokenWithdrawReplyValidationError) Error() string {
+func (e UserInfo1Reply_List11ValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6928,14 +16245,14 @@ func (e TokenWithdrawReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTokenWithdrawReply.%s: %s%s",
+		"invalid %sUserInfo1Reply_List11.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TokenWithdrawReplyValidationError{}
+var _ error = UserInfo1Reply_List11ValidationError{}
 
 var _ interface {
 	Field() string
@@ -6943,44 +16260,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TokenWithdrawReplyValidationError{}
+} = UserInfo1Reply_List11ValidationError{}
 
-// Validate checks the field values on AdminAllRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *AdminAllRequest) Validate() error {
+// Validate checks the field values on UserInfo1Reply_List9 with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UserInfo1Reply_List9) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminAllRequest with the rules
+// ValidateAll checks the field values on UserInfo1Reply_List9 with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminAllRequestMultiError, or nil if none found.
-func (m *AdminAllRequest) ValidateAll() error {
+// UserInfo1Reply_List9MultiError, or nil if none found.
+func (m *UserInfo1Reply_List9) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminAllRequest) validate(all bool) error {
+func (m *UserInfo1Reply_List9) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for CreatedAt
+
+	// no validation rules for Amount
+
 	if len(errors) > 0 {
-		return AdminAllRequestMultiError(errors)
+		return UserInfo1Reply_List9MultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminAllRequestMultiError is an error wrapping multiple validation errors
-// returned by AdminAllRequest.ValidateAll() if the designated constraints
-// aren't met.
-type AdminAllRequestMultiError []error
+// UserInfo1Reply_List9MultiError is an error wrapping multiple validation
+// errors returned by UserInfo1Reply_List9.ValidateAll() if the designated
+// constraints aren't met.
+type UserInfo1Reply_List9MultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminAllRequestMultiError) Error() string {
+func (m UserInfo1Reply_List9MultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6989,11 +16310,11 @@ func (m AdminAllRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminAllRequestMultiError) AllErrors() []error { return m }
+func (m UserInfo1Reply_List9MultiError) AllErrors() []error { return m }
 
-// AdminAllRequestValidationError is the validation error returned by
-// AdminAllRequest.Validate if the designated constraints aren't met.
-type AdminAllRequestValidationError struct {
+// UserInfo1Reply_List9ValidationError is the validation error returned by
+// UserInfo1Reply_List9.Validate if the designated constraints aren't met.
+type UserInfo1Reply_List9ValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7001,22 +16322,24 @@ type AdminAllRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminAllRequestValidationError) Field() string { return e.field }
+func (e UserInfo1Reply_List9ValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminAllRequestValidationError) Reason() string { return e.reason }
+func (e UserInfo1Reply_List9ValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminAllRequestValidationError) Cause() error { return e.cause }
+func (e UserInfo1Reply_List9ValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminAllRequestValidationError) Key() bool { return e.key }
+func (e UserInfo1Reply_List9ValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminAllRequestValidationError) ErrorName() string { return "AdminAllRequestValidationError" }
+func (e UserInfo1Reply_List9ValidationError) ErrorName() string {
+	return "UserInfo1Reply_List9ValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e AdminAllRequestValidationError) Error() string {
+func (e UserInfo1Reply_List9ValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7028,14 +16351,14 @@ func (e AdminAllRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminAllRequest.%s: %s%s",
+		"invalid %sUserInfo1Reply_List9.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminAllRequestValidationError{}
+var _ error = UserInfo1Reply_List9ValidationError{}
 
 var _ interface {
 	Field() string
@@ -7043,62 +16366,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminAllRequestValidationError{}
+} = UserInfo1Reply_List9ValidationError{}
 
-// Validate checks the field values on AdminAllReply with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *AdminAllReply) Validate() error {
+// Validate checks the field values on UserInfo1Reply_List13 with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UserInfo1Reply_List13) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminAllReply with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in AdminAllReplyMultiError, or
-// nil if none found.
-func (m *AdminAllReply) ValidateAll() error {
+// ValidateAll checks the field values on UserInfo1Reply_List13 with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UserInfo1Reply_List13MultiError, or nil if none found.
+func (m *UserInfo1Reply_List13) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminAllReply) validate(all bool) error {
+func (m *UserInfo1Reply_List13) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for TodayTotalUser
-
-	// no validation rules for TotalUser
-
-	// no validation rules for AllBalance
-
-	// no validation rules for TodayLocation
-
-	// no validation rules for AllLocation
-
-	// no validation rules for TodayWithdraw
-
-	// no validation rules for AllWithdraw
-
-	// no validation rules for AllReward
+	// no validation rules for CreatedAt
 
-	// no validation rules for AllSystemRewardAndFee
+	// no validation rules for Amount
 
 	if len(errors) > 0 {
-		return AdminAllReplyMultiError(errors)
+		return UserInfo1Reply_List13MultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminAllReplyMultiError is an error wrapping multiple validation errors
-// returned by AdminAllReply.ValidateAll() if the designated constraints
-// aren't met.
-type AdminAllReplyMultiError []error
+// UserInfo1Reply_List13MultiError is an error wrapping multiple validation
+// errors returned by UserInfo1Reply_List13.ValidateAll() if the designated
+// constraints aren't met.
+type UserInfo1Reply_List13MultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminAllReplyMultiError) Error() string {
+func (m UserInfo1Reply_List13MultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7107,11 +16416,11 @@ func (m AdminAllReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminAllReplyMultiError) AllErrors() []error { return m }
+func (m UserInfo1Reply_List13MultiError) AllErrors() []error { return m }
 
-// AdminAllReplyValidationError is the validation error returned by
-// AdminAllReply.Validate if the designated constraints aren't met.
-type AdminAllReplyValidationError struct {
+// UserInfo1Reply_List13ValidationError is the validation error returned by
+// UserInfo1Reply_List13.Validate if the designated constraints aren't met.
+type UserInfo1Reply_List13ValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7119,22 +16428,24 @@ type AdminAllReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminAllReplyValidationError) Field() string { return e.field }
+func (e UserInfo1Reply_List13ValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminAllReplyValidationError) Reason() string { return e.reason }
+func (e UserInfo1Reply_List13ValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminAllReplyValidationError) Cause() error { return e.cause }
+func (e UserInfo1Reply_List13ValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminAllReplyValidationError) Key() bool { return e.key }
+func (e UserInfo1Reply_List13ValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminAllReplyValidationError) ErrorName() string { return "AdminAllReplyValidationError" }
+func (e UserInfo1Reply_List13ValidationError) ErrorName() string {
+	return "UserInfo1Reply_List13ValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e AdminAllReplyValidationError) Error() string {
+func (e UserInfo1Reply_List13ValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7146,14 +16457,14 @@ func (e AdminAllReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminAllReply.%s: %s%s",
+		"invalid %sUserInfo1Reply_List13.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminAllReplyValidationError{}
+var _ error = UserInfo1Reply_List13ValidationError{}
 
 var _ interface {
 	Field() string
@@ -7161,46 +16472,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminAllReplyValidationError{}
+} = UserInfo1Reply_List13ValidationError{}
 
-// Validate checks the field values on AdminUserRecommendRequest with the rules
+// Validate checks the field values on RewardListReply_List with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminUserRecommendRequest) Validate() error {
+func (m *RewardListReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminUserRecommendRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on RewardListReply_List with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminUserRecommendRequestMultiError, or nil if none found.
-func (m *AdminUserRecommendRequest) ValidateAll() error {
+// RewardListReply_ListMultiError, or nil if none found.
+func (m *RewardListReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminUserRecommendRequest) validate(all bool) error {
+func (m *RewardListReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for UserId
+	// no validation rules for CreatedAt
+
+	// no validation rules for Amount
+
+	// no validation rules for LocationStatus
+
+	// no validation rules for Type
 
 	if len(errors) > 0 {
-		return AdminUserRecommendRequestMultiError(errors)
+		return RewardListReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminUserRecommendRequestMultiError is an error wrapping multiple validation
-// errors returned by AdminUserRecommendRequest.ValidateAll() if the
-// designated constraints aren't met.
-type AdminUserRecommendRequestMultiError []error
+// RewardListReply_ListMultiError is an error wrapping multiple validation
+// errors returned by RewardListReply_List.ValidateAll() if the designated
+// constraints aren't met.
+type RewardListReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminUserRecommendRequestMultiError) Error() string {
+func (m RewardListReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7209,11 +16526,11 @@ func (m AdminUserRecommendRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminUserRecommendRequestMultiError) AllErrors() []error { return m }
+func (m RewardListReply_ListMultiError) AllErrors() []error { return m }
 
-// AdminUserRecommendRequestValidationError is the validation error returned by
-// AdminUserRecommendRequest.Validate if the designated constraints aren't met.
-type AdminUserRecommendRequestValidationError struct {
+// RewardListReply_ListValidationError is the validation error returned by
+// RewardListReply_List.Validate if the designated constraints aren't met.
+type RewardListReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7221,24 +16538,24 @@ type AdminUserRecommendRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminUserRecommendRequestValidationError) Field() string { return e.field }
+func (e RewardListReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminUserRecommendRequestValidationError) Reason() string { return e.reason }
+func (e RewardListReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminUserRecommendRequestValidationError) Cause() error { return e.cause }
+func (e RewardListReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminUserRecommendRequestValidationError) Key() bool { return e.key }
+func (e RewardListReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminUserRecommendRequestValidationError) ErrorName() string {
-	return "AdminUserRecommendRequestValidationError"
+func (e RewardListReply_ListValidationError) ErrorName() string {
+	return "RewardListReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminUserRecommendRequestValidationError) Error() string {
+func (e RewardListReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7250,14 +16567,14 @@ func (e AdminUserRecommendRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminUserRecommendRequest.%s: %s%s",
+		"invalid %sRewardListReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminUserRecommendRequestValidationError{}
+var _ error = RewardListReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -7265,78 +16582,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminUserRecommendRequestValidationError{}
+} = RewardListReply_ListValidationError{}
 
-// Validate checks the field values on AdminUserRecommendReply with the rules
+// Validate checks the field values on GetTradeRequest_SendBody with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminUserRecommendReply) Validate() error {
+func (m *GetTradeRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminUserRecommendReply with the
+// ValidateAll checks the field values on GetTradeRequest_SendBody with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminUserRecommendReplyMultiError, or nil if none found.
-func (m *AdminUserRecommendReply) ValidateAll() error {
+// GetTradeRequest_SendBodyMultiError, or nil if none found.
+func (m *GetTradeRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminUserRecommendReply) validate(all bool) error {
+func (m *GetTradeRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetUsers() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AdminUserRecommendReplyValidationError{
-						field:  fmt.Sprintf("Users[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, AdminUserRecommendReplyValidationError{
-						field:  fmt.Sprintf("Users[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return AdminUserRecommendReplyValidationError{
-					field:  fmt.Sprintf("Users[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
+	// no validation rules for Amount
 
 	if len(errors) > 0 {
-		return AdminUserRecommendReplyMultiError(errors)
+		return GetTradeRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminUserRecommendReplyMultiError is an error wrapping multiple validation
-// errors returned by AdminUserRecommendReply.ValidateAll() if the designated
+// GetTradeRequest_SendBodyMultiError is an error wrapping multiple validation
+// errors returned by GetTradeRequest_SendBody.ValidateAll() if the designated
 // constraints aren't met.
-type AdminUserRecommendReplyMultiError []error
+type GetTradeRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminUserRecommendReplyMultiError) Error() string {
+func (m GetTradeRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7345,11 +16630,11 @@ func (m AdminUserRecommendReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminUserRecommendReplyMultiError) AllErrors() []error { return m }
+func (m GetTradeRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// AdminUserRecommendReplyValidationError is the validation error returned by
-// AdminUserRecommendReply.Validate if the designated constraints aren't met.
-type AdminUserRecommendReplyValidationError struct {
+// GetTradeRequest_SendBodyValidationError is the validation error returned by
+// GetTradeRequest_SendBody.Validate if the designated constraints aren't met.
+type GetTradeRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7357,24 +16642,24 @@ type AdminUserRecommendReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminUserRecommendReplyValidationError) Field() string { return e.field }
+func (e GetTradeRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminUserRecommendReplyValidationError) Reason() string { return e.reason }
+func (e GetTradeRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminUserRecommendReplyValidationError) Cause() error { return e.cause }
+func (e GetTradeRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminUserRecommendReplyValidationError) Key() bool { return e.key }
+func (e GetTradeRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminUserRecommendReplyValidationError) ErrorName() string {
-	return "AdminUserRecommendReplyValidationError"
+func (e GetTradeRequest_SendBodyValidationError) ErrorName() string {
+	return "GetTradeRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminUserRecommendReplyValidationError) Error() string {
+func (e GetTradeRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7386,14 +16671,14 @@ func (e AdminUserRecommendReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminUserRecommendReply.%s: %s%s",
+		"invalid %sGetTradeRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminUserRecommendReplyValidationError{}
+var _ error = GetTradeRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -7401,48 +16686,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminUserRecommendReplyValidationError{}
+} = GetTradeRequest_SendBodyValidationError{}
 
-// Validate checks the field values on AdminMonthRecommendRequest with the
+// Validate checks the field values on RecommendRewardListReply_List with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminMonthRecommendRequest) Validate() error {
+func (m *RecommendRewardListReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminMonthRecommendRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AdminMonthRecommendRequestMultiError, or nil if none found.
-func (m *AdminMonthRecommendRequest) ValidateAll() error {
+// ValidateAll checks the field values on RecommendRewardListReply_List with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RecommendRewardListReply_ListMultiError, or nil if none found.
+func (m *RecommendRewardListReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminMonthRecommendRequest) validate(all bool) error {
+func (m *RecommendRewardListReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Address
+	// no validation rules for CreatedAt
 
-	// no validation rules for Page
+	// no validation rules for Amount
+
+	// no validation rules for Type
+
+	// no validation rules for Reason
 
 	if len(errors) > 0 {
-		return AdminMonthRecommendRequestMultiError(errors)
+		return RecommendRewardListReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminMonthRecommendRequestMultiError is an error wrapping multiple
-// validation errors returned by AdminMonthRecommendRequest.ValidateAll() if
-// the designated constraints aren't met.
-type AdminMonthRecommendRequestMultiError []error
+// RecommendRewardListReply_ListMultiError is an error wrapping multiple
+// validation errors returned by RecommendRewardListReply_List.ValidateAll()
+// if the designated constraints aren't met.
+type RecommendRewardListReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminMonthRecommendRequestMultiError) Error() string {
+func (m RecommendRewardListReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7451,11 +16740,12 @@ func (m AdminMonthRecommendRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminMonthRecommendRequestMultiError) AllErrors() []error { return m }
+func (m RecommendRewardListReply_ListMultiError) AllErrors() []error { return m }
 
-// AdminMonthRecommendRequestValidationError is the validation error returned
-// by AdminMonthRecommendRequest.Validate if the designated constraints aren't met.
-type AdminMonthRecommendRequestValidationError struct {
+// RecommendRewardListReply_ListValidationError is the validation error
+// returned by RecommendRewardListReply_List.Validate if the designated
+// constraints aren't met.
+type RecommendRewardListReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7463,24 +16753,24 @@ type AdminMonthRecommendRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminMonthRecommendRequestValidationError) Field() string { return e.field }
+func (e RecommendRewardListReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminMonthRecommendRequestValidationError) Reason() string { return e.reason }
+func (e RecommendRewardListReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminMonthRecommendRequestValidationError) Cause() error { return e.cause }
+func (e RecommendRewardListReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminMonthRecommendRequestValidationError) Key() bool { return e.key }
+func (e RecommendRewardListReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminMonthRecommendRequestValidationError) ErrorName() string {
-	return "AdminMonthRecommendRequestValidationError"
+func (e RecommendRewardListReply_ListValidationError) ErrorName() string {
+	return "RecommendRewardListReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminMonthRecommendRequestValidationError) Error() string {
+func (e RecommendRewardListReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7492,14 +16782,14 @@ func (e AdminMonthRecommendRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminMonthRecommendRequest.%s: %s%s",
+		"invalid %sRecommendRewardListReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminMonthRecommendRequestValidationError{}
+var _ error = RecommendRewardListReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -7507,80 +16797,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminMonthRecommendRequestValidationError{}
+} = RecommendRewardListReply_ListValidationError{}
 
-// Validate checks the field values on AdminMonthRecommendReply with the rules
+// Validate checks the field values on FeeRewardListReply_List with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminMonthRecommendReply) Validate() error {
+func (m *FeeRewardListReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminMonthRecommendReply with the
+// ValidateAll checks the field values on FeeRewardListReply_List with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminMonthRecommendReplyMultiError, or nil if none found.
-func (m *AdminMonthRecommendReply) ValidateAll() error {
+// FeeRewardListReply_ListMultiError, or nil if none found.
+func (m *FeeRewardListReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminMonthRecommendReply) validate(all bool) error {
+func (m *FeeRewardListReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetUsers() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AdminMonthRecommendReplyValidationError{
-						field:  fmt.Sprintf("Users[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, AdminMonthRecommendReplyValidationError{
-						field:  fmt.Sprintf("Users[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return AdminMonthRecommendReplyValidationError{
-					field:  fmt.Sprintf("Users[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
+	// no validation rules for CreatedAt
 
-	// no validation rules for Count
+	// no validation rules for Amount
 
 	if len(errors) > 0 {
-		return AdminMonthRecommendReplyMultiError(errors)
+		return FeeRewardListReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminMonthRecommendReplyMultiError is an error wrapping multiple validation
-// errors returned by AdminMonthRecommendReply.ValidateAll() if the designated
+// FeeRewardListReply_ListMultiError is an error wrapping multiple validation
+// errors returned by FeeRewardListReply_List.ValidateAll() if the designated
 // constraints aren't met.
-type AdminMonthRecommendReplyMultiError []error
+type FeeRewardListReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminMonthRecommendReplyMultiError) Error() string {
+func (m FeeRewardListReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7589,11 +16847,11 @@ func (m AdminMonthRecommendReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminMonthRecommendReplyMultiError) AllErrors() []error { return m }
+func (m FeeRewardListReply_ListMultiError) AllErrors() []error { return m }
 
-// AdminMonthRecommendReplyValidationError is the validation error returned by
-// AdminMonthRecommendReply.Validate if the designated constraints aren't met.
-type AdminMonthRecommendReplyValidationError struct {
+// FeeRewardListReply_ListValidationError is the validation error returned by
+// FeeRewardListReply_List.Validate if the designated constraints aren't met.
+type FeeRewardListReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7601,24 +16859,24 @@ type AdminMonthRecommendReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminMonthRecommendReplyValidationError) Field() string { return e.field }
+func (e FeeRewardListReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminMonthRecommendReplyValidationError) Reason() string { return e.reason }
+func (e FeeRewardListReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminMonthRecommendReplyValidationError) Cause() error { return e.cause }
+func (e FeeRewardListReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminMonthRecommendReplyValidationError) Key() bool { return e.key }
+func (e FeeRewardListReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminMonthRecommendReplyValidationError) ErrorName() string {
-	return "AdminMonthRecommendReplyValidationError"
+func (e FeeRewardListReply_ListValidationError) ErrorName() string {
+	return "FeeRewardListReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminMonthRecommendReplyValidationError) Error() string {
+func (e FeeRewardListReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7630,14 +16888,14 @@ func (e AdminMonthRecommendReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminMonthRecommendReply.%s: %s%s",
+		"invalid %sFeeRewardListReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminMonthRecommendReplyValidationError{}
+var _ error = FeeRewardListReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -7645,48 +16903,58 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminMonthRecommendReplyValidationError{}
+} = FeeRewardListReply_ListValidationError{}
 
-// Validate checks the field values on AdminConfigRequest with the rules
+// Validate checks the field values on WithdrawListReply_List with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminConfigRequest) Validate() error {
+func (m *WithdrawListReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminConfigRequest with the rules
+// ValidateAll checks the field values on WithdrawListReply_List with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminConfigRequestMultiError, or nil if none found.
-func (m *AdminConfigRequest) ValidateAll() error {
+// WithdrawListReply_ListMultiError, or nil if none found.
+func (m *WithdrawListReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminConfigRequest) validate(all bool) error {
+func (m *WithdrawListReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for UserId
+	// no validation rules for CreatedAt
 
-	// no validation rules for Page
+	// no validation rules for Amount
+
+	// no validation rules for Type
+
+	// no validation rules for Status
+
+	// no validation rules for RelAmount
+
+	// no validation rules for Fee
+
+	// no validation rules for Burn
 
 	if len(errors) > 0 {
-		return AdminConfigRequestMultiError(errors)
+		return WithdrawListReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminConfigRequestMultiError is an error wrapping multiple validation errors
-// returned by AdminConfigRequest.ValidateAll() if the designated constraints
-// aren't met.
-type AdminConfigRequestMultiError []error
+// WithdrawListReply_ListMultiError is an error wrapping multiple validation
+// errors returned by WithdrawListReply_List.ValidateAll() if the designated
+// constraints aren't met.
+type WithdrawListReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminConfigRequestMultiError) Error() string {
+func (m WithdrawListReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7695,11 +16963,11 @@ func (m AdminConfigRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminConfigRequestMultiError) AllErrors() []error { return m }
+func (m WithdrawListReply_ListMultiError) AllErrors() []error { return m }
 
-// AdminConfigRequestValidationError is the validation error returned by
-// AdminConfigRequest.Validate if the designated constraints aren't met.
-type AdminConfigRequestValidationError struct {
+// WithdrawListReply_ListValidationError is the validation error returned by
+// WithdrawListReply_List.Validate if the designated constraints aren't met.
+type WithdrawListReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7707,24 +16975,24 @@ type AdminConfigRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminConfigRequestValidationError) Field() string { return e.field }
+func (e WithdrawListReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminConfigRequestValidationError) Reason() string { return e.reason }
+func (e WithdrawListReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminConfigRequestValidationError) Cause() error { return e.cause }
+func (e WithdrawListReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminConfigRequestValidationError) Key() bool { return e.key }
+func (e WithdrawListReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminConfigRequestValidationError) ErrorName() string {
-	return "AdminConfigRequestValidationError"
+func (e WithdrawListReply_ListValidationError) ErrorName() string {
+	return "WithdrawListReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminConfigRequestValidationError) Error() string {
+func (e WithdrawListReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7736,14 +17004,14 @@ func (e AdminConfigRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminConfigRequest.%s: %s%s",
+		"invalid %sWithdrawListReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminConfigRequestValidationError{}
+var _ error = WithdrawListReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -7751,80 +17019,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminConfigRequestValidationError{}
+} = WithdrawListReply_ListValidationError{}
 
-// Validate checks the field values on AdminConfigReply with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *AdminConfigReply) Validate() error {
+// Validate checks the field values on TradeListReply_List with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TradeListReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminConfigReply with the rules
+// ValidateAll checks the field values on TradeListReply_List with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminConfigReplyMultiError, or nil if none found.
-func (m *AdminConfigReply) ValidateAll() error {
+// TradeListReply_ListMultiError, or nil if none found.
+func (m *TradeListReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminConfigReply) validate(all bool) error {
+func (m *TradeListReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetConfig() {
-		_, _ = idx, item
+	// no validation rules for CreatedAt
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AdminConfigReplyValidationError{
-						field:  fmt.Sprintf("Config[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, AdminConfigReplyValidationError{
-						field:  fmt.Sprintf("Config[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return AdminConfigReplyValidationError{
-					field:  fmt.Sprintf("Config[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for AmountCsd
 
-	}
+	// no validation rules for AmountHbs
 
-	// no validation rules for Count
+	// no validation rules for Status
 
 	if len(errors) > 0 {
-		return AdminConfigReplyMultiError(errors)
+		return TradeListReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminConfigReplyMultiError is an error wrapping multiple validation errors
-// returned by AdminConfigReply.ValidateAll() if the designated constraints
-// aren't met.
-type AdminConfigReplyMultiError []error
+// TradeListReply_ListMultiError is an error wrapping multiple validation
+// errors returned by TradeListReply_List.ValidateAll() if the designated
+// constraints aren't met.
+type TradeListReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminConfigReplyMultiError) Error() string {
+func (m TradeListReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7833,11 +17073,11 @@ func (m AdminConfigReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminConfigReplyMultiError) AllErrors() []error { return m }
+func (m TradeListReply_ListMultiError) AllErrors() []error { return m }
 
-// AdminConfigReplyValidationError is the validation error returned by
-// AdminConfigReply.Validate if the designated constraints aren't met.
-type AdminConfigReplyValidationError struct {
+// TradeListReply_ListValidationError is the validation error returned by
+// TradeListReply_List.Validate if the designated constraints aren't met.
+type TradeListReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7845,22 +17085,24 @@ type AdminConfigReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminConfigReplyValidationError) Field() string { return e.field }
+func (e TradeListReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminConfigReplyValidationError) Reason() string { return e.reason }
+func (e TradeListReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminConfigReplyValidationError) Cause() error { return e.cause }
+func (e TradeListReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminConfigReplyValidationError) Key() bool { return e.key }
+func (e TradeListReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminConfigReplyValidationError) ErrorName() string { return "AdminConfigReplyValidationError" }
+func (e TradeListReply_ListValidationError) ErrorName() string {
+	return "TradeListReply_ListValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e AdminConfigReplyValidationError) Error() string {
+func (e TradeListReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7872,14 +17114,14 @@ func (e AdminConfigReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminConfigReply.%s: %s%s",
+		"invalid %sTradeListReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminConfigReplyValidationError{}
+var _ error = TradeListReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -7887,73 +17129,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminConfigReplyValidationError{}
+} = TradeListReply_ListValidationError{}
 
-// Validate checks the field values on AdminConfigUpdateRequest with the rules
+// Validate checks the field values on TranListReply_List with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminConfigUpdateRequest) Validate() error {
+func (m *TranListReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminConfigUpdateRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on TranListReply_List with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminConfigUpdateRequestMultiError, or nil if none found.
-func (m *AdminConfigUpdateRequest) ValidateAll() error {
+// TranListReply_ListMultiError, or nil if none found.
+func (m *TranListReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminConfigUpdateRequest) validate(all bool) error {
+func (m *TranListReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetSendBody()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AdminConfigUpdateRequestValidationError{
-					field:  "SendBody",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, AdminConfigUpdateRequestValidationError{
-					field:  "SendBody",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetSendBody()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return AdminConfigUpdateRequestValidationError{
-				field:  "SendBody",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for CreatedAt
+
+	// no validation rules for Amount
 
 	if len(errors) > 0 {
-		return AdminConfigUpdateRequestMultiError(errors)
+		return TranListReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminConfigUpdateRequestMultiError is an error wrapping multiple validation
-// errors returned by AdminConfigUpdateRequest.ValidateAll() if the designated
-// constraints aren't met.
-type AdminConfigUpdateRequestMultiError []error
+// TranListReply_ListMultiError is an error wrapping multiple validation errors
+// returned by TranListReply_List.ValidateAll() if the designated constraints
+// aren't met.
+type TranListReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminConfigUpdateRequestMultiError) Error() string {
+func (m TranListReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7962,11 +17179,11 @@ func (m AdminConfigUpdateRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminConfigUpdateRequestMultiError) AllErrors() []error { return m }
+func (m TranListReply_ListMultiError) AllErrors() []error { return m }
 
-// AdminConfigUpdateRequestValidationError is the validation error returned by
-// AdminConfigUpdateRequest.Validate if the designated constraints aren't met.
-type AdminConfigUpdateRequestValidationError struct {
+// TranListReply_ListValidationError is the validation error returned by
+// TranListReply_List.Validate if the designated constraints aren't met.
+type TranListReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7974,24 +17191,24 @@ type AdminConfigUpdateRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminConfigUpdateRequestValidationError) Field() string { return e.field }
+func (e TranListReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminConfigUpdateRequestValidationError) Reason() string { return e.reason }
+func (e TranListReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminConfigUpdateRequestValidationError) Cause() error { return e.cause }
+func (e TranListReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminConfigUpdateRequestValidationError) Key() bool { return e.key }
+func (e TranListReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminConfigUpdateRequestValidationError) ErrorName() string {
-	return "AdminConfigUpdateRequestValidationError"
+func (e TranListReply_ListValidationError) ErrorName() string {
+	return "TranListReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminConfigUpdateRequestValidationError) Error() string {
+func (e TranListReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8003,14 +17220,14 @@ func (e AdminConfigUpdateRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminConfigUpdateRequest.%s: %s%s",
+		"invalid %sTranListReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminConfigUpdateRequestValidationError{}
+var _ error = TranListReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -8018,44 +17235,66 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminConfigUpdateRequestValidationError{}
-
-// Validate checks the field values on AdminConfigUpdateReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AdminConfigUpdateReply) Validate() error {
+} = TranListReply_ListValidationError{}
+
+// Validate checks the field values on HistoryReply_List with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *HistoryReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AdminConfigUpdateReply with the rules
+// ValidateAll checks the field values on HistoryReply_List with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AdminConfigUpdateReplyMultiError, or nil if none found.
-func (m *AdminConfigUpdateReply) ValidateAll() error {
+// HistoryReply_ListMultiError, or nil if none found.
+func (m *HistoryReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AdminConfigUpdateReply) validate(all bool) error {
+func (m *HistoryReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Id
+
+	// no validation rules for Type
+
+	// no validation rules for Reason
+
+	// no validation rules for Coin
+
+	// no validation rules for Status
+
+	// no validation rules for Amount
+
+	// no validation rules for AmountB
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for Address
+
+	// no validation rules for Fee
+
+	// no validation rules for Burn
+
 	if len(errors) > 0 {
-		return AdminConfigUpdateReplyMultiError(errors)
+		return HistoryReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// AdminConfigUpdateReplyMultiError is an error wrapping multiple validation
-// errors returned by AdminConfigUpdateReply.ValidateAll() if the designated
-// constraints aren't met.
-type AdminConfigUpdateReplyMultiError []error
+// HistoryReply_ListMultiError is an error wrapping multiple validation errors
+// returned by HistoryReply_List.ValidateAll() if the designated constraints
+// aren't met.
+type HistoryReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AdminConfigUpdateReplyMultiError) Error() string {
+func (m HistoryReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8064,11 +17303,11 @@ func (m AdminConfigUpdateReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AdminConfigUpdateReplyMultiError) AllErrors() []error { return m }
+func (m HistoryReply_ListMultiError) AllErrors() []error { return m }
 
-// AdminConfigUpdateReplyValidationError is the validation error returned by
-// AdminConfigUpdateReply.Validate if the designated constraints aren't met.
-type AdminConfigUpdateReplyValidationError struct {
+// HistoryReply_ListValidationError is the validation error returned by
+// HistoryReply_List.Validate if the designated constraints aren't met.
+type HistoryReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8076,24 +17315,24 @@ type AdminConfigUpdateReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AdminConfigUpdateReplyValidationError) Field() string { return e.field }
+func (e HistoryReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AdminConfigUpdateReplyValidationError) Reason() string { return e.reason }
+func (e HistoryReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AdminConfigUpdateReplyValidationError) Cause() error { return e.cause }
+func (e HistoryReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AdminConfigUpdateReplyValidationError) Key() bool { return e.key }
+func (e HistoryReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AdminConfigUpdateReplyValidationError) ErrorName() string {
-	return "AdminConfigUpdateReplyValidationError"
+func (e HistoryReply_ListValidationError) ErrorName() string {
+	return "HistoryReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AdminConfigUpdateReplyValidationError) Error() string {
+func (e HistoryReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8105,14 +17344,14 @@ func (e AdminConfigUpdateReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAdminConfigUpdateReply.%s: %s%s",
+		"invalid %sHistoryReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AdminConfigUpdateReplyValidationError{}
+var _ error = HistoryReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -8120,52 +17359,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AdminConfigUpdateReplyValidationError{}
+} = HistoryReply_ListValidationError{}
 
-// Validate checks the field values on EthAuthorizeRequest_SendBody with the
+// Validate checks the field values on CreateStatementRequest_SendBody with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *EthAuthorizeRequest_SendBody) Validate() error {
+func (m *CreateStatementRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on EthAuthorizeRequest_SendBody with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// EthAuthorizeRequest_SendBodyMultiError, or nil if none found.
-func (m *EthAuthorizeRequest_SendBody) ValidateAll() error {
+// ValidateAll checks the field values on CreateStatementRequest_SendBody with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CreateStatementRequest_SendBodyMultiError, or nil if none found.
+func (m *CreateStatementRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *EthAuthorizeRequest_SendBody) validate(all bool) error {
+func (m *CreateStatementRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Address
-
-	// no validation rules for Code
+	// no validation rules for Start
 
-	// no validation rules for Password
+	// no validation rules for End
 
-	// no validation rules for Sign
+	// no validation rules for Format
 
 	if len(errors) > 0 {
-		return EthAuthorizeRequest_SendBodyMultiError(errors)
+		return CreateStatementRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// EthAuthorizeRequest_SendBodyMultiError is an error wrapping multiple
-// validation errors returned by EthAuthorizeRequest_SendBody.ValidateAll() if
-// the designated constraints aren't met.
-type EthAuthorizeRequest_SendBodyMultiError []error
+// CreateStatementRequest_SendBodyMultiError is an error wrapping multiple
+// validation errors returned by CreateStatementRequest_SendBody.ValidateAll()
+// if the designated constraints aren't met.
+type CreateStatementRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m EthAuthorizeRequest_SendBodyMultiError) Error() string {
+func (m CreateStatementRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8174,12 +17411,12 @@ func (m EthAuthorizeRequest_SendBodyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m EthAuthorizeRequest_SendBodyMultiError) AllErrors() []error { return m }
+func (m CreateStatementRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// EthAuthorizeRequest_SendBodyValidationError is the validation error returned
-// by EthAuthorizeRequest_SendBody.Validate if the designated constraints
-// aren't met.
-type EthAuthorizeRequest_SendBodyValidationError struct {
+// CreateStatementRequest_SendBodyValidationError is the validation error
+// returned by CreateStatementRequest_SendBody.Validate if the designated
+// constraints aren't met.
+type CreateStatementRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8187,24 +17424,24 @@ type EthAuthorizeRequest_SendBodyValidationError struct {
 }
 
 // Field function returns field value.
-func (e EthAuthorizeRequest_SendBodyValidationError) Field() string { return e.field }
+func (e CreateStatementRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e EthAuthorizeRequest_SendBodyValidationError) Reason() string { return e.reason }
+func (e CreateStatementRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e EthAuthorizeRequest_SendBodyValidationError) Cause() error { return e.cause }
+func (e CreateStatementRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e EthAuthorizeRequest_SendBodyValidationError) Key() bool { return e.key }
+func (e CreateStatementRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e EthAuthorizeRequest_SendBodyValidationError) ErrorName() string {
-	return "EthAuthorizeRequest_SendBodyValidationError"
+func (e CreateStatementRequest_SendBodyValidationError) ErrorName() string {
+	return "CreateStatementRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e EthAuthorizeRequest_SendBodyValidationError) Error() string {
+func (e CreateStatementRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8216,14 +17453,14 @@ func (e EthAuthorizeRequest_SendBodyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sEthAuthorizeRequest_SendBody.%s: %s%s",
+		"invalid %sCreateStatementRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = EthAuthorizeRequest_SendBodyValidationError{}
+var _ error = CreateStatementRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -8231,48 +17468,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = EthAuthorizeRequest_SendBodyValidationError{}
+} = CreateStatementRequest_SendBodyValidationError{}
 
-// Validate checks the field values on RecommendUpdateRequest_SendBody with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on RecommendListReply_List with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RecommendUpdateRequest_SendBody) Validate() error {
+func (m *RecommendListReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RecommendUpdateRequest_SendBody with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// RecommendUpdateRequest_SendBodyMultiError, or nil if none found.
-func (m *RecommendUpdateRequest_SendBody) ValidateAll() error {
+// ValidateAll checks the field values on RecommendListReply_List with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RecommendListReply_ListMultiError, or nil if none found.
+func (m *RecommendListReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RecommendUpdateRequest_SendBody) validate(all bool) error {
+func (m *RecommendListReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Code
+	// no validation rules for Amount
 
-	// no validation rules for Sign
+	// no validation rules for Status
 
 	if len(errors) > 0 {
-		return RecommendUpdateRequest_SendBodyMultiError(errors)
+		return RecommendListReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// RecommendUpdateRequest_SendBodyMultiError is an error wrapping multiple
-// validation errors returned by RecommendUpdateRequest_SendBody.ValidateAll()
-// if the designated constraints aren't met.
-type RecommendUpdateRequest_SendBodyMultiError []error
+// RecommendListReply_ListMultiError is an error wrapping multiple validation
+// errors returned by RecommendListReply_List.ValidateAll() if the designated
+// constraints aren't met.
+type RecommendListReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RecommendUpdateRequest_SendBodyMultiError) Error() string {
+func (m RecommendListReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8281,12 +17518,11 @@ func (m RecommendUpdateRequest_SendBodyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RecommendUpdateRequest_SendBodyMultiError) AllErrors() []error { return m }
+func (m RecommendListReply_ListMultiError) AllErrors() []error { return m }
 
-// RecommendUpdateRequest_SendBodyValidationError is the validation error
-// returned by RecommendUpdateRequest_SendBody.Validate if the designated
-// constraints aren't met.
-type RecommendUpdateRequest_SendBodyValidationError struct {
+// RecommendListReply_ListValidationError is the validation error returned by
+// RecommendListReply_List.Validate if the designated constraints aren't met.
+type RecommendListReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8294,24 +17530,24 @@ type RecommendUpdateRequest_SendBodyValidationError struct {
 }
 
 // Field function returns field value.
-func (e RecommendUpdateRequest_SendBodyValidationError) Field() string { return e.field }
+func (e RecommendListReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RecommendUpdateRequest_SendBodyValidationError) Reason() string { return e.reason }
+func (e RecommendListReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RecommendUpdateRequest_SendBodyValidationError) Cause() error { return e.cause }
+func (e RecommendListReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RecommendUpdateRequest_SendBodyValidationError) Key() bool { return e.key }
+func (e RecommendListReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RecommendUpdateRequest_SendBodyValidationError) ErrorName() string {
-	return "RecommendUpdateRequest_SendBodyValidationError"
+func (e RecommendListReply_ListValidationError) ErrorName() string {
+	return "RecommendListReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RecommendUpdateRequest_SendBodyValidationError) Error() string {
+func (e RecommendListReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8323,14 +17559,14 @@ func (e RecommendUpdateRequest_SendBodyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRecommendUpdateRequest_SendBody.%s: %s%s",
+		"invalid %sRecommendListReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RecommendUpdateRequest_SendBodyValidationError{}
+var _ error = RecommendListReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -8338,50 +17574,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RecommendUpdateRequest_SendBodyValidationError{}
+} = RecommendListReply_ListValidationError{}
 
-// Validate checks the field values on UserAreaReply_List with the rules
+// Validate checks the field values on ExchangeRequest_SendBody with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UserAreaReply_List) Validate() error {
+func (m *ExchangeRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UserAreaReply_List with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ExchangeRequest_SendBody with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UserAreaReply_ListMultiError, or nil if none found.
-func (m *UserAreaReply_List) ValidateAll() error {
+// ExchangeRequest_SendBodyMultiError, or nil if none found.
+func (m *ExchangeRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UserAreaReply_List) validate(all bool) error {
+func (m *ExchangeRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Address
-
-	// no validation rules for LocationId
+	// no validation rules for Sign
 
-	// no validation rules for CountLow
+	// no validation rules for Amount
 
 	if len(errors) > 0 {
-		return UserAreaReply_ListMultiError(errors)
+		return ExchangeRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// UserAreaReply_ListMultiError is an error wrapping multiple validation errors
-// returned by UserAreaReply_List.ValidateAll() if the designated constraints
-// aren't met.
-type UserAreaReply_ListMultiError []error
+// ExchangeRequest_SendBodyMultiError is an error wrapping multiple validation
+// errors returned by ExchangeRequest_SendBody.ValidateAll() if the designated
+// constraints aren't met.
+type ExchangeRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UserAreaReply_ListMultiError) Error() string {
+func (m ExchangeRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8390,11 +17624,11 @@ func (m UserAreaReply_ListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UserAreaReply_ListMultiError) AllErrors() []error { return m }
+func (m ExchangeRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// UserAreaReply_ListValidationError is the validation error returned by
-// UserAreaReply_List.Validate if the designated constraints aren't met.
-type UserAreaReply_ListValidationError struct {
+// ExchangeRequest_SendBodyValidationError is the validation error returned by
+// ExchangeRequest_SendBody.Validate if the designated constraints aren't met.
+type ExchangeRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8402,24 +17636,24 @@ type UserAreaReply_ListValidationError struct {
 }
 
 // Field function returns field value.
-func (e UserAreaReply_ListValidationError) Field() string { return e.field }
+func (e ExchangeRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UserAreaReply_ListValidationError) Reason() string { return e.reason }
+func (e ExchangeRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UserAreaReply_ListValidationError) Cause() error { return e.cause }
+func (e ExchangeRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UserAreaReply_ListValidationError) Key() bool { return e.key }
+func (e ExchangeRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UserAreaReply_ListValidationError) ErrorName() string {
-	return "UserAreaReply_ListValidationError"
+func (e ExchangeRequest_SendBodyValidationError) ErrorName() string {
+	return "ExchangeRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UserAreaReply_ListValidationError) Error() string {
+func (e ExchangeRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8431,14 +17665,14 @@ func (e UserAreaReply_ListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUserAreaReply_List.%s: %s%s",
+		"invalid %sExchangeRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UserAreaReply_ListValidationError{}
+var _ error = ExchangeRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -8446,50 +17680,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UserAreaReply_ListValidationError{}
+} = ExchangeRequest_SendBodyValidationError{}
 
-// Validate checks the field values on UserInfoReply_List with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on BuyLocationRequest_SendBody with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UserInfoReply_List) Validate() error {
+func (m *BuyLocationRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UserInfoReply_List with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on BuyLocationRequest_SendBody with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UserInfoReply_ListMultiError, or nil if none found.
-func (m *UserInfoReply_List) ValidateAll() error {
+// BuyLocationRequest_SendBodyMultiError, or nil if none found.
+func (m *BuyLocationRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UserInfoReply_List) validate(all bool) error {
+func (m *BuyLocationRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Current
+	// no validation rules for Sign
 
-	// no validation rules for CurrentMaxSubCurrent
+	// no validation rules for Num
 
-	// no validation rules for Amount
+	// no validation rules for Reinvest
 
 	if len(errors) > 0 {
-		return UserInfoReply_ListMultiError(errors)
+		return BuyLocationRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// UserInfoReply_ListMultiError is an error wrapping multiple validation errors
-// returned by UserInfoReply_List.ValidateAll() if the designated constraints
-// aren't met.
-type UserInfoReply_ListMultiError []error
+// BuyLocationRequest_SendBodyMultiError is an error wrapping multiple
+// validation errors returned by BuyLocationRequest_SendBody.ValidateAll() if
+// the designated constraints aren't met.
+type BuyLocationRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UserInfoReply_ListMultiError) Error() string {
+func (m BuyLocationRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8498,11 +17732,12 @@ func (m UserInfoReply_ListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UserInfoReply_ListMultiError) AllErrors() []error { return m }
+func (m BuyLocationRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// UserInfoReply_ListValidationError is the validation error returned by
-// UserInfoReply_List.Validate if the designated constraints aren't met.
-type UserInfoReply_ListValidationError struct {
+// BuyLocationRequest_SendBodyValidationError is the validation error returned
+// by BuyLocationRequest_SendBody.Validate if the designated constraints
+// aren't met.
+type BuyLocationRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8510,24 +17745,24 @@ type UserInfoReply_ListValidationError struct {
 }
 
 // Field function returns field value.
-func (e UserInfoReply_ListValidationError) Field() string { return e.field }
+func (e BuyLocationRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UserInfoReply_ListValidationError) Reason() string { return e.reason }
+func (e BuyLocationRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UserInfoReply_ListValidationError) Cause() error { return e.cause }
+func (e BuyLocationRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UserInfoReply_ListValidationError) Key() bool { return e.key }
+func (e BuyLocationRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UserInfoReply_ListValidationError) ErrorName() string {
-	return "UserInfoReply_ListValidationError"
+func (e BuyLocationRequest_SendBodyValidationError) ErrorName() string {
+	return "BuyLocationRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UserInfoReply_ListValidationError) Error() string {
+func (e BuyLocationRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8539,14 +17774,14 @@ func (e UserInfoReply_ListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUserInfoReply_List.%s: %s%s",
+		"invalid %sBuyLocationRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UserInfoReply_ListValidationError{}
+var _ error = BuyLocationRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -8554,48 +17789,54 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UserInfoReply_ListValidationError{}
+} = BuyLocationRequest_SendBodyValidationError{}
 
-// Validate checks the field values on UserInfoReply_ListWithdraw with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on WithdrawRequest_SendBody with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UserInfoReply_ListWithdraw) Validate() error {
+func (m *WithdrawRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UserInfoReply_ListWithdraw with the
+// ValidateAll checks the field values on WithdrawRequest_SendBody with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UserInfoReply_ListWithdrawMultiError, or nil if none found.
-func (m *UserInfoReply_ListWithdraw) ValidateAll() error {
+// WithdrawRequest_SendBodyMultiError, or nil if none found.
+func (m *WithdrawRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UserInfoReply_ListWithdraw) validate(all bool) error {
+func (m *WithdrawRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Type
+
 	// no validation rules for Amount
 
-	// no validation rules for CreateAt
+	// no validation rules for Password
+
+	// no validation rules for Sign
+
+	// no validation rules for AddressId
 
 	if len(errors) > 0 {
-		return UserInfoReply_ListWithdrawMultiError(errors)
+		return WithdrawRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// UserInfoReply_ListWithdrawMultiError is an error wrapping multiple
-// validation errors returned by UserInfoReply_ListWithdraw.ValidateAll() if
-// the designated constraints aren't met.
-type UserInfoReply_ListWithdrawMultiError []error
+// WithdrawRequest_SendBodyMultiError is an error wrapping multiple validation
+// errors returned by WithdrawRequest_SendBody.ValidateAll() if the designated
+// constraints aren't met.
+type WithdrawRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UserInfoReply_ListWithdrawMultiError) Error() string {
+func (m WithdrawRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8604,11 +17845,11 @@ func (m UserInfoReply_ListWithdrawMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UserInfoReply_ListWithdrawMultiError) AllErrors() []error { return m }
+func (m WithdrawRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// UserInfoReply_ListWithdrawValidationError is the validation error returned
-// by UserInfoReply_ListWithdraw.Validate if the designated constraints aren't met.
-type UserInfoReply_ListWithdrawValidationError struct {
+// WithdrawRequest_SendBodyValidationError is the validation error returned by
+// WithdrawRequest_SendBody.Validate if the designated constraints aren't met.
+type WithdrawRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8616,24 +17857,24 @@ type UserInfoReply_ListWithdrawValidationError struct {
 }
 
 // Field function returns field value.
-func (e UserInfoReply_ListWithdrawValidationError) Field() string { return e.field }
+func (e WithdrawRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UserInfoReply_ListWithdrawValidationError) Reason() string { return e.reason }
+func (e WithdrawRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UserInfoReply_ListWithdrawValidationError) Cause() error { return e.cause }
+func (e WithdrawRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UserInfoReply_ListWithdrawValidationError) Key() bool { return e.key }
+func (e WithdrawRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UserInfoReply_ListWithdrawValidationError) ErrorName() string {
-	return "UserInfoReply_ListWithdrawValidationError"
+func (e WithdrawRequest_SendBodyValidationError) ErrorName() string {
+	return "WithdrawRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UserInfoReply_ListWithdrawValidationError) Error() string {
+func (e WithdrawRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8645,14 +17886,14 @@ func (e UserInfoReply_ListWithdrawValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUserInfoReply_ListWithdraw.%s: %s%s",
+		"invalid %sWithdrawRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UserInfoReply_ListWithdrawValidationError{}
+var _ error = WithdrawRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -8660,50 +17901,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UserInfoReply_ListWithdrawValidationError{}
+} = WithdrawRequest_SendBodyValidationError{}
 
-// Validate checks the field values on UserInfoReply_ListFour with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on PasswordChangeRequest_SendBody with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UserInfoReply_ListFour) Validate() error {
+func (m *PasswordChangeRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UserInfoReply_ListFour with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// UserInfoReply_ListFourMultiError, or nil if none found.
-func (m *UserInfoReply_ListFour) ValidateAll() error {
+// ValidateAll checks the field values on PasswordChangeRequest_SendBody with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// PasswordChangeRequest_SendBodyMultiError, or nil if none found.
+func (m *PasswordChangeRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UserInfoReply_ListFour) validate(all bool) error {
+func (m *PasswordChangeRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Amount
-
-	// no validation rules for Reward
-
-	// no validation rules for Location
+	// no validation rules for Password
 
 	if len(errors) > 0 {
-		return UserInfoReply_ListFourMultiError(errors)
+		return PasswordChangeRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// UserInfoReply_ListFourMultiError is an error wrapping multiple validation
-// errors returned by UserInfoReply_ListFour.ValidateAll() if the designated
-// constraints aren't met.
-type UserInfoReply_ListFourMultiError []error
+// PasswordChangeRequest_SendBodyMultiError is an error wrapping multiple
+// validation errors returned by PasswordChangeRequest_SendBody.ValidateAll()
+// if the designated constraints aren't met.
+type PasswordChangeRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UserInfoReply_ListFourMultiError) Error() string {
+func (m PasswordChangeRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8712,11 +17949,12 @@ func (m UserInfoReply_ListFourMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UserInfoReply_ListFourMultiError) AllErrors() []error { return m }
+func (m PasswordChangeRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// UserInfoReply_ListFourValidationError is the validation error returned by
-// UserInfoReply_ListFour.Validate if the designated constraints aren't met.
-type UserInfoReply_ListFourValidationError struct {
+// PasswordChangeRequest_SendBodyValidationError is the validation error
+// returned by PasswordChangeRequest_SendBody.Validate if the designated
+// constraints aren't met.
+type PasswordChangeRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8724,24 +17962,24 @@ type UserInfoReply_ListFourValidationError struct {
 }
 
 // Field function returns field value.
-func (e UserInfoReply_ListFourValidationError) Field() string { return e.field }
+func (e PasswordChangeRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UserInfoReply_ListFourValidationError) Reason() string { return e.reason }
+func (e PasswordChangeRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UserInfoReply_ListFourValidationError) Cause() error { return e.cause }
+func (e PasswordChangeRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UserInfoReply_ListFourValidationError) Key() bool { return e.key }
+func (e PasswordChangeRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UserInfoReply_ListFourValidationError) ErrorName() string {
-	return "UserInfoReply_ListFourValidationError"
+func (e PasswordChangeRequest_SendBodyValidationError) ErrorName() string {
+	return "PasswordChangeRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UserInfoReply_ListFourValidationError) Error() string {
+func (e PasswordChangeRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8753,14 +17991,14 @@ func (e UserInfoReply_ListFourValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUserInfoReply_ListFour.%s: %s%s",
+		"invalid %sPasswordChangeRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UserInfoReply_ListFourValidationError{}
+var _ error = PasswordChangeRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -8768,52 +18006,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UserInfoReply_ListFourValidationError{}
+} = PasswordChangeRequest_SendBodyValidationError{}
 
-// Validate checks the field values on UserInfoReply_ListReward with the rules
+// Validate checks the field values on TranRequest_SendBody with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UserInfoReply_ListReward) Validate() error {
+func (m *TranRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UserInfoReply_ListReward with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on TranRequest_SendBody with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UserInfoReply_ListRewardMultiError, or nil if none found.
-func (m *UserInfoReply_ListReward) ValidateAll() error {
+// TranRequest_SendBodyMultiError, or nil if none found.
+func (m *TranRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UserInfoReply_ListReward) validate(all bool) error {
+func (m *TranRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for CreatedAt
-
 	// no validation rules for Type
 
-	// no validation rules for Reward
+	// no validation rules for Amount
 
-	// no validation rules for RewardUsdt
+	// no validation rules for Address
+
+	// no validation rules for Password
 
 	if len(errors) > 0 {
-		return UserInfoReply_ListRewardMultiError(errors)
+		return TranRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// UserInfoReply_ListRewardMultiError is an error wrapping multiple validation
-// errors returned by UserInfoReply_ListReward.ValidateAll() if the designated
+// TranRequest_SendBodyMultiError is an error wrapping multiple validation
+// errors returned by TranRequest_SendBody.ValidateAll() if the designated
 // constraints aren't met.
-type UserInfoReply_ListRewardMultiError []error
+type TranRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UserInfoReply_ListRewardMultiError) Error() string {
+func (m TranRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8822,11 +18060,11 @@ func (m UserInfoReply_ListRewardMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UserInfoReply_ListRewardMultiError) AllErrors() []error { return m }
+func (m TranRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// UserInfoReply_ListRewardValidationError is the validation error returned by
-// UserInfoReply_ListReward.Validate if the designated constraints aren't met.
-type UserInfoReply_ListRewardValidationError struct {
+// TranRequest_SendBodyValidationError is the validation error returned by
+// TranRequest_SendBody.Validate if the designated constraints aren't met.
+type TranRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8834,24 +18072,24 @@ type UserInfoReply_ListRewardValidationError struct {
 }
 
 // Field function returns field value.
-func (e UserInfoReply_ListRewardValidationError) Field() string { return e.field }
+func (e TranRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UserInfoReply_ListRewardValidationError) Reason() string { return e.reason }
+func (e TranRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UserInfoReply_ListRewardValidationError) Cause() error { return e.cause }
+func (e TranRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UserInfoReply_ListRewardValidationError) Key() bool { return e.key }
+func (e TranRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UserInfoReply_ListRewardValidationError) ErrorName() string {
-	return "UserInfoReply_ListRewardValidationError"
+func (e TranRequest_SendBodyValidationError) ErrorName() string {
+	return "TranRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UserInfoReply_ListRewardValidationError) Error() string {
+func (e TranRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8863,14 +18101,14 @@ func (e UserInfoReply_ListRewardValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUserInfoReply_ListReward.%s: %s%s",
+		"invalid %sTranRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UserInfoReply_ListRewardValidationError{}
+var _ error = TranRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -8878,46 +18116,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UserInfoReply_ListRewardValidationError{}
+} = TranRequest_SendBodyValidationError{}
 
-// Validate checks the field values on UserInfoReply_ListRecommend with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UserInfoReply_ListRecommend) Validate() error {
+// Validate checks the field values on SetBalanceRewardRequest_SendBody with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *SetBalanceRewardRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UserInfoReply_ListRecommend with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// UserInfoReply_ListRecommendMultiError, or nil if none found.
-func (m *UserInfoReply_ListRecommend) ValidateAll() error {
+// ValidateAll checks the field values on SetBalanceRewardRequest_SendBody with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// SetBalanceRewardRequest_SendBodyMultiError, or nil if none found.
+func (m *SetBalanceRewardRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UserInfoReply_ListRecommend) validate(all bool) error {
+func (m *SetBalanceRewardRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Address
+	// no validation rules for Amount
 
 	if len(errors) > 0 {
-		return UserInfoReply_ListRecommendMultiError(errors)
+		return SetBalanceRewardRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// UserInfoReply_ListRecommendMultiError is an error wrapping multiple
-// validation errors returned by UserInfoReply_ListRecommend.ValidateAll() if
-// the designated constraints aren't met.
-type UserInfoReply_ListRecommendMultiError []error
+// SetBalanceRewardRequest_SendBodyMultiError is an error wrapping multiple
+// validation errors returned by
+// SetBalanceRewardRequest_SendBody.ValidateAll() if the designated
+// constraints aren't met.
+type SetBalanceRewardRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UserInfoReply_ListRecommendMultiError) Error() string {
+func (m SetBalanceRewardRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8926,12 +18166,12 @@ func (m UserInfoReply_ListRecommendMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UserInfoReply_ListRecommendMultiError) AllErrors() []error { return m }
+func (m SetBalanceRewardRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// UserInfoReply_ListRecommendValidationError is the validation error returned
-// by UserInfoReply_ListRecommend.Validate if the designated constraints
-// aren't met.
-type UserInfoReply_ListRecommendValidationError struct {
+// SetBalanceRewardRequest_SendBodyValidationError is the validation error
+// returned by SetBalanceRewardRequest_SendBody.Validate if the designated
+// constraints aren't met.
+type SetBalanceRewardRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8939,24 +18179,24 @@ type UserInfoReply_ListRecommendValidationError struct {
 }
 
 // Field function returns field value.
-func (e UserInfoReply_ListRecommendValidationError) Field() string { return e.field }
+func (e SetBalanceRewardRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UserInfoReply_ListRecommendValidationError) Reason() string { return e.reason }
+func (e SetBalanceRewardRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UserInfoReply_ListRecommendValidationError) Cause() error { return e.cause }
+func (e SetBalanceRewardRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UserInfoReply_ListRecommendValidationError) Key() bool { return e.key }
+func (e SetBalanceRewardRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UserInfoReply_ListRecommendValidationError) ErrorName() string {
-	return "UserInfoReply_ListRecommendValidationError"
+func (e SetBalanceRewardRequest_SendBodyValidationError) ErrorName() string {
+	return "SetBalanceRewardRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UserInfoReply_ListRecommendValidationError) Error() string {
+func (e SetBalanceRewardRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8968,14 +18208,14 @@ func (e UserInfoReply_ListRecommendValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUserInfoReply_ListRecommend.%s: %s%s",
+		"invalid %sSetBalanceRewardRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UserInfoReply_ListRecommendValidationError{}
+var _ error = SetBalanceRewardRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -8983,24 +18223,25 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UserInfoReply_ListRecommendValidationError{}
+} = SetBalanceRewardRequest_SendBodyValidationError{}
 
-// Validate checks the field values on UserInfoReply_ListExchange with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UserInfoReply_ListExchange) Validate() error {
+// Validate checks the field values on DeleteBalanceRewardRequest_SendBody with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *DeleteBalanceRewardRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
-
-// ValidateAll checks the field values on UserInfoReply_ListExchange with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// UserInfoReply_ListExchangeMultiError, or nil if none found.
-func (m *UserInfoReply_ListExchange) ValidateAll() error {
+
+// ValidateAll checks the field values on DeleteBalanceRewardRequest_SendBody
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// DeleteBalanceRewardRequest_SendBodyMultiError, or nil if none found.
+func (m *DeleteBalanceRewardRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UserInfoReply_ListExchange) validate(all bool) error {
+func (m *DeleteBalanceRewardRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -9009,24 +18250,21 @@ func (m *UserInfoReply_ListExchange) validate(all bool) error {
 
 	// no validation rules for Amount
 
-	// no validation rules for UsdtAmount
-
-	// no validation rules for Created
-
 	if len(errors) > 0 {
-		return UserInfoReply_ListExchangeMultiError(errors)
+		return DeleteBalanceRewardRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// UserInfoReply_ListExchangeMultiError is an error wrapping multiple
-// validation errors returned by UserInfoReply_ListExchange.ValidateAll() if
-// the designated constraints aren't met.
-type UserInfoReply_ListExchangeMultiError []error
+// DeleteBalanceRewardRequest_SendBodyMultiError is an error wrapping multiple
+// validation errors returned by
+// DeleteBalanceRewardRequest_SendBody.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteBalanceRewardRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UserInfoReply_ListExchangeMultiError) Error() string {
+func (m DeleteBalanceRewardRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9035,11 +18273,12 @@ func (m UserInfoReply_ListExchangeMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UserInfoReply_ListExchangeMultiError) AllErrors() []error { return m }
+func (m DeleteBalanceRewardRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// UserInfoReply_ListExchangeValidationError is the validation error returned
-// by UserInfoReply_ListExchange.Validate if the designated constraints aren't met.
-type UserInfoReply_ListExchangeValidationError struct {
+// DeleteBalanceRewardRequest_SendBodyValidationError is the validation error
+// returned by DeleteBalanceRewardRequest_SendBody.Validate if the designated
+// constraints aren't met.
+type DeleteBalanceRewardRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9047,24 +18286,24 @@ type UserInfoReply_ListExchangeValidationError struct {
 }
 
 // Field function returns field value.
-func (e UserInfoReply_ListExchangeValidationError) Field() string { return e.field }
+func (e DeleteBalanceRewardRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UserInfoReply_ListExchangeValidationError) Reason() string { return e.reason }
+func (e DeleteBalanceRewardRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UserInfoReply_ListExchangeValidationError) Cause() error { return e.cause }
+func (e DeleteBalanceRewardRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UserInfoReply_ListExchangeValidationError) Key() bool { return e.key }
+func (e DeleteBalanceRewardRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UserInfoReply_ListExchangeValidationError) ErrorName() string {
-	return "UserInfoReply_ListExchangeValidationError"
+func (e DeleteBalanceRewardRequest_SendBodyValidationError) ErrorName() string {
+	return "DeleteBalanceRewardRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UserInfoReply_ListExchangeValidationError) Error() string {
+func (e DeleteBalanceRewardRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9076,14 +18315,14 @@ func (e UserInfoReply_ListExchangeValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUserInfoReply_ListExchange.%s: %s%s",
+		"invalid %sDeleteBalanceRewardRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UserInfoReply_ListExchangeValidationError{}
+var _ error = DeleteBalanceRewardRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -9091,52 +18330,54 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UserInfoReply_ListExchangeValidationError{}
+} = DeleteBalanceRewardRequest_SendBodyValidationError{}
 
-// Validate checks the field values on UserInfo1Reply_List with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on DeleteBalanceRewardReply_List with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UserInfo1Reply_List) Validate() error {
+func (m *DeleteBalanceRewardReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UserInfo1Reply_List with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// UserInfo1Reply_ListMultiError, or nil if none found.
-func (m *UserInfo1Reply_List) ValidateAll() error {
+// ValidateAll checks the field values on DeleteBalanceRewardReply_List with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// DeleteBalanceRewardReply_ListMultiError, or nil if none found.
+func (m *DeleteBalanceRewardReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UserInfo1Reply_List) validate(all bool) error {
+func (m *DeleteBalanceRewardReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for CreatedAt
+	// no validation rules for Id
 
 	// no validation rules for Amount
 
-	// no validation rules for AmountMax
+	// no validation rules for Penalty
 
-	// no validation rules for Num
+	// no validation rules for Status
+
+	// no validation rules for MatureAt
 
 	if len(errors) > 0 {
-		return UserInfo1Reply_ListMultiError(errors)
+		return DeleteBalanceRewardReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// UserInfo1Reply_ListMultiError is an error wrapping multiple validation
-// errors returned by UserInfo1Reply_List.ValidateAll() if the designated
-// constraints aren't met.
-type UserInfo1Reply_ListMultiError []error
+// DeleteBalanceRewardReply_ListMultiError is an error wrapping multiple
+// validation errors returned by DeleteBalanceRewardReply_List.ValidateAll()
+// if the designated constraints aren't met.
+type DeleteBalanceRewardReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UserInfo1Reply_ListMultiError) Error() string {
+func (m DeleteBalanceRewardReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9145,11 +18386,12 @@ func (m UserInfo1Reply_ListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UserInfo1Reply_ListMultiError) AllErrors() []error { return m }
+func (m DeleteBalanceRewardReply_ListMultiError) AllErrors() []error { return m }
 
-// UserInfo1Reply_ListValidationError is the validation error returned by
-// UserInfo1Reply_List.Validate if the designated constraints aren't met.
-type UserInfo1Reply_ListValidationError struct {
+// DeleteBalanceRewardReply_ListValidationError is the validation error
+// returned by DeleteBalanceRewardReply_List.Validate if the designated
+// constraints aren't met.
+type DeleteBalanceRewardReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9157,24 +18399,24 @@ type UserInfo1Reply_ListValidationError struct {
 }
 
 // Field function returns field value.
-func (e UserInfo1Reply_ListValidationError) Field() string { return e.field }
+func (e DeleteBalanceRewardReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UserInfo1Reply_ListValidationError) Reason() string { return e.reason }
+func (e DeleteBalanceRewardReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UserInfo1Reply_ListValidationError) Cause() error { return e.cause }
+func (e DeleteBalanceRewardReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UserInfo1Reply_ListValidationError) Key() bool { return e.key }
+func (e DeleteBalanceRewardReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UserInfo1Reply_ListValidationError) ErrorName() string {
-	return "UserInfo1Reply_ListValidationError"
+func (e DeleteBalanceRewardReply_ListValidationError) ErrorName() string {
+	return "DeleteBalanceRewardReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UserInfo1Reply_ListValidationError) Error() string {
+func (e DeleteBalanceRewardReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9186,14 +18428,14 @@ func (e UserInfo1Reply_ListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUserInfo1Reply_List.%s: %s%s",
+		"invalid %sDeleteBalanceRewardReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UserInfo1Reply_ListValidationError{}
+var _ error = DeleteBalanceRewardReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -9201,50 +18443,56 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UserInfo1Reply_ListValidationError{}
+} = DeleteBalanceRewardReply_ListValidationError{}
 
-// Validate checks the field values on UserInfo1Reply_List22 with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on BalanceRewardSummaryReply_List with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UserInfo1Reply_List22) Validate() error {
+func (m *BalanceRewardSummaryReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UserInfo1Reply_List22 with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// UserInfo1Reply_List22MultiError, or nil if none found.
-func (m *UserInfo1Reply_List22) ValidateAll() error {
+// ValidateAll checks the field values on BalanceRewardSummaryReply_List with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// BalanceRewardSummaryReply_ListMultiError, or nil if none found.
+func (m *BalanceRewardSummaryReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UserInfo1Reply_List22) validate(all bool) error {
+func (m *BalanceRewardSummaryReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for CreatedAt
+	// no validation rules for Id
 
 	// no validation rules for Amount
 
-	// no validation rules for AmountMax
+	// no validation rules for Rate
+
+	// no validation rules for SetDate
+
+	// no validation rules for LastRewardDate
+
+	// no validation rules for NextPayoutAt
 
 	if len(errors) > 0 {
-		return UserInfo1Reply_List22MultiError(errors)
+		return BalanceRewardSummaryReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// UserInfo1Reply_List22MultiError is an error wrapping multiple validation
-// errors returned by UserInfo1Reply_List22.ValidateAll() if the designated
-// constraints aren't met.
-type UserInfo1Reply_List22MultiError []error
+// BalanceRewardSummaryReply_ListMultiError is an error wrapping multiple
+// validation errors returned by BalanceRewardSummaryReply_List.ValidateAll()
+// if the designated constraints aren't met.
+type BalanceRewardSummaryReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UserInfo1Reply_List22MultiError) Error() string {
+func (m BalanceRewardSummaryReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9253,11 +18501,12 @@ func (m UserInfo1Reply_List22MultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UserInfo1Reply_List22MultiError) AllErrors() []error { return m }
+func (m BalanceRewardSummaryReply_ListMultiError) AllErrors() []error { return m }
 
-// UserInfo1Reply_List22ValidationError is the validation error returned by
-// UserInfo1Reply_List22.Validate if the designated constraints aren't met.
-type UserInfo1Reply_List22ValidationError struct {
+// BalanceRewardSummaryReply_ListValidationError is the validation error
+// returned by BalanceRewardSummaryReply_List.Validate if the designated
+// constraints aren't met.
+type BalanceRewardSummaryReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9265,24 +18514,24 @@ type UserInfo1Reply_List22ValidationError struct {
 }
 
 // Field function returns field value.
-func (e UserInfo1Reply_List22ValidationError) Field() string { return e.field }
+func (e BalanceRewardSummaryReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UserInfo1Reply_List22ValidationError) Reason() string { return e.reason }
+func (e BalanceRewardSummaryReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UserInfo1Reply_List22ValidationError) Cause() error { return e.cause }
+func (e BalanceRewardSummaryReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UserInfo1Reply_List22ValidationError) Key() bool { return e.key }
+func (e BalanceRewardSummaryReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UserInfo1Reply_List22ValidationError) ErrorName() string {
-	return "UserInfo1Reply_List22ValidationError"
+func (e BalanceRewardSummaryReply_ListValidationError) ErrorName() string {
+	return "BalanceRewardSummaryReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UserInfo1Reply_List22ValidationError) Error() string {
+func (e BalanceRewardSummaryReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9294,14 +18543,14 @@ func (e UserInfo1Reply_List22ValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUserInfo1Reply_List22.%s: %s%s",
+		"invalid %sBalanceRewardSummaryReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UserInfo1Reply_List22ValidationError{}
+var _ error = BalanceRewardSummaryReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -9309,48 +18558,56 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UserInfo1Reply_List22ValidationError{}
+} = BalanceRewardSummaryReply_ListValidationError{}
 
-// Validate checks the field values on UserInfo1Reply_List2 with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on WithdrawAddressListReply_List with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UserInfo1Reply_List2) Validate() error {
+func (m *WithdrawAddressListReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UserInfo1Reply_List2 with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// UserInfo1Reply_List2MultiError, or nil if none found.
-func (m *UserInfo1Reply_List2) ValidateAll() error {
+// ValidateAll checks the field values on WithdrawAddressListReply_List with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// WithdrawAddressListReply_ListMultiError, or nil if none found.
+func (m *WithdrawAddressListReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UserInfo1Reply_List2) validate(all bool) error {
+func (m *WithdrawAddressListReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for CreatedAt
+	// no validation rules for Id
 
-	// no validation rules for Amount
+	// no validation rules for Label
+
+	// no validation rules for Address
+
+	// no validation rules for Active
+
+	// no validation rules for ActiveAt
+
+	// no validation rules for CreatedAt
 
 	if len(errors) > 0 {
-		return UserInfo1Reply_List2MultiError(errors)
+		return WithdrawAddressListReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// UserInfo1Reply_List2MultiError is an error wrapping multiple validation
-// errors returned by UserInfo1Reply_List2.ValidateAll() if the designated
-// constraints aren't met.
-type UserInfo1Reply_List2MultiError []error
+// WithdrawAddressListReply_ListMultiError is an error wrapping multiple
+// validation errors returned by WithdrawAddressListReply_List.ValidateAll()
+// if the designated constraints aren't met.
+type WithdrawAddressListReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UserInfo1Reply_List2MultiError) Error() string {
+func (m WithdrawAddressListReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9359,11 +18616,12 @@ func (m UserInfo1Reply_List2MultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UserInfo1Reply_List2MultiError) AllErrors() []error { return m }
+func (m WithdrawAddressListReply_ListMultiError) AllErrors() []error { return m }
 
-// UserInfo1Reply_List2ValidationError is the validation error returned by
-// UserInfo1Reply_List2.Validate if the designated constraints aren't met.
-type UserInfo1Reply_List2ValidationError struct {
+// WithdrawAddressListReply_ListValidationError is the validation error
+// returned by WithdrawAddressListReply_List.Validate if the designated
+// constraints aren't met.
+type WithdrawAddressListReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9371,24 +18629,24 @@ type UserInfo1Reply_List2ValidationError struct {
 }
 
 // Field function returns field value.
-func (e UserInfo1Reply_List2ValidationError) Field() string { return e.field }
+func (e WithdrawAddressListReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UserInfo1Reply_List2ValidationError) Reason() string { return e.reason }
+func (e WithdrawAddressListReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UserInfo1Reply_List2ValidationError) Cause() error { return e.cause }
+func (e WithdrawAddressListReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UserInfo1Reply_List2ValidationError) Key() bool { return e.key }
+func (e WithdrawAddressListReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UserInfo1Reply_List2ValidationError) ErrorName() string {
-	return "UserInfo1Reply_List2ValidationError"
+func (e WithdrawAddressListReply_ListValidationError) ErrorName() string {
+	return "WithdrawAddressListReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UserInfo1Reply_List2ValidationError) Error() string {
+func (e WithdrawAddressListReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9400,14 +18658,14 @@ func (e UserInfo1Reply_List2ValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUserInfo1Reply_List2.%s: %s%s",
+		"invalid %sWithdrawAddressListReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UserInfo1Reply_List2ValidationError{}
+var _ error = WithdrawAddressListReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -9415,48 +18673,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UserInfo1Reply_List2ValidationError{}
+} = WithdrawAddressListReply_ListValidationError{}
 
-// Validate checks the field values on UserInfo1Reply_List5 with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UserInfo1Reply_List5) Validate() error {
+// Validate checks the field values on WithdrawAddressCreateRequest_SendBody
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *WithdrawAddressCreateRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UserInfo1Reply_List5 with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// UserInfo1Reply_List5MultiError, or nil if none found.
-func (m *UserInfo1Reply_List5) ValidateAll() error {
+// ValidateAll checks the field values on WithdrawAddressCreateRequest_SendBody
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// WithdrawAddressCreateRequest_SendBodyMultiError, or nil if none found.
+func (m *WithdrawAddressCreateRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UserInfo1Reply_List5) validate(all bool) error {
+func (m *WithdrawAddressCreateRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for CreatedAt
+	// no validation rules for Label
 
-	// no validation rules for Amount
+	// no validation rules for Address
+
+	// no validation rules for Sign
 
 	if len(errors) > 0 {
-		return UserInfo1Reply_List5MultiError(errors)
+		return WithdrawAddressCreateRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// UserInfo1Reply_List5MultiError is an error wrapping multiple validation
-// errors returned by UserInfo1Reply_List5.ValidateAll() if the designated
+// WithdrawAddressCreateRequest_SendBodyMultiError is an error wrapping
+// multiple validation errors returned by
+// WithdrawAddressCreateRequest_SendBody.ValidateAll() if the designated
 // constraints aren't met.
-type UserInfo1Reply_List5MultiError []error
+type WithdrawAddressCreateRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UserInfo1Reply_List5MultiError) Error() string {
+func (m WithdrawAddressCreateRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9465,11 +18727,12 @@ func (m UserInfo1Reply_List5MultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UserInfo1Reply_List5MultiError) AllErrors() []error { return m }
+func (m WithdrawAddressCreateRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// UserInfo1Reply_List5ValidationError is the validation error returned by
-// UserInfo1Reply_List5.Validate if the designated constraints aren't met.
-type UserInfo1Reply_List5ValidationError struct {
+// WithdrawAddressCreateRequest_SendBodyValidationError is the validation error
+// returned by WithdrawAddressCreateRequest_SendBody.Validate if the
+// designated constraints aren't met.
+type WithdrawAddressCreateRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9477,24 +18740,24 @@ type UserInfo1Reply_List5ValidationError struct {
 }
 
 // Field function returns field value.
-func (e UserInfo1Reply_List5ValidationError) Field() string { return e.field }
+func (e WithdrawAddressCreateRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UserInfo1Reply_List5ValidationError) Reason() string { return e.reason }
+func (e WithdrawAddressCreateRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UserInfo1Reply_List5ValidationError) Cause() error { return e.cause }
+func (e WithdrawAddressCreateRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UserInfo1Reply_List5ValidationError) Key() bool { return e.key }
+func (e WithdrawAddressCreateRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UserInfo1Reply_List5ValidationError) ErrorName() string {
-	return "UserInfo1Reply_List5ValidationError"
+func (e WithdrawAddressCreateRequest_SendBodyValidationError) ErrorName() string {
+	return "WithdrawAddressCreateRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UserInfo1Reply_List5ValidationError) Error() string {
+func (e WithdrawAddressCreateRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9506,14 +18769,14 @@ func (e UserInfo1Reply_List5ValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUserInfo1Reply_List5.%s: %s%s",
+		"invalid %sWithdrawAddressCreateRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UserInfo1Reply_List5ValidationError{}
+var _ error = WithdrawAddressCreateRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -9521,52 +18784,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UserInfo1Reply_List5ValidationError{}
+} = WithdrawAddressCreateRequest_SendBodyValidationError{}
 
-// Validate checks the field values on UserInfo1Reply_List7 with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UserInfo1Reply_List7) Validate() error {
+// Validate checks the field values on WithdrawAddressDeleteRequest_SendBody
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *WithdrawAddressDeleteRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UserInfo1Reply_List7 with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// UserInfo1Reply_List7MultiError, or nil if none found.
-func (m *UserInfo1Reply_List7) ValidateAll() error {
+// ValidateAll checks the field values on WithdrawAddressDeleteRequest_SendBody
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// WithdrawAddressDeleteRequest_SendBodyMultiError, or nil if none found.
+func (m *WithdrawAddressDeleteRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UserInfo1Reply_List7) validate(all bool) error {
+func (m *WithdrawAddressDeleteRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Address
-
-	// no validation rules for Amount
-
-	// no validation rules for Usdt
+	// no validation rules for Id
 
-	// no validation rules for Vip
+	// no validation rules for Sign
 
 	if len(errors) > 0 {
-		return UserInfo1Reply_List7MultiError(errors)
+		return WithdrawAddressDeleteRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// UserInfo1Reply_List7MultiError is an error wrapping multiple validation
-// errors returned by UserInfo1Reply_List7.ValidateAll() if the designated
+// WithdrawAddressDeleteRequest_SendBodyMultiError is an error wrapping
+// multiple validation errors returned by
+// WithdrawAddressDeleteRequest_SendBody.ValidateAll() if the designated
 // constraints aren't met.
-type UserInfo1Reply_List7MultiError []error
+type WithdrawAddressDeleteRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UserInfo1Reply_List7MultiError) Error() string {
+func (m WithdrawAddressDeleteRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9575,11 +18836,12 @@ func (m UserInfo1Reply_List7MultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UserInfo1Reply_List7MultiError) AllErrors() []error { return m }
+func (m WithdrawAddressDeleteRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// UserInfo1Reply_List7ValidationError is the validation error returned by
-// UserInfo1Reply_List7.Validate if the designated constraints aren't met.
-type UserInfo1Reply_List7ValidationError struct {
+// WithdrawAddressDeleteRequest_SendBodyValidationError is the validation error
+// returned by WithdrawAddressDeleteRequest_SendBody.Validate if the
+// designated constraints aren't met.
+type WithdrawAddressDeleteRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9587,24 +18849,24 @@ type UserInfo1Reply_List7ValidationError struct {
 }
 
 // Field function returns field value.
-func (e UserInfo1Reply_List7ValidationError) Field() string { return e.field }
+func (e WithdrawAddressDeleteRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UserInfo1Reply_List7ValidationError) Reason() string { return e.reason }
+func (e WithdrawAddressDeleteRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UserInfo1Reply_List7ValidationError) Cause() error { return e.cause }
+func (e WithdrawAddressDeleteRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UserInfo1Reply_List7ValidationError) Key() bool { return e.key }
+func (e WithdrawAddressDeleteRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UserInfo1Reply_List7ValidationError) ErrorName() string {
-	return "UserInfo1Reply_List7ValidationError"
+func (e WithdrawAddressDeleteRequest_SendBodyValidationError) ErrorName() string {
+	return "WithdrawAddressDeleteRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UserInfo1Reply_List7ValidationError) Error() string {
+func (e WithdrawAddressDeleteRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9616,14 +18878,14 @@ func (e UserInfo1Reply_List7ValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUserInfo1Reply_List7.%s: %s%s",
+		"invalid %sWithdrawAddressDeleteRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UserInfo1Reply_List7ValidationError{}
+var _ error = WithdrawAddressDeleteRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -9631,52 +18893,60 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UserInfo1Reply_List7ValidationError{}
+} = WithdrawAddressDeleteRequest_SendBodyValidationError{}
 
-// Validate checks the field values on UserInfo1Reply_List11 with the rules
+// Validate checks the field values on InviteCodeListReply_List with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UserInfo1Reply_List11) Validate() error {
+func (m *InviteCodeListReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UserInfo1Reply_List11 with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on InviteCodeListReply_List with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UserInfo1Reply_List11MultiError, or nil if none found.
-func (m *UserInfo1Reply_List11) ValidateAll() error {
+// InviteCodeListReply_ListMultiError, or nil if none found.
+func (m *InviteCodeListReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UserInfo1Reply_List11) validate(all bool) error {
+func (m *InviteCodeListReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Address
+	// no validation rules for Code
 
-	// no validation rules for Amount
+	// no validation rules for Name
 
-	// no validation rules for Usdt
+	// no validation rules for Status
 
-	// no validation rules for Vip
+	// no validation rules for UsedNum
+
+	// no validation rules for MaxUse
+
+	// no validation rules for ExpiredAt
+
+	// no validation rules for LastUsedAt
+
+	// no validation rules for CreatedAt
 
 	if len(errors) > 0 {
-		return UserInfo1Reply_List11MultiError(errors)
+		return InviteCodeListReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// UserInfo1Reply_List11MultiError is an error wrapping multiple validation
-// errors returned by UserInfo1Reply_List11.ValidateAll() if the designated
+// InviteCodeListReply_ListMultiError is an error wrapping multiple validation
+// errors returned by InviteCodeListReply_List.ValidateAll() if the designated
 // constraints aren't met.
-type UserInfo1Reply_List11MultiError []error
+type InviteCodeListReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UserInfo1Reply_List11MultiError) Error() string {
+func (m InviteCodeListReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9685,11 +18955,11 @@ func (m UserInfo1Reply_List11MultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UserInfo1Reply_List11MultiError) AllErrors() []error { return m }
+func (m InviteCodeListReply_ListMultiError) AllErrors() []error { return m }
 
-// UserInfo1Reply_List11ValidationError is the validation error returned by
-// UserInfo1Reply_List11.Validate if the designated constraints aren't met.
-type UserInfo1Reply_List11ValidationError struct {
+// InviteCodeListReply_ListValidationError is the validation error returned by
+// InviteCodeListReply_List.Validate if the designated constraints aren't met.
+type InviteCodeListReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9697,24 +18967,24 @@ type UserInfo1Reply_List11ValidationError struct {
 }
 
 // Field function returns field value.
-func (e UserInfo1Reply_List11ValidationError) Field() string { return e.field }
+func (e InviteCodeListReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UserInfo1Reply_List11ValidationError) Reason() string { return e.reason }
+func (e InviteCodeListReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UserInfo1Reply_List11ValidationError) Cause() error { return e.cause }
+func (e InviteCodeListReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UserInfo1Reply_List11ValidationError) Key() bool { return e.key }
+func (e InviteCodeListReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UserInfo1Reply_List11ValidationError) ErrorName() string {
-	return "UserInfo1Reply_List11ValidationError"
+func (e InviteCodeListReply_ListValidationError) ErrorName() string {
+	return "InviteCodeListReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UserInfo1Reply_List11ValidationError) Error() string {
+func (e InviteCodeListReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9726,14 +18996,14 @@ func (e UserInfo1Reply_List11ValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUserInfo1Reply_List11.%s: %s%s",
+		"invalid %sInviteCodeListReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UserInfo1Reply_List11ValidationError{}
+var _ error = InviteCodeListReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -9741,48 +19011,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UserInfo1Reply_List11ValidationError{}
+} = InviteCodeListReply_ListValidationError{}
 
-// Validate checks the field values on UserInfo1Reply_List9 with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UserInfo1Reply_List9) Validate() error {
+// Validate checks the field values on InviteCodeCreateRequest_SendBody with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *InviteCodeCreateRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UserInfo1Reply_List9 with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// UserInfo1Reply_List9MultiError, or nil if none found.
-func (m *UserInfo1Reply_List9) ValidateAll() error {
+// ValidateAll checks the field values on InviteCodeCreateRequest_SendBody with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// InviteCodeCreateRequest_SendBodyMultiError, or nil if none found.
+func (m *InviteCodeCreateRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UserInfo1Reply_List9) validate(all bool) error {
+func (m *InviteCodeCreateRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for CreatedAt
+	// no validation rules for Name
 
-	// no validation rules for Amount
+	// no validation rules for MaxUse
+
+	// no validation rules for ExpireDays
 
 	if len(errors) > 0 {
-		return UserInfo1Reply_List9MultiError(errors)
+		return InviteCodeCreateRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// UserInfo1Reply_List9MultiError is an error wrapping multiple validation
-// errors returned by UserInfo1Reply_List9.ValidateAll() if the designated
+// InviteCodeCreateRequest_SendBodyMultiError is an error wrapping multiple
+// validation errors returned by
+// InviteCodeCreateRequest_SendBody.ValidateAll() if the designated
 // constraints aren't met.
-type UserInfo1Reply_List9MultiError []error
+type InviteCodeCreateRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UserInfo1Reply_List9MultiError) Error() string {
+func (m InviteCodeCreateRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9791,11 +19065,12 @@ func (m UserInfo1Reply_List9MultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UserInfo1Reply_List9MultiError) AllErrors() []error { return m }
+func (m InviteCodeCreateRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// UserInfo1Reply_List9ValidationError is the validation error returned by
-// UserInfo1Reply_List9.Validate if the designated constraints aren't met.
-type UserInfo1Reply_List9ValidationError struct {
+// InviteCodeCreateRequest_SendBodyValidationError is the validation error
+// returned by InviteCodeCreateRequest_SendBody.Validate if the designated
+// constraints aren't met.
+type InviteCodeCreateRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9803,24 +19078,24 @@ type UserInfo1Reply_List9ValidationError struct {
 }
 
 // Field function returns field value.
-func (e UserInfo1Reply_List9ValidationError) Field() string { return e.field }
+func (e InviteCodeCreateRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UserInfo1Reply_List9ValidationError) Reason() string { return e.reason }
+func (e InviteCodeCreateRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UserInfo1Reply_List9ValidationError) Cause() error { return e.cause }
+func (e InviteCodeCreateRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UserInfo1Reply_List9ValidationError) Key() bool { return e.key }
+func (e InviteCodeCreateRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UserInfo1Reply_List9ValidationError) ErrorName() string {
-	return "UserInfo1Reply_List9ValidationError"
+func (e InviteCodeCreateRequest_SendBodyValidationError) ErrorName() string {
+	return "InviteCodeCreateRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UserInfo1Reply_List9ValidationError) Error() string {
+func (e InviteCodeCreateRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9832,14 +19107,14 @@ func (e UserInfo1Reply_List9ValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUserInfo1Reply_List9.%s: %s%s",
+		"invalid %sInviteCodeCreateRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UserInfo1Reply_List9ValidationError{}
+var _ error = InviteCodeCreateRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -9847,48 +19122,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UserInfo1Reply_List9ValidationError{}
+} = InviteCodeCreateRequest_SendBodyValidationError{}
 
-// Validate checks the field values on UserInfo1Reply_List13 with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on LocaleChangeRequest_SendBody with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UserInfo1Reply_List13) Validate() error {
+func (m *LocaleChangeRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UserInfo1Reply_List13 with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on LocaleChangeRequest_SendBody with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UserInfo1Reply_List13MultiError, or nil if none found.
-func (m *UserInfo1Reply_List13) ValidateAll() error {
+// LocaleChangeRequest_SendBodyMultiError, or nil if none found.
+func (m *LocaleChangeRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UserInfo1Reply_List13) validate(all bool) error {
+func (m *LocaleChangeRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for CreatedAt
-
-	// no validation rules for Amount
+	// no validation rules for Locale
 
 	if len(errors) > 0 {
-		return UserInfo1Reply_List13MultiError(errors)
+		return LocaleChangeRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// UserInfo1Reply_List13MultiError is an error wrapping multiple validation
-// errors returned by UserInfo1Reply_List13.ValidateAll() if the designated
-// constraints aren't met.
-type UserInfo1Reply_List13MultiError []error
+// LocaleChangeRequest_SendBodyMultiError is an error wrapping multiple
+// validation errors returned by LocaleChangeRequest_SendBody.ValidateAll() if
+// the designated constraints aren't met.
+type LocaleChangeRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UserInfo1Reply_List13MultiError) Error() string {
+func (m LocaleChangeRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9897,11 +19170,12 @@ func (m UserInfo1Reply_List13MultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UserInfo1Reply_List13MultiError) AllErrors() []error { return m }
+func (m LocaleChangeRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// UserInfo1Reply_List13ValidationError is the validation error returned by
-// UserInfo1Reply_List13.Validate if the designated constraints aren't met.
-type UserInfo1Reply_List13ValidationError struct {
+// LocaleChangeRequest_SendBodyValidationError is the validation error returned
+// by LocaleChangeRequest_SendBody.Validate if the designated constraints
+// aren't met.
+type LocaleChangeRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9909,24 +19183,24 @@ type UserInfo1Reply_List13ValidationError struct {
 }
 
 // Field function returns field value.
-func (e UserInfo1Reply_List13ValidationError) Field() string { return e.field }
+func (e LocaleChangeRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UserInfo1Reply_List13ValidationError) Reason() string { return e.reason }
+func (e LocaleChangeRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UserInfo1Reply_List13ValidationError) Cause() error { return e.cause }
+func (e LocaleChangeRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UserInfo1Reply_List13ValidationError) Key() bool { return e.key }
+func (e LocaleChangeRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UserInfo1Reply_List13ValidationError) ErrorName() string {
-	return "UserInfo1Reply_List13ValidationError"
+func (e LocaleChangeRequest_SendBodyValidationError) ErrorName() string {
+	return "LocaleChangeRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UserInfo1Reply_List13ValidationError) Error() string {
+func (e LocaleChangeRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9938,14 +19212,14 @@ func (e UserInfo1Reply_List13ValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUserInfo1Reply_List13.%s: %s%s",
+		"invalid %sLocaleChangeRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UserInfo1Reply_List13ValidationError{}
+var _ error = LocaleChangeRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -9953,52 +19227,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UserInfo1Reply_List13ValidationError{}
+} = LocaleChangeRequest_SendBodyValidationError{}
 
-// Validate checks the field values on RewardListReply_List with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on PushTicketRequest_SendBody with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RewardListReply_List) Validate() error {
+func (m *PushTicketRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RewardListReply_List with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on PushTicketRequest_SendBody with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RewardListReply_ListMultiError, or nil if none found.
-func (m *RewardListReply_List) ValidateAll() error {
+// PushTicketRequest_SendBodyMultiError, or nil if none found.
+func (m *PushTicketRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RewardListReply_List) validate(all bool) error {
+func (m *PushTicketRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for CreatedAt
-
-	// no validation rules for Amount
-
-	// no validation rules for LocationStatus
-
-	// no validation rules for Type
-
 	if len(errors) > 0 {
-		return RewardListReply_ListMultiError(errors)
+		return PushTicketRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// RewardListReply_ListMultiError is an error wrapping multiple validation
-// errors returned by RewardListReply_List.ValidateAll() if the designated
-// constraints aren't met.
-type RewardListReply_ListMultiError []error
+// PushTicketRequest_SendBodyMultiError is an error wrapping multiple
+// validation errors returned by PushTicketRequest_SendBody.ValidateAll() if
+// the designated constraints aren't met.
+type PushTicketRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RewardListReply_ListMultiError) Error() string {
+func (m PushTicketRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10007,11 +19273,11 @@ func (m RewardListReply_ListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RewardListReply_ListMultiError) AllErrors() []error { return m }
+func (m PushTicketRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// RewardListReply_ListValidationError is the validation error returned by
-// RewardListReply_List.Validate if the designated constraints aren't met.
-type RewardListReply_ListValidationError struct {
+// PushTicketRequest_SendBodyValidationError is the validation error returned
+// by PushTicketRequest_SendBody.Validate if the designated constraints aren't met.
+type PushTicketRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10019,24 +19285,24 @@ type RewardListReply_ListValidationError struct {
 }
 
 // Field function returns field value.
-func (e RewardListReply_ListValidationError) Field() string { return e.field }
+func (e PushTicketRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RewardListReply_ListValidationError) Reason() string { return e.reason }
+func (e PushTicketRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RewardListReply_ListValidationError) Cause() error { return e.cause }
+func (e PushTicketRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RewardListReply_ListValidationError) Key() bool { return e.key }
+func (e PushTicketRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RewardListReply_ListValidationError) ErrorName() string {
-	return "RewardListReply_ListValidationError"
+func (e PushTicketRequest_SendBodyValidationError) ErrorName() string {
+	return "PushTicketRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RewardListReply_ListValidationError) Error() string {
+func (e PushTicketRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10048,14 +19314,14 @@ func (e RewardListReply_ListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRewardListReply_List.%s: %s%s",
+		"invalid %sPushTicketRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RewardListReply_ListValidationError{}
+var _ error = PushTicketRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -10063,46 +19329,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RewardListReply_ListValidationError{}
+} = PushTicketRequest_SendBodyValidationError{}
 
-// Validate checks the field values on GetTradeRequest_SendBody with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetTradeRequest_SendBody) Validate() error {
+// Validate checks the field values on AdminInviteCodeCreateRequest_SendBody
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *AdminInviteCodeCreateRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetTradeRequest_SendBody with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetTradeRequest_SendBodyMultiError, or nil if none found.
-func (m *GetTradeRequest_SendBody) ValidateAll() error {
+// ValidateAll checks the field values on AdminInviteCodeCreateRequest_SendBody
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// AdminInviteCodeCreateRequest_SendBodyMultiError, or nil if none found.
+func (m *AdminInviteCodeCreateRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetTradeRequest_SendBody) validate(all bool) error {
+func (m *AdminInviteCodeCreateRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Amount
+	// no validation rules for Name
+
+	// no validation rules for MaxUse
+
+	// no validation rules for ExpireDays
 
 	if len(errors) > 0 {
-		return GetTradeRequest_SendBodyMultiError(errors)
+		return AdminInviteCodeCreateRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetTradeRequest_SendBodyMultiError is an error wrapping multiple validation
-// errors returned by GetTradeRequest_SendBody.ValidateAll() if the designated
+// AdminInviteCodeCreateRequest_SendBodyMultiError is an error wrapping
+// multiple validation errors returned by
+// AdminInviteCodeCreateRequest_SendBody.ValidateAll() if the designated
 // constraints aren't met.
-type GetTradeRequest_SendBodyMultiError []error
+type AdminInviteCodeCreateRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetTradeRequest_SendBodyMultiError) Error() string {
+func (m AdminInviteCodeCreateRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10111,11 +19383,12 @@ func (m GetTradeRequest_SendBodyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetTradeRequest_SendBodyMultiError) AllErrors() []error { return m }
+func (m AdminInviteCodeCreateRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// GetTradeRequest_SendBodyValidationError is the validation error returned by
-// GetTradeRequest_SendBody.Validate if the designated constraints aren't met.
-type GetTradeRequest_SendBodyValidationError struct {
+// AdminInviteCodeCreateRequest_SendBodyValidationError is the validation error
+// returned by AdminInviteCodeCreateRequest_SendBody.Validate if the
+// designated constraints aren't met.
+type AdminInviteCodeCreateRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10123,24 +19396,24 @@ type GetTradeRequest_SendBodyValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetTradeRequest_SendBodyValidationError) Field() string { return e.field }
+func (e AdminInviteCodeCreateRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetTradeRequest_SendBodyValidationError) Reason() string { return e.reason }
+func (e AdminInviteCodeCreateRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetTradeRequest_SendBodyValidationError) Cause() error { return e.cause }
+func (e AdminInviteCodeCreateRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetTradeRequest_SendBodyValidationError) Key() bool { return e.key }
+func (e AdminInviteCodeCreateRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetTradeRequest_SendBodyValidationError) ErrorName() string {
-	return "GetTradeRequest_SendBodyValidationError"
+func (e AdminInviteCodeCreateRequest_SendBodyValidationError) ErrorName() string {
+	return "AdminInviteCodeCreateRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetTradeRequest_SendBodyValidationError) Error() string {
+func (e AdminInviteCodeCreateRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10152,14 +19425,14 @@ func (e GetTradeRequest_SendBodyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetTradeRequest_SendBody.%s: %s%s",
+		"invalid %sAdminInviteCodeCreateRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetTradeRequest_SendBodyValidationError{}
+var _ error = AdminInviteCodeCreateRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -10167,52 +19440,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetTradeRequest_SendBodyValidationError{}
+} = AdminInviteCodeCreateRequest_SendBodyValidationError{}
 
-// Validate checks the field values on RecommendRewardListReply_List with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RecommendRewardListReply_List) Validate() error {
+// Validate checks the field values on AdminPasswordUpdateRequest_SendBody with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *AdminPasswordUpdateRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RecommendRewardListReply_List with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// RecommendRewardListReply_ListMultiError, or nil if none found.
-func (m *RecommendRewardListReply_List) ValidateAll() error {
+// ValidateAll checks the field values on AdminPasswordUpdateRequest_SendBody
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// AdminPasswordUpdateRequest_SendBodyMultiError, or nil if none found.
+func (m *AdminPasswordUpdateRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RecommendRewardListReply_List) validate(all bool) error {
+func (m *AdminPasswordUpdateRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for CreatedAt
-
-	// no validation rules for Amount
-
-	// no validation rules for Type
+	// no validation rules for UserId
 
-	// no validation rules for Reason
+	// no validation rules for Password
 
 	if len(errors) > 0 {
-		return RecommendRewardListReply_ListMultiError(errors)
+		return AdminPasswordUpdateRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// RecommendRewardListReply_ListMultiError is an error wrapping multiple
-// validation errors returned by RecommendRewardListReply_List.ValidateAll()
-// if the designated constraints aren't met.
-type RecommendRewardListReply_ListMultiError []error
+// AdminPasswordUpdateRequest_SendBodyMultiError is an error wrapping multiple
+// validation errors returned by
+// AdminPasswordUpdateRequest_SendBody.ValidateAll() if the designated
+// constraints aren't met.
+type AdminPasswordUpdateRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RecommendRewardListReply_ListMultiError) Error() string {
+func (m AdminPasswordUpdateRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10221,12 +19492,12 @@ func (m RecommendRewardListReply_ListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RecommendRewardListReply_ListMultiError) AllErrors() []error { return m }
+func (m AdminPasswordUpdateRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// RecommendRewardListReply_ListValidationError is the validation error
-// returned by RecommendRewardListReply_List.Validate if the designated
+// AdminPasswordUpdateRequest_SendBodyValidationError is the validation error
+// returned by AdminPasswordUpdateRequest_SendBody.Validate if the designated
 // constraints aren't met.
-type RecommendRewardListReply_ListValidationError struct {
+type AdminPasswordUpdateRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10234,24 +19505,24 @@ type RecommendRewardListReply_ListValidationError struct {
 }
 
 // Field function returns field value.
-func (e RecommendRewardListReply_ListValidationError) Field() string { return e.field }
+func (e AdminPasswordUpdateRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RecommendRewardListReply_ListValidationError) Reason() string { return e.reason }
+func (e AdminPasswordUpdateRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RecommendRewardListReply_ListValidationError) Cause() error { return e.cause }
+func (e AdminPasswordUpdateRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RecommendRewardListReply_ListValidationError) Key() bool { return e.key }
+func (e AdminPasswordUpdateRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RecommendRewardListReply_ListValidationError) ErrorName() string {
-	return "RecommendRewardListReply_ListValidationError"
+func (e AdminPasswordUpdateRequest_SendBodyValidationError) ErrorName() string {
+	return "AdminPasswordUpdateRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RecommendRewardListReply_ListValidationError) Error() string {
+func (e AdminPasswordUpdateRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10263,14 +19534,14 @@ func (e RecommendRewardListReply_ListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRecommendRewardListReply_List.%s: %s%s",
+		"invalid %sAdminPasswordUpdateRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RecommendRewardListReply_ListValidationError{}
+var _ error = AdminPasswordUpdateRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -10278,48 +19549,58 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RecommendRewardListReply_ListValidationError{}
+} = AdminPasswordUpdateRequest_SendBodyValidationError{}
 
-// Validate checks the field values on FeeRewardListReply_List with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *FeeRewardListReply_List) Validate() error {
+// Validate checks the field values on AdminCommissionSimulateReply_List with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *AdminCommissionSimulateReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on FeeRewardListReply_List with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// FeeRewardListReply_ListMultiError, or nil if none found.
-func (m *FeeRewardListReply_List) ValidateAll() error {
+// ValidateAll checks the field values on AdminCommissionSimulateReply_List
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// AdminCommissionSimulateReply_ListMultiError, or nil if none found.
+func (m *AdminCommissionSimulateReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *FeeRewardListReply_List) validate(all bool) error {
+func (m *AdminCommissionSimulateReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for CreatedAt
+	// no validation rules for UserId
+
+	// no validation rules for Address
+
+	// no validation rules for Level
 
 	// no validation rules for Amount
 
+	// no validation rules for Reason
+
+	// no validation rules for Planned
+
 	if len(errors) > 0 {
-		return FeeRewardListReply_ListMultiError(errors)
+		return AdminCommissionSimulateReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// FeeRewardListReply_ListMultiError is an error wrapping multiple validation
-// errors returned by FeeRewardListReply_List.ValidateAll() if the designated
+// AdminCommissionSimulateReply_ListMultiError is an error wrapping multiple
+// validation errors returned by
+// AdminCommissionSimulateReply_List.ValidateAll() if the designated
 // constraints aren't met.
-type FeeRewardListReply_ListMultiError []error
+type AdminCommissionSimulateReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m FeeRewardListReply_ListMultiError) Error() string {
+func (m AdminCommissionSimulateReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10328,11 +19609,12 @@ func (m FeeRewardListReply_ListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m FeeRewardListReply_ListMultiError) AllErrors() []error { return m }
+func (m AdminCommissionSimulateReply_ListMultiError) AllErrors() []error { return m }
 
-// FeeRewardListReply_ListValidationError is the validation error returned by
-// FeeRewardListReply_List.Validate if the designated constraints aren't met.
-type FeeRewardListReply_ListValidationError struct {
+// AdminCommissionSimulateReply_ListValidationError is the validation error
+// returned by AdminCommissionSimulateReply_List.Validate if the designated
+// constraints aren't met.
+type AdminCommissionSimulateReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10340,24 +19622,24 @@ type FeeRewardListReply_ListValidationError struct {
 }
 
 // Field function returns field value.
-func (e FeeRewardListReply_ListValidationError) Field() string { return e.field }
+func (e AdminCommissionSimulateReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e FeeRewardListReply_ListValidationError) Reason() string { return e.reason }
+func (e AdminCommissionSimulateReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e FeeRewardListReply_ListValidationError) Cause() error { return e.cause }
+func (e AdminCommissionSimulateReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e FeeRewardListReply_ListValidationError) Key() bool { return e.key }
+func (e AdminCommissionSimulateReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e FeeRewardListReply_ListValidationError) ErrorName() string {
-	return "FeeRewardListReply_ListValidationError"
+func (e AdminCommissionSimulateReply_ListValidationError) ErrorName() string {
+	return "AdminCommissionSimulateReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e FeeRewardListReply_ListValidationError) Error() string {
+func (e AdminCommissionSimulateReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10369,14 +19651,14 @@ func (e FeeRewardListReply_ListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sFeeRewardListReply_List.%s: %s%s",
+		"invalid %sAdminCommissionSimulateReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = FeeRewardListReply_ListValidationError{}
+var _ error = AdminCommissionSimulateReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -10384,52 +19666,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = FeeRewardListReply_ListValidationError{}
+} = AdminCommissionSimulateReply_ListValidationError{}
 
-// Validate checks the field values on WithdrawListReply_List with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AdminAreaLevelRequest_SendBody with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *WithdrawListReply_List) Validate() error {
+func (m *AdminAreaLevelRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on WithdrawListReply_List with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// WithdrawListReply_ListMultiError, or nil if none found.
-func (m *WithdrawListReply_List) ValidateAll() error {
+// ValidateAll checks the field values on AdminAreaLevelRequest_SendBody with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminAreaLevelRequest_SendBodyMultiError, or nil if none found.
+func (m *AdminAreaLevelRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *WithdrawListReply_List) validate(all bool) error {
+func (m *AdminAreaLevelRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for CreatedAt
-
-	// no validation rules for Amount
-
-	// no validation rules for Type
-
-	// no validation rules for Status
+	// no validation rules for BusinessDate
 
 	if len(errors) > 0 {
-		return WithdrawListReply_ListMultiError(errors)
+		return AdminAreaLevelRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// WithdrawListReply_ListMultiError is an error wrapping multiple validation
-// errors returned by WithdrawListReply_List.ValidateAll() if the designated
-// constraints aren't met.
-type WithdrawListReply_ListMultiError []error
+// AdminAreaLevelRequest_SendBodyMultiError is an error wrapping multiple
+// validation errors returned by AdminAreaLevelRequest_SendBody.ValidateAll()
+// if the designated constraints aren't met.
+type AdminAreaLevelRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m WithdrawListReply_ListMultiError) Error() string {
+func (m AdminAreaLevelRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10438,11 +19714,12 @@ func (m WithdrawListReply_ListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m WithdrawListReply_ListMultiError) AllErrors() []error { return m }
+func (m AdminAreaLevelRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// WithdrawListReply_ListValidationError is the validation error returned by
-// WithdrawListReply_List.Validate if the designated constraints aren't met.
-type WithdrawListReply_ListValidationError struct {
+// AdminAreaLevelRequest_SendBodyValidationError is the validation error
+// returned by AdminAreaLevelRequest_SendBody.Validate if the designated
+// constraints aren't met.
+type AdminAreaLevelRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10450,24 +19727,24 @@ type WithdrawListReply_ListValidationError struct {
 }
 
 // Field function returns field value.
-func (e WithdrawListReply_ListValidationError) Field() string { return e.field }
+func (e AdminAreaLevelRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e WithdrawListReply_ListValidationError) Reason() string { return e.reason }
+func (e AdminAreaLevelRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e WithdrawListReply_ListValidationError) Cause() error { return e.cause }
+func (e AdminAreaLevelRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e WithdrawListReply_ListValidationError) Key() bool { return e.key }
+func (e AdminAreaLevelRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e WithdrawListReply_ListValidationError) ErrorName() string {
-	return "WithdrawListReply_ListValidationError"
+func (e AdminAreaLevelRequest_SendBodyValidationError) ErrorName() string {
+	return "AdminAreaLevelRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e WithdrawListReply_ListValidationError) Error() string {
+func (e AdminAreaLevelRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10479,14 +19756,14 @@ func (e WithdrawListReply_ListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sWithdrawListReply_List.%s: %s%s",
+		"invalid %sAdminAreaLevelRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = WithdrawListReply_ListValidationError{}
+var _ error = AdminAreaLevelRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -10494,52 +19771,56 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = WithdrawListReply_ListValidationError{}
+} = AdminAreaLevelRequest_SendBodyValidationError{}
 
-// Validate checks the field values on TradeListReply_List with the rules
+// Validate checks the field values on AdminAreaLevelReply_List with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TradeListReply_List) Validate() error {
+func (m *AdminAreaLevelReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TradeListReply_List with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdminAreaLevelReply_List with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TradeListReply_ListMultiError, or nil if none found.
-func (m *TradeListReply_List) ValidateAll() error {
+// AdminAreaLevelReply_ListMultiError, or nil if none found.
+func (m *AdminAreaLevelReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TradeListReply_List) validate(all bool) error {
+func (m *AdminAreaLevelReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for CreatedAt
+	// no validation rules for UserId
 
-	// no validation rules for AmountCsd
+	// no validation rules for Address
 
-	// no validation rules for AmountHbs
+	// no validation rules for OriginLevel
 
-	// no validation rules for Status
+	// no validation rules for Level
+
+	// no validation rules for BigAmount
+
+	// no validation rules for SmallAmount
 
 	if len(errors) > 0 {
-		return TradeListReply_ListMultiError(errors)
+		return AdminAreaLevelReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// TradeListReply_ListMultiError is an error wrapping multiple validation
-// errors returned by TradeListReply_List.ValidateAll() if the designated
+// AdminAreaLevelReply_ListMultiError is an error wrapping multiple validation
+// errors returned by AdminAreaLevelReply_List.ValidateAll() if the designated
 // constraints aren't met.
-type TradeListReply_ListMultiError []error
+type AdminAreaLevelReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TradeListReply_ListMultiError) Error() string {
+func (m AdminAreaLevelReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10548,11 +19829,11 @@ func (m TradeListReply_ListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TradeListReply_ListMultiError) AllErrors() []error { return m }
+func (m AdminAreaLevelReply_ListMultiError) AllErrors() []error { return m }
 
-// TradeListReply_ListValidationError is the validation error returned by
-// TradeListReply_List.Validate if the designated constraints aren't met.
-type TradeListReply_ListValidationError struct {
+// AdminAreaLevelReply_ListValidationError is the validation error returned by
+// AdminAreaLevelReply_List.Validate if the designated constraints aren't met.
+type AdminAreaLevelReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10560,24 +19841,24 @@ type TradeListReply_ListValidationError struct {
 }
 
 // Field function returns field value.
-func (e TradeListReply_ListValidationError) Field() string { return e.field }
+func (e AdminAreaLevelReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TradeListReply_ListValidationError) Reason() string { return e.reason }
+func (e AdminAreaLevelReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TradeListReply_ListValidationError) Cause() error { return e.cause }
+func (e AdminAreaLevelReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TradeListReply_ListValidationError) Key() bool { return e.key }
+func (e AdminAreaLevelReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TradeListReply_ListValidationError) ErrorName() string {
-	return "TradeListReply_ListValidationError"
+func (e AdminAreaLevelReply_ListValidationError) ErrorName() string {
+	return "AdminAreaLevelReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e TradeListReply_ListValidationError) Error() string {
+func (e AdminAreaLevelReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10589,14 +19870,14 @@ func (e TradeListReply_ListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTradeListReply_List.%s: %s%s",
+		"invalid %sAdminAreaLevelReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TradeListReply_ListValidationError{}
+var _ error = AdminAreaLevelReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -10604,48 +19885,60 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TradeListReply_ListValidationError{}
+} = AdminAreaLevelReply_ListValidationError{}
 
-// Validate checks the field values on TranListReply_List with the rules
+// Validate checks the field values on AdminJobRunListReply_List with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TranListReply_List) Validate() error {
+func (m *AdminJobRunListReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TranListReply_List with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AdminJobRunListReply_List with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TranListReply_ListMultiError, or nil if none found.
-func (m *TranListReply_List) ValidateAll() error {
+// AdminJobRunListReply_ListMultiError, or nil if none found.
+func (m *AdminJobRunListReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TranListReply_List) validate(all bool) error {
+func (m *AdminJobRunListReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for CreatedAt
+	// no validation rules for Id
 
-	// no validation rules for Amount
+	// no validation rules for Name
+
+	// no validation rules for BusinessDate
+
+	// no validation rules for Owner
+
+	// no validation rules for Status
+
+	// no validation rules for Message
+
+	// no validation rules for StartAt
+
+	// no validation rules for EndAt
 
 	if len(errors) > 0 {
-		return TranListReply_ListMultiError(errors)
+		return AdminJobRunListReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// TranListReply_ListMultiError is an error wrapping multiple validation errors
-// returned by TranListReply_List.ValidateAll() if the designated constraints
-// aren't met.
-type TranListReply_ListMultiError []error
+// AdminJobRunListReply_ListMultiError is an error wrapping multiple validation
+// errors returned by AdminJobRunListReply_List.ValidateAll() if the
+// designated constraints aren't met.
+type AdminJobRunListReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TranListReply_ListMultiError) Error() string {
+func (m AdminJobRunListReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10654,11 +19947,11 @@ func (m TranListReply_ListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TranListReply_ListMultiError) AllErrors() []error { return m }
+func (m AdminJobRunListReply_ListMultiError) AllErrors() []error { return m }
 
-// TranListReply_ListValidationError is the validation error returned by
-// TranListReply_List.Validate if the designated constraints aren't met.
-type TranListReply_ListValidationError struct {
+// AdminJobRunListReply_ListValidationError is the validation error returned by
+// AdminJobRunListReply_List.Validate if the designated constraints aren't met.
+type AdminJobRunListReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10666,24 +19959,24 @@ type TranListReply_ListValidationError struct {
 }
 
 // Field function returns field value.
-func (e TranListReply_ListValidationError) Field() string { return e.field }
+func (e AdminJobRunListReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TranListReply_ListValidationError) Reason() string { return e.reason }
+func (e AdminJobRunListReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TranListReply_ListValidationError) Cause() error { return e.cause }
+func (e AdminJobRunListReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TranListReply_ListValidationError) Key() bool { return e.key }
+func (e AdminJobRunListReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TranListReply_ListValidationError) ErrorName() string {
-	return "TranListReply_ListValidationError"
+func (e AdminJobRunListReply_ListValidationError) ErrorName() string {
+	return "AdminJobRunListReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e TranListReply_ListValidationError) Error() string {
+func (e AdminJobRunListReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10695,14 +19988,14 @@ func (e TranListReply_ListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTranListReply_List.%s: %s%s",
+		"invalid %sAdminJobRunListReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TranListReply_ListValidationError{}
+var _ error = AdminJobRunListReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -10710,48 +20003,58 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TranListReply_ListValidationError{}
+} = AdminJobRunListReply_ListValidationError{}
 
-// Validate checks the field values on RecommendListReply_List with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RecommendListReply_List) Validate() error {
+// Validate checks the field values on AdminLocationTransitionListReply_List
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *AdminLocationTransitionListReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RecommendListReply_List with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// RecommendListReply_ListMultiError, or nil if none found.
-func (m *RecommendListReply_List) ValidateAll() error {
+// ValidateAll checks the field values on AdminLocationTransitionListReply_List
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// AdminLocationTransitionListReply_ListMultiError, or nil if none found.
+func (m *AdminLocationTransitionListReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RecommendListReply_List) validate(all bool) error {
+func (m *AdminLocationTransitionListReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Amount
+	// no validation rules for LocationId
 
-	// no validation rules for Status
+	// no validation rules for UserId
+
+	// no validation rules for From
+
+	// no validation rules for To
+
+	// no validation rules for Reason
+
+	// no validation rules for CreatedAt
 
 	if len(errors) > 0 {
-		return RecommendListReply_ListMultiError(errors)
+		return AdminLocationTransitionListReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// RecommendListReply_ListMultiError is an error wrapping multiple validation
-// errors returned by RecommendListReply_List.ValidateAll() if the designated
+// AdminLocationTransitionListReply_ListMultiError is an error wrapping
+// multiple validation errors returned by
+// AdminLocationTransitionListReply_List.ValidateAll() if the designated
 // constraints aren't met.
-type RecommendListReply_ListMultiError []error
+type AdminLocationTransitionListReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RecommendListReply_ListMultiError) Error() string {
+func (m AdminLocationTransitionListReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10760,11 +20063,12 @@ func (m RecommendListReply_ListMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RecommendListReply_ListMultiError) AllErrors() []error { return m }
+func (m AdminLocationTransitionListReply_ListMultiError) AllErrors() []error { return m }
 
-// RecommendListReply_ListValidationError is the validation error returned by
-// RecommendListReply_List.Validate if the designated constraints aren't met.
-type RecommendListReply_ListValidationError struct {
+// AdminLocationTransitionListReply_ListValidationError is the validation error
+// returned by AdminLocationTransitionListReply_List.Validate if the
+// designated constraints aren't met.
+type AdminLocationTransitionListReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10772,24 +20076,24 @@ type RecommendListReply_ListValidationError struct {
 }
 
 // Field function returns field value.
-func (e RecommendListReply_ListValidationError) Field() string { return e.field }
+func (e AdminLocationTransitionListReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RecommendListReply_ListValidationError) Reason() string { return e.reason }
+func (e AdminLocationTransitionListReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RecommendListReply_ListValidationError) Cause() error { return e.cause }
+func (e AdminLocationTransitionListReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RecommendListReply_ListValidationError) Key() bool { return e.key }
+func (e AdminLocationTransitionListReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RecommendListReply_ListValidationError) ErrorName() string {
-	return "RecommendListReply_ListValidationError"
+func (e AdminLocationTransitionListReply_ListValidationError) ErrorName() string {
+	return "AdminLocationTransitionListReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RecommendListReply_ListValidationError) Error() string {
+func (e AdminLocationTransitionListReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10801,14 +20105,14 @@ func (e RecommendListReply_ListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRecommendListReply_List.%s: %s%s",
+		"invalid %sAdminLocationTransitionListReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RecommendListReply_ListValidationError{}
+var _ error = AdminLocationTransitionListReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -10816,48 +20120,56 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RecommendListReply_ListValidationError{}
+} = AdminLocationTransitionListReply_ListValidationError{}
 
-// Validate checks the field values on ExchangeRequest_SendBody with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AdminWebhookListReply_List with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ExchangeRequest_SendBody) Validate() error {
+func (m *AdminWebhookListReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ExchangeRequest_SendBody with the
+// ValidateAll checks the field values on AdminWebhookListReply_List with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ExchangeRequest_SendBodyMultiError, or nil if none found.
-func (m *ExchangeRequest_SendBody) ValidateAll() error {
+// AdminWebhookListReply_ListMultiError, or nil if none found.
+func (m *AdminWebhookListReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ExchangeRequest_SendBody) validate(all bool) error {
+func (m *AdminWebhookListReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Sign
+	// no validation rules for Id
 
-	// no validation rules for Amount
+	// no validation rules for Url
+
+	// no validation rules for Secret
+
+	// no validation rules for Events
+
+	// no validation rules for Status
+
+	// no validation rules for CreatedAt
 
 	if len(errors) > 0 {
-		return ExchangeRequest_SendBodyMultiError(errors)
+		return AdminWebhookListReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// ExchangeRequest_SendBodyMultiError is an error wrapping multiple validation
-// errors returned by ExchangeRequest_SendBody.ValidateAll() if the designated
-// constraints aren't met.
-type ExchangeRequest_SendBodyMultiError []error
+// AdminWebhookListReply_ListMultiError is an error wrapping multiple
+// validation errors returned by AdminWebhookListReply_List.ValidateAll() if
+// the designated constraints aren't met.
+type AdminWebhookListReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ExchangeRequest_SendBodyMultiError) Error() string {
+func (m AdminWebhookListReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10866,11 +20178,11 @@ func (m ExchangeRequest_SendBodyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ExchangeRequest_SendBodyMultiError) AllErrors() []error { return m }
+func (m AdminWebhookListReply_ListMultiError) AllErrors() []error { return m }
 
-// ExchangeRequest_SendBodyValidationError is the validation error returned by
-// ExchangeRequest_SendBody.Validate if the designated constraints aren't met.
-type ExchangeRequest_SendBodyValidationError struct {
+// AdminWebhookListReply_ListValidationError is the validation error returned
+// by AdminWebhookListReply_List.Validate if the designated constraints aren't met.
+type AdminWebhookListReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10878,24 +20190,24 @@ type ExchangeRequest_SendBodyValidationError struct {
 }
 
 // Field function returns field value.
-func (e ExchangeRequest_SendBodyValidationError) Field() string { return e.field }
+func (e AdminWebhookListReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ExchangeRequest_SendBodyValidationError) Reason() string { return e.reason }
+func (e AdminWebhookListReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ExchangeRequest_SendBodyValidationError) Cause() error { return e.cause }
+func (e AdminWebhookListReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ExchangeRequest_SendBodyValidationError) Key() bool { return e.key }
+func (e AdminWebhookListReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ExchangeRequest_SendBodyValidationError) ErrorName() string {
-	return "ExchangeRequest_SendBodyValidationError"
+func (e AdminWebhookListReply_ListValidationError) ErrorName() string {
+	return "AdminWebhookListReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ExchangeRequest_SendBodyValidationError) Error() string {
+func (e AdminWebhookListReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10907,14 +20219,14 @@ func (e ExchangeRequest_SendBodyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sExchangeRequest_SendBody.%s: %s%s",
+		"invalid %sAdminWebhookListReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ExchangeRequest_SendBodyValidationError{}
+var _ error = AdminWebhookListReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -10922,52 +20234,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ExchangeRequest_SendBodyValidationError{}
+} = AdminWebhookListReply_ListValidationError{}
 
-// Validate checks the field values on WithdrawRequest_SendBody with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *WithdrawRequest_SendBody) Validate() error {
+// Validate checks the field values on AdminWebhookCreateRequest_SendBody with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *AdminWebhookCreateRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on WithdrawRequest_SendBody with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// WithdrawRequest_SendBodyMultiError, or nil if none found.
-func (m *WithdrawRequest_SendBody) ValidateAll() error {
+// ValidateAll checks the field values on AdminWebhookCreateRequest_SendBody
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// AdminWebhookCreateRequest_SendBodyMultiError, or nil if none found.
+func (m *AdminWebhookCreateRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *WithdrawRequest_SendBody) validate(all bool) error {
+func (m *AdminWebhookCreateRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Type
-
-	// no validation rules for Amount
+	// no validation rules for Url
 
-	// no validation rules for Password
+	// no validation rules for Secret
 
-	// no validation rules for Sign
+	// no validation rules for Events
 
 	if len(errors) > 0 {
-		return WithdrawRequest_SendBodyMultiError(errors)
+		return AdminWebhookCreateRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// WithdrawRequest_SendBodyMultiError is an error wrapping multiple validation
-// errors returned by WithdrawRequest_SendBody.ValidateAll() if the designated
+// AdminWebhookCreateRequest_SendBodyMultiError is an error wrapping multiple
+// validation errors returned by
+// AdminWebhookCreateRequest_SendBody.ValidateAll() if the designated
 // constraints aren't met.
-type WithdrawRequest_SendBodyMultiError []error
+type AdminWebhookCreateRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m WithdrawRequest_SendBodyMultiError) Error() string {
+func (m AdminWebhookCreateRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10976,11 +20288,12 @@ func (m WithdrawRequest_SendBodyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m WithdrawRequest_SendBodyMultiError) AllErrors() []error { return m }
+func (m AdminWebhookCreateRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// WithdrawRequest_SendBodyValidationError is the validation error returned by
-// WithdrawRequest_SendBody.Validate if the designated constraints aren't met.
-type WithdrawRequest_SendBodyValidationError struct {
+// AdminWebhookCreateRequest_SendBodyValidationError is the validation error
+// returned by AdminWebhookCreateRequest_SendBody.Validate if the designated
+// constraints aren't met.
+type AdminWebhookCreateRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10988,24 +20301,24 @@ type WithdrawRequest_SendBodyValidationError struct {
 }
 
 // Field function returns field value.
-func (e WithdrawRequest_SendBodyValidationError) Field() string { return e.field }
+func (e AdminWebhookCreateRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e WithdrawRequest_SendBodyValidationError) Reason() string { return e.reason }
+func (e AdminWebhookCreateRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e WithdrawRequest_SendBodyValidationError) Cause() error { return e.cause }
+func (e AdminWebhookCreateRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e WithdrawRequest_SendBodyValidationError) Key() bool { return e.key }
+func (e AdminWebhookCreateRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e WithdrawRequest_SendBodyValidationError) ErrorName() string {
-	return "WithdrawRequest_SendBodyValidationError"
+func (e AdminWebhookCreateRequest_SendBodyValidationError) ErrorName() string {
+	return "AdminWebhookCreateRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e WithdrawRequest_SendBodyValidationError) Error() string {
+func (e AdminWebhookCreateRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11017,14 +20330,14 @@ func (e WithdrawRequest_SendBodyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sWithdrawRequest_SendBody.%s: %s%s",
+		"invalid %sAdminWebhookCreateRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = WithdrawRequest_SendBodyValidationError{}
+var _ error = AdminWebhookCreateRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -11032,46 +20345,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = WithdrawRequest_SendBodyValidationError{}
+} = AdminWebhookCreateRequest_SendBodyValidationError{}
 
-// Validate checks the field values on PasswordChangeRequest_SendBody with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *PasswordChangeRequest_SendBody) Validate() error {
+// Validate checks the field values on AdminWebhookUpdateRequest_SendBody with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *AdminWebhookUpdateRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PasswordChangeRequest_SendBody with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// PasswordChangeRequest_SendBodyMultiError, or nil if none found.
-func (m *PasswordChangeRequest_SendBody) ValidateAll() error {
+// ValidateAll checks the field values on AdminWebhookUpdateRequest_SendBody
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// AdminWebhookUpdateRequest_SendBodyMultiError, or nil if none found.
+func (m *AdminWebhookUpdateRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PasswordChangeRequest_SendBody) validate(all bool) error {
+func (m *AdminWebhookUpdateRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Password
+	// no validation rules for Id
+
+	// no validation rules for Events
+
+	// no validation rules for Status
 
 	if len(errors) > 0 {
-		return PasswordChangeRequest_SendBodyMultiError(errors)
+		return AdminWebhookUpdateRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// PasswordChangeRequest_SendBodyMultiError is an error wrapping multiple
-// validation errors returned by PasswordChangeRequest_SendBody.ValidateAll()
-// if the designated constraints aren't met.
-type PasswordChangeRequest_SendBodyMultiError []error
+// AdminWebhookUpdateRequest_SendBodyMultiError is an error wrapping multiple
+// validation errors returned by
+// AdminWebhookUpdateRequest_SendBody.ValidateAll() if the designated
+// constraints aren't met.
+type AdminWebhookUpdateRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PasswordChangeRequest_SendBodyMultiError) Error() string {
+func (m AdminWebhookUpdateRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11080,12 +20399,12 @@ func (m PasswordChangeRequest_SendBodyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PasswordChangeRequest_SendBodyMultiError) AllErrors() []error { return m }
+func (m AdminWebhookUpdateRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// PasswordChangeRequest_SendBodyValidationError is the validation error
-// returned by PasswordChangeRequest_SendBody.Validate if the designated
+// AdminWebhookUpdateRequest_SendBodyValidationError is the validation error
+// returned by AdminWebhookUpdateRequest_SendBody.Validate if the designated
 // constraints aren't met.
-type PasswordChangeRequest_SendBodyValidationError struct {
+type AdminWebhookUpdateRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11093,24 +20412,24 @@ type PasswordChangeRequest_SendBodyValidationError struct {
 }
 
 // Field function returns field value.
-func (e PasswordChangeRequest_SendBodyValidationError) Field() string { return e.field }
+func (e AdminWebhookUpdateRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PasswordChangeRequest_SendBodyValidationError) Reason() string { return e.reason }
+func (e AdminWebhookUpdateRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PasswordChangeRequest_SendBodyValidationError) Cause() error { return e.cause }
+func (e AdminWebhookUpdateRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PasswordChangeRequest_SendBodyValidationError) Key() bool { return e.key }
+func (e AdminWebhookUpdateRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PasswordChangeRequest_SendBodyValidationError) ErrorName() string {
-	return "PasswordChangeRequest_SendBodyValidationError"
+func (e AdminWebhookUpdateRequest_SendBodyValidationError) ErrorName() string {
+	return "AdminWebhookUpdateRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PasswordChangeRequest_SendBodyValidationError) Error() string {
+func (e AdminWebhookUpdateRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11122,14 +20441,14 @@ func (e PasswordChangeRequest_SendBodyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPasswordChangeRequest_SendBody.%s: %s%s",
+		"invalid %sAdminWebhookUpdateRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PasswordChangeRequest_SendBodyValidationError{}
+var _ error = AdminWebhookUpdateRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -11137,52 +20456,68 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PasswordChangeRequest_SendBodyValidationError{}
+} = AdminWebhookUpdateRequest_SendBodyValidationError{}
 
-// Validate checks the field values on TranRequest_SendBody with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TranRequest_SendBody) Validate() error {
+// Validate checks the field values on AdminWebhookDeliveryListReply_List with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *AdminWebhookDeliveryListReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TranRequest_SendBody with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// TranRequest_SendBodyMultiError, or nil if none found.
-func (m *TranRequest_SendBody) ValidateAll() error {
+// ValidateAll checks the field values on AdminWebhookDeliveryListReply_List
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// AdminWebhookDeliveryListReply_ListMultiError, or nil if none found.
+func (m *AdminWebhookDeliveryListReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TranRequest_SendBody) validate(all bool) error {
+func (m *AdminWebhookDeliveryListReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Type
+	// no validation rules for Id
 
-	// no validation rules for Amount
+	// no validation rules for EventId
 
-	// no validation rules for Address
+	// no validation rules for Event
 
-	// no validation rules for Password
+	// no validation rules for Payload
+
+	// no validation rules for SubscriberId
+
+	// no validation rules for Status
+
+	// no validation rules for Attempts
+
+	// no validation rules for ResponseCode
+
+	// no validation rules for LastError
+
+	// no validation rules for NextAt
+
+	// no validation rules for CreatedAt
 
 	if len(errors) > 0 {
-		return TranRequest_SendBodyMultiError(errors)
+		return AdminWebhookDeliveryListReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// TranRequest_SendBodyMultiError is an error wrapping multiple validation
-// errors returned by TranRequest_SendBody.ValidateAll() if the designated
+// AdminWebhookDeliveryListReply_ListMultiError is an error wrapping multiple
+// validation errors returned by
+// AdminWebhookDeliveryListReply_List.ValidateAll() if the designated
 // constraints aren't met.
-type TranRequest_SendBodyMultiError []error
+type AdminWebhookDeliveryListReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TranRequest_SendBodyMultiError) Error() string {
+func (m AdminWebhookDeliveryListReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11191,11 +20526,12 @@ func (m TranRequest_SendBodyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TranRequest_SendBodyMultiError) AllErrors() []error { return m }
+func (m AdminWebhookDeliveryListReply_ListMultiError) AllErrors() []error { return m }
 
-// TranRequest_SendBodyValidationError is the validation error returned by
-// TranRequest_SendBody.Validate if the designated constraints aren't met.
-type TranRequest_SendBodyValidationError struct {
+// AdminWebhookDeliveryListReply_ListValidationError is the validation error
+// returned by AdminWebhookDeliveryListReply_List.Validate if the designated
+// constraints aren't met.
+type AdminWebhookDeliveryListReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11203,24 +20539,24 @@ type TranRequest_SendBodyValidationError struct {
 }
 
 // Field function returns field value.
-func (e TranRequest_SendBodyValidationError) Field() string { return e.field }
+func (e AdminWebhookDeliveryListReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TranRequest_SendBodyValidationError) Reason() string { return e.reason }
+func (e AdminWebhookDeliveryListReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TranRequest_SendBodyValidationError) Cause() error { return e.cause }
+func (e AdminWebhookDeliveryListReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TranRequest_SendBodyValidationError) Key() bool { return e.key }
+func (e AdminWebhookDeliveryListReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TranRequest_SendBodyValidationError) ErrorName() string {
-	return "TranRequest_SendBodyValidationError"
+func (e AdminWebhookDeliveryListReply_ListValidationError) ErrorName() string {
+	return "AdminWebhookDeliveryListReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e TranRequest_SendBodyValidationError) Error() string {
+func (e AdminWebhookDeliveryListReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11232,14 +20568,14 @@ func (e TranRequest_SendBodyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTranRequest_SendBody.%s: %s%s",
+		"invalid %sAdminWebhookDeliveryListReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TranRequest_SendBodyValidationError{}
+var _ error = AdminWebhookDeliveryListReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -11247,48 +20583,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TranRequest_SendBodyValidationError{}
+} = AdminWebhookDeliveryListReply_ListValidationError{}
 
-// Validate checks the field values on SetBalanceRewardRequest_SendBody with
+// Validate checks the field values on AdminWebhookReplayRequest_SendBody with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the first error encountered is returned, or nil if there are
 // no violations.
-func (m *SetBalanceRewardRequest_SendBody) Validate() error {
+func (m *AdminWebhookReplayRequest_SendBody) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SetBalanceRewardRequest_SendBody with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// SetBalanceRewardRequest_SendBodyMultiError, or nil if none found.
-func (m *SetBalanceRewardRequest_SendBody) ValidateAll() error {
+// ValidateAll checks the field values on AdminWebhookReplayRequest_SendBody
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// AdminWebhookReplayRequest_SendBodyMultiError, or nil if none found.
+func (m *AdminWebhookReplayRequest_SendBody) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SetBalanceRewardRequest_SendBody) validate(all bool) error {
+func (m *AdminWebhookReplayRequest_SendBody) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Amount
+	// no validation rules for DeliveryId
+
+	// no validation rules for EventId
 
 	if len(errors) > 0 {
-		return SetBalanceRewardRequest_SendBodyMultiError(errors)
+		return AdminWebhookReplayRequest_SendBodyMultiError(errors)
 	}
 
 	return nil
 }
 
-// SetBalanceRewardRequest_SendBodyMultiError is an error wrapping multiple
+// AdminWebhookReplayRequest_SendBodyMultiError is an error wrapping multiple
 // validation errors returned by
-// SetBalanceRewardRequest_SendBody.ValidateAll() if the designated
+// AdminWebhookReplayRequest_SendBody.ValidateAll() if the designated
 // constraints aren't met.
-type SetBalanceRewardRequest_SendBodyMultiError []error
+type AdminWebhookReplayRequest_SendBodyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SetBalanceRewardRequest_SendBodyMultiError) Error() string {
+func (m AdminWebhookReplayRequest_SendBodyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11297,12 +20635,12 @@ func (m SetBalanceRewardRequest_SendBodyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SetBalanceRewardRequest_SendBodyMultiError) AllErrors() []error { return m }
+func (m AdminWebhookReplayRequest_SendBodyMultiError) AllErrors() []error { return m }
 
-// SetBalanceRewardRequest_SendBodyValidationError is the validation error
-// returned by SetBalanceRewardRequest_SendBody.Validate if the designated
+// AdminWebhookReplayRequest_SendBodyValidationError is the validation error
+// returned by AdminWebhookReplayRequest_SendBody.Validate if the designated
 // constraints aren't met.
-type SetBalanceRewardRequest_SendBodyValidationError struct {
+type AdminWebhookReplayRequest_SendBodyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11310,24 +20648,24 @@ type SetBalanceRewardRequest_SendBodyValidationError struct {
 }
 
 // Field function returns field value.
-func (e SetBalanceRewardRequest_SendBodyValidationError) Field() string { return e.field }
+func (e AdminWebhookReplayRequest_SendBodyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SetBalanceRewardRequest_SendBodyValidationError) Reason() string { return e.reason }
+func (e AdminWebhookReplayRequest_SendBodyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SetBalanceRewardRequest_SendBodyValidationError) Cause() error { return e.cause }
+func (e AdminWebhookReplayRequest_SendBodyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SetBalanceRewardRequest_SendBodyValidationError) Key() bool { return e.key }
+func (e AdminWebhookReplayRequest_SendBodyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SetBalanceRewardRequest_SendBodyValidationError) ErrorName() string {
-	return "SetBalanceRewardRequest_SendBodyValidationError"
+func (e AdminWebhookReplayRequest_SendBodyValidationError) ErrorName() string {
+	return "AdminWebhookReplayRequest_SendBodyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SetBalanceRewardRequest_SendBodyValidationError) Error() string {
+func (e AdminWebhookReplayRequest_SendBodyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11339,14 +20677,14 @@ func (e SetBalanceRewardRequest_SendBodyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSetBalanceRewardRequest_SendBody.%s: %s%s",
+		"invalid %sAdminWebhookReplayRequest_SendBody.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SetBalanceRewardRequest_SendBodyValidationError{}
+var _ error = AdminWebhookReplayRequest_SendBodyValidationError{}
 
 var _ interface {
 	Field() string
@@ -11354,48 +20692,54 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SetBalanceRewardRequest_SendBodyValidationError{}
+} = AdminWebhookReplayRequest_SendBodyValidationError{}
 
-// Validate checks the field values on DeleteBalanceRewardRequest_SendBody with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *DeleteBalanceRewardRequest_SendBody) Validate() error {
+// Validate checks the field values on AdminCacheStatsReply_List with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminCacheStatsReply_List) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteBalanceRewardRequest_SendBody
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// DeleteBalanceRewardRequest_SendBodyMultiError, or nil if none found.
-func (m *DeleteBalanceRewardRequest_SendBody) ValidateAll() error {
+// ValidateAll checks the field values on AdminCacheStatsReply_List with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminCacheStatsReply_ListMultiError, or nil if none found.
+func (m *AdminCacheStatsReply_List) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteBalanceRewardRequest_SendBody) validate(all bool) error {
+func (m *AdminCacheStatsReply_List) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Amount
+	// no validation rules for Name
+
+	// no validation rules for Hits
+
+	// no validation rules for Misses
+
+	// no validation rules for Errors
+
+	// no validation rules for HitRatio
 
 	if len(errors) > 0 {
-		return DeleteBalanceRewardRequest_SendBodyMultiError(errors)
+		return AdminCacheStatsReply_ListMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteBalanceRewardRequest_SendBodyMultiError is an error wrapping multiple
-// validation errors returned by
-// DeleteBalanceRewardRequest_SendBody.ValidateAll() if the designated
-// constraints aren't met.
-type DeleteBalanceRewardRequest_SendBodyMultiError []error
+// AdminCacheStatsReply_ListMultiError is an error wrapping multiple validation
+// errors returned by AdminCacheStatsReply_List.ValidateAll() if the
+// designated constraints aren't met.
+type AdminCacheStatsReply_ListMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteBalanceRewardRequest_SendBodyMultiError) Error() string {
+func (m AdminCacheStatsReply_ListMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11404,12 +20748,11 @@ func (m DeleteBalanceRewardRequest_SendBodyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteBalanceRewardRequest_SendBodyMultiError) AllErrors() []error { return m }
+func (m AdminCacheStatsReply_ListMultiError) AllErrors() []error { return m }
 
-// DeleteBalanceRewardRequest_SendBodyValidationError is the validation error
-// returned by DeleteBalanceRewardRequest_SendBody.Validate if the designated
-// constraints aren't met.
-type DeleteBalanceRewardRequest_SendBodyValidationError struct {
+// AdminCacheStatsReply_ListValidationError is the validation error returned by
+// AdminCacheStatsReply_List.Validate if the designated constraints aren't met.
+type AdminCacheStatsReply_ListValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11417,24 +20760,24 @@ type DeleteBalanceRewardRequest_SendBodyValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteBalanceRewardRequest_SendBodyValidationError) Field() string { return e.field }
+func (e AdminCacheStatsReply_ListValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteBalanceRewardRequest_SendBodyValidationError) Reason() string { return e.reason }
+func (e AdminCacheStatsReply_ListValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteBalanceRewardRequest_SendBodyValidationError) Cause() error { return e.cause }
+func (e AdminCacheStatsReply_ListValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteBalanceRewardRequest_SendBodyValidationError) Key() bool { return e.key }
+func (e AdminCacheStatsReply_ListValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteBalanceRewardRequest_SendBodyValidationError) ErrorName() string {
-	return "DeleteBalanceRewardRequest_SendBodyValidationError"
+func (e AdminCacheStatsReply_ListValidationError) ErrorName() string {
+	return "AdminCacheStatsReply_ListValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteBalanceRewardRequest_SendBodyValidationError) Error() string {
+func (e AdminCacheStatsReply_ListValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11446,14 +20789,14 @@ func (e DeleteBalanceRewardRequest_SendBodyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteBalanceRewardRequest_SendBody.%s: %s%s",
+		"invalid %sAdminCacheStatsReply_List.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteBalanceRewardRequest_SendBodyValidationError{}
+var _ error = AdminCacheStatsReply_ListValidationError{}
 
 var _ interface {
 	Field() string
@@ -11461,7 +20804,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteBalanceRewardRequest_SendBodyValidationError{}
+} = AdminCacheStatsReply_ListValidationError{}
 
 // Validate checks the field values on AdminRewardListReply_List with the rules
 // defined in the proto definition for this message. If any rules are
@@ -11932,6 +21275,113 @@ var _ interface {
 	ErrorName() string
 } = AdminWithdrawListReply_ListValidationError{}
 
+// Validate checks the field values on AdminWithdrawRequest_SendBody with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminWithdrawRequest_SendBody) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminWithdrawRequest_SendBody with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AdminWithdrawRequest_SendBodyMultiError, or nil if none found.
+func (m *AdminWithdrawRequest_SendBody) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminWithdrawRequest_SendBody) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return AdminWithdrawRequest_SendBodyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminWithdrawRequest_SendBodyMultiError is an error wrapping multiple
+// validation errors returned by AdminWithdrawRequest_SendBody.ValidateAll()
+// if the designated constraints aren't met.
+type AdminWithdrawRequest_SendBodyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminWithdrawRequest_SendBodyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminWithdrawRequest_SendBodyMultiError) AllErrors() []error { return m }
+
+// AdminWithdrawRequest_SendBodyValidationError is the validation error
+// returned by AdminWithdrawRequest_SendBody.Validate if the designated
+// constraints aren't met.
+type AdminWithdrawRequest_SendBodyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminWithdrawRequest_SendBodyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminWithdrawRequest_SendBodyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminWithdrawRequest_SendBodyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminWithdrawRequest_SendBodyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminWithdrawRequest_SendBodyValidationError) ErrorName() string {
+	return "AdminWithdrawRequest_SendBodyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminWithdrawRequest_SendBodyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminWithdrawRequest_SendBody.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminWithdrawRequest_SendBodyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminWithdrawRequest_SendBodyValidationError{}
+
 // Validate checks the field values on AdminUserRecommendReply_List with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -19,30 +19,57 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	App_EthAuthorize_FullMethodName        = "/api.App/EthAuthorize"
-	App_RecommendUpdate_FullMethodName     = "/api.App/RecommendUpdate"
-	App_UserInfo_FullMethodName            = "/api.App/UserInfo"
-	App_UserArea_FullMethodName            = "/api.App/UserArea"
-	App_RewardList_FullMethodName          = "/api.App/RewardList"
-	App_RecommendRewardList_FullMethodName = "/api.App/RecommendRewardList"
-	App_FeeRewardList_FullMethodName       = "/api.App/FeeRewardList"
-	App_WithdrawList_FullMethodName        = "/api.App/WithdrawList"
-	App_TradeList_FullMethodName           = "/api.App/TradeList"
-	App_TranList_FullMethodName            = "/api.App/TranList"
-	App_RecommendList_FullMethodName       = "/api.App/RecommendList"
-	App_PasswordChange_FullMethodName      = "/api.App/PasswordChange"
-	App_Withdraw_FullMethodName            = "/api.App/Withdraw"
-	App_Exchange_FullMethodName            = "/api.App/Exchange"
-	App_Trade_FullMethodName               = "/api.App/Trade"
-	App_Tran_FullMethodName                = "/api.App/Tran"
-	App_GetTrade_FullMethodName            = "/api.App/GetTrade"
-	App_SetBalanceReward_FullMethodName    = "/api.App/SetBalanceReward"
-	App_DeleteBalanceReward_FullMethodName = "/api.App/DeleteBalanceReward"
-	App_Deposit_FullMethodName             = "/api.App/Deposit"
-	App_AdminWithdraw_FullMethodName       = "/api.App/AdminWithdraw"
-	App_AdminWithdrawEth_FullMethodName    = "/api.App/AdminWithdrawEth"
-	App_AdminFee_FullMethodName            = "/api.App/AdminFee"
-	App_TokenWithdraw_FullMethodName       = "/api.App/TokenWithdraw"
+	App_EthAuthorize_FullMethodName                = "/api.App/EthAuthorize"
+	App_RecommendUpdate_FullMethodName             = "/api.App/RecommendUpdate"
+	App_UserInfo_FullMethodName                    = "/api.App/UserInfo"
+	App_UserArea_FullMethodName                    = "/api.App/UserArea"
+	App_RewardList_FullMethodName                  = "/api.App/RewardList"
+	App_RecommendRewardList_FullMethodName         = "/api.App/RecommendRewardList"
+	App_FeeRewardList_FullMethodName               = "/api.App/FeeRewardList"
+	App_WithdrawList_FullMethodName                = "/api.App/WithdrawList"
+	App_TradeList_FullMethodName                   = "/api.App/TradeList"
+	App_CreateStatement_FullMethodName             = "/api.App/CreateStatement"
+	App_GetStatement_FullMethodName                = "/api.App/GetStatement"
+	App_History_FullMethodName                     = "/api.App/History"
+	App_FeePreview_FullMethodName                  = "/api.App/FeePreview"
+	App_TranList_FullMethodName                    = "/api.App/TranList"
+	App_RecommendList_FullMethodName               = "/api.App/RecommendList"
+	App_PasswordChange_FullMethodName              = "/api.App/PasswordChange"
+	App_BuyLocation_FullMethodName                 = "/api.App/BuyLocation"
+	App_Withdraw_FullMethodName                    = "/api.App/Withdraw"
+	App_Exchange_FullMethodName                    = "/api.App/Exchange"
+	App_Trade_FullMethodName                       = "/api.App/Trade"
+	App_Tran_FullMethodName                        = "/api.App/Tran"
+	App_GetTrade_FullMethodName                    = "/api.App/GetTrade"
+	App_SetBalanceReward_FullMethodName            = "/api.App/SetBalanceReward"
+	App_DeleteBalanceReward_FullMethodName         = "/api.App/DeleteBalanceReward"
+	App_BalanceRewardSummary_FullMethodName        = "/api.App/BalanceRewardSummary"
+	App_WithdrawAddressList_FullMethodName         = "/api.App/WithdrawAddressList"
+	App_WithdrawAddressCreate_FullMethodName       = "/api.App/WithdrawAddressCreate"
+	App_WithdrawAddressDelete_FullMethodName       = "/api.App/WithdrawAddressDelete"
+	App_InviteCodeList_FullMethodName              = "/api.App/InviteCodeList"
+	App_InviteCodeCreate_FullMethodName            = "/api.App/InviteCodeCreate"
+	App_Locale_FullMethodName                      = "/api.App/Locale"
+	App_LocaleChange_FullMethodName                = "/api.App/LocaleChange"
+	App_PushTicket_FullMethodName                  = "/api.App/PushTicket"
+	App_AdminInviteCodeCreate_FullMethodName       = "/api.App/AdminInviteCodeCreate"
+	App_AdminPasswordUpdate_FullMethodName         = "/api.App/AdminPasswordUpdate"
+	App_AdminCommissionSimulate_FullMethodName     = "/api.App/AdminCommissionSimulate"
+	App_AdminAreaLevel_FullMethodName              = "/api.App/AdminAreaLevel"
+	App_AdminJobRunList_FullMethodName             = "/api.App/AdminJobRunList"
+	App_AdminLocationTransitionList_FullMethodName = "/api.App/AdminLocationTransitionList"
+	App_AdminMatrixReplay_FullMethodName           = "/api.App/AdminMatrixReplay"
+	App_AdminWebhookList_FullMethodName            = "/api.App/AdminWebhookList"
+	App_AdminWebhookCreate_FullMethodName          = "/api.App/AdminWebhookCreate"
+	App_AdminWebhookUpdate_FullMethodName          = "/api.App/AdminWebhookUpdate"
+	App_AdminWebhookDeliveryList_FullMethodName    = "/api.App/AdminWebhookDeliveryList"
+	App_AdminWebhookReplay_FullMethodName          = "/api.App/AdminWebhookReplay"
+	App_AdminCacheStats_FullMethodName             = "/api.App/AdminCacheStats"
+	App_Deposit_FullMethodName                     = "/api.App/Deposit"
+	App_AdminWithdraw_FullMethodName               = "/api.App/AdminWithdraw"
+	App_AdminWithdrawEth_FullMethodName            = "/api.App/AdminWithdrawEth"
+	App_AdminFee_FullMethodName                    = "/api.App/AdminFee"
+	App_TokenWithdraw_FullMethodName               = "/api.App/TokenWithdraw"
 )
 
 // AppClient is the client API for App service.
@@ -58,9 +85,14 @@ type AppClient interface {
 	FeeRewardList(ctx context.Context, in *FeeRewardListRequest, opts ...grpc.CallOption) (*FeeRewardListReply, error)
 	WithdrawList(ctx context.Context, in *WithdrawListRequest, opts ...grpc.CallOption) (*WithdrawListReply, error)
 	TradeList(ctx context.Context, in *TradeListRequest, opts ...grpc.CallOption) (*TradeListReply, error)
+	CreateStatement(ctx context.Context, in *CreateStatementRequest, opts ...grpc.CallOption) (*CreateStatementReply, error)
+	GetStatement(ctx context.Context, in *GetStatementRequest, opts ...grpc.CallOption) (*CreateStatementReply, error)
+	History(ctx context.Context, in *HistoryRequest, opts ...grpc.CallOption) (*HistoryReply, error)
+	FeePreview(ctx context.Context, in *FeePreviewRequest, opts ...grpc.CallOption) (*FeePreviewReply, error)
 	TranList(ctx context.Context, in *TranListRequest, opts ...grpc.CallOption) (*TranListReply, error)
 	RecommendList(ctx context.Context, in *RecommendListRequest, opts ...grpc.CallOption) (*RecommendListReply, error)
 	PasswordChange(ctx context.Context, in *PasswordChangeRequest, opts ...grpc.CallOption) (*PasswordChangeReply, error)
+	BuyLocation(ctx context.Context, in *BuyLocationRequest, opts ...grpc.CallOption) (*BuyLocationReply, error)
 	Withdraw(ctx context.Context, in *WithdrawRequest, opts ...grpc.CallOption) (*WithdrawReply, error)
 	Exchange(ctx context.Context, in *ExchangeRequest, opts ...grpc.CallOption) (*ExchangeReply, error)
 	Trade(ctx context.Context, in *WithdrawRequest, opts ...grpc.CallOption) (*WithdrawReply, error)
@@ -68,6 +100,31 @@ type AppClient interface {
 	GetTrade(ctx context.Context, in *GetTradeRequest, opts ...grpc.CallOption) (*GetTradeReply, error)
 	SetBalanceReward(ctx context.Context, in *SetBalanceRewardRequest, opts ...grpc.CallOption) (*SetBalanceRewardReply, error)
 	DeleteBalanceReward(ctx context.Context, in *DeleteBalanceRewardRequest, opts ...grpc.CallOption) (*DeleteBalanceRewardReply, error)
+	BalanceRewardSummary(ctx context.Context, in *BalanceRewardSummaryRequest, opts ...grpc.CallOption) (*BalanceRewardSummaryReply, error)
+	WithdrawAddressList(ctx context.Context, in *WithdrawAddressListRequest, opts ...grpc.CallOption) (*WithdrawAddressListReply, error)
+	WithdrawAddressCreate(ctx context.Context, in *WithdrawAddressCreateRequest, opts ...grpc.CallOption) (*WithdrawAddressCreateReply, error)
+	WithdrawAddressDelete(ctx context.Context, in *WithdrawAddressDeleteRequest, opts ...grpc.CallOption) (*WithdrawAddressDeleteReply, error)
+	InviteCodeList(ctx context.Context, in *InviteCodeListRequest, opts ...grpc.CallOption) (*InviteCodeListReply, error)
+	InviteCodeCreate(ctx context.Context, in *InviteCodeCreateRequest, opts ...grpc.CallOption) (*InviteCodeCreateReply, error)
+	// 当前语言和支持的语言
+	Locale(ctx context.Context, in *LocaleRequest, opts ...grpc.CallOption) (*LocaleReply, error)
+	// 设置语言，优先于 Accept-Language，为空时恢复按请求头选择
+	LocaleChange(ctx context.Context, in *LocaleChangeRequest, opts ...grpc.CallOption) (*LocaleChangeReply, error)
+	// 推送连接凭证，一次性，有效期很短，EventSource 不能设置请求头时放在 ticket 参数中
+	PushTicket(ctx context.Context, in *PushTicketRequest, opts ...grpc.CallOption) (*PushTicketReply, error)
+	AdminInviteCodeCreate(ctx context.Context, in *AdminInviteCodeCreateRequest, opts ...grpc.CallOption) (*AdminInviteCodeCreateReply, error)
+	AdminPasswordUpdate(ctx context.Context, in *AdminPasswordUpdateRequest, opts ...grpc.CallOption) (*AdminPasswordUpdateReply, error)
+	AdminCommissionSimulate(ctx context.Context, in *AdminCommissionSimulateRequest, opts ...grpc.CallOption) (*AdminCommissionSimulateReply, error)
+	AdminAreaLevel(ctx context.Context, in *AdminAreaLevelRequest, opts ...grpc.CallOption) (*AdminAreaLevelReply, error)
+	AdminJobRunList(ctx context.Context, in *AdminJobRunListRequest, opts ...grpc.CallOption) (*AdminJobRunListReply, error)
+	AdminLocationTransitionList(ctx context.Context, in *AdminLocationTransitionListRequest, opts ...grpc.CallOption) (*AdminLocationTransitionListReply, error)
+	AdminMatrixReplay(ctx context.Context, in *AdminMatrixReplayRequest, opts ...grpc.CallOption) (*AdminMatrixReplayReply, error)
+	AdminWebhookList(ctx context.Context, in *AdminWebhookListRequest, opts ...grpc.CallOption) (*AdminWebhookListReply, error)
+	AdminWebhookCreate(ctx context.Context, in *AdminWebhookCreateRequest, opts ...grpc.CallOption) (*AdminWebhookCreateReply, error)
+	AdminWebhookUpdate(ctx context.Context, in *AdminWebhookUpdateRequest, opts ...grpc.CallOption) (*AdminWebhookUpdateReply, error)
+	AdminWebhookDeliveryList(ctx context.Context, in *AdminWebhookDeliveryListRequest, opts ...grpc.CallOption) (*AdminWebhookDeliveryListReply, error)
+	AdminWebhookReplay(ctx context.Context, in *AdminWebhookReplayRequest, opts ...grpc.CallOption) (*AdminWebhookReplayReply, error)
+	AdminCacheStats(ctx context.Context, in *AdminCacheStatsRequest, opts ...grpc.CallOption) (*AdminCacheStatsReply, error)
 	Deposit(ctx context.Context, in *DepositRequest, opts ...grpc.CallOption) (*DepositReply, error)
 	//	rpc AdminRewardList (AdminRewardListRequest) returns (AdminRewardListReply) {
 	//		option (google.api.http) = {
@@ -187,6 +244,42 @@ func (c *appClient) TradeList(ctx context.Context, in *TradeListRequest, opts ..
 	return out, nil
 }
 
+func (c *appClient) CreateStatement(ctx context.Context, in *CreateStatementRequest, opts ...grpc.CallOption) (*CreateStatementReply, error) {
+	out := new(CreateStatementReply)
+	err := c.cc.Invoke(ctx, App_CreateStatement_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) GetStatement(ctx context.Context, in *GetStatementRequest, opts ...grpc.CallOption) (*CreateStatementReply, error) {
+	out := new(CreateStatementReply)
+	err := c.cc.Invoke(ctx, App_GetStatement_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) History(ctx context.Context, in *HistoryRequest, opts ...grpc.CallOption) (*HistoryReply, error) {
+	out := new(HistoryReply)
+	err := c.cc.Invoke(ctx, App_History_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) FeePreview(ctx context.Context, in *FeePreviewRequest, opts ...grpc.CallOption) (*FeePreviewReply, error) {
+	out := new(FeePreviewReply)
+	err := c.cc.Invoke(ctx, App_FeePreview_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *appClient) TranList(ctx context.Context, in *TranListRequest, opts ...grpc.CallOption) (*TranListReply, error) {
 	out := new(TranListReply)
 	err := c.cc.Invoke(ctx, App_TranList_FullMethodName, in, out, opts...)
@@ -214,6 +307,15 @@ func (c *appClient) PasswordChange(ctx context.Context, in *PasswordChangeReques
 	return out, nil
 }
 
+func (c *appClient) BuyLocation(ctx context.Context, in *BuyLocationRequest, opts ...grpc.CallOption) (*BuyLocationReply, error) {
+	out := new(BuyLocationReply)
+	err := c.cc.Invoke(ctx, App_BuyLocation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *appClient) Withdraw(ctx context.Context, in *WithdrawRequest, opts ...grpc.CallOption) (*WithdrawReply, error) {
 	out := new(WithdrawReply)
 	err := c.cc.Invoke(ctx, App_Withdraw_FullMethodName, in, out, opts...)
@@ -277,6 +379,204 @@ func (c *appClient) DeleteBalanceReward(ctx context.Context, in *DeleteBalanceRe
 	return out, nil
 }
 
+func (c *appClient) BalanceRewardSummary(ctx context.Context, in *BalanceRewardSummaryRequest, opts ...grpc.CallOption) (*BalanceRewardSummaryReply, error) {
+	out := new(BalanceRewardSummaryReply)
+	err := c.cc.Invoke(ctx, App_BalanceRewardSummary_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) WithdrawAddressList(ctx context.Context, in *WithdrawAddressListRequest, opts ...grpc.CallOption) (*WithdrawAddressListReply, error) {
+	out := new(WithdrawAddressListReply)
+	err := c.cc.Invoke(ctx, App_WithdrawAddressList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) WithdrawAddressCreate(ctx context.Context, in *WithdrawAddressCreateRequest, opts ...grpc.CallOption) (*WithdrawAddressCreateReply, error) {
+	out := new(WithdrawAddressCreateReply)
+	err := c.cc.Invoke(ctx, App_WithdrawAddressCreate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) WithdrawAddressDelete(ctx context.Context, in *WithdrawAddressDeleteRequest, opts ...grpc.CallOption) (*WithdrawAddressDeleteReply, error) {
+	out := new(WithdrawAddressDeleteReply)
+	err := c.cc.Invoke(ctx, App_WithdrawAddressDelete_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) InviteCodeList(ctx context.Context, in *InviteCodeListRequest, opts ...grpc.CallOption) (*InviteCodeListReply, error) {
+	out := new(InviteCodeListReply)
+	err := c.cc.Invoke(ctx, App_InviteCodeList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) InviteCodeCreate(ctx context.Context, in *InviteCodeCreateRequest, opts ...grpc.CallOption) (*InviteCodeCreateReply, error) {
+	out := new(InviteCodeCreateReply)
+	err := c.cc.Invoke(ctx, App_InviteCodeCreate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) Locale(ctx context.Context, in *LocaleRequest, opts ...grpc.CallOption) (*LocaleReply, error) {
+	out := new(LocaleReply)
+	err := c.cc.Invoke(ctx, App_Locale_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) LocaleChange(ctx context.Context, in *LocaleChangeRequest, opts ...grpc.CallOption) (*LocaleChangeReply, error) {
+	out := new(LocaleChangeReply)
+	err := c.cc.Invoke(ctx, App_LocaleChange_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) PushTicket(ctx context.Context, in *PushTicketRequest, opts ...grpc.CallOption) (*PushTicketReply, error) {
+	out := new(PushTicketReply)
+	err := c.cc.Invoke(ctx, App_PushTicket_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminInviteCodeCreate(ctx context.Context, in *AdminInviteCodeCreateRequest, opts ...grpc.CallOption) (*AdminInviteCodeCreateReply, error) {
+	out := new(AdminInviteCodeCreateReply)
+	err := c.cc.Invoke(ctx, App_AdminInviteCodeCreate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminPasswordUpdate(ctx context.Context, in *AdminPasswordUpdateRequest, opts ...grpc.CallOption) (*AdminPasswordUpdateReply, error) {
+	out := new(AdminPasswordUpdateReply)
+	err := c.cc.Invoke(ctx, App_AdminPasswordUpdate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminCommissionSimulate(ctx context.Context, in *AdminCommissionSimulateRequest, opts ...grpc.CallOption) (*AdminCommissionSimulateReply, error) {
+	out := new(AdminCommissionSimulateReply)
+	err := c.cc.Invoke(ctx, App_AdminCommissionSimulate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminAreaLevel(ctx context.Context, in *AdminAreaLevelRequest, opts ...grpc.CallOption) (*AdminAreaLevelReply, error) {
+	out := new(AdminAreaLevelReply)
+	err := c.cc.Invoke(ctx, App_AdminAreaLevel_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminJobRunList(ctx context.Context, in *AdminJobRunListRequest, opts ...grpc.CallOption) (*AdminJobRunListReply, error) {
+	out := new(AdminJobRunListReply)
+	err := c.cc.Invoke(ctx, App_AdminJobRunList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminLocationTransitionList(ctx context.Context, in *AdminLocationTransitionListRequest, opts ...grpc.CallOption) (*AdminLocationTransitionListReply, error) {
+	out := new(AdminLocationTransitionListReply)
+	err := c.cc.Invoke(ctx, App_AdminLocationTransitionList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminMatrixReplay(ctx context.Context, in *AdminMatrixReplayRequest, opts ...grpc.CallOption) (*AdminMatrixReplayReply, error) {
+	out := new(AdminMatrixReplayReply)
+	err := c.cc.Invoke(ctx, App_AdminMatrixReplay_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminWebhookList(ctx context.Context, in *AdminWebhookListRequest, opts ...grpc.CallOption) (*AdminWebhookListReply, error) {
+	out := new(AdminWebhookListReply)
+	err := c.cc.Invoke(ctx, App_AdminWebhookList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminWebhookCreate(ctx context.Context, in *AdminWebhookCreateRequest, opts ...grpc.CallOption) (*AdminWebhookCreateReply, error) {
+	out := new(AdminWebhookCreateReply)
+	err := c.cc.Invoke(ctx, App_AdminWebhookCreate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminWebhookUpdate(ctx context.Context, in *AdminWebhookUpdateRequest, opts ...grpc.CallOption) (*AdminWebhookUpdateReply, error) {
+	out := new(AdminWebhookUpdateReply)
+	err := c.cc.Invoke(ctx, App_AdminWebhookUpdate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminWebhookDeliveryList(ctx context.Context, in *AdminWebhookDeliveryListRequest, opts ...grpc.CallOption) (*AdminWebhookDeliveryListReply, error) {
+	out := new(AdminWebhookDeliveryListReply)
+	err := c.cc.Invoke(ctx, App_AdminWebhookDeliveryList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminWebhookReplay(ctx context.Context, in *AdminWebhookReplayRequest, opts ...grpc.CallOption) (*AdminWebhookReplayReply, error) {
+	out := new(AdminWebhookReplayReply)
+	err := c.cc.Invoke(ctx, App_AdminWebhookReplay_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appClient) AdminCacheStats(ctx context.Context, in *AdminCacheStatsRequest, opts ...grpc.CallOption) (*AdminCacheStatsReply, error) {
+	out := new(AdminCacheStatsReply)
+	err := c.cc.Invoke(ctx, App_AdminCacheStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *appClient) Deposit(ctx context.Context, in *DepositRequest, opts ...grpc.CallOption) (*DepositReply, error) {
 	out := new(DepositReply)
 	err := c.cc.Invoke(ctx, App_Deposit_FullMethodName, in, out, opts...)
@@ -335,9 +635,14 @@ type AppServer interface {
 	FeeRewardList(context.Context, *FeeRewardListRequest) (*FeeRewardListReply, error)
 	WithdrawList(context.Context, *WithdrawListRequest) (*WithdrawListReply, error)
 	TradeList(context.Context, *TradeListRequest) (*TradeListReply, error)
+	CreateStatement(context.Context, *CreateStatementRequest) (*CreateStatementReply, error)
+	GetStatement(context.Context, *GetStatementRequest) (*CreateStatementReply, error)
+	History(context.Context, *HistoryRequest) (*HistoryReply, error)
+	FeePreview(context.Context, *FeePreviewRequest) (*FeePreviewReply, error)
 	TranList(context.Context, *TranListRequest) (*TranListReply, error)
 	RecommendList(context.Context, *RecommendListRequest) (*RecommendListReply, error)
 	PasswordChange(context.Context, *PasswordChangeRequest) (*PasswordChangeReply, error)
+	BuyLocation(context.Context, *BuyLocationRequest) (*BuyLocationReply, error)
 	Withdraw(context.Context, *WithdrawRequest) (*WithdrawReply, error)
 	Exchange(context.Context, *ExchangeRequest) (*ExchangeReply, error)
 	Trade(context.Context, *WithdrawRequest) (*WithdrawReply, error)
@@ -345,6 +650,31 @@ type AppServer interface {
 	GetTrade(context.Context, *GetTradeRequest) (*GetTradeReply, error)
 	SetBalanceReward(context.Context, *SetBalanceRewardRequest) (*SetBalanceRewardReply, error)
 	DeleteBalanceReward(context.Context, *DeleteBalanceRewardRequest) (*DeleteBalanceRewardReply, error)
+	BalanceRewardSummary(context.Context, *BalanceRewardSummaryRequest) (*BalanceRewardSummaryReply, error)
+	WithdrawAddressList(context.Context, *WithdrawAddressListRequest) (*WithdrawAddressListReply, error)
+	WithdrawAddressCreate(context.Context, *WithdrawAddressCreateRequest) (*WithdrawAddressCreateReply, error)
+	WithdrawAddressDelete(context.Context, *WithdrawAddressDeleteRequest) (*WithdrawAddressDeleteReply, error)
+	InviteCodeList(context.Context, *InviteCodeListRequest) (*InviteCodeListReply, error)
+	InviteCodeCreate(context.Context, *InviteCodeCreateRequest) (*InviteCodeCreateReply, error)
+	// 当前语言和支持的语言
+	Locale(context.Context, *LocaleRequest) (*LocaleReply, error)
+	// 设置语言，优先于 Accept-Language，为空时恢复按请求头选择
+	LocaleChange(context.Context, *LocaleChangeRequest) (*LocaleChangeReply, error)
+	// 推送连接凭证，一次性，有效期很短，EventSource 不能设置请求头时放在 ticket 参数中
+	PushTicket(context.Context, *PushTicketRequest) (*PushTicketReply, error)
+	AdminInviteCodeCreate(context.Context, *AdminInviteCodeCreateRequest) (*AdminInviteCodeCreateReply, error)
+	AdminPasswordUpdate(context.Context, *AdminPasswordUpdateRequest) (*AdminPasswordUpdateReply, error)
+	AdminCommissionSimulate(context.Context, *AdminCommissionSimulateRequest) (*AdminCommissionSimulateReply, error)
+	AdminAreaLevel(context.Context, *AdminAreaLevelRequest) (*AdminAreaLevelReply, error)
+	AdminJobRunList(context.Context, *AdminJobRunListRequest) (*AdminJobRunListReply, error)
+	AdminLocationTransitionList(context.Context, *AdminLocationTransitionListRequest) (*AdminLocationTransitionListReply, error)
+	AdminMatrixReplay(context.Context, *AdminMatrixReplayRequest) (*AdminMatrixReplayReply, error)
+	AdminWebhookList(context.Context, *AdminWebhookListRequest) (*AdminWebhookListReply, error)
+	AdminWebhookCreate(context.Context, *AdminWebhookCreateRequest) (*AdminWebhookCreateReply, error)
+	AdminWebhookUpdate(context.Context, *AdminWebhookUpdateRequest) (*AdminWebhookUpdateReply, error)
+	AdminWebhookDeliveryList(context.Context, *AdminWebhookDeliveryListRequest) (*AdminWebhookDeliveryListReply, error)
+	AdminWebhookReplay(context.Context, *AdminWebhookReplayRequest) (*AdminWebhookReplayReply, error)
+	AdminCacheStats(context.Context, *AdminCacheStatsRequest) (*AdminCacheStatsReply, error)
 	Deposit(context.Context, *DepositRequest) (*DepositReply, error)
 	//	rpc AdminRewardList (AdminRewardListRequest) returns (AdminRewardListReply) {
 	//		option (google.api.http) = {
@@ -407,6 +737,18 @@ func (UnimplementedAppServer) WithdrawList(context.Context, *WithdrawListRequest
 func (UnimplementedAppServer) TradeList(context.Context, *TradeListRequest) (*TradeListReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TradeList not implemented")
 }
+func (UnimplementedAppServer) CreateStatement(context.Context, *CreateStatementRequest) (*CreateStatementReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateStatement not implemented")
+}
+func (UnimplementedAppServer) GetStatement(context.Context, *GetStatementRequest) (*CreateStatementReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStatement not implemented")
+}
+func (UnimplementedAppServer) History(context.Context, *HistoryRequest) (*HistoryReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method History not implemented")
+}
+func (UnimplementedAppServer) FeePreview(context.Context, *FeePreviewRequest) (*FeePreviewReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeePreview not implemented")
+}
 func (UnimplementedAppServer) TranList(context.Context, *TranListRequest) (*TranListReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TranList not implemented")
 }
@@ -416,6 +758,9 @@ func (UnimplementedAppServer) RecommendList(context.Context, *RecommendListReque
 func (UnimplementedAppServer) PasswordChange(context.Context, *PasswordChangeRequest) (*PasswordChangeReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PasswordChange not implemented")
 }
+func (UnimplementedAppServer) BuyLocation(context.Context, *BuyLocationRequest) (*BuyLocationReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BuyLocation not implemented")
+}
 func (UnimplementedAppServer) Withdraw(context.Context, *WithdrawRequest) (*WithdrawReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Withdraw not implemented")
 }
@@ -437,6 +782,72 @@ func (UnimplementedAppServer) SetBalanceReward(context.Context, *SetBalanceRewar
 func (UnimplementedAppServer) DeleteBalanceReward(context.Context, *DeleteBalanceRewardRequest) (*DeleteBalanceRewardReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteBalanceReward not implemented")
 }
+func (UnimplementedAppServer) BalanceRewardSummary(context.Context, *BalanceRewardSummaryRequest) (*BalanceRewardSummaryReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BalanceRewardSummary not implemented")
+}
+func (UnimplementedAppServer) WithdrawAddressList(context.Context, *WithdrawAddressListRequest) (*WithdrawAddressListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawAddressList not implemented")
+}
+func (UnimplementedAppServer) WithdrawAddressCreate(context.Context, *WithdrawAddressCreateRequest) (*WithdrawAddressCreateReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawAddressCreate not implemented")
+}
+func (UnimplementedAppServer) WithdrawAddressDelete(context.Context, *WithdrawAddressDeleteRequest) (*WithdrawAddressDeleteReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawAddressDelete not implemented")
+}
+func (UnimplementedAppServer) InviteCodeList(context.Context, *InviteCodeListRequest) (*InviteCodeListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InviteCodeList not implemented")
+}
+func (UnimplementedAppServer) InviteCodeCreate(context.Context, *InviteCodeCreateRequest) (*InviteCodeCreateReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InviteCodeCreate not implemented")
+}
+func (UnimplementedAppServer) Locale(context.Context, *LocaleRequest) (*LocaleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Locale not implemented")
+}
+func (UnimplementedAppServer) LocaleChange(context.Context, *LocaleChangeRequest) (*LocaleChangeReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LocaleChange not implemented")
+}
+func (UnimplementedAppServer) PushTicket(context.Context, *PushTicketRequest) (*PushTicketReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PushTicket not implemented")
+}
+func (UnimplementedAppServer) AdminInviteCodeCreate(context.Context, *AdminInviteCodeCreateRequest) (*AdminInviteCodeCreateReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminInviteCodeCreate not implemented")
+}
+func (UnimplementedAppServer) AdminPasswordUpdate(context.Context, *AdminPasswordUpdateRequest) (*AdminPasswordUpdateReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminPasswordUpdate not implemented")
+}
+func (UnimplementedAppServer) AdminCommissionSimulate(context.Context, *AdminCommissionSimulateRequest) (*AdminCommissionSimulateReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminCommissionSimulate not implemented")
+}
+func (UnimplementedAppServer) AdminAreaLevel(context.Context, *AdminAreaLevelRequest) (*AdminAreaLevelReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminAreaLevel not implemented")
+}
+func (UnimplementedAppServer) AdminJobRunList(context.Context, *AdminJobRunListRequest) (*AdminJobRunListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminJobRunList not implemented")
+}
+func (UnimplementedAppServer) AdminLocationTransitionList(context.Context, *AdminLocationTransitionListRequest) (*AdminLocationTransitionListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminLocationTransitionList not implemented")
+}
+func (UnimplementedAppServer) AdminMatrixReplay(context.Context, *AdminMatrixReplayRequest) (*AdminMatrixReplayReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminMatrixReplay not implemented")
+}
+func (UnimplementedAppServer) AdminWebhookList(context.Context, *AdminWebhookListRequest) (*AdminWebhookListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminWebhookList not implemented")
+}
+func (UnimplementedAppServer) AdminWebhookCreate(context.Context, *AdminWebhookCreateRequest) (*AdminWebhookCreateReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminWebhookCreate not implemented")
+}
+func (UnimplementedAppServer) AdminWebhookUpdate(context.Context, *AdminWebhookUpdateRequest) (*AdminWebhookUpdateReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminWebhookUpdate not implemented")
+}
+func (UnimplementedAppServer) AdminWebhookDeliveryList(context.Context, *AdminWebhookDeliveryListRequest) (*AdminWebhookDeliveryListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminWebhookDeliveryList not implemented")
+}
+func (UnimplementedAppServer) AdminWebhookReplay(context.Context, *AdminWebhookReplayRequest) (*AdminWebhookReplayReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminWebhookReplay not implemented")
+}
+func (UnimplementedAppServer) AdminCacheStats(context.Context, *AdminCacheStatsRequest) (*AdminCacheStatsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminCacheStats not implemented")
+}
 func (UnimplementedAppServer) Deposit(context.Context, *DepositRequest) (*DepositReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Deposit not implemented")
 }
@@ -627,6 +1038,78 @@ func _App_TradeList_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _App_CreateStatement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateStatementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).CreateStatement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: App_CreateStatement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).CreateStatement(ctx, req.(*CreateStatementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_GetStatement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStatementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).GetStatement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: App_GetStatement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).GetStatement(ctx, req.(*GetStatementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_History_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).History(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: App_History_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).History(ctx, req.(*HistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_FeePreview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FeePreviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).FeePreview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: App_FeePreview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).FeePreview(ctx, req.(*FeePreviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _App_TranList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TranListRequest)
 	if err := dec(in); err != nil {
@@ -681,6 +1164,24 @@ func _App_PasswordChange_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _App_BuyLocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BuyLocationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).BuyLocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: App_BuyLocation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).BuyLocation(ctx, req.(*BuyLocationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _App_Withdraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(WithdrawRequest)
 	if err := dec(in); err != nil {
@@ -807,8 +1308,404 @@ func _App_DeleteBalanceReward_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
-func _App_Deposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DepositRequest)
+func _App_BalanceRewardSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BalanceRewardSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).BalanceRewardSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: App_BalanceRewardSummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).BalanceRewardSummary(ctx, req.(*BalanceRewardSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_WithdrawAddressList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawAddressListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).WithdrawAddressList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: App_WithdrawAddressList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).WithdrawAddressList(ctx, req.(*WithdrawAddressListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_WithdrawAddressCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawAddressCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).WithdrawAddressCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: App_WithdrawAddressCreate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).WithdrawAddressCreate(ctx, req.(*WithdrawAddressCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_WithdrawAddressDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawAddressDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).WithdrawAddressDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: App_WithdrawAddressDelete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).WithdrawAddressDelete(ctx, req.(*WithdrawAddressDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_InviteCodeList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InviteCodeListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).InviteCodeList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: App_InviteCodeList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).InviteCodeList(ctx, req.(*InviteCodeListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_InviteCodeCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InviteCodeCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).InviteCodeCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: App_InviteCodeCreate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).InviteCodeCreate(ctx, req.(*InviteCodeCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_Locale_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LocaleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).Locale(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: App_Locale_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).Locale(ctx, req.(*LocaleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_LocaleChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LocaleChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).LocaleChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: App_LocaleChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).LocaleChange(ctx, req.(*LocaleChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_PushTicket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PushTicketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).PushTicket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: App_PushTicket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).PushTicket(ctx, req.(*PushTicketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminInviteCodeCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminInviteCodeCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminInviteCodeCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: App_AdminInviteCodeCreate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminInviteCodeCreate(ctx, req.(*AdminInviteCodeCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminPasswordUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminPasswordUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminPasswordUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: App_AdminPasswordUpdate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminPasswordUpdate(ctx, req.(*AdminPasswordUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminCommissionSimulate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminCommissionSimulateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminCommissionSimulate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: App_AdminCommissionSimulate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminCommissionSimulate(ctx, req.(*AdminCommissionSimulateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminAreaLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminAreaLevelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminAreaLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: App_AdminAreaLevel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminAreaLevel(ctx, req.(*AdminAreaLevelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminJobRunList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminJobRunListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminJobRunList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: App_AdminJobRunList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminJobRunList(ctx, req.(*AdminJobRunListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminLocationTransitionList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminLocationTransitionListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminLocationTransitionList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: App_AdminLocationTransitionList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminLocationTransitionList(ctx, req.(*AdminLocationTransitionListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminMatrixReplay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminMatrixReplayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminMatrixReplay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: App_AdminMatrixReplay_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminMatrixReplay(ctx, req.(*AdminMatrixReplayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminWebhookList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminWebhookListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminWebhookList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: App_AdminWebhookList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminWebhookList(ctx, req.(*AdminWebhookListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminWebhookCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminWebhookCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminWebhookCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: App_AdminWebhookCreate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminWebhookCreate(ctx, req.(*AdminWebhookCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminWebhookUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminWebhookUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminWebhookUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: App_AdminWebhookUpdate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminWebhookUpdate(ctx, req.(*AdminWebhookUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminWebhookDeliveryList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminWebhookDeliveryListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminWebhookDeliveryList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: App_AdminWebhookDeliveryList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminWebhookDeliveryList(ctx, req.(*AdminWebhookDeliveryListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminWebhookReplay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminWebhookReplayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminWebhookReplay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: App_AdminWebhookReplay_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminWebhookReplay(ctx, req.(*AdminWebhookReplayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_AdminCacheStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminCacheStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppServer).AdminCacheStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: App_AdminCacheStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppServer).AdminCacheStats(ctx, req.(*AdminCacheStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _App_Deposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DepositRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -940,6 +1837,22 @@ var App_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TradeList",
 			Handler:    _App_TradeList_Handler,
 		},
+		{
+			MethodName: "CreateStatement",
+			Handler:    _App_CreateStatement_Handler,
+		},
+		{
+			MethodName: "GetStatement",
+			Handler:    _App_GetStatement_Handler,
+		},
+		{
+			MethodName: "History",
+			Handler:    _App_History_Handler,
+		},
+		{
+			MethodName: "FeePreview",
+			Handler:    _App_FeePreview_Handler,
+		},
 		{
 			MethodName: "TranList",
 			Handler:    _App_TranList_Handler,
@@ -952,6 +1865,10 @@ var App_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PasswordChange",
 			Handler:    _App_PasswordChange_Handler,
 		},
+		{
+			MethodName: "BuyLocation",
+			Handler:    _App_BuyLocation_Handler,
+		},
 		{
 			MethodName: "Withdraw",
 			Handler:    _App_Withdraw_Handler,
@@ -980,6 +1897,94 @@ var App_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteBalanceReward",
 			Handler:    _App_DeleteBalanceReward_Handler,
 		},
+		{
+			MethodName: "BalanceRewardSummary",
+			Handler:    _App_BalanceRewardSummary_Handler,
+		},
+		{
+			MethodName: "WithdrawAddressList",
+			Handler:    _App_WithdrawAddressList_Handler,
+		},
+		{
+			MethodName: "WithdrawAddressCreate",
+			Handler:    _App_WithdrawAddressCreate_Handler,
+		},
+		{
+			MethodName: "WithdrawAddressDelete",
+			Handler:    _App_WithdrawAddressDelete_Handler,
+		},
+		{
+			MethodName: "InviteCodeList",
+			Handler:    _App_InviteCodeList_Handler,
+		},
+		{
+			MethodName: "InviteCodeCreate",
+			Handler:    _App_InviteCodeCreate_Handler,
+		},
+		{
+			MethodName: "Locale",
+			Handler:    _App_Locale_Handler,
+		},
+		{
+			MethodName: "LocaleChange",
+			Handler:    _App_LocaleChange_Handler,
+		},
+		{
+			MethodName: "PushTicket",
+			Handler:    _App_PushTicket_Handler,
+		},
+		{
+			MethodName: "AdminInviteCodeCreate",
+			Handler:    _App_AdminInviteCodeCreate_Handler,
+		},
+		{
+			MethodName: "AdminPasswordUpdate",
+			Handler:    _App_AdminPasswordUpdate_Handler,
+		},
+		{
+			MethodName: "AdminCommissionSimulate",
+			Handler:    _App_AdminCommissionSimulate_Handler,
+		},
+		{
+			MethodName: "AdminAreaLevel",
+			Handler:    _App_AdminAreaLevel_Handler,
+		},
+		{
+			MethodName: "AdminJobRunList",
+			Handler:    _App_AdminJobRunList_Handler,
+		},
+		{
+			MethodName: "AdminLocationTransitionList",
+			Handler:    _App_AdminLocationTransitionList_Handler,
+		},
+		{
+			MethodName: "AdminMatrixReplay",
+			Handler:    _App_AdminMatrixReplay_Handler,
+		},
+		{
+			MethodName: "AdminWebhookList",
+			Handler:    _App_AdminWebhookList_Handler,
+		},
+		{
+			MethodName: "AdminWebhookCreate",
+			Handler:    _App_AdminWebhookCreate_Handler,
+		},
+		{
+			MethodName: "AdminWebhookUpdate",
+			Handler:    _App_AdminWebhookUpdate_Handler,
+		},
+		{
+			MethodName: "AdminWebhookDeliveryList",
+			Handler:    _App_AdminWebhookDeliveryList_Handler,
+		},
+		{
+			MethodName: "AdminWebhookReplay",
+			Handler:    _App_AdminWebhookReplay_Handler,
+		},
+		{
+			MethodName: "AdminCacheStats",
+			Handler:    _App_AdminCacheStats_Handler,
+		},
 		{
 			MethodName: "Deposit",
 			Handler:    _App_Deposit_Handler,
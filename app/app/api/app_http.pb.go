@@ -19,16 +19,40 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationAppAdminAreaLevel = "/api.App/AdminAreaLevel"
+const OperationAppAdminCacheStats = "/api.App/AdminCacheStats"
+const OperationAppAdminCommissionSimulate = "/api.App/AdminCommissionSimulate"
 const OperationAppAdminFee = "/api.App/AdminFee"
+const OperationAppAdminInviteCodeCreate = "/api.App/AdminInviteCodeCreate"
+const OperationAppAdminJobRunList = "/api.App/AdminJobRunList"
+const OperationAppAdminLocationTransitionList = "/api.App/AdminLocationTransitionList"
+const OperationAppAdminMatrixReplay = "/api.App/AdminMatrixReplay"
+const OperationAppAdminPasswordUpdate = "/api.App/AdminPasswordUpdate"
+const OperationAppAdminWebhookCreate = "/api.App/AdminWebhookCreate"
+const OperationAppAdminWebhookDeliveryList = "/api.App/AdminWebhookDeliveryList"
+const OperationAppAdminWebhookList = "/api.App/AdminWebhookList"
+const OperationAppAdminWebhookReplay = "/api.App/AdminWebhookReplay"
+const OperationAppAdminWebhookUpdate = "/api.App/AdminWebhookUpdate"
 const OperationAppAdminWithdraw = "/api.App/AdminWithdraw"
 const OperationAppAdminWithdrawEth = "/api.App/AdminWithdrawEth"
+const OperationAppBalanceRewardSummary = "/api.App/BalanceRewardSummary"
+const OperationAppBuyLocation = "/api.App/BuyLocation"
+const OperationAppCreateStatement = "/api.App/CreateStatement"
 const OperationAppDeleteBalanceReward = "/api.App/DeleteBalanceReward"
 const OperationAppDeposit = "/api.App/Deposit"
 const OperationAppEthAuthorize = "/api.App/EthAuthorize"
 const OperationAppExchange = "/api.App/Exchange"
+const OperationAppFeePreview = "/api.App/FeePreview"
 const OperationAppFeeRewardList = "/api.App/FeeRewardList"
+const OperationAppGetStatement = "/api.App/GetStatement"
 const OperationAppGetTrade = "/api.App/GetTrade"
+const OperationAppHistory = "/api.App/History"
+const OperationAppInviteCodeCreate = "/api.App/InviteCodeCreate"
+const OperationAppInviteCodeList = "/api.App/InviteCodeList"
+const OperationAppLocale = "/api.App/Locale"
+const OperationAppLocaleChange = "/api.App/LocaleChange"
 const OperationAppPasswordChange = "/api.App/PasswordChange"
+const OperationAppPushTicket = "/api.App/PushTicket"
 const OperationAppRecommendList = "/api.App/RecommendList"
 const OperationAppRecommendRewardList = "/api.App/RecommendRewardList"
 const OperationAppRecommendUpdate = "/api.App/RecommendUpdate"
@@ -42,10 +66,26 @@ const OperationAppTranList = "/api.App/TranList"
 const OperationAppUserArea = "/api.App/UserArea"
 const OperationAppUserInfo = "/api.App/UserInfo"
 const OperationAppWithdraw = "/api.App/Withdraw"
+const OperationAppWithdrawAddressCreate = "/api.App/WithdrawAddressCreate"
+const OperationAppWithdrawAddressDelete = "/api.App/WithdrawAddressDelete"
+const OperationAppWithdrawAddressList = "/api.App/WithdrawAddressList"
 const OperationAppWithdrawList = "/api.App/WithdrawList"
 
 type AppHTTPServer interface {
+	AdminAreaLevel(context.Context, *AdminAreaLevelRequest) (*AdminAreaLevelReply, error)
+	AdminCacheStats(context.Context, *AdminCacheStatsRequest) (*AdminCacheStatsReply, error)
+	AdminCommissionSimulate(context.Context, *AdminCommissionSimulateRequest) (*AdminCommissionSimulateReply, error)
 	AdminFee(context.Context, *AdminFeeRequest) (*AdminFeeReply, error)
+	AdminInviteCodeCreate(context.Context, *AdminInviteCodeCreateRequest) (*AdminInviteCodeCreateReply, error)
+	AdminJobRunList(context.Context, *AdminJobRunListRequest) (*AdminJobRunListReply, error)
+	AdminLocationTransitionList(context.Context, *AdminLocationTransitionListRequest) (*AdminLocationTransitionListReply, error)
+	AdminMatrixReplay(context.Context, *AdminMatrixReplayRequest) (*AdminMatrixReplayReply, error)
+	AdminPasswordUpdate(context.Context, *AdminPasswordUpdateRequest) (*AdminPasswordUpdateReply, error)
+	AdminWebhookCreate(context.Context, *AdminWebhookCreateRequest) (*AdminWebhookCreateReply, error)
+	AdminWebhookDeliveryList(context.Context, *AdminWebhookDeliveryListRequest) (*AdminWebhookDeliveryListReply, error)
+	AdminWebhookList(context.Context, *AdminWebhookListRequest) (*AdminWebhookListReply, error)
+	AdminWebhookReplay(context.Context, *AdminWebhookReplayRequest) (*AdminWebhookReplayReply, error)
+	AdminWebhookUpdate(context.Context, *AdminWebhookUpdateRequest) (*AdminWebhookUpdateReply, error)
 	// AdminWithdraw
 	//	rpc AdminRewardList (AdminRewardListRequest) returns (AdminRewardListReply) {
 	//		option (google.api.http) = {
@@ -73,13 +113,27 @@ type AppHTTPServer interface {
 	//
 	AdminWithdraw(context.Context, *AdminWithdrawRequest) (*AdminWithdrawReply, error)
 	AdminWithdrawEth(context.Context, *AdminWithdrawEthRequest) (*AdminWithdrawEthReply, error)
+	BalanceRewardSummary(context.Context, *BalanceRewardSummaryRequest) (*BalanceRewardSummaryReply, error)
+	BuyLocation(context.Context, *BuyLocationRequest) (*BuyLocationReply, error)
+	CreateStatement(context.Context, *CreateStatementRequest) (*CreateStatementReply, error)
 	DeleteBalanceReward(context.Context, *DeleteBalanceRewardRequest) (*DeleteBalanceRewardReply, error)
 	Deposit(context.Context, *DepositRequest) (*DepositReply, error)
 	EthAuthorize(context.Context, *EthAuthorizeRequest) (*EthAuthorizeReply, error)
 	Exchange(context.Context, *ExchangeRequest) (*ExchangeReply, error)
+	FeePreview(context.Context, *FeePreviewRequest) (*FeePreviewReply, error)
 	FeeRewardList(context.Context, *FeeRewardListRequest) (*FeeRewardListReply, error)
+	GetStatement(context.Context, *GetStatementRequest) (*CreateStatementReply, error)
 	GetTrade(context.Context, *GetTradeRequest) (*GetTradeReply, error)
+	History(context.Context, *HistoryRequest) (*HistoryReply, error)
+	InviteCodeCreate(context.Context, *InviteCodeCreateRequest) (*InviteCodeCreateReply, error)
+	InviteCodeList(context.Context, *InviteCodeListRequest) (*InviteCodeListReply, error)
+	// Locale 当前语言和支持的语言
+	Locale(context.Context, *LocaleRequest) (*LocaleReply, error)
+	// LocaleChange 设置语言，优先于 Accept-Language，为空时恢复按请求头选择
+	LocaleChange(context.Context, *LocaleChangeRequest) (*LocaleChangeReply, error)
 	PasswordChange(context.Context, *PasswordChangeRequest) (*PasswordChangeReply, error)
+	// PushTicket 推送连接凭证，一次性，有效期很短，EventSource 不能设置请求头时放在 ticket 参数中
+	PushTicket(context.Context, *PushTicketRequest) (*PushTicketReply, error)
 	RecommendList(context.Context, *RecommendListRequest) (*RecommendListReply, error)
 	RecommendRewardList(context.Context, *RecommendRewardListRequest) (*RecommendRewardListReply, error)
 	RecommendUpdate(context.Context, *RecommendUpdateRequest) (*RecommendUpdateReply, error)
@@ -93,6 +147,9 @@ type AppHTTPServer interface {
 	UserArea(context.Context, *UserAreaRequest) (*UserAreaReply, error)
 	UserInfo(context.Context, *UserInfoRequest) (*UserInfoReply, error)
 	Withdraw(context.Context, *WithdrawRequest) (*WithdrawReply, error)
+	WithdrawAddressCreate(context.Context, *WithdrawAddressCreateRequest) (*WithdrawAddressCreateReply, error)
+	WithdrawAddressDelete(context.Context, *WithdrawAddressDeleteRequest) (*WithdrawAddressDeleteReply, error)
+	WithdrawAddressList(context.Context, *WithdrawAddressListRequest) (*WithdrawAddressListReply, error)
 	WithdrawList(context.Context, *WithdrawListRequest) (*WithdrawListReply, error)
 }
 
@@ -107,9 +164,14 @@ func RegisterAppHTTPServer(s *http.Server, srv AppHTTPServer) {
 	r.GET("/api/app_server/fee_reward_list", _App_FeeRewardList0_HTTP_Handler(srv))
 	r.GET("/api/app_server/withdraw_list", _App_WithdrawList0_HTTP_Handler(srv))
 	r.GET("/api/app_server/trade_list", _App_TradeList0_HTTP_Handler(srv))
+	r.POST("/api/app_server/statement", _App_CreateStatement0_HTTP_Handler(srv))
+	r.GET("/api/app_server/statement", _App_GetStatement0_HTTP_Handler(srv))
+	r.GET("/api/app_server/history", _App_History0_HTTP_Handler(srv))
+	r.GET("/api/app_server/fee_preview", _App_FeePreview0_HTTP_Handler(srv))
 	r.GET("/api/app_server/tran_list", _App_TranList0_HTTP_Handler(srv))
 	r.GET("/api/app_server/recommend_list", _App_RecommendList0_HTTP_Handler(srv))
 	r.POST("/api/app_server/password_change", _App_PasswordChange0_HTTP_Handler(srv))
+	r.POST("/api/app_server/buy_location", _App_BuyLocation0_HTTP_Handler(srv))
 	r.POST("/api/app_server/withdraw", _App_Withdraw0_HTTP_Handler(srv))
 	r.POST("/api/app_server/exchange", _App_Exchange0_HTTP_Handler(srv))
 	r.POST("/api/app_server/trade", _App_Trade0_HTTP_Handler(srv))
@@ -117,8 +179,30 @@ func RegisterAppHTTPServer(s *http.Server, srv AppHTTPServer) {
 	r.POST("/api/app_server/get_trade", _App_GetTrade0_HTTP_Handler(srv))
 	r.POST("/api/app_server/set_balance_reward", _App_SetBalanceReward0_HTTP_Handler(srv))
 	r.POST("/api/app_server/delete_balance_reward", _App_DeleteBalanceReward0_HTTP_Handler(srv))
+	r.GET("/api/app_server/balance_reward_summary", _App_BalanceRewardSummary0_HTTP_Handler(srv))
+	r.GET("/api/app_server/withdraw_address_list", _App_WithdrawAddressList0_HTTP_Handler(srv))
+	r.POST("/api/app_server/withdraw_address_create", _App_WithdrawAddressCreate0_HTTP_Handler(srv))
+	r.POST("/api/app_server/withdraw_address_delete", _App_WithdrawAddressDelete0_HTTP_Handler(srv))
+	r.GET("/api/app_server/invite_code_list", _App_InviteCodeList0_HTTP_Handler(srv))
+	r.POST("/api/app_server/invite_code_create", _App_InviteCodeCreate0_HTTP_Handler(srv))
+	r.GET("/api/app_server/locale", _App_Locale0_HTTP_Handler(srv))
+	r.POST("/api/app_server/locale_change", _App_LocaleChange0_HTTP_Handler(srv))
+	r.POST("/api/app_server/push_ticket", _App_PushTicket0_HTTP_Handler(srv))
+	r.POST("/api/admin_dhb/invite_code_create", _App_AdminInviteCodeCreate0_HTTP_Handler(srv))
+	r.POST("/api/admin_dhb/password_update", _App_AdminPasswordUpdate0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/commission_simulate", _App_AdminCommissionSimulate0_HTTP_Handler(srv))
+	r.POST("/api/admin_dhb/area_level", _App_AdminAreaLevel0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/job_run_list", _App_AdminJobRunList0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/location_transition_list", _App_AdminLocationTransitionList0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/matrix_replay", _App_AdminMatrixReplay0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/webhook_list", _App_AdminWebhookList0_HTTP_Handler(srv))
+	r.POST("/api/admin_dhb/webhook_create", _App_AdminWebhookCreate0_HTTP_Handler(srv))
+	r.POST("/api/admin_dhb/webhook_update", _App_AdminWebhookUpdate0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/webhook_delivery_list", _App_AdminWebhookDeliveryList0_HTTP_Handler(srv))
+	r.POST("/api/admin_dhb/webhook_replay", _App_AdminWebhookReplay0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/cache_stats", _App_AdminCacheStats0_HTTP_Handler(srv))
 	r.GET("/api/admin_dhb/deposit", _App_Deposit0_HTTP_Handler(srv))
-	r.GET("/api/admin_dhb/withdraw", _App_AdminWithdraw0_HTTP_Handler(srv))
+	r.POST("/api/admin_dhb/withdraw", _App_AdminWithdraw0_HTTP_Handler(srv))
 	r.GET("/api/admin_dhb/withdraw_eth", _App_AdminWithdrawEth0_HTTP_Handler(srv))
 	r.GET("/api/admin_dhb/fee", _App_AdminFee0_HTTP_Handler(srv))
 	r.GET("/api/app_server/token_withdraw", _App_TokenWithdraw0_HTTP_Handler(srv))
@@ -301,6 +385,85 @@ func _App_TradeList0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) erro
 	}
 }
 
+func _App_CreateStatement0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateStatementRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppCreateStatement)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateStatement(ctx, req.(*CreateStatementRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CreateStatementReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_GetStatement0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetStatementRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppGetStatement)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetStatement(ctx, req.(*GetStatementRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CreateStatementReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_History0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in HistoryRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppHistory)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.History(ctx, req.(*HistoryRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*HistoryReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_FeePreview0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in FeePreviewRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppFeePreview)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.FeePreview(ctx, req.(*FeePreviewRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*FeePreviewReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _App_TranList0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in TranListRequest
@@ -361,6 +524,28 @@ func _App_PasswordChange0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context)
 	}
 }
 
+func _App_BuyLocation0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in BuyLocationRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppBuyLocation)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.BuyLocation(ctx, req.(*BuyLocationRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*BuyLocationReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _App_Withdraw0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in WithdrawRequest
@@ -502,15 +687,466 @@ func _App_DeleteBalanceReward0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Con
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationAppDeleteBalanceReward)
+		http.SetOperation(ctx, OperationAppDeleteBalanceReward)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteBalanceReward(ctx, req.(*DeleteBalanceRewardRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeleteBalanceRewardReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_BalanceRewardSummary0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in BalanceRewardSummaryRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppBalanceRewardSummary)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.BalanceRewardSummary(ctx, req.(*BalanceRewardSummaryRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*BalanceRewardSummaryReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_WithdrawAddressList0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in WithdrawAddressListRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppWithdrawAddressList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.WithdrawAddressList(ctx, req.(*WithdrawAddressListRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*WithdrawAddressListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_WithdrawAddressCreate0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in WithdrawAddressCreateRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppWithdrawAddressCreate)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.WithdrawAddressCreate(ctx, req.(*WithdrawAddressCreateRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*WithdrawAddressCreateReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_WithdrawAddressDelete0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in WithdrawAddressDeleteRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppWithdrawAddressDelete)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.WithdrawAddressDelete(ctx, req.(*WithdrawAddressDeleteRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*WithdrawAddressDeleteReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_InviteCodeList0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in InviteCodeListRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppInviteCodeList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.InviteCodeList(ctx, req.(*InviteCodeListRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*InviteCodeListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_InviteCodeCreate0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in InviteCodeCreateRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppInviteCodeCreate)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.InviteCodeCreate(ctx, req.(*InviteCodeCreateRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*InviteCodeCreateReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_Locale0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in LocaleRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppLocale)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Locale(ctx, req.(*LocaleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*LocaleReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_LocaleChange0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in LocaleChangeRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppLocaleChange)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.LocaleChange(ctx, req.(*LocaleChangeRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*LocaleChangeReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_PushTicket0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in PushTicketRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppPushTicket)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.PushTicket(ctx, req.(*PushTicketRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*PushTicketReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminInviteCodeCreate0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminInviteCodeCreateRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminInviteCodeCreate)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminInviteCodeCreate(ctx, req.(*AdminInviteCodeCreateRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminInviteCodeCreateReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminPasswordUpdate0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminPasswordUpdateRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminPasswordUpdate)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminPasswordUpdate(ctx, req.(*AdminPasswordUpdateRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminPasswordUpdateReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminCommissionSimulate0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminCommissionSimulateRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminCommissionSimulate)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminCommissionSimulate(ctx, req.(*AdminCommissionSimulateRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminCommissionSimulateReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminAreaLevel0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminAreaLevelRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminAreaLevel)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminAreaLevel(ctx, req.(*AdminAreaLevelRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminAreaLevelReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminJobRunList0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminJobRunListRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminJobRunList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminJobRunList(ctx, req.(*AdminJobRunListRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminJobRunListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminLocationTransitionList0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminLocationTransitionListRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminLocationTransitionList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminLocationTransitionList(ctx, req.(*AdminLocationTransitionListRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminLocationTransitionListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminMatrixReplay0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminMatrixReplayRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminMatrixReplay)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminMatrixReplay(ctx, req.(*AdminMatrixReplayRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminMatrixReplayReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminWebhookList0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminWebhookListRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminWebhookList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminWebhookList(ctx, req.(*AdminWebhookListRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminWebhookListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminWebhookCreate0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminWebhookCreateRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminWebhookCreate)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminWebhookCreate(ctx, req.(*AdminWebhookCreateRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminWebhookCreateReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminWebhookUpdate0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminWebhookUpdateRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminWebhookUpdate)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminWebhookUpdate(ctx, req.(*AdminWebhookUpdateRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminWebhookUpdateReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminWebhookDeliveryList0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminWebhookDeliveryListRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminWebhookDeliveryList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminWebhookDeliveryList(ctx, req.(*AdminWebhookDeliveryListRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminWebhookDeliveryListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminWebhookReplay0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminWebhookReplayRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminWebhookReplay)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminWebhookReplay(ctx, req.(*AdminWebhookReplayRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminWebhookReplayReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _App_AdminCacheStats0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminCacheStatsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAppAdminCacheStats)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.DeleteBalanceReward(ctx, req.(*DeleteBalanceRewardRequest))
+			return srv.AdminCacheStats(ctx, req.(*AdminCacheStatsRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*DeleteBalanceRewardReply)
+		reply := out.(*AdminCacheStatsReply)
 		return ctx.Result(200, reply)
 	}
 }
@@ -537,6 +1173,9 @@ func _App_Deposit0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error
 func _App_AdminWithdraw0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in AdminWithdrawRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
@@ -611,16 +1250,40 @@ func _App_TokenWithdraw0_HTTP_Handler(srv AppHTTPServer) func(ctx http.Context)
 }
 
 type AppHTTPClient interface {
+	AdminAreaLevel(ctx context.Context, req *AdminAreaLevelRequest, opts ...http.CallOption) (rsp *AdminAreaLevelReply, err error)
+	AdminCacheStats(ctx context.Context, req *AdminCacheStatsRequest, opts ...http.CallOption) (rsp *AdminCacheStatsReply, err error)
+	AdminCommissionSimulate(ctx context.Context, req *AdminCommissionSimulateRequest, opts ...http.CallOption) (rsp *AdminCommissionSimulateReply, err error)
 	AdminFee(ctx context.Context, req *AdminFeeRequest, opts ...http.CallOption) (rsp *AdminFeeReply, err error)
+	AdminInviteCodeCreate(ctx context.Context, req *AdminInviteCodeCreateRequest, opts ...http.CallOption) (rsp *AdminInviteCodeCreateReply, err error)
+	AdminJobRunList(ctx context.Context, req *AdminJobRunListRequest, opts ...http.CallOption) (rsp *AdminJobRunListReply, err error)
+	AdminLocationTransitionList(ctx context.Context, req *AdminLocationTransitionListRequest, opts ...http.CallOption) (rsp *AdminLocationTransitionListReply, err error)
+	AdminMatrixReplay(ctx context.Context, req *AdminMatrixReplayRequest, opts ...http.CallOption) (rsp *AdminMatrixReplayReply, err error)
+	AdminPasswordUpdate(ctx context.Context, req *AdminPasswordUpdateRequest, opts ...http.CallOption) (rsp *AdminPasswordUpdateReply, err error)
+	AdminWebhookCreate(ctx context.Context, req *AdminWebhookCreateRequest, opts ...http.CallOption) (rsp *AdminWebhookCreateReply, err error)
+	AdminWebhookDeliveryList(ctx context.Context, req *AdminWebhookDeliveryListRequest, opts ...http.CallOption) (rsp *AdminWebhookDeliveryListReply, err error)
+	AdminWebhookList(ctx context.Context, req *AdminWebhookListRequest, opts ...http.CallOption) (rsp *AdminWebhookListReply, err error)
+	AdminWebhookReplay(ctx context.Context, req *AdminWebhookReplayRequest, opts ...http.CallOption) (rsp *AdminWebhookReplayReply, err error)
+	AdminWebhookUpdate(ctx context.Context, req *AdminWebhookUpdateRequest, opts ...http.CallOption) (rsp *AdminWebhookUpdateReply, err error)
 	AdminWithdraw(ctx context.Context, req *AdminWithdrawRequest, opts ...http.CallOption) (rsp *AdminWithdrawReply, err error)
 	AdminWithdrawEth(ctx context.Context, req *AdminWithdrawEthRequest, opts ...http.CallOption) (rsp *AdminWithdrawEthReply, err error)
+	BalanceRewardSummary(ctx context.Context, req *BalanceRewardSummaryRequest, opts ...http.CallOption) (rsp *BalanceRewardSummaryReply, err error)
+	BuyLocation(ctx context.Context, req *BuyLocationRequest, opts ...http.CallOption) (rsp *BuyLocationReply, err error)
+	CreateStatement(ctx context.Context, req *CreateStatementRequest, opts ...http.CallOption) (rsp *CreateStatementReply, err error)
 	DeleteBalanceReward(ctx context.Context, req *DeleteBalanceRewardRequest, opts ...http.CallOption) (rsp *DeleteBalanceRewardReply, err error)
 	Deposit(ctx context.Context, req *DepositRequest, opts ...http.CallOption) (rsp *DepositReply, err error)
 	EthAuthorize(ctx context.Context, req *EthAuthorizeRequest, opts ...http.CallOption) (rsp *EthAuthorizeReply, err error)
 	Exchange(ctx context.Context, req *ExchangeRequest, opts ...http.CallOption) (rsp *ExchangeReply, err error)
+	FeePreview(ctx context.Context, req *FeePreviewRequest, opts ...http.CallOption) (rsp *FeePreviewReply, err error)
 	FeeRewardList(ctx context.Context, req *FeeRewardListRequest, opts ...http.CallOption) (rsp *FeeRewardListReply, err error)
+	GetStatement(ctx context.Context, req *GetStatementRequest, opts ...http.CallOption) (rsp *CreateStatementReply, err error)
 	GetTrade(ctx context.Context, req *GetTradeRequest, opts ...http.CallOption) (rsp *GetTradeReply, err error)
+	History(ctx context.Context, req *HistoryRequest, opts ...http.CallOption) (rsp *HistoryReply, err error)
+	InviteCodeCreate(ctx context.Context, req *InviteCodeCreateRequest, opts ...http.CallOption) (rsp *InviteCodeCreateReply, err error)
+	InviteCodeList(ctx context.Context, req *InviteCodeListRequest, opts ...http.CallOption) (rsp *InviteCodeListReply, err error)
+	Locale(ctx context.Context, req *LocaleRequest, opts ...http.CallOption) (rsp *LocaleReply, err error)
+	LocaleChange(ctx context.Context, req *LocaleChangeRequest, opts ...http.CallOption) (rsp *LocaleChangeReply, err error)
 	PasswordChange(ctx context.Context, req *PasswordChangeRequest, opts ...http.CallOption) (rsp *PasswordChangeReply, err error)
+	PushTicket(ctx context.Context, req *PushTicketRequest, opts ...http.CallOption) (rsp *PushTicketReply, err error)
 	RecommendList(ctx context.Context, req *RecommendListRequest, opts ...http.CallOption) (rsp *RecommendListReply, err error)
 	RecommendRewardList(ctx context.Context, req *RecommendRewardListRequest, opts ...http.CallOption) (rsp *RecommendRewardListReply, err error)
 	RecommendUpdate(ctx context.Context, req *RecommendUpdateRequest, opts ...http.CallOption) (rsp *RecommendUpdateReply, err error)
@@ -634,6 +1297,9 @@ type AppHTTPClient interface {
 	UserArea(ctx context.Context, req *UserAreaRequest, opts ...http.CallOption) (rsp *UserAreaReply, err error)
 	UserInfo(ctx context.Context, req *UserInfoRequest, opts ...http.CallOption) (rsp *UserInfoReply, err error)
 	Withdraw(ctx context.Context, req *WithdrawRequest, opts ...http.CallOption) (rsp *WithdrawReply, err error)
+	WithdrawAddressCreate(ctx context.Context, req *WithdrawAddressCreateRequest, opts ...http.CallOption) (rsp *WithdrawAddressCreateReply, err error)
+	WithdrawAddressDelete(ctx context.Context, req *WithdrawAddressDeleteRequest, opts ...http.CallOption) (rsp *WithdrawAddressDeleteReply, err error)
+	WithdrawAddressList(ctx context.Context, req *WithdrawAddressListRequest, opts ...http.CallOption) (rsp *WithdrawAddressListReply, err error)
 	WithdrawList(ctx context.Context, req *WithdrawListRequest, opts ...http.CallOption) (rsp *WithdrawListReply, err error)
 }
 
@@ -645,6 +1311,45 @@ func NewAppHTTPClient(client *http.Client) AppHTTPClient {
 	return &AppHTTPClientImpl{client}
 }
 
+func (c *AppHTTPClientImpl) AdminAreaLevel(ctx context.Context, in *AdminAreaLevelRequest, opts ...http.CallOption) (*AdminAreaLevelReply, error) {
+	var out AdminAreaLevelReply
+	pattern := "/api/admin_dhb/area_level"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAppAdminAreaLevel))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminCacheStats(ctx context.Context, in *AdminCacheStatsRequest, opts ...http.CallOption) (*AdminCacheStatsReply, error) {
+	var out AdminCacheStatsReply
+	pattern := "/api/admin_dhb/cache_stats"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppAdminCacheStats))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminCommissionSimulate(ctx context.Context, in *AdminCommissionSimulateRequest, opts ...http.CallOption) (*AdminCommissionSimulateReply, error) {
+	var out AdminCommissionSimulateReply
+	pattern := "/api/admin_dhb/commission_simulate"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppAdminCommissionSimulate))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *AppHTTPClientImpl) AdminFee(ctx context.Context, in *AdminFeeRequest, opts ...http.CallOption) (*AdminFeeReply, error) {
 	var out AdminFeeReply
 	pattern := "/api/admin_dhb/fee"
@@ -658,13 +1363,143 @@ func (c *AppHTTPClientImpl) AdminFee(ctx context.Context, in *AdminFeeRequest, o
 	return &out, err
 }
 
+func (c *AppHTTPClientImpl) AdminInviteCodeCreate(ctx context.Context, in *AdminInviteCodeCreateRequest, opts ...http.CallOption) (*AdminInviteCodeCreateReply, error) {
+	var out AdminInviteCodeCreateReply
+	pattern := "/api/admin_dhb/invite_code_create"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAppAdminInviteCodeCreate))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminJobRunList(ctx context.Context, in *AdminJobRunListRequest, opts ...http.CallOption) (*AdminJobRunListReply, error) {
+	var out AdminJobRunListReply
+	pattern := "/api/admin_dhb/job_run_list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppAdminJobRunList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminLocationTransitionList(ctx context.Context, in *AdminLocationTransitionListRequest, opts ...http.CallOption) (*AdminLocationTransitionListReply, error) {
+	var out AdminLocationTransitionListReply
+	pattern := "/api/admin_dhb/location_transition_list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppAdminLocationTransitionList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminMatrixReplay(ctx context.Context, in *AdminMatrixReplayRequest, opts ...http.CallOption) (*AdminMatrixReplayReply, error) {
+	var out AdminMatrixReplayReply
+	pattern := "/api/admin_dhb/matrix_replay"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppAdminMatrixReplay))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminPasswordUpdate(ctx context.Context, in *AdminPasswordUpdateRequest, opts ...http.CallOption) (*AdminPasswordUpdateReply, error) {
+	var out AdminPasswordUpdateReply
+	pattern := "/api/admin_dhb/password_update"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAppAdminPasswordUpdate))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminWebhookCreate(ctx context.Context, in *AdminWebhookCreateRequest, opts ...http.CallOption) (*AdminWebhookCreateReply, error) {
+	var out AdminWebhookCreateReply
+	pattern := "/api/admin_dhb/webhook_create"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAppAdminWebhookCreate))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminWebhookDeliveryList(ctx context.Context, in *AdminWebhookDeliveryListRequest, opts ...http.CallOption) (*AdminWebhookDeliveryListReply, error) {
+	var out AdminWebhookDeliveryListReply
+	pattern := "/api/admin_dhb/webhook_delivery_list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppAdminWebhookDeliveryList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminWebhookList(ctx context.Context, in *AdminWebhookListRequest, opts ...http.CallOption) (*AdminWebhookListReply, error) {
+	var out AdminWebhookListReply
+	pattern := "/api/admin_dhb/webhook_list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppAdminWebhookList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminWebhookReplay(ctx context.Context, in *AdminWebhookReplayRequest, opts ...http.CallOption) (*AdminWebhookReplayReply, error) {
+	var out AdminWebhookReplayReply
+	pattern := "/api/admin_dhb/webhook_replay"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAppAdminWebhookReplay))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) AdminWebhookUpdate(ctx context.Context, in *AdminWebhookUpdateRequest, opts ...http.CallOption) (*AdminWebhookUpdateReply, error) {
+	var out AdminWebhookUpdateReply
+	pattern := "/api/admin_dhb/webhook_update"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAppAdminWebhookUpdate))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *AppHTTPClientImpl) AdminWithdraw(ctx context.Context, in *AdminWithdrawRequest, opts ...http.CallOption) (*AdminWithdrawReply, error) {
 	var out AdminWithdrawReply
 	pattern := "/api/admin_dhb/withdraw"
-	path := binding.EncodeURL(pattern, in, true)
+	path := binding.EncodeURL(pattern, in, false)
 	opts = append(opts, http.Operation(OperationAppAdminWithdraw))
 	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -684,6 +1519,45 @@ func (c *AppHTTPClientImpl) AdminWithdrawEth(ctx context.Context, in *AdminWithd
 	return &out, err
 }
 
+func (c *AppHTTPClientImpl) BalanceRewardSummary(ctx context.Context, in *BalanceRewardSummaryRequest, opts ...http.CallOption) (*BalanceRewardSummaryReply, error) {
+	var out BalanceRewardSummaryReply
+	pattern := "/api/app_server/balance_reward_summary"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppBalanceRewardSummary))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) BuyLocation(ctx context.Context, in *BuyLocationRequest, opts ...http.CallOption) (*BuyLocationReply, error) {
+	var out BuyLocationReply
+	pattern := "/api/app_server/buy_location"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAppBuyLocation))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) CreateStatement(ctx context.Context, in *CreateStatementRequest, opts ...http.CallOption) (*CreateStatementReply, error) {
+	var out CreateStatementReply
+	pattern := "/api/app_server/statement"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAppCreateStatement))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *AppHTTPClientImpl) DeleteBalanceReward(ctx context.Context, in *DeleteBalanceRewardRequest, opts ...http.CallOption) (*DeleteBalanceRewardReply, error) {
 	var out DeleteBalanceRewardReply
 	pattern := "/api/app_server/delete_balance_reward"
@@ -736,6 +1610,19 @@ func (c *AppHTTPClientImpl) Exchange(ctx context.Context, in *ExchangeRequest, o
 	return &out, err
 }
 
+func (c *AppHTTPClientImpl) FeePreview(ctx context.Context, in *FeePreviewRequest, opts ...http.CallOption) (*FeePreviewReply, error) {
+	var out FeePreviewReply
+	pattern := "/api/app_server/fee_preview"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppFeePreview))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *AppHTTPClientImpl) FeeRewardList(ctx context.Context, in *FeeRewardListRequest, opts ...http.CallOption) (*FeeRewardListReply, error) {
 	var out FeeRewardListReply
 	pattern := "/api/app_server/fee_reward_list"
@@ -749,6 +1636,19 @@ func (c *AppHTTPClientImpl) FeeRewardList(ctx context.Context, in *FeeRewardList
 	return &out, err
 }
 
+func (c *AppHTTPClientImpl) GetStatement(ctx context.Context, in *GetStatementRequest, opts ...http.CallOption) (*CreateStatementReply, error) {
+	var out CreateStatementReply
+	pattern := "/api/app_server/statement"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppGetStatement))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *AppHTTPClientImpl) GetTrade(ctx context.Context, in *GetTradeRequest, opts ...http.CallOption) (*GetTradeReply, error) {
 	var out GetTradeReply
 	pattern := "/api/app_server/get_trade"
@@ -762,6 +1662,71 @@ func (c *AppHTTPClientImpl) GetTrade(ctx context.Context, in *GetTradeRequest, o
 	return &out, err
 }
 
+func (c *AppHTTPClientImpl) History(ctx context.Context, in *HistoryRequest, opts ...http.CallOption) (*HistoryReply, error) {
+	var out HistoryReply
+	pattern := "/api/app_server/history"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppHistory))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) InviteCodeCreate(ctx context.Context, in *InviteCodeCreateRequest, opts ...http.CallOption) (*InviteCodeCreateReply, error) {
+	var out InviteCodeCreateReply
+	pattern := "/api/app_server/invite_code_create"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAppInviteCodeCreate))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) InviteCodeList(ctx context.Context, in *InviteCodeListRequest, opts ...http.CallOption) (*InviteCodeListReply, error) {
+	var out InviteCodeListReply
+	pattern := "/api/app_server/invite_code_list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppInviteCodeList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) Locale(ctx context.Context, in *LocaleRequest, opts ...http.CallOption) (*LocaleReply, error) {
+	var out LocaleReply
+	pattern := "/api/app_server/locale"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppLocale))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) LocaleChange(ctx context.Context, in *LocaleChangeRequest, opts ...http.CallOption) (*LocaleChangeReply, error) {
+	var out LocaleChangeReply
+	pattern := "/api/app_server/locale_change"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAppLocaleChange))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *AppHTTPClientImpl) PasswordChange(ctx context.Context, in *PasswordChangeRequest, opts ...http.CallOption) (*PasswordChangeReply, error) {
 	var out PasswordChangeReply
 	pattern := "/api/app_server/password_change"
@@ -775,6 +1740,19 @@ func (c *AppHTTPClientImpl) PasswordChange(ctx context.Context, in *PasswordChan
 	return &out, err
 }
 
+func (c *AppHTTPClientImpl) PushTicket(ctx context.Context, in *PushTicketRequest, opts ...http.CallOption) (*PushTicketReply, error) {
+	var out PushTicketReply
+	pattern := "/api/app_server/push_ticket"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAppPushTicket))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *AppHTTPClientImpl) RecommendList(ctx context.Context, in *RecommendListRequest, opts ...http.CallOption) (*RecommendListReply, error) {
 	var out RecommendListReply
 	pattern := "/api/app_server/recommend_list"
@@ -944,6 +1922,45 @@ func (c *AppHTTPClientImpl) Withdraw(ctx context.Context, in *WithdrawRequest, o
 	return &out, err
 }
 
+func (c *AppHTTPClientImpl) WithdrawAddressCreate(ctx context.Context, in *WithdrawAddressCreateRequest, opts ...http.CallOption) (*WithdrawAddressCreateReply, error) {
+	var out WithdrawAddressCreateReply
+	pattern := "/api/app_server/withdraw_address_create"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAppWithdrawAddressCreate))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) WithdrawAddressDelete(ctx context.Context, in *WithdrawAddressDeleteRequest, opts ...http.CallOption) (*WithdrawAddressDeleteReply, error) {
+	var out WithdrawAddressDeleteReply
+	pattern := "/api/app_server/withdraw_address_delete"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAppWithdrawAddressDelete))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *AppHTTPClientImpl) WithdrawAddressList(ctx context.Context, in *WithdrawAddressListRequest, opts ...http.CallOption) (*WithdrawAddressListReply, error) {
+	var out WithdrawAddressListReply
+	pattern := "/api/app_server/withdraw_address_list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAppWithdrawAddressList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *AppHTTPClientImpl) WithdrawList(ctx context.Context, in *WithdrawListRequest, opts ...http.CallOption) (*WithdrawListReply, error) {
 	var out WithdrawListReply
 	pattern := "/api/app_server/withdraw_list"
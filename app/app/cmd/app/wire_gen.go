@@ -23,10 +23,14 @@ import (
 // Injectors from wire.go:
 
 // wireApp init kratos application.
-func wireApp(confServer *conf.Server, confData *conf.Data, auth *conf.Auth, logger log.Logger) (*kratos.App, func(), error) {
+func wireApp(confServer *conf.Server, confData *conf.Data, auth *conf.Auth, business *conf.Business, scheduler *conf.Scheduler, statement *conf.Statement, internal *conf.Internal, events *conf.Events, push *conf.Push, rateLimit *conf.RateLimit, i18N *conf.I18N, logger log.Logger) (*kratos.App, func(), error) {
 	db := data.NewDB(confData)
 	client := data.NewRedis(confData)
-	dataData, cleanup, err := data.NewData(confData, logger, db, client)
+	businessDay, err := data.NewBusinessDay(business)
+	if err != nil {
+		return nil, nil, err
+	}
+	dataData, cleanup, err := data.NewData(confData, logger, db, client, businessDay)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -38,12 +42,73 @@ func wireApp(confServer *conf.Server, confData *conf.Data, auth *conf.Auth, logg
 	locationRepo := data.NewLocationRepo(dataData, logger)
 	userCurrentMonthRecommendRepo := data.NewUserCurrentMonthRecommendRepo(dataData, logger)
 	userBalanceRepo := data.NewUserBalanceRepo(dataData, logger)
-	userUseCase := biz.NewUserUseCase(userRepo, transaction, configRepo, userInfoRepo, userRecommendRepo, locationRepo, userCurrentMonthRecommendRepo, userBalanceRepo, logger)
+	userInviteCodeRepo := data.NewUserInviteCodeRepo(dataData, logger)
+	withdrawAddressRepo := data.NewWithdrawAddressRepo(dataData, logger)
+	positionRepo := data.NewPositionRepo(dataData, logger)
+	positionUseCase := biz.NewPositionUseCase(positionRepo, transaction, businessDay, logger)
+	matrixRepo := data.NewMatrixRepo(dataData, logger)
+	matrixUseCase := biz.NewMatrixUseCase(matrixRepo, locationRepo, configRepo, transaction, logger)
+	outboxRepo := data.NewOutboxRepo(dataData, logger)
+	jobRepo := data.NewJobRepo(dataData, logger)
+	eventConfig := data.NewEventConfig(events)
+	eventSinks := data.NewEventSinks(events, dataData, logger)
+	eventBus := biz.NewEventBus(outboxRepo, jobRepo, eventConfig, eventSinks, logger)
+	userUseCase := biz.NewUserUseCase(userRepo, transaction, configRepo, userInfoRepo, userRecommendRepo, locationRepo, userCurrentMonthRecommendRepo, userBalanceRepo, userInviteCodeRepo, withdrawAddressRepo, positionUseCase, matrixUseCase, eventBus, businessDay, logger)
 	ethUserRecordRepo := data.NewEthUserRecordRepo(dataData, logger)
 	recordUseCase := biz.NewRecordUseCase(ethUserRecordRepo, locationRepo, userBalanceRepo, userRecommendRepo, userInfoRepo, configRepo, userCurrentMonthRecommendRepo, transaction, logger)
-	appService := service.NewAppService(userUseCase, recordUseCase, logger, auth)
-	httpServer := server.NewHTTPServer(confServer, appService, logger)
-	app := newApp(logger, httpServer)
+	areaRepo := data.NewAreaRepo(dataData, logger)
+	areaUseCase := biz.NewAreaUseCase(areaRepo, userRecommendRepo, locationRepo, userBalanceRepo, configRepo, userRepo, positionUseCase, eventBus, transaction, businessDay, logger)
+	statementRepo := data.NewStatementRepo(dataData, statement, logger)
+	statementConfig, err := data.NewStatementConfig(statement)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	statementUseCase := biz.NewStatementUseCase(statementRepo, businessDay, statementConfig, logger)
+	webhookRepo := data.NewWebhookRepo(dataData, logger)
+	webhookUseCase := biz.NewWebhookUseCase(webhookRepo, eventBus, businessDay, logger)
+	jobUseCase := biz.NewJobUseCase(jobRepo, businessDay, areaUseCase, userUseCase, positionUseCase, matrixUseCase, statementUseCase, webhookUseCase, logger)
+	cacheRepo := data.NewCacheRepo(dataData)
+	cacheUseCase := biz.NewCacheUseCase(cacheRepo, logger)
+	pushTicketRepo := data.NewPushTicketRepo(dataData, logger)
+	pushConfig := data.NewPushConfig(push)
+	pushHub := biz.NewPushHub(outboxRepo, pushTicketRepo, userBalanceRepo, configRepo, eventConfig, pushConfig, logger)
+	catalog, err := server.NewCatalog(i18N)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	appService := service.NewAppService(userUseCase, recordUseCase, areaUseCase, jobUseCase, positionUseCase, matrixUseCase, statementUseCase, webhookUseCase, cacheUseCase, pushHub, catalog, logger, auth)
+	pushService := service.NewPushService(pushHub, auth, push, catalog, logger)
+	rateLimitRepo := data.NewRateLimitRepo(dataData, rateLimit, logger)
+	rateLimitConfig, err := data.NewRateLimitConfig(rateLimit)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	rateLimiter := biz.NewRateLimiter(rateLimitRepo, rateLimitConfig, logger)
+	httpServer := server.NewHTTPServer(confServer, appService, pushService, rateLimiter, catalog, userUseCase, logger)
+	grpcServer, err := server.NewGRPCServer(confServer, appService, rateLimiter, catalog, userUseCase, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	internalTransferRepo := data.NewInternalTransferRepo(dataData, logger)
+	internalUseCase := biz.NewInternalUseCase(userRepo, userInfoRepo, userBalanceRepo, userRecommendRepo, internalTransferRepo, userUseCase, eventBus, transaction, businessDay, logger)
+	internalService := service.NewInternalService(internalUseCase, logger)
+	internalServer, err := server.NewInternalServer(internal, internalService, internalUseCase, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	jobServer, err := server.NewJobServer(scheduler, jobUseCase, businessDay, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	eventServer := server.NewEventServer(events, eventBus, logger)
+	pushServer := server.NewPushServer(push, pushHub, logger)
+	app := newApp(logger, httpServer, grpcServer, internalServer, jobServer, eventServer, pushServer)
 	return app, func() {
 		cleanup()
 	}, nil
@@ -0,0 +1,425 @@
+package biz
+
+import (
+	"context"
+	v1 "dhb/app/app/api"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/log"
+	"strconv"
+	"time"
+)
+
+type UserAreaLevelHistory struct {
+	ID            int64
+	BusinessDate  string
+	UserId        int64
+	OriginLevel   int64
+	Level         int64
+	BigAmount     int64 // 大区业绩
+	SmallAmount   int64 // 小区业绩
+	EffectiveDate time.Time
+	CreatedAt     time.Time
+}
+
+type AreaLevelRun struct {
+	ID           int64
+	BusinessDate string    // 评定的业务日，每天只能评定一次
+	StartAt      time.Time // 本次级差奖统计的业绩区间
+	EndAt        time.Time
+	UserNum      int64
+	Promotions   int64
+	Demotions    int64
+	BonusTotal   int64
+	CreatedAt    time.Time
+}
+
+// AreaAmount 用户的团队业绩
+type AreaAmount struct {
+	UserId      int64
+	SelfAmount  int64
+	TeamAmount  int64 // 不含自己
+	BigAmount   int64
+	SmallAmount int64
+}
+
+type AreaRepo interface {
+	GetAllUserAreas(ctx context.Context) (map[int64]*UserArea, error)
+	SaveUserArea(ctx context.Context, ua *UserArea) error
+	CreateUserAreaLevelHistory(ctx context.Context, h *UserAreaLevelHistory) error
+	GetLastAreaLevelRun(ctx context.Context) (*AreaLevelRun, error)
+	GetAreaLevelRunByDate(ctx context.Context, date string) (*AreaLevelRun, error)
+	CreateAreaLevelRun(ctx context.Context, r *AreaLevelRun) (*AreaLevelRun, error)
+	UpdateAreaLevelRun(ctx context.Context, r *AreaLevelRun) error
+}
+
+type AreaUseCase struct {
+	areaRepo     AreaRepo
+	urRepo       UserRecommendRepo
+	locationRepo LocationRepo
+	ubRepo       UserBalanceRepo
+	configRepo   ConfigRepo
+	repo         UserRepo
+	puc          *PositionUseCase
+	bus          *EventBus
+	tx           Transaction
+	day          *BusinessDay
+	log          *log.Helper
+}
+
+func NewAreaUseCase(areaRepo AreaRepo, urRepo UserRecommendRepo, locationRepo LocationRepo, ubRepo UserBalanceRepo, configRepo ConfigRepo, repo UserRepo, puc *PositionUseCase, bus *EventBus, tx Transaction, day *BusinessDay, logger log.Logger) *AreaUseCase {
+	return &AreaUseCase{
+		areaRepo:     areaRepo,
+		urRepo:       urRepo,
+		locationRepo: locationRepo,
+		ubRepo:       ubRepo,
+		configRepo:   configRepo,
+		repo:         repo,
+		puc:          puc,
+		bus:          bus,
+		tx:           tx,
+		day:          day,
+		log:          log.NewHelper(logger),
+	}
+}
+
+// areaLevelConfig 小区业绩门槛和级差比例(千分比)，下标 0 对应 1 级
+func (auc *AreaUseCase) areaLevelConfig(ctx context.Context) ([]int64, []int64) {
+	var (
+		keys       = []string{"area_one", "area_two", "area_three", "area_four", "area_five"}
+		rateKeys   = []string{"area_rate_one", "area_rate_two", "area_rate_three", "area_rate_four", "area_rate_five"}
+		thresholds = make([]int64, len(keys))
+		rates      = make([]int64, len(keys))
+	)
+
+	configs, _ := auc.configRepo.GetConfigByKeys(ctx, append(keys, rateKeys...)...)
+	if nil != configs {
+		for _, vConfig := range configs {
+			for i := range keys {
+				if keys[i] == vConfig.KeyName {
+					thresholds[i], _ = strconv.ParseInt(vConfig.Value+"00000", 10, 64)
+				}
+				if rateKeys[i] == vConfig.KeyName {
+					rates[i], _ = strconv.ParseInt(vConfig.Value, 10, 64)
+				}
+			}
+		}
+	}
+
+	return thresholds, rates
+}
+
+// AreaLevel 按小区业绩计算级别，门槛为 0 的级别不启用
+func AreaLevel(thresholds []int64, smallAmount int64) int64 {
+	var level int64
+	for i, v := range thresholds {
+		if 0 < v && v <= smallAmount {
+			level = int64(i + 1)
+		}
+	}
+
+	return level
+}
+
+// ComputeAreaAmounts 根据推荐关系和个人业绩计算每个用户的大小区业绩
+func ComputeAreaAmounts(userRecommends []*UserRecommend, selfAmounts map[int64]int64) map[int64]*AreaAmount {
+	var (
+		res      = make(map[int64]*AreaAmount, 0)
+		children = make(map[int64][]int64, 0)
+		depth    = make(map[int64]int, 0)
+		maxDepth int
+	)
+
+	for _, v := range userRecommends {
+		res[v.UserId] = &AreaAmount{UserId: v.UserId, SelfAmount: selfAmounts[v.UserId]}
+
+		ancestorIds := recommendCodeUserIds(v.RecommendCode)
+		depth[v.UserId] = len(ancestorIds)
+		if maxDepth < len(ancestorIds) {
+			maxDepth = len(ancestorIds)
+		}
+		if 0 < len(ancestorIds) {
+			parentId := ancestorIds[len(ancestorIds)-1]
+			children[parentId] = append(children[parentId], v.UserId)
+		}
+	}
+
+	// 从最深的一层往上汇总
+	byDepth := make([][]int64, maxDepth+1)
+	for userId, d := range depth {
+		byDepth[d] = append(byDepth[d], userId)
+	}
+	for d := maxDepth; d >= 0; d-- {
+		for _, userId := range byDepth[d] {
+			area := res[userId]
+			for _, childId := range children[userId] {
+				child, ok := res[childId]
+				if !ok {
+					continue
+				}
+
+				leg := child.SelfAmount + child.TeamAmount
+				area.TeamAmount += leg
+				if leg > area.BigAmount {
+					area.BigAmount = leg
+				}
+			}
+			area.SmallAmount = area.TeamAmount - area.BigAmount
+		}
+	}
+
+	return res
+}
+
+// EvaluateAreaLevel 团队级别评定：计算大小区业绩，更新级别，记录变动，并按新级别发放区间内新业绩的级差奖。
+// 区间截止到 date 业务日结束，同一业务日只能评定一次，由评定记录的唯一索引在发放事务中保证
+func (auc *AreaUseCase) EvaluateAreaLevel(ctx context.Context, date string) (*AreaLevelRun, []*UserAreaLevelHistory, error) {
+	var (
+		userRecommends []*UserRecommend
+		selfAmounts    map[int64]int64
+		userAreas      map[int64]*UserArea
+		lastRun        *AreaLevelRun
+		locations      []*LocationNew
+		now            time.Time
+		err            error
+	)
+
+	_, now, err = auc.day.Range(date)
+	if nil != err {
+		return nil, nil, v1.ErrorDateInvalid("日期格式错误")
+	}
+
+	lastRun, err = auc.areaRepo.GetLastAreaLevelRun(ctx)
+	if nil != err {
+		return nil, nil, err
+	}
+	if nil != lastRun && date <= lastRun.BusinessDate {
+		return nil, nil, v1.ErrorAreaLevelEvaluated("%s 已评定", date)
+	}
+
+	thresholds, rates := auc.areaLevelConfig(ctx)
+
+	userRecommends, err = auc.urRepo.GetUserRecommends(ctx)
+	if nil != err {
+		return nil, nil, err
+	}
+
+	selfAmounts, err = auc.locationRepo.GetLocationUsdtTotalGroupByUserId(ctx)
+	if nil != err {
+		return nil, nil, err
+	}
+
+	userAreas, err = auc.areaRepo.GetAllUserAreas(ctx)
+	if nil != err {
+		return nil, nil, err
+	}
+
+	run := &AreaLevelRun{BusinessDate: date, EndAt: now}
+	if nil != lastRun {
+		// 首次运行只评定级别，不补发历史级差奖
+		run.StartAt = lastRun.EndAt
+		locations, err = auc.locationRepo.GetLocationsByCreatedAt(ctx, run.StartAt, run.EndAt)
+		if nil != err {
+			return nil, nil, err
+		}
+	} else {
+		run.StartAt = now
+	}
+
+	areaAmounts := ComputeAreaAmounts(userRecommends, selfAmounts)
+	levels := make(map[int64]int64, 0)
+	changes := make([]*UserAreaLevelHistory, 0)
+	updates := make([]*UserArea, 0)
+	for userId, area := range areaAmounts {
+		var originLevel int64
+		origin, ok := userAreas[userId]
+		if ok {
+			originLevel = origin.Level
+		}
+
+		level := AreaLevel(thresholds, area.SmallAmount)
+		levels[userId] = level
+		run.UserNum++
+
+		if !ok || origin.Level != level || origin.Amount != area.TeamAmount || origin.SelfAmount != area.SelfAmount {
+			updates = append(updates, &UserArea{UserId: userId, Level: level, Amount: area.TeamAmount, SelfAmount: area.SelfAmount})
+		}
+
+		if originLevel == level {
+			continue
+		}
+		if level > originLevel {
+			run.Promotions++
+		} else {
+			run.Demotions++
+		}
+		changes = append(changes, &UserAreaLevelHistory{
+			BusinessDate:  date,
+			UserId:        userId,
+			OriginLevel:   originLevel,
+			Level:         level,
+			BigAmount:     area.BigAmount,
+			SmallAmount:   area.SmallAmount,
+			EffectiveDate: now,
+		})
+	}
+
+	// 级差奖：新业绩沿推荐链往上，每个上级拿自己比例减去下面已发比例的部分
+	recommendCodes := make(map[int64]string, 0)
+	for _, v := range userRecommends {
+		recommendCodes[v.UserId] = v.RecommendCode
+	}
+	bonuses := make([]*CommissionReward, 0)
+	bonusLocations := make([]int64, 0)
+	bonusSources := make([]int64, 0)
+	for _, vLocation := range locations {
+		ancestorIds := recommendCodeUserIds(recommendCodes[vLocation.UserId])
+		var paidRate int64
+		for i := len(ancestorIds) - 1; i >= 0; i-- {
+			level := levels[ancestorIds[i]]
+			if 0 >= level {
+				continue
+			}
+
+			rate := rates[level-1]
+			if rate <= paidRate {
+				continue
+			}
+
+			amount := vLocation.Usdt * (rate - paidRate) / 1000
+			paidRate = rate
+			if 0 >= amount {
+				continue
+			}
+
+			run.BonusTotal += amount
+			bonuses = append(bonuses, &CommissionReward{UserId: ancestorIds[i], Level: level, Amount: amount, Reason: "area"})
+			bonusLocations = append(bonusLocations, vLocation.ID)
+			bonusSources = append(bonusSources, vLocation.UserId)
+		}
+	}
+
+	if err = auc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		// 先写评定记录，并发评定同一天或同一区间时后到的在这里失败，不会重复发放
+		var created *AreaLevelRun
+		created, err = auc.areaRepo.CreateAreaLevelRun(ctx, run)
+		if nil != err {
+			return err
+		}
+		run.ID = created.ID
+		run.CreatedAt = created.CreatedAt
+
+		for _, v := range updates {
+			err = auc.areaRepo.SaveUserArea(ctx, v)
+			if nil != err {
+				return err
+			}
+		}
+
+		for _, v := range changes {
+			err = auc.areaRepo.CreateUserAreaLevelHistory(ctx, v)
+			if nil != err {
+				return err
+			}
+		}
+
+		for i, v := range bonuses {
+			// 级差奖计入仓位额度，超出部分不发放
+			var amount int64
+			amount, _, err = auc.puc.Credit(ctx, v.UserId, v.Amount, "area")
+			if nil != err {
+				return err
+			}
+			run.BonusTotal -= v.Amount - amount
+			if 0 >= amount {
+				continue
+			}
+
+			_, err = auc.ubRepo.CommissionReward(ctx, v.UserId, amount, "location", bonusLocations[i], bonusSources[i], v.Reason)
+			if nil != err {
+				return err
+			}
+
+			err = auc.bus.Publish(ctx, &RewardPaid{
+				UserId:     v.UserId,
+				Amount:     formatAmount(amount),
+				Source:     "area",
+				RecordId:   bonusLocations[i],
+				FromUserId: bonusSources[i],
+				Level:      v.Level,
+				Reason:     v.Reason,
+			})
+			if nil != err {
+				return err
+			}
+		}
+
+		return auc.areaRepo.UpdateAreaLevelRun(ctx, run)
+	}); nil != err {
+		if r, _ := auc.areaRepo.GetAreaLevelRunByDate(ctx, date); nil != r {
+			return nil, nil, v1.ErrorAreaLevelEvaluated("%s 已评定", date)
+		}
+		return nil, nil, err
+	}
+
+	return run, changes, nil
+}
+
+// AdminAreaLevel 手动评定一个业务日并返回升降级报告，不传日期时为上一个已结束的业务日，和定时任务使用相同的区间
+func (auc *AreaUseCase) AdminAreaLevel(ctx context.Context, req *v1.AdminAreaLevelRequest) (*v1.AdminAreaLevelReply, error) {
+	var (
+		run     *AreaLevelRun
+		changes []*UserAreaLevelHistory
+		users   map[int64]*User
+		err     error
+	)
+
+	date := req.SendBody.GetBusinessDate()
+	if "" == date {
+		date = auc.day.AddDate(auc.day.Date(time.Now()), -1)
+	}
+	if date >= auc.day.Date(time.Now()) {
+		return nil, v1.ErrorDateInvalid("业务日未结束")
+	}
+
+	run, changes, err = auc.EvaluateAreaLevel(ctx, date)
+	if nil != err {
+		return nil, err
+	}
+
+	userIds := make([]int64, 0)
+	for _, v := range changes {
+		userIds = append(userIds, v.UserId)
+	}
+	users = make(map[int64]*User, 0)
+	if 0 < len(userIds) {
+		users, err = auc.repo.GetUserByUserIds(ctx, userIds...)
+		if nil != err {
+			return nil, err
+		}
+	}
+
+	res := &v1.AdminAreaLevelReply{
+		UserNum:    run.UserNum,
+		Promotions: run.Promotions,
+		Demotions:  run.Demotions,
+		BonusTotal: fmt.Sprintf("%.2f", float64(run.BonusTotal)/float64(100000)),
+		Changes:    make([]*v1.AdminAreaLevelReply_List, 0),
+	}
+	for _, v := range changes {
+		var address string
+		if _, ok := users[v.UserId]; ok {
+			address = users[v.UserId].Address
+		}
+
+		res.Changes = append(res.Changes, &v1.AdminAreaLevelReply_List{
+			UserId:      v.UserId,
+			Address:     address,
+			OriginLevel: v.OriginLevel,
+			Level:       v.Level,
+			BigAmount:   fmt.Sprintf("%.2f", float64(v.BigAmount)/float64(100000)),
+			SmallAmount: fmt.Sprintf("%.2f", float64(v.SmallAmount)/float64(100000)),
+		})
+	}
+
+	return res, nil
+}
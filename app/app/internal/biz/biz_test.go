@@ -0,0 +1,137 @@
+package biz
+
+import (
+	"context"
+	"github.com/go-kratos/kratos/v2/errors"
+	"strings"
+	"time"
+)
+
+// 测试用的仓储，只实现用到的方法，其余方法调用时 panic
+
+type fakeConfigRepo struct {
+	ConfigRepo
+	values map[string]string
+}
+
+func (r *fakeConfigRepo) GetConfigByKeys(ctx context.Context, keys ...string) ([]*Config, error) {
+	res := make([]*Config, 0)
+	for _, k := range keys {
+		if v, ok := r.values[k]; ok {
+			res = append(res, &Config{KeyName: k, Value: v})
+		}
+	}
+	return res, nil
+}
+
+type fakeUserRecommendRepo struct {
+	UserRecommendRepo
+	codes map[int64]string // user_id -> recommend_code
+}
+
+func (r *fakeUserRecommendRepo) GetUserRecommendByUserId(ctx context.Context, userId int64) (*UserRecommend, error) {
+	code, ok := r.codes[userId]
+	if !ok {
+		return nil, errors.NotFound("USER_RECOMMEND_NOT_FOUND", "user recommend not found")
+	}
+	return &UserRecommend{UserId: userId, RecommendCode: code}, nil
+}
+
+func (r *fakeUserRecommendRepo) GetUserRecommendByCode(ctx context.Context, code string) ([]*UserRecommend, error) {
+	res := make([]*UserRecommend, 0)
+	for userId, v := range r.codes {
+		if v == code {
+			res = append(res, &UserRecommend{UserId: userId, RecommendCode: v})
+		}
+	}
+	return res, nil
+}
+
+type fakeUserBalanceRepo struct {
+	UserBalanceRepo
+	deposits map[int64]int64 // user_id -> 累计入金
+}
+
+func (r *fakeUserBalanceRepo) GetUserBalanceRecordUserUsdtTotalByUserIds(ctx context.Context, userIds ...int64) (map[int64]int64, error) {
+	res := make(map[int64]int64, 0)
+	for _, v := range userIds {
+		res[v] = r.deposits[v]
+	}
+	return res, nil
+}
+
+type fakePositionRepo struct {
+	PositionRepo
+	locations   []*LocationNew
+	transitions []*LocationTransition
+}
+
+func (r *fakePositionRepo) find(userId int64, status ...string) *LocationNew {
+	for i := len(r.locations) - 1; i >= 0; i-- {
+		v := r.locations[i]
+		for _, s := range status {
+			if userId == v.UserId && s == v.Status {
+				tmp := *v
+				return &tmp
+			}
+		}
+	}
+	return nil
+}
+
+func (r *fakePositionRepo) byId(id int64) *LocationNew {
+	for _, v := range r.locations {
+		if id == v.ID {
+			return v
+		}
+	}
+	return nil
+}
+
+func (r *fakePositionRepo) GetRunningLocationNewByUserId(ctx context.Context, userId int64) (*LocationNew, error) {
+	return r.find(userId, PositionRunning), nil
+}
+
+func (r *fakePositionRepo) LockRunningLocationNewByUserId(ctx context.Context, userId int64) (*LocationNew, error) {
+	return r.find(userId, PositionRunning), nil
+}
+
+func (r *fakePositionRepo) LockLocationNewByUserIdAndStatus(ctx context.Context, userId int64, status ...string) (*LocationNew, error) {
+	return r.find(userId, status...), nil
+}
+
+func (r *fakePositionRepo) AddLocationNewCurrent(ctx context.Context, id int64, amount int64) error {
+	r.byId(id).Current += amount
+	return nil
+}
+
+func (r *fakePositionRepo) UpdateLocationNewStatus(ctx context.Context, id int64, from string, to string, stopDate time.Time) error {
+	v := r.byId(id)
+	if from != v.Status {
+		return errors.New(500, "UPDATE_LOCATION_ERROR", "仓位状态已变化")
+	}
+	v.Status = to
+	v.StopDate = stopDate
+	return nil
+}
+
+func (r *fakePositionRepo) CreateLocationNew(ctx context.Context, l *LocationNew) (*LocationNew, error) {
+	tmp := *l
+	tmp.ID = int64(len(r.locations) + 1)
+	r.locations = append(r.locations, &tmp)
+	res := tmp
+	return &res, nil
+}
+
+func (r *fakePositionRepo) CreateLocationTransition(ctx context.Context, t *LocationTransition) error {
+	r.transitions = append(r.transitions, t)
+	return nil
+}
+
+// recommendCode 按上级 id 顺序拼推荐码
+func recommendCode(ids ...string) string {
+	if 0 >= len(ids) {
+		return ""
+	}
+	return "D" + strings.Join(ids, "D")
+}
@@ -0,0 +1,64 @@
+package biz
+
+import (
+	"context"
+	v1 "dhb/app/app/api"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// CacheStat 缓存命中统计
+type CacheStat struct {
+	Name   string
+	Hits   int64
+	Misses int64
+	Errors int64 // Redis 读写失败，此时直接读库
+}
+
+type CacheRepo interface {
+	Remember(ctx context.Context, name string, key string, dest interface{}, load func(ctx context.Context) (interface{}, error)) error
+	GetCacheStats(ctx context.Context) (bool, []*CacheStat)
+}
+
+type CacheUseCase struct {
+	repo CacheRepo
+	log  *log.Helper
+}
+
+func NewCacheUseCase(repo CacheRepo, logger log.Logger) *CacheUseCase {
+	return &CacheUseCase{
+		repo: repo,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// Price 外部币价，缓存时间较短，同一时间大量请求只查询一次
+func (cuc *CacheUseCase) Price(ctx context.Context, source string, key string, dest interface{}, load func(ctx context.Context) (interface{}, error)) error {
+	return cuc.repo.Remember(ctx, "price", source+":"+key, dest, load)
+}
+
+// AdminCacheStats 缓存命中率，每个节点单独统计
+func (cuc *CacheUseCase) AdminCacheStats(ctx context.Context, req *v1.AdminCacheStatsRequest) (*v1.AdminCacheStatsReply, error) {
+	enable, stats := cuc.repo.GetCacheStats(ctx)
+
+	res := &v1.AdminCacheStatsReply{
+		Enable: enable,
+		List:   make([]*v1.AdminCacheStatsReply_List, 0),
+	}
+	for _, v := range stats {
+		hitRatio := "0"
+		if total := v.Hits + v.Misses + v.Errors; 0 < total {
+			hitRatio = fmt.Sprintf("%.4f", float64(v.Hits)/float64(total))
+		}
+
+		res.List = append(res.List, &v1.AdminCacheStatsReply_List{
+			Name:     v.Name,
+			Hits:     v.Hits,
+			Misses:   v.Misses,
+			Errors:   v.Errors,
+			HitRatio: hitRatio,
+		})
+	}
+
+	return res, nil
+}
@@ -0,0 +1,98 @@
+package biz
+
+import (
+	"fmt"
+	"time"
+	_ "time/tzdata" // 部署环境不一定有时区数据
+)
+
+const (
+	businessDateLayout = "2006-01-02"
+	businessTimeLayout = "2006-01-02 15:04:05"
+)
+
+// BusinessDay 业务日历，一个业务日为本地时间 [日期+日切, 次日+日切)
+type BusinessDay struct {
+	loc    *time.Location
+	cutoff time.Duration
+}
+
+// NewBusinessDay timezone 为空时使用 Asia/Shanghai，cutoff 为本地时间 HH:MM，为空时 00:00
+func NewBusinessDay(timezone string, cutoff string) (*BusinessDay, error) {
+	if "" == timezone {
+		timezone = "Asia/Shanghai"
+	}
+	loc, err := time.LoadLocation(timezone)
+	if nil != err {
+		return nil, err
+	}
+
+	var hour, minute int
+	if "" != cutoff {
+		if _, err = fmt.Sscanf(cutoff, "%d:%d", &hour, &minute); nil != err {
+			return nil, fmt.Errorf("invalid business cutoff %q: %v", cutoff, err)
+		}
+		if 0 > hour || 23 < hour || 0 > minute || 59 < minute {
+			return nil, fmt.Errorf("invalid business cutoff %q", cutoff)
+		}
+	}
+
+	return &BusinessDay{
+		loc:    loc,
+		cutoff: time.Duration(hour)*time.Hour + time.Duration(minute)*time.Minute,
+	}, nil
+}
+
+// Location 业务时区
+func (b *BusinessDay) Location() *time.Location {
+	return b.loc
+}
+
+// Date t 所在的业务日期
+func (b *BusinessDay) Date(t time.Time) string {
+	return t.In(b.loc).Add(-b.cutoff).Format(businessDateLayout)
+}
+
+// Range 业务日期对应的 UTC 区间 [start, end)
+func (b *BusinessDay) Range(date string) (time.Time, time.Time, error) {
+	day, err := time.ParseInLocation(businessDateLayout, date, b.loc)
+	if nil != err {
+		return time.Time{}, time.Time{}, err
+	}
+
+	return day.Add(b.cutoff).UTC(), day.AddDate(0, 0, 1).Add(b.cutoff).UTC(), nil
+}
+
+// AddDate 业务日期加减天数
+func (b *BusinessDay) AddDate(date string, days int) string {
+	day, err := time.ParseInLocation(businessDateLayout, date, b.loc)
+	if nil != err {
+		return date
+	}
+
+	return day.AddDate(0, 0, days).Format(businessDateLayout)
+}
+
+// Today now 所在业务日的 UTC 区间
+func (b *BusinessDay) Today(now time.Time) (time.Time, time.Time) {
+	return b.Offset(now, 0)
+}
+
+// Offset now 所在业务日往前或往后 days 天的 UTC 区间，-1 为昨天
+func (b *BusinessDay) Offset(now time.Time, days int) (time.Time, time.Time) {
+	start, end, _ := b.Range(b.AddDate(b.Date(now), days))
+	return start, end
+}
+
+// LastMonth now 所在业务月的上一个月的 UTC 区间 [start, end)
+func (b *BusinessDay) LastMonth(now time.Time) (time.Time, time.Time) {
+	day, _ := time.ParseInLocation(businessDateLayout, b.Date(now), b.loc)
+	thisMonth := time.Date(day.Year(), day.Month(), 1, 0, 0, 0, 0, b.loc)
+
+	return thisMonth.AddDate(0, -1, 0).Add(b.cutoff).UTC(), thisMonth.Add(b.cutoff).UTC()
+}
+
+// Format 按业务时区展示时间
+func (b *BusinessDay) Format(t time.Time) string {
+	return t.In(b.loc).Format(businessTimeLayout)
+}
@@ -0,0 +1,365 @@
+package biz
+
+import (
+	"context"
+	v1 "dhb/app/app/api"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// 分佣理由码，写入 reward.reason
+const (
+	CommissionReasonPaid           = "commission"
+	CommissionReasonCapped         = "commission_capped"      // 触发封顶，只发放了部分
+	CommissionReasonMinDeposit     = "commission_min_deposit" // 个人入金不足，不发放
+	CommissionReasonMinRecommend   = "commission_min_recommend"
+	CommissionReasonCapReached     = "commission_cap_reached"     // 本次来源已封顶，不发放
+	CommissionReasonPositionCapped = "commission_position_capped" // 仓位额度不足，只发放了部分或不发放
+	CommissionReasonNoPosition     = "commission_no_position"     // 没有运行中的仓位，不发放
+	CommissionReasonRateZero       = "commission_rate_zero"       // 这一代比例为 0 或基数太小，不发放
+)
+
+// CommissionEvent 触发分佣的事件
+type CommissionEvent struct {
+	Type     string // deposit trade withdraw
+	RecordId int64  // 触发记录id
+	UserId   int64  // 触发用户
+	Amount   int64  // 计提基数
+}
+
+// CommissionLevel 一代的分佣配置
+type CommissionLevel struct {
+	Rate         int64 // 千分比
+	MinDeposit   int64 // 个人最低入金
+	MinRecommend int64 // 最少有效直推人数
+}
+
+// CommissionRule 某类事件的分佣配置
+type CommissionRule struct {
+	Levels []*CommissionLevel
+	Cap    int64 // 单次来源最多发放基数的千分比，0 不限制
+}
+
+// CommissionReward 分佣结果，Amount 为 0 的是不满足条件的上级，Reason 为原因
+type CommissionReward struct {
+	UserId  int64
+	Level   int64
+	Amount  int64
+	Planned int64 // 按比例应得的金额，没有全额发放时和 Amount 不同
+	Reason  string
+}
+
+// parseCommissionLevels 解析分佣表配置，格式：千分比:最低入金:最少有效直推,...，按代数顺序
+func parseCommissionLevels(value string) []*CommissionLevel {
+	res := make([]*CommissionLevel, 0)
+	for _, v := range strings.Split(value, ",") {
+		v = strings.TrimSpace(v)
+		if "" == v {
+			continue
+		}
+
+		tmp := strings.Split(v, ":")
+		level := &CommissionLevel{}
+		level.Rate, _ = strconv.ParseInt(tmp[0], 10, 64)
+		if 2 <= len(tmp) && "" != tmp[1] {
+			level.MinDeposit, _ = strconv.ParseInt(tmp[1]+"00000", 10, 64)
+		}
+		if 3 <= len(tmp) {
+			level.MinRecommend, _ = strconv.ParseInt(tmp[2], 10, 64)
+		}
+		res = append(res, level)
+	}
+
+	return res
+}
+
+// getCommissionRule 读取事件对应的分佣配置
+func (uuc *UserUseCase) getCommissionRule(ctx context.Context, eventType string) *CommissionRule {
+	rule := &CommissionRule{Levels: make([]*CommissionLevel, 0)}
+
+	configs, _ := uuc.configRepo.GetConfigByKeys(ctx, "commission_levels_"+eventType, "commission_cap_"+eventType)
+	if nil != configs {
+		for _, vConfig := range configs {
+			if "commission_levels_"+eventType == vConfig.KeyName {
+				rule.Levels = parseCommissionLevels(vConfig.Value)
+			}
+			if "commission_cap_"+eventType == vConfig.KeyName {
+				rule.Cap, _ = strconv.ParseInt(vConfig.Value, 10, 64)
+			}
+		}
+	}
+
+	return rule
+}
+
+// PlanCommission 计算分佣，只读不写
+func (uuc *UserUseCase) PlanCommission(ctx context.Context, event *CommissionEvent) ([]*CommissionReward, error) {
+	var (
+		userRecommend *UserRecommend
+		err           error
+	)
+	res := make([]*CommissionReward, 0)
+
+	if 0 >= event.Amount {
+		return res, nil
+	}
+
+	rule := uuc.getCommissionRule(ctx, event.Type)
+	if 0 >= len(rule.Levels) {
+		return res, nil
+	}
+
+	userRecommend, err = uuc.urRepo.GetUserRecommendByUserId(ctx, event.UserId)
+	if nil == userRecommend {
+		return nil, err
+	}
+
+	// 上级从直推人开始往上
+	ancestorIds := recommendCodeUserIds(userRecommend.RecommendCode)
+	if 0 >= len(ancestorIds) {
+		return res, nil
+	}
+
+	var (
+		levelUserIds  []int64
+		levelDirects  = make(map[int64][]int64, 0)
+		checkUserIds  []int64
+		depositTotals map[int64]int64
+	)
+	for i := 0; i < len(rule.Levels) && i < len(ancestorIds); i++ {
+		tmpUserId := ancestorIds[len(ancestorIds)-1-i]
+		levelUserIds = append(levelUserIds, tmpUserId)
+		checkUserIds = append(checkUserIds, tmpUserId)
+
+		if 0 >= rule.Levels[i].MinRecommend {
+			continue
+		}
+
+		// 直推人列表
+		var directs []*UserRecommend
+		tmpCode := strings.Join(strings.Split(userRecommend.RecommendCode, "D")[:len(ancestorIds)-i+1], "D")
+		directs, err = uuc.urRepo.GetUserRecommendByCode(ctx, tmpCode)
+		if nil != err {
+			return nil, err
+		}
+		for _, vDirect := range directs {
+			levelDirects[tmpUserId] = append(levelDirects[tmpUserId], vDirect.UserId)
+			checkUserIds = append(checkUserIds, vDirect.UserId)
+		}
+	}
+
+	depositTotals, err = uuc.ubRepo.GetUserBalanceRecordUserUsdtTotalByUserIds(ctx, checkUserIds...)
+	if nil != err {
+		return nil, err
+	}
+
+	var (
+		paid   int64
+		capMax int64 = -1
+	)
+	if 0 < rule.Cap {
+		capMax = event.Amount * rule.Cap / 1000
+	}
+	for i, tmpUserId := range levelUserIds {
+		level := rule.Levels[i]
+		amount := event.Amount * level.Rate / 1000
+		reward := &CommissionReward{UserId: tmpUserId, Level: int64(i + 1), Planned: amount}
+		res = append(res, reward)
+
+		if depositTotals[tmpUserId] < level.MinDeposit {
+			reward.Reason = CommissionReasonMinDeposit
+			continue
+		}
+
+		if 0 < level.MinRecommend {
+			var activeNum int64
+			for _, vDirect := range levelDirects[tmpUserId] {
+				if 0 < depositTotals[vDirect] {
+					activeNum++
+				}
+			}
+			if activeNum < level.MinRecommend {
+				reward.Reason = CommissionReasonMinRecommend
+				continue
+			}
+		}
+
+		if 0 >= amount {
+			reward.Reason = CommissionReasonRateZero
+			continue
+		}
+
+		reward.Reason = CommissionReasonPaid
+		if 0 <= capMax && paid+amount > capMax {
+			amount = capMax - paid
+			reward.Reason = CommissionReasonCapped
+			if 0 >= amount {
+				reward.Reason = CommissionReasonCapReached
+				continue
+			}
+		}
+
+		reward.Amount = amount
+		paid += amount
+	}
+
+	return res, nil
+}
+
+// Commission 分佣，simulate 时按当前仓位额度模拟计入结果，不写入，否则在同一事务中写入余额和分红记录
+func (uuc *UserUseCase) Commission(ctx context.Context, event *CommissionEvent, simulate bool) ([]*CommissionReward, error) {
+	rewards, err := uuc.PlanCommission(ctx, event)
+	if nil != err {
+		return nil, err
+	}
+
+	if simulate {
+		for _, v := range rewards {
+			if 0 >= v.Amount {
+				continue
+			}
+
+			amount, short, err := uuc.puc.SimulateCredit(ctx, v.UserId, v.Amount)
+			if nil != err {
+				return nil, err
+			}
+			applyCommissionCredit(v, amount, short)
+		}
+		return rewards, nil
+	}
+
+	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		return uuc.payCommission(ctx, event, rewards)
+	}); nil != err {
+		return nil, err
+	}
+
+	return rewards, nil
+}
+
+// applyCommissionCredit 按仓位实际计入的金额修改分佣结果
+func applyCommissionCredit(reward *CommissionReward, amount int64, short string) {
+	if amount >= reward.Amount {
+		return
+	}
+
+	reward.Amount = amount
+	reward.Reason = CommissionReasonPositionCapped
+	if CreditShortNoPosition == short {
+		reward.Reason = CommissionReasonNoPosition
+	}
+}
+
+// payCommission 事务中使用，写入分佣，没有发放的上级写 amount 为 0 的记录和原因
+func (uuc *UserUseCase) payCommission(ctx context.Context, event *CommissionEvent, rewards []*CommissionReward) error {
+	for _, v := range rewards {
+		if 0 < v.Amount {
+			// 收益计入仓位额度，超出部分不发放
+			amount, short, err := uuc.puc.Credit(ctx, v.UserId, v.Amount, "commission")
+			if nil != err {
+				return err
+			}
+			applyCommissionCredit(v, amount, short)
+		}
+
+		if 0 >= v.Amount {
+			if err := uuc.ubRepo.ForfeitReward(ctx, v.UserId, v.Planned, event.Type, event.RecordId, event.UserId, v.Reason); nil != err {
+				return err
+			}
+			continue
+		}
+
+		_, err := uuc.ubRepo.CommissionReward(ctx, v.UserId, v.Amount, event.Type, event.RecordId, event.UserId, v.Reason)
+		if nil != err {
+			return err
+		}
+
+		err = uuc.bus.Publish(ctx, &RewardPaid{
+			UserId:     v.UserId,
+			Amount:     formatAmount(v.Amount),
+			Source:     event.Type,
+			RecordId:   event.RecordId,
+			FromUserId: event.UserId,
+			Level:      v.Level,
+			Reason:     v.Reason,
+		})
+		if nil != err {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// commissionPaid payCommission 之后实际发放的分佣合计
+func commissionPaid(rewards []*CommissionReward) int64 {
+	var total int64
+	for _, v := range rewards {
+		if 0 < v.Amount {
+			total += v.Amount
+		}
+	}
+	return total
+}
+
+// AdminCommissionSimulate 模拟分佣结果
+func (uuc *UserUseCase) AdminCommissionSimulate(ctx context.Context, req *v1.AdminCommissionSimulateRequest) (*v1.AdminCommissionSimulateReply, error) {
+	var (
+		rewards []*CommissionReward
+		users   map[int64]*User
+		err     error
+	)
+
+	amountFloat, _ := strconv.ParseFloat(req.Amount, 10)
+	amountFloat *= 100000
+	amount, _ := strconv.ParseInt(strconv.FormatFloat(amountFloat, 'f', -1, 64), 10, 64)
+	if 0 >= amount || 0 >= req.UserId {
+		return nil, v1.ErrorParamInvalid("参数错误")
+	}
+
+	rewards, err = uuc.Commission(ctx, &CommissionEvent{
+		Type:   req.Type,
+		UserId: req.UserId,
+		Amount: amount,
+	}, true)
+	if nil != err {
+		return nil, err
+	}
+
+	userIds := make([]int64, 0)
+	for _, v := range rewards {
+		userIds = append(userIds, v.UserId)
+	}
+	users = make(map[int64]*User, 0)
+	if 0 < len(userIds) {
+		users, err = uuc.repo.GetUserByUserIds(ctx, userIds...)
+		if nil != err {
+			return nil, err
+		}
+	}
+
+	res := &v1.AdminCommissionSimulateReply{
+		Rewards: make([]*v1.AdminCommissionSimulateReply_List, 0),
+	}
+	var total int64
+	for _, v := range rewards {
+		var address string
+		if _, ok := users[v.UserId]; ok {
+			address = users[v.UserId].Address
+		}
+
+		total += v.Amount
+		res.Rewards = append(res.Rewards, &v1.AdminCommissionSimulateReply_List{
+			UserId:  v.UserId,
+			Address: address,
+			Level:   v.Level,
+			Amount:  fmt.Sprintf("%.2f", float64(v.Amount)/float64(100000)),
+			Reason:  v.Reason,
+			Planned: fmt.Sprintf("%.2f", float64(v.Planned)/float64(100000)),
+		})
+	}
+	res.Total = fmt.Sprintf("%.2f", float64(total)/float64(100000))
+
+	return res, nil
+}
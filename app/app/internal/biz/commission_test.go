@@ -0,0 +1,153 @@
+package biz
+
+import (
+	"context"
+	"testing"
+)
+
+func TestParseCommissionLevels(t *testing.T) {
+	levels := parseCommissionLevels(" 100:50:2, 50, ,20:1")
+	want := []CommissionLevel{
+		{Rate: 100, MinDeposit: 5000000, MinRecommend: 2},
+		{Rate: 50},
+		{Rate: 20, MinDeposit: 100000},
+	}
+	if len(levels) != len(want) {
+		t.Fatalf("parseCommissionLevels() = %d levels, want %d", len(levels), len(want))
+	}
+	for i := range want {
+		if *levels[i] != want[i] {
+			t.Errorf("level %d = %+v, want %+v", i+1, *levels[i], want[i])
+		}
+	}
+}
+
+func TestPlanCommission(t *testing.T) {
+	// 4 的上级从直推开始依次为 3 2 1，3 的直推有 4 和 5
+	codes := map[int64]string{
+		1: "",
+		2: recommendCode("1"),
+		3: recommendCode("1", "2"),
+		4: recommendCode("1", "2", "3"),
+		5: recommendCode("1", "2", "3"),
+	}
+
+	tests := []struct {
+		name     string
+		configs  map[string]string
+		deposits map[int64]int64
+		amount   int64
+		want     []CommissionReward
+	}{
+		{
+			name:    "no levels",
+			configs: map[string]string{},
+			amount:  100000,
+			want:    []CommissionReward{},
+		},
+		{
+			name:    "levels deeper than ancestors",
+			configs: map[string]string{"commission_levels_deposit": "100,50,20,10"},
+			amount:  100000,
+			want: []CommissionReward{
+				{UserId: 3, Level: 1, Amount: 10000, Planned: 10000, Reason: CommissionReasonPaid},
+				{UserId: 2, Level: 2, Amount: 5000, Planned: 5000, Reason: CommissionReasonPaid},
+				{UserId: 1, Level: 3, Amount: 2000, Planned: 2000, Reason: CommissionReasonPaid},
+			},
+		},
+		{
+			name:     "min deposit and min recommend",
+			configs:  map[string]string{"commission_levels_deposit": "100:1:2,50:1"},
+			deposits: map[int64]int64{3: 100000, 4: 100000},
+			amount:   100000,
+			want: []CommissionReward{
+				{UserId: 3, Level: 1, Planned: 10000, Reason: CommissionReasonMinRecommend}, // 只有 4 入金
+				{UserId: 2, Level: 2, Planned: 5000, Reason: CommissionReasonMinDeposit},
+			},
+		},
+		{
+			name:     "min recommend reached",
+			configs:  map[string]string{"commission_levels_deposit": "100:1:2"},
+			deposits: map[int64]int64{3: 100000, 4: 100000, 5: 1},
+			amount:   100000,
+			want: []CommissionReward{
+				{UserId: 3, Level: 1, Amount: 10000, Planned: 10000, Reason: CommissionReasonPaid},
+			},
+		},
+		{
+			name:    "cap",
+			configs: map[string]string{"commission_levels_deposit": "100,50,20", "commission_cap_deposit": "120"},
+			amount:  100000,
+			want: []CommissionReward{
+				{UserId: 3, Level: 1, Amount: 10000, Planned: 10000, Reason: CommissionReasonPaid},
+				{UserId: 2, Level: 2, Amount: 2000, Planned: 5000, Reason: CommissionReasonCapped},
+				{UserId: 1, Level: 3, Planned: 2000, Reason: CommissionReasonCapReached},
+			},
+		},
+		{
+			name:    "rate too small",
+			configs: map[string]string{"commission_levels_deposit": "0,1"},
+			amount:  100,
+			want: []CommissionReward{
+				{UserId: 3, Level: 1, Reason: CommissionReasonRateZero},
+				{UserId: 2, Level: 2, Reason: CommissionReasonRateZero},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uuc := &UserUseCase{
+				configRepo: &fakeConfigRepo{values: tt.configs},
+				urRepo:     &fakeUserRecommendRepo{codes: codes},
+				ubRepo:     &fakeUserBalanceRepo{deposits: tt.deposits},
+			}
+
+			got, err := uuc.PlanCommission(context.Background(), &CommissionEvent{Type: "deposit", UserId: 4, Amount: tt.amount})
+			if nil != err {
+				t.Fatal(err)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("PlanCommission() = %d rewards, want %d", len(got), len(tt.want))
+			}
+			for i := range tt.want {
+				if *got[i] != tt.want[i] {
+					t.Errorf("reward %d = %+v, want %+v", i, *got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestCommissionSimulateAppliesPositionCap(t *testing.T) {
+	uuc := &UserUseCase{
+		configRepo: &fakeConfigRepo{values: map[string]string{"commission_levels_deposit": "100,50,20"}},
+		urRepo: &fakeUserRecommendRepo{codes: map[int64]string{
+			4: recommendCode("1", "2", "3"),
+		}},
+		ubRepo: &fakeUserBalanceRepo{},
+		puc: &PositionUseCase{positionRepo: &fakePositionRepo{locations: []*LocationNew{
+			{ID: 1, UserId: 3, Status: PositionRunning, CurrentMax: 100000},
+			{ID: 2, UserId: 2, Status: PositionRunning, Current: 97000, CurrentMax: 100000},
+			{ID: 3, UserId: 1, Status: PositionStopped, CurrentMax: 100000},
+		}}},
+	}
+
+	got, err := uuc.Commission(context.Background(), &CommissionEvent{Type: "deposit", UserId: 4, Amount: 100000}, true)
+	if nil != err {
+		t.Fatal(err)
+	}
+	want := []CommissionReward{
+		{UserId: 3, Level: 1, Amount: 10000, Planned: 10000, Reason: CommissionReasonPaid},
+		{UserId: 2, Level: 2, Amount: 3000, Planned: 5000, Reason: CommissionReasonPositionCapped},
+		{UserId: 1, Level: 3, Planned: 2000, Reason: CommissionReasonNoPosition},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("Commission() = %d rewards, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if *got[i] != want[i] {
+			t.Errorf("reward %d = %+v, want %+v", i, *got[i], want[i])
+		}
+	}
+}
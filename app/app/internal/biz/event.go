@@ -0,0 +1,390 @@
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/go-kratos/kratos/v2/log"
+	"sort"
+	"time"
+)
+
+const eventRelayLeaseName = "event_relay"
+
+// 领域事件类型
+const (
+	EventDepositCredited   = "deposit.credited"
+	EventWithdrawCreated   = "withdraw.created"
+	EventWithdrawApproved  = "withdraw.approved"
+	EventWithdrawPaid      = "withdraw.paid"
+	EventWithdrawFailed    = "withdraw.failed"
+	EventTransferCompleted = "transfer.completed"
+	EventTransferReceived  = "transfer.received"
+	EventExchangeCompleted = "exchange.completed"
+	EventTradeCreated      = "trade.created"
+	EventRewardPaid        = "reward.paid"
+)
+
+// Event 领域事件，在业务事务中写入发件箱
+type Event interface {
+	EventType() string
+	EventUserId() int64 // 事件所属用户
+}
+
+// DepositCredited 充值入账
+type DepositCredited struct {
+	UserId      int64  `json:"userId"`
+	Coin        string `json:"coin"`
+	Amount      string `json:"amount"`
+	Balance     string `json:"balance"`
+	ReferenceId string `json:"referenceId"`
+	Reason      string `json:"reason"`
+}
+
+func (e *DepositCredited) EventType() string  { return EventDepositCredited }
+func (e *DepositCredited) EventUserId() int64 { return e.UserId }
+
+// WithdrawCreated 提现申请
+type WithdrawCreated struct {
+	WithdrawId int64  `json:"withdrawId"`
+	UserId     int64  `json:"userId"`
+	Coin       string `json:"coin"`
+	Amount     string `json:"amount"`
+	Fee        string `json:"fee"`
+	Net        string `json:"net"`
+	Address    string `json:"address"`
+}
+
+func (e *WithdrawCreated) EventType() string  { return EventWithdrawCreated }
+func (e *WithdrawCreated) EventUserId() int64 { return e.UserId }
+
+// WithdrawStatusChanged 提现审核、打款结果，Type 为 withdraw.approved/paid/failed
+type WithdrawStatusChanged struct {
+	Type       string `json:"-"`
+	WithdrawId int64  `json:"withdrawId"`
+	UserId     int64  `json:"userId"`
+	Coin       string `json:"coin"`
+	Amount     string `json:"amount"`
+	Net        string `json:"net"`
+	Address    string `json:"address"`
+	Status     string `json:"status"`
+}
+
+func (e *WithdrawStatusChanged) EventType() string  { return e.Type }
+func (e *WithdrawStatusChanged) EventUserId() int64 { return e.UserId }
+
+// TransferCompleted 用户间转账
+type TransferCompleted struct {
+	FromUserId int64  `json:"fromUserId"`
+	ToUserId   int64  `json:"toUserId"`
+	Coin       string `json:"coin"`
+	Amount     string `json:"amount"`
+}
+
+func (e *TransferCompleted) EventType() string  { return EventTransferCompleted }
+func (e *TransferCompleted) EventUserId() int64 { return e.FromUserId }
+
+// TransferReceived 转账到账，属于收款方
+type TransferReceived struct {
+	FromUserId int64  `json:"fromUserId"`
+	ToUserId   int64  `json:"toUserId"`
+	Coin       string `json:"coin"`
+	Amount     string `json:"amount"`
+}
+
+func (e *TransferReceived) EventType() string  { return EventTransferReceived }
+func (e *TransferReceived) EventUserId() int64 { return e.ToUserId }
+
+// ExchangeCompleted 币种兑换
+type ExchangeCompleted struct {
+	UserId int64  `json:"userId"`
+	Dhb    string `json:"dhb"`
+	Usdt   string `json:"usdt"`
+	Net    string `json:"net"`
+}
+
+func (e *ExchangeCompleted) EventType() string  { return EventExchangeCompleted }
+func (e *ExchangeCompleted) EventUserId() int64 { return e.UserId }
+
+// TradeCreated 交易
+type TradeCreated struct {
+	TradeId int64  `json:"tradeId"`
+	UserId  int64  `json:"userId"`
+	Usdt    string `json:"usdt"`
+	Dhb     string `json:"dhb"`
+	UsdtNet string `json:"usdtNet"`
+	DhbNet  string `json:"dhbNet"`
+}
+
+func (e *TradeCreated) EventType() string  { return EventTradeCreated }
+func (e *TradeCreated) EventUserId() int64 { return e.UserId }
+
+// RewardPaid 收益到账，Source 为分佣的事件类型、area 级差奖或 balance_reward 理财收益
+type RewardPaid struct {
+	UserId     int64  `json:"userId"`
+	Amount     string `json:"amount"`
+	Source     string `json:"source"`
+	RecordId   int64  `json:"recordId"`
+	FromUserId int64  `json:"fromUserId"`
+	Level      int64  `json:"level"`
+	Reason     string `json:"reason"`
+}
+
+func (e *RewardPaid) EventType() string  { return EventRewardPaid }
+func (e *RewardPaid) EventUserId() int64 { return e.UserId }
+
+// OutboxEvent 发件箱中的事件，ID 递增，消费方按 OutboxCursor 记录位置
+type OutboxEvent struct {
+	ID        int64
+	Type      string
+	UserId    int64
+	Payload   string // json
+	CreatedAt time.Time
+}
+
+type OutboxRepo interface {
+	CreateOutboxEvent(ctx context.Context, e *OutboxEvent) (*OutboxEvent, error)
+	GetOutboxEventsAfter(ctx context.Context, afterId int64, limit int) ([]*OutboxEvent, error)
+	GetOutboxEventsByIds(ctx context.Context, ids ...int64) (map[int64]*OutboxEvent, error)
+	GetOutboxEventsByUserId(ctx context.Context, userId int64, afterId int64, maxId int64, limit int) ([]*OutboxEvent, error)
+	GetOutboxLastId(ctx context.Context) (int64, error)
+	GetEventOffset(ctx context.Context, consumer string) (*OutboxCursor, error)
+	SaveEventOffset(ctx context.Context, consumer string, cursor *OutboxCursor) error
+}
+
+// maxGapSpan 相邻两个事件之间超过这个数量的 id 不再逐个记为空洞，视为自增值跳跃
+const maxGapSpan = 1000
+
+// OutboxCursor 发件箱读取位置。自增 id 在插入时分配，事务提交顺序可能不同，
+// 读到较大的 id 时较小的 id 可能还没有提交，记为空洞，之后每次读取时补查，超过 GapTimeout 视为事务已回滚
+type OutboxCursor struct {
+	Offset int64               // 这个 id 及之前的事件都已读取或放弃
+	MaxId  int64               // 已读取的最大 id
+	Gaps   map[int64]time.Time // (Offset, MaxId] 中还没读到的 id 和发现时间
+}
+
+// NewOutboxCursor 从 id 之后开始读取
+func NewOutboxCursor(id int64) *OutboxCursor {
+	return &OutboxCursor{Offset: id, MaxId: id, Gaps: make(map[int64]time.Time, 0)}
+}
+
+// Pending 已经越过但还没读到的 id
+func (c *OutboxCursor) Pending(id int64) bool {
+	_, ok := c.Gaps[id]
+	return ok
+}
+
+func (c *OutboxCursor) clone() *OutboxCursor {
+	res := &OutboxCursor{Offset: c.Offset, MaxId: c.MaxId, Gaps: make(map[int64]time.Time, len(c.Gaps))}
+	for k, v := range c.Gaps {
+		res.Gaps[k] = v
+	}
+	return res
+}
+
+// readOutbox 读取空洞中已提交的事件和 MaxId 之后的一批新事件，按 id 排序，
+// 返回推进后的位置，调用方处理成功后再使用新位置，失败时下次从原位置重读，至少投递一次
+func readOutbox(ctx context.Context, repo OutboxRepo, cur *OutboxCursor, now time.Time, limit int, gapTimeout time.Duration) ([]*OutboxEvent, *OutboxCursor, error) {
+	next := cur.clone()
+	events := make([]*OutboxEvent, 0)
+
+	if 0 < len(cur.Gaps) {
+		ids := make([]int64, 0, len(cur.Gaps))
+		for id := range cur.Gaps {
+			ids = append(ids, id)
+		}
+		filled, err := repo.GetOutboxEventsByIds(ctx, ids...)
+		if nil != err {
+			return nil, nil, err
+		}
+		for id, e := range filled {
+			events = append(events, e)
+			delete(next.Gaps, id)
+		}
+	}
+
+	fresh, err := repo.GetOutboxEventsAfter(ctx, cur.MaxId, limit)
+	if nil != err {
+		return nil, nil, err
+	}
+	for _, e := range fresh {
+		if e.ID-next.MaxId-1 <= maxGapSpan {
+			for id := next.MaxId + 1; id < e.ID; id++ {
+				next.Gaps[id] = now
+			}
+		}
+		next.MaxId = e.ID
+		events = append(events, e)
+	}
+
+	for id, at := range next.Gaps {
+		if gapTimeout < now.Sub(at) {
+			delete(next.Gaps, id)
+		}
+	}
+	next.Offset = next.MaxId
+	for id := range next.Gaps {
+		if id-1 < next.Offset {
+			next.Offset = id - 1
+		}
+	}
+
+	sort.Slice(events, func(i, j int) bool {
+		return events[i].ID < events[j].ID
+	})
+	return events, next, nil
+}
+
+// EventSink 事件的投递目标，Publish 返回 nil 后才会推进消费位置，至少投递一次
+type EventSink interface {
+	Name() string
+	Publish(ctx context.Context, events []*OutboxEvent) error
+}
+
+// EventSinks 外部投递目标，由 data 层按配置创建
+type EventSinks []EventSink
+
+// EventHandler 进程内订阅的处理函数
+type EventHandler func(ctx context.Context, e *OutboxEvent) error
+
+// handlerSink 进程内订阅，Types 为空时接收全部事件
+type handlerSink struct {
+	name    string
+	types   map[string]bool
+	handler EventHandler
+}
+
+func (s *handlerSink) Name() string {
+	return s.name
+}
+
+func (s *handlerSink) Publish(ctx context.Context, events []*OutboxEvent) error {
+	for _, e := range events {
+		if 0 < len(s.types) && !s.types[e.Type] {
+			continue
+		}
+		if err := s.handler(ctx, e); nil != err {
+			return err
+		}
+	}
+	return nil
+}
+
+// EventConfig 转发配置
+type EventConfig struct {
+	Batch      int64
+	GapTimeout time.Duration // 空洞等待提交的最长时间，超过视为事务已回滚
+}
+
+// EventBus 领域事件，业务事务中写入发件箱，由主节点按消费方逐个转发
+type EventBus struct {
+	repo    OutboxRepo
+	jobRepo JobRepo
+	c       *EventConfig
+	sinks   []EventSink
+	log     *log.Helper
+}
+
+func NewEventBus(repo OutboxRepo, jobRepo JobRepo, c *EventConfig, sinks EventSinks, logger log.Logger) *EventBus {
+	return &EventBus{
+		repo:    repo,
+		jobRepo: jobRepo,
+		c:       c,
+		sinks:   sinks,
+		log:     log.NewHelper(logger),
+	}
+}
+
+// Register 注册投递目标，名称即消费位置的 key，不能重复
+func (b *EventBus) Register(sink EventSink) {
+	b.sinks = append(b.sinks, sink)
+}
+
+// Subscribe 进程内订阅，handler 返回错误时下次从该事件重新处理
+func (b *EventBus) Subscribe(name string, handler EventHandler, types ...string) {
+	s := &handlerSink{name: name, types: make(map[string]bool, 0), handler: handler}
+	for _, v := range types {
+		s.types[v] = true
+	}
+	b.Register(s)
+}
+
+// Publish 写入发件箱，需要在业务事务中调用，事务回滚时事件一起回滚
+func (b *EventBus) Publish(ctx context.Context, events ...Event) error {
+	for _, e := range events {
+		data, err := json.Marshal(e)
+		if nil != err {
+			return err
+		}
+
+		_, err = b.repo.CreateOutboxEvent(ctx, &OutboxEvent{
+			Type:    e.EventType(),
+			UserId:  e.EventUserId(),
+			Payload: string(data),
+		})
+		if nil != err {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetEventsByIds .
+func (b *EventBus) GetEventsByIds(ctx context.Context, ids ...int64) (map[int64]*OutboxEvent, error) {
+	return b.repo.GetOutboxEventsByIds(ctx, ids...)
+}
+
+// Lead 竞选或续约转发主节点
+func (b *EventBus) Lead(ctx context.Context, owner string, ttl time.Duration) bool {
+	ok, err := b.jobRepo.AcquireJobLease(ctx, eventRelayLeaseName, owner, ttl)
+	if nil != err {
+		b.log.Errorf("event relay lease: %v", err)
+		return false
+	}
+	return ok
+}
+
+// Resign 放弃转发主节点
+func (b *EventBus) Resign(ctx context.Context, owner string) {
+	if err := b.jobRepo.ReleaseJobLease(ctx, eventRelayLeaseName, owner); nil != err {
+		b.log.Errorf("event relay lease release: %v", err)
+	}
+}
+
+// Relay 每个消费方从自己的位置开始转发一批，返回转发的事件数量
+func (b *EventBus) Relay(ctx context.Context, now time.Time) (int64, error) {
+	var total int64
+	for _, sink := range b.sinks {
+		n, err := b.relay(ctx, sink, now)
+		total += n
+		if nil != err {
+			b.log.Errorf("event relay %s: %v", sink.Name(), err)
+		}
+	}
+	return total, nil
+}
+
+func (b *EventBus) relay(ctx context.Context, sink EventSink, now time.Time) (int64, error) {
+	cursor, err := b.repo.GetEventOffset(ctx, sink.Name())
+	if nil != err {
+		return 0, err
+	}
+
+	events, next, err := readOutbox(ctx, b.repo, cursor, now, int(b.c.Batch), b.c.GapTimeout)
+	if nil != err {
+		return 0, err
+	}
+
+	if 0 < len(events) {
+		if err = sink.Publish(ctx, events); nil != err {
+			return 0, err
+		}
+	}
+
+	// 没有新事件时空洞也可能超时放弃，位置有变化就保存
+	if 0 < len(events) || next.Offset != cursor.Offset || len(next.Gaps) != len(cursor.Gaps) {
+		if err = b.repo.SaveEventOffset(ctx, sink.Name(), next); nil != err {
+			return 0, err
+		}
+	}
+	return int64(len(events)), nil
+}
@@ -0,0 +1,211 @@
+package biz
+
+import (
+	"context"
+	v1 "dhb/app/app/api"
+	"strconv"
+	"strings"
+)
+
+// 收费方式
+const (
+	FeeKindFlat = "flat" // 固定金额
+	FeeKindRate = "rate" // 千分比，可设最低最高
+	FeeKindTier = "tier" // 按金额区间取千分比
+)
+
+// FeeTier 金额不超过 Upto 时适用的千分比，Upto 为 0 表示以上全部
+type FeeTier struct {
+	Upto int64
+	Rate int64
+}
+
+// FeeSchedule 某个动作某个币种的收费表
+type FeeSchedule struct {
+	Kind  string
+	Flat  int64
+	Rate  int64
+	Min   int64
+	Max   int64
+	Tiers []*FeeTier
+}
+
+// FeeQuote 手续费试算，Fee 进平台手续费账户，Burn 进销毁账户
+type FeeQuote struct {
+	Action string
+	Coin   string
+	Amount int64
+	Fee    int64
+	Burn   int64
+	Net    int64
+}
+
+// parseFeeSchedule 解析收费表配置，格式：
+// flat:金额
+// rate:千分比[:最低[:最高]]
+// tier:上限:千分比,上限:千分比,:千分比
+func parseFeeSchedule(value string) *FeeSchedule {
+	value = strings.TrimSpace(value)
+	i := strings.Index(value, ":")
+	if 0 > i {
+		return nil
+	}
+
+	s := &FeeSchedule{Kind: value[:i]}
+	switch s.Kind {
+	case FeeKindFlat:
+		s.Flat, _ = strconv.ParseInt(value[i+1:]+"00000", 10, 64)
+	case FeeKindRate:
+		tmp := strings.Split(value[i+1:], ":")
+		s.Rate, _ = strconv.ParseInt(tmp[0], 10, 64)
+		if 2 <= len(tmp) && "" != tmp[1] {
+			s.Min, _ = strconv.ParseInt(tmp[1]+"00000", 10, 64)
+		}
+		if 3 <= len(tmp) && "" != tmp[2] {
+			s.Max, _ = strconv.ParseInt(tmp[2]+"00000", 10, 64)
+		}
+	case FeeKindTier:
+		for _, v := range strings.Split(value[i+1:], ",") {
+			tmp := strings.Split(strings.TrimSpace(v), ":")
+			if 2 > len(tmp) {
+				continue
+			}
+			tier := &FeeTier{}
+			if "" != tmp[0] {
+				tier.Upto, _ = strconv.ParseInt(tmp[0]+"00000", 10, 64)
+			}
+			tier.Rate, _ = strconv.ParseInt(tmp[1], 10, 64)
+			s.Tiers = append(s.Tiers, tier)
+		}
+	default:
+		return nil
+	}
+
+	return s
+}
+
+// Compute 按收费表计算，未配置收费为 0
+func (s *FeeSchedule) Compute(amount int64) int64 {
+	if nil == s || 0 >= amount {
+		return 0
+	}
+
+	var fee int64
+	switch s.Kind {
+	case FeeKindFlat:
+		fee = s.Flat
+	case FeeKindRate:
+		fee = amount * s.Rate / 1000
+		if 0 < s.Min && s.Min > fee {
+			fee = s.Min
+		}
+		if 0 < s.Max && s.Max < fee {
+			fee = s.Max
+		}
+	case FeeKindTier:
+		for _, v := range s.Tiers {
+			if 0 == v.Upto || amount <= v.Upto {
+				fee = amount * v.Rate / 1000
+				break
+			}
+		}
+	}
+
+	if fee > amount {
+		fee = amount
+	}
+	return fee
+}
+
+// getFeeSchedules 读取 fee_动作_币种 和 burn_动作_币种，
+// 交易没有配置时沿用 withdraw_rate、withdraw_destroy_rate 百分比
+func (uuc *UserUseCase) getFeeSchedules(ctx context.Context, action string, coin string) (*FeeSchedule, *FeeSchedule, error) {
+	var (
+		fee, burn                     *FeeSchedule
+		feeSet, burnSet               bool
+		legacyFeeRate, legacyBurnRate int64
+		feeKey, burnKey               = "fee_" + action + "_" + coin, "burn_" + action + "_" + coin
+	)
+
+	configs, err := uuc.configRepo.GetConfigByKeys(ctx, feeKey, burnKey, "withdraw_rate", "withdraw_destroy_rate")
+	if nil != err {
+		return nil, nil, err
+	}
+	for _, vConfig := range configs {
+		switch vConfig.KeyName {
+		case feeKey:
+			fee, feeSet = parseFeeSchedule(vConfig.Value), true
+		case burnKey:
+			burn, burnSet = parseFeeSchedule(vConfig.Value), true
+		case "withdraw_rate":
+			legacyFeeRate, _ = strconv.ParseInt(vConfig.Value, 10, 64)
+		case "withdraw_destroy_rate":
+			legacyBurnRate, _ = strconv.ParseInt(vConfig.Value, 10, 64)
+		}
+	}
+
+	if "trade" == action {
+		if !feeSet && 0 < legacyFeeRate {
+			fee = &FeeSchedule{Kind: FeeKindRate, Rate: legacyFeeRate * 10}
+		}
+		if !burnSet && 0 < legacyBurnRate {
+			burn = &FeeSchedule{Kind: FeeKindRate, Rate: legacyBurnRate * 10}
+		}
+	}
+
+	return fee, burn, nil
+}
+
+// QuoteFee 计算手续费和销毁，合计不超过金额
+func (uuc *UserUseCase) QuoteFee(ctx context.Context, action string, coin string, amount int64) (*FeeQuote, error) {
+	fee, burn, err := uuc.getFeeSchedules(ctx, action, coin)
+	if nil != err {
+		return nil, err
+	}
+
+	q := &FeeQuote{Action: action, Coin: coin, Amount: amount}
+	q.Fee = fee.Compute(amount)
+	q.Burn = burn.Compute(amount)
+	if q.Fee+q.Burn > amount {
+		q.Burn = amount - q.Fee
+	}
+	q.Net = amount - q.Fee - q.Burn
+
+	return q, nil
+}
+
+// FeePreview 提交前展示手续费和到账金额
+func (uuc *UserUseCase) FeePreview(ctx context.Context, req *v1.FeePreviewRequest) (*v1.FeePreviewReply, error) {
+	if "withdraw" != req.Action && "trade" != req.Action {
+		return nil, v1.ErrorParamInvalid("动作错误")
+	}
+
+	coin := req.Coin
+	if "2" == coin {
+		coin = "usdt"
+	}
+	if "3" == coin {
+		coin = "dhb"
+	}
+
+	amountFloat, _ := strconv.ParseFloat(req.Amount, 10)
+	amountFloat *= 100000
+	amount, _ := strconv.ParseInt(strconv.FormatFloat(amountFloat, 'f', -1, 64), 10, 64)
+	if 0 >= amount {
+		return nil, v1.ErrorAmountInvalid("金额错误")
+	}
+
+	q, err := uuc.QuoteFee(ctx, req.Action, coin, amount)
+	if nil != err {
+		return nil, err
+	}
+
+	return &v1.FeePreviewReply{
+		Action: q.Action,
+		Coin:   q.Coin,
+		Amount: formatAmount(q.Amount),
+		Fee:    formatAmount(q.Fee),
+		Burn:   formatAmount(q.Burn),
+		Net:    formatAmount(q.Net),
+	}, nil
+}
@@ -0,0 +1,98 @@
+package biz
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestParseFeeSchedule(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  *FeeSchedule
+	}{
+		{"flat", "flat:2", &FeeSchedule{Kind: FeeKindFlat, Flat: 200000}},
+		{"rate", "rate:5", &FeeSchedule{Kind: FeeKindRate, Rate: 5}},
+		{"rate with min max", " rate:5:1:10 ", &FeeSchedule{Kind: FeeKindRate, Rate: 5, Min: 100000, Max: 1000000}},
+		{"rate with max only", "rate:5::10", &FeeSchedule{Kind: FeeKindRate, Rate: 5, Max: 1000000}},
+		{"tier", "tier:100:10, 1000:5,:2", &FeeSchedule{Kind: FeeKindTier, Tiers: []*FeeTier{
+			{Upto: 10000000, Rate: 10},
+			{Upto: 100000000, Rate: 5},
+			{Upto: 0, Rate: 2},
+		}}},
+		{"tier skips bad entry", "tier:100,:2", &FeeSchedule{Kind: FeeKindTier, Tiers: []*FeeTier{{Rate: 2}}}},
+		{"no kind", "5", nil},
+		{"unknown kind", "percent:5", nil},
+		{"empty", "", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseFeeSchedule(tt.value); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseFeeSchedule(%q) = %+v, want %+v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFeeScheduleCompute(t *testing.T) {
+	tiers := parseFeeSchedule("tier:100:10,1000:5,:2")
+	tests := []struct {
+		name     string
+		schedule *FeeSchedule
+		amount   int64
+		want     int64
+	}{
+		{"nil schedule", nil, 100000, 0},
+		{"zero amount", parseFeeSchedule("flat:2"), 0, 0},
+		{"flat", parseFeeSchedule("flat:2"), 1000000, 200000},
+		{"flat over amount", parseFeeSchedule("flat:2"), 100000, 100000},
+		{"rate", parseFeeSchedule("rate:5"), 10000000, 50000},
+		{"rate min", parseFeeSchedule("rate:5:1:10"), 10000000, 100000},
+		{"rate max", parseFeeSchedule("rate:5:1:10"), 1000000000, 1000000},
+		{"tier first", tiers, 10000000, 100000},
+		{"tier second", tiers, 10000001, 50000},
+		{"tier rest", tiers, 1000000000, 2000000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.schedule.Compute(tt.amount); got != tt.want {
+				t.Errorf("Compute(%d) = %d, want %d", tt.amount, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestQuoteFee(t *testing.T) {
+	tests := []struct {
+		name   string
+		values map[string]string
+		action string
+		amount int64
+		fee    int64
+		burn   int64
+	}{
+		{"no config", nil, "withdraw", 10000000, 0, 0},
+		{"fee and burn", map[string]string{"fee_withdraw_usdt": "rate:10", "burn_withdraw_usdt": "flat:1"}, "withdraw", 10000000, 100000, 100000},
+		{"burn limited by amount", map[string]string{"fee_withdraw_usdt": "flat:3", "burn_withdraw_usdt": "flat:3"}, "withdraw", 500000, 300000, 200000},
+		{"withdraw ignores legacy rate", map[string]string{"withdraw_rate": "5", "withdraw_destroy_rate": "2"}, "withdraw", 10000000, 0, 0},
+		{"trade uses legacy rate", map[string]string{"withdraw_rate": "5", "withdraw_destroy_rate": "2"}, "trade", 10000000, 500000, 200000},
+		{"trade schedule overrides legacy", map[string]string{"fee_trade_usdt": "flat:1", "withdraw_rate": "5", "withdraw_destroy_rate": "2"}, "trade", 10000000, 100000, 200000},
+		{"trade empty schedule disables legacy", map[string]string{"fee_trade_usdt": "", "withdraw_rate": "5"}, "trade", 10000000, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uuc := &UserUseCase{configRepo: &fakeConfigRepo{values: tt.values}}
+			q, err := uuc.QuoteFee(context.Background(), tt.action, "usdt", tt.amount)
+			if nil != err {
+				t.Fatal(err)
+			}
+			if q.Fee != tt.fee || q.Burn != tt.burn || q.Net != tt.amount-tt.fee-tt.burn {
+				t.Errorf("QuoteFee() = fee %d, burn %d, net %d; want %d, %d, %d", q.Fee, q.Burn, q.Net, tt.fee, tt.burn, tt.amount-tt.fee-tt.burn)
+			}
+		})
+	}
+}
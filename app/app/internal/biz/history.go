@@ -0,0 +1,227 @@
+package biz
+
+import (
+	"context"
+	v1 "dhb/app/app/api"
+	"fmt"
+	"time"
+)
+
+// 历史记录分页
+const (
+	historyDefaultPageSize = 20
+	historyMaxPageSize     = 100
+)
+
+// HistoryFilter 历史记录查询条件，Cursor 为上一页最后一条的 id
+type HistoryFilter struct {
+	UserId   int64
+	Types    []string
+	Reasons  []string
+	Coin     string
+	Status   string
+	Start    time.Time
+	End      time.Time
+	Cursor   int64
+	PageSize int64
+	Asc      bool
+}
+
+// HistoryTotal 符合条件的记录合计，不受分页影响
+type HistoryTotal struct {
+	Count  int64
+	Amount int64
+}
+
+// HistoryQuery 接口传入的查询条件
+type HistoryQuery struct {
+	Types    []string
+	Reasons  []string
+	Coin     string
+	Status   string
+	Start    string // 业务日 2006-01-02，含
+	End      string // 业务日 2006-01-02，含
+	Cursor   int64
+	PageSize int64
+	Sort     string // desc 默认，asc
+}
+
+// 推荐收益包含的理由，提现分佣的理由相同，另外按事件类型区分
+var recommendRewardReasons = []string{"recommend_location", CommissionReasonPaid, CommissionReasonCapped, CommissionReasonPositionCapped}
+
+// 推荐收益的事件类型，reward.type 为触发分佣的事件，提现分佣在手续费收益中
+var recommendRewardTypes = []string{"location", "deposit", "trade"}
+
+// historyFilter 校验并转换查询条件
+func (uuc *UserUseCase) historyFilter(userId int64, q *HistoryQuery) (*HistoryFilter, error) {
+	f := &HistoryFilter{
+		UserId:   userId,
+		Types:    q.Types,
+		Reasons:  q.Reasons,
+		Coin:     q.Coin,
+		Status:   q.Status,
+		Cursor:   q.Cursor,
+		PageSize: q.PageSize,
+		Asc:      "asc" == q.Sort,
+	}
+	if 0 >= f.PageSize {
+		f.PageSize = historyDefaultPageSize
+	}
+	if historyMaxPageSize < f.PageSize {
+		f.PageSize = historyMaxPageSize
+	}
+
+	var err error
+	if "" != q.Start {
+		f.Start, _, err = uuc.day.Range(q.Start)
+		if nil != err {
+			return nil, v1.ErrorDateInvalid("开始日期格式错误")
+		}
+	}
+	if "" != q.End {
+		_, f.End, err = uuc.day.Range(q.End)
+		if nil != err {
+			return nil, v1.ErrorDateInvalid("结束日期格式错误")
+		}
+	}
+	if !f.Start.IsZero() && !f.End.IsZero() && !f.Start.Before(f.End) {
+		return nil, v1.ErrorDateInvalid("日期范围错误")
+	}
+
+	return f, nil
+}
+
+// formatAmount 金额统一保留两位
+func formatAmount(amount int64) string {
+	return fmt.Sprintf("%.2f", float64(amount)/float64(100000))
+}
+
+// History 统一历史记录，kind: reward withdraw balance trade
+func (uuc *UserUseCase) History(ctx context.Context, req *v1.HistoryRequest, user *User) (*v1.HistoryReply, error) {
+	q := &HistoryQuery{
+		Coin:     req.Coin,
+		Status:   req.Status,
+		Start:    req.Start,
+		End:      req.End,
+		Cursor:   req.Cursor,
+		PageSize: req.PageSize,
+		Sort:     req.Sort,
+	}
+	if "" != req.Type {
+		q.Types = []string{req.Type}
+	}
+	if "" != req.Reason {
+		q.Reasons = []string{req.Reason}
+	}
+
+	f, err := uuc.historyFilter(user.ID, q)
+	if nil != err {
+		return nil, err
+	}
+
+	res := &v1.HistoryReply{
+		List: make([]*v1.HistoryReply_List, 0),
+	}
+
+	var (
+		total *HistoryTotal
+		ids   = make([]int64, 0)
+	)
+	switch req.Kind {
+	case "reward":
+		var rewards []*Reward
+		rewards, err, total = uuc.ubRepo.GetUserRewardHistory(ctx, f)
+		if nil != err {
+			return nil, err
+		}
+		for _, v := range rewards {
+			ids = append(ids, v.ID)
+			res.List = append(res.List, &v1.HistoryReply_List{
+				Id:        v.ID,
+				Type:      v.Type,
+				Reason:    v.Reason,
+				Coin:      "usdt",
+				Amount:    formatAmount(v.Amount),
+				AmountB:   formatAmount(v.AmountB),
+				CreatedAt: uuc.day.Format(v.CreatedAt),
+			})
+		}
+	case "withdraw":
+		var withdraws []*Withdraw
+		f.Types, f.Reasons = nil, nil
+		withdraws, err, total = uuc.ubRepo.GetWithdrawHistory(ctx, f)
+		if nil != err {
+			return nil, err
+		}
+		for _, v := range withdraws {
+			ids = append(ids, v.ID)
+			res.List = append(res.List, &v1.HistoryReply_List{
+				Id:        v.ID,
+				Type:      "withdraw",
+				Coin:      v.Type,
+				Status:    v.Status,
+				Amount:    formatAmount(v.Amount),
+				AmountB:   formatAmount(v.RelAmount),
+				Fee:       formatAmount(v.Fee),
+				Burn:      formatAmount(v.Burn),
+				CreatedAt: uuc.day.Format(v.CreatedAt),
+				Address:   v.Address,
+			})
+		}
+	case "balance":
+		var records []*UserBalanceRecord
+		f.Reasons = nil
+		records, err, total = uuc.ubRepo.GetUserBalanceRecordHistory(ctx, f)
+		if nil != err {
+			return nil, err
+		}
+		for _, v := range records {
+			ids = append(ids, v.ID)
+			res.List = append(res.List, &v1.HistoryReply_List{
+				Id:        v.ID,
+				Type:      v.Type,
+				Coin:      v.CoinType,
+				Amount:    formatAmount(v.Amount),
+				AmountB:   formatAmount(v.Balance),
+				CreatedAt: uuc.day.Format(v.CreatedAt),
+			})
+		}
+	case "trade":
+		var trades []*Trade
+		f.Types, f.Reasons = nil, nil
+		trades, err, total = uuc.ubRepo.GetTradeHistory(ctx, f)
+		if nil != err {
+			return nil, err
+		}
+		for _, v := range trades {
+			ids = append(ids, v.ID)
+			res.List = append(res.List, &v1.HistoryReply_List{
+				Id:        v.ID,
+				Type:      "trade",
+				Status:    v.Status,
+				Amount:    formatAmount(v.AmountCsd),
+				AmountB:   formatAmount(v.AmountHbs),
+				CreatedAt: uuc.day.Format(v.CreatedAt),
+			})
+		}
+	default:
+		return nil, v1.ErrorParamInvalid("记录类型错误")
+	}
+
+	res.NextCursor = historyNextCursor(ids, f)
+	if int64(len(res.List)) > f.PageSize {
+		res.List = res.List[:f.PageSize]
+	}
+	res.Total = total.Count
+	res.TotalAmount = formatAmount(total.Amount)
+
+	return res, nil
+}
+
+// historyNextCursor 仓储多取一条判断是否有下一页，0 没有下一页
+func historyNextCursor(ids []int64, f *HistoryFilter) int64 {
+	if int64(len(ids)) <= f.PageSize {
+		return 0
+	}
+	return ids[f.PageSize-1]
+}
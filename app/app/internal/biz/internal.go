@@ -0,0 +1,325 @@
+package biz
+
+import (
+	"context"
+	v1 "dhb/app/app/api"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	internalTreeMaxDepth = 5
+	internalTreeMaxNodes = 1000 // 下级最多返回的数量
+)
+
+// InternalTransfer 内部服务加减余额记录，调用方和流水号唯一
+type InternalTransfer struct {
+	ID              int64
+	Caller          string
+	ReferenceId     string
+	UserId          int64
+	Coin            string
+	Amount          int64 // 扣减为负
+	Balance         int64
+	Reason          string
+	BalanceRecordId int64
+	CreatedAt       time.Time
+}
+
+type InternalTransferRepo interface {
+	CreateInternalTransfer(ctx context.Context, t *InternalTransfer) (*InternalTransfer, error)
+	GetInternalTransferByReference(ctx context.Context, caller string, referenceId string) (*InternalTransfer, error)
+	// UseNonce 记录签名 nonce，ttl 内已使用过返回 false
+	UseNonce(ctx context.Context, caller string, nonce string, ttl time.Duration) (bool, error)
+}
+
+type InternalUseCase struct {
+	repo         UserRepo
+	uiRepo       UserInfoRepo
+	ubRepo       UserBalanceRepo
+	urRepo       UserRecommendRepo
+	internalRepo InternalTransferRepo
+	uuc          *UserUseCase
+	bus          *EventBus
+	tx           Transaction
+	day          *BusinessDay
+	log          *log.Helper
+}
+
+func NewInternalUseCase(repo UserRepo, uiRepo UserInfoRepo, ubRepo UserBalanceRepo, urRepo UserRecommendRepo, internalRepo InternalTransferRepo, uuc *UserUseCase, bus *EventBus, tx Transaction, day *BusinessDay, logger log.Logger) *InternalUseCase {
+	return &InternalUseCase{
+		repo:         repo,
+		uiRepo:       uiRepo,
+		ubRepo:       ubRepo,
+		urRepo:       urRepo,
+		internalRepo: internalRepo,
+		uuc:          uuc,
+		bus:          bus,
+		tx:           tx,
+		day:          day,
+		log:          log.NewHelper(logger),
+	}
+}
+
+// UseNonce 防止签名重放，Redis 不可用时拒绝
+func (iuc *InternalUseCase) UseNonce(ctx context.Context, caller string, nonce string, ttl time.Duration) bool {
+	ok, err := iuc.internalRepo.UseNonce(ctx, caller, nonce, ttl)
+	if nil != err {
+		iuc.log.Errorf("internal nonce %s: %v", caller, err)
+		return false
+	}
+	return ok
+}
+
+// GetUser 按 id 或地址查询用户
+func (iuc *InternalUseCase) GetUser(ctx context.Context, req *v1.InternalGetUserRequest) (*v1.InternalUserReply, error) {
+	var (
+		user *User
+		err  error
+	)
+	if 0 < req.Id {
+		user, err = iuc.repo.GetUserById(ctx, req.Id)
+	} else if "" != req.Address {
+		user, err = iuc.repo.GetUserByAddress(ctx, req.Address)
+	} else {
+		return nil, v1.ErrorParamInvalid("id 和 address 不能都为空")
+	}
+	if nil != err {
+		return nil, err
+	}
+	if nil == user {
+		return nil, v1.ErrorUserNotFound("用户不存在")
+	}
+
+	res := &v1.InternalUserReply{
+		Id:      user.ID,
+		Address: user.Address,
+	}
+	if !user.CreatedAt.IsZero() {
+		res.CreatedAt = iuc.day.Format(user.CreatedAt)
+	}
+
+	userInfo, err := iuc.uiRepo.GetUserInfoByUserId(ctx, user.ID)
+	if nil != err && !errors.IsNotFound(err) {
+		return nil, err
+	}
+	if nil != userInfo {
+		res.Vip = userInfo.Vip
+	}
+
+	userRecommend, err := iuc.urRepo.GetUserRecommendByUserId(ctx, user.ID)
+	if nil != err && !errors.IsNotFound(err) {
+		return nil, err
+	}
+	if nil != userRecommend {
+		ancestorIds := recommendCodeUserIds(userRecommend.RecommendCode)
+		if 0 < len(ancestorIds) {
+			res.RecommendUserId = ancestorIds[len(ancestorIds)-1]
+		}
+	}
+
+	return res, nil
+}
+
+// GetBalance 查询余额
+func (iuc *InternalUseCase) GetBalance(ctx context.Context, req *v1.InternalGetBalanceRequest) (*v1.InternalBalanceReply, error) {
+	userBalance, err := iuc.ubRepo.GetUserBalance(ctx, req.UserId)
+	if nil != err {
+		return nil, err
+	}
+
+	return &v1.InternalBalanceReply{
+		UserId: userBalance.UserId,
+		Usdt:   userBalance.BalanceUsdt,
+		Dhb:    userBalance.BalanceDhb,
+	}, nil
+}
+
+// Transfer 加减余额，同一调用方同一流水号只执行一次，重复提交返回第一次的结果
+func (iuc *InternalUseCase) Transfer(ctx context.Context, caller string, req *v1.InternalTransferRequest, debit bool) (*v1.InternalTransferReply, error) {
+	if "" == caller {
+		return nil, v1.ErrorCallerUnauthorized("调用方未认证")
+	}
+	if 0 >= req.UserId || 0 >= req.Amount {
+		return nil, v1.ErrorParamInvalid("用户或金额错误")
+	}
+	if "usdt" != req.Coin && "dhb" != req.Coin {
+		return nil, v1.ErrorCoinUnsupported("币种错误")
+	}
+	if "" == req.ReferenceId || 64 < len(req.ReferenceId) || 45 < len(req.Reason) {
+		return nil, v1.ErrorParamInvalid("流水号或备注错误")
+	}
+
+	amount, recordType := req.Amount, "internal_credit"
+	if debit {
+		amount, recordType = -req.Amount, "internal_debit"
+	}
+
+	replay := func() (*v1.InternalTransferReply, error) {
+		t, err := iuc.internalRepo.GetInternalTransferByReference(ctx, caller, req.ReferenceId)
+		if nil != err || nil == t {
+			return nil, err
+		}
+		if t.UserId != req.UserId || t.Coin != req.Coin || t.Amount != amount {
+			return nil, v1.ErrorReferenceConflict("流水号已用于其他操作")
+		}
+		return iuc.transferReply(t, true), nil
+	}
+
+	res, err := replay()
+	if nil != err || nil != res {
+		return res, err
+	}
+
+	// usdt 充值入账分佣
+	var (
+		commissionEvent = &CommissionEvent{Type: "deposit", UserId: req.UserId, Amount: req.Amount}
+		commissions     []*CommissionReward
+	)
+	if !debit && "usdt" == req.Coin {
+		commissions, err = iuc.uuc.PlanCommission(ctx, commissionEvent)
+		if nil != err {
+			return nil, err
+		}
+	}
+
+	var t *InternalTransfer
+	if err = iuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		recordId, balance, err := iuc.ubRepo.ChangeBalance(ctx, req.UserId, req.Coin, amount, recordType)
+		if nil != err {
+			return err
+		}
+
+		t, err = iuc.internalRepo.CreateInternalTransfer(ctx, &InternalTransfer{
+			Caller:          caller,
+			ReferenceId:     req.ReferenceId,
+			UserId:          req.UserId,
+			Coin:            req.Coin,
+			Amount:          amount,
+			Balance:         balance,
+			Reason:          req.Reason,
+			BalanceRecordId: recordId,
+		})
+		if nil != err || debit {
+			return err
+		}
+
+		commissionEvent.RecordId = t.ID
+		err = iuc.uuc.payCommission(ctx, commissionEvent, commissions)
+		if nil != err {
+			return err
+		}
+
+		// 加余额来自链上充值的入账服务
+		return iuc.bus.Publish(ctx, &DepositCredited{
+			UserId:      req.UserId,
+			Coin:        req.Coin,
+			Amount:      formatAmount(req.Amount),
+			Balance:     formatAmount(balance),
+			ReferenceId: req.ReferenceId,
+			Reason:      req.Reason,
+		})
+	}); nil != err {
+		// 并发重复提交时唯一索引冲突，返回先提交的结果
+		if res, replayErr := replay(); nil == replayErr && nil != res {
+			return res, nil
+		}
+		return nil, err
+	}
+
+	return iuc.transferReply(t, false), nil
+}
+
+func (iuc *InternalUseCase) transferReply(t *InternalTransfer, replayed bool) *v1.InternalTransferReply {
+	amount := t.Amount
+	if 0 > amount {
+		amount = -amount
+	}
+
+	return &v1.InternalTransferReply{
+		Id:          t.ID,
+		UserId:      t.UserId,
+		Coin:        t.Coin,
+		Amount:      amount,
+		Balance:     t.Balance,
+		ReferenceId: t.ReferenceId,
+		Replayed:    replayed,
+		CreatedAt:   iuc.day.Format(t.CreatedAt),
+	}
+}
+
+// GetReferralTree 上级链路和指定层数内的下级
+func (iuc *InternalUseCase) GetReferralTree(ctx context.Context, req *v1.InternalReferralTreeRequest) (*v1.InternalReferralTreeReply, error) {
+	depth := req.Depth
+	if 0 >= depth {
+		depth = 1
+	}
+	if internalTreeMaxDepth < depth {
+		depth = internalTreeMaxDepth
+	}
+
+	userRecommend, err := iuc.urRepo.GetUserRecommendByUserId(ctx, req.UserId)
+	if nil != err {
+		return nil, err
+	}
+
+	res := &v1.InternalReferralTreeReply{
+		Ancestors:   make([]*v1.InternalReferralTreeReply_Node, 0),
+		Descendants: make([]*v1.InternalReferralTreeReply_Node, 0),
+	}
+
+	ancestorIds := recommendCodeUserIds(userRecommend.RecommendCode)
+	for i := len(ancestorIds) - 1; i >= 0; i-- {
+		res.Ancestors = append(res.Ancestors, &v1.InternalReferralTreeReply_Node{
+			UserId: ancestorIds[i],
+			Level:  int64(i - len(ancestorIds)),
+		})
+	}
+
+	myCode := userRecommend.RecommendCode + "D" + strconv.FormatInt(req.UserId, 10)
+	descendants, err := iuc.urRepo.GetUserRecommendLikeCode(ctx, myCode)
+	if nil != err {
+		return nil, err
+	}
+	for _, v := range descendants {
+		if internalTreeMaxNodes <= len(res.Descendants) {
+			break
+		}
+		if myCode != v.RecommendCode && !strings.HasPrefix(v.RecommendCode, myCode+"D") {
+			continue // 前缀相同的其他用户
+		}
+
+		level := 1 + int64(strings.Count(v.RecommendCode[len(myCode):], "D"))
+		if depth < level {
+			continue
+		}
+		res.Descendants = append(res.Descendants, &v1.InternalReferralTreeReply_Node{
+			UserId: v.UserId,
+			Level:  level,
+		})
+	}
+
+	userIds := make([]int64, 0, len(res.Ancestors)+len(res.Descendants))
+	for _, v := range res.Ancestors {
+		userIds = append(userIds, v.UserId)
+	}
+	for _, v := range res.Descendants {
+		userIds = append(userIds, v.UserId)
+	}
+	if 0 < len(userIds) {
+		users, err := iuc.repo.GetUserByUserIds(ctx, userIds...)
+		if nil != err {
+			return nil, err
+		}
+		for _, v := range append(res.Ancestors, res.Descendants...) {
+			if u, ok := users[v.UserId]; ok {
+				v.Address = u.Address
+			}
+		}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,368 @@
+package biz
+
+import (
+	"context"
+	"crypto/rand"
+	v1 "dhb/app/app/api"
+	"encoding/base64"
+	"github.com/go-kratos/kratos/v2/errors"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// crockford base32 字符表，校验位在此基础上多出 *~$=U 五个符号
+const (
+	crockfordAlphabet      = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+	crockfordCheckAlphabet = crockfordAlphabet + "*~$=U"
+	inviteCodeBodyLen      = 10 // 50位随机数，不可枚举
+	inviteCodeMaxDefault   = 10 // 每个用户默认最多可创建的推荐码数量
+)
+
+type UserInviteCode struct {
+	ID         int64
+	UserId     int64 // 0 为后台发放的根推荐码，使用者没有推荐人
+	Code       string
+	Name       string
+	Status     string // enable disable
+	UsedNum    int64
+	MaxUse     int64     // 0 不限制
+	ExpiredAt  time.Time // 零值不过期
+	LastUsedAt time.Time
+	CreatedAt  time.Time
+}
+
+type UserInviteCodeRepo interface {
+	CreateUserInviteCode(ctx context.Context, ic *UserInviteCode) (*UserInviteCode, error)
+	GetUserInviteCodeByCode(ctx context.Context, code string) (*UserInviteCode, error)
+	GetUserInviteCodesByUserId(ctx context.Context, userId int64) ([]*UserInviteCode, error)
+	UseUserInviteCode(ctx context.Context, id int64) error
+}
+
+// Usable 推荐码是否还能使用
+func (ic *UserInviteCode) Usable(now time.Time) bool {
+	if "enable" != ic.Status {
+		return false
+	}
+	if !ic.ExpiredAt.IsZero() && !now.Before(ic.ExpiredAt) {
+		return false
+	}
+	if 0 < ic.MaxUse && ic.UsedNum >= ic.MaxUse {
+		return false
+	}
+	return true
+}
+
+// GenerateInviteCode 生成随机推荐码，末位为 crockford mod 37 校验位
+func GenerateInviteCode() (string, error) {
+	var (
+		value uint64
+		code  = make([]byte, 0, inviteCodeBodyLen+1)
+	)
+	for i := 0; i < inviteCodeBodyLen; i++ {
+		n, err := rand.Int(rand.Reader, big.NewInt(int64(len(crockfordAlphabet))))
+		if nil != err {
+			return "", err
+		}
+		value = value*32 + n.Uint64()
+		code = append(code, crockfordAlphabet[n.Int64()])
+	}
+
+	code = append(code, crockfordCheckAlphabet[value%37])
+	return string(code), nil
+}
+
+// NormalizeInviteCode 按 crockford 规则规范化并校验推荐码，不合法返回 false
+func NormalizeInviteCode(code string) (string, bool) {
+	code = strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(code), "-", ""))
+	if inviteCodeBodyLen+1 != len(code) {
+		return "", false
+	}
+
+	var (
+		value uint64
+		res   = make([]byte, 0, len(code))
+	)
+	for i := 0; i < inviteCodeBodyLen; i++ {
+		c := code[i]
+		switch c {
+		case 'O':
+			c = '0'
+		case 'I', 'L':
+			c = '1'
+		}
+		index := strings.IndexByte(crockfordAlphabet, c)
+		if 0 > index {
+			return "", false
+		}
+		value = value*32 + uint64(index)
+		res = append(res, c)
+	}
+
+	if crockfordCheckAlphabet[value%37] != code[inviteCodeBodyLen] {
+		return "", false
+	}
+	res = append(res, code[inviteCodeBodyLen])
+	return string(res), true
+}
+
+// decodeLegacyInviteCode 兼容旧推荐码 base64("D"+userId)
+func decodeLegacyInviteCode(code string) (int64, bool) {
+	decodeBytes, err := base64.StdEncoding.DecodeString(code)
+	if nil != err {
+		return 0, false
+	}
+
+	code = string(decodeBytes)
+	if 1 >= len(code) || 'D' != code[0] {
+		return 0, false
+	}
+
+	userId, err := strconv.ParseInt(code[1:], 10, 64)
+	if 0 >= userId || nil != err {
+		return 0, false
+	}
+
+	return userId, true
+}
+
+// LegacyInviteCodeAllowed 旧推荐码是否还能使用，配置 invite_code_legacy_before：
+// 为空兼容所有旧推荐码，0 关闭，日期（UTC，2006-01-02 或 2006-01-02 15:04:05）时只兼容在这之前注册的推荐人
+func LegacyInviteCodeAllowed(value string, createdAt time.Time) bool {
+	value = strings.TrimSpace(value)
+	if "" == value {
+		return true
+	}
+
+	for _, layout := range []string{"2006-01-02 15:04:05", "2006-01-02"} {
+		if before, err := time.Parse(layout, value); nil == err {
+			return createdAt.Before(before)
+		}
+	}
+	return false
+}
+
+// legacyInviteCodeUser 旧推荐码对应的推荐人还能使用旧推荐码时返回 true
+func (uuc *UserUseCase) legacyInviteCodeUser(ctx context.Context, userId int64) bool {
+	var value string
+	configs, _ := uuc.configRepo.GetConfigByKeys(ctx, "invite_code_legacy_before")
+	if nil != configs {
+		for _, vConfig := range configs {
+			if "invite_code_legacy_before" == vConfig.KeyName {
+				value = vConfig.Value
+			}
+		}
+	}
+	if "" == strings.TrimSpace(value) {
+		return true
+	}
+
+	user, err := uuc.repo.GetUserById(ctx, userId)
+	if nil != err || nil == user {
+		return false
+	}
+	return LegacyInviteCodeAllowed(value, user.CreatedAt)
+}
+
+// ResolveInviteCode 解析推荐码，返回推荐人id和对应的推荐码记录，旧推荐码没有记录，根推荐码的推荐人id为0
+func (uuc *UserUseCase) ResolveInviteCode(ctx context.Context, code string) (int64, *UserInviteCode, error) {
+	if normalized, ok := NormalizeInviteCode(code); ok {
+		inviteCode, err := uuc.uicRepo.GetUserInviteCodeByCode(ctx, normalized)
+		if nil != err || nil == inviteCode {
+			return 0, nil, v1.ErrorRecommendCodeInvalid("无效的推荐码")
+		}
+
+		if !inviteCode.Usable(time.Now().UTC()) {
+			return 0, nil, v1.ErrorRecommendCodeExpired("推荐码已失效")
+		}
+
+		return inviteCode.UserId, inviteCode, nil
+	}
+
+	if userId, ok := decodeLegacyInviteCode(code); ok && uuc.legacyInviteCodeUser(ctx, userId) {
+		return userId, nil, nil
+	}
+
+	return 0, nil, v1.ErrorRecommendCodeInvalid("无效的推荐码")
+}
+
+// createInviteCode 生成不重复的推荐码并保存
+func (uuc *UserUseCase) createInviteCode(ctx context.Context, ic *UserInviteCode) (*UserInviteCode, error) {
+	var (
+		err  error
+		code string
+	)
+	for i := 0; i < 5; i++ {
+		code, err = GenerateInviteCode()
+		if nil != err {
+			return nil, err
+		}
+
+		var exist *UserInviteCode
+		exist, err = uuc.uicRepo.GetUserInviteCodeByCode(ctx, code)
+		if nil != err && !errors.IsNotFound(err) {
+			return nil, err
+		}
+		if nil != exist {
+			continue // 碰撞重新生成
+		}
+
+		ic.Code = code
+		ic.Status = "enable"
+		return uuc.uicRepo.CreateUserInviteCode(ctx, ic)
+	}
+
+	return nil, v1.ErrorInternalError("推荐码生成失败")
+}
+
+// GetDefaultInviteCode 用户的默认推荐码，老用户没有时补建
+func (uuc *UserUseCase) GetDefaultInviteCode(ctx context.Context, userId int64) (*UserInviteCode, error) {
+	inviteCodes, err := uuc.uicRepo.GetUserInviteCodesByUserId(ctx, userId)
+	if nil != err {
+		return nil, err
+	}
+
+	for _, v := range inviteCodes {
+		if "default" == v.Name && "enable" == v.Status {
+			return v, nil
+		}
+	}
+
+	return uuc.createInviteCode(ctx, &UserInviteCode{UserId: userId, Name: "default"})
+}
+
+// InviteCodeList 我的推荐码及使用统计
+func (uuc *UserUseCase) InviteCodeList(ctx context.Context, user *User) (*v1.InviteCodeListReply, error) {
+	var (
+		inviteCodes []*UserInviteCode
+		err         error
+	)
+
+	// 确保默认推荐码存在
+	_, err = uuc.GetDefaultInviteCode(ctx, user.ID)
+	if nil != err {
+		return nil, err
+	}
+
+	inviteCodes, err = uuc.uicRepo.GetUserInviteCodesByUserId(ctx, user.ID)
+	if nil != err {
+		return nil, err
+	}
+
+	res := &v1.InviteCodeListReply{
+		Codes: make([]*v1.InviteCodeListReply_List, 0),
+	}
+	for _, v := range inviteCodes {
+		var expiredAt, lastUsedAt string
+		if !v.ExpiredAt.IsZero() {
+			expiredAt = uuc.day.Format(v.ExpiredAt)
+		}
+		if !v.LastUsedAt.IsZero() {
+			lastUsedAt = uuc.day.Format(v.LastUsedAt)
+		}
+
+		res.Codes = append(res.Codes, &v1.InviteCodeListReply_List{
+			Code:       v.Code,
+			Name:       v.Name,
+			Status:     v.Status,
+			UsedNum:    v.UsedNum,
+			MaxUse:     v.MaxUse,
+			ExpiredAt:  expiredAt,
+			LastUsedAt: lastUsedAt,
+			CreatedAt:  uuc.day.Format(v.CreatedAt),
+		})
+	}
+
+	return res, nil
+}
+
+// InviteCodeCreate 新建命名推荐码
+func (uuc *UserUseCase) InviteCodeCreate(ctx context.Context, req *v1.InviteCodeCreateRequest, user *User) (*v1.InviteCodeCreateReply, error) {
+	var (
+		configs     []*Config
+		inviteCodes []*UserInviteCode
+		inviteCode  *UserInviteCode
+		codeMax     int64 = inviteCodeMaxDefault
+		err         error
+	)
+
+	name := strings.TrimSpace(req.SendBody.Name)
+	if "" == name || "default" == name || 45 < len(name) {
+		return nil, v1.ErrorParamInvalid("推荐码名称错误")
+	}
+	if 0 > req.SendBody.MaxUse || 0 > req.SendBody.ExpireDays {
+		return nil, v1.ErrorParamInvalid("参数错误")
+	}
+
+	// 配置
+	configs, err = uuc.configRepo.GetConfigByKeys(ctx, "invite_code_max")
+	if nil != configs {
+		for _, vConfig := range configs {
+			if "invite_code_max" == vConfig.KeyName {
+				codeMax, _ = strconv.ParseInt(vConfig.Value, 10, 64)
+			}
+		}
+	}
+
+	inviteCodes, err = uuc.uicRepo.GetUserInviteCodesByUserId(ctx, user.ID)
+	if nil != err {
+		return nil, err
+	}
+	if codeMax <= int64(len(inviteCodes)) {
+		return nil, v1.ErrorInviteCodeLimit("推荐码数量已达上限")
+	}
+	for _, v := range inviteCodes {
+		if name == v.Name {
+			return nil, v1.ErrorInviteCodeExists("推荐码名称已存在")
+		}
+	}
+
+	ic := &UserInviteCode{
+		UserId: user.ID,
+		Name:   name,
+		MaxUse: req.SendBody.MaxUse,
+	}
+	if 0 < req.SendBody.ExpireDays {
+		ic.ExpiredAt = time.Now().UTC().AddDate(0, 0, int(req.SendBody.ExpireDays))
+	}
+
+	inviteCode, err = uuc.createInviteCode(ctx, ic)
+	if nil != err {
+		return nil, err
+	}
+
+	return &v1.InviteCodeCreateReply{Code: inviteCode.Code}, nil
+}
+
+// AdminInviteCodeCreate 后台发放根推荐码，使用者成为顶级用户
+func (uuc *UserUseCase) AdminInviteCodeCreate(ctx context.Context, req *v1.AdminInviteCodeCreateRequest) (*v1.AdminInviteCodeCreateReply, error) {
+	var (
+		inviteCode *UserInviteCode
+		err        error
+	)
+
+	name := strings.TrimSpace(req.SendBody.Name)
+	if "" == name || 45 < len(name) {
+		return nil, v1.ErrorParamInvalid("推荐码名称错误")
+	}
+	if 0 > req.SendBody.MaxUse || 0 > req.SendBody.ExpireDays {
+		return nil, v1.ErrorParamInvalid("参数错误")
+	}
+
+	ic := &UserInviteCode{
+		UserId: 0,
+		Name:   name,
+		MaxUse: req.SendBody.MaxUse,
+	}
+	if 0 < req.SendBody.ExpireDays {
+		ic.ExpiredAt = time.Now().UTC().AddDate(0, 0, int(req.SendBody.ExpireDays))
+	}
+
+	inviteCode, err = uuc.createInviteCode(ctx, ic)
+	if nil != err {
+		return nil, err
+	}
+
+	return &v1.AdminInviteCodeCreateReply{Code: inviteCode.Code}, nil
+}
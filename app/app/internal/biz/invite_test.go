@@ -0,0 +1,101 @@
+package biz
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+)
+
+func TestGenerateInviteCode(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		code, err := GenerateInviteCode()
+		if nil != err {
+			t.Fatal(err)
+		}
+		if inviteCodeBodyLen+1 != len(code) {
+			t.Fatalf("GenerateInviteCode() = %q, want %d chars", code, inviteCodeBodyLen+1)
+		}
+		if normalized, ok := NormalizeInviteCode(code); !ok || normalized != code {
+			t.Fatalf("NormalizeInviteCode(%q) = %q, %v", code, normalized, ok)
+		}
+	}
+}
+
+func TestNormalizeInviteCode(t *testing.T) {
+	// 0000000001 的值为 1，校验位 crockfordCheckAlphabet[1] = '1'
+	tests := []struct {
+		name string
+		code string
+		want string
+		ok   bool
+	}{
+		{"valid", "00000000011", "00000000011", true},
+		{"lower case and dashes", " 00000-00001-1 ", "00000000011", true},
+		{"ambiguous letters", "OOOOOOOOOL1", "00000000011", true},
+		{"check symbol", "0000000010*", "0000000010*", true}, // 32%37=32 -> '*'
+		{"wrong check digit", "00000000012", "", false},
+		{"too short", "0000000001", "", false},
+		{"too long", "000000000011", "", false},
+		{"invalid char", "0000000000U", "", false}, // 0%37 -> '0'
+		{"U not allowed in body", "U0000000000", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := NormalizeInviteCode(tt.code)
+			if got != tt.want || ok != tt.ok {
+				t.Errorf("NormalizeInviteCode(%q) = %q, %v, want %q, %v", tt.code, got, ok, tt.want, tt.ok)
+			}
+		})
+	}
+}
+
+func TestDecodeLegacyInviteCode(t *testing.T) {
+	tests := []struct {
+		name   string
+		code   string
+		userId int64
+		ok     bool
+	}{
+		{"valid", base64.StdEncoding.EncodeToString([]byte("D123")), 123, true},
+		{"no prefix", base64.StdEncoding.EncodeToString([]byte("123")), 0, false},
+		{"zero", base64.StdEncoding.EncodeToString([]byte("D0")), 0, false},
+		{"not number", base64.StdEncoding.EncodeToString([]byte("Dabc")), 0, false},
+		{"only prefix", base64.StdEncoding.EncodeToString([]byte("D")), 0, false},
+		{"not base64", "!!!", 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userId, ok := decodeLegacyInviteCode(tt.code)
+			if userId != tt.userId || ok != tt.ok {
+				t.Errorf("decodeLegacyInviteCode(%q) = %d, %v, want %d, %v", tt.code, userId, ok, tt.userId, tt.ok)
+			}
+		})
+	}
+}
+
+func TestLegacyInviteCodeAllowed(t *testing.T) {
+	createdAt := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name  string
+		value string
+		want  bool
+	}{
+		{"empty allows all", "", true},
+		{"off", "0", false},
+		{"created before date", "2024-03-02", true},
+		{"created after date", "2024-03-01", false},
+		{"created before datetime", "2024-03-01 12:00:01", true},
+		{"created at datetime", "2024-03-01 12:00:00", false},
+		{"invalid", "soon", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := LegacyInviteCodeAllowed(tt.value, createdAt); got != tt.want {
+				t.Errorf("LegacyInviteCodeAllowed(%q) = %v, want %v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,247 @@
+package biz
+
+import (
+	"context"
+	v1 "dhb/app/app/api"
+	"github.com/go-kratos/kratos/v2/log"
+	"sort"
+	"time"
+)
+
+const schedulerLeaseName = "scheduler"
+
+type JobRun struct {
+	ID           int64
+	Name         string
+	BusinessDate string // 每日任务结算的业务日期，其他任务为空
+	Owner        string // 执行节点
+	Status       string // running success fail
+	Message      string
+	StartAt      time.Time
+	EndAt        time.Time
+	CreatedAt    time.Time
+}
+
+type JobRepo interface {
+	AcquireJobLease(ctx context.Context, name string, owner string, ttl time.Duration) (bool, error)
+	ReleaseJobLease(ctx context.Context, name string, owner string) error
+	// CreateJobRun 每日任务同一业务日只有一条记录，失败的记录重新置为执行中，执行中或已成功时返回错误
+	CreateJobRun(ctx context.Context, r *JobRun) (*JobRun, error)
+	FailRunningJobRuns(ctx context.Context, owner string, message string) error
+	UpdateJobRun(ctx context.Context, id int64, status string, message string, endAt time.Time) error
+	GetLastSuccessJobRun(ctx context.Context, name string) (*JobRun, error)
+	GetJobRuns(ctx context.Context, name string, b *Pagination) ([]*JobRun, error, int64)
+}
+
+// Job 定时任务，Daily 的任务按业务日结算，错过的日期会补跑
+type Job struct {
+	Name  string
+	Daily bool
+	Run   func(ctx context.Context, start time.Time, end time.Time) error // 每日任务为业务日区间，其他为触发时间
+}
+
+type JobUseCase struct {
+	jobRepo JobRepo
+	day     *BusinessDay
+	jobs    map[string]*Job
+	log     *log.Helper
+}
+
+func NewJobUseCase(jobRepo JobRepo, day *BusinessDay, auc *AreaUseCase, uuc *UserUseCase, puc *PositionUseCase, muc *MatrixUseCase, suc *StatementUseCase, wuc *WebhookUseCase, logger log.Logger) *JobUseCase {
+	juc := &JobUseCase{
+		jobRepo: jobRepo,
+		day:     day,
+		jobs:    make(map[string]*Job, 0),
+		log:     log.NewHelper(logger),
+	}
+
+	// 团队级别评定，区间截止到业务日结束
+	juc.Register(&Job{Name: "area_level", Daily: true, Run: func(ctx context.Context, start time.Time, end time.Time) error {
+		_, _, err := auc.EvaluateAreaLevel(ctx, day.Date(start))
+		if v1.IsAreaLevelEvaluated(err) { // 已经在后台手动评定过
+			return nil
+		}
+		return err
+	}})
+
+	// 理财计息，补跑时按业务日结束时间计算到期的周期
+	juc.Register(&Job{Name: "balance_reward", Daily: true, Run: func(ctx context.Context, start time.Time, end time.Time) error {
+		_, err := uuc.AccrueBalanceReward(ctx, end)
+		return err
+	}})
+
+	// 冷却期到期的理财赎回入账
+	juc.Register(&Job{Name: "balance_reward_unstake", Run: func(ctx context.Context, start time.Time, end time.Time) error {
+		_, err := uuc.MatureBalanceRewardUnstake(ctx, end)
+		return err
+	}})
+
+	// 封顶仓位出局，出局仓位让出排位
+	juc.Register(&Job{Name: "location_matrix", Run: func(ctx context.Context, start time.Time, end time.Time) error {
+		if _, err := puc.StopCapped(ctx); nil != err {
+			return err
+		}
+		_, err := muc.RemoveStopped(ctx)
+		return err
+	}})
+
+	// 后台生成对账单
+	juc.Register(&Job{Name: "statement", Run: func(ctx context.Context, start time.Time, end time.Time) error {
+		_, err := suc.GeneratePending(ctx)
+		return err
+	}})
+
+	// webhook 分发和重试
+	juc.Register(&Job{Name: "webhook", Run: func(ctx context.Context, start time.Time, end time.Time) error {
+		_, err := wuc.Dispatch(ctx, end)
+		return err
+	}})
+
+	return juc
+}
+
+// Register 注册任务，同名覆盖
+func (juc *JobUseCase) Register(job *Job) {
+	juc.jobs[job.Name] = job
+}
+
+// JobNames 已注册的任务
+func (juc *JobUseCase) JobNames() []string {
+	res := make([]string, 0, len(juc.jobs))
+	for name := range juc.jobs {
+		res = append(res, name)
+	}
+	sort.Strings(res)
+	return res
+}
+
+// IsDaily 是否为每日任务
+func (juc *JobUseCase) IsDaily(name string) bool {
+	job, ok := juc.jobs[name]
+	return ok && job.Daily
+}
+
+// Lead 竞选或续约主节点，只有主节点执行任务
+func (juc *JobUseCase) Lead(ctx context.Context, owner string, ttl time.Duration) bool {
+	ok, err := juc.jobRepo.AcquireJobLease(ctx, schedulerLeaseName, owner, ttl)
+	if nil != err {
+		juc.log.Errorf("scheduler lease: %v", err)
+		return false
+	}
+	return ok
+}
+
+// Resign 放弃主节点
+func (juc *JobUseCase) Resign(ctx context.Context, owner string) {
+	if err := juc.jobRepo.ReleaseJobLease(ctx, schedulerLeaseName, owner); nil != err {
+		juc.log.Errorf("scheduler lease release: %v", err)
+	}
+}
+
+// Takeover 成为主节点时把其他节点遗留的执行中记录置为失败，之后可以补跑
+func (juc *JobUseCase) Takeover(ctx context.Context, owner string) {
+	if err := juc.jobRepo.FailRunningJobRuns(ctx, owner, "执行节点失去租约"); nil != err {
+		juc.log.Errorf("scheduler takeover: %v", err)
+	}
+}
+
+// RunJob 执行任务。每日任务结算 now 之前已结束的业务日，从上次成功的下一天开始补跑，最多 catchUpDays 天
+func (juc *JobUseCase) RunJob(ctx context.Context, name string, owner string, now time.Time, catchUpDays int64) error {
+	job, ok := juc.jobs[name]
+	if !ok {
+		return v1.ErrorJobNotFound("任务不存在")
+	}
+
+	if !job.Daily {
+		return juc.runOnce(ctx, job, owner, "", now, now)
+	}
+
+	target := juc.day.AddDate(juc.day.Date(now), -1)
+	from := target
+	if 0 < catchUpDays {
+		from = juc.day.AddDate(target, -int(catchUpDays-1))
+	}
+
+	lastRun, err := juc.jobRepo.GetLastSuccessJobRun(ctx, name)
+	if nil != err {
+		return err
+	}
+	if nil != lastRun && "" != lastRun.BusinessDate {
+		if next := juc.day.AddDate(lastRun.BusinessDate, 1); next > from {
+			from = next
+		}
+	}
+
+	for date := from; date <= target; date = juc.day.AddDate(date, 1) {
+		start, end, err := juc.day.Range(date)
+		if nil != err {
+			return err
+		}
+
+		if err = juc.runOnce(ctx, job, owner, date, start, end); nil != err {
+			return err // 按日期顺序执行，失败后不再往后跑
+		}
+	}
+
+	return nil
+}
+
+func (juc *JobUseCase) runOnce(ctx context.Context, job *Job, owner string, date string, start time.Time, end time.Time) error {
+	run, err := juc.jobRepo.CreateJobRun(ctx, &JobRun{
+		Name:         job.Name,
+		BusinessDate: date,
+		Owner:        owner,
+		Status:       "running",
+		StartAt:      time.Now().UTC(),
+	})
+	if nil != err {
+		return err
+	}
+
+	status, message := "success", ""
+	if err = job.Run(ctx, start, end); nil != err {
+		status, message = "fail", err.Error()
+		juc.log.Errorf("job %s %s: %v", job.Name, date, err)
+	}
+
+	if updateErr := juc.jobRepo.UpdateJobRun(ctx, run.ID, status, message, time.Now().UTC()); nil != updateErr {
+		return updateErr
+	}
+
+	return err
+}
+
+// AdminJobRunList 任务执行记录
+func (juc *JobUseCase) AdminJobRunList(ctx context.Context, req *v1.AdminJobRunListRequest) (*v1.AdminJobRunListReply, error) {
+	runs, err, count := juc.jobRepo.GetJobRuns(ctx, req.Name, &Pagination{
+		PageNum:  int(req.Page),
+		PageSize: 10,
+	})
+	if nil != err {
+		return nil, err
+	}
+
+	res := &v1.AdminJobRunListReply{
+		Runs:  make([]*v1.AdminJobRunListReply_List, 0),
+		Count: count,
+	}
+	for _, v := range runs {
+		var endAt string
+		if !v.EndAt.IsZero() {
+			endAt = juc.day.Format(v.EndAt)
+		}
+
+		res.Runs = append(res.Runs, &v1.AdminJobRunListReply_List{
+			Id:           v.ID,
+			Name:         v.Name,
+			BusinessDate: v.BusinessDate,
+			Owner:        v.Owner,
+			Status:       v.Status,
+			Message:      v.Message,
+			StartAt:      juc.day.Format(v.StartAt),
+			EndAt:        endAt,
+		})
+	}
+
+	return res, nil
+}
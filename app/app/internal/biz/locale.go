@@ -0,0 +1,38 @@
+package biz
+
+import (
+	"context"
+	v1 "dhb/app/app/api"
+	"dhb/app/app/internal/pkg/i18n"
+)
+
+// GetUserLocale 用户设置的语言，未设置或查询失败时为空
+func (uuc *UserUseCase) GetUserLocale(ctx context.Context, userId int64) string {
+	user, err := uuc.repo.GetUserById(ctx, userId)
+	if nil != err {
+		return ""
+	}
+	return user.Locale
+}
+
+// Locale 当前语言，supported 为语言包中的语言
+func (uuc *UserUseCase) Locale(ctx context.Context, user *User, supported []string) (*v1.LocaleReply, error) {
+	res := &v1.LocaleReply{
+		Preference: uuc.GetUserLocale(ctx, user.ID),
+		Supported:  supported,
+	}
+	if l := i18n.FromContext(ctx); nil != l {
+		res.Locale = l.Tag
+	}
+
+	return res, nil
+}
+
+// LocaleChange 设置语言，locale 由 service 按语言包校验
+func (uuc *UserUseCase) LocaleChange(ctx context.Context, locale string, user *User) (*v1.LocaleChangeReply, error) {
+	if err := uuc.repo.UpdateUserLocale(ctx, user.ID, locale); nil != err {
+		return nil, err
+	}
+
+	return &v1.LocaleChangeReply{Status: "ok", Locale: locale}, nil
+}
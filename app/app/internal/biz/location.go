@@ -0,0 +1,229 @@
+package biz
+
+import (
+	"context"
+	v1 "dhb/app/app/api"
+	"fmt"
+	"strconv"
+)
+
+// 套餐配置下标 0 对应 1 号套餐
+var locationPackageKeys = []string{"one", "two", "three", "four", "five", "six"}
+
+// LocationPackage 可购买的套餐
+type LocationPackage struct {
+	Num   int64 // 套餐编号 1-6
+	Usdt  int64 // 金额
+	Stock int64 // 全平台可售数量，0 不限制
+}
+
+// LocationBuyRule 购买规则
+type LocationBuyRule struct {
+	Packages []*LocationPackage
+	Limit    int64 // 每个用户最多购买次数，0 不限制
+	Multiple int64 // 出局倍数千分比，CurrentMax = Usdt * Multiple / 1000
+}
+
+// Package 按编号查套餐
+func (rule *LocationBuyRule) Package(num int64) *LocationPackage {
+	for _, v := range rule.Packages {
+		if num == v.Num {
+			return v
+		}
+	}
+	return nil
+}
+
+// getLocationBuyRule 套餐金额 buy_amount_*，可售数量 buy_*，金额为 0 的套餐不开放
+func (uuc *UserUseCase) getLocationBuyRule(ctx context.Context) *LocationBuyRule {
+	var (
+		amounts = make([]int64, len(locationPackageKeys))
+		stocks  = make([]int64, len(locationPackageKeys))
+		keys    = []string{"buy_limit", "location_multiple"}
+		rule    = &LocationBuyRule{Multiple: 3000}
+	)
+	for _, v := range locationPackageKeys {
+		keys = append(keys, "buy_"+v, "buy_amount_"+v)
+	}
+
+	configs, _ := uuc.configRepo.GetConfigByKeys(ctx, keys...)
+	if nil != configs {
+		for _, vConfig := range configs {
+			if "buy_limit" == vConfig.KeyName {
+				rule.Limit, _ = strconv.ParseInt(vConfig.Value, 10, 64)
+			}
+			if "location_multiple" == vConfig.KeyName {
+				rule.Multiple, _ = strconv.ParseInt(vConfig.Value, 10, 64)
+			}
+			for i, v := range locationPackageKeys {
+				if "buy_"+v == vConfig.KeyName {
+					stocks[i], _ = strconv.ParseInt(vConfig.Value, 10, 64)
+				}
+				if "buy_amount_"+v == vConfig.KeyName {
+					amounts[i], _ = strconv.ParseInt(vConfig.Value+"00000", 10, 64)
+				}
+			}
+		}
+	}
+
+	rule.Packages = make([]*LocationPackage, 0)
+	for i := range locationPackageKeys {
+		if 0 >= amounts[i] {
+			continue
+		}
+		rule.Packages = append(rule.Packages, &LocationPackage{Num: int64(i + 1), Usdt: amounts[i], Stock: stocks[i]})
+	}
+
+	return rule
+}
+
+// placementTop 新仓位在排位树中的上级，为最近的有仓位的推荐人的最新仓位，都没有时为 0
+func (uuc *UserUseCase) placementTop(ctx context.Context, userId int64) (int64, error) {
+	userRecommend, err := uuc.urRepo.GetUserRecommendByUserId(ctx, userId)
+	if nil == userRecommend {
+		return 0, err
+	}
+
+	ancestorIds := recommendCodeUserIds(userRecommend.RecommendCode)
+	for i := len(ancestorIds) - 1; i >= 0; i-- {
+		locations, err := uuc.locationRepo.GetLocationsByUserId(ctx, ancestorIds[i])
+		if nil != err {
+			return 0, err
+		}
+		if 0 < len(locations) {
+			return locations[0].ID, nil // id 倒序
+		}
+	}
+
+	return 0, nil
+}
+
+// BuyLocation 购买套餐开仓，复投时沿用最近出局仓位的套餐并标记为已复投
+func (uuc *UserUseCase) BuyLocation(ctx context.Context, req *v1.BuyLocationRequest, user *User) (*v1.BuyLocationReply, error) {
+	var (
+		userBalance *UserBalance
+		stopped     *LocationNew
+		location    *LocationNew
+		rewards     []*CommissionReward
+		count       int64
+		err         error
+	)
+
+	rule := uuc.getLocationBuyRule(ctx)
+	num := req.SendBody.Num
+	if req.SendBody.Reinvest {
+		stopped, err = uuc.puc.GetLastStopped(ctx, user.ID)
+		if nil != err {
+			return nil, err
+		}
+		if nil == stopped {
+			return nil, v1.ErrorPositionNotRunning("没有可复投的仓位")
+		}
+		if 0 >= num {
+			num = stopped.Num
+		}
+	}
+
+	pkg := rule.Package(num)
+	if nil == pkg {
+		return nil, v1.ErrorPackageNotFound("套餐不存在")
+	}
+
+	userBalance, err = uuc.ubRepo.GetUserBalance(ctx, user.ID)
+	if nil != err {
+		return nil, err
+	}
+	if userBalance.BalanceUsdt < pkg.Usdt {
+		return nil, v1.ErrorBalanceInsufficient("余额不足")
+	}
+
+	event := &CommissionEvent{Type: "location", UserId: user.ID, Amount: pkg.Usdt}
+	rewards, err = uuc.PlanCommission(ctx, event)
+	if nil != err {
+		return nil, err
+	}
+
+	top, err := uuc.placementTop(ctx, user.ID)
+	if nil != err {
+		return nil, err
+	}
+
+	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		// 锁定余额，同一用户的购买串行，事务中重新读取规则检查次数
+		if _, err = uuc.ubRepo.LockUserBalance(ctx, user.ID); nil != err {
+			return err
+		}
+
+		rule = uuc.getLocationBuyRule(ctx)
+		current := rule.Package(num)
+		if nil == current || current.Usdt != pkg.Usdt {
+			return v1.ErrorPackageNotFound("套餐已变更，请重试")
+		}
+		pkg = current
+
+		if 0 < rule.Limit {
+			count, err = uuc.puc.GetCountByUserId(ctx, user.ID)
+			if nil != err {
+				return err
+			}
+			if rule.Limit <= count {
+				return v1.ErrorBuyLimit("已达到购买次数上限")
+			}
+		}
+
+		// 按套餐计数，条件更新保证并发购买不超卖
+		if 0 < pkg.Stock {
+			ok, err := uuc.locationRepo.ReserveLocationStock(ctx, pkg.Num, pkg.Stock, uuc.locationRepo.GetAllLocationsCount(ctx, pkg.Usdt))
+			if nil != err {
+				return err
+			}
+			if !ok {
+				return v1.ErrorPackageSoldOut("套餐已售罄")
+			}
+		}
+
+		_, err = uuc.ubRepo.SubUsdt(ctx, user.ID, pkg.Usdt, "location")
+		if nil != err {
+			return err
+		}
+
+		if nil != stopped {
+			err = uuc.puc.Reinvest(ctx, stopped.ID)
+			if nil != err {
+				return err
+			}
+		}
+
+		reason := "buy"
+		if nil != stopped {
+			reason = "reinvest"
+		}
+		location, err = uuc.puc.Open(ctx, &LocationNew{
+			UserId:     user.ID,
+			Num:        pkg.Num,
+			Top:        top,
+			Usdt:       pkg.Usdt,
+			CurrentMax: pkg.Usdt * rule.Multiple / 1000,
+		}, reason)
+		if nil != err {
+			return err
+		}
+
+		// 新仓位进入排位矩阵
+		if err = uuc.muc.Place(ctx, location); nil != err {
+			return err
+		}
+
+		event.RecordId = location.ID
+		return uuc.payCommission(ctx, event, rewards)
+	}); nil != err {
+		return nil, err
+	}
+
+	return &v1.BuyLocationReply{
+		Status:     "ok",
+		LocationId: location.ID,
+		Usdt:       fmt.Sprintf("%.2f", float64(location.Usdt)/float64(100000)),
+		CurrentMax: fmt.Sprintf("%.2f", float64(location.CurrentMax)/float64(100000)),
+	}, nil
+}
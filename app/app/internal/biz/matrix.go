@@ -0,0 +1,578 @@
+package biz
+
+import (
+	"context"
+	v1 "dhb/app/app/api"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/log"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// 排位默认每行 3 个
+const defaultMatrixWidth = 3
+
+// 排位方式，矩阵创建时由 location_matrix_mode 确定，之后修改不生效
+const (
+	MatrixModeShift = "shift" // 原有方式：出局后后面的仓位依次前移一位，新仓位排到尾部
+	MatrixModeFill  = "fill"  // 出局空出的位置由新仓位按空出顺序补上，其他仓位不移动
+)
+
+// MatrixPosition 位置下标换算行列，下标从 0 开始，行列从 1 开始
+func MatrixPosition(slot int64, width int64) (int64, int64) {
+	return slot/width + 1, slot%width + 1
+}
+
+// MatrixSlot 行列换算位置下标
+func MatrixSlot(row int64, col int64, width int64) int64 {
+	return (row-1)*width + col - 1
+}
+
+// Matrix 排位矩阵，shift 方式移除时后面的仓位依次前移，移除是 O(n)；
+// fill 方式空出的位置由后面新进的仓位按空出顺序补上，其他仓位不移动，放置和移除都是 O(1)
+type Matrix struct {
+	width int64
+	mode  string
+	rows  map[int64][]int64 // 行 -> 每列的仓位 id，0 为空位
+	index map[int64]int64   // 仓位 id -> 位置下标
+	free  []int64           // 空出的位置下标，先空出的先补，只用于 fill
+	next  int64             // 尾部下一个位置下标
+}
+
+func NewMatrix(width int64, mode string) *Matrix {
+	if 0 >= width {
+		width = defaultMatrixWidth
+	}
+	if MatrixModeFill != mode {
+		mode = MatrixModeShift
+	}
+	return &Matrix{
+		width: width,
+		mode:  mode,
+		rows:  make(map[int64][]int64, 0),
+		index: make(map[int64]int64, 0),
+		free:  make([]int64, 0),
+	}
+}
+
+// Width 每行位置数
+func (m *Matrix) Width() int64 {
+	return m.width
+}
+
+// Place 放置仓位，返回位置下标；已在矩阵中时返回原位置
+func (m *Matrix) Place(id int64) int64 {
+	if slot, ok := m.index[id]; ok {
+		return slot
+	}
+
+	var slot int64
+	if 0 < len(m.free) {
+		slot = m.free[0]
+		m.free = m.free[1:]
+	} else {
+		slot = m.next
+		m.next++
+	}
+
+	m.set(id, slot)
+
+	return slot
+}
+
+func (m *Matrix) set(id int64, slot int64) {
+	row, col := MatrixPosition(slot, m.width)
+	if _, ok := m.rows[row]; !ok {
+		m.rows[row] = make([]int64, m.width)
+	}
+	m.rows[row][col-1] = id
+	m.index[id] = slot
+}
+
+// Remove 移除仓位，不在矩阵中时返回 false
+func (m *Matrix) Remove(id int64) bool {
+	slot, ok := m.index[id]
+	if !ok {
+		return false
+	}
+
+	row, col := MatrixPosition(slot, m.width)
+	m.rows[row][col-1] = 0
+	delete(m.index, id)
+	if MatrixModeFill == m.mode {
+		m.free = append(m.free, slot)
+		return true
+	}
+
+	// 后面的仓位依次前移一位，和原有 UpdateLocationRowAndCol 一致
+	for tmpSlot := slot + 1; tmpSlot < m.next; tmpSlot++ {
+		tmpRow, tmpCol := MatrixPosition(tmpSlot, m.width)
+		r, ok := m.rows[tmpRow]
+		if !ok || 0 == r[tmpCol-1] {
+			continue
+		}
+		tmpId := r[tmpCol-1]
+		r[tmpCol-1] = 0
+		m.set(tmpId, tmpSlot-1)
+	}
+	m.next--
+
+	return true
+}
+
+// Seed 按已有位置登记仓位，只用于回放初始化记录，登记完成后调用 Seal
+func (m *Matrix) Seed(id int64, slot int64) {
+	m.set(id, slot)
+	if slot >= m.next {
+		m.next = slot + 1
+	}
+}
+
+// Seal 登记完成，fill 方式中间的空位按位置顺序作为空出位置，shift 方式空位保留
+func (m *Matrix) Seal() {
+	if MatrixModeFill != m.mode {
+		return
+	}
+	for slot := int64(0); slot < m.next; slot++ {
+		row, col := MatrixPosition(slot, m.width)
+		if r, ok := m.rows[row]; !ok || 0 == r[col-1] {
+			m.free = append(m.free, slot)
+		}
+	}
+}
+
+// Position 仓位所在行列
+func (m *Matrix) Position(id int64) (int64, int64, bool) {
+	slot, ok := m.index[id]
+	if !ok {
+		return 0, 0, false
+	}
+	row, col := MatrixPosition(slot, m.width)
+	return row, col, true
+}
+
+// Row 一行中的仓位 id，0 为空位
+func (m *Matrix) Row(row int64) []int64 {
+	res := make([]int64, m.width)
+	copy(res, m.rows[row])
+	return res
+}
+
+// LocationMatrix 矩阵状态，宽度和排位方式在第一次排位时确定
+type LocationMatrix struct {
+	Width    int64
+	Mode     string
+	NextSlot int64
+}
+
+// LocationMatrixEvent 排位记录，用于回放校验
+type LocationMatrixEvent struct {
+	ID         int64
+	LocationId int64
+	Action     string // seed place remove，seed 为初始化时按原行列登记
+	Slot       int64
+	Row        int64
+	Col        int64
+	CreatedAt  time.Time
+}
+
+type MatrixRepo interface {
+	LockLocationMatrix(ctx context.Context, width int64, mode string) (*LocationMatrix, bool, error)
+	UpdateLocationMatrixNextSlot(ctx context.Context, nextSlot int64) error
+	PopLocationMatrixFreeSlot(ctx context.Context) (int64, bool, error)
+	PushLocationMatrixFreeSlot(ctx context.Context, slot int64) error
+	ShiftLocationAfterSlot(ctx context.Context, slot int64, width int64) error
+	UpdateLocationRowAndCol(ctx context.Context, id int64, row int64, col int64) error
+	UpdateLocationStopIsUpdate(ctx context.Context, id int64) error
+	CreateLocationMatrixEvent(ctx context.Context, e *LocationMatrixEvent) error
+	GetLocationMatrixEvents(ctx context.Context) ([]*LocationMatrixEvent, error)
+	GetLocationMatrix(ctx context.Context) (*LocationMatrix, error)
+	GetPlacedLocations(ctx context.Context) ([]*LocationNew, error)
+	GetUnplacedLocations(ctx context.Context) ([]*LocationNew, error)
+	GetStoppedPlacedLocations(ctx context.Context) ([]*LocationNew, error)
+}
+
+// MatrixUseCase 排位服务，仓位的行列只能通过这里修改
+type MatrixUseCase struct {
+	matrixRepo   MatrixRepo
+	locationRepo LocationRepo
+	configRepo   ConfigRepo
+	tx           Transaction
+	log          *log.Helper
+}
+
+func NewMatrixUseCase(matrixRepo MatrixRepo, locationRepo LocationRepo, configRepo ConfigRepo, tx Transaction, logger log.Logger) *MatrixUseCase {
+	return &MatrixUseCase{
+		matrixRepo:   matrixRepo,
+		locationRepo: locationRepo,
+		configRepo:   configRepo,
+		tx:           tx,
+		log:          log.NewHelper(logger),
+	}
+}
+
+// getMatrixWidth 每行位置数 location_row_width，矩阵已有排位后修改不生效
+func (muc *MatrixUseCase) getMatrixWidth(ctx context.Context) int64 {
+	var width int64 = defaultMatrixWidth
+	configs, _ := muc.configRepo.GetConfigByKeys(ctx, "location_row_width")
+	if nil != configs {
+		for _, vConfig := range configs {
+			if "location_row_width" == vConfig.KeyName {
+				width, _ = strconv.ParseInt(vConfig.Value, 10, 64)
+			}
+		}
+	}
+	if 0 >= width {
+		width = defaultMatrixWidth
+	}
+
+	return width
+}
+
+// getMatrixMode 排位方式 location_matrix_mode，默认 shift 保持原有排位，矩阵已有排位后修改不生效
+func (muc *MatrixUseCase) getMatrixMode(ctx context.Context) string {
+	configs, _ := muc.configRepo.GetConfigByKeys(ctx, "location_matrix_mode")
+	if nil != configs {
+		for _, vConfig := range configs {
+			if "location_matrix_mode" == vConfig.KeyName && MatrixModeFill == vConfig.Value {
+				return MatrixModeFill
+			}
+		}
+	}
+
+	return MatrixModeShift
+}
+
+// lock 事务中使用，锁定矩阵，矩阵第一次创建时在同一事务中按已有仓位初始化，返回初始化时排位的仓位
+func (muc *MatrixUseCase) lock(ctx context.Context) (*LocationMatrix, map[int64]*LocationNew, error) {
+	matrix, created, err := muc.matrixRepo.LockLocationMatrix(ctx, muc.getMatrixWidth(ctx), muc.getMatrixMode(ctx))
+	if nil != err {
+		return nil, nil, err
+	}
+	if !created {
+		return matrix, nil, nil
+	}
+
+	placed, err := muc.backfill(ctx, matrix)
+	if nil != err {
+		return nil, nil, err
+	}
+	return matrix, placed, nil
+}
+
+// backfill 只执行一次：已有行列的仓位按原位置登记，fill 方式中间的空位作为空出位置，其余运行中的仓位按 id 依次排位
+func (muc *MatrixUseCase) backfill(ctx context.Context, matrix *LocationMatrix) (map[int64]*LocationNew, error) {
+	seeded, err := muc.matrixRepo.GetPlacedLocations(ctx)
+	if nil != err {
+		return nil, err
+	}
+
+	occupied := make(map[int64]bool, 0)
+	for _, v := range seeded {
+		if v.Col > matrix.Width {
+			return nil, fmt.Errorf("matrix backfill: location %d at %d-%d exceeds width %d", v.ID, v.Row, v.Col, matrix.Width)
+		}
+		slot := MatrixSlot(v.Row, v.Col, matrix.Width)
+		if occupied[slot] {
+			return nil, fmt.Errorf("matrix backfill: location %d at %d-%d already occupied", v.ID, v.Row, v.Col)
+		}
+		occupied[slot] = true
+		if slot >= matrix.NextSlot {
+			matrix.NextSlot = slot + 1
+		}
+
+		if err = muc.matrixRepo.CreateLocationMatrixEvent(ctx, &LocationMatrixEvent{
+			LocationId: v.ID,
+			Action:     "seed",
+			Slot:       slot,
+			Row:        v.Row,
+			Col:        v.Col,
+		}); nil != err {
+			return nil, err
+		}
+	}
+
+	for slot := int64(0); slot < matrix.NextSlot && MatrixModeFill == matrix.Mode; slot++ {
+		if occupied[slot] {
+			continue
+		}
+		if err = muc.matrixRepo.PushLocationMatrixFreeSlot(ctx, slot); nil != err {
+			return nil, err
+		}
+	}
+	if err = muc.matrixRepo.UpdateLocationMatrixNextSlot(ctx, matrix.NextSlot); nil != err {
+		return nil, err
+	}
+
+	unplaced, err := muc.matrixRepo.GetUnplacedLocations(ctx)
+	if nil != err {
+		return nil, err
+	}
+	placed := make(map[int64]*LocationNew, 0)
+	for _, v := range unplaced {
+		if err = muc.place(ctx, matrix, v); nil != err {
+			return nil, err
+		}
+		placed[v.ID] = v
+	}
+
+	muc.log.Infof("matrix backfill: %d seeded, %d placed", len(seeded), len(placed))
+	return placed, nil
+}
+
+// Place 事务中使用，给新仓位排位：fill 方式优先补最早空出的位置，否则排到尾部
+func (muc *MatrixUseCase) Place(ctx context.Context, location *LocationNew) error {
+	matrix, placed, err := muc.lock(ctx)
+	if nil != err {
+		return err
+	}
+	if v, ok := placed[location.ID]; ok { // 初始化时已经排位
+		location.Row, location.Col = v.Row, v.Col
+		return nil
+	}
+
+	return muc.place(ctx, matrix, location)
+}
+
+func (muc *MatrixUseCase) place(ctx context.Context, matrix *LocationMatrix, location *LocationNew) error {
+	var (
+		slot int64
+		ok   bool
+		err  error
+	)
+	if MatrixModeFill == matrix.Mode {
+		slot, ok, err = muc.matrixRepo.PopLocationMatrixFreeSlot(ctx)
+		if nil != err {
+			return err
+		}
+	}
+	if !ok {
+		slot = matrix.NextSlot
+		matrix.NextSlot++
+		if err = muc.matrixRepo.UpdateLocationMatrixNextSlot(ctx, matrix.NextSlot); nil != err {
+			return err
+		}
+	}
+
+	row, col := MatrixPosition(slot, matrix.Width)
+	if err = muc.matrixRepo.UpdateLocationRowAndCol(ctx, location.ID, row, col); nil != err {
+		return err
+	}
+
+	location.Row = row
+	location.Col = col
+	return muc.matrixRepo.CreateLocationMatrixEvent(ctx, &LocationMatrixEvent{
+		LocationId: location.ID,
+		Action:     "place",
+		Slot:       slot,
+		Row:        row,
+		Col:        col,
+	})
+}
+
+// Remove 事务中使用，出局仓位让出位置，shift 方式后面的仓位依次前移，fill 方式后面的仓位不移动
+func (muc *MatrixUseCase) Remove(ctx context.Context, location *LocationNew) error {
+	matrix, _, err := muc.lock(ctx)
+	if nil != err {
+		return err
+	}
+
+	if 0 < location.Row && 0 < location.Col {
+		slot := MatrixSlot(location.Row, location.Col, matrix.Width)
+		if MatrixModeFill == matrix.Mode {
+			err = muc.matrixRepo.PushLocationMatrixFreeSlot(ctx, slot)
+		} else {
+			err = muc.shift(ctx, matrix, slot)
+		}
+		if nil != err {
+			return err
+		}
+
+		if err = muc.matrixRepo.CreateLocationMatrixEvent(ctx, &LocationMatrixEvent{
+			LocationId: location.ID,
+			Action:     "remove",
+			Slot:       slot,
+			Row:        location.Row,
+			Col:        location.Col,
+		}); nil != err {
+			return err
+		}
+	}
+
+	return muc.matrixRepo.UpdateLocationStopIsUpdate(ctx, location.ID)
+}
+
+// shift 事务中使用，slot 之后的仓位前移一位
+func (muc *MatrixUseCase) shift(ctx context.Context, matrix *LocationMatrix, slot int64) error {
+	if err := muc.matrixRepo.ShiftLocationAfterSlot(ctx, slot, matrix.Width); nil != err {
+		return err
+	}
+	if slot >= matrix.NextSlot {
+		return nil
+	}
+
+	matrix.NextSlot--
+	return muc.matrixRepo.UpdateLocationMatrixNextSlot(ctx, matrix.NextSlot)
+}
+
+// RemoveStopped 已出局但还占着位置的仓位让出位置，矩阵还没有初始化时先初始化
+func (muc *MatrixUseCase) RemoveStopped(ctx context.Context) (int64, error) {
+	if err := muc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		_, _, err := muc.lock(ctx)
+		return err
+	}); nil != err {
+		return 0, err
+	}
+
+	locations, err := muc.matrixRepo.GetStoppedPlacedLocations(ctx)
+	if nil != err {
+		return 0, err
+	}
+
+	var num int64
+	for _, v := range locations {
+		if err = muc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+			return muc.Remove(ctx, v)
+		}); nil != err {
+			muc.log.Errorf("location %d matrix remove: %v", v.ID, err)
+			continue
+		}
+		num++
+	}
+
+	return num, nil
+}
+
+// MatrixReplay 回放结果，Divergences 为 fill 方式的排位和原有 shift 方式相比不同的仓位
+type MatrixReplay struct {
+	Mode        string
+	Events      int64
+	Placed      int64
+	Mismatches  []string
+	Divergences []string
+}
+
+// replayMatrix 按排位记录在内存中重新排位，checkSlot 时和记录里的位置比对
+func replayMatrix(width int64, mode string, events []*LocationMatrixEvent, checkSlot bool) (*Matrix, []string) {
+	mismatches := make([]string, 0)
+	matrix := NewMatrix(width, mode)
+	sealed := false
+	for _, v := range events {
+		if "seed" == v.Action {
+			matrix.Seed(v.LocationId, v.Slot)
+			continue
+		}
+		if !sealed {
+			matrix.Seal()
+			sealed = true
+		}
+
+		switch v.Action {
+		case "place":
+			if slot := matrix.Place(v.LocationId); checkSlot && slot != v.Slot {
+				mismatches = append(mismatches, fmt.Sprintf("event %d: location %d placed at slot %d, replay %d", v.ID, v.LocationId, v.Slot, slot))
+			}
+		case "remove":
+			if !matrix.Remove(v.LocationId) {
+				mismatches = append(mismatches, fmt.Sprintf("event %d: location %d removed but not placed", v.ID, v.LocationId))
+			}
+		}
+	}
+	if !sealed {
+		matrix.Seal()
+	}
+
+	return matrix, mismatches
+}
+
+// MatrixDivergences matrix 和 legacy 中位置不同的仓位，按 id 排序
+func MatrixDivergences(matrix *Matrix, legacy *Matrix) []string {
+	ids := make([]int64, 0, len(matrix.index))
+	for id := range matrix.index {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	res := make([]string, 0)
+	for _, id := range ids {
+		row, col, _ := matrix.Position(id)
+		legacyRow, legacyCol, ok := legacy.Position(id)
+		if !ok {
+			res = append(res, fmt.Sprintf("location %d at %d-%d, shift not placed", id, row, col))
+			continue
+		}
+		if row != legacyRow || col != legacyCol {
+			res = append(res, fmt.Sprintf("location %d at %d-%d, shift %d-%d", id, row, col, legacyRow, legacyCol))
+		}
+	}
+
+	return res
+}
+
+// Replay 按排位记录在内存中重新排位，和记录里的位置、仓位当前的行列逐一比对，
+// fill 方式同时按原有 shift 方式回放，列出排位不同的仓位
+func (muc *MatrixUseCase) Replay(ctx context.Context) (*MatrixReplay, error) {
+	matrixState, err := muc.matrixRepo.GetLocationMatrix(ctx)
+	if nil != err {
+		return nil, err
+	}
+	res := &MatrixReplay{Mismatches: make([]string, 0), Divergences: make([]string, 0)}
+	if nil == matrixState {
+		return res, nil
+	}
+	res.Mode = matrixState.Mode
+
+	events, err := muc.matrixRepo.GetLocationMatrixEvents(ctx)
+	if nil != err {
+		return nil, err
+	}
+	res.Events = int64(len(events))
+
+	matrix, mismatches := replayMatrix(matrixState.Width, matrixState.Mode, events, true)
+	res.Mismatches = append(res.Mismatches, mismatches...)
+	if MatrixModeFill == matrixState.Mode {
+		legacy, _ := replayMatrix(matrixState.Width, MatrixModeShift, events, false)
+		res.Divergences = MatrixDivergences(matrix, legacy)
+	}
+	if matrix.next != matrixState.NextSlot {
+		res.Mismatches = append(res.Mismatches, fmt.Sprintf("next slot %d, replay %d", matrixState.NextSlot, matrix.next))
+	}
+
+	locations, err := muc.matrixRepo.GetPlacedLocations(ctx)
+	if nil != err {
+		return nil, err
+	}
+	for _, v := range locations {
+		row, col, ok := matrix.Position(v.ID)
+		if !ok {
+			res.Mismatches = append(res.Mismatches, fmt.Sprintf("location %d at %d-%d, replay not placed", v.ID, v.Row, v.Col))
+			continue
+		}
+		res.Placed++
+		if row != v.Row || col != v.Col {
+			res.Mismatches = append(res.Mismatches, fmt.Sprintf("location %d at %d-%d, replay %d-%d", v.ID, v.Row, v.Col, row, col))
+		}
+	}
+	if res.Placed != int64(len(matrix.index)) {
+		res.Mismatches = append(res.Mismatches, fmt.Sprintf("%d locations placed, replay %d", res.Placed, len(matrix.index)))
+	}
+
+	return res, nil
+}
+
+// AdminMatrixReplay 排位回放校验
+func (muc *MatrixUseCase) AdminMatrixReplay(ctx context.Context, req *v1.AdminMatrixReplayRequest) (*v1.AdminMatrixReplayReply, error) {
+	replay, err := muc.Replay(ctx)
+	if nil != err {
+		return nil, err
+	}
+
+	return &v1.AdminMatrixReplayReply{
+		Events:      replay.Events,
+		Placed:      replay.Placed,
+		Mismatches:  replay.Mismatches,
+		Mode:        replay.Mode,
+		Divergences: replay.Divergences,
+	}, nil
+}
@@ -0,0 +1,179 @@
+package biz
+
+import (
+	"reflect"
+	"testing"
+)
+
+func matrixSlots(m *Matrix, ids ...int64) []int64 {
+	res := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		row, col, ok := m.Position(id)
+		if !ok {
+			res = append(res, -1)
+			continue
+		}
+		res = append(res, MatrixSlot(row, col, m.Width()))
+	}
+	return res
+}
+
+func TestMatrixPosition(t *testing.T) {
+	tests := []struct {
+		slot int64
+		row  int64
+		col  int64
+	}{
+		{0, 1, 1},
+		{2, 1, 3},
+		{3, 2, 1},
+		{7, 3, 2},
+	}
+
+	for _, tt := range tests {
+		row, col := MatrixPosition(tt.slot, 3)
+		if row != tt.row || col != tt.col {
+			t.Errorf("MatrixPosition(%d) = %d-%d, want %d-%d", tt.slot, row, col, tt.row, tt.col)
+		}
+		if slot := MatrixSlot(row, col, 3); slot != tt.slot {
+			t.Errorf("MatrixSlot(%d, %d) = %d, want %d", row, col, slot, tt.slot)
+		}
+	}
+}
+
+func TestMatrixPlaceRemove(t *testing.T) {
+	tests := []struct {
+		name string
+		mode string
+		// 放置 1-5，移除 2，再放置 6 7 后 1-7 的位置，-1 为不在矩阵中
+		want []int64
+	}{
+		{"shift", MatrixModeShift, []int64{0, -1, 1, 2, 3, 4, 5}},
+		{"fill", MatrixModeFill, []int64{0, -1, 2, 3, 4, 1, 5}},
+		{"unknown mode is shift", "", []int64{0, -1, 1, 2, 3, 4, 5}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewMatrix(3, tt.mode)
+			for id := int64(1); id <= 5; id++ {
+				if slot := m.Place(id); slot != id-1 {
+					t.Fatalf("Place(%d) = %d, want %d", id, slot, id-1)
+				}
+			}
+			if slot := m.Place(3); 2 != slot {
+				t.Errorf("Place(3) again = %d, want 2", slot)
+			}
+			if !m.Remove(2) {
+				t.Fatal("Remove(2) = false")
+			}
+			if m.Remove(2) || m.Remove(99) {
+				t.Error("Remove() of missing location = true")
+			}
+			m.Place(6)
+			m.Place(7)
+
+			if got := matrixSlots(m, 1, 2, 3, 4, 5, 6, 7); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("slots = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatrixSeal(t *testing.T) {
+	tests := []struct {
+		name string
+		mode string
+		want []int64 // 登记 1@0 2@2 5@5 后放置 3 4 6 的位置
+		row  []int64 // 第一行
+	}{
+		{"shift keeps gaps", MatrixModeShift, []int64{6, 7, 8}, []int64{1, 0, 2}},
+		{"fill reuses gaps in order", MatrixModeFill, []int64{1, 3, 4}, []int64{1, 3, 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewMatrix(3, tt.mode)
+			m.Seed(1, 0)
+			m.Seed(2, 2)
+			m.Seed(5, 5)
+			m.Seal()
+			got := []int64{m.Place(3), m.Place(4), m.Place(6)}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Place() = %v, want %v", got, tt.want)
+			}
+			if row := m.Row(1); !reflect.DeepEqual(row, tt.row) {
+				t.Errorf("Row(1) = %v, want %v", row, tt.row)
+			}
+		})
+	}
+}
+
+func TestReplayMatrix(t *testing.T) {
+	events := []*LocationMatrixEvent{
+		{ID: 1, LocationId: 1, Action: "seed", Slot: 0},
+		{ID: 2, LocationId: 2, Action: "seed", Slot: 1},
+		{ID: 3, LocationId: 3, Action: "place", Slot: 2},
+		{ID: 4, LocationId: 1, Action: "remove", Slot: 0},
+		{ID: 5, LocationId: 4, Action: "place", Slot: 0},
+	}
+
+	tests := []struct {
+		name       string
+		mode       string
+		events     []*LocationMatrixEvent
+		slots      []int64
+		mismatches int
+	}{
+		{"fill", MatrixModeFill, events, []int64{-1, 1, 2, 0}, 0},
+		{"shift places at tail", MatrixModeShift, events, []int64{-1, 0, 1, 2}, 1},
+		{"remove before place", MatrixModeFill, []*LocationMatrixEvent{
+			{ID: 1, LocationId: 9, Action: "remove"},
+		}, []int64{-1, -1, -1, -1}, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, mismatches := replayMatrix(3, tt.mode, tt.events, true)
+			if got := matrixSlots(m, 1, 2, 3, 4); !reflect.DeepEqual(got, tt.slots) {
+				t.Errorf("slots = %v, want %v", got, tt.slots)
+			}
+			if len(mismatches) != tt.mismatches {
+				t.Errorf("mismatches = %v, want %d", mismatches, tt.mismatches)
+			}
+		})
+	}
+
+	if _, mismatches := replayMatrix(3, MatrixModeShift, events, false); 0 != len(mismatches) {
+		t.Errorf("mismatches without checkSlot = %v, want none", mismatches)
+	}
+}
+
+func TestMatrixDivergences(t *testing.T) {
+	events := []*LocationMatrixEvent{
+		{ID: 1, LocationId: 1, Action: "place", Slot: 0},
+		{ID: 2, LocationId: 2, Action: "place", Slot: 1},
+		{ID: 3, LocationId: 3, Action: "place", Slot: 2},
+		{ID: 4, LocationId: 1, Action: "remove", Slot: 0},
+		{ID: 5, LocationId: 4, Action: "place", Slot: 0},
+	}
+	fill, _ := replayMatrix(3, MatrixModeFill, events, false)
+	shift, _ := replayMatrix(3, MatrixModeShift, events, false)
+
+	want := []string{
+		"location 2 at 1-2, shift 1-1",
+		"location 3 at 1-3, shift 1-2",
+		"location 4 at 1-1, shift 1-3",
+	}
+	if got := MatrixDivergences(fill, shift); !reflect.DeepEqual(got, want) {
+		t.Errorf("MatrixDivergences() = %v, want %v", got, want)
+	}
+	if got := MatrixDivergences(shift, shift); 0 != len(got) {
+		t.Errorf("MatrixDivergences() of same matrix = %v, want none", got)
+	}
+
+	shift.Remove(4)
+	if got := MatrixDivergences(fill, shift); "location 4 at 1-1, shift not placed" != got[len(got)-1] {
+		t.Errorf("MatrixDivergences() = %v, want location 4 not placed", got)
+	}
+}
@@ -0,0 +1,330 @@
+package biz
+
+import (
+	"context"
+	v1 "dhb/app/app/api"
+	"github.com/go-kratos/kratos/v2/log"
+	"time"
+)
+
+// 仓位状态，stop 沿用原有取值
+const (
+	PositionPending    = "pending" // 已创建，同一事务中激活
+	PositionRunning    = "running"
+	PositionCapped     = "capped" // 额度用完，等待结算出局
+	PositionStopped    = "stop"
+	PositionReinvested = "reinvested" // 出局后已复投
+)
+
+// 收益没有全额计入仓位的原因
+const (
+	CreditShortNoPosition = "no_position" // 没有运行中的仓位
+	CreditShortCapped     = "capped"      // 仓位额度不足
+)
+
+// positionActive 未结束的状态，同一用户同时只能有一个
+var positionActive = []string{PositionPending, PositionRunning, PositionCapped}
+
+// positionTransitions 允许的状态迁移
+var positionTransitions = map[string][]string{
+	PositionPending: {PositionRunning, PositionStopped},
+	PositionRunning: {PositionCapped, PositionStopped},
+	PositionCapped:  {PositionStopped},
+	PositionStopped: {PositionReinvested},
+}
+
+type LocationTransition struct {
+	ID         int64
+	LocationId int64
+	UserId     int64
+	From       string
+	To         string
+	Reason     string
+	CreatedAt  time.Time
+}
+
+type PositionRepo interface {
+	LockRunningLocationNewByUserId(ctx context.Context, userId int64) (*LocationNew, error)
+	LockLocationNewByUserIdAndStatus(ctx context.Context, userId int64, status ...string) (*LocationNew, error)
+	GetRunningLocationNewByUserId(ctx context.Context, userId int64) (*LocationNew, error)
+	GetLocationNewById(ctx context.Context, id int64) (*LocationNew, error)
+	UpdateLocationNewStatus(ctx context.Context, id int64, from string, to string, stopDate time.Time) error
+	AddLocationNewCurrent(ctx context.Context, id int64, amount int64) error
+	AddLocationNewCurrentMaxNew(ctx context.Context, id int64, amount int64) error
+	CreateLocationNew(ctx context.Context, l *LocationNew) (*LocationNew, error)
+	GetLastStoppedLocationNew(ctx context.Context, userId int64) (*LocationNew, error)
+	GetLocationNewsByStatus(ctx context.Context, status string) ([]*LocationNew, error)
+	GetLocationNewCountByUserId(ctx context.Context, userId int64) (int64, error)
+	CreateLocationTransition(ctx context.Context, t *LocationTransition) error
+	GetLocationTransitions(ctx context.Context, locationId int64) ([]*LocationTransition, error)
+}
+
+// PositionUseCase 仓位服务，仓位状态和收益额度只能通过这里修改
+type PositionUseCase struct {
+	positionRepo PositionRepo
+	tx           Transaction
+	day          *BusinessDay
+	log          *log.Helper
+}
+
+func NewPositionUseCase(positionRepo PositionRepo, tx Transaction, day *BusinessDay, logger log.Logger) *PositionUseCase {
+	return &PositionUseCase{
+		positionRepo: positionRepo,
+		tx:           tx,
+		day:          day,
+		log:          log.NewHelper(logger),
+	}
+}
+
+// CanTransit 状态迁移是否允许
+func CanTransit(from string, to string) bool {
+	for _, v := range positionTransitions[from] {
+		if to == v {
+			return true
+		}
+	}
+	return false
+}
+
+// Room 仓位剩余额度，收益 Current 和闪兑 CurrentMaxNew 共用 CurrentMax，所有额度检查都用这里
+func (l *LocationNew) Room() int64 {
+	if PositionRunning != l.Status || l.Current+l.CurrentMaxNew >= l.CurrentMax {
+		return 0
+	}
+	return l.CurrentMax - l.Current - l.CurrentMaxNew
+}
+
+// Transit 事务中使用，按状态机修改仓位状态并记录
+func (puc *PositionUseCase) Transit(ctx context.Context, location *LocationNew, to string, reason string) error {
+	if !CanTransit(location.Status, to) {
+		return v1.ErrorPositionStateInvalid("仓位状态不能从 %s 变为 %s", location.Status, to).
+			WithMetadata(map[string]string{"from": location.Status, "to": to})
+	}
+
+	var stopDate time.Time
+	if PositionStopped == to {
+		stopDate = time.Now().UTC()
+	}
+
+	err := puc.positionRepo.UpdateLocationNewStatus(ctx, location.ID, location.Status, to, stopDate)
+	if nil != err {
+		return err
+	}
+
+	err = puc.positionRepo.CreateLocationTransition(ctx, &LocationTransition{
+		LocationId: location.ID,
+		UserId:     location.UserId,
+		From:       location.Status,
+		To:         to,
+		Reason:     reason,
+	})
+	if nil != err {
+		return err
+	}
+
+	location.Status = to
+	if !stopDate.IsZero() {
+		location.StopDate = stopDate
+	}
+	return nil
+}
+
+// PlanCredit 收益计入 location 时实际可发放的金额，没有全额发放时返回原因 CreditShort*
+func PlanCredit(location *LocationNew, amount int64) (int64, string) {
+	if 0 >= amount {
+		return 0, ""
+	}
+	if nil == location {
+		return 0, CreditShortNoPosition
+	}
+	if room := location.Room(); amount > room {
+		if 0 > room {
+			room = 0
+		}
+		return room, CreditShortCapped
+	}
+	return amount, ""
+}
+
+// Credit 事务中使用，收益计入用户运行中的仓位，超出额度的部分不发放，返回实际可发放金额和没有全额发放的原因；达到额度后仓位自动出局
+func (puc *PositionUseCase) Credit(ctx context.Context, userId int64, amount int64, reason string) (int64, string, error) {
+	if 0 >= amount {
+		return 0, "", nil
+	}
+
+	location, err := puc.positionRepo.LockRunningLocationNewByUserId(ctx, userId)
+	if nil != err {
+		return 0, "", err
+	}
+
+	amount, short := PlanCredit(location, amount)
+	if 0 >= amount {
+		return 0, short, nil
+	}
+
+	err = puc.positionRepo.AddLocationNewCurrent(ctx, location.ID, amount)
+	if nil != err {
+		return 0, "", err
+	}
+	location.Current += amount
+
+	if err = puc.capIfFull(ctx, location, reason); nil != err {
+		return 0, "", err
+	}
+
+	return amount, short, nil
+}
+
+// SimulateCredit 和 Credit 相同的额度计算，只读不锁不写，用于模拟
+func (puc *PositionUseCase) SimulateCredit(ctx context.Context, userId int64, amount int64) (int64, string, error) {
+	if 0 >= amount {
+		return 0, "", nil
+	}
+
+	location, err := puc.positionRepo.GetRunningLocationNewByUserId(ctx, userId)
+	if nil != err {
+		return 0, "", err
+	}
+
+	amount, short := PlanCredit(location, amount)
+	return amount, short, nil
+}
+
+// capIfFull 额度用完时封顶，出局由 StopCapped 统一处理
+func (puc *PositionUseCase) capIfFull(ctx context.Context, location *LocationNew, reason string) error {
+	if 0 < location.Room() {
+		return nil
+	}
+	return puc.Transit(ctx, location, PositionCapped, reason)
+}
+
+// StopCapped 封顶的仓位出局，返回出局数量
+func (puc *PositionUseCase) StopCapped(ctx context.Context) (int64, error) {
+	locations, err := puc.positionRepo.GetLocationNewsByStatus(ctx, PositionCapped)
+	if nil != err {
+		return 0, err
+	}
+
+	var num int64
+	for _, v := range locations {
+		if err = puc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+			return puc.Transit(ctx, v, PositionStopped, "settle")
+		}); nil != err {
+			puc.log.Errorf("location %d stop: %v", v.ID, err)
+			continue
+		}
+		num++
+	}
+
+	return num, nil
+}
+
+// Exchange 事务中使用，闪兑占用运行中仓位的额度
+func (puc *PositionUseCase) Exchange(ctx context.Context, userId int64, amount int64) (*LocationNew, error) {
+	location, err := puc.positionRepo.LockRunningLocationNewByUserId(ctx, userId)
+	if nil != err {
+		return nil, err
+	}
+	if nil == location {
+		return nil, v1.ErrorPositionNotRunning("没有运行中的仓位")
+	}
+	if location.Room() < amount {
+		return nil, v1.ErrorPositionQuotaExceeded("仓位额度不足")
+	}
+
+	err = puc.positionRepo.AddLocationNewCurrentMaxNew(ctx, location.ID, amount)
+	if nil != err {
+		return nil, err
+	}
+	location.CurrentMaxNew += amount
+
+	if err = puc.capIfFull(ctx, location, "exchange"); nil != err {
+		return nil, err
+	}
+
+	return location, nil
+}
+
+// Open 事务中使用，开仓，同一用户只能有一个未结束（pending running capped）的仓位
+func (puc *PositionUseCase) Open(ctx context.Context, location *LocationNew, reason string) (*LocationNew, error) {
+	active, err := puc.positionRepo.LockLocationNewByUserIdAndStatus(ctx, location.UserId, positionActive...)
+	if nil != err {
+		return nil, err
+	}
+	if nil != active {
+		return nil, v1.ErrorPositionRunning("已有未结束的仓位:%s", active.Status).
+			WithMetadata(map[string]string{"status": active.Status})
+	}
+
+	// 先创建为 pending，再按状态机激活，两步都有记录
+	location.Status = PositionPending
+	location, err = puc.positionRepo.CreateLocationNew(ctx, location)
+	if nil != err {
+		return nil, err
+	}
+
+	err = puc.positionRepo.CreateLocationTransition(ctx, &LocationTransition{
+		LocationId: location.ID,
+		UserId:     location.UserId,
+		To:         PositionPending,
+		Reason:     reason,
+	})
+	if nil != err {
+		return nil, err
+	}
+
+	if err = puc.Transit(ctx, location, PositionRunning, reason); nil != err {
+		return nil, err
+	}
+
+	return location, nil
+}
+
+// GetById .
+func (puc *PositionUseCase) GetById(ctx context.Context, id int64) (*LocationNew, error) {
+	return puc.positionRepo.GetLocationNewById(ctx, id)
+}
+
+// GetLastStopped 最近一个出局且未复投的仓位，没有时返回 nil
+func (puc *PositionUseCase) GetLastStopped(ctx context.Context, userId int64) (*LocationNew, error) {
+	return puc.positionRepo.GetLastStoppedLocationNew(ctx, userId)
+}
+
+// GetCountByUserId 用户开过的仓位数量
+func (puc *PositionUseCase) GetCountByUserId(ctx context.Context, userId int64) (int64, error) {
+	return puc.positionRepo.GetLocationNewCountByUserId(ctx, userId)
+}
+
+// Reinvest 事务中使用，出局仓位标记为已复投
+func (puc *PositionUseCase) Reinvest(ctx context.Context, locationId int64) error {
+	location, err := puc.positionRepo.GetLocationNewById(ctx, locationId)
+	if nil != err {
+		return err
+	}
+
+	return puc.Transit(ctx, location, PositionReinvested, "reinvest")
+}
+
+// AdminLocationTransitionList 仓位状态变更记录
+func (puc *PositionUseCase) AdminLocationTransitionList(ctx context.Context, req *v1.AdminLocationTransitionListRequest) (*v1.AdminLocationTransitionListReply, error) {
+	transitions, err := puc.positionRepo.GetLocationTransitions(ctx, req.LocationId)
+	if nil != err {
+		return nil, err
+	}
+
+	res := &v1.AdminLocationTransitionListReply{
+		Transitions: make([]*v1.AdminLocationTransitionListReply_List, 0),
+	}
+	for _, v := range transitions {
+		res.Transitions = append(res.Transitions, &v1.AdminLocationTransitionListReply_List{
+			LocationId: v.LocationId,
+			UserId:     v.UserId,
+			From:       v.From,
+			To:         v.To,
+			Reason:     v.Reason,
+			CreatedAt:  puc.day.Format(v.CreatedAt),
+		})
+	}
+
+	return res, nil
+}
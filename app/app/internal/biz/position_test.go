@@ -0,0 +1,140 @@
+package biz
+
+import (
+	"context"
+	v1 "dhb/app/app/api"
+	"github.com/go-kratos/kratos/v2/errors"
+	"testing"
+)
+
+func TestCanTransit(t *testing.T) {
+	tests := []struct {
+		from string
+		to   string
+		want bool
+	}{
+		{PositionPending, PositionRunning, true},
+		{PositionPending, PositionStopped, true},
+		{PositionPending, PositionCapped, false},
+		{PositionRunning, PositionCapped, true},
+		{PositionRunning, PositionStopped, true},
+		{PositionRunning, PositionPending, false},
+		{PositionCapped, PositionStopped, true},
+		{PositionCapped, PositionRunning, false},
+		{PositionStopped, PositionReinvested, true},
+		{PositionStopped, PositionRunning, false},
+		{PositionReinvested, PositionRunning, false},
+		{"", PositionRunning, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.from+"->"+tt.to, func(t *testing.T) {
+			if got := CanTransit(tt.from, tt.to); got != tt.want {
+				t.Errorf("CanTransit(%q, %q) = %v, want %v", tt.from, tt.to, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPlanCredit(t *testing.T) {
+	tests := []struct {
+		name     string
+		location *LocationNew
+		amount   int64
+		want     int64
+		short    string
+	}{
+		{"no position", nil, 100, 0, CreditShortNoPosition},
+		{"zero amount", nil, 0, 0, ""},
+		{"full", &LocationNew{Status: PositionRunning, CurrentMax: 1000}, 100, 100, ""},
+		{"exactly room", &LocationNew{Status: PositionRunning, Current: 900, CurrentMax: 1000}, 100, 100, ""},
+		{"clamped", &LocationNew{Status: PositionRunning, Current: 900, CurrentMax: 1000}, 150, 100, CreditShortCapped},
+		{"exchange shares room", &LocationNew{Status: PositionRunning, Current: 500, CurrentMaxNew: 450, CurrentMax: 1000}, 100, 50, CreditShortCapped},
+		{"not running", &LocationNew{Status: PositionCapped, Current: 1000, CurrentMax: 1000}, 100, 0, CreditShortCapped},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, short := PlanCredit(tt.location, tt.amount)
+			if got != tt.want || short != tt.short {
+				t.Errorf("PlanCredit() = %d, %q, want %d, %q", got, short, tt.want, tt.short)
+			}
+		})
+	}
+}
+
+func TestPositionCreditCapsFullPosition(t *testing.T) {
+	repo := &fakePositionRepo{locations: []*LocationNew{
+		{ID: 1, UserId: 7, Status: PositionRunning, Current: 800, CurrentMax: 1000},
+	}}
+	puc := &PositionUseCase{positionRepo: repo}
+
+	tests := []struct {
+		name   string
+		amount int64
+		want   int64
+		short  string
+		status string
+	}{
+		{"within room", 100, 100, "", PositionRunning},
+		{"reaches cap", 300, 100, CreditShortCapped, PositionCapped},
+		{"capped position gets nothing", 100, 0, CreditShortNoPosition, PositionCapped},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, short, err := puc.Credit(context.Background(), 7, tt.amount, "test")
+			if nil != err {
+				t.Fatal(err)
+			}
+			if got != tt.want || short != tt.short || repo.locations[0].Status != tt.status {
+				t.Errorf("Credit() = %d, %q, status %s, want %d, %q, status %s", got, short, repo.locations[0].Status, tt.want, tt.short, tt.status)
+			}
+		})
+	}
+
+	if 1000 != repo.locations[0].Current {
+		t.Errorf("Current = %d, want 1000", repo.locations[0].Current)
+	}
+	if 1 != len(repo.transitions) || PositionRunning != repo.transitions[0].From || PositionCapped != repo.transitions[0].To {
+		t.Errorf("transitions = %+v, want running -> capped", repo.transitions)
+	}
+}
+
+func TestPositionOpenBlocksUnfinished(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  string
+		blocked bool
+	}{
+		{"pending", PositionPending, true},
+		{"running", PositionRunning, true},
+		{"capped", PositionCapped, true},
+		{"stopped", PositionStopped, false},
+		{"reinvested", PositionReinvested, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakePositionRepo{locations: []*LocationNew{{ID: 1, UserId: 7, Status: tt.status}}}
+			puc := &PositionUseCase{positionRepo: repo}
+
+			location, err := puc.Open(context.Background(), &LocationNew{UserId: 7, CurrentMax: 1000}, "buy")
+			if tt.blocked {
+				if v1.ErrorReason_POSITION_RUNNING.String() != errors.Reason(err) {
+					t.Fatalf("Open() error = %v, want POSITION_RUNNING", err)
+				}
+				return
+			}
+			if nil != err {
+				t.Fatal(err)
+			}
+			if PositionRunning != location.Status {
+				t.Errorf("Open() status = %s, want running", location.Status)
+			}
+			if 2 != len(repo.transitions) || PositionPending != repo.transitions[0].To || PositionRunning != repo.transitions[1].To {
+				t.Errorf("transitions = %+v, want pending then running", repo.transitions)
+			}
+		})
+	}
+}
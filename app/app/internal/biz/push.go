@@ -0,0 +1,391 @@
+package biz
+
+import (
+	"context"
+	"crypto/rand"
+	v1 "dhb/app/app/api"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/log"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// 推送消息类型，除事件外
+const (
+	PushTypeBalance = "balance" // 余额快照
+	PushTypePrice   = "price"   // 价格变化，广播
+	PushTypeReset   = "reset"   // 断线太久无法补发，客户端需要重新拉取
+)
+
+// pushBalanceEvents 影响余额的事件，推送后再推一次余额快照
+var pushBalanceEvents = map[string]bool{
+	EventDepositCredited:   true,
+	EventWithdrawCreated:   true,
+	EventTransferCompleted: true,
+	EventTransferReceived:  true,
+	EventExchangeCompleted: true,
+	EventTradeCreated:      true,
+	EventRewardPaid:        true,
+}
+
+// PushMessage 推送给用户的消息，Id 为发件箱事件 id，Watermark 为推送这条消息时不大于它、且之前的事件都已推送的位置
+type PushMessage struct {
+	Id        int64 // 0 表示不可续传的快照消息
+	Watermark int64
+	Type      string
+	Data      string // json
+}
+
+// ResumeToken 续传位置，作为 SSE 的 id，客户端重连时原样带回
+func (m *PushMessage) ResumeToken() string {
+	return fmt.Sprintf("%d-%d", m.Id, m.Watermark)
+}
+
+// ParsePushResumeToken 解析续传位置，只有事件 id 时按 id 之前都已推送处理
+func ParsePushResumeToken(token string) (id int64, watermark int64) {
+	tmp := strings.SplitN(strings.TrimSpace(token), "-", 2)
+	id, _ = strconv.ParseInt(tmp[0], 10, 64)
+	watermark = id
+	if 2 == len(tmp) {
+		watermark, _ = strconv.ParseInt(tmp[1], 10, 64)
+	}
+	if 0 >= id || 0 > watermark || watermark > id {
+		return 0, 0
+	}
+	return id, watermark
+}
+
+// PushConfig 推送配置
+type PushConfig struct {
+	Buffer        int           // 每个连接的缓冲消息数，写满时断开连接，客户端重连续传
+	ReplayLimit   int           // 重连最多补发的事件数，超过时推送 reset
+	PriceInterval time.Duration // 价格检查间隔
+	TicketTtl     time.Duration // 连接凭证有效期
+}
+
+// PushTicketRepo 推送连接凭证，只能使用一次
+type PushTicketRepo interface {
+	CreatePushTicket(ctx context.Context, ticket string, userId int64, ttl time.Duration) error
+	// UsePushTicket 取出并删除，不存在或已过期时返回 0
+	UsePushTicket(ctx context.Context, ticket string) (int64, error)
+}
+
+// PushSubscription 一个推送连接
+type PushSubscription struct {
+	UserId int64
+	C      chan *PushMessage // 连接被服务端断开时关闭
+	closed bool
+}
+
+// PushHub 按用户推送领域事件，每个节点独立读取发件箱，不需要主节点
+type PushHub struct {
+	repo       OutboxRepo
+	ticketRepo PushTicketRepo
+	ubRepo     UserBalanceRepo
+	configRepo ConfigRepo
+	ec         *EventConfig
+	c          *PushConfig
+	mu         sync.Mutex
+	subs       map[int64]map[*PushSubscription]struct{}
+	cursor     *OutboxCursor // 已推送到的位置，空洞中的事件提交后补推
+	price      string
+	priceAt    time.Time
+	log        *log.Helper
+}
+
+func NewPushHub(repo OutboxRepo, ticketRepo PushTicketRepo, ubRepo UserBalanceRepo, configRepo ConfigRepo, ec *EventConfig, c *PushConfig, logger log.Logger) *PushHub {
+	return &PushHub{
+		repo:       repo,
+		ticketRepo: ticketRepo,
+		ubRepo:     ubRepo,
+		configRepo: configRepo,
+		ec:         ec,
+		c:          c,
+		subs:       make(map[int64]map[*PushSubscription]struct{}, 0),
+		log:        log.NewHelper(logger),
+	}
+}
+
+// CreateTicket 连接凭证，SSE 地址中不放 jwt，避免出现在访问日志中
+func (h *PushHub) CreateTicket(ctx context.Context, userId int64) (*v1.PushTicketReply, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); nil != err {
+		return nil, err
+	}
+	ticket := hex.EncodeToString(b)
+
+	if err := h.ticketRepo.CreatePushTicket(ctx, ticket, userId, h.c.TicketTtl); nil != err {
+		return nil, err
+	}
+
+	return &v1.PushTicketReply{Ticket: ticket, ExpiresIn: int64(h.c.TicketTtl / time.Second)}, nil
+}
+
+// UseTicket 凭证只能使用一次
+func (h *PushHub) UseTicket(ctx context.Context, ticket string) (int64, error) {
+	if "" == ticket || 64 < len(ticket) {
+		return 0, v1.ErrorTokenInvalid("无效TOKEN")
+	}
+
+	userId, err := h.ticketRepo.UsePushTicket(ctx, ticket)
+	if nil != err {
+		return 0, err
+	}
+	if 0 >= userId {
+		return 0, v1.ErrorTokenInvalid("无效TOKEN")
+	}
+
+	return userId, nil
+}
+
+// Subscribe 注册连接，lastEventId 大于 0 时补发 watermark 之后的事件，否则推送当前余额和价格
+func (h *PushHub) Subscribe(ctx context.Context, userId int64, lastEventId int64, watermark int64) (*PushSubscription, error) {
+	if err := h.init(ctx); nil != err {
+		return nil, err
+	}
+
+	sub := &PushSubscription{UserId: userId, C: make(chan *PushMessage, h.c.Buffer)}
+
+	// 先注册再补发，补发注册时已越过且不在空洞中的事件，空洞和之后的事件由 Poll 推送，不重不漏
+	h.mu.Lock()
+	cursor, price := h.cursor.clone(), h.price
+	if _, ok := h.subs[userId]; !ok {
+		h.subs[userId] = make(map[*PushSubscription]struct{}, 0)
+	}
+	h.subs[userId][sub] = struct{}{}
+	h.mu.Unlock()
+
+	msgs := make([]*PushMessage, 0)
+	if 0 < lastEventId && watermark < cursor.MaxId {
+		events, err := h.repo.GetOutboxEventsByUserId(ctx, userId, watermark, cursor.MaxId, h.c.ReplayLimit+1)
+		if nil != err {
+			h.Unsubscribe(sub)
+			return nil, err
+		}
+
+		if h.c.ReplayLimit < len(events) {
+			msgs = append(msgs, &PushMessage{Type: PushTypeReset, Data: "{}"})
+			lastEventId = 0
+		} else {
+			for _, e := range events {
+				if e.ID == lastEventId || cursor.Pending(e.ID) {
+					continue
+				}
+				msgs = append(msgs, &PushMessage{Id: e.ID, Watermark: pushWatermark(cursor, e.ID), Type: e.Type, Data: e.Payload})
+			}
+		}
+	}
+
+	if 0 >= lastEventId || 0 < len(msgs) {
+		if m := h.balanceMessage(ctx, userId); nil != m {
+			msgs = append(msgs, m)
+		}
+	}
+	if 0 >= lastEventId && "" != price {
+		msgs = append(msgs, &PushMessage{Type: PushTypePrice, Data: price})
+	}
+
+	// 缓冲区放不下时同样断开
+	h.mu.Lock()
+	for _, m := range msgs {
+		h.send(sub, m)
+	}
+	h.mu.Unlock()
+
+	return sub, nil
+}
+
+// pushWatermark 事件按 id 顺序推送，推送 id 时不大于 id 且不大于 Offset 的事件都已推送
+func pushWatermark(cursor *OutboxCursor, id int64) int64 {
+	if cursor.Offset < id {
+		return cursor.Offset
+	}
+	return id
+}
+
+// Unsubscribe 连接断开
+func (h *PushHub) Unsubscribe(sub *PushSubscription) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.close(sub)
+}
+
+// Poll 推送新写入的事件和价格变化，返回推送的事件数量
+func (h *PushHub) Poll(ctx context.Context, now time.Time) (int64, error) {
+	if err := h.init(ctx); nil != err {
+		return 0, err
+	}
+
+	h.pollPrice(ctx, now)
+
+	// 只有 Poll 修改位置，不需要在读取期间持有锁
+	h.mu.Lock()
+	cursor := h.cursor
+	h.mu.Unlock()
+
+	events, next, err := readOutbox(ctx, h.repo, cursor, now, int(h.ec.Batch), h.ec.GapTimeout)
+	if nil != err {
+		return 0, err
+	}
+	if 0 >= len(events) {
+		h.mu.Lock()
+		h.cursor = next
+		h.mu.Unlock()
+		return 0, nil
+	}
+
+	// 没有连接的用户不用查余额
+	h.mu.Lock()
+	balanceUserIds := make(map[int64]bool, 0)
+	for _, e := range events {
+		if _, ok := h.subs[e.UserId]; ok && pushBalanceEvents[e.Type] {
+			balanceUserIds[e.UserId] = true
+		}
+	}
+	h.mu.Unlock()
+
+	balances := make(map[int64]*PushMessage, 0)
+	for userId := range balanceUserIds {
+		if m := h.balanceMessage(ctx, userId); nil != m {
+			balances[userId] = m
+		}
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for _, e := range events {
+		for sub := range h.subs[e.UserId] {
+			h.send(sub, &PushMessage{Id: e.ID, Watermark: pushWatermark(next, e.ID), Type: e.Type, Data: e.Payload})
+		}
+	}
+	for userId, m := range balances {
+		for sub := range h.subs[userId] {
+			h.send(sub, m)
+		}
+	}
+
+	h.cursor = next
+	return int64(len(events)), nil
+}
+
+// init 从最新的事件开始推送，历史事件只在重连补发时读取
+func (h *PushHub) init(ctx context.Context) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if nil != h.cursor {
+		return nil
+	}
+
+	lastId, err := h.repo.GetOutboxLastId(ctx)
+	if nil != err {
+		return err
+	}
+	h.cursor = NewOutboxCursor(lastId)
+	return nil
+}
+
+func (h *PushHub) pollPrice(ctx context.Context, now time.Time) {
+	h.mu.Lock()
+	due := !now.Before(h.priceAt)
+	if due {
+		h.priceAt = now.Add(h.c.PriceInterval)
+	}
+	h.mu.Unlock()
+	if !due {
+		return
+	}
+
+	configs, err := h.configRepo.GetConfigByKeys(ctx, "b_price", "b_price_base")
+	if nil != err {
+		h.log.Errorf("push price: %v", err)
+		return
+	}
+
+	var bPrice, bPriceBase int64
+	for _, vConfig := range configs {
+		if "b_price" == vConfig.KeyName {
+			bPrice, _ = strconv.ParseInt(vConfig.Value, 10, 64)
+		}
+		if "b_price_base" == vConfig.KeyName {
+			bPriceBase, _ = strconv.ParseInt(vConfig.Value, 10, 64)
+		}
+	}
+	if 0 >= bPriceBase {
+		return
+	}
+
+	data, _ := json.Marshal(map[string]interface{}{
+		"price":     strconv.FormatFloat(float64(bPrice)/float64(bPriceBase), 'f', -1, 64),
+		"bPrice":    bPrice,
+		"priceBase": bPriceBase,
+	})
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if string(data) == h.price {
+		return
+	}
+	changed := "" != h.price
+	h.price = string(data)
+	if !changed {
+		return
+	}
+
+	for _, subs := range h.subs {
+		for sub := range subs {
+			h.send(sub, &PushMessage{Type: PushTypePrice, Data: h.price})
+		}
+	}
+}
+
+func (h *PushHub) balanceMessage(ctx context.Context, userId int64) *PushMessage {
+	userBalance, err := h.ubRepo.GetUserBalance(ctx, userId)
+	if nil != err {
+		h.log.Errorf("push balance %d: %v", userId, err)
+		return nil
+	}
+
+	data, _ := json.Marshal(map[string]interface{}{
+		"usdt": formatAmount(userBalance.BalanceUsdt),
+		"dhb":  formatAmount(userBalance.BalanceDhb),
+	})
+	return &PushMessage{Type: PushTypeBalance, Data: string(data)}
+}
+
+// send 不阻塞，连接消费太慢时断开，客户端带上最后的 id 重连补发，需要持有锁
+func (h *PushHub) send(sub *PushSubscription, m *PushMessage) {
+	if sub.closed {
+		return
+	}
+
+	select {
+	case sub.C <- m:
+	default:
+		h.log.Warnf("push: user %d slow consumer, disconnected", sub.UserId)
+		h.close(sub)
+	}
+}
+
+// close 需要持有锁
+func (h *PushHub) close(sub *PushSubscription) {
+	if sub.closed {
+		return
+	}
+	sub.closed = true
+	close(sub.C)
+
+	if subs, ok := h.subs[sub.UserId]; ok {
+		delete(subs, sub)
+		if 0 >= len(subs) {
+			delete(h.subs, sub.UserId)
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package biz
+
+import (
+	"context"
+	"github.com/go-kratos/kratos/v2/log"
+	"net"
+	"time"
+)
+
+// RateLimitRule 令牌桶，Period 内最多 Burst 次，匀速恢复
+type RateLimitRule struct {
+	Burst  int64
+	Period time.Duration
+}
+
+// RateLimitConfig 限流配置
+type RateLimitConfig struct {
+	Enable         bool
+	TrustProxy     bool
+	TrustedProxies []*net.IPNet
+	Ip             *RateLimitRule // 按 ip 的总限流，不区分接口
+	Default        *RateLimitRule
+	Operations     map[string]*RateLimitRule
+}
+
+type RateLimitRepo interface {
+	// TakeToken 取一个令牌，取不到时返回需要等待的时间
+	TakeToken(ctx context.Context, key string, rule *RateLimitRule) (bool, time.Duration, error)
+}
+
+type RateLimiter struct {
+	repo RateLimitRepo
+	c    *RateLimitConfig
+	log  *log.Helper
+}
+
+func NewRateLimiter(repo RateLimitRepo, c *RateLimitConfig, logger log.Logger) *RateLimiter {
+	return &RateLimiter{
+		repo: repo,
+		c:    c,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// TrustProxy 是否从代理头取客户端 ip
+func (r *RateLimiter) TrustProxy() bool {
+	return r.c.TrustProxy
+}
+
+// TrustedProxy 地址是否是配置的代理
+func (r *RateLimiter) TrustedProxy(ip net.IP) bool {
+	for _, v := range r.c.TrustedProxies {
+		if v.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// Allow subject 为 user:id 或 ip:addr，Redis 不可用时放行
+func (r *RateLimiter) Allow(ctx context.Context, operation string, subject string) (bool, time.Duration) {
+	rule, ok := r.c.Operations[operation]
+	if !ok {
+		rule = r.c.Default
+	}
+	return r.take(ctx, operation+":"+subject, rule)
+}
+
+// AllowIp 按 ip 的总限流，在 jwt 验证之前使用
+func (r *RateLimiter) AllowIp(ctx context.Context, ip string) (bool, time.Duration) {
+	return r.take(ctx, "*:ip:"+ip, r.c.Ip)
+}
+
+func (r *RateLimiter) take(ctx context.Context, key string, rule *RateLimitRule) (bool, time.Duration) {
+	if !r.c.Enable || nil == rule || 0 >= rule.Burst || 0 >= rule.Period {
+		return true, 0
+	}
+
+	allowed, retryAfter, err := r.repo.TakeToken(ctx, key, rule)
+	if nil != err {
+		r.log.Errorf("rate limit %s: %v", key, err)
+		return true, 0
+	}
+
+	return allowed, retryAfter
+}
@@ -0,0 +1,135 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"net"
+	"testing"
+	"time"
+)
+
+type fakeRateLimitRepo struct {
+	tokens map[string]int64 // key -> 已取令牌数
+	err    error
+	keys   []string
+	rules  []*RateLimitRule
+}
+
+func (r *fakeRateLimitRepo) TakeToken(ctx context.Context, key string, rule *RateLimitRule) (bool, time.Duration, error) {
+	r.keys = append(r.keys, key)
+	r.rules = append(r.rules, rule)
+	if nil != r.err {
+		return false, 0, r.err
+	}
+	if r.tokens[key] >= rule.Burst {
+		return false, rule.Period / time.Duration(rule.Burst), nil
+	}
+	r.tokens[key]++
+	return true, 0, nil
+}
+
+func TestRateLimiterAllow(t *testing.T) {
+	def := &RateLimitRule{Burst: 2, Period: time.Minute}
+	login := &RateLimitRule{Burst: 1, Period: time.Minute}
+	c := &RateLimitConfig{
+		Enable:     true,
+		Default:    def,
+		Ip:         &RateLimitRule{Burst: 3, Period: time.Minute},
+		Operations: map[string]*RateLimitRule{"/api.App/EthAuthorize": login},
+	}
+
+	tests := []struct {
+		name      string
+		operation string
+		subject   string
+		allowed   []bool
+		retry     time.Duration
+		key       string
+		rule      *RateLimitRule
+	}{
+		{"default rule", "/api.App/UserInfo", "user:1", []bool{true, true, false}, 30 * time.Second, "/api.App/UserInfo:user:1", def},
+		{"operation rule", "/api.App/EthAuthorize", "ip:1.2.3.4", []bool{true, false}, time.Minute, "/api.App/EthAuthorize:ip:1.2.3.4", login},
+		{"subjects are separate", "/api.App/UserInfo", "user:2", []bool{true, true, false}, 30 * time.Second, "/api.App/UserInfo:user:2", def},
+	}
+
+	repo := &fakeRateLimitRepo{tokens: map[string]int64{}}
+	limiter := NewRateLimiter(repo, c, log.DefaultLogger)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for i, want := range tt.allowed {
+				allowed, retry := limiter.Allow(context.Background(), tt.operation, tt.subject)
+				if allowed != want {
+					t.Fatalf("Allow() #%d = %v, want %v", i+1, allowed, want)
+				}
+				if !allowed && retry != tt.retry {
+					t.Errorf("Allow() retry = %s, want %s", retry, tt.retry)
+				}
+			}
+			if last := len(repo.keys) - 1; repo.keys[last] != tt.key || repo.rules[last] != tt.rule {
+				t.Errorf("TakeToken() key %s rule %+v, want %s %+v", repo.keys[last], repo.rules[last], tt.key, tt.rule)
+			}
+		})
+	}
+
+	for i := 0; i < 3; i++ {
+		if allowed, _ := limiter.AllowIp(context.Background(), "1.2.3.4"); !allowed {
+			t.Fatalf("AllowIp() #%d = false", i+1)
+		}
+	}
+	if allowed, _ := limiter.AllowIp(context.Background(), "1.2.3.4"); allowed {
+		t.Error("AllowIp() over burst = true")
+	}
+	if "*:ip:1.2.3.4" != repo.keys[len(repo.keys)-1] {
+		t.Errorf("AllowIp() key = %s", repo.keys[len(repo.keys)-1])
+	}
+}
+
+func TestRateLimiterPassThrough(t *testing.T) {
+	rule := &RateLimitRule{Burst: 1, Period: time.Minute}
+	tests := []struct {
+		name string
+		c    *RateLimitConfig
+		err  error
+	}{
+		{"disabled", &RateLimitConfig{Default: rule}, nil},
+		{"no rule", &RateLimitConfig{Enable: true}, nil},
+		{"zero burst", &RateLimitConfig{Enable: true, Default: &RateLimitRule{Period: time.Minute}}, nil},
+		{"zero period", &RateLimitConfig{Enable: true, Default: &RateLimitRule{Burst: 1}}, nil},
+		{"redis down", &RateLimitConfig{Enable: true, Default: rule}, errors.New("connection refused")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakeRateLimitRepo{tokens: map[string]int64{"op:user:1": 1}, err: tt.err}
+			limiter := NewRateLimiter(repo, tt.c, log.DefaultLogger)
+			for i := 0; i < 3; i++ {
+				if allowed, _ := limiter.Allow(context.Background(), "op", "user:1"); !allowed {
+					t.Fatalf("Allow() #%d = false, want pass through", i+1)
+				}
+			}
+		})
+	}
+}
+
+func TestRateLimiterTrustedProxy(t *testing.T) {
+	_, v4, _ := net.ParseCIDR("10.0.0.0/8")
+	_, v6, _ := net.ParseCIDR("fd00::/8")
+	limiter := NewRateLimiter(nil, &RateLimitConfig{TrustedProxies: []*net.IPNet{v4, v6}}, log.DefaultLogger)
+
+	tests := []struct {
+		ip   string
+		want bool
+	}{
+		{"10.1.2.3", true},
+		{"11.1.2.3", false},
+		{"fd00::1", true},
+		{"2001:db8::1", false},
+	}
+
+	for _, tt := range tests {
+		if got := limiter.TrustedProxy(net.ParseIP(tt.ip)); got != tt.want {
+			t.Errorf("TrustedProxy(%s) = %v, want %v", tt.ip, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package biz
+
+import (
+	"context"
+	v1 "dhb/app/app/api"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type UserRecommendHistory struct {
+	ID                  int64
+	UserId              int64
+	OriginRecommendCode string
+	RecommendCode       string
+	OriginRecommendId   int64
+	RecommendId         int64
+	TeamNum             int64 // 随之改写路径的下级数量
+	CreatedAt           time.Time
+}
+
+// RecommendRebindRule 修改推荐人规则
+type RecommendRebindRule struct {
+	Days     int64 // 注册后多少天内允许修改，0 不限制
+	WithTeam bool  // 已有下级时是否允许修改
+}
+
+// recommendCodeUserIds 推荐码路径中的全部上级id，顺序从顶级到直推人
+func recommendCodeUserIds(code string) []int64 {
+	res := make([]int64, 0)
+	for _, v := range strings.Split(code, "D") {
+		if "" == v {
+			continue
+		}
+		tmpUserId, _ := strconv.ParseInt(v, 10, 64)
+		if 0 < tmpUserId {
+			res = append(res, tmpUserId)
+		}
+	}
+
+	return res
+}
+
+// getRecommendRebindRule 读取修改推荐人配置
+func (uuc *UserUseCase) getRecommendRebindRule(ctx context.Context) *RecommendRebindRule {
+	rule := &RecommendRebindRule{}
+
+	configs, _ := uuc.configRepo.GetConfigByKeys(ctx, "recommend_update_days", "recommend_update_with_team")
+	if nil != configs {
+		for _, vConfig := range configs {
+			if "recommend_update_days" == vConfig.KeyName {
+				rule.Days, _ = strconv.ParseInt(vConfig.Value, 10, 64)
+			}
+			if "recommend_update_with_team" == vConfig.KeyName {
+				rule.WithTeam = "1" == vConfig.Value
+			}
+		}
+	}
+
+	return rule
+}
+
+// CheckRecommendRebind 校验修改推荐人规则，recommendUser 为新推荐人的推荐关系
+func (rule *RecommendRebindRule) CheckRecommendRebind(user *User, recommendUser *UserRecommend, teamNum int64, now time.Time) error {
+	if 0 < rule.Days && now.After(user.CreatedAt.AddDate(0, 0, int(rule.Days))) {
+		return v1.ErrorRecommendUpdateExpired("已超过可修改推荐人的期限")
+	}
+
+	if !rule.WithTeam && 0 < teamNum {
+		return v1.ErrorRecommendHasChildren("已有下级，不可修改推荐人")
+	}
+
+	// 新推荐人不能是自己或自己的下级
+	if user.ID == recommendUser.UserId {
+		return v1.ErrorRecommendSelf("不能绑定自己")
+	}
+	for _, v := range recommendCodeUserIds(recommendUser.RecommendCode) {
+		if user.ID == v {
+			return v1.ErrorRecommendCycle("不能绑定自己的下级")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package biz
+
+import (
+	v1 "dhb/app/app/api"
+	"github.com/go-kratos/kratos/v2/errors"
+	"testing"
+	"time"
+)
+
+func TestCheckRecommendRebind(t *testing.T) {
+	now := time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC)
+	user := &User{ID: 5, CreatedAt: now.AddDate(0, 0, -3)}
+
+	tests := []struct {
+		name      string
+		rule      *RecommendRebindRule
+		recommend *UserRecommend
+		teamNum   int64
+		want      string // 错误 reason，为空时通过
+	}{
+		{"ok", &RecommendRebindRule{}, &UserRecommend{UserId: 2, RecommendCode: "D1"}, 0, ""},
+		{"within days", &RecommendRebindRule{Days: 7}, &UserRecommend{UserId: 2, RecommendCode: "D1"}, 0, ""},
+		{"expired", &RecommendRebindRule{Days: 2}, &UserRecommend{UserId: 2, RecommendCode: "D1"}, 0, v1.ErrorReason_RECOMMEND_UPDATE_EXPIRED.String()},
+		{"has team", &RecommendRebindRule{}, &UserRecommend{UserId: 2, RecommendCode: "D1"}, 1, v1.ErrorReason_RECOMMEND_HAS_CHILDREN.String()},
+		{"has team allowed", &RecommendRebindRule{WithTeam: true}, &UserRecommend{UserId: 2, RecommendCode: "D1"}, 1, ""},
+		{"self", &RecommendRebindRule{}, &UserRecommend{UserId: 5, RecommendCode: "D1"}, 0, v1.ErrorReason_RECOMMEND_SELF.String()},
+		{"direct child", &RecommendRebindRule{WithTeam: true}, &UserRecommend{UserId: 8, RecommendCode: "D1D5"}, 1, v1.ErrorReason_RECOMMEND_CYCLE.String()},
+		{"deep child", &RecommendRebindRule{WithTeam: true}, &UserRecommend{UserId: 9, RecommendCode: "D1D5D8"}, 2, v1.ErrorReason_RECOMMEND_CYCLE.String()},
+		{"id prefix is not child", &RecommendRebindRule{}, &UserRecommend{UserId: 9, RecommendCode: "D1D55"}, 0, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.rule.CheckRecommendRebind(user, tt.recommend, tt.teamNum, now)
+			if "" == tt.want {
+				if nil != err {
+					t.Errorf("CheckRecommendRebind() = %v, want nil", err)
+				}
+				return
+			}
+			if got := errors.Reason(err); got != tt.want {
+				t.Errorf("CheckRecommendRebind() reason = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRecommendCodeUserIds(t *testing.T) {
+	tests := []struct {
+		code string
+		want []int64
+	}{
+		{"", []int64{}},
+		{"D1", []int64{1}},
+		{"D1D22D333", []int64{1, 22, 333}},
+		{"D0D1", []int64{1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.code, func(t *testing.T) {
+			got := recommendCodeUserIds(tt.code)
+			if len(got) != len(tt.want) {
+				t.Fatalf("recommendCodeUserIds(%q) = %v, want %v", tt.code, got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Fatalf("recommendCodeUserIds(%q) = %v, want %v", tt.code, got, tt.want)
+				}
+			}
+		})
+	}
+}
@@ -61,15 +61,18 @@ type LocationRepo interface {
 	GetMyLocationRunningLast(ctx context.Context, userId int64) (*Location, error)
 	GetLocationsByUserId(ctx context.Context, userId int64) ([]*LocationNew, error)
 	GetLocationsByTop(ctx context.Context, top int64) ([]*LocationNew, error)
+	GetLocationsByTopPage(ctx context.Context, top int64, cursor int64, limit int64) ([]*LocationNew, error)
+	GetLocationSubtree(ctx context.Context, tops []int64, depth int64) ([]*LocationNew, error)
+	GetTeamVolumes(ctx context.Context, userIds []int64) (map[int64]int64, error)
 	GetLocationsByUserId2(ctx context.Context, userId int64) ([]*LocationNew, error)
 	GetAllLocationsCount(ctx context.Context, usdt int64) int64
-	GetRewardLocationByRowOrCol(ctx context.Context, row int64, col int64, locationRowConfig int64) ([]*Location, error)
+	ReserveLocationStock(ctx context.Context, num int64, stock int64, current int64) (bool, error)
+	GetRewardLocationByRowOrCol(ctx context.Context, row int64, col int64, locationRowConfig int64) ([]*LocationNew, error)
 	GetRewardLocationByIds(ctx context.Context, ids ...int64) (map[int64]*Location, error)
 	GetLocationMapByIds(ctx context.Context, userIds ...int64) (map[int64][]*Location, error)
 	GetLocationByIds(ctx context.Context, userIds ...int64) ([]*Location, error)
 	UpdateLocation(ctx context.Context, id int64, status string, current int64, stopDate time.Time) error
 	GetLocations(ctx context.Context, b *Pagination, userId int64) ([]*Location, error, int64)
-	UpdateLocationRowAndCol(ctx context.Context, id int64) error
 	GetLocationsStopNotUpdate(ctx context.Context) ([]*Location, error)
 	LockGlobalLocation(ctx context.Context) (bool, error)
 	UnLockGlobalLocation(ctx context.Context) (bool, error)
@@ -79,6 +82,8 @@ type LocationRepo interface {
 
 	GetMyStopLocationsLast(ctx context.Context, userId int64) ([]*LocationNew, error)
 	GetLocationDailyYesterday(ctx context.Context, day int) ([]*LocationNew, error)
+	GetLocationUsdtTotalGroupByUserId(ctx context.Context) (map[int64]int64, error)
+	GetLocationsByCreatedAt(ctx context.Context, start time.Time, end time.Time) ([]*LocationNew, error)
 }
 
 func NewRecordUseCase(
@@ -0,0 +1,391 @@
+package biz
+
+import (
+	"context"
+	v1 "dhb/app/app/api"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// 理财计息周期，从存入时间起每满 24 小时计一次
+const stakingPeriod = 24 * time.Hour
+
+// StakingTier 理财利率档位
+type StakingTier struct {
+	MinAmount int64 // 最低本金
+	MinDays   int64 // 最少持有天数
+	Rate      int64 // 日利率千分比
+}
+
+// BalanceRewardUnstake 赎回记录，冷却期内为 pending，到期后本金扣除违约金后入账
+type BalanceRewardUnstake struct {
+	ID              int64
+	UserId          int64
+	BalanceRewardId int64
+	Amount          int64  // 赎回本金
+	Penalty         int64  // 提前赎回违约金
+	Status          string // pending done
+	MatureAt        time.Time
+	CreatedAt       time.Time
+}
+
+// UnstakeRule 赎回规则
+type UnstakeRule struct {
+	Order         string // fifo 先存先取，lifo 后存先取
+	PenaltyRate   int64  // 违约金千分比
+	PenaltyDays   int64  // 持有不足多少天收取违约金，0 不收
+	CooldownHours int64  // 冷却期，0 立即到账
+}
+
+// StakingAccrual 一次计息结果
+type StakingAccrual struct {
+	BalanceRewardId int64
+	UserId          int64
+	Periods         int64
+	Amount          int64
+	LastRewardDate  time.Time
+	Forfeited       int64 // 仓位额度不足未发放的部分
+
+	periodAmounts []int64
+	periodDates   []time.Time
+}
+
+// 理财收益未发放的理由码，写入 reward.reason
+const StakingReasonPositionCapped = "staking_position_capped"
+
+// parseStakingTiers 解析利率配置，格式：最低本金:最少持有天数:日利率千分比,...
+func parseStakingTiers(value string) []*StakingTier {
+	res := make([]*StakingTier, 0)
+	for _, v := range strings.Split(value, ",") {
+		v = strings.TrimSpace(v)
+		if "" == v {
+			continue
+		}
+
+		tmp := strings.Split(v, ":")
+		if 3 != len(tmp) {
+			continue
+		}
+
+		tier := &StakingTier{}
+		tier.MinAmount, _ = strconv.ParseInt(tmp[0]+"00000", 10, 64)
+		tier.MinDays, _ = strconv.ParseInt(tmp[1], 10, 64)
+		tier.Rate, _ = strconv.ParseInt(tmp[2], 10, 64)
+		res = append(res, tier)
+	}
+
+	return res
+}
+
+// StakingRate 满足条件的档位中最高的日利率
+func StakingRate(tiers []*StakingTier, amount int64, days int64) int64 {
+	var rate int64
+	for _, v := range tiers {
+		if amount >= v.MinAmount && days >= v.MinDays && v.Rate > rate {
+			rate = v.Rate
+		}
+	}
+
+	return rate
+}
+
+// PlanStakingAccrual 计算到 now 为止未计息的完整周期，每个周期按当时的持有天数取利率
+func PlanStakingAccrual(tiers []*StakingTier, balanceReward *BalanceReward, now time.Time) *StakingAccrual {
+	res := &StakingAccrual{
+		BalanceRewardId: balanceReward.ID,
+		UserId:          balanceReward.UserId,
+		LastRewardDate:  balanceReward.LastRewardDate,
+	}
+
+	for at := balanceReward.LastRewardDate.Add(stakingPeriod); !at.After(now); at = at.Add(stakingPeriod) {
+		days := int64(at.Sub(balanceReward.SetDate) / stakingPeriod)
+		amount := balanceReward.Amount * StakingRate(tiers, balanceReward.Amount, days) / 1000
+		res.Amount += amount
+		res.Periods++
+		res.LastRewardDate = at
+		res.periodAmounts = append(res.periodAmounts, amount)
+		res.periodDates = append(res.periodDates, at)
+	}
+
+	return res
+}
+
+// SettleStakingAccrual 按实际发放金额 paid 结算计息结果：全额发放的周期计息时间前进，
+// 只发放了一部分的周期也前进，差额记为 Forfeited，一点都没发放的周期不前进，等仓位有额度后再计
+func SettleStakingAccrual(accrual *StakingAccrual, lastRewardDate time.Time, paid int64) *StakingAccrual {
+	res := &StakingAccrual{
+		BalanceRewardId: accrual.BalanceRewardId,
+		UserId:          accrual.UserId,
+		LastRewardDate:  lastRewardDate,
+	}
+
+	var total int64
+	for i, amount := range accrual.periodAmounts {
+		if total >= paid && 0 < amount {
+			break
+		}
+
+		total += amount
+		res.Periods++
+		res.LastRewardDate = accrual.periodDates[i]
+		res.periodAmounts = append(res.periodAmounts, amount)
+		res.periodDates = append(res.periodDates, accrual.periodDates[i])
+		if total > paid {
+			res.Forfeited = total - paid
+			total = paid
+			break
+		}
+	}
+	res.Amount = total
+
+	return res
+}
+
+// PlanUnstake 按顺序从仓位中扣减赎回金额，仓位不足时返回 nil
+func PlanUnstake(rule *UnstakeRule, balanceRewards []*BalanceReward, amount int64, now time.Time) []*BalanceRewardUnstake {
+	positions := make([]*BalanceReward, len(balanceRewards))
+	copy(positions, balanceRewards)
+	sort.SliceStable(positions, func(i, j int) bool {
+		if "lifo" == rule.Order {
+			return positions[i].SetDate.After(positions[j].SetDate)
+		}
+		return positions[i].SetDate.Before(positions[j].SetDate)
+	})
+
+	res := make([]*BalanceRewardUnstake, 0)
+	for _, v := range positions {
+		if 0 >= amount {
+			break
+		}
+		if 0 >= v.Amount {
+			continue
+		}
+
+		tmpAmount := v.Amount
+		if amount < tmpAmount {
+			tmpAmount = amount
+		}
+		amount -= tmpAmount
+
+		unstake := &BalanceRewardUnstake{
+			UserId:          v.UserId,
+			BalanceRewardId: v.ID,
+			Amount:          tmpAmount,
+			Status:          "pending",
+			MatureAt:        now.Add(time.Duration(rule.CooldownHours) * time.Hour),
+		}
+		if 0 < rule.PenaltyDays && now.Sub(v.SetDate) < time.Duration(rule.PenaltyDays)*stakingPeriod {
+			unstake.Penalty = tmpAmount * rule.PenaltyRate / 1000
+		}
+		res = append(res, unstake)
+	}
+
+	if 0 < amount {
+		return nil
+	}
+
+	return res
+}
+
+func (uuc *UserUseCase) getUnstakeRule(ctx context.Context) *UnstakeRule {
+	rule := &UnstakeRule{Order: "fifo"}
+
+	configs, _ := uuc.configRepo.GetConfigByKeys(ctx, "balance_reward_unstake_order", "balance_reward_penalty", "balance_reward_penalty_days", "balance_reward_cooldown_hours")
+	if nil != configs {
+		for _, vConfig := range configs {
+			if "balance_reward_unstake_order" == vConfig.KeyName && "lifo" == vConfig.Value {
+				rule.Order = "lifo"
+			}
+			if "balance_reward_penalty" == vConfig.KeyName {
+				rule.PenaltyRate, _ = strconv.ParseInt(vConfig.Value, 10, 64)
+			}
+			if "balance_reward_penalty_days" == vConfig.KeyName {
+				rule.PenaltyDays, _ = strconv.ParseInt(vConfig.Value, 10, 64)
+			}
+			if "balance_reward_cooldown_hours" == vConfig.KeyName {
+				rule.CooldownHours, _ = strconv.ParseInt(vConfig.Value, 10, 64)
+			}
+		}
+	}
+
+	return rule
+}
+
+// MatureBalanceRewardUnstake 冷却期已到的赎回入账
+func (uuc *UserUseCase) MatureBalanceRewardUnstake(ctx context.Context, now time.Time) (int64, error) {
+	unstakes, err := uuc.ubRepo.GetBalanceRewardUnstakesMature(ctx, now)
+	if nil != err {
+		return 0, err
+	}
+
+	var num int64
+	for _, v := range unstakes {
+		if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+			return uuc.ubRepo.CreditBalanceRewardUnstake(ctx, v)
+		}); nil != err {
+			uuc.log.Errorf("balance reward unstake %d credit: %v", v.ID, err)
+			continue
+		}
+		num++
+	}
+
+	return num, nil
+}
+
+func (uuc *UserUseCase) getStakingTiers(ctx context.Context) []*StakingTier {
+	configs, _ := uuc.configRepo.GetConfigByKeys(ctx, "balance_reward_rate")
+	if nil != configs {
+		for _, vConfig := range configs {
+			if "balance_reward_rate" == vConfig.KeyName {
+				return parseStakingTiers(vConfig.Value)
+			}
+		}
+	}
+
+	return make([]*StakingTier, 0)
+}
+
+// AccrueBalanceReward 理财计息，已计息的周期通过 LastRewardDate 条件更新保证不重复发放
+func (uuc *UserUseCase) AccrueBalanceReward(ctx context.Context, now time.Time) ([]*StakingAccrual, error) {
+	var (
+		balanceRewards []*BalanceReward
+		err            error
+	)
+	res := make([]*StakingAccrual, 0)
+
+	tiers := uuc.getStakingTiers(ctx)
+	if 0 >= len(tiers) {
+		return res, nil
+	}
+
+	balanceRewards, err = uuc.ubRepo.GetBalanceRewards(ctx)
+	if nil != err {
+		return nil, err
+	}
+
+	for _, vBalanceReward := range balanceRewards {
+		accrual := PlanStakingAccrual(tiers, vBalanceReward, now)
+		if 0 >= accrual.Periods {
+			continue
+		}
+
+		var settled *StakingAccrual
+		if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+			// 理财收益和其他收益一样计入仓位额度，按实际发放的周期推进计息时间
+			var amount int64
+			amount, _, err = uuc.puc.Credit(ctx, vBalanceReward.UserId, accrual.Amount, "staking")
+			if nil != err {
+				return err
+			}
+			settled = SettleStakingAccrual(accrual, vBalanceReward.LastRewardDate, amount)
+			if 0 >= settled.Periods {
+				return nil // 没有可用仓位额度，保留未计息周期
+			}
+
+			err = uuc.ubRepo.UpdateBalanceRewardLastRewardDate(ctx, vBalanceReward.ID, vBalanceReward.LastRewardDate, settled.LastRewardDate)
+			if nil != err {
+				return err
+			}
+
+			if 0 < settled.Forfeited {
+				err = uuc.ubRepo.ForfeitReward(ctx, vBalanceReward.UserId, settled.Forfeited, "balance_reward", vBalanceReward.ID, vBalanceReward.UserId, StakingReasonPositionCapped)
+				if nil != err {
+					return err
+				}
+			}
+
+			if 0 < amount {
+				_, err = uuc.ubRepo.CommissionReward(ctx, vBalanceReward.UserId, amount, "balance_reward", vBalanceReward.ID, vBalanceReward.UserId, "staking")
+				if nil != err {
+					return err
+				}
+
+				err = uuc.bus.Publish(ctx, &RewardPaid{
+					UserId:     vBalanceReward.UserId,
+					Amount:     formatAmount(amount),
+					Source:     "balance_reward",
+					RecordId:   vBalanceReward.ID,
+					FromUserId: vBalanceReward.UserId,
+					Reason:     "staking",
+				})
+				if nil != err {
+					return err
+				}
+			}
+
+			return nil
+		}); nil != err {
+			uuc.log.Errorf("balance reward %d accrue: %v", vBalanceReward.ID, err)
+			continue // 其他节点已计息或单笔失败，不影响其他仓位
+		}
+		if 0 >= settled.Periods {
+			continue
+		}
+
+		res = append(res, settled)
+	}
+
+	return res, nil
+}
+
+// BalanceRewardSummary 我的理财汇总
+func (uuc *UserUseCase) BalanceRewardSummary(ctx context.Context, user *User) (*v1.BalanceRewardSummaryReply, error) {
+	var (
+		balanceRewards []*BalanceReward
+		accrued        int64
+		principal      int64
+		pending        int64
+		nextPayoutAt   time.Time
+		err            error
+	)
+
+	balanceRewards, err = uuc.ubRepo.GetBalanceRewardByUserId(ctx, user.ID)
+	if nil != err {
+		return nil, err
+	}
+
+	accrued, err = uuc.ubRepo.GetUserRewardTotalByType(ctx, user.ID, "balance_reward")
+	if nil != err {
+		return nil, err
+	}
+
+	pending, err = uuc.ubRepo.GetUserBalanceRewardUnstakePending(ctx, user.ID)
+	if nil != err {
+		return nil, err
+	}
+
+	tiers := uuc.getStakingTiers(ctx)
+	now := time.Now().UTC()
+	res := &v1.BalanceRewardSummaryReply{
+		List: make([]*v1.BalanceRewardSummaryReply_List, 0),
+	}
+	for _, v := range balanceRewards {
+		principal += v.Amount
+
+		next := v.LastRewardDate.Add(stakingPeriod)
+		if nextPayoutAt.IsZero() || next.Before(nextPayoutAt) {
+			nextPayoutAt = next
+		}
+
+		res.List = append(res.List, &v1.BalanceRewardSummaryReply_List{
+			Id:             v.ID,
+			Amount:         fmt.Sprintf("%.2f", float64(v.Amount)/float64(100000)),
+			Rate:           StakingRate(tiers, v.Amount, int64(now.Sub(v.SetDate)/stakingPeriod)),
+			SetDate:        uuc.day.Format(v.SetDate),
+			LastRewardDate: uuc.day.Format(v.LastRewardDate),
+			NextPayoutAt:   uuc.day.Format(next),
+		})
+	}
+
+	res.Principal = fmt.Sprintf("%.2f", float64(principal)/float64(100000))
+	res.Accrued = fmt.Sprintf("%.2f", float64(accrued)/float64(100000))
+	res.PendingUnstake = fmt.Sprintf("%.2f", float64(pending)/float64(100000))
+	if !nextPayoutAt.IsZero() {
+		res.NextPayoutAt = uuc.day.Format(nextPayoutAt)
+	}
+
+	return res, nil
+}
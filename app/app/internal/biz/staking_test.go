@@ -0,0 +1,170 @@
+package biz
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseStakingTiers(t *testing.T) {
+	tiers := parseStakingTiers("100:0:1, 1000:30:2,bad,")
+	want := []StakingTier{
+		{MinAmount: 10000000, MinDays: 0, Rate: 1},
+		{MinAmount: 100000000, MinDays: 30, Rate: 2},
+	}
+	if len(tiers) != len(want) {
+		t.Fatalf("parseStakingTiers() = %d tiers, want %d", len(tiers), len(want))
+	}
+	for i := range want {
+		if *tiers[i] != want[i] {
+			t.Errorf("tier %d = %+v, want %+v", i, *tiers[i], want[i])
+		}
+	}
+}
+
+func TestStakingRate(t *testing.T) {
+	tiers := parseStakingTiers("100:0:1,1000:0:2,100:30:3")
+	tests := []struct {
+		name   string
+		amount int64
+		days   int64
+		want   int64
+	}{
+		{"below all", 9999999, 100, 0},
+		{"first tier", 10000000, 0, 1},
+		{"amount tier", 100000000, 0, 2},
+		{"days tier", 10000000, 30, 3},
+		{"highest of matched", 100000000, 30, 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := StakingRate(tiers, tt.amount, tt.days); got != tt.want {
+				t.Errorf("StakingRate(%d, %d) = %d, want %d", tt.amount, tt.days, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPlanStakingAccrual(t *testing.T) {
+	setDate := time.Date(2024, 1, 1, 8, 0, 0, 0, time.UTC)
+	tiers := parseStakingTiers("100:0:1,100:2:2") // 满 2 天后日利率 2‰
+
+	tests := []struct {
+		name           string
+		lastRewardDate time.Time
+		now            time.Time
+		periods        int64
+		amount         int64
+		lastDate       time.Time
+	}{
+		{"not a full period", setDate, setDate.Add(23 * time.Hour), 0, 0, setDate},
+		{"one period", setDate, setDate.Add(24 * time.Hour), 1, 10000, setDate.Add(24 * time.Hour)},
+		{"rate changes by held days", setDate, setDate.Add(73 * time.Hour), 3, 10000 + 20000 + 20000, setDate.Add(72 * time.Hour)},
+		{"continue from last reward", setDate.Add(48 * time.Hour), setDate.Add(96 * time.Hour), 2, 40000, setDate.Add(96 * time.Hour)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := PlanStakingAccrual(tiers, &BalanceReward{ID: 1, UserId: 2, Amount: 10000000, SetDate: setDate, LastRewardDate: tt.lastRewardDate}, tt.now)
+			if got.Periods != tt.periods || got.Amount != tt.amount || !got.LastRewardDate.Equal(tt.lastDate) {
+				t.Errorf("PlanStakingAccrual() = %d periods %d amount until %s, want %d %d %s",
+					got.Periods, got.Amount, got.LastRewardDate, tt.periods, tt.amount, tt.lastDate)
+			}
+		})
+	}
+}
+
+func TestPlanUnstake(t *testing.T) {
+	now := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	positions := []*BalanceReward{
+		{ID: 2, UserId: 9, Amount: 3000, SetDate: now.AddDate(0, 0, -3)},
+		{ID: 1, UserId: 9, Amount: 5000, SetDate: now.AddDate(0, 0, -20)},
+		{ID: 3, UserId: 9, Amount: 0, SetDate: now.AddDate(0, 0, -30)},
+	}
+
+	type part struct {
+		balanceRewardId int64
+		amount          int64
+		penalty         int64
+	}
+	tests := []struct {
+		name   string
+		rule   *UnstakeRule
+		amount int64
+		want   []part // nil 为仓位不足
+		mature time.Time
+	}{
+		{"fifo", &UnstakeRule{Order: "fifo"}, 6000, []part{{1, 5000, 0}, {2, 1000, 0}}, now},
+		{"lifo", &UnstakeRule{Order: "lifo"}, 6000, []part{{2, 3000, 0}, {1, 3000, 0}}, now},
+		{"exact", &UnstakeRule{Order: "fifo"}, 8000, []part{{1, 5000, 0}, {2, 3000, 0}}, now},
+		{"not enough", &UnstakeRule{Order: "fifo"}, 8001, nil, now},
+		{"penalty only on young position", &UnstakeRule{Order: "fifo", PenaltyRate: 100, PenaltyDays: 7}, 6000, []part{{1, 5000, 0}, {2, 1000, 100}}, now},
+		{"cooldown", &UnstakeRule{Order: "fifo", CooldownHours: 48}, 1000, []part{{1, 1000, 0}}, now.Add(48 * time.Hour)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := PlanUnstake(tt.rule, positions, tt.amount, now)
+			if nil == tt.want {
+				if nil != got {
+					t.Fatalf("PlanUnstake() = %d parts, want nil", len(got))
+				}
+				return
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("PlanUnstake() = %d parts, want %d", len(got), len(tt.want))
+			}
+			for i, w := range tt.want {
+				v := got[i]
+				if v.BalanceRewardId != w.balanceRewardId || v.Amount != w.amount || v.Penalty != w.penalty ||
+					"pending" != v.Status || 9 != v.UserId || !v.MatureAt.Equal(tt.mature) {
+					t.Errorf("part %d = %+v, want %+v mature at %s", i, *v, w, tt.mature)
+				}
+			}
+		})
+	}
+
+	if 2 != positions[0].ID {
+		t.Errorf("PlanUnstake() reordered the input")
+	}
+}
+
+func TestSettleStakingAccrual(t *testing.T) {
+	d0 := time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC)
+	d1, d2, d3 := d0.AddDate(0, 0, 1), d0.AddDate(0, 0, 2), d0.AddDate(0, 0, 3)
+	accrual := &StakingAccrual{
+		BalanceRewardId: 1,
+		UserId:          7,
+		Amount:          200,
+		Periods:         3,
+		LastRewardDate:  d3,
+		periodAmounts:   []int64{100, 0, 100},
+		periodDates:     []time.Time{d1, d2, d3},
+	}
+
+	tests := []struct {
+		name      string
+		accrual   *StakingAccrual
+		paid      int64
+		amount    int64
+		forfeited int64
+		periods   int64
+		last      time.Time
+	}{
+		{"full pay", accrual, 200, 200, 0, 3, d3},
+		{"partial pay forfeits the period", accrual, 150, 150, 50, 3, d3},
+		{"pay stops before unpaid period", accrual, 100, 100, 0, 2, d2},
+		{"nothing paid does not advance", accrual, 0, 0, 0, 0, d0},
+		{"zero rate periods advance", &StakingAccrual{periodAmounts: []int64{0, 0}, periodDates: []time.Time{d1, d2}}, 0, 0, 0, 2, d2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := SettleStakingAccrual(tt.accrual, d0, tt.paid)
+			if got.Amount != tt.amount || got.Forfeited != tt.forfeited || got.Periods != tt.periods || !got.LastRewardDate.Equal(tt.last) {
+				t.Errorf("SettleStakingAccrual() = amount %d, forfeited %d, periods %d, last %s; want %d, %d, %d, %s",
+					got.Amount, got.Forfeited, got.Periods, got.LastRewardDate, tt.amount, tt.forfeited, tt.periods, tt.last)
+			}
+		})
+	}
+}
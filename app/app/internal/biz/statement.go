@@ -0,0 +1,369 @@
+package biz
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	v1 "dhb/app/app/api"
+	"dhb/app/app/internal/pkg/pdf"
+	"encoding/csv"
+	"encoding/hex"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/log"
+	"io"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// Statement 对账单，超过同步天数的在后台生成
+type Statement struct {
+	ID        int64
+	UserId    int64
+	StartDate string // 业务日，含
+	EndDate   string // 业务日，含
+	Format    string // csv pdf
+	Status    string // pending done failed
+	File      string
+	Message   string
+	CreatedAt time.Time
+}
+
+// StatementLine 对账单明细
+type StatementLine struct {
+	CreatedAt time.Time
+	Source    string // balance reward withdraw trade balance_reward
+	Id        int64
+	Type      string
+	Coin      string
+	Amount    int64
+	Balance   int64
+	Status    string
+}
+
+// StatementBalance 币种期初期末余额
+type StatementBalance struct {
+	Coin    string
+	Opening int64
+	Closing int64
+}
+
+// StatementConfig 对账单配置
+type StatementConfig struct {
+	SyncDays int64         // 不超过这个天数同步生成
+	UrlTtl   time.Duration // 下载链接有效期
+	Key      string        // 下载链接签名
+	BaseUrl  string        // 下载链接前缀
+}
+
+type StatementRepo interface {
+	CreateStatement(ctx context.Context, s *Statement) (*Statement, error)
+	GetStatementById(ctx context.Context, id int64) (*Statement, error)
+	GetStatementsPending(ctx context.Context) ([]*Statement, error)
+	UpdateStatement(ctx context.Context, id int64, status string, file string, message string) error
+	WriteStatementFile(ctx context.Context, name string, write func(w io.Writer) error) error
+	ReadStatementFile(ctx context.Context, name string) ([]byte, error)
+	GetStatementLines(ctx context.Context, userId int64, start time.Time, end time.Time, after *StatementLine, limit int) ([]*StatementLine, error)
+	GetUserBalanceBefore(ctx context.Context, userId int64, t time.Time) (map[string]int64, error)
+}
+
+// statementPageSize 生成文件时每次查询的明细条数
+const statementPageSize = 500
+
+type StatementUseCase struct {
+	statementRepo StatementRepo
+	day           *BusinessDay
+	c             *StatementConfig
+	log           *log.Helper
+}
+
+func NewStatementUseCase(statementRepo StatementRepo, day *BusinessDay, c *StatementConfig, logger log.Logger) *StatementUseCase {
+	return &StatementUseCase{
+		statementRepo: statementRepo,
+		day:           day,
+		c:             c,
+		log:           log.NewHelper(logger),
+	}
+}
+
+// statementSign 签名内容 id:过期时间戳
+func (suc *StatementUseCase) statementSign(id int64, expires int64) string {
+	mac := hmac.New(sha256.New, []byte(suc.c.Key))
+	mac.Write([]byte(fmt.Sprintf("%d:%d", id, expires)))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// statementUrl 带签名和过期时间的下载链接
+func (suc *StatementUseCase) statementUrl(id int64, now time.Time) string {
+	expires := now.Add(suc.c.UrlTtl).Unix()
+	return fmt.Sprintf("%s/api/statement/download?id=%d&expires=%d&sign=%s", suc.c.BaseUrl, id, expires, suc.statementSign(id, expires))
+}
+
+// CreateStatement 生成对账单，范围较大时后台生成，通过 GetStatement 查询
+func (suc *StatementUseCase) CreateStatement(ctx context.Context, req *v1.CreateStatementRequest, user *User) (*v1.CreateStatementReply, error) {
+	if "csv" != req.SendBody.Format && "pdf" != req.SendBody.Format {
+		return nil, v1.ErrorParamInvalid("格式错误")
+	}
+
+	start, _, err := suc.day.Range(req.SendBody.Start)
+	if nil != err {
+		return nil, v1.ErrorDateInvalid("开始日期格式错误")
+	}
+	_, end, err := suc.day.Range(req.SendBody.End)
+	if nil != err {
+		return nil, v1.ErrorDateInvalid("结束日期格式错误")
+	}
+	if !start.Before(end) {
+		return nil, v1.ErrorDateInvalid("日期范围错误")
+	}
+
+	statement, err := suc.statementRepo.CreateStatement(ctx, &Statement{
+		UserId:    user.ID,
+		StartDate: req.SendBody.Start,
+		EndDate:   req.SendBody.End,
+		Format:    req.SendBody.Format,
+		Status:    "pending",
+	})
+	if nil != err {
+		return nil, err
+	}
+
+	if int64(end.Sub(start)/(24*time.Hour)) <= suc.c.SyncDays {
+		if err = suc.generate(ctx, statement); nil != err {
+			return nil, err
+		}
+	}
+
+	return suc.statementReply(statement), nil
+}
+
+// GetStatement 查询对账单状态，生成完成后返回下载链接
+func (suc *StatementUseCase) GetStatement(ctx context.Context, req *v1.GetStatementRequest, user *User) (*v1.CreateStatementReply, error) {
+	statement, err := suc.statementRepo.GetStatementById(ctx, req.Id)
+	if nil != err {
+		return nil, err
+	}
+	if statement.UserId != user.ID {
+		return nil, v1.ErrorStatementNotFound("对账单不存在")
+	}
+
+	return suc.statementReply(statement), nil
+}
+
+func (suc *StatementUseCase) statementReply(statement *Statement) *v1.CreateStatementReply {
+	res := &v1.CreateStatementReply{
+		Id:      statement.ID,
+		Status:  statement.Status,
+		Message: statement.Message,
+	}
+	if "done" == statement.Status {
+		res.Url = suc.statementUrl(statement.ID, time.Now())
+	}
+
+	return res
+}
+
+// GeneratePending 后台生成等待中的对账单
+func (suc *StatementUseCase) GeneratePending(ctx context.Context) (int64, error) {
+	statements, err := suc.statementRepo.GetStatementsPending(ctx)
+	if nil != err {
+		return 0, err
+	}
+
+	var num int64
+	for _, v := range statements {
+		if err = suc.generate(ctx, v); nil != err {
+			suc.log.Errorf("statement %d generate: %v", v.ID, err)
+			continue
+		}
+		num++
+	}
+
+	return num, nil
+}
+
+// generate 生成文件，失败时记录原因
+func (suc *StatementUseCase) generate(ctx context.Context, statement *Statement) error {
+	file := fmt.Sprintf("%d_%d.%s", statement.UserId, statement.ID, statement.Format)
+	err := suc.statementRepo.WriteStatementFile(ctx, file, func(w io.Writer) error {
+		return suc.render(ctx, statement, w)
+	})
+	if nil != err {
+		statement.Status, statement.Message = "failed", err.Error()
+		return suc.statementRepo.UpdateStatement(ctx, statement.ID, statement.Status, "", statement.Message)
+	}
+
+	statement.Status, statement.File = "done", file
+	return suc.statementRepo.UpdateStatement(ctx, statement.ID, statement.Status, statement.File, "")
+}
+
+// statementWriter 对账单文件格式
+type statementWriter interface {
+	head(statement *Statement, balances []*StatementBalance) error
+	lines(lines []*StatementLine) error
+	close() error
+}
+
+// render 先写期初期末余额，明细按页查询后逐页写入
+func (suc *StatementUseCase) render(ctx context.Context, statement *Statement, w io.Writer) error {
+	start, _, err := suc.day.Range(statement.StartDate)
+	if nil != err {
+		return err
+	}
+	_, end, err := suc.day.Range(statement.EndDate)
+	if nil != err {
+		return err
+	}
+
+	opening, err := suc.statementRepo.GetUserBalanceBefore(ctx, statement.UserId, start)
+	if nil != err {
+		return err
+	}
+	closing, err := suc.statementRepo.GetUserBalanceBefore(ctx, statement.UserId, end)
+	if nil != err {
+		return err
+	}
+
+	coins := make([]string, 0)
+	for coin := range closing {
+		coins = append(coins, coin)
+	}
+	sort.Strings(coins)
+	balances := make([]*StatementBalance, 0, len(coins))
+	for _, coin := range coins {
+		balances = append(balances, &StatementBalance{Coin: coin, Opening: opening[coin], Closing: closing[coin]})
+	}
+
+	var sw statementWriter
+	if "pdf" == statement.Format {
+		sw = &statementPdf{doc: pdf.New(w), day: suc.day}
+	} else {
+		sw = &statementCsv{w: csv.NewWriter(w), day: suc.day}
+	}
+	if err = sw.head(statement, balances); nil != err {
+		return err
+	}
+
+	var after *StatementLine
+	for {
+		lines, err := suc.statementRepo.GetStatementLines(ctx, statement.UserId, start, end, after, statementPageSize)
+		if nil != err {
+			return err
+		}
+		if err = sw.lines(lines); nil != err {
+			return err
+		}
+		if statementPageSize > len(lines) {
+			break
+		}
+		after = lines[len(lines)-1]
+	}
+
+	return sw.close()
+}
+
+type statementCsv struct {
+	w   *csv.Writer
+	day *BusinessDay
+}
+
+func (s *statementCsv) head(statement *Statement, balances []*StatementBalance) error {
+	records := [][]string{
+		{"user_id", strconv.FormatInt(statement.UserId, 10), "start", statement.StartDate, "end", statement.EndDate},
+		{"coin", "opening", "closing"},
+	}
+	for _, v := range balances {
+		records = append(records, []string{v.Coin, formatAmount(v.Opening), formatAmount(v.Closing)})
+	}
+	records = append(records, []string{}, []string{"created_at", "source", "id", "type", "coin", "amount", "balance", "status"})
+
+	return s.write(records)
+}
+
+func (s *statementCsv) lines(lines []*StatementLine) error {
+	records := make([][]string, 0, len(lines))
+	for _, v := range lines {
+		records = append(records, []string{
+			s.day.Format(v.CreatedAt),
+			v.Source,
+			strconv.FormatInt(v.Id, 10),
+			v.Type,
+			v.Coin,
+			formatAmount(v.Amount),
+			formatAmount(v.Balance),
+			v.Status,
+		})
+	}
+
+	return s.write(records)
+}
+
+// write 每页写完刷新，缓冲不随明细增长
+func (s *statementCsv) write(records [][]string) error {
+	for _, v := range records {
+		if err := s.w.Write(v); nil != err {
+			return err
+		}
+	}
+	s.w.Flush()
+	return s.w.Error()
+}
+
+func (s *statementCsv) close() error {
+	return nil
+}
+
+type statementPdf struct {
+	doc *pdf.Document
+	day *BusinessDay
+}
+
+func (s *statementPdf) head(statement *Statement, balances []*StatementBalance) error {
+	s.doc.Line("Statement  user %d  %s ~ %s", statement.UserId, statement.StartDate, statement.EndDate)
+	s.doc.Line("")
+	s.doc.Line("%-12s %18s %18s", "coin", "opening", "closing")
+	for _, v := range balances {
+		s.doc.Line("%-12s %18s %18s", v.Coin, formatAmount(v.Opening), formatAmount(v.Closing))
+	}
+	s.doc.Line("")
+	s.doc.Line("%-19s %-14s %-10s %-14s %-8s %14s %14s %-8s", "created_at", "source", "id", "type", "coin", "amount", "balance", "status")
+
+	return nil
+}
+
+func (s *statementPdf) lines(lines []*StatementLine) error {
+	for _, v := range lines {
+		s.doc.Line("%-19s %-14s %-10d %-14s %-8s %14s %14s %-8s", s.day.Format(v.CreatedAt), v.Source, v.Id, v.Type, v.Coin, formatAmount(v.Amount), formatAmount(v.Balance), v.Status)
+	}
+
+	return nil
+}
+
+func (s *statementPdf) close() error {
+	return s.doc.Close()
+}
+
+// Download 校验签名和有效期后返回文件
+func (suc *StatementUseCase) Download(ctx context.Context, id int64, expires int64, sign string) (string, []byte, error) {
+	if time.Now().Unix() > expires {
+		return "", nil, v1.ErrorLinkExpired("下载链接已过期")
+	}
+	if !hmac.Equal([]byte(sign), []byte(suc.statementSign(id, expires))) {
+		return "", nil, v1.ErrorLinkInvalid("下载链接签名错误")
+	}
+
+	statement, err := suc.statementRepo.GetStatementById(ctx, id)
+	if nil != err {
+		return "", nil, err
+	}
+	if "done" != statement.Status {
+		return "", nil, v1.ErrorStatementNotFound("对账单不存在")
+	}
+
+	content, err := suc.statementRepo.ReadStatementFile(ctx, statement.File)
+	if nil != err {
+		return "", nil, err
+	}
+
+	return statement.File, content, nil
+}
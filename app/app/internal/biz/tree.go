@@ -0,0 +1,67 @@
+package biz
+
+import (
+	"context"
+)
+
+// 排位树查询限制
+const (
+	placementTreeMaxDepth        = 5
+	placementTreeDefaultPageSize = 20
+	placementTreeMaxPageSize     = 100
+)
+
+// PlacementNode 排位树节点
+type PlacementNode struct {
+	Location *LocationNew
+	Depth    int64 // 相对查询节点的层级，直接下级为 1
+	Children int64 // 直接下级数量
+	Volume   int64 // 这个仓位和用户全部推荐下级的仓位金额，和返回的层级无关
+	Active   bool  // 仓位运行中
+}
+
+// BuildPlacementTree 按层级整理 first 和 subtree，first 为查询节点的直接下级，subtree 为 first 以下的仓位，
+// team 为用户全部推荐下级的仓位金额
+func BuildPlacementTree(first []*LocationNew, subtree []*LocationNew, depth int64, team map[int64]int64) []*PlacementNode {
+	var (
+		nodes    = make(map[int64]*PlacementNode, 0)
+		ordered  = make([]*PlacementNode, 0)
+		children = make(map[int64]int64, 0)
+	)
+
+	for _, v := range first {
+		node := &PlacementNode{Location: v, Depth: 1, Volume: v.Usdt + team[v.UserId], Active: PositionRunning == v.Status}
+		nodes[v.ID] = node
+		ordered = append(ordered, node)
+	}
+
+	// subtree 按 id 升序，下级的 id 大于上级，上级一定先出现
+	for _, v := range subtree {
+		parent, ok := nodes[v.Top]
+		if !ok {
+			continue
+		}
+		node := &PlacementNode{Location: v, Depth: parent.Depth + 1, Volume: v.Usdt + team[v.UserId], Active: PositionRunning == v.Status}
+		nodes[v.ID] = node
+		children[v.Top]++
+		ordered = append(ordered, node)
+	}
+
+	res := make([]*PlacementNode, 0)
+	for _, v := range ordered {
+		if v.Depth <= depth {
+			v.Children = children[v.Location.ID]
+			res = append(res, v)
+		}
+	}
+
+	return res
+}
+
+// inMyTeam 用户是否是我自己或我的推荐下级
+func (uuc *UserUseCase) inMyTeam(ctx context.Context, myUserId int64, userId int64) (bool, error) {
+	if myUserId == userId {
+		return true, nil
+	}
+	return uuc.urRepo.InUserRecommendSubtree(ctx, myUserId, userId)
+}
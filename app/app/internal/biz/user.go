@@ -3,9 +3,7 @@ package biz
 import (
 	"context"
 	v1 "dhb/app/app/api"
-	"encoding/base64"
 	"fmt"
-	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 	"strconv"
 	"strings"
@@ -18,6 +16,9 @@ type User struct {
 	Password  string
 	Undo      int64
 	CreatedAt time.Time
+	// 最近一次修改密码的时间，未修改过为零值
+	PasswordUpdatedAt time.Time
+	Locale            string // 用户设置的语言，为空时按请求头选择
 }
 
 type UserInfo struct {
@@ -94,9 +95,12 @@ type Withdraw struct {
 	UserId          int64
 	Amount          int64
 	RelAmount       int64
+	Fee             int64 // 手续费，含分佣
+	Burn            int64 // 销毁
 	BalanceRecordId int64
 	Status          string
 	Type            string
+	Address         string // 提现目标地址
 	CreatedAt       time.Time
 }
 
@@ -113,7 +117,13 @@ type UserUseCase struct {
 	ubRepo                        UserBalanceRepo
 	locationRepo                  LocationRepo
 	userCurrentMonthRecommendRepo UserCurrentMonthRecommendRepo
+	uicRepo                       UserInviteCodeRepo
+	warRepo                       WithdrawAddressRepo
 	tx                            Transaction
+	puc                           *PositionUseCase
+	muc                           *MatrixUseCase
+	bus                           *EventBus
+	day                           *BusinessDay
 	log                           *log.Helper
 }
 
@@ -121,6 +131,7 @@ type LocationNew struct {
 	ID                int64
 	UserId            int64
 	Num               int64
+	Top               int64
 	Status            string
 	Current           int64
 	CurrentMax        int64
@@ -134,6 +145,8 @@ type LocationNew struct {
 	TotalTwo          int64
 	TotalThree        int64
 	LastLevel         int64
+	Row               int64 // 排位，0 为未排位
+	Col               int64
 	StopDate          time.Time
 	CreatedAt         time.Time
 }
@@ -149,13 +162,14 @@ type UserBalanceRecord struct {
 }
 
 type BalanceReward struct {
-	ID        int64
-	UserId    int64
-	Status    int64
-	Amount    int64
-	SetDate   time.Time
-	UpdatedAt time.Time
-	CreatedAt time.Time
+	ID             int64
+	UserId         int64
+	Status         int64
+	Amount         int64
+	SetDate        time.Time
+	LastRewardDate time.Time // 已计息到的时间
+	UpdatedAt      time.Time
+	CreatedAt      time.Time
 }
 
 type Reward struct {
@@ -168,6 +182,7 @@ type Reward struct {
 	TypeRecordId     int64
 	Reason           string
 	ReasonLocationId int64
+	SourceUserId     int64
 	LocationType     string
 	CreatedAt        time.Time
 }
@@ -195,9 +210,12 @@ type UserBalanceRepo interface {
 	SystemFee(ctx context.Context, amount int64, locationId int64) error
 	GetSystemYesterdayDailyReward(ctx context.Context) (*Reward, error)
 	UserFee(ctx context.Context, userId int64, amount int64) (int64, error)
+	RecordFee(ctx context.Context, userId int64, recordId int64, q *FeeQuote, commission int64) error
 	RecommendWithdrawReward(ctx context.Context, userId int64, amount int64, locationId int64) (int64, error)
 	NormalRecommendReward(ctx context.Context, userId int64, amount int64, locationId int64) (int64, error)
 	NormalWithdrawRecommendReward(ctx context.Context, userId int64, amount int64, locationId int64) (int64, error)
+	CommissionReward(ctx context.Context, userId int64, amount int64, eventType string, recordId int64, sourceUserId int64, reason string) (int64, error)
+	ForfeitReward(ctx context.Context, userId int64, forfeited int64, eventType string, recordId int64, sourceUserId int64, reason string) error
 	Deposit(ctx context.Context, userId int64, amount int64) (int64, error)
 	DepositLast(ctx context.Context, userId int64, lastAmount int64, locationId int64) (int64, error)
 	DepositDhb(ctx context.Context, userId int64, amount int64) (int64, error)
@@ -206,13 +224,17 @@ type UserBalanceRepo interface {
 	GetLocationsToday(ctx context.Context) ([]*LocationNew, error)
 	GetUserRewardByUserIds(ctx context.Context, userIds ...int64) (map[int64]*UserSortRecommendReward, error)
 	GetUserRewards(ctx context.Context, b *Pagination, userId int64) ([]*Reward, error, int64)
+	GetUserRewardHistory(ctx context.Context, f *HistoryFilter) ([]*Reward, error, *HistoryTotal)
+	GetWithdrawHistory(ctx context.Context, f *HistoryFilter) ([]*Withdraw, error, *HistoryTotal)
+	GetUserBalanceRecordHistory(ctx context.Context, f *HistoryFilter) ([]*UserBalanceRecord, error, *HistoryTotal)
+	GetTradeHistory(ctx context.Context, f *HistoryFilter) ([]*Trade, error, *HistoryTotal)
 	GetUserRewardsLastMonthFee(ctx context.Context) ([]*Reward, error)
 	GetUserBalanceByUserIds(ctx context.Context, userIds ...int64) (map[int64]*UserBalance, error)
 	GetUserBalanceUsdtTotal(ctx context.Context) (int64, error)
-	GreateWithdraw(ctx context.Context, userId int64, relAmount int64, amount int64, amountFee int64, coinType string) (*Withdraw, error)
+	GreateWithdraw(ctx context.Context, userId int64, relAmount int64, amount int64, fee int64, burn int64, coinType string, address string) (*Withdraw, error)
 	WithdrawUsdt(ctx context.Context, userId int64, amount int64, tmpRecommendUserIdsInt []int64) error
 	WithdrawUsdt2(ctx context.Context, userId int64, amount int64) error
-	Exchange(ctx context.Context, userId int64, amount int64, amountUsdtSubFee int64, amountUsdt int64, locationId int64) error
+	Exchange(ctx context.Context, userId int64, amount int64, amountUsdtSubFee int64, amountUsdt int64) error
 	WithdrawUsdt3(ctx context.Context, userId int64, amount int64) error
 	TranUsdt(ctx context.Context, userId int64, toUserId int64, amount int64, tmpRecommendUserIdsInt []int64, tmpRecommendUserIdsInt2 []int64) error
 	WithdrawDhb(ctx context.Context, userId int64, amount int64) error
@@ -225,12 +247,20 @@ type UserBalanceRepo interface {
 	GetWithdraws(ctx context.Context, b *Pagination, userId int64) ([]*Withdraw, error, int64)
 	GetWithdrawPassOrRewarded(ctx context.Context) ([]*Withdraw, error)
 	UpdateWithdraw(ctx context.Context, id int64, status string) (*Withdraw, error)
+	UpdateWithdrawFrom(ctx context.Context, id int64, from []string, status string) (bool, error)
 	GetWithdrawById(ctx context.Context, id int64) (*Withdraw, error)
 	GetWithdrawNotDeal(ctx context.Context) ([]*Withdraw, error)
 	GetUserBalanceRecordUserUsdtTotal(ctx context.Context, userId int64) (int64, error)
+	GetUserBalanceRecordUserUsdtTotalByUserIds(ctx context.Context, userIds ...int64) (map[int64]int64, error)
 	GetUserBalanceRecordUsdtTotal(ctx context.Context) (int64, error)
 	GetUserBalanceRecordUsdtTotalToday(ctx context.Context) (int64, error)
 	GetUserWithdrawUsdtTotalToday(ctx context.Context) (int64, error)
+	GetUserWithdrawTotalTodayByCoinType(ctx context.Context, coinType string) (int64, error)
+	GetUserWithdrawUsage(ctx context.Context, userId int64, coinType string, start time.Time) (*WithdrawUsage, error)
+	ReserveWithdrawPlatformDaily(ctx context.Context, date string, coin string, amount int64, limit int64, current int64) (bool, error)
+	ReleaseWithdrawPlatformDaily(ctx context.Context, date string, coin string, amount int64) error
+	RefundWithdraw(ctx context.Context, userId int64, coinType string, amount int64) error
+	LockUserBalance(ctx context.Context, userId int64) (*UserBalance, error)
 	GetUserWithdrawUsdtTotal(ctx context.Context) (int64, error)
 	GetUserRewardUsdtTotal(ctx context.Context) (int64, error)
 	GetSystemRewardUsdtTotal(ctx context.Context) (int64, error)
@@ -241,18 +271,35 @@ type UserBalanceRepo interface {
 	SetBalanceReward(ctx context.Context, userId int64, amount int64) error
 	UpdateBalanceReward(ctx context.Context, userId int64, id int64, amount int64, status int64) error
 	GetBalanceRewardByUserId(ctx context.Context, userId int64) ([]*BalanceReward, error)
+	GetBalanceRewards(ctx context.Context) ([]*BalanceReward, error)
+	SubUsdt(ctx context.Context, userId int64, amount int64, recordType string) (int64, error)
+	ChangeBalance(ctx context.Context, userId int64, coinType string, amount int64, recordType string) (int64, int64, error)
+	LockBalanceRewardByUserId(ctx context.Context, userId int64) ([]*BalanceReward, error)
+	ReduceBalanceReward(ctx context.Context, id int64, amount int64, status int64) error
+	CreateBalanceRewardUnstake(ctx context.Context, u *BalanceRewardUnstake) (*BalanceRewardUnstake, error)
+	CreditBalanceRewardUnstake(ctx context.Context, u *BalanceRewardUnstake) error
+	GetBalanceRewardUnstakesMature(ctx context.Context, now time.Time) ([]*BalanceRewardUnstake, error)
+	GetUserBalanceRewardUnstakePending(ctx context.Context, userId int64) (int64, error)
+	UpdateBalanceRewardLastRewardDate(ctx context.Context, id int64, lastRewardDate time.Time, newLastRewardDate time.Time) error
+	GetUserRewardTotalByType(ctx context.Context, userId int64, rewardType string) (int64, error)
 
 	GetUserBalanceLock(ctx context.Context, userId int64) (*UserBalance, error)
-	Trade(ctx context.Context, userId int64, amount int64, amountB int64, amountRel int64, amountBRel int64, tmpRecommendUserIdsInt []int64, amount2 int64) error
+	Trade(ctx context.Context, userId int64, amount int64, amountB int64, amountRel int64, amountBRel int64, tmpRecommendUserIdsInt []int64, amount2 int64) (int64, error)
 }
 
 type UserRecommendRepo interface {
 	GetUserRecommendByUserId(ctx context.Context, userId int64) (*UserRecommend, error)
 	GetUserRecommendsFour(ctx context.Context) ([]*UserRecommend, error)
+	GetUserRecommends(ctx context.Context) ([]*UserRecommend, error)
 	CreateUserRecommend(ctx context.Context, u *User, recommendUser *UserRecommend) (*UserRecommend, error)
 	UpdateUserRecommend(ctx context.Context, u *User, recommendUser *UserRecommend) (bool, error)
+	UpdateUserRecommendTree(ctx context.Context, userId int64, originCode string, code string) (int64, error)
+	LockUserRecommendByUserIds(ctx context.Context, userIds ...int64) (map[int64]*UserRecommend, error)
+	CreateUserRecommendHistory(ctx context.Context, h *UserRecommendHistory) error
+	GetUserRecommendHistoryLast(ctx context.Context, userId int64) (*UserRecommendHistory, error)
 	GetUserRecommendByCode(ctx context.Context, code string) ([]*UserRecommend, error)
 	GetUserRecommendLikeCode(ctx context.Context, code string) ([]*UserRecommend, error)
+	InUserRecommendSubtree(ctx context.Context, ancestorId int64, userId int64) (bool, error)
 	CreateUserRecommendArea(ctx context.Context, u *User, recommendUser *UserRecommend) (bool, error)
 	DeleteOrOriginUserRecommendArea(ctx context.Context, code string, originCode string) (bool, error)
 	GetUserRecommendLowArea(ctx context.Context, code string) ([]*UserRecommendArea, error)
@@ -285,9 +332,12 @@ type UserRepo interface {
 	GetUsers(ctx context.Context, b *Pagination, address string) ([]*User, error, int64)
 	GetUserCount(ctx context.Context) (int64, error)
 	GetUserCountToday(ctx context.Context) (int64, error)
+	UpdateUserLocale(ctx context.Context, userId int64, locale string) error
+	UpdateUserPassword(ctx context.Context, userId int64, password string, now time.Time) error
+	GetUserPassword(ctx context.Context, userId int64) (string, error)
 }
 
-func NewUserUseCase(repo UserRepo, tx Transaction, configRepo ConfigRepo, uiRepo UserInfoRepo, urRepo UserRecommendRepo, locationRepo LocationRepo, userCurrentMonthRecommendRepo UserCurrentMonthRecommendRepo, ubRepo UserBalanceRepo, logger log.Logger) *UserUseCase {
+func NewUserUseCase(repo UserRepo, tx Transaction, configRepo ConfigRepo, uiRepo UserInfoRepo, urRepo UserRecommendRepo, locationRepo LocationRepo, userCurrentMonthRecommendRepo UserCurrentMonthRecommendRepo, ubRepo UserBalanceRepo, uicRepo UserInviteCodeRepo, warRepo WithdrawAddressRepo, puc *PositionUseCase, muc *MatrixUseCase, bus *EventBus, day *BusinessDay, logger log.Logger) *UserUseCase {
 	return &UserUseCase{
 		repo:                          repo,
 		tx:                            tx,
@@ -297,6 +347,12 @@ func NewUserUseCase(repo UserRepo, tx Transaction, configRepo ConfigRepo, uiRepo
 		uiRepo:                        uiRepo,
 		urRepo:                        urRepo,
 		ubRepo:                        ubRepo,
+		uicRepo:                       uicRepo,
+		warRepo:                       warRepo,
+		puc:                           puc,
+		muc:                           muc,
+		bus:                           bus,
+		day:                           day,
 		log:                           log.NewHelper(logger),
 	}
 }
@@ -313,24 +369,20 @@ func (uuc *UserUseCase) GetExistUserByAddressOrCreate(ctx context.Context, u *Us
 	var (
 		user          *User
 		recommendUser *UserRecommend
+		inviteCode    *UserInviteCode
 		err           error
 		userId        int64
-		decodeBytes   []byte
 	)
 
 	user, err = uuc.repo.GetUserByAddress(ctx, u.Address) // 查询用户
 	if nil == user || nil != err {
-		code := req.SendBody.Code // 查询推荐码 abf00dd52c08a9213f225827bc3fb100 md5 dhbmachinefirst
-		if "abf00dd52c08a9213f225827bc3fb100" != code {
-			decodeBytes, err = base64.StdEncoding.DecodeString(code)
-			code = string(decodeBytes)
-			if 1 >= len(code) {
-				return nil, errors.New(500, "USER_ERROR", "无效的推荐码")
-			}
-			if userId, err = strconv.ParseInt(code[1:], 10, 64); 0 >= userId || nil != err {
-				return nil, errors.New(500, "USER_ERROR", "无效的推荐码")
-			}
+		// 查询推荐码，后台发放的根推荐码没有推荐人
+		userId, inviteCode, err = uuc.ResolveInviteCode(ctx, req.SendBody.Code)
+		if nil != err {
+			return nil, err
+		}
 
+		if 0 < userId {
 			var (
 				ethRecord map[string]*EthUserRecord
 			)
@@ -340,13 +392,13 @@ func (uuc *UserUseCase) GetExistUserByAddressOrCreate(ctx context.Context, u *Us
 			}
 
 			if 0 >= len(ethRecord) {
-				return nil, errors.New(500, "USER_ERROR", "推荐人未入金")
+				return nil, v1.ErrorRecommenderInactive("推荐人未入金")
 			}
 
 			// 查询推荐人的相关信息
 			recommendUser, err = uuc.urRepo.GetUserRecommendByUserId(ctx, userId)
 			if err != nil {
-				return nil, errors.New(500, "USER_ERROR", "无效的推荐码")
+				return nil, v1.ErrorRecommendCodeInvalid("无效的推荐码")
 			}
 		}
 
@@ -371,6 +423,18 @@ func (uuc *UserUseCase) GetExistUserByAddressOrCreate(ctx context.Context, u *Us
 				return err
 			}
 
+			if nil != inviteCode {
+				err = uuc.uicRepo.UseUserInviteCode(ctx, inviteCode.ID) // 推荐码使用次数
+				if err != nil {
+					return err
+				}
+			}
+
+			_, err = uuc.createInviteCode(ctx, &UserInviteCode{UserId: user.ID, Name: "default"}) // 默认推荐码
+			if err != nil {
+				return err
+			}
+
 			return nil
 		}); err != nil {
 			return nil, err
@@ -390,20 +454,16 @@ func (uuc *UserUseCase) UpdateUserRecommend(ctx context.Context, u *User, req *v
 		myRecommendUser       *User
 		myUserRecommendUserId int64
 		Address               string
-		decodeBytes           []byte
+		inviteCode            *UserInviteCode
 	)
 
-	code := req.SendBody.Code // 查询推荐码 abf00dd52c08a9213f225827bc3fb100 md5 dhbmachinefirst
-	if "abf00dd52c08a9213f225827bc3fb100" != code {
-		decodeBytes, err = base64.StdEncoding.DecodeString(code)
-		code = string(decodeBytes)
-		if 1 >= len(code) {
-			return nil, errors.New(500, "USER_ERROR", "无效的推荐码")
-		}
-		if userId, err = strconv.ParseInt(code[1:], 10, 64); 0 >= userId || nil != err {
-			return nil, errors.New(500, "USER_ERROR", "无效的推荐码")
-		}
+	// 查询推荐码，根推荐码不用于修改推荐人
+	userId, inviteCode, err = uuc.ResolveInviteCode(ctx, req.SendBody.Code)
+	if nil != err {
+		return nil, err
+	}
 
+	if 0 < userId {
 		// 现有推荐人信息，判断推荐人是否改变
 		userRecommend, err = uuc.urRepo.GetUserRecommendByUserId(ctx, u.ID)
 		if nil == userRecommend {
@@ -447,18 +507,92 @@ func (uuc *UserUseCase) UpdateUserRecommend(ctx context.Context, u *User, req *v
 		// 查询推荐人的相关信息
 		recommendUser, err = uuc.urRepo.GetUserRecommendByUserId(ctx, userId)
 		if err != nil {
-			return nil, errors.New(500, "USER_ERROR", "无效的推荐码")
+			return nil, v1.ErrorRecommendCodeInvalid("无效的推荐码")
+		}
+
+		// 修改规则：期限，下级，环路
+		var (
+			user       *User
+			myTeam     []*UserRecommend
+			originCode = userRecommend.RecommendCode
+			newCode    = recommendUser.RecommendCode + "D" + strconv.FormatInt(userId, 10)
+		)
+		user, err = uuc.repo.GetUserById(ctx, u.ID)
+		if nil != err {
+			return nil, err
+		}
+		myTeam, err = uuc.urRepo.GetUserRecommendByCode(ctx, originCode+"D"+strconv.FormatInt(u.ID, 10))
+		if nil != err {
+			return nil, err
+		}
+		err = uuc.getRecommendRebindRule(ctx).CheckRecommendRebind(user, recommendUser, int64(len(myTeam)), time.Now().UTC())
+		if nil != err {
+			return nil, err
 		}
 
 		// 推荐人信息
+		originRecommendUserId := myRecommendUser.ID
 		myRecommendUser, err = uuc.repo.GetUserById(ctx, userId)
 		if err != nil {
 			return nil, err
 		}
 
-		// 更新
-		_, err = uuc.urRepo.UpdateUserRecommend(ctx, u, recommendUser)
-		if err != nil {
+		if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+			// 锁定自己和新推荐人的推荐关系，按锁定后的数据重新校验，并发修改时不会形成环路
+			var locked map[int64]*UserRecommend
+			locked, err = uuc.urRepo.LockUserRecommendByUserIds(ctx, u.ID, userId)
+			if nil != err {
+				return err
+			}
+			if _, ok := locked[u.ID]; !ok {
+				return v1.ErrorRecommendCodeInvalid("无效的推荐码")
+			}
+			if _, ok := locked[userId]; !ok {
+				return v1.ErrorRecommendCodeInvalid("无效的推荐码")
+			}
+			originCode = locked[u.ID].RecommendCode
+			newCode = locked[userId].RecommendCode + "D" + strconv.FormatInt(userId, 10)
+			if tmpIds := recommendCodeUserIds(originCode); 0 < len(tmpIds) {
+				originRecommendUserId = tmpIds[len(tmpIds)-1]
+			}
+
+			myTeam, err = uuc.urRepo.GetUserRecommendByCode(ctx, originCode+"D"+strconv.FormatInt(u.ID, 10))
+			if nil != err {
+				return err
+			}
+			err = uuc.getRecommendRebindRule(ctx).CheckRecommendRebind(user, locked[userId], int64(len(myTeam)), time.Now().UTC())
+			if nil != err {
+				return err
+			}
+
+			// 更新自己和整个下级的推荐路径
+			var teamNum int64
+			teamNum, err = uuc.urRepo.UpdateUserRecommendTree(ctx, u.ID, originCode, newCode)
+			if err != nil {
+				return err
+			}
+
+			err = uuc.urRepo.CreateUserRecommendHistory(ctx, &UserRecommendHistory{
+				UserId:              u.ID,
+				OriginRecommendCode: originCode,
+				RecommendCode:       newCode,
+				OriginRecommendId:   originRecommendUserId,
+				RecommendId:         userId,
+				TeamNum:             teamNum,
+			})
+			if err != nil {
+				return err
+			}
+
+			if nil != inviteCode {
+				err = uuc.uicRepo.UseUserInviteCode(ctx, inviteCode.ID) // 推荐码使用次数
+				if err != nil {
+					return err
+				}
+			}
+
+			return nil
+		}); err != nil {
 			return nil, err
 		}
 		Address = myRecommendUser.Address
@@ -473,6 +607,7 @@ func (uuc *UserUseCase) UserInfo(ctx context.Context, user *User) (*v1.UserInfoR
 		myUser                *User
 		userRecommend         *UserRecommend
 		myCode                string
+		myInviteCode          *UserInviteCode
 		encodeString          string
 		myUserRecommendUserId int64
 		inviteUserAddress     string
@@ -750,8 +885,11 @@ func (uuc *UserUseCase) UserInfo(ctx context.Context, user *User) (*v1.UserInfoR
 	}
 
 	myCode = "D" + strconv.FormatInt(myUser.ID, 10)
-	codeByte := []byte(myCode)
-	encodeString = base64.StdEncoding.EncodeToString(codeByte)
+	myInviteCode, err = uuc.GetDefaultInviteCode(ctx, myUser.ID)
+	if nil != err {
+		return nil, err
+	}
+	encodeString = myInviteCode.Code
 	if "" != userRecommend.RecommendCode {
 		tmpRecommendUserIds := strings.Split(userRecommend.RecommendCode, "D")
 		if 2 <= len(tmpRecommendUserIds) {
@@ -866,19 +1004,26 @@ func (uuc *UserUseCase) UserInfo(ctx context.Context, user *User) (*v1.UserInfoR
 		userRewards []*Reward
 	)
 	listReward := make([]*v1.UserInfoReply_ListReward, 0)
-	userRewards, err = uuc.ubRepo.GetUserRewardByUserId(ctx, myUser.ID)
+	userRewards, err, _ = uuc.ubRepo.GetUserRewardHistory(ctx, &HistoryFilter{ // 只返回最近的，全部记录走 History
+		UserId:   myUser.ID,
+		Reasons:  []string{"recommend_location", "buy"},
+		PageSize: historyDefaultPageSize,
+	})
+	if int64(len(userRewards)) > historyDefaultPageSize {
+		userRewards = userRewards[:historyDefaultPageSize]
+	}
 	if nil != userRewards {
 		for _, vUserReward := range userRewards {
 			if "recommend_location" == vUserReward.Reason {
 				listReward = append(listReward, &v1.UserInfoReply_ListReward{
-					CreatedAt:  vUserReward.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
+					CreatedAt:  uuc.day.Format(vUserReward.CreatedAt),
 					Reward:     fmt.Sprintf("%.4f", float64(vUserReward.AmountB)/float64(100000)),
 					RewardUsdt: fmt.Sprintf("%.4f", float64(vUserReward.Amount)/float64(100000)),
 					Type:       8,
 				})
 			} else if "buy" == vUserReward.Reason {
 				listReward = append(listReward, &v1.UserInfoReply_ListReward{
-					CreatedAt:  vUserReward.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
+					CreatedAt:  uuc.day.Format(vUserReward.CreatedAt),
 					Reward:     fmt.Sprintf("%.4f", float64(vUserReward.AmountB)/float64(100000)),
 					RewardUsdt: fmt.Sprintf("%.4f", float64(vUserReward.Amount)/float64(100000)),
 					Type:       7,
@@ -1073,10 +1218,81 @@ func (uuc *UserUseCase) UserArea(ctx context.Context, req *v1.UserAreaRequest, u
 		locationId      = req.LocationId
 		Locations       []*LocationNew
 		LocationRunning *LocationNew
+		root            *LocationNew
+		first           []*LocationNew
+		subtree         []*LocationNew
+		users           map[int64]*User
+		team            map[int64]int64
+		depth           = req.Depth
+		pageSize        = req.PageSize
 	)
 
-	res := make([]*v1.UserAreaReply_List, 0)
-	if 0 >= locationId {
+	res := &v1.UserAreaReply{Area: make([]*v1.UserAreaReply_List, 0)}
+	if 0 >= depth {
+		depth = 1
+	}
+	if placementTreeMaxDepth < depth {
+		depth = placementTreeMaxDepth
+	}
+	if 0 >= pageSize {
+		pageSize = placementTreeDefaultPageSize
+	}
+	if placementTreeMaxPageSize < pageSize {
+		pageSize = placementTreeMaxPageSize
+	}
+
+	// 按地址在我的下级中查找
+	if "" != req.Address {
+		var searchUser *User
+		searchUser, err = uuc.repo.GetUserByAddress(ctx, req.Address)
+		if nil != err {
+			return nil, err
+		}
+		if nil == searchUser {
+			return res, nil
+		}
+
+		var in bool
+		in, err = uuc.inMyTeam(ctx, user.ID, searchUser.ID)
+		if nil != err {
+			return nil, err
+		}
+		if !in {
+			return res, nil
+		}
+
+		Locations, err = uuc.locationRepo.GetLocationsByUserId(ctx, searchUser.ID)
+		if nil != err {
+			return nil, err
+		}
+
+		locationId = 0
+		for _, vLocations := range Locations {
+			if 0 >= locationId || "running" == vLocations.Status {
+				locationId = vLocations.ID
+			}
+			if "running" == vLocations.Status {
+				break
+			}
+		}
+		if 0 >= locationId {
+			return res, nil
+		}
+	} else if 0 < locationId {
+		root, err = uuc.puc.GetById(ctx, locationId)
+		if nil != err {
+			return nil, err
+		}
+
+		var in bool
+		in, err = uuc.inMyTeam(ctx, user.ID, root.UserId)
+		if nil != err {
+			return nil, err
+		}
+		if !in {
+			return res, nil
+		}
+	} else {
 		Locations, err = uuc.locationRepo.GetLocationsByUserId(ctx, user.ID)
 		if nil != err {
 			return nil, err
@@ -1089,50 +1305,90 @@ func (uuc *UserUseCase) UserArea(ctx context.Context, req *v1.UserAreaRequest, u
 		}
 
 		if nil == LocationRunning {
-			return &v1.UserAreaReply{Area: res}, nil
+			return res, nil
 		}
 
 		locationId = LocationRunning.ID
 	}
 
-	var (
-		myLowLocations []*LocationNew
-	)
+	// 固定查询次数：本层一页、以下各层一次递归、团队业绩一次、用户一次
+	if nil == root {
+		root, err = uuc.puc.GetById(ctx, locationId)
+		if nil != err {
+			return nil, err
+		}
+	}
 
-	myLowLocations, err = uuc.locationRepo.GetLocationsByTop(ctx, locationId)
+	first, err = uuc.locationRepo.GetLocationsByTopPage(ctx, locationId, req.Cursor, pageSize+1)
 	if nil != err {
 		return nil, err
 	}
+	if int64(len(first)) > pageSize {
+		first = first[:pageSize]
+		res.NextCursor = first[len(first)-1].ID
+	}
 
-	for _, vMyLowLocations := range myLowLocations {
-		var (
-			userLow           *User
-			tmpMyLowLocations []*LocationNew
-		)
+	firstIds := make([]int64, 0, len(first))
+	for _, v := range first {
+		firstIds = append(firstIds, v.ID)
+	}
+	subtree, err = uuc.locationRepo.GetLocationSubtree(ctx, firstIds, depth)
+	if nil != err {
+		return nil, err
+	}
 
-		userLow, err = uuc.repo.GetUserById(ctx, vMyLowLocations.UserId)
-		if nil != err {
-			continue
-		}
+	userIds := []int64{root.UserId}
+	for _, v := range first {
+		userIds = append(userIds, v.UserId)
+	}
+	for _, v := range subtree {
+		userIds = append(userIds, v.UserId)
+	}
+	team, err = uuc.locationRepo.GetTeamVolumes(ctx, userIds)
+	if nil != err {
+		return nil, err
+	}
 
-		tmpMyLowLocations, err = uuc.locationRepo.GetLocationsByTop(ctx, vMyLowLocations.ID)
-		if nil != err {
-			return nil, err
-		}
+	nodes := BuildPlacementTree(first, subtree, depth, team)
+
+	users, err = uuc.repo.GetUserByUserIds(ctx, userIds...)
+	if nil != err {
+		return nil, err
+	}
 
-		var address1 string
-		if 20 <= len(userLow.Address) {
-			address1 = userLow.Address[:6] + "..." + userLow.Address[len(userLow.Address)-4:]
+	res.Root = &v1.UserAreaReply_List{
+		Address:    maskAddress(users[root.UserId]),
+		LocationId: root.ID,
+		Status:     root.Status,
+		Active:     PositionRunning == root.Status,
+		Volume:     fmt.Sprintf("%.2f", float64(root.Usdt+team[root.UserId])/float64(100000)),
+	}
+	for _, v := range nodes {
+		if 1 == v.Depth {
+			res.Root.CountLow++
 		}
 
-		res = append(res, &v1.UserAreaReply_List{
-			Address:    address1,
-			LocationId: vMyLowLocations.ID,
-			CountLow:   int64(len(tmpMyLowLocations)),
+		res.Area = append(res.Area, &v1.UserAreaReply_List{
+			Address:    maskAddress(users[v.Location.UserId]),
+			LocationId: v.Location.ID,
+			CountLow:   v.Children,
+			Status:     v.Location.Status,
+			Active:     v.Active,
+			Volume:     fmt.Sprintf("%.2f", float64(v.Volume)/float64(100000)),
+			Depth:      v.Depth,
+			Top:        v.Location.Top,
 		})
 	}
 
-	return &v1.UserAreaReply{Area: res}, nil
+	return res, nil
+}
+
+// maskAddress 地址只显示首尾
+func maskAddress(user *User) string {
+	if nil == user || 20 > len(user.Address) {
+		return ""
+	}
+	return user.Address[:6] + "..." + user.Address[len(user.Address)-4:]
 }
 
 func (uuc *UserUseCase) UserInfoOld(ctx context.Context, user *User) (*v1.UserInfoReply, error) {
@@ -1466,123 +1722,234 @@ func (uuc *UserUseCase) UserInfoOld(ctx context.Context, user *User) (*v1.UserIn
 }
 
 func (uuc *UserUseCase) RewardList(ctx context.Context, req *v1.RewardListRequest, user *User) (*v1.RewardListReply, error) {
+	q := &HistoryQuery{Start: req.Start, End: req.End, Cursor: req.Cursor, PageSize: req.PageSize, Sort: req.Sort}
+	if "" != req.Type {
+		q.Types = []string{req.Type}
+	}
+	f, err := uuc.historyFilter(user.ID, q)
+	if nil != err {
+		return nil, err
+	}
+
+	rewards, err, total := uuc.ubRepo.GetUserRewardHistory(ctx, f)
+	if nil != err {
+		return nil, err
+	}
 
 	res := &v1.RewardListReply{
-		Rewards: make([]*v1.RewardListReply_List, 0),
+		Rewards:     make([]*v1.RewardListReply_List, 0),
+		Total:       total.Count,
+		TotalAmount: formatAmount(total.Amount),
+	}
+	ids := make([]int64, 0)
+	for _, v := range rewards {
+		ids = append(ids, v.ID)
+		res.Rewards = append(res.Rewards, &v1.RewardListReply_List{
+			CreatedAt: uuc.day.Format(v.CreatedAt),
+			Amount:    formatAmount(v.Amount),
+			Type:      v.Type,
+		})
+	}
+	res.NextCursor = historyNextCursor(ids, f)
+	if int64(len(res.Rewards)) > f.PageSize {
+		res.Rewards = res.Rewards[:f.PageSize]
 	}
 
 	return res, nil
 }
 
-func (uuc *UserUseCase) RecommendRewardList(ctx context.Context, user *User) (*v1.RecommendRewardListReply, error) {
+func (uuc *UserUseCase) RecommendRewardList(ctx context.Context, req *v1.RecommendRewardListRequest, user *User) (*v1.RecommendRewardListReply, error) {
+	f, err := uuc.historyFilter(user.ID, &HistoryQuery{Types: recommendRewardTypes, Reasons: recommendRewardReasons, Start: req.Start, End: req.End, Cursor: req.Cursor, PageSize: req.PageSize, Sort: req.Sort})
+	if nil != err {
+		return nil, err
+	}
+
+	rewards, err, total := uuc.ubRepo.GetUserRewardHistory(ctx, f)
+	if nil != err {
+		return nil, err
+	}
 
 	res := &v1.RecommendRewardListReply{
-		Rewards: make([]*v1.RecommendRewardListReply_List, 0),
+		Rewards:     make([]*v1.RecommendRewardListReply_List, 0),
+		Total:       total.Count,
+		TotalAmount: formatAmount(total.Amount),
+	}
+	ids := make([]int64, 0)
+	for _, v := range rewards {
+		ids = append(ids, v.ID)
+		res.Rewards = append(res.Rewards, &v1.RecommendRewardListReply_List{
+			CreatedAt: uuc.day.Format(v.CreatedAt),
+			Amount:    formatAmount(v.Amount),
+			Type:      v.Type,
+			Reason:    v.Reason,
+		})
+	}
+	res.NextCursor = historyNextCursor(ids, f)
+	if int64(len(res.Rewards)) > f.PageSize {
+		res.Rewards = res.Rewards[:f.PageSize]
 	}
 
 	return res, nil
 }
 
-func (uuc *UserUseCase) FeeRewardList(ctx context.Context, user *User) (*v1.FeeRewardListReply, error) {
+// FeeRewardList 提现手续费分佣
+func (uuc *UserUseCase) FeeRewardList(ctx context.Context, req *v1.FeeRewardListRequest, user *User) (*v1.FeeRewardListReply, error) {
+	f, err := uuc.historyFilter(user.ID, &HistoryQuery{
+		Types:    []string{"withdraw"},
+		Reasons:  []string{CommissionReasonPaid, CommissionReasonCapped, CommissionReasonPositionCapped},
+		Start:    req.Start,
+		End:      req.End,
+		Cursor:   req.Cursor,
+		PageSize: req.PageSize,
+		Sort:     req.Sort,
+	})
+	if nil != err {
+		return nil, err
+	}
+
+	rewards, err, total := uuc.ubRepo.GetUserRewardHistory(ctx, f)
+	if nil != err {
+		return nil, err
+	}
+
 	res := &v1.FeeRewardListReply{
-		Rewards: make([]*v1.FeeRewardListReply_List, 0),
+		Rewards:     make([]*v1.FeeRewardListReply_List, 0),
+		Total:       total.Count,
+		TotalAmount: formatAmount(total.Amount),
+	}
+	ids := make([]int64, 0)
+	for _, v := range rewards {
+		ids = append(ids, v.ID)
+		res.Rewards = append(res.Rewards, &v1.FeeRewardListReply_List{
+			CreatedAt: uuc.day.Format(v.CreatedAt),
+			Amount:    formatAmount(v.Amount),
+		})
 	}
+	res.NextCursor = historyNextCursor(ids, f)
+	if int64(len(res.Rewards)) > f.PageSize {
+		res.Rewards = res.Rewards[:f.PageSize]
+	}
+
 	return res, nil
 }
 
-func (uuc *UserUseCase) TranList(ctx context.Context, user *User, reqTypeCoin string, reqTran string) (*v1.TranListReply, error) {
-
+func (uuc *UserUseCase) TranList(ctx context.Context, req *v1.TranListRequest, user *User) (*v1.TranListReply, error) {
 	var (
-		userBalanceRecord []*UserBalanceRecord
-		typeCoin          = "usdt"
-		tran              = "tran"
-		err               error
+		typeCoin = "usdt"
+		tran     = "tran"
 	)
 
-	res := &v1.TranListReply{
-		Tran: make([]*v1.TranListReply_List, 0),
+	if "" != req.Type {
+		typeCoin = req.Type
 	}
 
-	if "" != reqTypeCoin {
-		typeCoin = reqTypeCoin
+	if "tran_to" == req.Tran {
+		tran = req.Tran
 	}
 
-	if "tran_to" == reqTran {
-		tran = reqTran
+	f, err := uuc.historyFilter(user.ID, &HistoryQuery{Types: []string{tran}, Coin: typeCoin, Start: req.Start, End: req.End, Cursor: req.Cursor, PageSize: req.PageSize, Sort: req.Sort})
+	if nil != err {
+		return nil, err
 	}
 
-	userBalanceRecord, err = uuc.ubRepo.GetUserBalanceRecordByUserId(ctx, user.ID, typeCoin, tran)
+	userBalanceRecord, err, total := uuc.ubRepo.GetUserBalanceRecordHistory(ctx, f)
 	if nil != err {
-		return res, err
+		return nil, err
 	}
 
+	res := &v1.TranListReply{
+		Tran:        make([]*v1.TranListReply_List, 0),
+		Total:       total.Count,
+		TotalAmount: formatAmount(total.Amount),
+	}
+	ids := make([]int64, 0)
 	for _, v := range userBalanceRecord {
+		ids = append(ids, v.ID)
 		res.Tran = append(res.Tran, &v1.TranListReply_List{
-			CreatedAt: v.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
-			Amount:    fmt.Sprintf("%.2f", float64(v.Amount)/float64(100000)),
+			CreatedAt: uuc.day.Format(v.CreatedAt),
+			Amount:    formatAmount(v.Amount),
 		})
 	}
+	res.NextCursor = historyNextCursor(ids, f)
+	if int64(len(res.Tran)) > f.PageSize {
+		res.Tran = res.Tran[:f.PageSize]
+	}
 
 	return res, nil
 }
 
-func (uuc *UserUseCase) WithdrawList(ctx context.Context, user *User, reqTypeCoin string) (*v1.WithdrawListReply, error) {
-
-	var (
-		withdraws []*Withdraw
-		typeCoin  = "usdt"
-		err       error
-	)
-
-	res := &v1.WithdrawListReply{
-		Withdraw: make([]*v1.WithdrawListReply_List, 0),
+func (uuc *UserUseCase) WithdrawList(ctx context.Context, req *v1.WithdrawListRequest, user *User) (*v1.WithdrawListReply, error) {
+	typeCoin := "usdt"
+	if "" != req.Type {
+		typeCoin = req.Type
 	}
 
-	if "" != reqTypeCoin {
-		typeCoin = reqTypeCoin
+	f, err := uuc.historyFilter(user.ID, &HistoryQuery{Coin: typeCoin, Status: req.Status, Start: req.Start, End: req.End, Cursor: req.Cursor, PageSize: req.PageSize, Sort: req.Sort})
+	if nil != err {
+		return nil, err
 	}
 
-	withdraws, err = uuc.ubRepo.GetWithdrawByUserId(ctx, user.ID, typeCoin)
+	withdraws, err, total := uuc.ubRepo.GetWithdrawHistory(ctx, f)
 	if nil != err {
-		return res, err
+		return nil, err
 	}
 
+	res := &v1.WithdrawListReply{
+		Withdraw:    make([]*v1.WithdrawListReply_List, 0),
+		Total:       total.Count,
+		TotalAmount: formatAmount(total.Amount),
+	}
+	ids := make([]int64, 0)
 	for _, v := range withdraws {
+		ids = append(ids, v.ID)
 		res.Withdraw = append(res.Withdraw, &v1.WithdrawListReply_List{
-			CreatedAt: v.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
-			Amount:    fmt.Sprintf("%.2f", float64(v.Amount)/float64(100000)),
+			CreatedAt: uuc.day.Format(v.CreatedAt),
+			Amount:    formatAmount(v.Amount),
 			Status:    v.Status,
 			Type:      v.Type,
+			RelAmount: formatAmount(v.RelAmount),
+			Fee:       formatAmount(v.Fee),
+			Burn:      formatAmount(v.Burn),
 		})
 	}
+	res.NextCursor = historyNextCursor(ids, f)
+	if int64(len(res.Withdraw)) > f.PageSize {
+		res.Withdraw = res.Withdraw[:f.PageSize]
+	}
 
 	return res, nil
 }
 
-func (uuc *UserUseCase) TradeList(ctx context.Context, user *User) (*v1.TradeListReply, error) {
-
-	var (
-		trades []*Trade
-		err    error
-	)
-
-	res := &v1.TradeListReply{
-		Trade: make([]*v1.TradeListReply_List, 0),
+func (uuc *UserUseCase) TradeList(ctx context.Context, req *v1.TradeListRequest, user *User) (*v1.TradeListReply, error) {
+	f, err := uuc.historyFilter(user.ID, &HistoryQuery{Status: req.Status, Start: req.Start, End: req.End, Cursor: req.Cursor, PageSize: req.PageSize, Sort: req.Sort})
+	if nil != err {
+		return nil, err
 	}
 
-	trades, err = uuc.ubRepo.GetTradeByUserId(ctx, user.ID)
+	trades, err, total := uuc.ubRepo.GetTradeHistory(ctx, f)
 	if nil != err {
-		return res, err
+		return nil, err
 	}
 
+	res := &v1.TradeListReply{
+		Trade:       make([]*v1.TradeListReply_List, 0),
+		Total:       total.Count,
+		TotalAmount: formatAmount(total.Amount),
+	}
+	ids := make([]int64, 0)
 	for _, v := range trades {
+		ids = append(ids, v.ID)
 		res.Trade = append(res.Trade, &v1.TradeListReply_List{
-			CreatedAt: v.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
-			AmountCsd: fmt.Sprintf("%.2f", float64(v.AmountCsd)/float64(100000)),
-			AmountHbs: fmt.Sprintf("%.2f", float64(v.AmountHbs)/float64(100000)),
+			CreatedAt: uuc.day.Format(v.CreatedAt),
+			AmountCsd: formatAmount(v.AmountCsd),
+			AmountHbs: formatAmount(v.AmountHbs),
 			Status:    v.Status,
 		})
 	}
+	res.NextCursor = historyNextCursor(ids, f)
+	if int64(len(res.Trade)) > f.PageSize {
+		res.Trade = res.Trade[:f.PageSize]
+	}
 
 	return res, nil
 }
@@ -1609,9 +1976,8 @@ func (uuc *UserUseCase) Exchange(ctx context.Context, req *v1.ExchangeRequest, u
 	}
 
 	if 100000 > amount {
-		return &v1.ExchangeReply{
-			Status: "fail",
-		}, nil
+		return nil, v1.ErrorAmountTooSmall("最小兑换数量:%s", formatAmount(100000)).
+			WithMetadata(map[string]string{"min": formatAmount(100000)})
 	}
 
 	// 配置
@@ -1645,9 +2011,7 @@ func (uuc *UserUseCase) Exchange(ctx context.Context, req *v1.ExchangeRequest, u
 	amountUsdt := amount / bPriceBase * bPrice
 	amountUsdtSubFee := amountUsdt - amountUsdt*exchangeRate/1000
 	if amountUsdt <= 0 {
-		return &v1.ExchangeReply{
-			Status: "fail price",
-		}, nil
+		return nil, v1.ErrorPriceUnavailable("币价错误")
 	}
 
 	var (
@@ -1661,32 +2025,36 @@ func (uuc *UserUseCase) Exchange(ctx context.Context, req *v1.ExchangeRequest, u
 	}
 
 	if 0 >= len(locations) {
-		return &v1.ExchangeReply{
-			Status: "fail location",
-		}, nil
+		return nil, v1.ErrorPositionNotRunning("没有运行中的仓位")
 	}
 
 	runningLocation = locations[0]
-	if "running" != runningLocation.Status {
-		return &v1.ExchangeReply{
-			Status: "fail location",
-		}, nil
+	if PositionRunning != runningLocation.Status {
+		return nil, v1.ErrorPositionNotRunning("没有运行中的仓位")
 	}
 
-	if runningLocation.CurrentMax < runningLocation.CurrentMaxNew+amountUsdt {
-		return &v1.ExchangeReply{
-			Status: "fail location max",
-		}, nil
+	if runningLocation.Room() < amountUsdt {
+		return nil, v1.ErrorPositionQuotaExceeded("仓位额度不足")
 	}
 
 	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
 
-		err = uuc.ubRepo.Exchange(ctx, user.ID, amount, amountUsdtSubFee, amountUsdt, runningLocation.ID) // 提现
+		_, err = uuc.puc.Exchange(ctx, user.ID, amountUsdt)
 		if nil != err {
 			return err
 		}
 
-		return nil
+		err = uuc.ubRepo.Exchange(ctx, user.ID, amount, amountUsdtSubFee, amountUsdt) // 提现
+		if nil != err {
+			return err
+		}
+
+		return uuc.bus.Publish(ctx, &ExchangeCompleted{
+			UserId: user.ID,
+			Dhb:    formatAmount(amount),
+			Usdt:   formatAmount(amountUsdt),
+			Net:    formatAmount(amountUsdtSubFee),
+		})
 	}); nil != err {
 		return nil, err
 	}
@@ -1729,239 +2097,110 @@ func (uuc *UserUseCase) Withdraw(ctx context.Context, req *v1.WithdrawRequest, u
 	//}
 
 	if "usdt" != req.SendBody.Type {
-		return &v1.WithdrawReply{
-			Status: "fail type",
-		}, nil
-	}
-
-	userBalance, err = uuc.ubRepo.GetUserBalance(ctx, user.ID)
-	if nil != err {
-		return &v1.WithdrawReply{
-			Status: "余额信息错误",
-		}, nil
+		return nil, v1.ErrorWithdrawCoinUnsupported("不支持的提现币种")
 	}
 
 	amountFloat, _ := strconv.ParseFloat(req.SendBody.Amount, 10)
 	amountFloat *= 100000
 	amount, _ := strconv.ParseInt(strconv.FormatFloat(amountFloat, 'f', -1, 64), 10, 64)
 
-	//if "dhb" == req.SendBody.Type {
-	//	if userBalance.BalanceDhb < amount {
-	//		return &v1.WithdrawReply{
-	//			Status: "fail",
-	//		}, nil
-	//	}
-	//
-	//	if 10000000 > amount {
-	//		return &v1.WithdrawReply{
-	//			Status: "fail",
-	//		}, nil
-	//	}
-	//}
-
-	// 配置
-	var (
-		configs            []*Config
-		withdrawMaxStr     string
-		withdrawMax        int64
-		withdrawMinStr     string
-		withdrawMin        int64
-		withdrawMaxStrBnbs string
-		withdrawMaxBnbs    int64
-		withdrawMinStrBnbs string
-		withdrawMinBnbs    int64
-		withdrawOpen       string
-	)
-	configs, err = uuc.configRepo.GetConfigByKeys(ctx,
-		"withdraw_amount_max",
-		"withdraw_amount_min",
-		"withdraw_amount_bnbs_max",
-		"withdraw_amount_bnbs_min",
-		"withdraw_open",
-	)
-	if nil != configs {
-		for _, vConfig := range configs {
-			if "withdraw_amount_max" == vConfig.KeyName {
-				withdrawMaxStr = vConfig.Value
-				withdrawMax, _ = strconv.ParseInt(vConfig.Value+"00000", 10, 64)
-			}
-
-			if "withdraw_amount_min" == vConfig.KeyName {
-				withdrawMinStr = vConfig.Value
-				withdrawMin, _ = strconv.ParseInt(vConfig.Value+"00000", 10, 64)
-			}
-
-			if "withdraw_amount_bnbs_max" == vConfig.KeyName {
-				withdrawMaxStrBnbs = vConfig.Value
-				withdrawMaxBnbs, _ = strconv.ParseInt(vConfig.Value+"00000", 10, 64)
-			}
+	rule, err := uuc.getWithdrawLimitRule(ctx, req.SendBody.Type)
+	if nil != err {
+		return nil, err
+	}
+	if !rule.Open {
+		return nil, v1.ErrorWithdrawClosed("提现已关闭")
+	}
 
-			if "withdraw_amount_bnbs_min" == vConfig.KeyName {
-				withdrawMinStrBnbs = vConfig.Value
-				withdrawMinBnbs, _ = strconv.ParseInt(vConfig.Value+"00000", 10, 64)
-			}
+	address, err := uuc.withdrawDestination(ctx, req.SendBody.AddressId, user, time.Now())
+	if nil != err {
+		return nil, err
+	}
 
-			if "withdraw_open" == vConfig.KeyName {
-				withdrawOpen = vConfig.Value
-			}
-		}
+	quote, err := uuc.QuoteFee(ctx, "withdraw", req.SendBody.Type, amount)
+	if nil != err {
+		return nil, err
 	}
 
-	if "1" != withdrawOpen {
-		return &v1.WithdrawReply{
-			Status: "ok",
-		}, nil
+	// 分佣，以手续费为基数
+	var (
+		commissionEvent = &CommissionEvent{Type: "withdraw", UserId: user.ID, Amount: quote.Fee}
+		commissions     []*CommissionReward
+	)
+	commissions, err = uuc.PlanCommission(ctx, commissionEvent)
+	if nil != err {
+		return nil, err
 	}
 
-	if "usdt" == req.SendBody.Type {
-		if userBalance.BalanceUsdt <= amount {
-			amount = userBalance.BalanceUsdt
+	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		// 锁定余额，同一用户的提现串行检查额度
+		userBalance, err = uuc.ubRepo.LockUserBalance(ctx, user.ID)
+		if nil != err {
+			return err
 		}
 
-		if withdrawMax < amount {
-			return &v1.WithdrawReply{
-				Status: "最大提现金额:" + withdrawMaxStr,
-			}, nil
+		balance := userBalance.BalanceUsdt
+		if "dhb" == req.SendBody.Type {
+			balance = userBalance.BalanceDhb
 		}
-
-		if withdrawMin > amount {
-			return &v1.WithdrawReply{
-				Status: "最小提现金额:" + withdrawMinStr,
-			}, nil
+		err = uuc.checkWithdrawLimit(ctx, rule, user.ID, req.SendBody.Type, amount, balance, time.Now())
+		if nil != err {
+			return err
 		}
-	}
-
-	if "dhb" == req.SendBody.Type {
-		if userBalance.BalanceDhb <= amount {
-			amount = userBalance.BalanceDhb
+		if 0 >= quote.Net {
+			fee := formatAmount(quote.Fee + quote.Burn)
+			return v1.ErrorWithdrawAmountBelowFee("提现金额不足以支付手续费:%s", fee).
+				WithMetadata(map[string]string{"fee": fee})
 		}
 
-		if withdrawMaxBnbs < amount {
-			return &v1.WithdrawReply{
-				Status: "最大提现金额:" + withdrawMaxStrBnbs,
-			}, nil
+		if "usdt" == req.SendBody.Type {
+			err = uuc.ubRepo.WithdrawUsdt2(ctx, user.ID, amount) // 提现
+		} else {
+			err = uuc.ubRepo.WithdrawDhb(ctx, user.ID, amount) // 提现
 		}
-
-		if withdrawMinBnbs > amount {
-			return &v1.WithdrawReply{
-				Status: "最小提现金额:" + withdrawMinStrBnbs,
-			}, nil
+		if nil != err {
+			return err
 		}
-	}
-
-	//if "usdt_2" == req.SendBody.Type {
-	//	if userBalance.BalanceUsdt2 < amount {
-	//		return &v1.WithdrawReply{
-	//			Status: "fail",
-	//		}, nil
-	//	}
-	//
-	//	if 1000000 > amount {
-	//		return &v1.WithdrawReply{
-	//			Status: "fail",
-	//		}, nil
-	//	}
-	//}
-
-	//var userRecommend *UserRecommend
-	//userRecommend, err = uuc.urRepo.GetUserRecommendByUserId(ctx, user.ID)
-	//if nil == userRecommend {
-	//	return &v1.WithdrawReply{
-	//		Status: "信息错误",
-	//	}, nil
-	//}
-	//
-	//var (
-	//	tmpRecommendUserIds    []string
-	//	tmpRecommendUserIdsInt []int64
-	//)
-	//if "" != userRecommend.RecommendCode {
-	//	tmpRecommendUserIds = strings.Split(userRecommend.RecommendCode, "D")
-	//}
-	//lastKey := len(tmpRecommendUserIds) - 1
-	//if 1 <= lastKey {
-	//	for i := 0; i <= lastKey; i++ {
-	//		// 有占位信息，推荐人推荐人的上一代
-	//		if lastKey-i <= 0 {
-	//			break
-	//		}
-	//
-	//		tmpMyTopUserRecommendUserId, _ := strconv.ParseInt(tmpRecommendUserIds[lastKey-i], 10, 64) // 最后一位是直推人
-	//		tmpRecommendUserIdsInt = append(tmpRecommendUserIdsInt, tmpMyTopUserRecommendUserId)
-	//	}
-	//}
 
-	// 配置
-	//var (
-	//	configs      []*Config
-	//	withdrawRate int64
-	//)
-	//configs, err = uuc.configRepo.GetConfigByKeys(ctx,
-	//	"withdraw_rate",
-	//)
-	//if nil != configs {
-	//	for _, vConfig := range configs {
-	//		if "withdraw_rate" == vConfig.KeyName {
-	//			withdrawRate, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-	//		}
-	//	}
-	//}
-
-	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
-
-		if "usdt" == req.SendBody.Type {
-			err = uuc.ubRepo.WithdrawUsdt2(ctx, user.ID, amount) // 提现
-			if nil != err {
-				return err
-			}
-			_, err = uuc.ubRepo.GreateWithdraw(ctx, user.ID, amount, amount, 0, req.SendBody.Type)
-			if nil != err {
-				return err
-			}
+		var withdraw *Withdraw
+		withdraw, err = uuc.ubRepo.GreateWithdraw(ctx, user.ID, quote.Net, amount, quote.Fee, quote.Burn, req.SendBody.Type, address)
+		if nil != err {
+			return err
+		}
 
+		commissionEvent.RecordId = withdraw.ID
+		err = uuc.payCommission(ctx, commissionEvent, commissions)
+		if nil != err {
+			return err
 		}
 
-		if "dhb" == req.SendBody.Type {
-			err = uuc.ubRepo.WithdrawDhb(ctx, user.ID, amount) // 提现
-			if nil != err {
-				return err
-			}
-			_, err = uuc.ubRepo.GreateWithdraw(ctx, user.ID, amount, amount, 0, req.SendBody.Type)
-			if nil != err {
-				return err
-			}
+		// 分佣从手续费中支出，都是 usdt
+		var commission int64
+		if "usdt" == quote.Coin {
+			commission = commissionPaid(commissions)
+		}
+		err = uuc.ubRepo.RecordFee(ctx, user.ID, withdraw.ID, quote, commission)
+		if nil != err {
+			return err
 		}
-		//else if "usdt_2" == req.SendBody.Type {
-		//	err = uuc.ubRepo.WithdrawUsdt3(ctx, user.ID, amount) // 提现
-		//	if nil != err {
-		//		return err
-		//	}
-		//	_, err = uuc.ubRepo.GreateWithdraw(ctx, user.ID, amount, amount-amount*withdrawRate/100, amount*withdrawRate/100, req.SendBody.Type)
-		//	if nil != err {
-		//		return err
-		//	}
-		//
-		//}
-		//else if "dhb" == req.SendBody.Type {
-		//	err = uuc.ubRepo.WithdrawDhb(ctx, user.ID, amount) // 提现
-		//	if nil != err {
-		//		return err
-		//	}
-		//	_, err = uuc.ubRepo.GreateWithdraw(ctx, user.ID, amount-1000000, 1000000, req.SendBody.Type)
-		//	if nil != err {
-		//		return err
-		//	}
-		//}
 
-		return nil
+		return uuc.bus.Publish(ctx, &WithdrawCreated{
+			WithdrawId: withdraw.ID,
+			UserId:     user.ID,
+			Coin:       req.SendBody.Type,
+			Amount:     formatAmount(amount),
+			Fee:        formatAmount(quote.Fee + quote.Burn),
+			Net:        formatAmount(quote.Net),
+			Address:    address,
+		})
 	}); nil != err {
-		return &v1.WithdrawReply{
-			Status: "提现错误",
-		}, nil
+		return nil, err
 	}
 
 	return &v1.WithdrawReply{
 		Status: "ok",
+		Fee:    formatAmount(quote.Fee + quote.Burn),
+		Net:    formatAmount(quote.Net),
 	}, nil
 }
 
@@ -1973,46 +2212,44 @@ func (uuc *UserUseCase) Tran(ctx context.Context, req *v1.TranRequest, user *Use
 		u           *User
 	)
 
-	u, _ = uuc.repo.GetUserById(ctx, user.ID)
+	u, err = uuc.repo.GetUserById(ctx, user.ID)
+	if nil != err {
+		return nil, err
+	}
+
+	// 缓存中没有密码
+	u.Password, err = uuc.repo.GetUserPassword(ctx, user.ID)
 	if nil != err {
 		return nil, err
 	}
 
 	if "" == u.Password || 6 > len(u.Password) {
-		return nil, errors.New(500, "ERROR_TOKEN", "未设置密码，联系管理员")
+		return nil, v1.ErrorPasswordNotSet("未设置密码，联系管理员")
 	}
 
 	if u.Password != user.Password {
-		return nil, errors.New(403, "ERROR_TOKEN", "无效TOKEN")
+		return nil, v1.ErrorTokenInvalid("无效TOKEN")
 	}
 
 	if password != u.Password {
-		return nil, errors.New(500, "密码错误", "密码错误")
+		return nil, v1.ErrorPasswordWrong("密码错误")
 	}
 
 	if "" == req.SendBody.Address {
-		return &v1.TranReply{
-			Status: "不存在地址",
-		}, nil
+		return nil, v1.ErrorUserNotFound("不存在地址")
 	}
 
 	toUser, err = uuc.repo.GetUserByAddress(ctx, req.SendBody.Address)
 	if nil != err {
-		return &v1.TranReply{
-			Status: "不存在地址",
-		}, nil
+		return nil, v1.ErrorUserNotFound("不存在地址")
 	}
 
 	if user.ID == toUser.ID {
-		return &v1.TranReply{
-			Status: "不能给自己转账",
-		}, nil
+		return nil, v1.ErrorTransferSelf("不能给自己转账")
 	}
 
 	if "dhb" != req.SendBody.Type && "usdt" != req.SendBody.Type {
-		return &v1.TranReply{
-			Status: "fail",
-		}, nil
+		return nil, v1.ErrorCoinUnsupported("币种错误")
 	}
 
 	userBalance, err = uuc.ubRepo.GetUserBalance(ctx, user.ID)
@@ -2026,29 +2263,23 @@ func (uuc *UserUseCase) Tran(ctx context.Context, req *v1.TranRequest, user *Use
 
 	if "dhb" == req.SendBody.Type {
 		if userBalance.BalanceDhb < amount {
-			return &v1.TranReply{
-				Status: "fail",
-			}, nil
+			return nil, v1.ErrorBalanceInsufficient("余额不足")
 		}
 
 		if 10000000 > amount {
-			return &v1.TranReply{
-				Status: "fail",
-			}, nil
+			return nil, v1.ErrorAmountTooSmall("最小转账数量:%s", formatAmount(10000000)).
+				WithMetadata(map[string]string{"min": formatAmount(10000000)})
 		}
 	}
 
 	if "usdt" == req.SendBody.Type {
 		if userBalance.BalanceUsdt < amount {
-			return &v1.TranReply{
-				Status: "fail",
-			}, nil
+			return nil, v1.ErrorBalanceInsufficient("余额不足")
 		}
 
 		if 1000000 > amount {
-			return &v1.TranReply{
-				Status: "fail",
-			}, nil
+			return nil, v1.ErrorAmountTooSmall("最小转账数量:%s", formatAmount(1000000)).
+				WithMetadata(map[string]string{"min": formatAmount(1000000)})
 		}
 	}
 
@@ -2058,9 +2289,7 @@ func (uuc *UserUseCase) Tran(ctx context.Context, req *v1.TranRequest, user *Use
 	)
 	userRecommend, err = uuc.urRepo.GetUserRecommendByUserId(ctx, user.ID)
 	if nil == userRecommend {
-		return &v1.TranReply{
-			Status: "信息错误",
-		}, nil
+		return nil, v1.ErrorRecommendNotFound("信息错误")
 	}
 
 	var (
@@ -2090,9 +2319,7 @@ func (uuc *UserUseCase) Tran(ctx context.Context, req *v1.TranRequest, user *Use
 
 	userRecommend2, err = uuc.urRepo.GetUserRecommendByUserId(ctx, toUser.ID)
 	if nil == userRecommend2 {
-		return &v1.TranReply{
-			Status: "信息错误",
-		}, nil
+		return nil, v1.ErrorRecommendNotFound("信息错误")
 	}
 	if "" != userRecommend2.RecommendCode {
 		toUserTmpRecommendUserIds = strings.Split(userRecommend2.RecommendCode, "D")
@@ -2127,7 +2354,17 @@ func (uuc *UserUseCase) Tran(ctx context.Context, req *v1.TranRequest, user *Use
 			}
 		}
 
-		return nil
+		return uuc.bus.Publish(ctx, &TransferCompleted{
+			FromUserId: user.ID,
+			ToUserId:   toUser.ID,
+			Coin:       req.SendBody.Type,
+			Amount:     formatAmount(amount),
+		}, &TransferReceived{
+			FromUserId: user.ID,
+			ToUserId:   toUser.ID,
+			Coin:       req.SendBody.Type,
+			Amount:     formatAmount(amount),
+		})
 	}); nil != err {
 		return nil, err
 	}
@@ -2139,45 +2376,46 @@ func (uuc *UserUseCase) Tran(ctx context.Context, req *v1.TranRequest, user *Use
 
 func (uuc *UserUseCase) Trade(ctx context.Context, req *v1.WithdrawRequest, user *User, amount int64, amountB int64, amount2 int64, password string) (*v1.WithdrawReply, error) {
 	var (
-		u                   *User
-		userBalance         *UserBalance
-		userBalance2        *UserBalance
-		configs             []*Config
-		userRecommend       *UserRecommend
-		withdrawRate        int64
-		withdrawDestroyRate int64
-		err                 error
+		u             *User
+		userBalance   *UserBalance
+		userBalance2  *UserBalance
+		userRecommend *UserRecommend
+		quote         *FeeQuote
+		quoteB        *FeeQuote
+		err           error
 	)
 
-	u, _ = uuc.repo.GetUserById(ctx, user.ID)
+	u, err = uuc.repo.GetUserById(ctx, user.ID)
+	if nil != err {
+		return nil, err
+	}
+
+	// 缓存中没有密码
+	u.Password, err = uuc.repo.GetUserPassword(ctx, user.ID)
 	if nil != err {
 		return nil, err
 	}
 
 	if "" == u.Password || 6 > len(u.Password) {
-		return nil, errors.New(500, "ERROR_TOKEN", "未设置密码，联系管理员")
+		return nil, v1.ErrorPasswordNotSet("未设置密码，联系管理员")
 	}
 
 	if u.Password != user.Password {
-		return nil, errors.New(403, "ERROR_TOKEN", "无效TOKEN")
+		return nil, v1.ErrorTokenInvalid("无效TOKEN")
 	}
 
 	if password != u.Password {
-		return nil, errors.New(500, "密码错误", "密码错误")
+		return nil, v1.ErrorPasswordWrong("密码错误")
 	}
 
-	configs, _ = uuc.configRepo.GetConfigByKeys(ctx, "withdraw_rate",
-		"withdraw_destroy_rate",
-	)
-
-	if nil != configs {
-		for _, vConfig := range configs {
-			if "withdraw_rate" == vConfig.KeyName {
-				withdrawRate, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			} else if "withdraw_destroy_rate" == vConfig.KeyName {
-				withdrawDestroyRate, _ = strconv.ParseInt(vConfig.Value, 10, 64)
-			}
-		}
+	// 手续费，csd 和 hbs 分别计算
+	quote, err = uuc.QuoteFee(ctx, "trade", "usdt", amount)
+	if nil != err {
+		return nil, err
+	}
+	quoteB, err = uuc.QuoteFee(ctx, "trade", "dhb", amountB)
+	if nil != err {
+		return nil, err
 	}
 
 	userBalance, err = uuc.ubRepo.GetUserBalanceLock(ctx, user.ID)
@@ -2191,23 +2429,17 @@ func (uuc *UserUseCase) Trade(ctx context.Context, req *v1.WithdrawRequest, user
 	}
 
 	if userBalance.BalanceUsdt < amount {
-		return &v1.WithdrawReply{
-			Status: "csd锁定部分的余额不足",
-		}, nil
+		return nil, v1.ErrorBalanceInsufficient("csd锁定部分的余额不足")
 	}
 
 	if userBalance2.BalanceDhb < amountB {
-		return &v1.WithdrawReply{
-			Status: "hbs锁定部分的余额不足",
-		}, nil
+		return nil, v1.ErrorBalanceInsufficient("hbs锁定部分的余额不足")
 	}
 
 	// 推荐人
 	userRecommend, err = uuc.urRepo.GetUserRecommendByUserId(ctx, user.ID)
 	if nil == userRecommend {
-		return &v1.WithdrawReply{
-			Status: "信息错误",
-		}, nil
+		return nil, v1.ErrorRecommendNotFound("信息错误")
 	}
 
 	var (
@@ -2230,14 +2462,48 @@ func (uuc *UserUseCase) Trade(ctx context.Context, req *v1.WithdrawRequest, user
 		}
 	}
 
+	// 分佣
+	var (
+		commissionEvent = &CommissionEvent{Type: "trade", UserId: user.ID, Amount: amount}
+		commissions     []*CommissionReward
+	)
+	commissions, err = uuc.PlanCommission(ctx, commissionEvent)
+	if nil != err {
+		return nil, err
+	}
+
 	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
 
-		err = uuc.ubRepo.Trade(ctx, user.ID, amount, amountB, amount-amount/100*(withdrawRate+withdrawDestroyRate), amountB-amountB/100*(withdrawRate+withdrawDestroyRate), tmpRecommendUserIdsInt, amount2) // 提现
+		var tradeId int64
+		tradeId, err = uuc.ubRepo.Trade(ctx, user.ID, amount, amountB, quote.Net, quoteB.Net, tmpRecommendUserIdsInt, amount2) // 提现
 		if nil != err {
 			return err
 		}
 
-		return nil
+		commissionEvent.RecordId = tradeId
+		err = uuc.payCommission(ctx, commissionEvent, commissions)
+		if nil != err {
+			return err
+		}
+
+		// 分佣从 usdt 手续费中支出
+		err = uuc.ubRepo.RecordFee(ctx, user.ID, tradeId, quote, commissionPaid(commissions))
+		if nil != err {
+			return err
+		}
+		err = uuc.ubRepo.RecordFee(ctx, user.ID, tradeId, quoteB, 0)
+		if nil != err {
+			return err
+		}
+
+		return uuc.bus.Publish(ctx, &TradeCreated{
+			TradeId: tradeId,
+			UserId:  user.ID,
+			Usdt:    formatAmount(amount),
+			Dhb:     formatAmount(amountB),
+			UsdtNet: formatAmount(quote.Net),
+			DhbNet:  formatAmount(quoteB.Net),
+		})
 	}); nil != err {
 		return nil, err
 	}
@@ -2257,9 +2523,7 @@ func (uuc *UserUseCase) SetBalanceReward(ctx context.Context, req *v1.SetBalance
 	amountFloat *= 100000
 	amount, _ := strconv.ParseInt(strconv.FormatFloat(amountFloat, 'f', -1, 64), 10, 64)
 	if 0 >= amount {
-		return &v1.SetBalanceRewardReply{
-			Status: "fail",
-		}, nil
+		return nil, v1.ErrorAmountInvalid("金额错误")
 	}
 
 	userBalance, err = uuc.ubRepo.GetUserBalance(ctx, user.ID)
@@ -2268,9 +2532,7 @@ func (uuc *UserUseCase) SetBalanceReward(ctx context.Context, req *v1.SetBalance
 	}
 
 	if userBalance.BalanceUsdt < amount {
-		return &v1.SetBalanceRewardReply{
-			Status: "fail",
-		}, nil
+		return nil, v1.ErrorBalanceInsufficient("余额不足")
 	}
 
 	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
@@ -2290,70 +2552,85 @@ func (uuc *UserUseCase) SetBalanceReward(ctx context.Context, req *v1.SetBalance
 	}, nil
 }
 
+// DeleteBalanceReward 赎回理财，锁定仓位、扣减、生成赎回记录和入账在同一事务中完成
 func (uuc *UserUseCase) DeleteBalanceReward(ctx context.Context, req *v1.DeleteBalanceRewardRequest, user *User) (*v1.DeleteBalanceRewardReply, error) {
 	var (
-		err            error
-		balanceRewards []*BalanceReward
+		err      error
+		unstakes []*BalanceRewardUnstake
 	)
 
 	amountFloat, _ := strconv.ParseFloat(req.SendBody.Amount, 10)
 	amountFloat *= 100000
 	amount, _ := strconv.ParseInt(strconv.FormatFloat(amountFloat, 'f', -1, 64), 10, 64)
 	if 0 >= amount {
-		return &v1.DeleteBalanceRewardReply{
-			Status: "fail",
-		}, nil
-	}
-
-	balanceRewards, err = uuc.ubRepo.GetBalanceRewardByUserId(ctx, user.ID)
-	if nil != err {
-		return &v1.DeleteBalanceRewardReply{
-			Status: "fail",
-		}, nil
+		return nil, v1.ErrorAmountInvalid("金额错误")
 	}
 
-	var totalBalanceRewardAmount int64
-	for _, vBalanceReward := range balanceRewards {
-		totalBalanceRewardAmount += vBalanceReward.Amount
-	}
-
-	if totalBalanceRewardAmount < amount {
-		return &v1.DeleteBalanceRewardReply{
-			Status: "fail",
-		}, nil
-	}
+	rule := uuc.getUnstakeRule(ctx)
+	now := time.Now().UTC()
+	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		var balanceRewards []*BalanceReward
+		balanceRewards, err = uuc.ubRepo.LockBalanceRewardByUserId(ctx, user.ID)
+		if nil != err {
+			return err
+		}
 
-	for _, vBalanceReward := range balanceRewards {
-		tmpAmount := int64(0)
-		Status := int64(1)
+		unstakes = PlanUnstake(rule, balanceRewards, amount, now)
+		if nil == unstakes {
+			return v1.ErrorBalanceInsufficient("理财余额不足")
+		}
 
-		if amount-vBalanceReward.Amount < 0 {
-			tmpAmount = amount
-		} else {
-			tmpAmount = vBalanceReward.Amount
-			Status = 2
+		remains := make(map[int64]int64, 0)
+		for _, v := range balanceRewards {
+			remains[v.ID] = v.Amount
 		}
+		for _, v := range unstakes {
+			status := int64(1)
+			if remains[v.BalanceRewardId] == v.Amount {
+				status = 2
+			}
 
-		if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
-			err = uuc.ubRepo.UpdateBalanceReward(ctx, user.ID, vBalanceReward.ID, tmpAmount, Status) // 提现
+			err = uuc.ubRepo.ReduceBalanceReward(ctx, v.BalanceRewardId, v.Amount, status)
 			if nil != err {
 				return err
 			}
 
-			return nil
-		}); nil != err {
-			return nil, err
-		}
-		amount -= tmpAmount
+			var unstake *BalanceRewardUnstake
+			unstake, err = uuc.ubRepo.CreateBalanceRewardUnstake(ctx, v)
+			if nil != err {
+				return err
+			}
+			v.ID = unstake.ID
 
-		if amount <= 0 {
-			break
+			if !v.MatureAt.After(now) { // 没有冷却期直接入账
+				err = uuc.ubRepo.CreditBalanceRewardUnstake(ctx, v)
+				if nil != err {
+					return err
+				}
+				v.Status = "done"
+			}
 		}
+
+		return nil
+	}); nil != err {
+		return nil, err
 	}
 
-	return &v1.DeleteBalanceRewardReply{
-		Status: "ok",
-	}, nil
+	res := &v1.DeleteBalanceRewardReply{
+		Status:    "ok",
+		Positions: make([]*v1.DeleteBalanceRewardReply_List, 0),
+	}
+	for _, v := range unstakes {
+		res.Positions = append(res.Positions, &v1.DeleteBalanceRewardReply_List{
+			Id:       v.BalanceRewardId,
+			Amount:   fmt.Sprintf("%.2f", float64(v.Amount)/float64(100000)),
+			Penalty:  fmt.Sprintf("%.2f", float64(v.Penalty)/float64(100000)),
+			Status:   v.Status,
+			MatureAt: uuc.day.Format(v.MatureAt),
+		})
+	}
+
+	return res, nil
 }
 
 func (uuc *UserUseCase) AdminRewardList(ctx context.Context, req *v1.AdminRewardListRequest) (*v1.AdminRewardListReply, error) {
@@ -2425,8 +2702,67 @@ func (uuc *UserUseCase) UpdateWithdrawDoing(ctx context.Context, id int64) (*Wit
 	return uuc.ubRepo.UpdateWithdraw(ctx, id, "doing")
 }
 
+// UpdateWithdrawPass 审核通过
+func (uuc *UserUseCase) UpdateWithdrawPass(ctx context.Context, id int64) (*Withdraw, error) {
+	return uuc.updateWithdrawStatus(ctx, id, []string{"rewarded"}, "pass", EventWithdrawApproved, nil)
+}
+
 func (uuc *UserUseCase) UpdateWithdrawSuccess(ctx context.Context, id int64) (*Withdraw, error) {
-	return uuc.ubRepo.UpdateWithdraw(ctx, id, "success")
+	return uuc.updateWithdrawStatus(ctx, id, []string{"pass", "doing"}, "success", EventWithdrawPaid, nil)
+}
+
+// UpdateWithdrawFail 打款失败，同一事务退回提现金额并释放全平台当日额度，
+// 状态条件更新保证重复提交只退一次
+func (uuc *UserUseCase) UpdateWithdrawFail(ctx context.Context, id int64) (*Withdraw, error) {
+	return uuc.updateWithdrawStatus(ctx, id, []string{"pass", "doing"}, "fail", EventWithdrawFailed, func(ctx context.Context, withdraw *Withdraw) error {
+		if err := uuc.ubRepo.RefundWithdraw(ctx, withdraw.UserId, withdraw.Type, withdraw.Amount); nil != err {
+			return err
+		}
+		return uuc.ubRepo.ReleaseWithdrawPlatformDaily(ctx, uuc.day.Date(withdraw.CreatedAt), withdraw.Type, withdraw.Amount)
+	})
+}
+
+// updateWithdrawStatus 从 from 中的状态修改为 status，同一事务执行 after 并写入领域事件，状态已变化时返回 WITHDRAW_STATE_INVALID
+func (uuc *UserUseCase) updateWithdrawStatus(ctx context.Context, id int64, from []string, status string, eventType string, after func(ctx context.Context, withdraw *Withdraw) error) (*Withdraw, error) {
+	var withdraw *Withdraw
+	if err := uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		var err error
+		withdraw, err = uuc.ubRepo.GetWithdrawById(ctx, id)
+		if nil != err {
+			return err
+		}
+
+		ok, err := uuc.ubRepo.UpdateWithdrawFrom(ctx, id, from, status)
+		if nil != err {
+			return err
+		}
+		if !ok {
+			return v1.ErrorWithdrawStateInvalid("提现状态不能从%s改为%s", withdraw.Status, status).
+				WithMetadata(map[string]string{"from": withdraw.Status, "to": status})
+		}
+		withdraw.Status = status
+
+		if nil != after {
+			if err = after(ctx, withdraw); nil != err {
+				return err
+			}
+		}
+
+		return uuc.bus.Publish(ctx, &WithdrawStatusChanged{
+			Type:       eventType,
+			WithdrawId: withdraw.ID,
+			UserId:     withdraw.UserId,
+			Coin:       withdraw.Type,
+			Amount:     formatAmount(withdraw.Amount),
+			Net:        formatAmount(withdraw.RelAmount),
+			Address:    withdraw.Address,
+			Status:     status,
+		})
+	}); nil != err {
+		return nil, err
+	}
+
+	return withdraw, nil
 }
 
 func (uuc *UserUseCase) AdminWithdrawList(ctx context.Context, req *v1.AdminWithdrawListRequest) (*v1.AdminWithdrawListReply, error) {
@@ -2447,6 +2783,35 @@ func (uuc *UserUseCase) AdminAll(ctx context.Context, req *v1.AdminAllRequest) (
 	return &v1.AdminAllReply{}, nil
 }
 
+// AdminWithdraw 后台审核和记录打款结果
 func (uuc *UserUseCase) AdminWithdraw(ctx context.Context, req *v1.AdminWithdrawRequest) (*v1.AdminWithdrawReply, error) {
-	return &v1.AdminWithdrawReply{}, nil
+	var err error
+	switch req.SendBody.Status {
+	case "pass":
+		_, err = uuc.UpdateWithdrawPass(ctx, req.SendBody.Id)
+	case "success":
+		_, err = uuc.UpdateWithdrawSuccess(ctx, req.SendBody.Id)
+	case "fail":
+		_, err = uuc.UpdateWithdrawFail(ctx, req.SendBody.Id)
+	default:
+		return nil, v1.ErrorParamInvalid("状态错误")
+	}
+	if nil != err {
+		return nil, err
+	}
+
+	return &v1.AdminWithdrawReply{Status: "ok"}, nil
+}
+
+// AdminPasswordUpdate 后台重置密码，password 为 md5 后的值，记录修改时间用于提现冷静期，旧 token 随之失效
+func (uuc *UserUseCase) AdminPasswordUpdate(ctx context.Context, userId int64, password string) (*v1.AdminPasswordUpdateReply, error) {
+	if _, err := uuc.repo.GetUserById(ctx, userId); nil != err {
+		return nil, err
+	}
+
+	if err := uuc.repo.UpdateUserPassword(ctx, userId, password, time.Now()); nil != err {
+		return nil, err
+	}
+
+	return &v1.AdminPasswordUpdateReply{Status: "ok"}, nil
 }
@@ -0,0 +1,563 @@
+package biz
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	v1 "dhb/app/app/api"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// 可订阅的事件类型
+var webhookEventTypes = []string{
+	EventDepositCredited,
+	EventWithdrawCreated,
+	EventWithdrawApproved,
+	EventWithdrawPaid,
+	EventWithdrawFailed,
+	EventTransferCompleted,
+	EventTransferReceived,
+	EventExchangeCompleted,
+	EventTradeCreated,
+	EventRewardPaid,
+}
+
+// 投递状态，重试次数用完进入死信 dead
+const (
+	WebhookDeliveryPending = "pending"
+	WebhookDeliverySuccess = "success"
+	WebhookDeliveryDead    = "dead"
+)
+
+const (
+	webhookMaxAttempts = 10
+	webhookBatch       = 50
+	webhookWorkers     = 8
+	webhookTimeout     = 5 * time.Second
+	webhookRunBudget   = 30 * time.Second // 单次任务超过这个时间不再取新的一批，避免影响调度续约
+	webhookBackoffBase = time.Minute
+	webhookBackoffMax  = 6 * time.Hour
+)
+
+// WebhookSubscriber 订阅方，Events 为事件类型，支持 * 和 withdraw.* 这样的前缀
+type WebhookSubscriber struct {
+	ID        int64
+	Url       string
+	Secret    string
+	Events    []string
+	Status    string // enable disable
+	CreatedAt time.Time
+}
+
+// WebhookDelivery 事件对某个订阅方的投递，同一事件同一订阅方只有一条
+type WebhookDelivery struct {
+	ID           int64
+	EventId      int64
+	SubscriberId int64
+	Status       string
+	Attempts     int64
+	NextAt       time.Time
+	ResponseCode int64
+	LastError    string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+type WebhookRepo interface {
+	CreateWebhookSubscriber(ctx context.Context, s *WebhookSubscriber) (*WebhookSubscriber, error)
+	UpdateWebhookSubscriber(ctx context.Context, s *WebhookSubscriber) error
+	GetWebhookSubscribers(ctx context.Context) ([]*WebhookSubscriber, error)
+	GetWebhookSubscriberById(ctx context.Context, id int64) (*WebhookSubscriber, error)
+	CreateWebhookDeliveries(ctx context.Context, deliveries []*WebhookDelivery) error
+	ResetWebhookDeliveries(ctx context.Context, eventId int64) (int64, error)
+	GetWebhookDeliveriesDue(ctx context.Context, now time.Time, limit int) ([]*WebhookDelivery, error)
+	GetWebhookDeliveryById(ctx context.Context, id int64) (*WebhookDelivery, error)
+	GetWebhookDeliveries(ctx context.Context, status string, subscriberId int64, b *Pagination) ([]*WebhookDelivery, error, int64)
+	UpdateWebhookDelivery(ctx context.Context, d *WebhookDelivery) error
+}
+
+type WebhookUseCase struct {
+	repo   WebhookRepo
+	bus    *EventBus
+	day    *BusinessDay
+	client *http.Client
+	log    *log.Helper
+}
+
+// NewWebhookUseCase 作为进程内订阅方接收领域事件，生成投递记录
+func NewWebhookUseCase(repo WebhookRepo, bus *EventBus, day *BusinessDay, logger log.Logger) *WebhookUseCase {
+	wuc := &WebhookUseCase{
+		repo:   repo,
+		bus:    bus,
+		day:    day,
+		client: newWebhookClient(),
+		log:    log.NewHelper(logger),
+	}
+	bus.Subscribe("webhook", wuc.handle, webhookEventTypes...)
+	return wuc
+}
+
+// newWebhookClient 投递用的 client，不走代理、不跟随跳转，建立连接时再校验一次地址，防止域名解析到内网
+func newWebhookClient() *http.Client {
+	dialer := &net.Dialer{
+		Timeout: webhookTimeout,
+		Control: func(network, address string, c syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if nil != err {
+				return err
+			}
+			if ip := net.ParseIP(host); nil == ip || !webhookPublicIP(ip) {
+				return fmt.Errorf("webhook: address %s not allowed", host)
+			}
+			return nil
+		},
+	}
+
+	return &http.Client{
+		Timeout: webhookTimeout,
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: webhookTimeout,
+			MaxIdleConns:        webhookWorkers,
+			IdleConnTimeout:     time.Minute,
+		},
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse // 3xx 按失败处理
+		},
+	}
+}
+
+// webhookPublicIP 只允许投递到公网地址
+func webhookPublicIP(ip net.IP) bool {
+	return !ip.IsLoopback() && !ip.IsPrivate() && !ip.IsUnspecified() &&
+		!ip.IsLinkLocalUnicast() && !ip.IsLinkLocalMulticast() && !ip.IsInterfaceLocalMulticast() && !ip.IsMulticast()
+}
+
+// checkWebhookUrl 只允许 https，域名解析出的地址都必须是公网地址
+func checkWebhookUrl(ctx context.Context, value string) error {
+	u, err := url.Parse(value)
+	if nil != err || "https" != u.Scheme || "" == u.Hostname() || nil != u.User || 200 < len(value) {
+		return v1.ErrorParamInvalid("地址错误，只支持 https")
+	}
+
+	addrs, err := net.DefaultResolver.LookupIPAddr(ctx, u.Hostname())
+	if nil != err || 0 == len(addrs) {
+		return v1.ErrorParamInvalid("地址无法解析")
+	}
+	for _, v := range addrs {
+		if !webhookPublicIP(v.IP) {
+			return v1.ErrorParamInvalid("不允许内网地址")
+		}
+	}
+
+	return nil
+}
+
+// Match 订阅方是否订阅了该事件
+func (s *WebhookSubscriber) Match(eventType string) bool {
+	if "enable" != s.Status {
+		return false
+	}
+	for _, v := range s.Events {
+		if "*" == v || v == eventType {
+			return true
+		}
+		if strings.HasSuffix(v, ".*") && strings.HasPrefix(eventType, v[:len(v)-1]) {
+			return true
+		}
+	}
+	return false
+}
+
+// WebhookSign 签名为 hex(hmac_sha256(secret, "时间戳.请求体"))
+func WebhookSign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10) + "."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// webhookBackoff 第 attempts 次失败后的等待时间，指数增长
+func webhookBackoff(attempts int64) time.Duration {
+	d := webhookBackoffBase
+	for i := int64(1); i < attempts; i++ {
+		d *= 2
+		if d >= webhookBackoffMax {
+			return webhookBackoffMax
+		}
+	}
+	return d
+}
+
+// handle 为订阅了该事件的订阅方生成投递记录，重复转发时不会重复生成
+func (wuc *WebhookUseCase) handle(ctx context.Context, e *OutboxEvent) error {
+	subscribers, err := wuc.repo.GetWebhookSubscribers(ctx)
+	if nil != err {
+		return err
+	}
+	return wuc.repo.CreateWebhookDeliveries(ctx, webhookDeliveries(e, subscribers))
+}
+
+// Dispatch 定时任务，投递到期的记录
+func (wuc *WebhookUseCase) Dispatch(ctx context.Context, now time.Time) (int64, error) {
+	var (
+		total    int64
+		deadline = now.Add(webhookRunBudget)
+	)
+	for time.Now().Before(deadline) {
+		deliveries, err := wuc.repo.GetWebhookDeliveriesDue(ctx, time.Now().UTC(), webhookBatch)
+		if nil != err {
+			return total, err
+		}
+		if 0 >= len(deliveries) {
+			break
+		}
+
+		if err = wuc.deliver(ctx, deliveries); nil != err {
+			return total, err
+		}
+		total += int64(len(deliveries))
+		if webhookBatch > len(deliveries) {
+			break
+		}
+	}
+
+	return total, nil
+}
+
+func webhookDeliveries(e *OutboxEvent, subscribers []*WebhookSubscriber) []*WebhookDelivery {
+	now := time.Now().UTC()
+	res := make([]*WebhookDelivery, 0)
+	for _, s := range subscribers {
+		if s.Match(e.Type) {
+			res = append(res, &WebhookDelivery{
+				EventId:      e.ID,
+				SubscriberId: s.ID,
+				Status:       WebhookDeliveryPending,
+				NextAt:       now,
+			})
+		}
+	}
+	return res
+}
+
+// deliver 并发投递一批记录，失败的按指数退避重试，超过次数进入死信
+func (wuc *WebhookUseCase) deliver(ctx context.Context, deliveries []*WebhookDelivery) error {
+	eventIds := make([]int64, 0, len(deliveries))
+	for _, d := range deliveries {
+		eventIds = append(eventIds, d.EventId)
+	}
+	events, err := wuc.bus.GetEventsByIds(ctx, eventIds...)
+	if nil != err {
+		return err
+	}
+	subscribers, err := wuc.repo.GetWebhookSubscribers(ctx)
+	if nil != err {
+		return err
+	}
+	subscriberMap := make(map[int64]*WebhookSubscriber, 0)
+	for _, s := range subscribers {
+		subscriberMap[s.ID] = s
+	}
+
+	var (
+		wg  sync.WaitGroup
+		sem = make(chan struct{}, webhookWorkers)
+	)
+	for _, d := range deliveries {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(d *WebhookDelivery) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			d.Attempts++
+			d.ResponseCode, d.LastError = 0, ""
+			e, s := events[d.EventId], subscriberMap[d.SubscriberId]
+			switch {
+			case nil == e:
+				d.Status, d.LastError = WebhookDeliveryDead, "event not found"
+			case nil == s || "enable" != s.Status:
+				d.Status, d.LastError = WebhookDeliveryDead, "subscriber disabled"
+			default:
+				code, postErr := wuc.post(ctx, s, e)
+				d.ResponseCode = code
+				if nil == postErr {
+					d.Status = WebhookDeliverySuccess
+				} else if webhookMaxAttempts <= d.Attempts {
+					d.Status, d.LastError = WebhookDeliveryDead, postErr.Error()
+				} else {
+					d.LastError, d.NextAt = postErr.Error(), time.Now().UTC().Add(webhookBackoff(d.Attempts))
+				}
+			}
+
+			if err := wuc.repo.UpdateWebhookDelivery(ctx, d); nil != err {
+				wuc.log.Errorf("webhook delivery %d: %v", d.ID, err)
+			}
+		}(d)
+	}
+	wg.Wait()
+
+	return nil
+}
+
+// post 发送事件，2xx 为成功
+func (wuc *WebhookUseCase) post(ctx context.Context, s *WebhookSubscriber, e *OutboxEvent) (int64, error) {
+	body, err := json.Marshal(map[string]interface{}{
+		"id":         e.ID,
+		"type":       e.Type,
+		"created_at": e.CreatedAt.UTC().Format(time.RFC3339),
+		"data":       json.RawMessage(e.Payload),
+	})
+	if nil != err {
+		return 0, err
+	}
+
+	if u, err := url.Parse(s.Url); nil != err || "https" != u.Scheme {
+		return 0, fmt.Errorf("webhook: https required")
+	}
+
+	timestamp := time.Now().Unix()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.Url, bytes.NewReader(body))
+	if nil != err {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Webhook-Id", strconv.FormatInt(e.ID, 10))
+	req.Header.Set("X-Webhook-Event", e.Type)
+	req.Header.Set("X-Webhook-Timestamp", strconv.FormatInt(timestamp, 10))
+	req.Header.Set("X-Webhook-Signature", "sha256="+WebhookSign(s.Secret, timestamp, body))
+
+	resp, err := wuc.client.Do(req)
+	if nil != err {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+
+	if 200 > resp.StatusCode || 300 <= resp.StatusCode {
+		return int64(resp.StatusCode), errors.New(resp.StatusCode, "WEBHOOK_ERROR", resp.Status)
+	}
+	return int64(resp.StatusCode), nil
+}
+
+// parseWebhookEvents 校验订阅的事件类型
+func parseWebhookEvents(value string) ([]string, bool) {
+	known := make(map[string]bool, 0)
+	for _, v := range webhookEventTypes {
+		known[v] = true
+		known[v[:strings.Index(v, ".")]+".*"] = true
+	}
+
+	res := make([]string, 0)
+	for _, v := range strings.Split(value, ",") {
+		v = strings.TrimSpace(v)
+		if "" == v {
+			continue
+		}
+		if "*" != v && !known[v] {
+			return nil, false
+		}
+		res = append(res, v)
+	}
+	return res, 0 < len(res)
+}
+
+// AdminWebhookList 订阅方列表，密钥只显示末尾
+func (wuc *WebhookUseCase) AdminWebhookList(ctx context.Context, req *v1.AdminWebhookListRequest) (*v1.AdminWebhookListReply, error) {
+	subscribers, err := wuc.repo.GetWebhookSubscribers(ctx)
+	if nil != err {
+		return nil, err
+	}
+
+	res := &v1.AdminWebhookListReply{
+		List:   make([]*v1.AdminWebhookListReply_List, 0),
+		Events: webhookEventTypes,
+	}
+	for _, v := range subscribers {
+		secret := v.Secret
+		if 4 < len(secret) {
+			secret = "****" + secret[len(secret)-4:]
+		}
+		res.List = append(res.List, &v1.AdminWebhookListReply_List{
+			Id:        v.ID,
+			Url:       v.Url,
+			Secret:    secret,
+			Events:    strings.Join(v.Events, ","),
+			Status:    v.Status,
+			CreatedAt: wuc.day.Format(v.CreatedAt),
+		})
+	}
+
+	return res, nil
+}
+
+// AdminWebhookCreate 添加订阅方，没有传密钥时生成，只在创建时返回
+func (wuc *WebhookUseCase) AdminWebhookCreate(ctx context.Context, req *v1.AdminWebhookCreateRequest) (*v1.AdminWebhookCreateReply, error) {
+	err := checkWebhookUrl(ctx, req.SendBody.Url)
+	if nil != err {
+		return nil, err
+	}
+
+	events, ok := parseWebhookEvents(req.SendBody.Events)
+	if !ok {
+		return nil, v1.ErrorParamInvalid("事件类型错误")
+	}
+
+	secret := req.SendBody.Secret
+	if "" == secret {
+		b := make([]byte, 32)
+		if _, err = rand.Read(b); nil != err {
+			return nil, err
+		}
+		secret = hex.EncodeToString(b)
+	}
+	if 16 > len(secret) || 100 < len(secret) {
+		return nil, v1.ErrorParamInvalid("密钥长度为16到100")
+	}
+
+	s, err := wuc.repo.CreateWebhookSubscriber(ctx, &WebhookSubscriber{
+		Url:    req.SendBody.Url,
+		Secret: secret,
+		Events: events,
+		Status: "enable",
+	})
+	if nil != err {
+		return nil, err
+	}
+
+	return &v1.AdminWebhookCreateReply{
+		Id:     s.ID,
+		Secret: secret,
+	}, nil
+}
+
+// AdminWebhookUpdate 修改订阅事件或启用停用
+func (wuc *WebhookUseCase) AdminWebhookUpdate(ctx context.Context, req *v1.AdminWebhookUpdateRequest) (*v1.AdminWebhookUpdateReply, error) {
+	s, err := wuc.repo.GetWebhookSubscriberById(ctx, req.SendBody.Id)
+	if nil != err {
+		return nil, err
+	}
+	if nil == s {
+		return nil, v1.ErrorWebhookNotFound("webhook 不存在")
+	}
+
+	if "" != req.SendBody.Events {
+		events, ok := parseWebhookEvents(req.SendBody.Events)
+		if !ok {
+			return nil, v1.ErrorParamInvalid("事件类型错误")
+		}
+		s.Events = events
+	}
+	if "" != req.SendBody.Status {
+		if "enable" != req.SendBody.Status && "disable" != req.SendBody.Status {
+			return nil, v1.ErrorParamInvalid("状态错误")
+		}
+		s.Status = req.SendBody.Status
+	}
+
+	if err = wuc.repo.UpdateWebhookSubscriber(ctx, s); nil != err {
+		return nil, err
+	}
+
+	return &v1.AdminWebhookUpdateReply{Status: "ok"}, nil
+}
+
+// AdminWebhookDeliveryList 投递记录，status 传 dead 查看死信
+func (wuc *WebhookUseCase) AdminWebhookDeliveryList(ctx context.Context, req *v1.AdminWebhookDeliveryListRequest) (*v1.AdminWebhookDeliveryListReply, error) {
+	deliveries, err, count := wuc.repo.GetWebhookDeliveries(ctx, req.Status, req.SubscriberId, &Pagination{
+		PageNum:  int(req.Page),
+		PageSize: 10,
+	})
+	if nil != err {
+		return nil, err
+	}
+
+	eventIds := make([]int64, 0, len(deliveries))
+	for _, v := range deliveries {
+		eventIds = append(eventIds, v.EventId)
+	}
+	events, err := wuc.bus.GetEventsByIds(ctx, eventIds...)
+	if nil != err {
+		return nil, err
+	}
+
+	res := &v1.AdminWebhookDeliveryListReply{
+		List:  make([]*v1.AdminWebhookDeliveryListReply_List, 0),
+		Count: count,
+	}
+	for _, v := range deliveries {
+		item := &v1.AdminWebhookDeliveryListReply_List{
+			Id:           v.ID,
+			EventId:      v.EventId,
+			SubscriberId: v.SubscriberId,
+			Status:       v.Status,
+			Attempts:     v.Attempts,
+			ResponseCode: v.ResponseCode,
+			LastError:    v.LastError,
+			NextAt:       wuc.day.Format(v.NextAt),
+			CreatedAt:    wuc.day.Format(v.CreatedAt),
+		}
+		if e, ok := events[v.EventId]; ok {
+			item.Event = e.Type
+			item.Payload = e.Payload
+		}
+		res.List = append(res.List, item)
+	}
+
+	return res, nil
+}
+
+// AdminWebhookReplay 重放，传 deliveryId 时重新投递该记录（含死信），
+// 传 eventId 时按当前订阅关系补齐投递记录，已有的记录重新投递
+func (wuc *WebhookUseCase) AdminWebhookReplay(ctx context.Context, req *v1.AdminWebhookReplayRequest) (*v1.AdminWebhookReplayReply, error) {
+	if 0 < req.SendBody.DeliveryId {
+		d, err := wuc.repo.GetWebhookDeliveryById(ctx, req.SendBody.DeliveryId)
+		if nil != err {
+			return nil, err
+		}
+		if nil == d {
+			return nil, v1.ErrorWebhookDeliveryNotFound("投递记录不存在")
+		}
+
+		d.Status, d.Attempts, d.NextAt = WebhookDeliveryPending, 0, time.Now().UTC()
+		if err = wuc.repo.UpdateWebhookDelivery(ctx, d); nil != err {
+			return nil, err
+		}
+		return &v1.AdminWebhookReplayReply{Deliveries: 1}, nil
+	}
+
+	events, err := wuc.bus.GetEventsByIds(ctx, req.SendBody.EventId)
+	if nil != err {
+		return nil, err
+	}
+	e, ok := events[req.SendBody.EventId]
+	if !ok {
+		return nil, v1.ErrorEventNotFound("事件不存在")
+	}
+
+	if err = wuc.handle(ctx, e); nil != err {
+		return nil, err
+	}
+	count, err := wuc.repo.ResetWebhookDeliveries(ctx, e.ID)
+	if nil != err {
+		return nil, err
+	}
+
+	return &v1.AdminWebhookReplayReply{Deliveries: count}, nil
+}
@@ -0,0 +1,84 @@
+package biz
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWebhookSign(t *testing.T) {
+	tests := []struct {
+		name      string
+		secret    string
+		timestamp int64
+		body      string
+		want      string
+	}{
+		{"known", "whsec", 1700000000, `{"id":1}`, "e79220cb981f992adbc8b93ac6d46028b0217ea19327d27dc9d18bf334403bde"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := WebhookSign(tt.secret, tt.timestamp, []byte(tt.body)); got != tt.want {
+				t.Errorf("WebhookSign() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+
+	sign := WebhookSign("whsec", 1700000000, []byte(`{"id":1}`))
+	for _, v := range []string{
+		WebhookSign("other", 1700000000, []byte(`{"id":1}`)),
+		WebhookSign("whsec", 1700000001, []byte(`{"id":1}`)),
+		WebhookSign("whsec", 1700000000, []byte(`{"id":2}`)),
+	} {
+		if sign == v {
+			t.Errorf("WebhookSign() = %s for different input", v)
+		}
+	}
+}
+
+func TestWebhookBackoff(t *testing.T) {
+	tests := []struct {
+		attempts int64
+		want     time.Duration
+	}{
+		{0, time.Minute},
+		{1, time.Minute},
+		{2, 2 * time.Minute},
+		{5, 16 * time.Minute},
+		{9, 256 * time.Minute},
+		{10, 6 * time.Hour},
+		{100, 6 * time.Hour},
+	}
+
+	for _, tt := range tests {
+		if got := webhookBackoff(tt.attempts); got != tt.want {
+			t.Errorf("webhookBackoff(%d) = %s, want %s", tt.attempts, got, tt.want)
+		}
+	}
+}
+
+func TestWebhookSubscriberMatch(t *testing.T) {
+	tests := []struct {
+		name      string
+		status    string
+		events    []string
+		eventType string
+		want      bool
+	}{
+		{"exact", "enable", []string{"withdraw.created"}, "withdraw.created", true},
+		{"all", "enable", []string{"*"}, "user.registered", true},
+		{"prefix", "enable", []string{"withdraw.*"}, "withdraw.failed", true},
+		{"prefix needs dot", "enable", []string{"withdraw.*"}, "withdrawal.failed", false},
+		{"other", "enable", []string{"withdraw.created"}, "withdraw.failed", false},
+		{"disabled", "disable", []string{"*"}, "withdraw.created", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &WebhookSubscriber{Status: tt.status, Events: tt.events}
+			if got := s.Match(tt.eventType); got != tt.want {
+				t.Errorf("Match(%q) = %v, want %v", tt.eventType, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,155 @@
+package biz
+
+import (
+	"context"
+	v1 "dhb/app/app/api"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	withdrawAddressMax          = 20             // 每个用户最多保存的提现地址数量
+	withdrawAddressDelayDefault = 24 * time.Hour // 新地址默认等待时间
+)
+
+// WithdrawAddress 提现地址簿，ActiveAt 之后才能使用
+type WithdrawAddress struct {
+	ID        int64
+	UserId    int64
+	Label     string
+	Address   string // EIP-55 校验和格式
+	ActiveAt  time.Time
+	CreatedAt time.Time
+}
+
+type WithdrawAddressRepo interface {
+	CreateWithdrawAddress(ctx context.Context, wa *WithdrawAddress) (*WithdrawAddress, error)
+	GetWithdrawAddressesByUserId(ctx context.Context, userId int64) ([]*WithdrawAddress, error)
+	GetWithdrawAddressById(ctx context.Context, id int64) (*WithdrawAddress, error)
+	DeleteWithdrawAddress(ctx context.Context, userId int64, id int64) error
+}
+
+// getWithdrawAddressRule 新地址等待时间，是否只能提现到地址簿
+func (uuc *UserUseCase) getWithdrawAddressRule(ctx context.Context) (time.Duration, bool) {
+	var (
+		delay         = withdrawAddressDelayDefault
+		whitelistOnly bool
+	)
+
+	configs, _ := uuc.configRepo.GetConfigByKeys(ctx, "withdraw_address_delay", "withdraw_whitelist_only")
+	if nil != configs {
+		for _, vConfig := range configs {
+			if "withdraw_address_delay" == vConfig.KeyName { // 小时
+				hours, err := strconv.ParseInt(vConfig.Value, 10, 64)
+				if nil == err && 0 <= hours {
+					delay = time.Duration(hours) * time.Hour
+				}
+			}
+			if "withdraw_whitelist_only" == vConfig.KeyName {
+				whitelistOnly = "1" == vConfig.Value
+			}
+		}
+	}
+
+	return delay, whitelistOnly
+}
+
+// WithdrawAddressList 我的提现地址
+func (uuc *UserUseCase) WithdrawAddressList(ctx context.Context, user *User) (*v1.WithdrawAddressListReply, error) {
+	addresses, err := uuc.warRepo.GetWithdrawAddressesByUserId(ctx, user.ID)
+	if nil != err {
+		return nil, err
+	}
+
+	_, whitelistOnly := uuc.getWithdrawAddressRule(ctx)
+	now := time.Now()
+	res := &v1.WithdrawAddressListReply{
+		WhitelistOnly: whitelistOnly,
+		List:          make([]*v1.WithdrawAddressListReply_List, 0),
+	}
+	for _, v := range addresses {
+		res.List = append(res.List, &v1.WithdrawAddressListReply_List{
+			Id:        v.ID,
+			Label:     v.Label,
+			Address:   v.Address,
+			Active:    !now.Before(v.ActiveAt),
+			ActiveAt:  uuc.day.Format(v.ActiveAt),
+			CreatedAt: uuc.day.Format(v.CreatedAt),
+		})
+	}
+
+	return res, nil
+}
+
+// WithdrawAddressCreate 添加提现地址，地址格式和合约检查由调用方完成
+func (uuc *UserUseCase) WithdrawAddressCreate(ctx context.Context, label string, address string, user *User) (*v1.WithdrawAddressCreateReply, error) {
+	label = strings.TrimSpace(label)
+	if "" == label || 45 < len(label) {
+		return nil, v1.ErrorParamInvalid("地址备注错误")
+	}
+
+	addresses, err := uuc.warRepo.GetWithdrawAddressesByUserId(ctx, user.ID)
+	if nil != err {
+		return nil, err
+	}
+	if withdrawAddressMax <= len(addresses) {
+		return nil, v1.ErrorWithdrawAddressLimit("提现地址数量已达上限")
+	}
+	for _, v := range addresses {
+		if strings.EqualFold(address, v.Address) {
+			return nil, v1.ErrorWithdrawAddressExists("提现地址已存在")
+		}
+	}
+
+	delay, _ := uuc.getWithdrawAddressRule(ctx)
+	wa, err := uuc.warRepo.CreateWithdrawAddress(ctx, &WithdrawAddress{
+		UserId:   user.ID,
+		Label:    label,
+		Address:  address,
+		ActiveAt: time.Now().UTC().Add(delay),
+	})
+	if nil != err {
+		return nil, err
+	}
+
+	return &v1.WithdrawAddressCreateReply{
+		Id:       wa.ID,
+		ActiveAt: uuc.day.Format(wa.ActiveAt),
+	}, nil
+}
+
+// WithdrawAddressDelete 删除提现地址
+func (uuc *UserUseCase) WithdrawAddressDelete(ctx context.Context, id int64, user *User) (*v1.WithdrawAddressDeleteReply, error) {
+	if err := uuc.warRepo.DeleteWithdrawAddress(ctx, user.ID, id); nil != err {
+		return nil, err
+	}
+
+	return &v1.WithdrawAddressDeleteReply{Status: "ok"}, nil
+}
+
+// withdrawDestination 提现目标地址，未指定时为登录地址，白名单模式下必须使用地址簿中已生效的地址
+func (uuc *UserUseCase) withdrawDestination(ctx context.Context, addressId int64, user *User, now time.Time) (string, error) {
+	_, whitelistOnly := uuc.getWithdrawAddressRule(ctx)
+	if 0 >= addressId {
+		if whitelistOnly {
+			return "", v1.ErrorWithdrawAddressRequired("请选择地址簿中的提现地址")
+		}
+		return user.Address, nil
+	}
+
+	wa, err := uuc.warRepo.GetWithdrawAddressById(ctx, addressId)
+	if nil != err {
+		return "", err
+	}
+	if nil == wa || wa.UserId != user.ID {
+		return "", v1.ErrorWithdrawAddressInvalid("提现地址不存在")
+	}
+	if now.Before(wa.ActiveAt) {
+		until := uuc.day.Format(wa.ActiveAt)
+		return "", v1.ErrorWithdrawAddressPending("新地址请于%s后使用", until).
+			WithMetadata(map[string]string{"until": until})
+	}
+
+	return wa.Address, nil
+}
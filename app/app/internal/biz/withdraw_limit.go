@@ -0,0 +1,254 @@
+package biz
+
+import (
+	"context"
+	v1 "dhb/app/app/api"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// WithdrawTier vip 等级的提现上限，覆盖个人上限
+type WithdrawTier struct {
+	Daily  int64
+	Weekly int64
+}
+
+// WithdrawLimitRule 某个币种的提现规则，金额和笔数为 0 不限制
+type WithdrawLimitRule struct {
+	Open              bool
+	Min               int64
+	Max               int64
+	UserDaily         int64
+	UserWeekly        int64 // 含今天的最近 7 个业务日
+	Tiers             map[int64]*WithdrawTier
+	PlatformDaily     int64
+	PasswordCooldown  time.Duration // 修改密码后多久不能提现
+	RecommendCooldown time.Duration // 修改推荐人后多久不能提现
+	MaxPerHour        int64
+	MaxPerDay         int64
+}
+
+// WithdrawUsage 一段时间内已提现的笔数和金额
+type WithdrawUsage struct {
+	Count  int64
+	Amount int64
+}
+
+// parseWithdrawTiers 解析 vip 提现上限，格式：vip:日上限:周上限,...
+func parseWithdrawTiers(value string) map[int64]*WithdrawTier {
+	res := make(map[int64]*WithdrawTier, 0)
+	for _, v := range strings.Split(value, ",") {
+		v = strings.TrimSpace(v)
+		if "" == v {
+			continue
+		}
+
+		tmp := strings.Split(v, ":")
+		vip, err := strconv.ParseInt(tmp[0], 10, 64)
+		if nil != err {
+			continue
+		}
+		tier := &WithdrawTier{}
+		if 2 <= len(tmp) && "" != tmp[1] {
+			tier.Daily, _ = strconv.ParseInt(tmp[1]+"00000", 10, 64)
+		}
+		if 3 <= len(tmp) && "" != tmp[2] {
+			tier.Weekly, _ = strconv.ParseInt(tmp[2]+"00000", 10, 64)
+		}
+		res[vip] = tier
+	}
+
+	return res
+}
+
+// getWithdrawLimitRule 读取币种对应的提现规则，usdt 和 dhb 的最小最大金额沿用原有配置
+func (uuc *UserUseCase) getWithdrawLimitRule(ctx context.Context, coin string) (*WithdrawLimitRule, error) {
+	rule := &WithdrawLimitRule{Tiers: make(map[int64]*WithdrawTier, 0)}
+
+	minKey, maxKey := "withdraw_amount_min_"+coin, "withdraw_amount_max_"+coin
+	if "usdt" == coin {
+		minKey, maxKey = "withdraw_amount_min", "withdraw_amount_max"
+	}
+	if "dhb" == coin {
+		minKey, maxKey = "withdraw_amount_bnbs_min", "withdraw_amount_bnbs_max"
+	}
+
+	configs, err := uuc.configRepo.GetConfigByKeys(ctx,
+		"withdraw_open",
+		minKey,
+		maxKey,
+		"withdraw_user_daily_"+coin,
+		"withdraw_user_weekly_"+coin,
+		"withdraw_vip_limit_"+coin,
+		"withdraw_platform_daily_"+coin,
+		"withdraw_password_cooldown",
+		"withdraw_recommend_cooldown",
+		"withdraw_max_per_hour",
+		"withdraw_max_per_day",
+	)
+	if nil != err {
+		return nil, err
+	}
+
+	for _, vConfig := range configs {
+		switch vConfig.KeyName {
+		case "withdraw_open":
+			rule.Open = "1" == vConfig.Value
+		case minKey:
+			rule.Min, _ = strconv.ParseInt(vConfig.Value+"00000", 10, 64)
+		case maxKey:
+			rule.Max, _ = strconv.ParseInt(vConfig.Value+"00000", 10, 64)
+		case "withdraw_user_daily_" + coin:
+			rule.UserDaily, _ = strconv.ParseInt(vConfig.Value+"00000", 10, 64)
+		case "withdraw_user_weekly_" + coin:
+			rule.UserWeekly, _ = strconv.ParseInt(vConfig.Value+"00000", 10, 64)
+		case "withdraw_vip_limit_" + coin:
+			rule.Tiers = parseWithdrawTiers(vConfig.Value)
+		case "withdraw_platform_daily_" + coin:
+			rule.PlatformDaily, _ = strconv.ParseInt(vConfig.Value+"00000", 10, 64)
+		case "withdraw_password_cooldown": // 小时
+			hours, _ := strconv.ParseInt(vConfig.Value, 10, 64)
+			rule.PasswordCooldown = time.Duration(hours) * time.Hour
+		case "withdraw_recommend_cooldown": // 小时
+			hours, _ := strconv.ParseInt(vConfig.Value, 10, 64)
+			rule.RecommendCooldown = time.Duration(hours) * time.Hour
+		case "withdraw_max_per_hour":
+			rule.MaxPerHour, _ = strconv.ParseInt(vConfig.Value, 10, 64)
+		case "withdraw_max_per_day":
+			rule.MaxPerDay, _ = strconv.ParseInt(vConfig.Value, 10, 64)
+		}
+	}
+
+	return rule, nil
+}
+
+// platformWithdrawToday 全平台今日已提现
+func (uuc *UserUseCase) platformWithdrawToday(ctx context.Context, coin string) (int64, error) {
+	if "usdt" == coin {
+		return uuc.ubRepo.GetUserWithdrawUsdtTotalToday(ctx)
+	}
+	return uuc.ubRepo.GetUserWithdrawTotalTodayByCoinType(ctx, coin)
+}
+
+// checkWithdrawLimit 事务中余额锁定后调用，返回 nil 表示允许提现，拒绝原因见 ErrorReason WITHDRAW_*
+func (uuc *UserUseCase) checkWithdrawLimit(ctx context.Context, rule *WithdrawLimitRule, userId int64, coin string, amount int64, balance int64, now time.Time) error {
+	if !rule.Open {
+		return v1.ErrorWithdrawClosed("提现已关闭")
+	}
+	if 0 >= amount {
+		return v1.ErrorWithdrawAmountInvalid("提现金额错误")
+	}
+	if 0 < rule.Min && rule.Min > amount {
+		limit := formatAmount(rule.Min)
+		return v1.ErrorWithdrawAmountMin("最小提现金额:%s", limit).
+			WithMetadata(map[string]string{"min": limit})
+	}
+	if 0 < rule.Max && rule.Max < amount {
+		limit := formatAmount(rule.Max)
+		return v1.ErrorWithdrawAmountMax("最大提现金额:%s", limit).
+			WithMetadata(map[string]string{"max": limit})
+	}
+	if balance < amount {
+		return v1.ErrorWithdrawInsufficientBalance("余额不足")
+	}
+
+	// 修改密码、推荐人后的冷静期
+	if 0 < rule.PasswordCooldown {
+		user, err := uuc.repo.GetUserById(ctx, userId)
+		if nil != err {
+			return err
+		}
+		if !user.PasswordUpdatedAt.IsZero() && now.Before(user.PasswordUpdatedAt.Add(rule.PasswordCooldown)) {
+			until := uuc.day.Format(user.PasswordUpdatedAt.Add(rule.PasswordCooldown))
+			return v1.ErrorWithdrawPasswordCooldown("修改密码后暂不能提现，请于%s后再试", until).
+				WithMetadata(map[string]string{"until": until})
+		}
+	}
+	if 0 < rule.RecommendCooldown {
+		history, err := uuc.urRepo.GetUserRecommendHistoryLast(ctx, userId)
+		if nil != err {
+			return err
+		}
+		if nil != history && now.Before(history.CreatedAt.Add(rule.RecommendCooldown)) {
+			until := uuc.day.Format(history.CreatedAt.Add(rule.RecommendCooldown))
+			return v1.ErrorWithdrawRecommendCooldown("修改推荐人后暂不能提现，请于%s后再试", until).
+				WithMetadata(map[string]string{"until": until})
+		}
+	}
+
+	// 笔数
+	if 0 < rule.MaxPerHour {
+		usage, err := uuc.ubRepo.GetUserWithdrawUsage(ctx, userId, coin, now.Add(-time.Hour))
+		if nil != err {
+			return err
+		}
+		if usage.Count >= rule.MaxPerHour {
+			return v1.ErrorWithdrawVelocityHour("提现过于频繁，每小时最多%d笔", rule.MaxPerHour).
+				WithMetadata(map[string]string{"count": strconv.FormatInt(rule.MaxPerHour, 10)})
+		}
+	}
+
+	todayStart, _ := uuc.day.Today(now)
+	today, err := uuc.ubRepo.GetUserWithdrawUsage(ctx, userId, coin, todayStart)
+	if nil != err {
+		return err
+	}
+	if 0 < rule.MaxPerDay && today.Count >= rule.MaxPerDay {
+		return v1.ErrorWithdrawVelocityDay("今日提现笔数已达上限:%d", rule.MaxPerDay).
+			WithMetadata(map[string]string{"count": strconv.FormatInt(rule.MaxPerDay, 10)})
+	}
+
+	// 个人金额上限，vip 等级有配置的以等级为准
+	daily, weekly := rule.UserDaily, rule.UserWeekly
+	if 0 < len(rule.Tiers) {
+		userInfo, err := uuc.uiRepo.GetUserInfoByUserId(ctx, userId)
+		if nil != err {
+			return err
+		}
+		if tier, ok := rule.Tiers[userInfo.Vip]; ok {
+			daily, weekly = tier.Daily, tier.Weekly
+		}
+	}
+	if 0 < daily && today.Amount+amount > daily {
+		remain := formatAmount(withdrawRemain(daily, today.Amount))
+		return v1.ErrorWithdrawUserDailyCap("超过今日提现额度，剩余:%s", remain).
+			WithMetadata(map[string]string{"remain": remain})
+	}
+	if 0 < weekly {
+		weekStart, _ := uuc.day.Offset(now, -6)
+		week, err := uuc.ubRepo.GetUserWithdrawUsage(ctx, userId, coin, weekStart)
+		if nil != err {
+			return err
+		}
+		if week.Amount+amount > weekly {
+			remain := formatAmount(withdrawRemain(weekly, week.Amount))
+			return v1.ErrorWithdrawUserWeeklyCap("超过本周提现额度，剩余:%s", remain).
+				WithMetadata(map[string]string{"remain": remain})
+		}
+	}
+
+	// 全平台每日上限，放在最后，通过检查时在计数行上原子占用额度，并发提现不会超过上限
+	if 0 < rule.PlatformDaily {
+		total, err := uuc.platformWithdrawToday(ctx, coin)
+		if nil != err {
+			return err
+		}
+		ok, err := uuc.ubRepo.ReserveWithdrawPlatformDaily(ctx, uuc.day.Date(now), coin, amount, rule.PlatformDaily, total)
+		if nil != err {
+			return err
+		}
+		if !ok {
+			return v1.ErrorWithdrawPlatformDailyCap("今日平台提现额度已满，请明日再试")
+		}
+	}
+
+	return nil
+}
+
+func withdrawRemain(limit int64, used int64) int64 {
+	if used >= limit {
+		return 0
+	}
+	return limit - used
+}
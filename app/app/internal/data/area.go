@@ -0,0 +1,191 @@
+package data
+
+import (
+	"context"
+	"dhb/app/app/internal/biz"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"time"
+)
+
+type UserAreaLevelHistory struct {
+	ID            int64     `gorm:"primarykey;type:int"`
+	BusinessDate  string    `gorm:"type:varchar(10);not null;uniqueIndex:idx_area_level_history_date_user,priority:1"`
+	UserId        int64     `gorm:"type:int;not null;uniqueIndex:idx_area_level_history_date_user,priority:2"`
+	OriginLevel   int64     `gorm:"type:int;not null"`
+	Level         int64     `gorm:"type:int;not null"`
+	BigAmount     int64     `gorm:"type:bigint;not null"`
+	SmallAmount   int64     `gorm:"type:bigint;not null"`
+	EffectiveDate time.Time `gorm:"type:datetime;not null"`
+	CreatedAt     time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt     time.Time `gorm:"type:datetime;not null"`
+}
+
+// AreaLevelRun 每个业务日一条，start_at 唯一保证两次评定不会从同一个位置开始发放
+type AreaLevelRun struct {
+	ID           int64     `gorm:"primarykey;type:int"`
+	BusinessDate string    `gorm:"type:varchar(10);not null;uniqueIndex"`
+	StartAt      time.Time `gorm:"type:datetime;not null;uniqueIndex"`
+	EndAt        time.Time `gorm:"type:datetime;not null"`
+	UserNum      int64     `gorm:"type:int;not null"`
+	Promotions   int64     `gorm:"type:int;not null"`
+	Demotions    int64     `gorm:"type:int;not null"`
+	BonusTotal   int64     `gorm:"type:bigint;not null"`
+	CreatedAt    time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt    time.Time `gorm:"type:datetime;not null"`
+}
+
+type AreaRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewAreaRepo(data *Data, logger log.Logger) biz.AreaRepo {
+	return &AreaRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// GetAllUserAreas .
+func (ar *AreaRepo) GetAllUserAreas(ctx context.Context) (map[int64]*biz.UserArea, error) {
+	var userAreas []*UserArea
+	res := make(map[int64]*biz.UserArea, 0)
+	if err := ar.data.db.Table("user_area").Find(&userAreas).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return res, nil
+		}
+
+		return nil, errors.New(500, "USER AREA ERROR", err.Error())
+	}
+
+	for _, v := range userAreas {
+		res[v.UserId] = &biz.UserArea{
+			ID:         v.ID,
+			UserId:     v.UserId,
+			Amount:     v.Amount,
+			SelfAmount: v.SelfAmount,
+			Level:      v.Level,
+		}
+	}
+
+	return res, nil
+}
+
+// SaveUserArea 事务中使用，没有记录时创建 .
+func (ar *AreaRepo) SaveUserArea(ctx context.Context, ua *biz.UserArea) error {
+	var userArea UserArea
+	if err := ar.data.DB(ctx).Where("user_id=?", ua.UserId).Table("user_area").First(&userArea).Error; err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New(500, "USER AREA ERROR", err.Error())
+		}
+
+		userArea.UserId = ua.UserId
+		userArea.Level = ua.Level
+		userArea.Amount = ua.Amount
+		userArea.SelfAmount = ua.SelfAmount
+		if res := ar.data.DB(ctx).Table("user_area").Create(&userArea); res.Error != nil {
+			return errors.New(500, "CREATE_USER_AREA_ERROR", "用户区信息创建失败")
+		}
+
+		return nil
+	}
+
+	if res := ar.data.DB(ctx).Table("user_area").
+		Where("id=?", userArea.ID).
+		Updates(map[string]interface{}{"level": ua.Level, "amount": ua.Amount, "self_amount": ua.SelfAmount}); res.Error != nil {
+		return errors.New(500, "UPDATE_USER_AREA_ERROR", "用户区信息修改失败")
+	}
+
+	return nil
+}
+
+// CreateUserAreaLevelHistory .
+func (ar *AreaRepo) CreateUserAreaLevelHistory(ctx context.Context, h *biz.UserAreaLevelHistory) error {
+	var history UserAreaLevelHistory
+	history.BusinessDate = h.BusinessDate
+	history.UserId = h.UserId
+	history.OriginLevel = h.OriginLevel
+	history.Level = h.Level
+	history.BigAmount = h.BigAmount
+	history.SmallAmount = h.SmallAmount
+	history.EffectiveDate = h.EffectiveDate
+
+	if res := ar.data.DB(ctx).Table("user_area_level_history").Create(&history); res.Error != nil {
+		return errors.New(500, "CREATE_USER_AREA_LEVEL_HISTORY_ERROR", "用户级别记录创建失败")
+	}
+
+	return nil
+}
+
+func (r *AreaLevelRun) toBiz() *biz.AreaLevelRun {
+	return &biz.AreaLevelRun{
+		ID:           r.ID,
+		BusinessDate: r.BusinessDate,
+		StartAt:      r.StartAt,
+		EndAt:        r.EndAt,
+		UserNum:      r.UserNum,
+		Promotions:   r.Promotions,
+		Demotions:    r.Demotions,
+		BonusTotal:   r.BonusTotal,
+		CreatedAt:    r.CreatedAt,
+	}
+}
+
+// GetLastAreaLevelRun .
+func (ar *AreaRepo) GetLastAreaLevelRun(ctx context.Context) (*biz.AreaLevelRun, error) {
+	var run AreaLevelRun
+	if err := ar.data.db.Table("area_level_run").Order("business_date desc").First(&run).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, errors.New(500, "AREA LEVEL RUN ERROR", err.Error())
+	}
+
+	return run.toBiz(), nil
+}
+
+// CreateAreaLevelRun .
+func (ar *AreaRepo) CreateAreaLevelRun(ctx context.Context, r *biz.AreaLevelRun) (*biz.AreaLevelRun, error) {
+	var run AreaLevelRun
+	run.BusinessDate = r.BusinessDate
+	run.StartAt = r.StartAt
+	run.EndAt = r.EndAt
+	run.UserNum = r.UserNum
+	run.Promotions = r.Promotions
+	run.Demotions = r.Demotions
+	run.BonusTotal = r.BonusTotal
+
+	if res := ar.data.DB(ctx).Table("area_level_run").Create(&run); res.Error != nil {
+		return nil, errors.New(500, "CREATE_AREA_LEVEL_RUN_ERROR", "级别评定记录创建失败")
+	}
+
+	return run.toBiz(), nil
+}
+
+// GetAreaLevelRunByDate 没有记录时返回 nil .
+func (ar *AreaRepo) GetAreaLevelRunByDate(ctx context.Context, date string) (*biz.AreaLevelRun, error) {
+	var run AreaLevelRun
+	if err := ar.data.db.Table("area_level_run").Where("business_date=?", date).First(&run).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, errors.New(500, "AREA LEVEL RUN ERROR", err.Error())
+	}
+
+	return run.toBiz(), nil
+}
+
+// UpdateAreaLevelRun 事务中使用，发放完成后写入统计 .
+func (ar *AreaRepo) UpdateAreaLevelRun(ctx context.Context, r *biz.AreaLevelRun) error {
+	if res := ar.data.DB(ctx).Table("area_level_run").
+		Where("id=?", r.ID).
+		Updates(map[string]interface{}{"user_num": r.UserNum, "promotions": r.Promotions, "demotions": r.Demotions, "bonus_total": r.BonusTotal}); res.Error != nil {
+		return errors.New(500, "UPDATE_AREA_LEVEL_RUN_ERROR", "级别评定记录修改失败")
+	}
+
+	return nil
+}
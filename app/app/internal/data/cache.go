@@ -0,0 +1,234 @@
+package data
+
+import (
+	"context"
+	"dhb/app/app/internal/biz"
+	"dhb/app/app/internal/conf"
+	"encoding/json"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/v8"
+	"golang.org/x/sync/singleflight"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// 缓存名称，每个名称单独统计命中率
+const (
+	cacheConfig        = "config"
+	cacheUser          = "user"
+	cacheUserInfo      = "user_info"
+	cacheUserRecommend = "user_recommend"
+	cachePrice         = "price"
+)
+
+type cacheStat struct {
+	hits   int64
+	misses int64
+	errors int64
+}
+
+// cacheLoadTimeout 合并读库时不使用某一个请求的 ctx，避免一个请求取消导致所有等待的请求失败
+const cacheLoadTimeout = 5 * time.Second
+
+// cacheGenTTL 版本号的过期时间，远大于 cacheLoadTimeout，读库期间版本号不会过期
+const cacheGenTTL = time.Hour
+
+// cacheSetIfGen 版本号没有变化时才写入，读库期间有 Forget 时放弃写入，避免旧数据写回缓存
+var cacheSetIfGen = redis.NewScript(`
+local gen = redis.call('GET', KEYS[2])
+if (gen or '') ~= ARGV[1] then
+	return 0
+end
+redis.call('SET', KEYS[1], ARGV[2], 'PX', ARGV[3])
+return 1
+`)
+
+// Cache Redis 读穿缓存，同一个 key 并发未命中时只读一次库，Redis 不可用时直接读库
+type Cache struct {
+	rdb    *redis.Client
+	enable bool
+	prefix string
+	ttl    map[string]time.Duration
+	group  singleflight.Group
+	mu     sync.Mutex
+	stats  map[string]*cacheStat
+	log    *log.Helper
+}
+
+// NewCache .
+func NewCache(c *conf.Data_Cache, rdb *redis.Client, logger log.Logger) *Cache {
+	res := &Cache{
+		rdb:    rdb,
+		enable: c.GetEnable(),
+		prefix: "dhb:cache:",
+		ttl: map[string]time.Duration{
+			cacheConfig:        time.Minute,
+			cacheUser:          10 * time.Minute,
+			cacheUserInfo:      10 * time.Minute,
+			cacheUserRecommend: 10 * time.Minute,
+			cachePrice:         10 * time.Second,
+		},
+		stats: make(map[string]*cacheStat, 0),
+		log:   log.NewHelper(logger),
+	}
+	if "" != c.GetPrefix() {
+		res.prefix = c.GetPrefix()
+	}
+	if nil != c.GetConfigTtl() {
+		res.ttl[cacheConfig] = c.GetConfigTtl().AsDuration()
+	}
+	if nil != c.GetUserTtl() {
+		res.ttl[cacheUser] = c.GetUserTtl().AsDuration()
+		res.ttl[cacheUserInfo] = c.GetUserTtl().AsDuration()
+		res.ttl[cacheUserRecommend] = c.GetUserTtl().AsDuration()
+	}
+	if nil != c.GetPriceTtl() {
+		res.ttl[cachePrice] = c.GetPriceTtl().AsDuration()
+	}
+
+	return res
+}
+
+// NewCacheRepo .
+func NewCacheRepo(data *Data) biz.CacheRepo {
+	return data.cache
+}
+
+func (c *Cache) stat(name string) *cacheStat {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	s, ok := c.stats[name]
+	if !ok {
+		s = &cacheStat{}
+		c.stats[name] = s
+	}
+	return s
+}
+
+// Remember 命中时解析到 dest，未命中时调用 load 并写入缓存，load 返回错误时不缓存 .
+func (c *Cache) Remember(ctx context.Context, name string, key string, dest interface{}, load func(ctx context.Context) (interface{}, error)) error {
+	s := c.stat(name)
+	if !c.enable {
+		atomic.AddInt64(&s.misses, 1)
+		return c.decode(load(ctx))(dest)
+	}
+
+	redisKey := c.prefix + name + ":" + key
+	data, err := c.rdb.Get(ctx, redisKey).Bytes()
+	if nil == err {
+		if err = json.Unmarshal(data, dest); nil == err {
+			atomic.AddInt64(&s.hits, 1)
+			return nil
+		}
+	}
+	if redis.Nil == err {
+		atomic.AddInt64(&s.misses, 1)
+	} else {
+		atomic.AddInt64(&s.errors, 1)
+		c.log.Errorf("cache get %s: %v", redisKey, err)
+	}
+
+	v, err, _ := c.group.Do(redisKey, func() (interface{}, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), cacheLoadTimeout)
+		defer cancel()
+
+		// 读库前记下版本号，写入时版本号已变说明期间数据被修改过
+		genKey := c.genKey(name, key)
+		gen, genErr := c.rdb.Get(ctx, genKey).Result()
+		if redis.Nil == genErr {
+			genErr = nil
+		}
+
+		value, err := load(ctx)
+		if nil != err {
+			return nil, err
+		}
+
+		data, err := json.Marshal(value)
+		if nil != err {
+			return nil, err
+		}
+		if nil != genErr {
+			c.log.Errorf("cache get %s: %v", genKey, genErr)
+			return data, nil
+		}
+		if err = cacheSetIfGen.Run(ctx, c.rdb, []string{redisKey, genKey}, gen, data, c.ttl[name].Milliseconds()).Err(); nil != err {
+			c.log.Errorf("cache set %s: %v", redisKey, err)
+		}
+		return data, nil
+	})
+	if nil != err {
+		return err
+	}
+
+	return json.Unmarshal(v.([]byte), dest)
+}
+
+// decode 不使用缓存时也经过一次 json，保证和缓存命中时的结果一致
+func (c *Cache) decode(value interface{}, err error) func(dest interface{}) error {
+	return func(dest interface{}) error {
+		if nil != err {
+			return err
+		}
+
+		data, err := json.Marshal(value)
+		if nil != err {
+			return err
+		}
+		return json.Unmarshal(data, dest)
+	}
+}
+
+func (c *Cache) genKey(name string, key string) string {
+	return c.prefix + "gen:" + name + ":" + key
+}
+
+// Forget 删除缓存并增加版本号，正在读库的请求不会再把旧数据写回 .
+func (c *Cache) Forget(ctx context.Context, name string, keys ...string) {
+	if !c.enable || 0 >= len(keys) {
+		return
+	}
+
+	redisKeys := make([]string, 0, len(keys))
+	for _, key := range keys {
+		redisKeys = append(redisKeys, c.prefix+name+":"+key)
+	}
+	if _, err := c.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, key := range keys {
+			pipe.Incr(ctx, c.genKey(name, key))
+			pipe.Expire(ctx, c.genKey(name, key), cacheGenTTL)
+		}
+		pipe.Del(ctx, redisKeys...)
+		return nil
+	}); nil != err {
+		atomic.AddInt64(&c.stat(name).errors, 1)
+		c.log.Errorf("cache del %v: %v", redisKeys, err)
+	}
+}
+
+// GetCacheStats 本进程启动以来的统计 .
+func (c *Cache) GetCacheStats(ctx context.Context) (bool, []*biz.CacheStat) {
+	c.mu.Lock()
+	names := make([]string, 0, len(c.stats))
+	for name := range c.stats {
+		names = append(names, name)
+	}
+	c.mu.Unlock()
+	sort.Strings(names)
+
+	res := make([]*biz.CacheStat, 0, len(names))
+	for _, name := range names {
+		s := c.stat(name)
+		res = append(res, &biz.CacheStat{
+			Name:   name,
+			Hits:   atomic.LoadInt64(&s.hits),
+			Misses: atomic.LoadInt64(&s.misses),
+			Errors: atomic.LoadInt64(&s.errors),
+		})
+	}
+
+	return c.enable, res
+}
@@ -17,27 +17,42 @@ import (
 )
 
 // ProviderSet is data providers.
-var ProviderSet = wire.NewSet(NewData, NewDB, NewRedis, NewTransaction, NewUserRepo, NewUserInfoRepo, NewUserBalanceRepo, NewConfigRepo, NewUserRecommendRepo, NewEthUserRecordRepo, NewLocationRepo, NewUserCurrentMonthRecommendRepo)
+var ProviderSet = wire.NewSet(NewData, NewDB, NewRedis, NewTransaction, NewUserRepo, NewUserInfoRepo, NewUserBalanceRepo, NewConfigRepo, NewUserRecommendRepo, NewEthUserRecordRepo, NewLocationRepo, NewUserCurrentMonthRecommendRepo, NewUserInviteCodeRepo, NewWithdrawAddressRepo, NewAreaRepo, NewBusinessDay, NewJobRepo, NewPositionRepo, NewMatrixRepo, NewStatementRepo, NewStatementConfig, NewInternalTransferRepo, NewWebhookRepo, NewOutboxRepo, NewPushTicketRepo, NewEventConfig, NewEventSinks, NewPushConfig, NewCacheRepo, NewRateLimitRepo, NewRateLimitConfig)
 
 type Data struct {
-	db  *gorm.DB
-	rdb *redis.Client
+	db    *gorm.DB
+	rdb   *redis.Client
+	cache *Cache
+	day   *biz.BusinessDay
 }
 
 // 用来承载事务的上下文
 type contextTxKey struct{}
 
+// 事务提交后执行的函数
+type contextTxHooksKey struct{}
+
 // NewData .
-func NewData(c *conf.Data, logger log.Logger, db *gorm.DB, rdb *redis.Client) (*Data, func(), error) {
+func NewData(c *conf.Data, logger log.Logger, db *gorm.DB, rdb *redis.Client, day *biz.BusinessDay) (*Data, func(), error) {
 	cleanup := func() {
 		log.NewHelper(logger).Info("closing the data resources")
+		if err := rdb.Close(); err != nil {
+			log.NewHelper(logger).Error(err)
+		}
 	}
 	return &Data{
-		db:  db,
-		rdb: rdb,
+		db:    db,
+		rdb:   rdb,
+		cache: NewCache(c.GetCache(), rdb, logger),
+		day:   day,
 	}, cleanup, nil
 }
 
+// NewBusinessDay 业务日历 .
+func NewBusinessDay(c *conf.Business) (*biz.BusinessDay, error) {
+	return biz.NewBusinessDay(c.GetTimezone(), c.GetCutoff())
+}
+
 // NewTransaction .
 func NewTransaction(d *Data) biz.Transaction {
 	return d
@@ -45,9 +60,35 @@ func NewTransaction(d *Data) biz.Transaction {
 
 // ExecTx gorm Transaction
 func (d *Data) ExecTx(ctx context.Context, fn func(ctx context.Context) error) error {
-	return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		ctx = context.WithValue(ctx, contextTxKey{}, tx)
-		return fn(ctx)
+	hooks := make([]func(), 0)
+	if err := d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		txCtx := context.WithValue(ctx, contextTxKey{}, tx)
+		txCtx = context.WithValue(txCtx, contextTxHooksKey{}, &hooks)
+		return fn(txCtx)
+	}); err != nil {
+		return err
+	}
+
+	for _, hook := range hooks {
+		hook()
+	}
+	return nil
+}
+
+// afterCommit 事务提交后执行，回滚时不执行，不在事务中时立即执行
+func (d *Data) afterCommit(ctx context.Context, fn func()) {
+	hooks, ok := ctx.Value(contextTxHooksKey{}).(*[]func())
+	if ok {
+		*hooks = append(*hooks, fn)
+		return
+	}
+	fn()
+}
+
+// forget 删除缓存，事务中的写入在提交后删除，避免其他请求在提交前读到旧数据又写回缓存
+func (d *Data) forget(ctx context.Context, name string, keys ...string) {
+	d.afterCommit(ctx, func() {
+		d.cache.Forget(context.Background(), name, keys...)
 	})
 }
 
@@ -64,7 +105,7 @@ func (d *Data) DB(ctx context.Context) *gorm.DB {
 func NewDB(c *conf.Data) *gorm.DB {
 	f, err := os.OpenFile("../../log/sql.log", os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0666)
 	if err != nil {
-		log.Errorf("failed opening sql.log", err)
+		log.Errorf("failed opening sql.log: %v", err)
 		panic("failed opening sql.log")
 	}
 
@@ -103,9 +144,6 @@ func NewRedis(c *conf.Data) *redis.Client {
 		ReadTimeout:  c.Redis.ReadTimeout.AsDuration(),
 	})
 
-	if err := rdb.Close(); err != nil {
-		log.Error(err)
-	}
 	return rdb
 }
 
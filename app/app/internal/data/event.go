@@ -0,0 +1,351 @@
+package data
+
+import (
+	"bytes"
+	"context"
+	"dhb/app/app/internal/biz"
+	"dhb/app/app/internal/conf"
+	"encoding/json"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/v8"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type OutboxEvent struct {
+	ID        int64     `gorm:"primarykey;type:int"`
+	Type      string    `gorm:"type:varchar(45);not null"`
+	UserId    int64     `gorm:"type:int;not null;index"`
+	Payload   string    `gorm:"type:text;not null"`
+	CreatedAt time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt time.Time `gorm:"type:datetime;not null"`
+}
+
+type EventOffset struct {
+	ID        int64     `gorm:"primarykey;type:int"`
+	Consumer  string    `gorm:"type:varchar(45);not null;uniqueIndex"`
+	Offset    int64     `gorm:"type:bigint;not null"`
+	MaxId     int64     `gorm:"type:bigint;not null"`
+	Gaps      string    `gorm:"type:text;not null"` // json，空洞 id 和发现时间的 unix 秒
+	CreatedAt time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt time.Time `gorm:"type:datetime;not null"`
+}
+
+type OutboxRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewOutboxRepo(data *Data, logger log.Logger) biz.OutboxRepo {
+	return &OutboxRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// NewEventConfig 事件转发配置 .
+func NewEventConfig(c *conf.Events) *biz.EventConfig {
+	res := &biz.EventConfig{Batch: 100, GapTimeout: 10 * time.Minute}
+	if 0 < c.GetBatch() {
+		res.Batch = c.GetBatch()
+	}
+	if nil != c.GetGapTimeout() && 0 < c.GetGapTimeout().AsDuration() {
+		res.GapTimeout = c.GetGapTimeout().AsDuration()
+	}
+	return res
+}
+
+// NewPushConfig 推送配置 .
+func NewPushConfig(c *conf.Push) *biz.PushConfig {
+	res := &biz.PushConfig{Buffer: 64, ReplayLimit: 200, PriceInterval: 10 * time.Second, TicketTtl: 30 * time.Second}
+	if 0 < c.GetBuffer() {
+		res.Buffer = int(c.GetBuffer())
+	}
+	if 0 < c.GetReplayLimit() {
+		res.ReplayLimit = int(c.GetReplayLimit())
+	}
+	if nil != c.GetPriceInterval() && 0 < c.GetPriceInterval().AsDuration() {
+		res.PriceInterval = c.GetPriceInterval().AsDuration()
+	}
+	if nil != c.GetTicketTtl() && 0 < c.GetTicketTtl().AsDuration() {
+		res.TicketTtl = c.GetTicketTtl().AsDuration()
+	}
+	return res
+}
+
+// NewEventSinks 按配置创建外部投递目标 .
+func NewEventSinks(c *conf.Events, data *Data, logger log.Logger) biz.EventSinks {
+	res := make(biz.EventSinks, 0)
+	if c.GetRedisStream().GetEnable() {
+		res = append(res, &redisStreamSink{
+			rdb:    data.rdb,
+			stream: c.GetRedisStream().GetStream(),
+			maxLen: c.GetRedisStream().GetMaxLen(),
+		})
+	}
+	if c.GetKafka().GetEnable() {
+		timeout := 5 * time.Second
+		if nil != c.GetKafka().GetTimeout() {
+			timeout = c.GetKafka().GetTimeout().AsDuration()
+		}
+		res = append(res, &kafkaRestSink{
+			url:    strings.TrimRight(c.GetKafka().GetRestUrl(), "/") + "/topics/" + c.GetKafka().GetTopic(),
+			client: &http.Client{Timeout: timeout},
+		})
+	}
+	return res
+}
+
+func (oe *OutboxEvent) toBiz() *biz.OutboxEvent {
+	return &biz.OutboxEvent{
+		ID:        oe.ID,
+		Type:      oe.Type,
+		UserId:    oe.UserId,
+		Payload:   oe.Payload,
+		CreatedAt: oe.CreatedAt,
+	}
+}
+
+// CreateOutboxEvent 使用事务连接，和业务数据一起提交 .
+func (o *OutboxRepo) CreateOutboxEvent(ctx context.Context, e *biz.OutboxEvent) (*biz.OutboxEvent, error) {
+	var event OutboxEvent
+	event.Type = e.Type
+	event.UserId = e.UserId
+	event.Payload = e.Payload
+
+	if res := o.data.DB(ctx).Table("outbox_event").Create(&event); res.Error != nil {
+		return nil, errors.New(500, "CREATE_OUTBOX_EVENT_ERROR", "事件创建失败")
+	}
+
+	return event.toBiz(), nil
+}
+
+// GetOutboxEventsAfter id 大于 afterId .
+func (o *OutboxRepo) GetOutboxEventsAfter(ctx context.Context, afterId int64, limit int) ([]*biz.OutboxEvent, error) {
+	var events []*OutboxEvent
+	if err := o.data.db.Table("outbox_event").
+		Where("id>?", afterId).
+		Order("id asc").
+		Limit(limit).
+		Find(&events).Error; err != nil {
+		return nil, errors.New(500, "OUTBOX EVENT ERROR", err.Error())
+	}
+
+	res := make([]*biz.OutboxEvent, 0)
+	for _, v := range events {
+		res = append(res, v.toBiz())
+	}
+
+	return res, nil
+}
+
+// GetOutboxEventsByIds .
+func (o *OutboxRepo) GetOutboxEventsByIds(ctx context.Context, ids ...int64) (map[int64]*biz.OutboxEvent, error) {
+	res := make(map[int64]*biz.OutboxEvent, 0)
+	if 0 >= len(ids) {
+		return res, nil
+	}
+
+	var events []*OutboxEvent
+	if err := o.data.db.Table("outbox_event").Where("id in (?)", ids).Find(&events).Error; err != nil {
+		return nil, errors.New(500, "OUTBOX EVENT ERROR", err.Error())
+	}
+
+	for _, v := range events {
+		res[v.ID] = v.toBiz()
+	}
+
+	return res, nil
+}
+
+// GetOutboxEventsByUserId 用户在 (afterId, maxId] 之间的事件 .
+func (o *OutboxRepo) GetOutboxEventsByUserId(ctx context.Context, userId int64, afterId int64, maxId int64, limit int) ([]*biz.OutboxEvent, error) {
+	var events []*OutboxEvent
+	if err := o.data.db.Table("outbox_event").
+		Where("user_id=?", userId).
+		Where("id>? and id<=?", afterId, maxId).
+		Order("id asc").
+		Limit(limit).
+		Find(&events).Error; err != nil {
+		return nil, errors.New(500, "OUTBOX EVENT ERROR", err.Error())
+	}
+
+	res := make([]*biz.OutboxEvent, 0)
+	for _, v := range events {
+		res = append(res, v.toBiz())
+	}
+
+	return res, nil
+}
+
+// GetOutboxLastId 没有事件时返回 0 .
+func (o *OutboxRepo) GetOutboxLastId(ctx context.Context) (int64, error) {
+	var lastId int64
+	if err := o.data.db.Table("outbox_event").Select("coalesce(max(id), 0)").Scan(&lastId).Error; err != nil {
+		return 0, errors.New(500, "OUTBOX EVENT ERROR", err.Error())
+	}
+
+	return lastId, nil
+}
+
+// GetEventOffset 没有记录时从头开始 .
+func (o *OutboxRepo) GetEventOffset(ctx context.Context, consumer string) (*biz.OutboxCursor, error) {
+	var offset EventOffset
+	if err := o.data.db.Table("event_offset").Where("consumer=?", consumer).First(&offset).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return biz.NewOutboxCursor(0), nil
+		}
+
+		return nil, errors.New(500, "EVENT OFFSET ERROR", err.Error())
+	}
+
+	res := biz.NewOutboxCursor(offset.Offset)
+	if offset.MaxId > res.MaxId {
+		res.MaxId = offset.MaxId
+	}
+	if "" != offset.Gaps {
+		gaps := make(map[int64]int64, 0)
+		if err := json.Unmarshal([]byte(offset.Gaps), &gaps); nil != err {
+			return nil, errors.New(500, "EVENT OFFSET ERROR", err.Error())
+		}
+		for id, at := range gaps {
+			res.Gaps[id] = time.Unix(at, 0)
+		}
+	}
+
+	return res, nil
+}
+
+// SaveEventOffset .
+func (o *OutboxRepo) SaveEventOffset(ctx context.Context, consumer string, cursor *biz.OutboxCursor) error {
+	gaps := make(map[int64]int64, len(cursor.Gaps))
+	for id, at := range cursor.Gaps {
+		gaps[id] = at.Unix()
+	}
+	data, err := json.Marshal(gaps)
+	if nil != err {
+		return err
+	}
+
+	if err = o.data.DB(ctx).Table("event_offset").Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "consumer"}},
+		DoUpdates: clause.AssignmentColumns([]string{"offset", "max_id", "gaps", "updated_at"}),
+	}).Create(&EventOffset{Consumer: consumer, Offset: cursor.Offset, MaxId: cursor.MaxId, Gaps: string(data)}).Error; err != nil {
+		return errors.New(500, "EVENT OFFSET ERROR", err.Error())
+	}
+
+	return nil
+}
+
+// redisStreamSink 写入 Redis Stream，消费方按 id 字段去重
+type redisStreamSink struct {
+	rdb    *redis.Client
+	stream string
+	maxLen int64
+}
+
+func (s *redisStreamSink) Name() string {
+	return "redis_stream"
+}
+
+func (s *redisStreamSink) Publish(ctx context.Context, events []*biz.OutboxEvent) error {
+	pipe := s.rdb.Pipeline()
+	for _, e := range events {
+		pipe.XAdd(ctx, &redis.XAddArgs{
+			Stream: s.stream,
+			MaxLen: s.maxLen,
+			Approx: 0 < s.maxLen,
+			Values: map[string]interface{}{
+				"id":         e.ID,
+				"type":       e.Type,
+				"user_id":    e.UserId,
+				"payload":    e.Payload,
+				"created_at": e.CreatedAt.UTC().Format(time.RFC3339),
+			},
+		})
+	}
+
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// kafkaRestSink 通过 Kafka REST Proxy v2 写入，兼容 Confluent 和 Redpanda，按用户分区保证顺序
+type kafkaRestSink struct {
+	url    string
+	client *http.Client
+}
+
+func (s *kafkaRestSink) Name() string {
+	return "kafka"
+}
+
+func (s *kafkaRestSink) Publish(ctx context.Context, events []*biz.OutboxEvent) error {
+	type record struct {
+		Key   string      `json:"key"`
+		Value interface{} `json:"value"`
+	}
+	records := make([]*record, 0, len(events))
+	for _, e := range events {
+		records = append(records, &record{
+			Key: strconv.FormatInt(e.UserId, 10),
+			Value: map[string]interface{}{
+				"id":         e.ID,
+				"type":       e.Type,
+				"userId":     e.UserId,
+				"data":       json.RawMessage(e.Payload),
+				"created_at": e.CreatedAt.UTC().Format(time.RFC3339),
+			},
+		})
+	}
+
+	body, err := json.Marshal(map[string]interface{}{"records": records})
+	if nil != err {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if nil != err {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/vnd.kafka.json.v2+json")
+	req.Header.Set("Accept", "application/vnd.kafka.v2+json")
+
+	resp, err := s.client.Do(req)
+	if nil != err {
+		return err
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if nil != err {
+		return err
+	}
+	if 200 > resp.StatusCode || 300 <= resp.StatusCode {
+		return fmt.Errorf("kafka rest: %s %s", resp.Status, data)
+	}
+
+	// 部分写入失败时整批重试
+	var result struct {
+		Offsets []struct {
+			ErrorCode *int   `json:"error_code"`
+			Error     string `json:"error"`
+		} `json:"offsets"`
+	}
+	if err = json.Unmarshal(data, &result); nil != err {
+		return err
+	}
+	for _, v := range result.Offsets {
+		if nil != v.ErrorCode {
+			return fmt.Errorf("kafka rest: %d %s", *v.ErrorCode, v.Error)
+		}
+	}
+
+	return nil
+}
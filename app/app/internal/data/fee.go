@@ -0,0 +1,73 @@
+package data
+
+import (
+	"context"
+	"dhb/app/app/internal/biz"
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// 平台手续费和销毁账户
+const (
+	systemFeeUserId  = 999999999
+	systemBurnUserId = 999999998
+)
+
+// RecordFee 事务中使用，手续费扣除已发放的分佣后记到平台手续费账户，销毁记到销毁账户，
+// 都写余额流水，reward 关联流水，source_user_id 为付费用户 .
+func (ub *UserBalanceRepo) RecordFee(ctx context.Context, userId int64, recordId int64, q *biz.FeeQuote, commission int64) error {
+	fee := q.Fee - commission
+	if 0 > fee {
+		fee = 0 // 分佣超过手续费的部分由平台承担，不从手续费账户扣
+	}
+
+	lines := []struct {
+		userId int64
+		amount int64
+		reason string
+	}{
+		{systemFeeUserId, fee, "system_fee"},
+		{systemBurnUserId, q.Burn, "system_burn"},
+	}
+
+	for _, v := range lines {
+		if 0 >= v.amount {
+			continue
+		}
+
+		balanceRecordId, err := ub.creditSystemAccount(ctx, v.userId, q.Coin, v.amount, v.reason)
+		if nil != err {
+			return err
+		}
+
+		var reward Reward
+		reward.UserId = v.userId
+		reward.Amount = v.amount
+		reward.AmountB = q.Amount
+		reward.BalanceRecordId = balanceRecordId
+		reward.Type = q.Action // 本次分红的行为类型
+		reward.TypeRecordId = recordId
+		reward.Reason = v.reason // 给我分红的理由
+		reward.SourceUserId = userId
+		reward.LocationType = q.Coin
+		if err = ub.data.DB(ctx).Table("reward").Create(&reward).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// creditSystemAccount 事务中使用，系统账户只追加流水不更新余额行，避免所有资金操作在同一行上排队，
+// 系统账户余额为流水合计，流水的 balance 不记录，返回流水id .
+func (ub *UserBalanceRepo) creditSystemAccount(ctx context.Context, userId int64, coinType string, amount int64, recordType string) (int64, error) {
+	var userBalanceRecode UserBalanceRecord
+	userBalanceRecode.UserId = userId
+	userBalanceRecode.Type = recordType
+	userBalanceRecode.CoinType = coinType
+	userBalanceRecode.Amount = amount
+	if err := ub.data.DB(ctx).Table("user_balance_record").Create(&userBalanceRecode).Error; nil != err {
+		return 0, errors.New(500, "USER BALANCE RECORD ERROR", err.Error())
+	}
+
+	return userBalanceRecode.ID, nil
+}
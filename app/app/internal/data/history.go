@@ -0,0 +1,199 @@
+package data
+
+import (
+	"context"
+	"dhb/app/app/internal/biz"
+	"github.com/go-kratos/kratos/v2/errors"
+	"gorm.io/gorm"
+)
+
+type HistoryTotal struct {
+	Count  int64
+	Amount int64
+}
+
+// historyScope 通用条件：用户、类型、理由、状态、时间范围
+func historyScope(f *biz.HistoryFilter, withReason bool, withStatus bool) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		db = db.Where("user_id=?", f.UserId)
+		if 0 < len(f.Types) {
+			db = db.Where("type IN (?)", f.Types)
+		}
+		if withReason && 0 < len(f.Reasons) {
+			db = db.Where("reason IN (?)", f.Reasons)
+		}
+		if withStatus && "" != f.Status {
+			db = db.Where("status=?", f.Status)
+		}
+		if !f.Start.IsZero() {
+			db = db.Where("created_at>=?", f.Start)
+		}
+		if !f.End.IsZero() {
+			db = db.Where("created_at<?", f.End)
+		}
+		return db
+	}
+}
+
+// historyCursor 按 id 游标翻页，多取一条判断是否有下一页
+func historyCursor(f *biz.HistoryFilter) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if f.Asc {
+			if 0 < f.Cursor {
+				db = db.Where("id>?", f.Cursor)
+			}
+			return db.Order("id asc").Limit(int(f.PageSize + 1))
+		}
+
+		if 0 < f.Cursor {
+			db = db.Where("id<?", f.Cursor)
+		}
+		return db.Order("id desc").Limit(int(f.PageSize + 1))
+	}
+}
+
+// historyTotal 合计，amountColumn 为金额字段
+func historyTotal(instance *gorm.DB, amountColumn string) (*biz.HistoryTotal, error) {
+	var total HistoryTotal
+	if err := instance.Select("count(*) as count, coalesce(sum(" + amountColumn + "),0) as amount").Take(&total).Error; err != nil {
+		return nil, err
+	}
+
+	return &biz.HistoryTotal{Count: total.Count, Amount: total.Amount}, nil
+}
+
+// GetUserRewardHistory .
+func (ub *UserBalanceRepo) GetUserRewardHistory(ctx context.Context, f *biz.HistoryFilter) ([]*biz.Reward, error, *biz.HistoryTotal) {
+	var rewards []*Reward
+	instance := ub.data.db.Table("reward").Scopes(historyScope(f, true, false))
+
+	total, err := historyTotal(instance.Session(&gorm.Session{}), "amount")
+	if nil != err {
+		return nil, errors.New(500, "REWARD ERROR", err.Error()), nil
+	}
+
+	if err = instance.Session(&gorm.Session{}).Scopes(historyCursor(f)).Find(&rewards).Error; err != nil {
+		return nil, errors.New(500, "REWARD ERROR", err.Error()), nil
+	}
+
+	res := make([]*biz.Reward, 0)
+	for _, reward := range rewards {
+		res = append(res, &biz.Reward{
+			ID:               reward.ID,
+			UserId:           reward.UserId,
+			Amount:           reward.Amount,
+			AmountB:          reward.AmountB,
+			BalanceRecordId:  reward.BalanceRecordId,
+			Type:             reward.Type,
+			TypeRecordId:     reward.TypeRecordId,
+			Reason:           reward.Reason,
+			ReasonLocationId: reward.ReasonLocationId,
+			SourceUserId:     reward.SourceUserId,
+			LocationType:     reward.LocationType,
+			CreatedAt:        reward.CreatedAt,
+		})
+	}
+
+	return res, nil, total
+}
+
+// GetWithdrawHistory 币种在 type 字段 .
+func (ub *UserBalanceRepo) GetWithdrawHistory(ctx context.Context, f *biz.HistoryFilter) ([]*biz.Withdraw, error, *biz.HistoryTotal) {
+	var withdraws []*Withdraw
+	instance := ub.data.db.Table("withdraw").Scopes(historyScope(f, false, true))
+	if "" != f.Coin {
+		instance = instance.Where("type=?", f.Coin)
+	}
+
+	total, err := historyTotal(instance.Session(&gorm.Session{}), "amount")
+	if nil != err {
+		return nil, errors.New(500, "WITHDRAW ERROR", err.Error()), nil
+	}
+
+	if err = instance.Session(&gorm.Session{}).Scopes(historyCursor(f)).Find(&withdraws).Error; err != nil {
+		return nil, errors.New(500, "WITHDRAW ERROR", err.Error()), nil
+	}
+
+	res := make([]*biz.Withdraw, 0)
+	for _, withdraw := range withdraws {
+		res = append(res, &biz.Withdraw{
+			ID:              withdraw.ID,
+			UserId:          withdraw.UserId,
+			Amount:          withdraw.Amount,
+			RelAmount:       withdraw.RelAmount,
+			Fee:             withdraw.Fee,
+			Burn:            withdraw.Burn,
+			Address:         withdraw.Address,
+			BalanceRecordId: withdraw.BalanceRecordId,
+			Status:          withdraw.Status,
+			Type:            withdraw.Type,
+			CreatedAt:       withdraw.CreatedAt,
+		})
+	}
+
+	return res, nil, total
+}
+
+// GetUserBalanceRecordHistory .
+func (ub *UserBalanceRepo) GetUserBalanceRecordHistory(ctx context.Context, f *biz.HistoryFilter) ([]*biz.UserBalanceRecord, error, *biz.HistoryTotal) {
+	var records []*UserBalanceRecord
+	instance := ub.data.db.Table("user_balance_record").Scopes(historyScope(f, false, false))
+	if "" != f.Coin {
+		instance = instance.Where("coin_type=?", f.Coin)
+	}
+
+	total, err := historyTotal(instance.Session(&gorm.Session{}), "amount")
+	if nil != err {
+		return nil, errors.New(500, "USER_BALANCE_RECORD ERROR", err.Error()), nil
+	}
+
+	if err = instance.Session(&gorm.Session{}).Scopes(historyCursor(f)).Find(&records).Error; err != nil {
+		return nil, errors.New(500, "USER_BALANCE_RECORD ERROR", err.Error()), nil
+	}
+
+	res := make([]*biz.UserBalanceRecord, 0)
+	for _, v := range records {
+		res = append(res, &biz.UserBalanceRecord{
+			ID:        v.ID,
+			UserId:    v.UserId,
+			Amount:    v.Amount,
+			CoinType:  v.CoinType,
+			Balance:   v.Balance,
+			Type:      v.Type,
+			CreatedAt: v.CreatedAt,
+		})
+	}
+
+	return res, nil, total
+}
+
+// GetTradeHistory .
+func (ub *UserBalanceRepo) GetTradeHistory(ctx context.Context, f *biz.HistoryFilter) ([]*biz.Trade, error, *biz.HistoryTotal) {
+	var trades []*Trade
+	instance := ub.data.db.Table("trade").Scopes(historyScope(f, false, true))
+
+	total, err := historyTotal(instance.Session(&gorm.Session{}), "amount_csd")
+	if nil != err {
+		return nil, errors.New(500, "TRADE ERROR", err.Error()), nil
+	}
+
+	if err = instance.Session(&gorm.Session{}).Scopes(historyCursor(f)).Find(&trades).Error; err != nil {
+		return nil, errors.New(500, "TRADE ERROR", err.Error()), nil
+	}
+
+	res := make([]*biz.Trade, 0)
+	for _, v := range trades {
+		res = append(res, &biz.Trade{
+			ID:           v.ID,
+			UserId:       v.UserId,
+			AmountCsd:    v.AmountCsd,
+			RelAmountCsd: v.RelAmountCsd,
+			AmountHbs:    v.AmountHbs,
+			RelAmountHbs: v.RelAmountHbs,
+			Status:       v.Status,
+			CreatedAt:    v.CreatedAt,
+		})
+	}
+
+	return res, nil, total
+}
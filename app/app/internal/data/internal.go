@@ -0,0 +1,134 @@
+package data
+
+import (
+	"context"
+	"dhb/app/app/internal/biz"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"time"
+)
+
+type InternalTransfer struct {
+	ID              int64     `gorm:"primarykey;type:int"`
+	Caller          string    `gorm:"type:varchar(45);not null;uniqueIndex:idx_internal_transfer_caller_reference,priority:1"`
+	ReferenceId     string    `gorm:"type:varchar(64);not null;uniqueIndex:idx_internal_transfer_caller_reference,priority:2"`
+	UserId          int64     `gorm:"type:int;not null;index"`
+	Coin            string    `gorm:"type:varchar(45);not null"`
+	Amount          int64     `gorm:"type:bigint;not null"`
+	Balance         int64     `gorm:"type:bigint;not null"`
+	Reason          string    `gorm:"type:varchar(45);not null"`
+	BalanceRecordId int64     `gorm:"type:int;not null"`
+	CreatedAt       time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt       time.Time `gorm:"type:datetime;not null"`
+}
+
+type InternalTransferRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewInternalTransferRepo(data *Data, logger log.Logger) biz.InternalTransferRepo {
+	return &InternalTransferRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (it *InternalTransfer) toBiz() *biz.InternalTransfer {
+	return &biz.InternalTransfer{
+		ID:              it.ID,
+		Caller:          it.Caller,
+		ReferenceId:     it.ReferenceId,
+		UserId:          it.UserId,
+		Coin:            it.Coin,
+		Amount:          it.Amount,
+		Balance:         it.Balance,
+		Reason:          it.Reason,
+		BalanceRecordId: it.BalanceRecordId,
+		CreatedAt:       it.CreatedAt,
+	}
+}
+
+// CreateInternalTransfer 调用方和流水号重复时返回错误 .
+func (itr *InternalTransferRepo) CreateInternalTransfer(ctx context.Context, t *biz.InternalTransfer) (*biz.InternalTransfer, error) {
+	var internalTransfer InternalTransfer
+	internalTransfer.Caller = t.Caller
+	internalTransfer.ReferenceId = t.ReferenceId
+	internalTransfer.UserId = t.UserId
+	internalTransfer.Coin = t.Coin
+	internalTransfer.Amount = t.Amount
+	internalTransfer.Balance = t.Balance
+	internalTransfer.Reason = t.Reason
+	internalTransfer.BalanceRecordId = t.BalanceRecordId
+
+	res := itr.data.DB(ctx).Table("internal_transfer").Create(&internalTransfer)
+	if res.Error != nil {
+		return nil, errors.New(500, "CREATE_INTERNAL_TRANSFER_ERROR", "内部转账记录创建失败")
+	}
+
+	return internalTransfer.toBiz(), nil
+}
+
+// GetInternalTransferByReference 没有返回 nil .
+func (itr *InternalTransferRepo) GetInternalTransferByReference(ctx context.Context, caller string, referenceId string) (*biz.InternalTransfer, error) {
+	var internalTransfer InternalTransfer
+	if err := itr.data.db.Table("internal_transfer").
+		Where("caller=? and reference_id=?", caller, referenceId).
+		First(&internalTransfer).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, errors.New(500, "INTERNAL TRANSFER ERROR", err.Error())
+	}
+
+	return internalTransfer.toBiz(), nil
+}
+
+// UseNonce .
+func (itr *InternalTransferRepo) UseNonce(ctx context.Context, caller string, nonce string, ttl time.Duration) (bool, error) {
+	return itr.data.rdb.SetNX(ctx, "dhb:internal:nonce:"+caller+":"+nonce, 1, ttl).Result()
+}
+
+// ChangeBalance 事务中使用，amount 为负时扣减，余额不足返回错误，返回流水id和变动后余额 .
+func (ub *UserBalanceRepo) ChangeBalance(ctx context.Context, userId int64, coinType string, amount int64, recordType string) (int64, int64, error) {
+	var column string
+	switch coinType {
+	case "usdt":
+		column = "balance_usdt"
+	case "dhb":
+		column = "balance_dhb"
+	default:
+		return 0, 0, errors.New(500, "USER BALANCE ERROR", "unknown coin type "+coinType)
+	}
+
+	if res := ub.data.DB(ctx).Table("user_balance").
+		Where("user_id=? and "+column+">=?", userId, -amount).
+		Updates(map[string]interface{}{column: gorm.Expr(column+" + ?", amount)}); 0 == res.RowsAffected || nil != res.Error {
+		return 0, 0, errors.NotFound("user balance err", "user balance error")
+	}
+
+	var userBalance UserBalance
+	if err := ub.data.DB(ctx).Where(&UserBalance{UserId: userId}).Table("user_balance").First(&userBalance).Error; err != nil {
+		return 0, 0, err
+	}
+
+	var userBalanceRecode UserBalanceRecord
+	userBalanceRecode.Balance = userBalance.BalanceUsdt
+	if "dhb" == coinType {
+		userBalanceRecode.Balance = userBalance.BalanceDhb
+	}
+	userBalanceRecode.UserId = userBalance.UserId
+	userBalanceRecode.Type = recordType
+	userBalanceRecode.CoinType = coinType
+	userBalanceRecode.Amount = amount // 流水金额为正，方向看类型
+	if 0 > amount {
+		userBalanceRecode.Amount = -amount
+	}
+	if err := ub.data.DB(ctx).Table("user_balance_record").Create(&userBalanceRecode).Error; err != nil {
+		return 0, 0, err
+	}
+
+	return userBalanceRecode.ID, userBalanceRecode.Balance, nil
+}
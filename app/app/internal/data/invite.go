@@ -0,0 +1,129 @@
+package data
+
+import (
+	"context"
+	"dhb/app/app/internal/biz"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"time"
+)
+
+type UserInviteCode struct {
+	ID         int64      `gorm:"primarykey;type:int"`
+	UserId     int64      `gorm:"type:int;not null;index"`
+	Code       string     `gorm:"type:varchar(20);not null;uniqueIndex"`
+	Name       string     `gorm:"type:varchar(45);not null"`
+	Status     string     `gorm:"type:varchar(45);not null"`
+	UsedNum    int64      `gorm:"type:int;not null"`
+	MaxUse     int64      `gorm:"type:int;not null"`
+	ExpiredAt  *time.Time `gorm:"type:datetime"`
+	LastUsedAt *time.Time `gorm:"type:datetime"`
+	CreatedAt  time.Time  `gorm:"type:datetime;not null"`
+	UpdatedAt  time.Time  `gorm:"type:datetime;not null"`
+}
+
+type UserInviteCodeRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewUserInviteCodeRepo(data *Data, logger log.Logger) biz.UserInviteCodeRepo {
+	return &UserInviteCodeRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (uic *UserInviteCode) toBiz() *biz.UserInviteCode {
+	res := &biz.UserInviteCode{
+		ID:        uic.ID,
+		UserId:    uic.UserId,
+		Code:      uic.Code,
+		Name:      uic.Name,
+		Status:    uic.Status,
+		UsedNum:   uic.UsedNum,
+		MaxUse:    uic.MaxUse,
+		CreatedAt: uic.CreatedAt,
+	}
+	if nil != uic.ExpiredAt {
+		res.ExpiredAt = *uic.ExpiredAt
+	}
+	if nil != uic.LastUsedAt {
+		res.LastUsedAt = *uic.LastUsedAt
+	}
+
+	return res
+}
+
+// CreateUserInviteCode .
+func (uicr *UserInviteCodeRepo) CreateUserInviteCode(ctx context.Context, ic *biz.UserInviteCode) (*biz.UserInviteCode, error) {
+	var userInviteCode UserInviteCode
+	userInviteCode.UserId = ic.UserId
+	userInviteCode.Code = ic.Code
+	userInviteCode.Name = ic.Name
+	userInviteCode.Status = ic.Status
+	userInviteCode.MaxUse = ic.MaxUse
+	if !ic.ExpiredAt.IsZero() {
+		userInviteCode.ExpiredAt = &ic.ExpiredAt
+	}
+
+	res := uicr.data.DB(ctx).Table("user_invite_code").Create(&userInviteCode)
+	if res.Error != nil {
+		return nil, errors.New(500, "CREATE_USER_INVITE_CODE_ERROR", "推荐码创建失败")
+	}
+
+	return userInviteCode.toBiz(), nil
+}
+
+// GetUserInviteCodeByCode .
+func (uicr *UserInviteCodeRepo) GetUserInviteCodeByCode(ctx context.Context, code string) (*biz.UserInviteCode, error) {
+	var userInviteCode UserInviteCode
+	if err := uicr.data.DB(ctx).Where("code=?", code).Table("user_invite_code").First(&userInviteCode).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.NotFound("USER_INVITE_CODE_NOT_FOUND", "user invite code not found")
+		}
+
+		return nil, errors.New(500, "USER INVITE CODE ERROR", err.Error())
+	}
+
+	return userInviteCode.toBiz(), nil
+}
+
+// GetUserInviteCodesByUserId .
+func (uicr *UserInviteCodeRepo) GetUserInviteCodesByUserId(ctx context.Context, userId int64) ([]*biz.UserInviteCode, error) {
+	var userInviteCodes []*UserInviteCode
+	res := make([]*biz.UserInviteCode, 0)
+	if err := uicr.data.DB(ctx).Where("user_id=?", userId).Table("user_invite_code").Order("id asc").Find(&userInviteCodes).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return res, nil
+		}
+
+		return nil, errors.New(500, "USER INVITE CODE ERROR", err.Error())
+	}
+
+	for _, userInviteCode := range userInviteCodes {
+		res = append(res, userInviteCode.toBiz())
+	}
+
+	return res, nil
+}
+
+// UseUserInviteCode 事务中使用，使用次数达到上限或过期时失败 .
+func (uicr *UserInviteCodeRepo) UseUserInviteCode(ctx context.Context, id int64) error {
+	now := time.Now().UTC()
+	res := uicr.data.DB(ctx).Table("user_invite_code").
+		Where("id=?", id).
+		Where("status=?", "enable").
+		Where("max_use=0 or used_num<max_use").
+		Where("expired_at is null or expired_at>?", now).
+		Updates(map[string]interface{}{"used_num": gorm.Expr("used_num + ?", 1), "last_used_at": now})
+	if nil != res.Error {
+		return errors.New(500, "USER INVITE CODE ERROR", res.Error.Error())
+	}
+	if 0 == res.RowsAffected {
+		return errors.New(500, "USER_ERROR", "推荐码已失效")
+	}
+
+	return nil
+}
@@ -0,0 +1,223 @@
+package data
+
+import (
+	"context"
+	"dhb/app/app/internal/biz"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"time"
+)
+
+type JobLease struct {
+	ID        int64     `gorm:"primarykey;type:int"`
+	Name      string    `gorm:"type:varchar(45);not null;uniqueIndex"`
+	Owner     string    `gorm:"type:varchar(100);not null"`
+	ExpiredAt time.Time `gorm:"type:datetime;not null"`
+	CreatedAt time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt time.Time `gorm:"type:datetime;not null"`
+}
+
+// JobRun 每日任务按 (name, business_date) 唯一，其他任务 business_date 为 NULL 不受限制
+type JobRun struct {
+	ID           int64      `gorm:"primarykey;type:int"`
+	Name         string     `gorm:"type:varchar(45);not null;uniqueIndex:idx_job_run_name_date,priority:1"`
+	BusinessDate *string    `gorm:"type:varchar(10);uniqueIndex:idx_job_run_name_date,priority:2"`
+	Owner        string     `gorm:"type:varchar(100);not null"`
+	Status       string     `gorm:"type:varchar(45);not null"`
+	Message      string     `gorm:"type:varchar(1000);not null"`
+	StartAt      time.Time  `gorm:"type:datetime;not null"`
+	EndAt        *time.Time `gorm:"type:datetime"`
+	CreatedAt    time.Time  `gorm:"type:datetime;not null"`
+	UpdatedAt    time.Time  `gorm:"type:datetime;not null"`
+}
+
+type JobRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewJobRepo(data *Data, logger log.Logger) biz.JobRepo {
+	return &JobRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (jr *JobRun) toBiz() *biz.JobRun {
+	res := &biz.JobRun{
+		ID:        jr.ID,
+		Name:      jr.Name,
+		Owner:     jr.Owner,
+		Status:    jr.Status,
+		Message:   jr.Message,
+		StartAt:   jr.StartAt,
+		CreatedAt: jr.CreatedAt,
+	}
+	if nil != jr.BusinessDate {
+		res.BusinessDate = *jr.BusinessDate
+	}
+	if nil != jr.EndAt {
+		res.EndAt = *jr.EndAt
+	}
+
+	return res
+}
+
+// AcquireJobLease 租约过期或本来就是自己持有时获得，否则返回 false .
+func (j *JobRepo) AcquireJobLease(ctx context.Context, name string, owner string, ttl time.Duration) (bool, error) {
+	now := time.Now().UTC()
+	res := j.data.db.WithContext(ctx).Table("job_lease").
+		Where("name=?", name).
+		Where("owner=? or expired_at<?", owner, now).
+		Updates(map[string]interface{}{"owner": owner, "expired_at": now.Add(ttl)})
+	if nil != res.Error {
+		return false, errors.New(500, "JOB LEASE ERROR", res.Error.Error())
+	}
+	if 0 < res.RowsAffected {
+		return true, nil
+	}
+
+	var count int64
+	if err := j.data.db.WithContext(ctx).Table("job_lease").Where("name=?", name).Count(&count).Error; nil != err {
+		return false, errors.New(500, "JOB LEASE ERROR", err.Error())
+	}
+	if 0 < count {
+		return false, nil
+	}
+
+	// 第一次竞选，唯一索引保证只有一个节点创建成功
+	lease := &JobLease{Name: name, Owner: owner, ExpiredAt: now.Add(ttl)}
+	if err := j.data.db.WithContext(ctx).Table("job_lease").Create(lease).Error; nil != err {
+		return false, nil
+	}
+
+	return true, nil
+}
+
+// ReleaseJobLease .
+func (j *JobRepo) ReleaseJobLease(ctx context.Context, name string, owner string) error {
+	if err := j.data.db.Table("job_lease").
+		Where("name=?", name).
+		Where("owner=?", owner).
+		Updates(map[string]interface{}{"expired_at": time.Now().UTC()}).Error; nil != err {
+		return errors.New(500, "JOB LEASE ERROR", err.Error())
+	}
+
+	return nil
+}
+
+// CreateJobRun .
+func (j *JobRepo) CreateJobRun(ctx context.Context, r *biz.JobRun) (*biz.JobRun, error) {
+	var jobRun JobRun
+	jobRun.Name = r.Name
+	jobRun.Owner = r.Owner
+	jobRun.Status = r.Status
+	jobRun.StartAt = r.StartAt
+	if "" != r.BusinessDate {
+		jobRun.BusinessDate = &r.BusinessDate
+	}
+
+	if res := j.data.DB(ctx).Table("job_run").Create(&jobRun); res.Error == nil {
+		return jobRun.toBiz(), nil
+	}
+	if nil == jobRun.BusinessDate {
+		return nil, errors.New(500, "CREATE_JOB_RUN_ERROR", "任务记录创建失败")
+	}
+
+	// 已有记录，只有失败的可以重新执行
+	res := j.data.DB(ctx).Table("job_run").
+		Where("name=?", r.Name).
+		Where("business_date=?", r.BusinessDate).
+		Where("status=?", "fail").
+		Updates(map[string]interface{}{"owner": r.Owner, "status": r.Status, "message": "", "start_at": r.StartAt, "end_at": nil})
+	if nil != res.Error {
+		return nil, errors.New(500, "CREATE_JOB_RUN_ERROR", "任务记录创建失败")
+	}
+	if 0 == res.RowsAffected {
+		return nil, errors.New(409, "JOB_RUN_CONFLICT", "任务正在执行或已完成")
+	}
+
+	if err := j.data.DB(ctx).Table("job_run").
+		Where("name=?", r.Name).
+		Where("business_date=?", r.BusinessDate).
+		First(&jobRun).Error; nil != err {
+		return nil, errors.New(500, "JOB RUN ERROR", err.Error())
+	}
+
+	return jobRun.toBiz(), nil
+}
+
+// FailRunningJobRuns 其他节点执行中的记录置为失败 .
+func (j *JobRepo) FailRunningJobRuns(ctx context.Context, owner string, message string) error {
+	if res := j.data.DB(ctx).Table("job_run").
+		Where("status=?", "running").
+		Where("owner<>?", owner).
+		Updates(map[string]interface{}{"status": "fail", "message": message, "end_at": time.Now().UTC()}); res.Error != nil {
+		return errors.New(500, "UPDATE_JOB_RUN_ERROR", "任务记录修改失败")
+	}
+
+	return nil
+}
+
+// UpdateJobRun .
+func (j *JobRepo) UpdateJobRun(ctx context.Context, id int64, status string, message string, endAt time.Time) error {
+	if 1000 < len(message) {
+		message = message[:1000]
+	}
+
+	if res := j.data.DB(ctx).Table("job_run").
+		Where("id=?", id).
+		Updates(map[string]interface{}{"status": status, "message": message, "end_at": endAt}); res.Error != nil {
+		return errors.New(500, "UPDATE_JOB_RUN_ERROR", "任务记录修改失败")
+	}
+
+	return nil
+}
+
+// GetLastSuccessJobRun 没有记录时返回 nil .
+func (j *JobRepo) GetLastSuccessJobRun(ctx context.Context, name string) (*biz.JobRun, error) {
+	var jobRun JobRun
+	if err := j.data.db.Table("job_run").
+		Where("name=?", name).
+		Where("status=?", "success").
+		Order("business_date desc").Order("id desc").
+		First(&jobRun).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, errors.New(500, "JOB RUN ERROR", err.Error())
+	}
+
+	return jobRun.toBiz(), nil
+}
+
+// GetJobRuns .
+func (j *JobRepo) GetJobRuns(ctx context.Context, name string, b *biz.Pagination) ([]*biz.JobRun, error, int64) {
+	var (
+		jobRuns []*JobRun
+		count   int64
+	)
+	res := make([]*biz.JobRun, 0)
+
+	instance := j.data.db.Table("job_run")
+	if "" != name {
+		instance = instance.Where("name=?", name)
+	}
+
+	instance = instance.Count(&count)
+	if err := instance.Scopes(Paginate(b.PageNum, b.PageSize)).Order("id desc").Find(&jobRuns).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return res, nil, 0
+		}
+
+		return nil, errors.New(500, "JOB RUN ERROR", err.Error()), 0
+	}
+
+	for _, v := range jobRuns {
+		res = append(res, v.toBiz())
+	}
+
+	return res, nil, count
+}
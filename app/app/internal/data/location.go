@@ -6,6 +6,7 @@ import (
 	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 	"time"
 )
 
@@ -24,10 +25,20 @@ type Location struct {
 	UpdatedAt    time.Time `gorm:"type:datetime;not null"`
 }
 
+// LocationPackageStock 套餐已售数量，购买时在事务中原子累加
+type LocationPackageStock struct {
+	ID        int64     `gorm:"primarykey;type:int"`
+	Num       int64     `gorm:"type:int;not null;uniqueIndex"`
+	Sold      int64     `gorm:"type:int;not null"`
+	CreatedAt time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt time.Time `gorm:"type:datetime;not null"`
+}
+
 type LocationNew struct {
 	ID                int64     `gorm:"primarykey;type:int"`
 	UserId            int64     `gorm:"type:int;not null"`
 	Num               int64     `gorm:"type:int;not null"`
+	Top               int64     `gorm:"type:int;not null;index"`
 	Status            string    `gorm:"type:varchar(45);not null"`
 	Current           int64     `gorm:"type:bigint;not null"`
 	CurrentMax        int64     `gorm:"type:bigint;not null"`
@@ -43,6 +54,9 @@ type LocationNew struct {
 	TotalTwo          int64     `gorm:"type:int"`
 	TotalThree        int64     `gorm:"type:int"`
 	LastLevel         int64     `gorm:"type:bigint;not null"`
+	Row               int64     `gorm:"type:int;not null;default:0;index:idx_location_new_row_col,priority:1;index:idx_location_new_col_row,priority:2"`
+	Col               int64     `gorm:"type:int;not null;default:0;index:idx_location_new_row_col,priority:2;index:idx_location_new_col_row,priority:1"`
+	StopIsUpdate      int64     `gorm:"type:int;not null;default:0"` // 出局后已让出排位
 }
 
 type GlobalLock struct {
@@ -251,6 +265,77 @@ func (lr *LocationRepo) GetLocationsByTop(ctx context.Context, top int64) ([]*bi
 	return res, nil
 }
 
+// GetLocationsByTopPage 按 id 游标分页 .
+func (lr *LocationRepo) GetLocationsByTopPage(ctx context.Context, top int64, cursor int64, limit int64) ([]*biz.LocationNew, error) {
+	var locations []*LocationNew
+	if err := lr.data.db.Table("location_new").
+		Where("top=?", top).
+		Where("id>?", cursor).
+		Order("id asc").Limit(int(limit)).Find(&locations).Error; err != nil {
+		return nil, errors.New(500, "LOCATION ERROR", err.Error())
+	}
+
+	res := make([]*biz.LocationNew, 0)
+	for _, location := range locations {
+		res = append(res, location.toBiz())
+	}
+
+	return res, nil
+}
+
+// GetLocationSubtree tops 以下 depth 层的全部仓位，一次递归查询 .
+func (lr *LocationRepo) GetLocationSubtree(ctx context.Context, tops []int64, depth int64) ([]*biz.LocationNew, error) {
+	res := make([]*biz.LocationNew, 0)
+	if 0 >= len(tops) || 0 >= depth {
+		return res, nil
+	}
+
+	var locations []*LocationNew
+	if err := lr.data.db.Raw(`WITH RECURSIVE tree AS (
+			SELECT id, 1 AS depth FROM location_new WHERE top IN (?)
+			UNION ALL
+			SELECT l.id, t.depth + 1 FROM location_new l JOIN tree t ON l.top = t.id WHERE t.depth < ?
+		)
+		SELECT location_new.* FROM location_new JOIN tree ON location_new.id = tree.id ORDER BY location_new.id ASC`, tops, depth).
+		Scan(&locations).Error; err != nil {
+		return nil, errors.New(500, "LOCATION ERROR", err.Error())
+	}
+
+	for _, location := range locations {
+		res = append(res, location.toBiz())
+	}
+
+	return res, nil
+}
+
+// GetTeamVolumes 每个用户全部推荐下级的仓位金额合计，不含自己，一次按路径前缀查询 .
+func (lr *LocationRepo) GetTeamVolumes(ctx context.Context, userIds []int64) (map[int64]int64, error) {
+	res := make(map[int64]int64, 0)
+	if 0 >= len(userIds) {
+		return res, nil
+	}
+
+	var volumes []*struct {
+		UserId int64
+		Volume int64
+	}
+	if err := lr.data.db.Raw(`SELECT a.user_id AS user_id, COALESCE(SUM(l.usdt), 0) AS volume
+		FROM user_recommend a
+		JOIN user_recommend d ON d.recommend_code = CONCAT(a.recommend_code, 'D', a.user_id) OR d.recommend_code LIKE CONCAT(a.recommend_code, 'D', a.user_id, 'D%')
+		JOIN location_new l ON l.user_id = d.user_id
+		WHERE a.user_id IN (?)
+		GROUP BY a.user_id`, userIds).
+		Scan(&volumes).Error; err != nil {
+		return nil, errors.New(500, "LOCATION ERROR", err.Error())
+	}
+
+	for _, v := range volumes {
+		res[v.UserId] = v.Volume
+	}
+
+	return res, nil
+}
+
 // GetLocationsByUserId2 .
 func (lr *LocationRepo) GetLocationsByUserId2(ctx context.Context, userId int64) ([]*biz.LocationNew, error) {
 	var locations []*LocationNew
@@ -296,18 +381,32 @@ func (lr *LocationRepo) GetAllLocationsCount(ctx context.Context, usdt int64) in
 	return count
 }
 
+// ReserveLocationStock 事务中使用，已售数量小于 stock 时占用一个并返回 true，
+// 第一次使用时以 current 为初始值，事务回滚时一起回滚
+func (lr *LocationRepo) ReserveLocationStock(ctx context.Context, num int64, stock int64, current int64) (bool, error) {
+	if err := lr.data.db.Table("location_package_stock").Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&LocationPackageStock{Num: num, Sold: current}).Error; nil != err {
+		return false, errors.New(500, "LOCATION STOCK ERROR", err.Error())
+	}
+
+	res := lr.data.DB(ctx).Table("location_package_stock").
+		Where("num=? and sold<?", num, stock).
+		Updates(map[string]interface{}{"sold": gorm.Expr("sold+1"), "updated_at": time.Now()})
+	if nil != res.Error {
+		return false, errors.New(500, "LOCATION STOCK ERROR", res.Error.Error())
+	}
+
+	return 1 == res.RowsAffected, nil
+}
+
 // GetLocationDailyYesterday .
 func (lr *LocationRepo) GetLocationDailyYesterday(ctx context.Context, day int) ([]*biz.LocationNew, error) {
 	var locations []*LocationNew
 	res := make([]*biz.LocationNew, 0)
 	instance := lr.data.db.Table("location_new")
 
-	// 16点之后执行
-	now := time.Now().UTC().AddDate(0, 0, day)
-	startDate := now
-	endDate := now.AddDate(0, 0, 1)
-	todayStart := time.Date(startDate.Year(), startDate.Month(), startDate.Day(), 16, 0, 0, 0, time.UTC)
-	todayEnd := time.Date(endDate.Year(), endDate.Month(), endDate.Day(), 16, 0, 0, 0, time.UTC)
+	// day 相对今天的业务日，-1 为昨天
+	todayStart, todayEnd := lr.data.day.Offset(time.Now(), day)
 
 	instance = instance.Where("created_at>=?", todayStart)
 	instance = instance.Where("created_at<?", todayEnd)
@@ -333,6 +432,58 @@ func (lr *LocationRepo) GetLocationDailyYesterday(ctx context.Context, day int)
 	return res, nil
 }
 
+// GetLocationUsdtTotalGroupByUserId 每个用户的个人业绩 .
+func (lr *LocationRepo) GetLocationUsdtTotalGroupByUserId(ctx context.Context) (map[int64]int64, error) {
+	var total []*UserSortRecommendReward
+	res := make(map[int64]int64, 0)
+	if err := lr.data.db.Table("location_new").
+		Group("user_id").
+		Select("sum(usdt) as total, user_id").
+		Find(&total).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return res, nil
+		}
+
+		return nil, errors.New(500, "LOCATION ERROR", err.Error())
+	}
+
+	for _, v := range total {
+		res[v.UserId] = v.Total
+	}
+
+	return res, nil
+}
+
+// GetLocationsByCreatedAt .
+func (lr *LocationRepo) GetLocationsByCreatedAt(ctx context.Context, start time.Time, end time.Time) ([]*biz.LocationNew, error) {
+	var locations []*LocationNew
+	res := make([]*biz.LocationNew, 0)
+	if err := lr.data.db.Table("location_new").
+		Where("created_at>=?", start).
+		Where("created_at<?", end).
+		Order("id asc").Find(&locations).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return res, nil
+		}
+
+		return nil, errors.New(500, "LOCATION ERROR", err.Error())
+	}
+
+	for _, v := range locations {
+		res = append(res, &biz.LocationNew{
+			ID:         v.ID,
+			UserId:     v.UserId,
+			Status:     v.Status,
+			Current:    v.Current,
+			CurrentMax: v.CurrentMax,
+			Usdt:       v.Usdt,
+			CreatedAt:  v.CreatedAt,
+		})
+	}
+
+	return res, nil
+}
+
 // GetLocationsByUserId .
 func (lr *LocationRepo) GetLocationsByUserId(ctx context.Context, userId int64) ([]*biz.LocationNew, error) {
 	var locations []*LocationNew
@@ -491,57 +642,13 @@ func (lr *LocationRepo) UpdateLocation(ctx context.Context, id int64, status str
 	return nil
 }
 
-// UpdateLocationRowAndCol 事务中使用 .
-func (lr *LocationRepo) UpdateLocationRowAndCol(ctx context.Context, id int64) error {
-
-	if res := lr.data.db.Table("location").
-		Where("id>?", id).
-		Where("col > 1").
-		Where("update_status=?", 0).
-		Updates(map[string]interface{}{"col": gorm.Expr("col - ?", 1), "update_status": 1}); res.Error != nil {
-		return res.Error
-	}
-
-	if res := lr.data.db.Table("location").
-		Where("id>?", id).
-		Where("col = 1").
-		Where("update_status=?", 0).
-		Updates(map[string]interface{}{"row": gorm.Expr("row - ?", 1), "col": 3, "update_status": 1}); res.Error != nil {
-		return res.Error
-	}
-
-	if res := lr.data.db.Table("location").
-		Where("id>?", id).
-		Updates(map[string]interface{}{"update_status": 0}); res.Error != nil {
-		return res.Error
-	}
-
-	if res := lr.data.db.Table("location").
-		Where("id=?", id).
-		Updates(map[string]interface{}{"stop_is_update": 1}); res.Error != nil {
-		return res.Error
-	}
-	return nil
-}
-
 // GetLocationDaily .
 func (lr *LocationRepo) GetLocationDaily(ctx context.Context) ([]*biz.Location, error) {
 	var locations []*Location
 	res := make([]*biz.Location, 0)
 	instance := lr.data.db.Table("location")
 
-	now := time.Now().UTC()
-	var startDate time.Time
-	var endDate time.Time
-	if 14 <= now.Hour() {
-		startDate = now
-		endDate = now.AddDate(0, 0, 1)
-	} else {
-		startDate = now.AddDate(0, 0, -1)
-		endDate = now
-	}
-	todayStart := time.Date(startDate.Year(), startDate.Month(), startDate.Day(), 14, 0, 0, 0, time.UTC)
-	todayEnd := time.Date(endDate.Year(), endDate.Month(), endDate.Day(), 14, 0, 0, 0, time.UTC)
+	todayStart, todayEnd := lr.data.day.Today(time.Now())
 
 	instance = instance.Where("created_at>=?", todayStart)
 	instance = instance.Where("created_at<?", todayEnd)
@@ -567,41 +674,52 @@ func (lr *LocationRepo) GetLocationDaily(ctx context.Context) ([]*biz.Location,
 	return res, nil
 }
 
-// GetRewardLocationByRowOrCol .
-func (lr *LocationRepo) GetRewardLocationByRowOrCol(ctx context.Context, row int64, col int64, locationRowConfig int64) ([]*biz.Location, error) {
+// GetRewardLocationByRowOrCol 同一行，或同一列上下 locationRowConfig 行内运行中的仓位，分两次查询以分别走 (row,col) 和 (col,row) 索引 .
+func (lr *LocationRepo) GetRewardLocationByRowOrCol(ctx context.Context, row int64, col int64, locationRowConfig int64) ([]*biz.LocationNew, error) {
 	var (
-		rowMin    int64 = 1
-		rowMax    int64
-		locations []*Location
+		rowMin       int64 = 1
+		rowMax       int64
+		rowLocations []*LocationNew
+		colLocations []*LocationNew
 	)
 	if row > locationRowConfig {
 		rowMin = row - locationRowConfig
 	}
 	rowMax = row + locationRowConfig
 
-	if err := lr.data.db.Table("location").
+	if err := lr.data.db.Table("location_new").
+		Where("row=?", row).
 		Where("status=?", "running").
-		Where("row=? or (col=? and row>=? and row<=?)", row, col, rowMin, rowMax).
-		Find(&locations).Error; err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, errors.NotFound("LOCATION_NOT_FOUND", "location not found")
-		}
+		Find(&rowLocations).Error; err != nil {
+		return nil, errors.New(500, "LOCATION ERROR", err.Error())
+	}
 
+	if err := lr.data.db.Table("location_new").
+		Where("col=?", col).
+		Where("row>=? and row<=?", rowMin, rowMax).
+		Where("status=?", "running").
+		Find(&colLocations).Error; err != nil {
 		return nil, errors.New(500, "LOCATION ERROR", err.Error())
 	}
 
-	res := make([]*biz.Location, 0)
-	for _, location := range locations {
-		res = append(res, &biz.Location{
-			ID:           location.ID,
-			UserId:       location.UserId,
-			Status:       location.Status,
-			CurrentLevel: location.CurrentLevel,
-			Current:      location.Current,
-			CurrentMax:   location.CurrentMax,
-			Row:          location.Row,
-			Col:          location.Col,
-			StopDate:     location.StopDate,
+	res := make([]*biz.LocationNew, 0)
+	ids := make(map[int64]bool, 0)
+	for _, location := range append(rowLocations, colLocations...) {
+		if ids[location.ID] {
+			continue
+		}
+		ids[location.ID] = true
+
+		res = append(res, &biz.LocationNew{
+			ID:         location.ID,
+			UserId:     location.UserId,
+			Status:     location.Status,
+			Current:    location.Current,
+			CurrentMax: location.CurrentMax,
+			Usdt:       location.Usdt,
+			Row:        location.Row,
+			Col:        location.Col,
+			StopDate:   location.StopDate,
 		})
 	}
 
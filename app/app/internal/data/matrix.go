@@ -0,0 +1,245 @@
+package data
+
+import (
+	"context"
+	"dhb/app/app/internal/biz"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+// LocationMatrix 只有 id=1 一行
+type LocationMatrix struct {
+	ID        int64     `gorm:"primarykey;type:int"`
+	Width     int64     `gorm:"type:int;not null"`
+	Mode      string    `gorm:"type:varchar(10);not null;default:'shift'"`
+	NextSlot  int64     `gorm:"type:int;not null"`
+	CreatedAt time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt time.Time `gorm:"type:datetime;not null"`
+}
+
+type LocationMatrixFree struct {
+	ID        int64     `gorm:"primarykey;type:int"`
+	Slot      int64     `gorm:"type:int;not null;uniqueIndex"`
+	CreatedAt time.Time `gorm:"type:datetime;not null"`
+}
+
+type LocationMatrixEvent struct {
+	ID         int64     `gorm:"primarykey;type:int"`
+	LocationId int64     `gorm:"type:int;not null;index"`
+	Action     string    `gorm:"type:varchar(45);not null"`
+	Slot       int64     `gorm:"type:int;not null"`
+	Row        int64     `gorm:"type:int;not null"`
+	Col        int64     `gorm:"type:int;not null"`
+	CreatedAt  time.Time `gorm:"type:datetime;not null"`
+}
+
+type MatrixRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewMatrixRepo(data *Data, logger log.Logger) biz.MatrixRepo {
+	return &MatrixRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// LockLocationMatrix 事务中使用，锁定矩阵，第一次排位时按 width 和 mode 创建，created 为 true 时由调用方在同一事务中初始化 .
+func (mr *MatrixRepo) LockLocationMatrix(ctx context.Context, width int64, mode string) (*biz.LocationMatrix, bool, error) {
+	var matrix LocationMatrix
+	err := mr.data.DB(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).
+		Table("location_matrix").Where("id=?", 1).First(&matrix).Error
+	if nil == err {
+		return &biz.LocationMatrix{Width: matrix.Width, Mode: matrix.Mode, NextSlot: matrix.NextSlot}, false, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, false, errors.New(500, "MATRIX ERROR", err.Error())
+	}
+
+	// 并发创建时主键冲突，后到的事务等待先到的提交后失败，下次重新锁定即可
+	matrix = LocationMatrix{ID: 1, Width: width, Mode: mode}
+	if err = mr.data.DB(ctx).Table("location_matrix").Create(&matrix).Error; nil != err {
+		return nil, false, errors.New(500, "MATRIX ERROR", "排位矩阵初始化失败")
+	}
+
+	return &biz.LocationMatrix{Width: matrix.Width, Mode: matrix.Mode, NextSlot: matrix.NextSlot}, true, nil
+}
+
+// GetLocationMatrix 没有时返回 nil .
+func (mr *MatrixRepo) GetLocationMatrix(ctx context.Context) (*biz.LocationMatrix, error) {
+	var matrix LocationMatrix
+	if err := mr.data.DB(ctx).Table("location_matrix").Where("id=?", 1).First(&matrix).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, errors.New(500, "MATRIX ERROR", err.Error())
+	}
+
+	return &biz.LocationMatrix{Width: matrix.Width, Mode: matrix.Mode, NextSlot: matrix.NextSlot}, nil
+}
+
+// UpdateLocationMatrixNextSlot 事务中使用 .
+func (mr *MatrixRepo) UpdateLocationMatrixNextSlot(ctx context.Context, nextSlot int64) error {
+	if res := mr.data.DB(ctx).Table("location_matrix").
+		Where("id=?", 1).
+		Updates(map[string]interface{}{"next_slot": nextSlot}); res.Error != nil {
+		return errors.New(500, "MATRIX ERROR", res.Error.Error())
+	}
+
+	return nil
+}
+
+// PopLocationMatrixFreeSlot 事务中使用，取出最早空出的位置 .
+func (mr *MatrixRepo) PopLocationMatrixFreeSlot(ctx context.Context) (int64, bool, error) {
+	var free LocationMatrixFree
+	if err := mr.data.DB(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).
+		Table("location_matrix_free").Order("id asc").First(&free).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return 0, false, nil
+		}
+
+		return 0, false, errors.New(500, "MATRIX ERROR", err.Error())
+	}
+
+	if res := mr.data.DB(ctx).Table("location_matrix_free").Where("id=?", free.ID).Delete(&LocationMatrixFree{}); res.Error != nil {
+		return 0, false, errors.New(500, "MATRIX ERROR", res.Error.Error())
+	}
+
+	return free.Slot, true, nil
+}
+
+// PushLocationMatrixFreeSlot 事务中使用 .
+func (mr *MatrixRepo) PushLocationMatrixFreeSlot(ctx context.Context, slot int64) error {
+	if res := mr.data.DB(ctx).Table("location_matrix_free").Create(&LocationMatrixFree{Slot: slot}); res.Error != nil {
+		return errors.New(500, "MATRIX ERROR", res.Error.Error())
+	}
+
+	return nil
+}
+
+// ShiftLocationAfterSlot 事务中使用，占着位置且在 slot 之后的仓位前移一位，第 1 列移到上一行最后一列 .
+func (mr *MatrixRepo) ShiftLocationAfterSlot(ctx context.Context, slot int64, width int64) error {
+	// row 先于 col 赋值，按修改前的 col 判断是否换行
+	if res := mr.data.DB(ctx).Exec("UPDATE location_new SET `row`=IF(col=1, `row`-1, `row`), col=IF(col=1, ?, col-1) "+
+		"WHERE `row`>0 AND stop_is_update=0 AND (`row`-1)*?+col-1>?", width, width, slot); nil != res.Error {
+		return errors.New(500, "LOCATION ERROR", res.Error.Error())
+	}
+
+	return nil
+}
+
+// UpdateLocationRowAndCol 事务中使用，只修改当前仓位 .
+func (mr *MatrixRepo) UpdateLocationRowAndCol(ctx context.Context, id int64, row int64, col int64) error {
+	res := mr.data.DB(ctx).Table("location_new").
+		Where("id=?", id).
+		Updates(map[string]interface{}{"row": row, "col": col})
+	if nil != res.Error {
+		return errors.New(500, "LOCATION ERROR", res.Error.Error())
+	}
+	if 0 == res.RowsAffected {
+		return errors.NotFound("LOCATION_NOT_FOUND", "location not found")
+	}
+
+	return nil
+}
+
+// UpdateLocationStopIsUpdate 事务中使用，出局仓位已让出位置 .
+func (mr *MatrixRepo) UpdateLocationStopIsUpdate(ctx context.Context, id int64) error {
+	res := mr.data.DB(ctx).Table("location_new").
+		Where("id=? and stop_is_update=?", id, 0).
+		Updates(map[string]interface{}{"stop_is_update": 1})
+	if nil != res.Error {
+		return errors.New(500, "LOCATION ERROR", res.Error.Error())
+	}
+	if 0 == res.RowsAffected {
+		return errors.New(500, "UPDATE_LOCATION_ERROR", "仓位已让出位置")
+	}
+
+	return nil
+}
+
+// CreateLocationMatrixEvent .
+func (mr *MatrixRepo) CreateLocationMatrixEvent(ctx context.Context, e *biz.LocationMatrixEvent) error {
+	var event LocationMatrixEvent
+	event.LocationId = e.LocationId
+	event.Action = e.Action
+	event.Slot = e.Slot
+	event.Row = e.Row
+	event.Col = e.Col
+
+	if res := mr.data.DB(ctx).Table("location_matrix_event").Create(&event); res.Error != nil {
+		return errors.New(500, "CREATE_MATRIX_EVENT_ERROR", "排位记录创建失败")
+	}
+
+	return nil
+}
+
+// GetLocationMatrixEvents .
+func (mr *MatrixRepo) GetLocationMatrixEvents(ctx context.Context) ([]*biz.LocationMatrixEvent, error) {
+	var events []*LocationMatrixEvent
+	if err := mr.data.db.Table("location_matrix_event").Order("id asc").Find(&events).Error; err != nil {
+		return nil, errors.New(500, "MATRIX ERROR", err.Error())
+	}
+
+	res := make([]*biz.LocationMatrixEvent, 0)
+	for _, v := range events {
+		res = append(res, &biz.LocationMatrixEvent{
+			ID:         v.ID,
+			LocationId: v.LocationId,
+			Action:     v.Action,
+			Slot:       v.Slot,
+			Row:        v.Row,
+			Col:        v.Col,
+			CreatedAt:  v.CreatedAt,
+		})
+	}
+
+	return res, nil
+}
+
+// GetPlacedLocations 占着位置的仓位，按 id 排序 .
+func (mr *MatrixRepo) GetPlacedLocations(ctx context.Context) ([]*biz.LocationNew, error) {
+	return mr.getLocations(ctx, mr.data.DB(ctx).Table("location_new").
+		Where("row>?", 0).
+		Where("stop_is_update=?", 0))
+}
+
+// GetUnplacedLocations 运行中还没有排位的仓位，按 id 排序 .
+func (mr *MatrixRepo) GetUnplacedLocations(ctx context.Context) ([]*biz.LocationNew, error) {
+	return mr.getLocations(ctx, mr.data.DB(ctx).Table("location_new").
+		Where("row=?", 0).
+		Where("status in (?)", []string{biz.PositionPending, biz.PositionRunning, biz.PositionCapped}))
+}
+
+// GetStoppedPlacedLocations 已出局但还占着位置的仓位 .
+func (mr *MatrixRepo) GetStoppedPlacedLocations(ctx context.Context) ([]*biz.LocationNew, error) {
+	return mr.getLocations(ctx, mr.data.db.Table("location_new").
+		Where("row>?", 0).
+		Where("stop_is_update=?", 0).
+		Where("status in (?)", []string{biz.PositionStopped, biz.PositionReinvested}))
+}
+
+func (mr *MatrixRepo) getLocations(ctx context.Context, instance *gorm.DB) ([]*biz.LocationNew, error) {
+	var locations []*LocationNew
+	if err := instance.Order("id asc").Find(&locations).Error; err != nil {
+		return nil, errors.New(500, "LOCATION ERROR", err.Error())
+	}
+
+	res := make([]*biz.LocationNew, 0)
+	for _, location := range locations {
+		res = append(res, &biz.LocationNew{
+			ID:     location.ID,
+			UserId: location.UserId,
+			Status: location.Status,
+			Row:    location.Row,
+			Col:    location.Col,
+		})
+	}
+
+	return res, nil
+}
@@ -0,0 +1,268 @@
+package data
+
+import (
+	"context"
+	"dhb/app/app/internal/biz"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+type LocationTransition struct {
+	ID         int64     `gorm:"primarykey;type:int"`
+	LocationId int64     `gorm:"type:int;not null;index"`
+	UserId     int64     `gorm:"type:int;not null"`
+	From       string    `gorm:"type:varchar(45);not null"`
+	To         string    `gorm:"type:varchar(45);not null"`
+	Reason     string    `gorm:"type:varchar(45);not null"`
+	CreatedAt  time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt  time.Time `gorm:"type:datetime;not null"`
+}
+
+type PositionRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewPositionRepo(data *Data, logger log.Logger) biz.PositionRepo {
+	return &PositionRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (location *LocationNew) toBiz() *biz.LocationNew {
+	return &biz.LocationNew{
+		ID:                location.ID,
+		UserId:            location.UserId,
+		Num:               location.Num,
+		Top:               location.Top,
+		Status:            location.Status,
+		Current:           location.Current,
+		CurrentMax:        location.CurrentMax,
+		CurrentMaxNew:     location.CurrentMaxNew,
+		StopLocationAgain: location.StopLocationAgain,
+		StopCoin:          location.StopCoin,
+		Usdt:              location.Usdt,
+		Biw:               location.Biw,
+		StopDate:          location.StopDate,
+		CreatedAt:         location.CreatedAt,
+	}
+}
+
+// LockRunningLocationNewByUserId 事务中使用，锁定用户运行中的仓位，没有时返回 nil .
+func (pr *PositionRepo) LockRunningLocationNewByUserId(ctx context.Context, userId int64) (*biz.LocationNew, error) {
+	return pr.LockLocationNewByUserIdAndStatus(ctx, userId, biz.PositionRunning)
+}
+
+// LockLocationNewByUserIdAndStatus 事务中使用，锁定用户最近一个状态在 status 中的仓位，没有时返回 nil .
+func (pr *PositionRepo) LockLocationNewByUserIdAndStatus(ctx context.Context, userId int64, status ...string) (*biz.LocationNew, error) {
+	var location LocationNew
+	if err := pr.data.DB(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).
+		Table("location_new").
+		Where("user_id=?", userId).
+		Where("status IN (?)", status).
+		Order("id desc").First(&location).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, errors.New(500, "LOCATION ERROR", err.Error())
+	}
+
+	return location.toBiz(), nil
+}
+
+// GetRunningLocationNewByUserId 用户运行中的仓位，不加锁，没有时返回 nil .
+func (pr *PositionRepo) GetRunningLocationNewByUserId(ctx context.Context, userId int64) (*biz.LocationNew, error) {
+	var location LocationNew
+	if err := pr.data.DB(ctx).Table("location_new").
+		Where("user_id=?", userId).
+		Where("status=?", biz.PositionRunning).
+		Order("id desc").First(&location).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, errors.New(500, "LOCATION ERROR", err.Error())
+	}
+
+	return location.toBiz(), nil
+}
+
+// GetLocationNewById .
+func (pr *PositionRepo) GetLocationNewById(ctx context.Context, id int64) (*biz.LocationNew, error) {
+	var location LocationNew
+	if err := pr.data.DB(ctx).Table("location_new").Where("id=?", id).First(&location).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.NotFound("LOCATION_NOT_FOUND", "location not found")
+		}
+
+		return nil, errors.New(500, "LOCATION ERROR", err.Error())
+	}
+
+	return location.toBiz(), nil
+}
+
+// UpdateLocationNewStatus 事务中使用，状态已被修改时失败 .
+func (pr *PositionRepo) UpdateLocationNewStatus(ctx context.Context, id int64, from string, to string, stopDate time.Time) error {
+	updates := map[string]interface{}{"status": to}
+	if !stopDate.IsZero() {
+		updates["stop_date"] = stopDate
+	}
+	if biz.PositionReinvested == to {
+		updates["stop_location_again"] = 1
+	}
+
+	res := pr.data.DB(ctx).Table("location_new").
+		Where("id=? and status=?", id, from).
+		Updates(updates)
+	if nil != res.Error {
+		return errors.New(500, "LOCATION ERROR", res.Error.Error())
+	}
+	if 0 == res.RowsAffected {
+		return errors.New(500, "UPDATE_LOCATION_ERROR", "仓位状态已变化")
+	}
+
+	return nil
+}
+
+// AddLocationNewCurrent 事务中使用，和闪兑额度合计不能超过 current_max .
+func (pr *PositionRepo) AddLocationNewCurrent(ctx context.Context, id int64, amount int64) error {
+	res := pr.data.DB(ctx).Table("location_new").
+		Where("id=? and status=?", id, biz.PositionRunning).
+		Where("current+current_max_new+?<=current_max", amount).
+		Updates(map[string]interface{}{"current": gorm.Expr("current + ?", amount)})
+	if nil != res.Error {
+		return errors.New(500, "LOCATION ERROR", res.Error.Error())
+	}
+	if 0 == res.RowsAffected {
+		return errors.New(500, "UPDATE_LOCATION_ERROR", "仓位额度不足")
+	}
+
+	return nil
+}
+
+// AddLocationNewCurrentMaxNew 事务中使用，和收益合计不能超过 current_max .
+func (pr *PositionRepo) AddLocationNewCurrentMaxNew(ctx context.Context, id int64, amount int64) error {
+	res := pr.data.DB(ctx).Table("location_new").
+		Where("id=? and status=?", id, biz.PositionRunning).
+		Where("current+current_max_new+?<=current_max", amount).
+		Updates(map[string]interface{}{"current_max_new": gorm.Expr("current_max_new + ?", amount)})
+	if nil != res.Error {
+		return errors.New(500, "LOCATION ERROR", res.Error.Error())
+	}
+	if 0 == res.RowsAffected {
+		return errors.New(500, "UPDATE_LOCATION_ERROR", "仓位额度不足")
+	}
+
+	return nil
+}
+
+// CreateLocationNew .
+func (pr *PositionRepo) CreateLocationNew(ctx context.Context, l *biz.LocationNew) (*biz.LocationNew, error) {
+	var location LocationNew
+	location.UserId = l.UserId
+	location.Num = l.Num
+	location.Top = l.Top
+	location.Status = l.Status
+	location.Usdt = l.Usdt
+	location.Biw = l.Biw
+	location.CurrentMax = l.CurrentMax
+
+	if res := pr.data.DB(ctx).Table("location_new").Create(&location); res.Error != nil {
+		return nil, errors.New(500, "CREATE_LOCATION_ERROR", "仓位创建失败")
+	}
+
+	return location.toBiz(), nil
+}
+
+// GetLastStoppedLocationNew 没有时返回 nil .
+func (pr *PositionRepo) GetLastStoppedLocationNew(ctx context.Context, userId int64) (*biz.LocationNew, error) {
+	var location LocationNew
+	if err := pr.data.DB(ctx).Table("location_new").
+		Where("user_id=?", userId).
+		Where("status=?", biz.PositionStopped).
+		Order("id desc").First(&location).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, errors.New(500, "LOCATION ERROR", err.Error())
+	}
+
+	return location.toBiz(), nil
+}
+
+// GetLocationNewsByStatus .
+func (pr *PositionRepo) GetLocationNewsByStatus(ctx context.Context, status string) ([]*biz.LocationNew, error) {
+	var locations []*LocationNew
+	if err := pr.data.DB(ctx).Table("location_new").
+		Where("status=?", status).
+		Order("id asc").Find(&locations).Error; err != nil {
+		return nil, errors.New(500, "LOCATION ERROR", err.Error())
+	}
+
+	res := make([]*biz.LocationNew, 0, len(locations))
+	for _, v := range locations {
+		res = append(res, v.toBiz())
+	}
+	return res, nil
+}
+
+// GetLocationNewCountByUserId .
+func (pr *PositionRepo) GetLocationNewCountByUserId(ctx context.Context, userId int64) (int64, error) {
+	var count int64
+	if err := pr.data.DB(ctx).Table("location_new").Where("user_id=?", userId).Count(&count).Error; err != nil {
+		return 0, errors.New(500, "LOCATION ERROR", err.Error())
+	}
+
+	return count, nil
+}
+
+// CreateLocationTransition .
+func (pr *PositionRepo) CreateLocationTransition(ctx context.Context, t *biz.LocationTransition) error {
+	var transition LocationTransition
+	transition.LocationId = t.LocationId
+	transition.UserId = t.UserId
+	transition.From = t.From
+	transition.To = t.To
+	transition.Reason = t.Reason
+
+	if res := pr.data.DB(ctx).Table("location_transition").Create(&transition); res.Error != nil {
+		return errors.New(500, "CREATE_LOCATION_TRANSITION_ERROR", "仓位状态记录创建失败")
+	}
+
+	return nil
+}
+
+// GetLocationTransitions .
+func (pr *PositionRepo) GetLocationTransitions(ctx context.Context, locationId int64) ([]*biz.LocationTransition, error) {
+	var transitions []*LocationTransition
+	res := make([]*biz.LocationTransition, 0)
+	if err := pr.data.db.Table("location_transition").
+		Where("location_id=?", locationId).
+		Order("id asc").Find(&transitions).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return res, nil
+		}
+
+		return nil, errors.New(500, "LOCATION TRANSITION ERROR", err.Error())
+	}
+
+	for _, v := range transitions {
+		res = append(res, &biz.LocationTransition{
+			ID:         v.ID,
+			LocationId: v.LocationId,
+			UserId:     v.UserId,
+			From:       v.From,
+			To:         v.To,
+			Reason:     v.Reason,
+			CreatedAt:  v.CreatedAt,
+		})
+	}
+
+	return res, nil
+}
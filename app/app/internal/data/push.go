@@ -0,0 +1,59 @@
+package data
+
+import (
+	"context"
+	"dhb/app/app/internal/biz"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/v8"
+	"strconv"
+	"time"
+)
+
+type PushTicketRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewPushTicketRepo(data *Data, logger log.Logger) biz.PushTicketRepo {
+	return &PushTicketRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func pushTicketKey(ticket string) string {
+	return "dhb:push:ticket:" + ticket
+}
+
+// CreatePushTicket .
+func (p *PushTicketRepo) CreatePushTicket(ctx context.Context, ticket string, userId int64, ttl time.Duration) error {
+	if err := p.data.rdb.Set(ctx, pushTicketKey(ticket), userId, ttl).Err(); nil != err {
+		return errors.New(500, "PUSH TICKET ERROR", err.Error())
+	}
+
+	return nil
+}
+
+// UsePushTicket 在一个事务中读取并删除，并发使用时只有一个成功 .
+func (p *PushTicketRepo) UsePushTicket(ctx context.Context, ticket string) (int64, error) {
+	var get *redis.StringCmd
+	if _, err := p.data.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		get = pipe.Get(ctx, pushTicketKey(ticket))
+		pipe.Del(ctx, pushTicketKey(ticket))
+		return nil
+	}); nil != err && redis.Nil != err {
+		return 0, errors.New(500, "PUSH TICKET ERROR", err.Error())
+	}
+
+	value, err := get.Result()
+	if redis.Nil == err {
+		return 0, nil
+	}
+	if nil != err {
+		return 0, errors.New(500, "PUSH TICKET ERROR", err.Error())
+	}
+
+	userId, _ := strconv.ParseInt(value, 10, 64)
+	return userId, nil
+}
@@ -0,0 +1,120 @@
+package data
+
+import (
+	"context"
+	"dhb/app/app/internal/biz"
+	"dhb/app/app/internal/conf"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/v8"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// tokenBucketScript 令牌桶，使用 Redis 的时间，多节点共用一个桶
+// KEYS[1] 桶，ARGV[1] 容量，ARGV[2] 每个令牌恢复的毫秒数
+// 返回 {是否取到, 需要等待的毫秒数}，Lua 数字转字符串只保留 14 位有效数字，时间用毫秒
+var tokenBucketScript = redis.NewScript(`
+local burst = tonumber(ARGV[1])
+local interval = tonumber(ARGV[2])
+local t = redis.call('TIME')
+local now = tonumber(t[1]) * 1000 + math.floor(tonumber(t[2]) / 1000)
+
+local bucket = redis.call('HMGET', KEYS[1], 'tokens', 'ts')
+local tokens = tonumber(bucket[1])
+local ts = tonumber(bucket[2])
+if tokens == nil or ts == nil then
+	tokens = burst
+	ts = now
+end
+
+tokens = math.min(burst, tokens + math.max(0, now - ts) / interval)
+local allowed = 0
+local wait = 0
+if tokens >= 1 then
+	tokens = tokens - 1
+	allowed = 1
+else
+	wait = math.ceil((1 - tokens) * interval)
+end
+
+redis.call('HSET', KEYS[1], 'tokens', tostring(tokens), 'ts', now)
+redis.call('PEXPIRE', KEYS[1], math.ceil(burst * interval) + 1000)
+return {allowed, wait}
+`)
+
+type RateLimitRepo struct {
+	data   *Data
+	prefix string
+	log    *log.Helper
+}
+
+func NewRateLimitRepo(data *Data, c *conf.RateLimit, logger log.Logger) biz.RateLimitRepo {
+	prefix := "dhb:ratelimit:"
+	if "" != c.GetPrefix() {
+		prefix = c.GetPrefix()
+	}
+
+	return &RateLimitRepo{
+		data:   data,
+		prefix: prefix,
+		log:    log.NewHelper(logger),
+	}
+}
+
+// NewRateLimitConfig 限流配置，代理地址可以是 CIDR 或单个 ip .
+func NewRateLimitConfig(c *conf.RateLimit) (*biz.RateLimitConfig, error) {
+	rule := func(r *conf.RateLimit_Rule) *biz.RateLimitRule {
+		if nil == r {
+			return nil
+		}
+		return &biz.RateLimitRule{Burst: r.GetBurst(), Period: r.GetPeriod().AsDuration()}
+	}
+
+	res := &biz.RateLimitConfig{
+		Enable:         c.GetEnable(),
+		TrustProxy:     c.GetTrustProxy(),
+		TrustedProxies: make([]*net.IPNet, 0),
+		Ip:             rule(c.GetIpRule()),
+		Default:        rule(c.GetDefaultRule()),
+		Operations:     make(map[string]*biz.RateLimitRule, 0),
+	}
+	for operation, r := range c.GetOperations() {
+		res.Operations[operation] = rule(r)
+	}
+	for _, v := range c.GetTrustedProxies() {
+		if !strings.Contains(v, "/") {
+			if ip := net.ParseIP(v); nil != ip && nil != ip.To4() {
+				v += "/32"
+			} else {
+				v += "/128"
+			}
+		}
+		_, ipNet, err := net.ParseCIDR(v)
+		if nil != err {
+			return nil, fmt.Errorf("rate_limit: invalid trusted proxy %q", v)
+		}
+		res.TrustedProxies = append(res.TrustedProxies, ipNet)
+	}
+	if res.TrustProxy && 0 == len(res.TrustedProxies) {
+		return nil, fmt.Errorf("rate_limit: trust_proxy requires trusted_proxies")
+	}
+
+	return res, nil
+}
+
+// TakeToken .
+func (r *RateLimitRepo) TakeToken(ctx context.Context, key string, rule *biz.RateLimitRule) (bool, time.Duration, error) {
+	interval := float64(rule.Period.Milliseconds()) / float64(rule.Burst)
+	res, err := tokenBucketScript.Run(ctx, r.data.rdb, []string{r.prefix + key}, rule.Burst, strconv.FormatFloat(interval, 'f', 3, 64)).Int64Slice()
+	if nil != err {
+		return false, 0, err
+	}
+	if 2 != len(res) {
+		return false, 0, redis.Nil
+	}
+
+	return 1 == res[0], time.Duration(res[1]) * time.Millisecond, nil
+}
@@ -0,0 +1,102 @@
+package data
+
+import (
+	"context"
+	"dhb/app/app/internal/biz"
+	"dhb/app/app/internal/conf"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/v8"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestNewRateLimitConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		c       *conf.RateLimit
+		proxies []string
+		err     bool
+	}{
+		{"empty", &conf.RateLimit{}, []string{}, false},
+		{"single ip", &conf.RateLimit{TrustProxy: true, TrustedProxies: []string{"10.0.0.1", "fd00::1"}}, []string{"10.0.0.1/32", "fd00::1/128"}, false},
+		{"cidr", &conf.RateLimit{TrustedProxies: []string{"10.0.0.0/8"}}, []string{"10.0.0.0/8"}, false},
+		{"invalid", &conf.RateLimit{TrustedProxies: []string{"proxy"}}, nil, true},
+		{"trust without proxies", &conf.RateLimit{TrustProxy: true}, nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := NewRateLimitConfig(tt.c)
+			if tt.err {
+				if nil == err {
+					t.Fatal("NewRateLimitConfig() error = nil")
+				}
+				return
+			}
+			if nil != err {
+				t.Fatal(err)
+			}
+			proxies := make([]string, 0)
+			for _, v := range c.TrustedProxies {
+				proxies = append(proxies, v.String())
+			}
+			if fmt.Sprint(proxies) != fmt.Sprint(tt.proxies) {
+				t.Errorf("TrustedProxies = %v, want %v", proxies, tt.proxies)
+			}
+		})
+	}
+
+	c, _ := NewRateLimitConfig(&conf.RateLimit{
+		DefaultRule: &conf.RateLimit_Rule{Burst: 5, Period: durationpb.New(time.Minute)},
+		Operations:  map[string]*conf.RateLimit_Rule{"/api.App/EthAuthorize": {Burst: 1, Period: durationpb.New(time.Hour)}},
+	})
+	if 5 != c.Default.Burst || time.Hour != c.Operations["/api.App/EthAuthorize"].Period || nil != c.Ip {
+		t.Errorf("NewRateLimitConfig() rules = %+v %+v %+v", c.Default, c.Operations, c.Ip)
+	}
+}
+
+// TestTakeToken 需要 Redis，DHB_TEST_REDIS 为地址，如 127.0.0.1:6379
+func TestTakeToken(t *testing.T) {
+	addr := os.Getenv("DHB_TEST_REDIS")
+	if "" == addr {
+		t.Skip("DHB_TEST_REDIS not set")
+	}
+	rdb := redis.NewClient(&redis.Options{Addr: addr})
+	defer rdb.Close()
+
+	ctx := context.Background()
+	prefix := fmt.Sprintf("dhb:test:ratelimit:%d:", time.Now().UnixNano())
+	repo := NewRateLimitRepo(&Data{rdb: rdb}, &conf.RateLimit{Prefix: prefix}, log.DefaultLogger)
+	rule := &biz.RateLimitRule{Burst: 3, Period: 600 * time.Millisecond}
+
+	for i := 0; i < 3; i++ {
+		allowed, _, err := repo.TakeToken(ctx, "k", rule)
+		if nil != err {
+			t.Fatal(err)
+		}
+		if !allowed {
+			t.Fatalf("TakeToken() #%d = false, want true", i+1)
+		}
+	}
+
+	allowed, retryAfter, err := repo.TakeToken(ctx, "k", rule)
+	if nil != err {
+		t.Fatal(err)
+	}
+	if allowed || 0 >= retryAfter || 200*time.Millisecond < retryAfter {
+		t.Fatalf("TakeToken() over burst = %v, %s, want false, (0, 200ms]", allowed, retryAfter)
+	}
+	if allowed, _, _ := repo.TakeToken(ctx, "other", rule); !allowed {
+		t.Error("TakeToken() other key = false, want true")
+	}
+
+	time.Sleep(retryAfter + 20*time.Millisecond)
+	if allowed, _, _ := repo.TakeToken(ctx, "k", rule); !allowed {
+		t.Error("TakeToken() after refill = false, want true")
+	}
+
+	rdb.Del(ctx, prefix+"k", prefix+"other")
+}
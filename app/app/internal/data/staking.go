@@ -0,0 +1,173 @@
+package data
+
+import (
+	"context"
+	"dhb/app/app/internal/biz"
+	"github.com/go-kratos/kratos/v2/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+type BalanceRewardUnstake struct {
+	ID              int64     `gorm:"primarykey;type:int"`
+	UserId          int64     `gorm:"type:int;not null;index"`
+	BalanceRewardId int64     `gorm:"type:int;not null"`
+	Amount          int64     `gorm:"type:bigint;not null"`
+	Penalty         int64     `gorm:"type:bigint;not null"`
+	Status          string    `gorm:"type:varchar(45);not null"`
+	MatureAt        time.Time `gorm:"type:datetime;not null"`
+	CreatedAt       time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt       time.Time `gorm:"type:datetime;not null"`
+}
+
+func (bru *BalanceRewardUnstake) toBiz() *biz.BalanceRewardUnstake {
+	return &biz.BalanceRewardUnstake{
+		ID:              bru.ID,
+		UserId:          bru.UserId,
+		BalanceRewardId: bru.BalanceRewardId,
+		Amount:          bru.Amount,
+		Penalty:         bru.Penalty,
+		Status:          bru.Status,
+		MatureAt:        bru.MatureAt,
+		CreatedAt:       bru.CreatedAt,
+	}
+}
+
+// LockBalanceRewardByUserId 事务中使用，锁定用户计息中的理财 .
+func (ub *UserBalanceRepo) LockBalanceRewardByUserId(ctx context.Context, userId int64) ([]*biz.BalanceReward, error) {
+	var balanceRewards []*BalanceReward
+	res := make([]*biz.BalanceReward, 0)
+	if err := ub.data.DB(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("user_id=?", userId).Where("status=?", 1).
+		Order("id asc").Table("balance_reward").Find(&balanceRewards).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return res, nil
+		}
+
+		return nil, errors.New(500, "BALANCE REWARD ERROR", err.Error())
+	}
+
+	for _, balanceReward := range balanceRewards {
+		res = append(res, &biz.BalanceReward{
+			ID:             balanceReward.ID,
+			UserId:         balanceReward.UserId,
+			Status:         balanceReward.Status,
+			Amount:         balanceReward.Amount,
+			SetDate:        balanceReward.SetDate,
+			LastRewardDate: balanceReward.LastRewardDate,
+		})
+	}
+	return res, nil
+}
+
+// ReduceBalanceReward 事务中使用，只扣减仓位，不入账 .
+func (ub *UserBalanceRepo) ReduceBalanceReward(ctx context.Context, id int64, amount int64, status int64) error {
+	if res := ub.data.DB(ctx).Table("balance_reward").
+		Where("id=? and status=? and amount>=?", id, 1, amount).
+		Updates(map[string]interface{}{"amount": gorm.Expr("amount - ?", amount), "status": status}); 0 == res.RowsAffected || nil != res.Error {
+		return errors.New(500, "BALANCE_REWARD_ERROR", "理财仓位修改失败")
+	}
+
+	return nil
+}
+
+// CreateBalanceRewardUnstake .
+func (ub *UserBalanceRepo) CreateBalanceRewardUnstake(ctx context.Context, u *biz.BalanceRewardUnstake) (*biz.BalanceRewardUnstake, error) {
+	var unstake BalanceRewardUnstake
+	unstake.UserId = u.UserId
+	unstake.BalanceRewardId = u.BalanceRewardId
+	unstake.Amount = u.Amount
+	unstake.Penalty = u.Penalty
+	unstake.Status = "pending"
+	unstake.MatureAt = u.MatureAt
+
+	if res := ub.data.DB(ctx).Table("balance_reward_unstake").Create(&unstake); res.Error != nil {
+		return nil, errors.New(500, "CREATE_BALANCE_REWARD_UNSTAKE_ERROR", "赎回记录创建失败")
+	}
+
+	return unstake.toBiz(), nil
+}
+
+// CreditBalanceRewardUnstake 事务中使用，赎回本金扣除违约金后入账，违约金记入平台手续费账户 .
+func (ub *UserBalanceRepo) CreditBalanceRewardUnstake(ctx context.Context, u *biz.BalanceRewardUnstake) error {
+	if res := ub.data.DB(ctx).Table("balance_reward_unstake").
+		Where("id=? and status=?", u.ID, "pending").
+		Updates(map[string]interface{}{"status": "done"}); 0 == res.RowsAffected || nil != res.Error {
+		return errors.New(500, "BALANCE_REWARD_ERROR", "赎回已到账")
+	}
+
+	if 0 < u.Penalty {
+		if _, err := ub.creditSystemAccount(ctx, systemFeeUserId, "usdt", u.Penalty, "balance_reward_penalty"); nil != err {
+			return err
+		}
+	}
+
+	amount := u.Amount - u.Penalty
+	if 0 >= amount {
+		return nil
+	}
+
+	if res := ub.data.DB(ctx).Table("user_balance").
+		Where("user_id=?", u.UserId).
+		Updates(map[string]interface{}{"balance_usdt": gorm.Expr("balance_usdt + ?", amount)}); 0 == res.RowsAffected || nil != res.Error {
+		return errors.NotFound("user balance err", "user balance error")
+	}
+
+	var userBalance UserBalance
+	err := ub.data.DB(ctx).Where(&UserBalance{UserId: u.UserId}).Table("user_balance").First(&userBalance).Error
+	if err != nil {
+		return err
+	}
+
+	var userBalanceRecode UserBalanceRecord
+	userBalanceRecode.Balance = userBalance.BalanceUsdt
+	userBalanceRecode.UserId = userBalance.UserId
+	userBalanceRecode.Type = "balance_reward_unstake"
+	userBalanceRecode.CoinType = "usdt"
+	userBalanceRecode.Amount = amount
+	err = ub.data.DB(ctx).Table("user_balance_record").Create(&userBalanceRecode).Error
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetBalanceRewardUnstakesMature .
+func (ub *UserBalanceRepo) GetBalanceRewardUnstakesMature(ctx context.Context, now time.Time) ([]*biz.BalanceRewardUnstake, error) {
+	var unstakes []*BalanceRewardUnstake
+	res := make([]*biz.BalanceRewardUnstake, 0)
+	if err := ub.data.db.Table("balance_reward_unstake").
+		Where("status=?", "pending").
+		Where("mature_at<=?", now).
+		Order("id asc").Find(&unstakes).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return res, nil
+		}
+
+		return nil, errors.New(500, "BALANCE REWARD UNSTAKE ERROR", err.Error())
+	}
+
+	for _, v := range unstakes {
+		res = append(res, v.toBiz())
+	}
+	return res, nil
+}
+
+// GetUserBalanceRewardUnstakePending .
+func (ub *UserBalanceRepo) GetUserBalanceRewardUnstakePending(ctx context.Context, userId int64) (int64, error) {
+	var total UserBalanceTotal
+	if err := ub.data.db.Table("balance_reward_unstake").
+		Where("user_id=?", userId).
+		Where("status=?", "pending").
+		Select("sum(amount - penalty) as total").Take(&total).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return 0, nil
+		}
+
+		return 0, errors.New(500, "BALANCE REWARD UNSTAKE ERROR", err.Error())
+	}
+
+	return total.Total, nil
+}
@@ -0,0 +1,303 @@
+package data
+
+import (
+	"bufio"
+	"context"
+	"dhb/app/app/internal/biz"
+	"dhb/app/app/internal/conf"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"time"
+)
+
+type Statement struct {
+	ID        int64     `gorm:"primarykey;type:int"`
+	UserId    int64     `gorm:"type:int;not null;index"`
+	StartDate string    `gorm:"type:varchar(10);not null"`
+	EndDate   string    `gorm:"type:varchar(10);not null"`
+	Format    string    `gorm:"type:varchar(10);not null"`
+	Status    string    `gorm:"type:varchar(45);not null;index"`
+	File      string    `gorm:"type:varchar(200);not null"`
+	Message   string    `gorm:"type:varchar(1000);not null"`
+	CreatedAt time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt time.Time `gorm:"type:datetime;not null"`
+}
+
+type StatementRepo struct {
+	data *Data
+	dir  string
+	log  *log.Helper
+}
+
+func NewStatementRepo(data *Data, c *conf.Statement, logger log.Logger) biz.StatementRepo {
+	dir := c.GetDir()
+	if "" == dir {
+		dir = "./statements"
+	}
+	return &StatementRepo{
+		data: data,
+		dir:  dir,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// statementKeyEnv 配置文件中没有签名密钥时从环境变量读取
+const statementKeyEnv = "DHB_STATEMENT_KEY"
+
+// NewStatementConfig 对账单配置，没有签名密钥时不启动 .
+func NewStatementConfig(c *conf.Statement) (*biz.StatementConfig, error) {
+	res := &biz.StatementConfig{
+		SyncDays: c.GetSyncDays(),
+		UrlTtl:   10 * time.Minute,
+		Key:      c.GetKey(),
+		BaseUrl:  c.GetBaseUrl(),
+	}
+	if "" == res.Key {
+		res.Key = os.Getenv(statementKeyEnv)
+	}
+	if "" == res.Key {
+		return nil, fmt.Errorf("statement: key is empty, set statement.key or %s", statementKeyEnv)
+	}
+	if nil != c.GetUrlTtl() {
+		res.UrlTtl = c.GetUrlTtl().AsDuration()
+	}
+
+	return res, nil
+}
+
+func (s *Statement) toBiz() *biz.Statement {
+	return &biz.Statement{
+		ID:        s.ID,
+		UserId:    s.UserId,
+		StartDate: s.StartDate,
+		EndDate:   s.EndDate,
+		Format:    s.Format,
+		Status:    s.Status,
+		File:      s.File,
+		Message:   s.Message,
+		CreatedAt: s.CreatedAt,
+	}
+}
+
+// CreateStatement .
+func (sr *StatementRepo) CreateStatement(ctx context.Context, s *biz.Statement) (*biz.Statement, error) {
+	var statement Statement
+	statement.UserId = s.UserId
+	statement.StartDate = s.StartDate
+	statement.EndDate = s.EndDate
+	statement.Format = s.Format
+	statement.Status = s.Status
+
+	if res := sr.data.DB(ctx).Table("statement").Create(&statement); res.Error != nil {
+		return nil, errors.New(500, "CREATE_STATEMENT_ERROR", "对账单创建失败")
+	}
+
+	return statement.toBiz(), nil
+}
+
+// GetStatementById .
+func (sr *StatementRepo) GetStatementById(ctx context.Context, id int64) (*biz.Statement, error) {
+	var statement Statement
+	if err := sr.data.db.Table("statement").Where("id=?", id).First(&statement).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.NotFound("STATEMENT_NOT_FOUND", "statement not found")
+		}
+
+		return nil, errors.New(500, "STATEMENT ERROR", err.Error())
+	}
+
+	return statement.toBiz(), nil
+}
+
+// GetStatementsPending .
+func (sr *StatementRepo) GetStatementsPending(ctx context.Context) ([]*biz.Statement, error) {
+	var statements []*Statement
+	if err := sr.data.db.Table("statement").Where("status=?", "pending").Order("id asc").Find(&statements).Error; err != nil {
+		return nil, errors.New(500, "STATEMENT ERROR", err.Error())
+	}
+
+	res := make([]*biz.Statement, 0)
+	for _, v := range statements {
+		res = append(res, v.toBiz())
+	}
+
+	return res, nil
+}
+
+// UpdateStatement 只更新等待中的记录 .
+func (sr *StatementRepo) UpdateStatement(ctx context.Context, id int64, status string, file string, message string) error {
+	res := sr.data.DB(ctx).Table("statement").
+		Where("id=? and status=?", id, "pending").
+		Updates(map[string]interface{}{"status": status, "file": file, "message": message})
+	if nil != res.Error {
+		return errors.New(500, "STATEMENT ERROR", res.Error.Error())
+	}
+	if 0 == res.RowsAffected {
+		return errors.New(500, "UPDATE_STATEMENT_ERROR", "对账单状态已变化")
+	}
+
+	return nil
+}
+
+// WriteStatementFile 先写临时文件，write 成功后改名，失败时删除 .
+func (sr *StatementRepo) WriteStatementFile(ctx context.Context, name string, write func(w io.Writer) error) error {
+	if err := os.MkdirAll(sr.dir, 0o755); nil != err {
+		return errors.New(500, "STATEMENT FILE ERROR", err.Error())
+	}
+
+	path := filepath.Join(sr.dir, filepath.Base(name))
+	f, err := os.CreateTemp(sr.dir, filepath.Base(name)+".*.tmp")
+	if nil != err {
+		return errors.New(500, "STATEMENT FILE ERROR", err.Error())
+	}
+	defer os.Remove(f.Name())
+
+	w := bufio.NewWriter(f)
+	if err = write(w); nil != err {
+		_ = f.Close()
+		return err
+	}
+	if err = w.Flush(); nil != err {
+		_ = f.Close()
+		return errors.New(500, "STATEMENT FILE ERROR", err.Error())
+	}
+	if err = f.Chmod(0o644); nil != err {
+		_ = f.Close()
+		return errors.New(500, "STATEMENT FILE ERROR", err.Error())
+	}
+	if err = f.Close(); nil != err {
+		return errors.New(500, "STATEMENT FILE ERROR", err.Error())
+	}
+	if err = os.Rename(f.Name(), path); nil != err {
+		return errors.New(500, "STATEMENT FILE ERROR", err.Error())
+	}
+
+	return nil
+}
+
+// ReadStatementFile .
+func (sr *StatementRepo) ReadStatementFile(ctx context.Context, name string) ([]byte, error) {
+	content, err := os.ReadFile(filepath.Join(sr.dir, filepath.Base(name)))
+	if nil != err {
+		if os.IsNotExist(err) {
+			return nil, errors.NotFound("STATEMENT_NOT_FOUND", "statement file not found")
+		}
+
+		return nil, errors.New(500, "STATEMENT FILE ERROR", err.Error())
+	}
+
+	return content, nil
+}
+
+// GetUserBalanceBefore t 之前每个币种最后一条流水的余额 .
+func (sr *StatementRepo) GetUserBalanceBefore(ctx context.Context, userId int64, t time.Time) (map[string]int64, error) {
+	var records []*UserBalanceRecord
+	if err := sr.data.db.Table("user_balance_record").
+		Where("id IN (?)", sr.data.db.Table("user_balance_record").
+			Select("max(id)").
+			Where("user_id=?", userId).
+			Where("created_at<?", t).
+			Group("coin_type")).
+		Find(&records).Error; err != nil {
+		return nil, errors.New(500, "USER_BALANCE_RECORD ERROR", err.Error())
+	}
+
+	res := make(map[string]int64, 0)
+	for _, v := range records {
+		res[v.CoinType] = v.Balance
+	}
+
+	return res, nil
+}
+
+// statementLineSources 明细来源，按来源名排序，和翻页条件一致
+var statementLineSources = []string{"balance", "balance_reward", "reward", "trade", "withdraw"}
+
+// GetStatementLines 合并资金流水、收益、提现、交易、理财存入，按时间、来源、id 排序，
+// after 为上一页最后一条，每个来源最多取 limit 条，合并后再取前 limit 条 .
+func (sr *StatementRepo) GetStatementLines(ctx context.Context, userId int64, start time.Time, end time.Time, after *biz.StatementLine, limit int) ([]*biz.StatementLine, error) {
+	scope := func(source string) func(db *gorm.DB) *gorm.DB {
+		return func(db *gorm.DB) *gorm.DB {
+			db = db.Where("user_id=?", userId).Where("created_at>=? and created_at<?", start, end)
+			if nil != after {
+				switch {
+				case source > after.Source:
+					db = db.Where("created_at>=?", after.CreatedAt)
+				case source == after.Source:
+					db = db.Where("created_at>? or (created_at=? and id>?)", after.CreatedAt, after.CreatedAt, after.Id)
+				default:
+					db = db.Where("created_at>?", after.CreatedAt)
+				}
+			}
+			return db.Order("created_at asc, id asc").Limit(limit)
+		}
+	}
+
+	res := make([]*biz.StatementLine, 0)
+	for _, source := range statementLineSources {
+		switch source {
+		case "balance":
+			var records []*UserBalanceRecord
+			if err := sr.data.db.Table("user_balance_record").Scopes(scope(source)).Find(&records).Error; err != nil {
+				return nil, errors.New(500, "STATEMENT ERROR", err.Error())
+			}
+			for _, v := range records {
+				res = append(res, &biz.StatementLine{CreatedAt: v.CreatedAt, Source: source, Id: v.ID, Type: v.Type, Coin: v.CoinType, Amount: v.Amount, Balance: v.Balance})
+			}
+		case "reward":
+			var rewards []*Reward
+			if err := sr.data.db.Table("reward").Scopes(scope(source)).Find(&rewards).Error; err != nil {
+				return nil, errors.New(500, "STATEMENT ERROR", err.Error())
+			}
+			for _, v := range rewards {
+				res = append(res, &biz.StatementLine{CreatedAt: v.CreatedAt, Source: source, Id: v.ID, Type: v.Type, Coin: "usdt", Amount: v.Amount, Status: v.Reason})
+			}
+		case "withdraw":
+			var withdraws []*Withdraw
+			if err := sr.data.db.Table("withdraw").Scopes(scope(source)).Find(&withdraws).Error; err != nil {
+				return nil, errors.New(500, "STATEMENT ERROR", err.Error())
+			}
+			for _, v := range withdraws {
+				res = append(res, &biz.StatementLine{CreatedAt: v.CreatedAt, Source: source, Id: v.ID, Type: "withdraw", Coin: v.Type, Amount: v.Amount, Status: v.Status})
+			}
+		case "trade":
+			var trades []*Trade
+			if err := sr.data.db.Table("trade").Scopes(scope(source)).Find(&trades).Error; err != nil {
+				return nil, errors.New(500, "STATEMENT ERROR", err.Error())
+			}
+			for _, v := range trades {
+				res = append(res, &biz.StatementLine{CreatedAt: v.CreatedAt, Source: source, Id: v.ID, Type: "trade", Coin: "csd", Amount: v.AmountCsd, Status: v.Status})
+			}
+		case "balance_reward":
+			var balanceRewards []*BalanceReward
+			if err := sr.data.db.Table("balance_reward").Scopes(scope(source)).Find(&balanceRewards).Error; err != nil {
+				return nil, errors.New(500, "STATEMENT ERROR", err.Error())
+			}
+			for _, v := range balanceRewards {
+				res = append(res, &biz.StatementLine{CreatedAt: v.CreatedAt, Source: source, Id: v.ID, Type: "stake", Coin: "usdt", Amount: v.Amount, Status: strconv.FormatInt(v.Status, 10)})
+			}
+		}
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		if !res[i].CreatedAt.Equal(res[j].CreatedAt) {
+			return res[i].CreatedAt.Before(res[j].CreatedAt)
+		}
+		if res[i].Source != res[j].Source {
+			return res[i].Source < res[j].Source
+		}
+		return res[i].Id < res[j].Id
+	})
+	if limit < len(res) {
+		res = res[:limit]
+	}
+
+	return res, nil
+}
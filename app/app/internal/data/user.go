@@ -6,6 +6,7 @@ import (
 	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 	"strconv"
 	"strings"
 	"time"
@@ -18,11 +19,14 @@ type User struct {
 	Undo      int64     `gorm:"type:int;not null"`
 	CreatedAt time.Time `gorm:"type:datetime;not null"`
 	UpdatedAt time.Time `gorm:"type:datetime;not null"`
+
+	PasswordUpdatedAt *time.Time `gorm:"type:datetime"`
+	Locale            string     `gorm:"type:varchar(20);not null;default:''"`
 }
 
 type Trade struct {
 	ID           int64     `gorm:"primarykey;type:int"`
-	UserId       int64     `gorm:"type:int"`
+	UserId       int64     `gorm:"type:int;index"`
 	AmountCsd    int64     `gorm:"type:bigint"`
 	RelAmountCsd int64     `gorm:"type:bigint"`
 	AmountHbs    int64     `gorm:"type:bigint"`
@@ -75,6 +79,18 @@ type UserRecommendArea struct {
 	UpdatedAt     time.Time `gorm:"type:datetime;not null"`
 }
 
+type UserRecommendHistory struct {
+	ID                  int64     `gorm:"primarykey;type:int"`
+	UserId              int64     `gorm:"type:int;not null;index"`
+	OriginRecommendCode string    `gorm:"type:varchar(10000);not null"`
+	RecommendCode       string    `gorm:"type:varchar(10000);not null"`
+	OriginRecommendId   int64     `gorm:"type:int;not null"`
+	RecommendId         int64     `gorm:"type:int;not null"`
+	TeamNum             int64     `gorm:"type:int;not null"`
+	CreatedAt           time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt           time.Time `gorm:"type:datetime;not null"`
+}
+
 type UserArea struct {
 	ID         int64     `gorm:"primarykey;type:int"`
 	UserId     int64     `gorm:"type:int;not null"`
@@ -119,37 +135,41 @@ type UserBalance struct {
 
 type Withdraw struct {
 	ID              int64     `gorm:"primarykey;type:int"`
-	UserId          int64     `gorm:"type:int"`
+	UserId          int64     `gorm:"type:int;index:idx_withdraw_user_type,priority:1"`
 	Amount          int64     `gorm:"type:bigint"`
 	RelAmount       int64     `gorm:"type:bigint"`
+	Fee             int64     `gorm:"type:bigint;not null;default:0"` // 手续费，含分佣
+	Burn            int64     `gorm:"type:bigint;not null;default:0"` // 销毁
 	Status          string    `gorm:"type:varchar(45);not null"`
-	Type            string    `gorm:"type:varchar(45);not null"`
+	Type            string    `gorm:"type:varchar(45);not null;index:idx_withdraw_user_type,priority:2"`
 	BalanceRecordId int64     `gorm:"type:int"`
-	CreatedAt       time.Time `gorm:"type:datetime;not null"`
+	Address         string    `gorm:"type:varchar(100);not null"`
+	CreatedAt       time.Time `gorm:"type:datetime;not null;index:idx_withdraw_user_type,priority:3"`
 	UpdatedAt       time.Time `gorm:"type:datetime;not null"`
 }
 
 type UserBalanceRecord struct {
 	ID        int64     `gorm:"primarykey;type:int"`
-	UserId    int64     `gorm:"type:int"`
+	UserId    int64     `gorm:"type:int;index:idx_user_balance_record_user_coin_type,priority:1"`
 	Balance   int64     `gorm:"type:bigint"`
 	Amount    int64     `gorm:"type:bigint"`
-	Type      string    `gorm:"type:varchar(45);not null"`
-	CoinType  string    `gorm:"type:varchar(45);not null"`
+	Type      string    `gorm:"type:varchar(45);not null;index:idx_user_balance_record_user_coin_type,priority:3"`
+	CoinType  string    `gorm:"type:varchar(45);not null;index:idx_user_balance_record_user_coin_type,priority:2"`
 	CreatedAt time.Time `gorm:"type:datetime;not null"`
 	UpdatedAt time.Time `gorm:"type:datetime;not null"`
 }
 
 type Reward struct {
 	ID               int64     `gorm:"primarykey;type:int"`
-	UserId           int64     `gorm:"type:int;not null"`
+	UserId           int64     `gorm:"type:int;not null;index:idx_reward_user_type,priority:1"`
 	Amount           int64     `gorm:"type:bigint;not null"`
 	AmountB          int64     `gorm:"type:bigint;not null"`
 	BalanceRecordId  int64     `gorm:"type:int;not null"`
-	Type             string    `gorm:"type:varchar(45);not null"`
+	Type             string    `gorm:"type:varchar(45);not null;index:idx_reward_user_type,priority:2"`
 	TypeRecordId     int64     `gorm:"type:int;not null"`
 	Reason           string    `gorm:"type:varchar(45);not null"`
 	ReasonLocationId int64     `gorm:"type:int;not null"`
+	SourceUserId     int64     `gorm:"type:int;not null;default:0"` // 产生这笔收益或费用的用户
 	LocationType     string    `gorm:"type:varchar(45);not null"`
 	CreatedAt        time.Time `gorm:"type:datetime;not null"`
 	UpdatedAt        time.Time `gorm:"type:datetime;not null"`
@@ -245,25 +265,23 @@ func (u *UserRepo) GetUserByAddress(ctx context.Context, address string) (*biz.U
 	}, nil
 }
 
-// GetConfigByKeys .
+// GetConfigByKeys 配置表整体缓存 .
 func (c *ConfigRepo) GetConfigByKeys(ctx context.Context, keys ...string) ([]*biz.Config, error) {
-	var configs []*Config
-	res := make([]*biz.Config, 0)
-	if err := c.data.db.Where("key_name IN (?)", keys).Table("config").Find(&configs).Error; err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, errors.NotFound("CONFIG_NOT_FOUND", "config not found")
-		}
+	configs, err := c.GetConfigs(ctx)
+	if nil != err {
+		return nil, err
+	}
 
-		return nil, errors.New(500, "Config ERROR", err.Error())
+	keyNames := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		keyNames[key] = true
 	}
 
+	res := make([]*biz.Config, 0)
 	for _, config := range configs {
-		res = append(res, &biz.Config{
-			ID:      config.ID,
-			KeyName: config.KeyName,
-			Name:    config.Name,
-			Value:   config.Value,
-		})
+		if keyNames[config.KeyName] {
+			res = append(res, config)
+		}
 	}
 
 	return res, nil
@@ -271,6 +289,15 @@ func (c *ConfigRepo) GetConfigByKeys(ctx context.Context, keys ...string) ([]*bi
 
 // GetConfigs .
 func (c *ConfigRepo) GetConfigs(ctx context.Context) ([]*biz.Config, error) {
+	res := make([]*biz.Config, 0)
+	if err := c.data.cache.Remember(ctx, cacheConfig, "all", &res, c.getConfigs); nil != err {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *ConfigRepo) getConfigs(ctx context.Context) (interface{}, error) {
 	var configs []*Config
 	res := make([]*biz.Config, 0)
 	if err := c.data.db.Table("config").Find(&configs).Error; err != nil {
@@ -302,12 +329,41 @@ func (c *ConfigRepo) UpdateConfig(ctx context.Context, id int64, value string) (
 	if res.Error != nil {
 		return false, errors.New(500, "UPDATE_USER_INFO_ERROR", "用户信息修改失败")
 	}
+	c.data.forget(ctx, cacheConfig, "all")
 
 	return true, nil
 }
 
 // GetUserById .
 func (u *UserRepo) GetUserById(ctx context.Context, Id int64) (*biz.User, error) {
+	var res *biz.User
+	if err := u.data.cache.Remember(ctx, cacheUser, strconv.FormatInt(Id, 10), &res, func(ctx context.Context) (interface{}, error) {
+		user, err := u.getUserById(ctx, Id)
+		if nil != err {
+			return nil, err
+		}
+
+		// 密码不写入缓存，校验时用 GetUserPassword 读库
+		user.Password = ""
+		return user, nil
+	}); nil != err {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GetUserPassword 不经过缓存 .
+func (u *UserRepo) GetUserPassword(ctx context.Context, userId int64) (string, error) {
+	user, err := u.getUserById(ctx, userId)
+	if nil != err {
+		return "", err
+	}
+
+	return user.Password, nil
+}
+
+func (u *UserRepo) getUserById(ctx context.Context, Id int64) (*biz.User, error) {
 	var user User
 	if err := u.data.db.Where(&User{ID: Id}).Table("user").First(&user).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -317,16 +373,43 @@ func (u *UserRepo) GetUserById(ctx context.Context, Id int64) (*biz.User, error)
 		return nil, errors.New(500, "USER ERROR", err.Error())
 	}
 
-	return &biz.User{
-		ID:       user.ID,
-		Password: user.Password,
-		Address:  user.Address,
-		Undo:     user.Undo,
-	}, nil
+	res := &biz.User{
+		ID:        user.ID,
+		Password:  user.Password,
+		Address:   user.Address,
+		Undo:      user.Undo,
+		CreatedAt: user.CreatedAt,
+		Locale:    user.Locale,
+	}
+	if nil != user.PasswordUpdatedAt {
+		res.PasswordUpdatedAt = *user.PasswordUpdatedAt
+	}
+
+	return res, nil
 }
 
 // GetUserInfoByUserId .
 func (ui *UserInfoRepo) GetUserInfoByUserId(ctx context.Context, userId int64) (*biz.UserInfo, error) {
+	var res *biz.UserInfo
+	if err := ui.data.cache.Remember(ctx, cacheUserInfo, strconv.FormatInt(userId, 10), &res, func(ctx context.Context) (interface{}, error) {
+		return ui.getUserInfoByUserId(ctx, userId)
+	}); nil != err {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// forgetUserInfo 修改用户信息后删除缓存
+func (d *Data) forgetUserInfo(ctx context.Context, userIds ...int64) {
+	keys := make([]string, 0, len(userIds))
+	for _, v := range userIds {
+		keys = append(keys, strconv.FormatInt(v, 10))
+	}
+	d.forget(ctx, cacheUserInfo, keys...)
+}
+
+func (ui *UserInfoRepo) getUserInfoByUserId(ctx context.Context, userId int64) (*biz.UserInfo, error) {
 	var userInfo UserInfo
 	if err := ui.data.db.Where(&UserInfo{UserId: userId}).Table("user_info").First(&userInfo).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -346,6 +429,29 @@ func (ui *UserInfoRepo) GetUserInfoByUserId(ctx context.Context, userId int64) (
 	}, nil
 }
 
+// UpdateUserLocale 为空时清除设置
+func (u *UserRepo) UpdateUserLocale(ctx context.Context, userId int64, locale string) error {
+	res := u.data.DB(ctx).Table("user").Where("id=?", userId).Update("locale", locale)
+	if res.Error != nil {
+		return errors.New(500, "UPDATE_USER_ERROR", "用户语言修改失败")
+	}
+	u.data.forget(ctx, cacheUser, strconv.FormatInt(userId, 10))
+
+	return nil
+}
+
+// UpdateUserPassword 修改密码并记录修改时间
+func (u *UserRepo) UpdateUserPassword(ctx context.Context, userId int64, password string, now time.Time) error {
+	res := u.data.DB(ctx).Table("user").Where("id=?", userId).
+		Updates(map[string]interface{}{"password": password, "password_updated_at": now})
+	if res.Error != nil {
+		return errors.New(500, "UPDATE_USER_ERROR", "用户密码修改失败")
+	}
+	u.data.forget(ctx, cacheUser, strconv.FormatInt(userId, 10))
+
+	return nil
+}
+
 // GetUserByAddresses .
 func (u *UserRepo) GetUserByAddresses(ctx context.Context, Addresses ...string) (map[string]*biz.User, error) {
 	var users []*User
@@ -384,21 +490,10 @@ func (u *UserRepo) GetUserCount(ctx context.Context) (int64, error) {
 // GetUserCountToday .
 func (u *UserRepo) GetUserCountToday(ctx context.Context) (int64, error) {
 	var count int64
-	now := time.Now().UTC()
-	var startDate time.Time
-	var endDate time.Time
-	if 14 <= now.Hour() {
-		startDate = now
-		endDate = now.AddDate(0, 0, 1)
-	} else {
-		startDate = now.AddDate(0, 0, -1)
-		endDate = now
-	}
-	todayStart := time.Date(startDate.Year(), startDate.Month(), startDate.Day(), 16, 0, 0, 0, time.UTC)
-	todayEnd := time.Date(endDate.Year(), endDate.Month(), endDate.Day(), 15, 59, 59, 0, time.UTC)
+	todayStart, todayEnd := u.data.day.Today(time.Now())
 
 	if err := u.data.db.Table("user").
-		Where("created_at>=?", todayStart).Where("created_at<=?", todayEnd).Count(&count).Error; err != nil {
+		Where("created_at>=?", todayStart).Where("created_at<?", todayEnd).Count(&count).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return count, errors.NotFound("USER_NOT_FOUND", "user not found")
 		}
@@ -488,6 +583,7 @@ func (ui *UserInfoRepo) CreateUserInfo(ctx context.Context, u *biz.User) (*biz.U
 	if res.Error != nil {
 		return nil, errors.New(500, "CREATE_USER_INFO_ERROR", "用户信息创建失败")
 	}
+	ui.data.forgetUserInfo(ctx, u.ID)
 
 	return &biz.UserInfo{
 		ID:               userInfo.ID,
@@ -507,6 +603,7 @@ func (ui *UserInfoRepo) UpdateUserInfo(ctx context.Context, u *biz.UserInfo) (*b
 	if res.Error != nil {
 		return nil, errors.New(500, "UPDATE_USER_INFO_ERROR", "用户信息修改失败")
 	}
+	ui.data.forgetUserInfo(ctx, u.UserId)
 
 	return &biz.UserInfo{
 		ID:               userInfo.ID,
@@ -539,8 +636,44 @@ func (ur *UserRecommendRepo) GetUserRecommendsFour(ctx context.Context) ([]*biz.
 	return res, nil
 }
 
+// GetUserRecommends .
+func (ur *UserRecommendRepo) GetUserRecommends(ctx context.Context) ([]*biz.UserRecommend, error) {
+	var userRecommends []*UserRecommend
+	res := make([]*biz.UserRecommend, 0)
+	if err := ur.data.db.Table("user_recommend").Find(&userRecommends).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return res, nil
+		}
+
+		return nil, errors.New(500, "USER RECOMMEND ERROR", err.Error())
+	}
+
+	for _, userRecommend := range userRecommends {
+		res = append(res, &biz.UserRecommend{
+			ID:            userRecommend.ID,
+			UserId:        userRecommend.UserId,
+			RecommendCode: userRecommend.RecommendCode,
+			Total:         userRecommend.Total,
+			CreatedAt:     userRecommend.CreatedAt,
+		})
+	}
+
+	return res, nil
+}
+
 // GetUserRecommendByUserId .
 func (ur *UserRecommendRepo) GetUserRecommendByUserId(ctx context.Context, userId int64) (*biz.UserRecommend, error) {
+	var res *biz.UserRecommend
+	if err := ur.data.cache.Remember(ctx, cacheUserRecommend, strconv.FormatInt(userId, 10), &res, func(ctx context.Context) (interface{}, error) {
+		return ur.getUserRecommendByUserId(ctx, userId)
+	}); nil != err {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (ur *UserRecommendRepo) getUserRecommendByUserId(ctx context.Context, userId int64) (*biz.UserRecommend, error) {
 	var userRecommend UserRecommend
 	if err := ur.data.db.Where(&UserRecommend{UserId: userId}).Table("user_recommend").First(&userRecommend).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -584,6 +717,20 @@ func (ur *UserRecommendRepo) GetUserRecommendByCode(ctx context.Context, code st
 	return res, nil
 }
 
+// InUserRecommendSubtree userId 是否在 ancestorId 的推荐下级中，一次按路径前缀查询 .
+func (ur *UserRecommendRepo) InUserRecommendSubtree(ctx context.Context, ancestorId int64, userId int64) (bool, error) {
+	var count int64
+	if err := ur.data.db.Table("user_recommend d").
+		Joins("JOIN user_recommend a ON a.user_id=?", ancestorId).
+		Where("d.user_id=?", userId).
+		Where("d.recommend_code=CONCAT(a.recommend_code, 'D', a.user_id) or d.recommend_code like CONCAT(a.recommend_code, 'D', a.user_id, 'D%')").
+		Count(&count).Error; err != nil {
+		return false, errors.New(500, "USER RECOMMEND ERROR", err.Error())
+	}
+
+	return 0 < count, nil
+}
+
 // GetUserRecommendLikeCode .
 func (ur *UserRecommendRepo) GetUserRecommendLikeCode(ctx context.Context, code string) ([]*biz.UserRecommend, error) {
 	var userRecommends []*UserRecommend
@@ -624,6 +771,7 @@ func (ur *UserRecommendRepo) CreateUserRecommend(ctx context.Context, u *biz.Use
 	if res.Error != nil {
 		return nil, errors.New(500, "CREATE_USER_RECOMMEND_ERROR", "用户推荐关系创建失败")
 	}
+	ur.data.forget(ctx, cacheUserRecommend, strconv.FormatInt(u.ID, 10))
 
 	return &biz.UserRecommend{
 		ID:            userRecommend.ID,
@@ -819,10 +967,86 @@ func (ur *UserRecommendRepo) UpdateUserRecommend(ctx context.Context, u *biz.Use
 	if res.Error != nil {
 		return false, errors.New(500, "CREATE_USER_RECOMMEND_ERROR", "用户推荐关系修改失败")
 	}
+	ur.data.forget(ctx, cacheUserRecommend, strconv.FormatInt(u.ID, 10))
 
 	return true, nil
 }
 
+// UpdateUserRecommendTree 事务中使用，修改自己的推荐路径并改写全部下级路径的前缀，返回下级数量 .
+func (ur *UserRecommendRepo) UpdateUserRecommendTree(ctx context.Context, userId int64, originCode string, code string) (int64, error) {
+	res := ur.data.DB(ctx).Table("user_recommend").
+		Where("user_id=? and recommend_code=?", userId, originCode).
+		Updates(map[string]interface{}{"recommend_code": code})
+	if 0 == res.RowsAffected || nil != res.Error {
+		return 0, errors.New(500, "UPDATE_USER_RECOMMEND_ERROR", "用户推荐关系修改失败")
+	}
+
+	// 下级路径 originCode+"D"+userId 开头的整体替换为 code+"D"+userId
+	myCode := "D" + strconv.FormatInt(userId, 10)
+	originPrefix := originCode + myCode
+
+	// 修改前查出下级，提交后删除缓存
+	var userIds []int64
+	if err := ur.data.DB(ctx).Table("user_recommend").
+		Where("recommend_code=? or recommend_code like ?", originPrefix, originPrefix+"D%").
+		Pluck("user_id", &userIds).Error; nil != err {
+		return 0, errors.New(500, "UPDATE_USER_RECOMMEND_ERROR", "用户下级推荐关系修改失败")
+	}
+	keys := []string{strconv.FormatInt(userId, 10)}
+	for _, v := range userIds {
+		keys = append(keys, strconv.FormatInt(v, 10))
+	}
+	ur.data.forget(ctx, cacheUserRecommend, keys...)
+
+	res = ur.data.DB(ctx).Table("user_recommend").
+		Where("recommend_code=? or recommend_code like ?", originPrefix, originPrefix+"D%").
+		Updates(map[string]interface{}{"recommend_code": gorm.Expr("CONCAT(?, SUBSTRING(recommend_code, ?))", code+myCode, len(originPrefix)+1)})
+	if nil != res.Error {
+		return 0, errors.New(500, "UPDATE_USER_RECOMMEND_ERROR", "用户下级推荐关系修改失败")
+	}
+
+	return res.RowsAffected, nil
+}
+
+// LockUserRecommendByUserIds 事务中使用，按 user_id 顺序锁定推荐关系，不走缓存 .
+func (ur *UserRecommendRepo) LockUserRecommendByUserIds(ctx context.Context, userIds ...int64) (map[int64]*biz.UserRecommend, error) {
+	var userRecommends []*UserRecommend
+	res := make(map[int64]*biz.UserRecommend, 0)
+	if err := ur.data.DB(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).Table("user_recommend").
+		Where("user_id IN (?)", userIds).Order("user_id asc").Find(&userRecommends).Error; nil != err {
+		return nil, errors.New(500, "USER RECOMMEND ERROR", err.Error())
+	}
+
+	for _, userRecommend := range userRecommends {
+		res[userRecommend.UserId] = &biz.UserRecommend{
+			ID:            userRecommend.ID,
+			UserId:        userRecommend.UserId,
+			RecommendCode: userRecommend.RecommendCode,
+			CreatedAt:     userRecommend.CreatedAt,
+		}
+	}
+
+	return res, nil
+}
+
+// CreateUserRecommendHistory .
+func (ur *UserRecommendRepo) CreateUserRecommendHistory(ctx context.Context, h *biz.UserRecommendHistory) error {
+	var userRecommendHistory UserRecommendHistory
+	userRecommendHistory.UserId = h.UserId
+	userRecommendHistory.OriginRecommendCode = h.OriginRecommendCode
+	userRecommendHistory.RecommendCode = h.RecommendCode
+	userRecommendHistory.OriginRecommendId = h.OriginRecommendId
+	userRecommendHistory.RecommendId = h.RecommendId
+	userRecommendHistory.TeamNum = h.TeamNum
+
+	res := ur.data.DB(ctx).Table("user_recommend_history").Create(&userRecommendHistory)
+	if res.Error != nil {
+		return errors.New(500, "CREATE_USER_RECOMMEND_HISTORY_ERROR", "用户推荐关系修改记录创建失败")
+	}
+
+	return nil
+}
+
 func (ub *UserBalanceRepo) GetEthUserRecordListByUserId(ctx context.Context, userId int64) (map[string]*biz.EthUserRecord, error) {
 	var ethUserRecord []*EthUserRecord
 
@@ -928,24 +1152,24 @@ func (ub UserBalanceRepo) GetUserBalanceLock(ctx context.Context, userId int64)
 }
 
 // Trade .
-func (ub *UserBalanceRepo) Trade(ctx context.Context, userId int64, amount int64, amountB int64, amountRel int64, amountBRel int64, tmpRecommendUserIdsInt []int64, amount2 int64) error {
+func (ub *UserBalanceRepo) Trade(ctx context.Context, userId int64, amount int64, amountB int64, amountRel int64, amountBRel int64, tmpRecommendUserIdsInt []int64, amount2 int64) (int64, error) {
 	var err error
 	if res := ub.data.DB(ctx).Table("user_balance_lock").
 		Where("user_id=? and balance_usdt>=?", userId, amount).
 		Updates(map[string]interface{}{"balance_usdt": gorm.Expr("balance_usdt - ?", amount)}); 0 == res.RowsAffected || nil != res.Error {
-		return errors.NotFound("user balance err", "user balance error")
+		return 0, errors.NotFound("user balance err", "user balance error")
 	}
 
 	if res := ub.data.DB(ctx).Table("user_balance").
 		Where("user_id=? and balance_dhb>=?", userId, amountB-amountBRel).
 		Updates(map[string]interface{}{"balance_dhb": gorm.Expr("balance_dhb - ?", amountB-amountBRel)}); 0 == res.RowsAffected || nil != res.Error {
-		return errors.NotFound("user balance err", "user balance error")
+		return 0, errors.NotFound("user balance err", "user balance error")
 	}
 
 	var userBalance UserBalance
 	err = ub.data.DB(ctx).Where(&UserBalance{UserId: userId}).Table("user_balance").First(&userBalance).Error
 	if err != nil {
-		return err
+		return 0, err
 	}
 
 	var userBalanceRecode UserBalanceRecord
@@ -955,7 +1179,7 @@ func (ub *UserBalanceRepo) Trade(ctx context.Context, userId int64, amount int64
 	userBalanceRecode.Amount = amount
 	err = ub.data.DB(ctx).Table("user_balance_record").Create(&userBalanceRecode).Error
 	if err != nil {
-		return err
+		return 0, err
 	}
 
 	var userBalanceRecode1 UserBalanceRecord
@@ -965,7 +1189,7 @@ func (ub *UserBalanceRepo) Trade(ctx context.Context, userId int64, amount int64
 	userBalanceRecode1.Amount = amount
 	err = ub.data.DB(ctx).Table("user_balance_record").Create(&userBalanceRecode1).Error
 	if err != nil {
-		return err
+		return 0, err
 	}
 
 	var trade Trade
@@ -977,24 +1201,25 @@ func (ub *UserBalanceRepo) Trade(ctx context.Context, userId int64, amount int64
 	trade.Status = "default"
 	err = ub.data.DB(ctx).Table("trade").Create(&trade).Error
 	if err != nil {
-		return err
+		return 0, err
 	}
 
 	if 0 < len(tmpRecommendUserIdsInt) {
 		if err = ub.data.DB(ctx).Table("user_info").
 			Where("user_id in (?)", tmpRecommendUserIdsInt).
 			Updates(map[string]interface{}{"team_csd_balance": gorm.Expr("team_csd_balance - ?", amount-amountRel)}).Error; nil != err {
-			return errors.NotFound("user balance err", "user balance not found")
+			return 0, errors.NotFound("user balance err", "user balance not found")
 		}
+		ub.data.forgetUserInfo(ctx, tmpRecommendUserIdsInt...)
 	}
 
 	if err = ub.data.DB(ctx).Table("user_balance").
 		Where("user_id=?", userId).
 		Updates(map[string]interface{}{"balance_usdt": gorm.Expr("balance_usdt + ?", amountRel)}).Error; nil != err {
-		return errors.NotFound("user balance err", "user balance not found")
+		return 0, errors.NotFound("user balance err", "user balance not found")
 	}
 
-	return nil
+	return trade.ID, nil
 }
 
 // LocationReward .
@@ -1202,6 +1427,9 @@ func (ub *UserBalanceRepo) UpdateWithdrawAmount(ctx context.Context, id int64, s
 		UserId:          withdraw.UserId,
 		Amount:          withdraw.Amount,
 		RelAmount:       withdraw.RelAmount,
+		Fee:             withdraw.Fee,
+		Burn:            withdraw.Burn,
+		Address:         withdraw.Address,
 		BalanceRecordId: withdraw.BalanceRecordId,
 		Status:          withdraw.Status,
 		Type:            withdraw.Type,
@@ -1209,6 +1437,35 @@ func (ub *UserBalanceRepo) UpdateWithdrawAmount(ctx context.Context, id int64, s
 	}, nil
 }
 
+// SubUsdt 扣除 usdt 余额并记录 .
+func (ub *UserBalanceRepo) SubUsdt(ctx context.Context, userId int64, amount int64, recordType string) (int64, error) {
+	var err error
+	if res := ub.data.DB(ctx).Table("user_balance").
+		Where("user_id=? and balance_usdt>=?", userId, amount).
+		Updates(map[string]interface{}{"balance_usdt": gorm.Expr("balance_usdt - ?", amount)}); 0 == res.RowsAffected || nil != res.Error {
+		return 0, errors.NotFound("user balance err", "user balance error")
+	}
+
+	var userBalance UserBalance
+	err = ub.data.DB(ctx).Where(&UserBalance{UserId: userId}).Table("user_balance").First(&userBalance).Error
+	if err != nil {
+		return 0, err
+	}
+
+	var userBalanceRecode UserBalanceRecord
+	userBalanceRecode.Balance = userBalance.BalanceUsdt
+	userBalanceRecode.UserId = userBalance.UserId
+	userBalanceRecode.Type = recordType
+	userBalanceRecode.CoinType = "usdt"
+	userBalanceRecode.Amount = amount
+	err = ub.data.DB(ctx).Table("user_balance_record").Create(&userBalanceRecode).Error
+	if err != nil {
+		return 0, err
+	}
+
+	return userBalanceRecode.ID, nil
+}
+
 // WithdrawUsdt2 .
 func (ub *UserBalanceRepo) WithdrawUsdt2(ctx context.Context, userId int64, amount int64) error {
 	var err error
@@ -1239,18 +1496,10 @@ func (ub *UserBalanceRepo) WithdrawUsdt2(ctx context.Context, userId int64, amou
 }
 
 // Exchange .
-func (ub *UserBalanceRepo) Exchange(ctx context.Context, userId int64, amount int64, amountUsdtSub int64, amountUsdt int64, locationId int64) error {
+func (ub *UserBalanceRepo) Exchange(ctx context.Context, userId int64, amount int64, amountUsdtSub int64, amountUsdt int64) error {
 	var (
 		err error
 	)
-	res := ub.data.DB(ctx).Table("location_new").
-		Where("id=?", locationId).
-		Where("status=?", "running").
-		Updates(map[string]interface{}{"current_max_new": gorm.Expr("current_max_new + ?", amountUsdt)})
-	if 0 == res.RowsAffected || res.Error != nil {
-		return res.Error
-	}
-
 	if res := ub.data.DB(ctx).Table("user_balance").
 		Where("user_id=? and balance_dhb>=?", userId, amount).
 		Updates(map[string]interface{}{"balance_dhb": gorm.Expr("balance_dhb - ?", amount), "balance_usdt": gorm.Expr("balance_usdt + ?", amountUsdtSub)}); 0 == res.RowsAffected || nil != res.Error {
@@ -1336,6 +1585,7 @@ func (ub *UserBalanceRepo) WithdrawUsdt(ctx context.Context, userId int64, amoun
 			Updates(map[string]interface{}{"team_csd_balance": gorm.Expr("team_csd_balance - ?", amount)}).Error; nil != err {
 			return errors.NotFound("user balance err", "user balance not found")
 		}
+		ub.data.forgetUserInfo(ctx, tmpRecommendUserIdsInt...)
 	}
 
 	var userBalance UserBalance
@@ -1379,6 +1629,7 @@ func (ub *UserBalanceRepo) TranUsdt(ctx context.Context, userId int64, toUserId
 			Updates(map[string]interface{}{"team_csd_balance": gorm.Expr("team_csd_balance + ?", amount)}).Error; nil != err {
 			return errors.NotFound("user balance err", "user balance not found")
 		}
+		ub.data.forgetUserInfo(ctx, tmpRecommendUserIdsInt2...)
 	}
 	if 0 < len(tmpRecommendUserIdsInt) {
 		if err = ub.data.DB(ctx).Table("user_info").
@@ -1386,6 +1637,7 @@ func (ub *UserBalanceRepo) TranUsdt(ctx context.Context, userId int64, toUserId
 			Updates(map[string]interface{}{"team_csd_balance": gorm.Expr("team_csd_balance - ?", amount)}).Error; nil != err {
 			return errors.NotFound("user balance err", "user balance not found")
 		}
+		ub.data.forgetUserInfo(ctx, tmpRecommendUserIdsInt...)
 	}
 
 	var userBalance UserBalance
@@ -1517,6 +1769,41 @@ func (ub *UserBalanceRepo) WithdrawDhb(ctx context.Context, userId int64, amount
 	return nil
 }
 
+// RefundWithdraw 提现失败退回余额 .
+func (ub *UserBalanceRepo) RefundWithdraw(ctx context.Context, userId int64, coinType string, amount int64) error {
+	column := "balance_usdt"
+	if "dhb" == coinType {
+		column = "balance_dhb"
+	}
+	if res := ub.data.DB(ctx).Table("user_balance").
+		Where("user_id=?", userId).
+		Updates(map[string]interface{}{column: gorm.Expr(column+" + ?", amount)}); 0 == res.RowsAffected || nil != res.Error {
+		return errors.NotFound("user balance err", "user balance not found")
+	}
+
+	var userBalance UserBalance
+	err := ub.data.DB(ctx).Where(&UserBalance{UserId: userId}).Table("user_balance").First(&userBalance).Error
+	if err != nil {
+		return err
+	}
+
+	var userBalanceRecode UserBalanceRecord
+	userBalanceRecode.Balance = userBalance.BalanceUsdt
+	if "dhb" == coinType {
+		userBalanceRecode.Balance = userBalance.BalanceDhb
+	}
+	userBalanceRecode.UserId = userBalance.UserId
+	userBalanceRecode.Type = "withdraw_refund"
+	userBalanceRecode.CoinType = coinType
+	userBalanceRecode.Amount = amount
+	err = ub.data.DB(ctx).Table("user_balance_record").Create(&userBalanceRecode).Error
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // TranDhb .
 func (ub *UserBalanceRepo) TranDhb(ctx context.Context, userId int64, toUserId int64, amount int64) error {
 	var err error
@@ -1563,11 +1850,14 @@ func (ub *UserBalanceRepo) TranDhb(ctx context.Context, userId int64, toUserId i
 }
 
 // GreateWithdraw .
-func (ub *UserBalanceRepo) GreateWithdraw(ctx context.Context, userId int64, relAmount int64, amount int64, amountFee int64, coinType string) (*biz.Withdraw, error) {
+func (ub *UserBalanceRepo) GreateWithdraw(ctx context.Context, userId int64, relAmount int64, amount int64, fee int64, burn int64, coinType string, address string) (*biz.Withdraw, error) {
 	var withdraw Withdraw
 	withdraw.UserId = userId
+	withdraw.Address = address
 	withdraw.Amount = amount
 	withdraw.RelAmount = relAmount
+	withdraw.Fee = fee
+	withdraw.Burn = burn
 	withdraw.Type = coinType
 	withdraw.Status = "rewarded"
 	res := ub.data.DB(ctx).Table("withdraw").Create(&withdraw)
@@ -1596,6 +1886,9 @@ func (ub *UserBalanceRepo) GreateWithdraw(ctx context.Context, userId int64, rel
 		UserId:          withdraw.UserId,
 		Amount:          withdraw.Amount,
 		RelAmount:       withdraw.RelAmount,
+		Fee:             withdraw.Fee,
+		Burn:            withdraw.Burn,
+		Address:         withdraw.Address,
 		BalanceRecordId: withdraw.BalanceRecordId,
 		Status:          withdraw.Status,
 		Type:            withdraw.Type,
@@ -1603,6 +1896,17 @@ func (ub *UserBalanceRepo) GreateWithdraw(ctx context.Context, userId int64, rel
 	}, nil
 }
 
+// UpdateWithdrawFrom 当前状态在 from 中时修改，返回是否修改成功
+func (ub *UserBalanceRepo) UpdateWithdrawFrom(ctx context.Context, id int64, from []string, status string) (bool, error) {
+	res := ub.data.DB(ctx).Table("withdraw").Where("id=? and status in (?)", id, from).
+		Updates(map[string]interface{}{"status": status, "updated_at": time.Now()})
+	if res.Error != nil {
+		return false, errors.New(500, "UPDATE_WITHDRAW_ERROR", "提现记录修改失败")
+	}
+
+	return 1 == res.RowsAffected, nil
+}
+
 // UpdateWithdraw .
 func (ub *UserBalanceRepo) UpdateWithdraw(ctx context.Context, id int64, status string) (*biz.Withdraw, error) {
 	var withdraw Withdraw
@@ -1617,6 +1921,9 @@ func (ub *UserBalanceRepo) UpdateWithdraw(ctx context.Context, id int64, status
 		UserId:          withdraw.UserId,
 		Amount:          withdraw.Amount,
 		RelAmount:       withdraw.RelAmount,
+		Fee:             withdraw.Fee,
+		Burn:            withdraw.Burn,
+		Address:         withdraw.Address,
 		BalanceRecordId: withdraw.BalanceRecordId,
 		Status:          withdraw.Status,
 		Type:            withdraw.Type,
@@ -1642,6 +1949,9 @@ func (ub *UserBalanceRepo) GetWithdrawByUserId2(ctx context.Context, userId int6
 			UserId:          withdraw.UserId,
 			Amount:          withdraw.Amount,
 			RelAmount:       withdraw.RelAmount,
+			Fee:             withdraw.Fee,
+			Burn:            withdraw.Burn,
+			Address:         withdraw.Address,
 			BalanceRecordId: withdraw.BalanceRecordId,
 			Status:          withdraw.Status,
 			Type:            withdraw.Type,
@@ -1669,6 +1979,9 @@ func (ub *UserBalanceRepo) GetWithdrawByUserId(ctx context.Context, userId int64
 			UserId:          withdraw.UserId,
 			Amount:          withdraw.Amount,
 			RelAmount:       withdraw.RelAmount,
+			Fee:             withdraw.Fee,
+			Burn:            withdraw.Burn,
+			Address:         withdraw.Address,
 			BalanceRecordId: withdraw.BalanceRecordId,
 			Status:          withdraw.Status,
 			Type:            withdraw.Type,
@@ -1770,16 +2083,58 @@ func (ub *UserBalanceRepo) GetBalanceRewardByUserId(ctx context.Context, userId
 
 	for _, balanceReward := range balanceRewards {
 		res = append(res, &biz.BalanceReward{
-			ID:      balanceReward.ID,
-			UserId:  balanceReward.UserId,
-			Status:  balanceReward.Status,
-			Amount:  balanceReward.Amount,
-			SetDate: balanceReward.SetDate,
+			ID:             balanceReward.ID,
+			UserId:         balanceReward.UserId,
+			Status:         balanceReward.Status,
+			Amount:         balanceReward.Amount,
+			SetDate:        balanceReward.SetDate,
+			LastRewardDate: balanceReward.LastRewardDate,
 		})
 	}
 	return res, nil
 }
 
+// GetBalanceRewards 全部计息中的理财 .
+func (ub *UserBalanceRepo) GetBalanceRewards(ctx context.Context) ([]*biz.BalanceReward, error) {
+	var balanceRewards []*BalanceReward
+	res := make([]*biz.BalanceReward, 0)
+	if err := ub.data.db.Where("status=?", 1).Order("id asc").Table("balance_reward").Find(&balanceRewards).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return res, nil
+		}
+
+		return nil, errors.New(500, "BALANCE REWARD ERROR", err.Error())
+	}
+
+	for _, balanceReward := range balanceRewards {
+		res = append(res, &biz.BalanceReward{
+			ID:             balanceReward.ID,
+			UserId:         balanceReward.UserId,
+			Status:         balanceReward.Status,
+			Amount:         balanceReward.Amount,
+			SetDate:        balanceReward.SetDate,
+			LastRewardDate: balanceReward.LastRewardDate,
+		})
+	}
+	return res, nil
+}
+
+// UpdateBalanceRewardLastRewardDate 事务中使用，计息时间已被修改时失败 .
+func (ub *UserBalanceRepo) UpdateBalanceRewardLastRewardDate(ctx context.Context, id int64, lastRewardDate time.Time, newLastRewardDate time.Time) error {
+	res := ub.data.DB(ctx).Table("balance_reward").
+		Where("id=? and status=?", id, 1).
+		Where("last_reward_date=?", lastRewardDate).
+		Updates(map[string]interface{}{"last_reward_date": newLastRewardDate})
+	if nil != res.Error {
+		return errors.New(500, "BALANCE REWARD ERROR", res.Error.Error())
+	}
+	if 0 == res.RowsAffected {
+		return errors.New(500, "BALANCE_REWARD_ERROR", "理财已计息")
+	}
+
+	return nil
+}
+
 // GetWithdrawNotDeal .
 func (ub *UserBalanceRepo) GetWithdrawNotDeal(ctx context.Context) ([]*biz.Withdraw, error) {
 	var withdraws []*Withdraw
@@ -1798,6 +2153,9 @@ func (ub *UserBalanceRepo) GetWithdrawNotDeal(ctx context.Context) ([]*biz.Withd
 			UserId:          withdraw.UserId,
 			Amount:          withdraw.Amount,
 			RelAmount:       withdraw.RelAmount,
+			Fee:             withdraw.Fee,
+			Burn:            withdraw.Burn,
+			Address:         withdraw.Address,
 			BalanceRecordId: withdraw.BalanceRecordId,
 			Status:          withdraw.Status,
 			Type:            withdraw.Type,
@@ -1821,6 +2179,9 @@ func (ub *UserBalanceRepo) GetWithdrawById(ctx context.Context, id int64) (*biz.
 		UserId:          withdraw.UserId,
 		Amount:          withdraw.Amount,
 		RelAmount:       withdraw.RelAmount,
+		Fee:             withdraw.Fee,
+		Burn:            withdraw.Burn,
+		Address:         withdraw.Address,
 		BalanceRecordId: withdraw.BalanceRecordId,
 		Status:          withdraw.Status,
 		Type:            withdraw.Type,
@@ -1857,6 +2218,9 @@ func (ub *UserBalanceRepo) GetWithdraws(ctx context.Context, b *biz.Pagination,
 			UserId:          withdraw.UserId,
 			Amount:          withdraw.Amount,
 			RelAmount:       withdraw.RelAmount,
+			Fee:             withdraw.Fee,
+			Burn:            withdraw.Burn,
+			Address:         withdraw.Address,
 			BalanceRecordId: withdraw.BalanceRecordId,
 			Status:          withdraw.Status,
 			Type:            withdraw.Type,
@@ -1884,6 +2248,9 @@ func (ub *UserBalanceRepo) GetWithdrawPassOrRewarded(ctx context.Context) ([]*bi
 			UserId:          withdraw.UserId,
 			Amount:          withdraw.Amount,
 			RelAmount:       withdraw.RelAmount,
+			Fee:             withdraw.Fee,
+			Burn:            withdraw.Burn,
+			Address:         withdraw.Address,
 			BalanceRecordId: withdraw.BalanceRecordId,
 			Status:          withdraw.Status,
 			Type:            withdraw.Type,
@@ -1977,18 +2344,7 @@ func (ub *UserBalanceRepo) SystemWithdrawReward(ctx context.Context, amount int6
 func (ub *UserBalanceRepo) GetSystemYesterdayDailyReward(ctx context.Context) (*biz.Reward, error) {
 	var reward Reward
 
-	now := time.Now().UTC()
-	var startDate time.Time
-	var endDate time.Time
-	if 14 <= now.Hour() {
-		startDate = now
-		endDate = now.AddDate(0, 0, 1)
-	} else {
-		startDate = now.AddDate(0, 0, -1)
-		endDate = now
-	}
-	todayStart := time.Date(startDate.Year(), startDate.Month(), startDate.Day(), 14, 0, 0, 0, time.UTC)
-	todayEnd := time.Date(endDate.Year(), endDate.Month(), endDate.Day(), 14, 0, 0, 0, time.UTC)
+	todayStart, todayEnd := ub.data.day.Today(time.Now())
 
 	if err := ub.data.db.
 		Where("user_id=?", 999999999).
@@ -2012,6 +2368,7 @@ func (ub *UserBalanceRepo) GetSystemYesterdayDailyReward(ctx context.Context) (*
 		TypeRecordId:     reward.TypeRecordId,
 		Reason:           reward.Reason,
 		ReasonLocationId: reward.ReasonLocationId,
+		SourceUserId:     reward.SourceUserId,
 		LocationType:     reward.LocationType,
 	}, nil
 }
@@ -2195,6 +2552,81 @@ func (ub *UserBalanceRepo) NormalWithdrawRecommendReward(ctx context.Context, us
 	return userBalanceRecode.ID, nil
 }
 
+// CommissionReward .
+func (ub *UserBalanceRepo) CommissionReward(ctx context.Context, userId int64, amount int64, eventType string, recordId int64, sourceUserId int64, reason string) (int64, error) {
+	var err error
+	if res := ub.data.DB(ctx).Table("user_balance").
+		Where("user_id=?", userId).
+		Updates(map[string]interface{}{"balance_usdt": gorm.Expr("balance_usdt + ?", amount)}); 0 == res.RowsAffected || nil != res.Error {
+		return 0, errors.NotFound("user balance err", "user balance not found")
+	}
+
+	var userBalance UserBalance
+	err = ub.data.DB(ctx).Where(&UserBalance{UserId: userId}).Table("user_balance").First(&userBalance).Error
+	if err != nil {
+		return 0, err
+	}
+
+	var userBalanceRecode UserBalanceRecord
+	userBalanceRecode.Balance = userBalance.BalanceUsdt
+	userBalanceRecode.UserId = userBalance.UserId
+	userBalanceRecode.Type = "reward"
+	userBalanceRecode.CoinType = "usdt"
+	userBalanceRecode.Amount = amount
+	err = ub.data.DB(ctx).Table("user_balance_record").Create(&userBalanceRecode).Error
+	if err != nil {
+		return 0, err
+	}
+
+	var reward Reward
+	reward.UserId = userBalance.UserId
+	reward.Amount = amount
+	reward.BalanceRecordId = userBalanceRecode.ID
+	reward.Type = eventType // 本次分红的行为类型
+	reward.TypeRecordId = recordId
+	reward.Reason = reason // 给我分红的理由
+	reward.SourceUserId = sourceUserId
+	err = ub.data.DB(ctx).Table("reward").Create(&reward).Error
+	if err != nil {
+		return 0, err
+	}
+
+	return userBalanceRecode.ID, nil
+}
+
+// ForfeitReward 未发放的收益，只写 amount 为 0 的分红记录，amount_b 记录未发放金额 .
+func (ub *UserBalanceRepo) ForfeitReward(ctx context.Context, userId int64, forfeited int64, eventType string, recordId int64, sourceUserId int64, reason string) error {
+	var reward Reward
+	reward.UserId = userId
+	reward.AmountB = forfeited
+	reward.Type = eventType
+	reward.TypeRecordId = recordId
+	reward.Reason = reason
+	reward.SourceUserId = sourceUserId
+	if err := ub.data.DB(ctx).Table("reward").Create(&reward).Error; nil != err {
+		return errors.New(500, "REWARD ERROR", err.Error())
+	}
+
+	return nil
+}
+
+// GetUserRewardTotalByType .
+func (ub *UserBalanceRepo) GetUserRewardTotalByType(ctx context.Context, userId int64, rewardType string) (int64, error) {
+	var total UserBalanceTotal
+	if err := ub.data.db.Table("reward").
+		Where("user_id=?", userId).
+		Where("type=?", rewardType).
+		Select("sum(amount) as total").Take(&total).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return 0, nil
+		}
+
+		return 0, errors.New(500, "REWARD ERROR", err.Error())
+	}
+
+	return total.Total, nil
+}
+
 // GetUserCurrentMonthRecommendByUserId .
 func (uc *UserCurrentMonthRecommendRepo) GetUserCurrentMonthRecommendByUserId(ctx context.Context, userId int64) ([]*biz.UserCurrentMonthRecommend, error) {
 	var userCurrentMonthRecommends []*UserCurrentMonthRecommend
@@ -2273,6 +2705,7 @@ func (ub *UserBalanceRepo) GetUserRewardByUserId(ctx context.Context, userId int
 			TypeRecordId:     reward.TypeRecordId,
 			Reason:           reward.Reason,
 			ReasonLocationId: reward.ReasonLocationId,
+			SourceUserId:     reward.SourceUserId,
 			LocationType:     reward.LocationType,
 			CreatedAt:        reward.CreatedAt,
 			AmountB:          reward.AmountB,
@@ -2312,19 +2745,7 @@ func (ub *UserBalanceRepo) GetUserRewardByUserIds(ctx context.Context, userIds .
 func (ub *UserBalanceRepo) GetUserRewardTodayTotalByUserId(ctx context.Context, userId int64) (*biz.UserSortRecommendReward, error) {
 	var total *UserSortRecommendReward
 
-	now := time.Now().UTC()
-	var startDate time.Time
-	var endDate time.Time
-	if 14 <= now.Hour() {
-		startDate = now
-		endDate = now.AddDate(0, 0, 1)
-	} else {
-		startDate = now.AddDate(0, 0, -1)
-		endDate = now
-	}
-
-	todayStart := time.Date(startDate.Year(), startDate.Month(), startDate.Day(), 14, 0, 0, 0, time.UTC)
-	todayEnd := time.Date(endDate.Year(), endDate.Month(), endDate.Day(), 14, 0, 0, 0, time.UTC)
+	todayStart, todayEnd := ub.data.day.Today(time.Now())
 
 	if err := ub.data.db.Table("reward").
 		Where("user_id=?", userId).
@@ -2376,6 +2797,7 @@ func (ub *UserBalanceRepo) GetUserRewards(ctx context.Context, b *biz.Pagination
 			TypeRecordId:     reward.TypeRecordId,
 			Reason:           reward.Reason,
 			ReasonLocationId: reward.ReasonLocationId,
+			SourceUserId:     reward.SourceUserId,
 			LocationType:     reward.LocationType,
 			CreatedAt:        reward.CreatedAt,
 		})
@@ -2392,14 +2814,10 @@ func (ub *UserBalanceRepo) GetUserRewardsLastMonthFee(ctx context.Context) ([]*b
 
 	instance := ub.data.db.Table("reward")
 
-	now := time.Now().UTC().Add(8 * time.Hour)
-	lastMonthFirstDay := now.AddDate(0, -1, -now.Day()+1)
-	lastMonthStart := time.Date(lastMonthFirstDay.Year(), lastMonthFirstDay.Month(), lastMonthFirstDay.Day(), 0, 0, 0, 0, time.UTC)
-	lastMonthEndDay := lastMonthFirstDay.AddDate(0, 1, -1)
-	lastMonthEnd := time.Date(lastMonthEndDay.Year(), lastMonthEndDay.Month(), lastMonthEndDay.Day(), 23, 59, 59, 0, time.UTC)
+	lastMonthStart, lastMonthEnd := ub.data.day.LastMonth(time.Now())
 
 	if err := instance.Where("created_at>=?", lastMonthStart).
-		Where("created_at<=?", lastMonthEnd).
+		Where("created_at<?", lastMonthEnd).
 		Where("reason=?", "system_fee").
 		Find(&rewards).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -2419,6 +2837,7 @@ func (ub *UserBalanceRepo) GetUserRewardsLastMonthFee(ctx context.Context) ([]*b
 			TypeRecordId:     reward.TypeRecordId,
 			Reason:           reward.Reason,
 			ReasonLocationId: reward.ReasonLocationId,
+			SourceUserId:     reward.SourceUserId,
 			LocationType:     reward.LocationType,
 			CreatedAt:        reward.CreatedAt,
 		})
@@ -2527,26 +2946,43 @@ func (ub UserBalanceRepo) GetUserBalanceRecordUserUsdtTotal(ctx context.Context,
 	return total.Total, nil
 }
 
+// GetUserBalanceRecordUserUsdtTotalByUserIds .
+func (ub UserBalanceRepo) GetUserBalanceRecordUserUsdtTotalByUserIds(ctx context.Context, userIds ...int64) (map[int64]int64, error) {
+	var total []*UserSortRecommendReward
+	res := make(map[int64]int64, 0)
+	if 0 >= len(userIds) {
+		return res, nil
+	}
+
+	if err := ub.data.db.Table("user_balance_record").
+		Where("user_id IN (?)", userIds).
+		Where("type=?", "deposit").
+		Where("coin_type=?", "usdt").
+		Group("user_id").
+		Select("sum(amount) as total, user_id").Find(&total).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return res, nil
+		}
+
+		return nil, errors.New(500, "USER BALANCE RECORD ERROR", err.Error())
+	}
+
+	for _, v := range total {
+		res[v.UserId] = v.Total
+	}
+
+	return res, nil
+}
+
 // GetLocationsToday .
 func (ub UserBalanceRepo) GetLocationsToday(ctx context.Context) ([]*biz.LocationNew, error) {
 	var locations []*LocationNew
 
-	now := time.Now().UTC()
-	var startDate time.Time
-	var endDate time.Time
-	if 14 <= now.Hour() {
-		startDate = now
-		endDate = now.AddDate(0, 0, 1)
-	} else {
-		startDate = now.AddDate(0, 0, -1)
-		endDate = now
-	}
-	todayStart := time.Date(startDate.Year(), startDate.Month(), startDate.Day(), 16, 0, 0, 0, time.UTC)
-	todayEnd := time.Date(endDate.Year(), endDate.Month(), endDate.Day(), 15, 59, 59, 0, time.UTC)
+	todayStart, todayEnd := ub.data.day.Today(time.Now())
 
 	res := make([]*biz.LocationNew, 0)
 	if err := ub.data.db.Table("location_new").
-		Where("created_at>=?", todayStart).Where("created_at<=?", todayEnd).
+		Where("created_at>=?", todayStart).Where("created_at<?", todayEnd).
 		Order("id desc").Find(&locations).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return res, nil
@@ -2581,23 +3017,12 @@ func (ub UserBalanceRepo) GetLocationsToday(ctx context.Context) ([]*biz.Locatio
 func (ub UserBalanceRepo) GetUserBalanceRecordUsdtTotalToday(ctx context.Context) (int64, error) {
 	var total UserBalanceTotal
 
-	now := time.Now().UTC()
-	var startDate time.Time
-	var endDate time.Time
-	if 14 <= now.Hour() {
-		startDate = now
-		endDate = now.AddDate(0, 0, 1)
-	} else {
-		startDate = now.AddDate(0, 0, -1)
-		endDate = now
-	}
-	todayStart := time.Date(startDate.Year(), startDate.Month(), startDate.Day(), 16, 0, 0, 0, time.UTC)
-	todayEnd := time.Date(endDate.Year(), endDate.Month(), endDate.Day(), 15, 59, 59, 0, time.UTC)
+	todayStart, todayEnd := ub.data.day.Today(time.Now())
 
 	if err := ub.data.db.Table("user_balance_record").
 		Where("type=?", "deposit").
 		Where("coin_type=?", "usdt").
-		Where("created_at>=?", todayStart).Where("created_at<=?", todayEnd).
+		Where("created_at>=?", todayStart).Where("created_at<?", todayEnd).
 		Select("sum(amount) as total").Take(&total).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return total.Total, errors.NotFound("USER_BALANCE_RECORD_NOT_FOUND", "user balance not found")
@@ -2614,18 +3039,7 @@ func (ub *UserBalanceRepo) GetUserRewardRecommendSort(ctx context.Context) ([]*b
 	var total []*UserSortRecommendReward
 	res := make([]*biz.UserSortRecommendReward, 0)
 
-	now := time.Now().UTC()
-	var startDate time.Time
-	var endDate time.Time
-	if 14 <= now.Hour() {
-		startDate = now
-		endDate = now.AddDate(0, 0, 1)
-	} else {
-		startDate = now.AddDate(0, 0, -1)
-		endDate = now
-	}
-	todayStart := time.Date(startDate.Year(), startDate.Month(), startDate.Day(), 14, 0, 0, 0, time.UTC)
-	todayEnd := time.Date(endDate.Year(), endDate.Month(), endDate.Day(), 14, 0, 0, 0, time.UTC)
+	todayStart, todayEnd := ub.data.day.Today(time.Now())
 
 	if err := ub.data.db.Table("reward").
 		Where("type=?", "location").
@@ -2655,23 +3069,17 @@ func (ub *UserBalanceRepo) GetUserRewardRecommendSort(ctx context.Context) ([]*b
 
 // GetUserWithdrawUsdtTotalToday .
 func (ub UserBalanceRepo) GetUserWithdrawUsdtTotalToday(ctx context.Context) (int64, error) {
+	return ub.GetUserWithdrawTotalTodayByCoinType(ctx, "usdt")
+}
+
+// GetUserWithdrawTotalTodayByCoinType 全平台今日提现 .
+func (ub UserBalanceRepo) GetUserWithdrawTotalTodayByCoinType(ctx context.Context, coinType string) (int64, error) {
 	var total UserBalanceTotal
-	now := time.Now().UTC()
-	var startDate time.Time
-	var endDate time.Time
-	if 14 <= now.Hour() {
-		startDate = now
-		endDate = now.AddDate(0, 0, 1)
-	} else {
-		startDate = now.AddDate(0, 0, -1)
-		endDate = now
-	}
-	todayStart := time.Date(startDate.Year(), startDate.Month(), startDate.Day(), 16, 0, 0, 0, time.UTC)
-	todayEnd := time.Date(endDate.Year(), endDate.Month(), endDate.Day(), 15, 59, 59, 0, time.UTC)
+	todayStart, todayEnd := ub.data.day.Today(time.Now())
 	if err := ub.data.db.Table("user_balance_record").
 		Where("type=?", "withdraw").
-		Where("coin_type=?", "usdt").
-		Where("created_at>=?", todayStart).Where("created_at<=?", todayEnd).
+		Where("coin_type=?", coinType).
+		Where("created_at>=?", todayStart).Where("created_at<?", todayEnd).
 		Select("sum(amount) as total").Take(&total).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return total.Total, errors.NotFound("USER_BALANCE_RECORD_NOT_FOUND", "user balance not found")
@@ -2784,17 +3192,13 @@ func (uc *UserCurrentMonthRecommendRepo) GetUserLastMonthRecommend(ctx context.C
 	var userCurrentMonthRecommends []*UserCurrentMonthRecommend
 	res := make([]int64, 0)
 
-	now := time.Now().UTC().Add(8 * time.Hour)
-	lastMonthFirstDay := now.AddDate(0, -1, -now.Day()+1)
-	lastMonthStart := time.Date(lastMonthFirstDay.Year(), lastMonthFirstDay.Month(), lastMonthFirstDay.Day(), 0, 0, 0, 0, time.UTC)
-	lastMonthEndDay := lastMonthFirstDay.AddDate(0, 1, -1)
-	lastMonthEnd := time.Date(lastMonthEndDay.Year(), lastMonthEndDay.Month(), lastMonthEndDay.Day(), 23, 59, 59, 0, time.UTC)
+	lastMonthStart, lastMonthEnd := uc.data.day.LastMonth(time.Now())
 
 	if err := uc.data.db.Table("user_current_month_recommend").
 		Group("user_id").
 		Having("count(id) >= 5").
 		Where("date>=?", lastMonthStart).
-		Where("date<=?", lastMonthEnd).
+		Where("date<?", lastMonthEnd).
 		Find(&userCurrentMonthRecommends).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return res, errors.NotFound("USER_CURRENT_MONTH_RECOMMEND_NOT_FOUND", "user current month recommend not found")
@@ -0,0 +1,257 @@
+package data
+
+import (
+	"context"
+	"dhb/app/app/internal/biz"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"strings"
+	"time"
+)
+
+type WebhookSubscriber struct {
+	ID        int64     `gorm:"primarykey;type:int"`
+	Url       string    `gorm:"type:varchar(200);not null"`
+	Secret    string    `gorm:"type:varchar(100);not null"`
+	Events    string    `gorm:"type:varchar(500);not null"`
+	Status    string    `gorm:"type:varchar(45);not null"`
+	CreatedAt time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt time.Time `gorm:"type:datetime;not null"`
+}
+
+type WebhookDelivery struct {
+	ID           int64     `gorm:"primarykey;type:int"`
+	EventId      int64     `gorm:"type:int;not null;uniqueIndex:idx_webhook_delivery_event_subscriber,priority:1"`
+	SubscriberId int64     `gorm:"type:int;not null;uniqueIndex:idx_webhook_delivery_event_subscriber,priority:2;index"`
+	Status       string    `gorm:"type:varchar(45);not null;index:idx_webhook_delivery_status_next,priority:1"`
+	Attempts     int64     `gorm:"type:int;not null"`
+	NextAt       time.Time `gorm:"type:datetime;not null;index:idx_webhook_delivery_status_next,priority:2"`
+	ResponseCode int64     `gorm:"type:int;not null"`
+	LastError    string    `gorm:"type:varchar(500);not null"`
+	CreatedAt    time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt    time.Time `gorm:"type:datetime;not null"`
+}
+
+type WebhookRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewWebhookRepo(data *Data, logger log.Logger) biz.WebhookRepo {
+	return &WebhookRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (ws *WebhookSubscriber) toBiz() *biz.WebhookSubscriber {
+	res := &biz.WebhookSubscriber{
+		ID:        ws.ID,
+		Url:       ws.Url,
+		Secret:    ws.Secret,
+		Events:    make([]string, 0),
+		Status:    ws.Status,
+		CreatedAt: ws.CreatedAt,
+	}
+	if "" != ws.Events {
+		res.Events = strings.Split(ws.Events, ",")
+	}
+
+	return res
+}
+
+func (wd *WebhookDelivery) toBiz() *biz.WebhookDelivery {
+	return &biz.WebhookDelivery{
+		ID:           wd.ID,
+		EventId:      wd.EventId,
+		SubscriberId: wd.SubscriberId,
+		Status:       wd.Status,
+		Attempts:     wd.Attempts,
+		NextAt:       wd.NextAt,
+		ResponseCode: wd.ResponseCode,
+		LastError:    wd.LastError,
+		CreatedAt:    wd.CreatedAt,
+		UpdatedAt:    wd.UpdatedAt,
+	}
+}
+
+// CreateWebhookSubscriber .
+func (w *WebhookRepo) CreateWebhookSubscriber(ctx context.Context, s *biz.WebhookSubscriber) (*biz.WebhookSubscriber, error) {
+	var subscriber WebhookSubscriber
+	subscriber.Url = s.Url
+	subscriber.Secret = s.Secret
+	subscriber.Events = strings.Join(s.Events, ",")
+	subscriber.Status = s.Status
+
+	if res := w.data.DB(ctx).Table("webhook_subscriber").Create(&subscriber); res.Error != nil {
+		return nil, errors.New(500, "CREATE_WEBHOOK_ERROR", "订阅创建失败")
+	}
+
+	return subscriber.toBiz(), nil
+}
+
+// UpdateWebhookSubscriber 修改订阅事件和状态 .
+func (w *WebhookRepo) UpdateWebhookSubscriber(ctx context.Context, s *biz.WebhookSubscriber) error {
+	if res := w.data.DB(ctx).Table("webhook_subscriber").
+		Where("id=?", s.ID).
+		Updates(map[string]interface{}{"events": strings.Join(s.Events, ","), "status": s.Status, "updated_at": time.Now()}); res.Error != nil {
+		return errors.New(500, "UPDATE_WEBHOOK_ERROR", "订阅修改失败")
+	}
+
+	return nil
+}
+
+// GetWebhookSubscribers .
+func (w *WebhookRepo) GetWebhookSubscribers(ctx context.Context) ([]*biz.WebhookSubscriber, error) {
+	var subscribers []*WebhookSubscriber
+	if err := w.data.db.Table("webhook_subscriber").Order("id asc").Find(&subscribers).Error; err != nil {
+		return nil, errors.New(500, "WEBHOOK ERROR", err.Error())
+	}
+
+	res := make([]*biz.WebhookSubscriber, 0)
+	for _, v := range subscribers {
+		res = append(res, v.toBiz())
+	}
+
+	return res, nil
+}
+
+// GetWebhookSubscriberById 没有返回 nil .
+func (w *WebhookRepo) GetWebhookSubscriberById(ctx context.Context, id int64) (*biz.WebhookSubscriber, error) {
+	var subscriber WebhookSubscriber
+	if err := w.data.db.Table("webhook_subscriber").Where("id=?", id).First(&subscriber).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, errors.New(500, "WEBHOOK ERROR", err.Error())
+	}
+
+	return subscriber.toBiz(), nil
+}
+
+// CreateWebhookDeliveries 已存在的跳过 .
+func (w *WebhookRepo) CreateWebhookDeliveries(ctx context.Context, deliveries []*biz.WebhookDelivery) error {
+	if 0 >= len(deliveries) {
+		return nil
+	}
+
+	rows := make([]*WebhookDelivery, 0, len(deliveries))
+	for _, v := range deliveries {
+		rows = append(rows, &WebhookDelivery{
+			EventId:      v.EventId,
+			SubscriberId: v.SubscriberId,
+			Status:       v.Status,
+			NextAt:       v.NextAt,
+		})
+	}
+
+	if res := w.data.DB(ctx).Table("webhook_delivery").Clauses(clause.OnConflict{DoNothing: true}).Create(&rows); res.Error != nil {
+		return errors.New(500, "CREATE_WEBHOOK_DELIVERY_ERROR", "投递记录创建失败")
+	}
+
+	return nil
+}
+
+// ResetWebhookDeliveries 事件的投递记录全部重新投递 .
+func (w *WebhookRepo) ResetWebhookDeliveries(ctx context.Context, eventId int64) (int64, error) {
+	res := w.data.DB(ctx).Table("webhook_delivery").
+		Where("event_id=?", eventId).
+		Updates(map[string]interface{}{"status": biz.WebhookDeliveryPending, "attempts": 0, "next_at": time.Now().UTC(), "updated_at": time.Now()})
+	if res.Error != nil {
+		return 0, errors.New(500, "UPDATE_WEBHOOK_DELIVERY_ERROR", "投递记录修改失败")
+	}
+
+	return res.RowsAffected, nil
+}
+
+// GetWebhookDeliveriesDue 到期待投递的记录 .
+func (w *WebhookRepo) GetWebhookDeliveriesDue(ctx context.Context, now time.Time, limit int) ([]*biz.WebhookDelivery, error) {
+	var deliveries []*WebhookDelivery
+	if err := w.data.db.Table("webhook_delivery").
+		Where("status=?", biz.WebhookDeliveryPending).
+		Where("next_at<=?", now).
+		Order("next_at asc").Order("id asc").
+		Limit(limit).
+		Find(&deliveries).Error; err != nil {
+		return nil, errors.New(500, "WEBHOOK DELIVERY ERROR", err.Error())
+	}
+
+	res := make([]*biz.WebhookDelivery, 0)
+	for _, v := range deliveries {
+		res = append(res, v.toBiz())
+	}
+
+	return res, nil
+}
+
+// GetWebhookDeliveryById 没有返回 nil .
+func (w *WebhookRepo) GetWebhookDeliveryById(ctx context.Context, id int64) (*biz.WebhookDelivery, error) {
+	var delivery WebhookDelivery
+	if err := w.data.db.Table("webhook_delivery").Where("id=?", id).First(&delivery).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, errors.New(500, "WEBHOOK DELIVERY ERROR", err.Error())
+	}
+
+	return delivery.toBiz(), nil
+}
+
+// GetWebhookDeliveries .
+func (w *WebhookRepo) GetWebhookDeliveries(ctx context.Context, status string, subscriberId int64, b *biz.Pagination) ([]*biz.WebhookDelivery, error, int64) {
+	var (
+		deliveries []*WebhookDelivery
+		count      int64
+	)
+	res := make([]*biz.WebhookDelivery, 0)
+
+	instance := w.data.db.Table("webhook_delivery")
+	if "" != status {
+		instance = instance.Where("status=?", status)
+	}
+	if 0 < subscriberId {
+		instance = instance.Where("subscriber_id=?", subscriberId)
+	}
+
+	instance = instance.Count(&count)
+	if err := instance.Scopes(Paginate(b.PageNum, b.PageSize)).Order("id desc").Find(&deliveries).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return res, nil, 0
+		}
+
+		return nil, errors.New(500, "WEBHOOK DELIVERY ERROR", err.Error()), 0
+	}
+
+	for _, v := range deliveries {
+		res = append(res, v.toBiz())
+	}
+
+	return res, nil, count
+}
+
+// UpdateWebhookDelivery 记录投递结果 .
+func (w *WebhookRepo) UpdateWebhookDelivery(ctx context.Context, d *biz.WebhookDelivery) error {
+	lastError := d.LastError
+	if 500 < len(lastError) {
+		lastError = lastError[:500]
+	}
+
+	if res := w.data.DB(ctx).Table("webhook_delivery").
+		Where("id=?", d.ID).
+		Updates(map[string]interface{}{
+			"status":        d.Status,
+			"attempts":      d.Attempts,
+			"next_at":       d.NextAt,
+			"response_code": d.ResponseCode,
+			"last_error":    lastError,
+			"updated_at":    time.Now(),
+		}); res.Error != nil {
+		return errors.New(500, "UPDATE_WEBHOOK_DELIVERY_ERROR", "投递记录修改失败")
+	}
+
+	return nil
+}
@@ -0,0 +1,101 @@
+package data
+
+import (
+	"context"
+	"dhb/app/app/internal/biz"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"time"
+)
+
+type WithdrawAddress struct {
+	ID        int64     `gorm:"primarykey;type:int"`
+	UserId    int64     `gorm:"type:int;not null;uniqueIndex:idx_withdraw_address_user_address,priority:1"`
+	Label     string    `gorm:"type:varchar(45);not null"`
+	Address   string    `gorm:"type:varchar(100);not null;uniqueIndex:idx_withdraw_address_user_address,priority:2"`
+	ActiveAt  time.Time `gorm:"type:datetime;not null"`
+	CreatedAt time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt time.Time `gorm:"type:datetime;not null"`
+}
+
+type WithdrawAddressRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewWithdrawAddressRepo(data *Data, logger log.Logger) biz.WithdrawAddressRepo {
+	return &WithdrawAddressRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (wa *WithdrawAddress) toBiz() *biz.WithdrawAddress {
+	return &biz.WithdrawAddress{
+		ID:        wa.ID,
+		UserId:    wa.UserId,
+		Label:     wa.Label,
+		Address:   wa.Address,
+		ActiveAt:  wa.ActiveAt,
+		CreatedAt: wa.CreatedAt,
+	}
+}
+
+// CreateWithdrawAddress .
+func (war *WithdrawAddressRepo) CreateWithdrawAddress(ctx context.Context, wa *biz.WithdrawAddress) (*biz.WithdrawAddress, error) {
+	var withdrawAddress WithdrawAddress
+	withdrawAddress.UserId = wa.UserId
+	withdrawAddress.Label = wa.Label
+	withdrawAddress.Address = wa.Address
+	withdrawAddress.ActiveAt = wa.ActiveAt
+
+	res := war.data.DB(ctx).Table("withdraw_address").Create(&withdrawAddress)
+	if res.Error != nil {
+		return nil, errors.New(500, "CREATE_WITHDRAW_ADDRESS_ERROR", "提现地址创建失败")
+	}
+
+	return withdrawAddress.toBiz(), nil
+}
+
+// GetWithdrawAddressesByUserId .
+func (war *WithdrawAddressRepo) GetWithdrawAddressesByUserId(ctx context.Context, userId int64) ([]*biz.WithdrawAddress, error) {
+	var withdrawAddresses []*WithdrawAddress
+	if err := war.data.db.Table("withdraw_address").Where("user_id=?", userId).Order("id asc").Find(&withdrawAddresses).Error; err != nil {
+		return nil, errors.New(500, "WITHDRAW ADDRESS ERROR", err.Error())
+	}
+
+	res := make([]*biz.WithdrawAddress, 0)
+	for _, v := range withdrawAddresses {
+		res = append(res, v.toBiz())
+	}
+
+	return res, nil
+}
+
+// GetWithdrawAddressById 没有返回 nil .
+func (war *WithdrawAddressRepo) GetWithdrawAddressById(ctx context.Context, id int64) (*biz.WithdrawAddress, error) {
+	var withdrawAddress WithdrawAddress
+	if err := war.data.db.Table("withdraw_address").Where("id=?", id).First(&withdrawAddress).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, errors.New(500, "WITHDRAW ADDRESS ERROR", err.Error())
+	}
+
+	return withdrawAddress.toBiz(), nil
+}
+
+// DeleteWithdrawAddress 只能删除自己的地址 .
+func (war *WithdrawAddressRepo) DeleteWithdrawAddress(ctx context.Context, userId int64, id int64) error {
+	res := war.data.DB(ctx).Table("withdraw_address").Where("id=? and user_id=?", id, userId).Delete(&WithdrawAddress{})
+	if nil != res.Error {
+		return errors.New(500, "WITHDRAW ADDRESS ERROR", res.Error.Error())
+	}
+	if 0 == res.RowsAffected {
+		return errors.NotFound("WITHDRAW_ADDRESS_NOT_FOUND", "withdraw address not found")
+	}
+
+	return nil
+}
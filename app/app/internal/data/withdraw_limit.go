@@ -0,0 +1,114 @@
+package data
+
+import (
+	"context"
+	"dhb/app/app/internal/biz"
+	"github.com/go-kratos/kratos/v2/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+// WithdrawPlatformDaily 全平台每个业务日已提现金额，提现时在事务中原子累加
+type WithdrawPlatformDaily struct {
+	ID           int64     `gorm:"primarykey;type:int"`
+	BusinessDate string    `gorm:"type:varchar(10);not null;uniqueIndex:idx_withdraw_platform_daily_date_coin,priority:1"`
+	Coin         string    `gorm:"type:varchar(45);not null;uniqueIndex:idx_withdraw_platform_daily_date_coin,priority:2"`
+	Amount       int64     `gorm:"type:bigint;not null"`
+	CreatedAt    time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt    time.Time `gorm:"type:datetime;not null"`
+}
+
+// ReserveWithdrawPlatformDaily 事务中使用，累加后不超过 limit 时占用额度并返回 true，
+// 当天第一次使用时以 current 为初始值，事务回滚时额度一起回滚
+func (ub UserBalanceRepo) ReserveWithdrawPlatformDaily(ctx context.Context, date string, coin string, amount int64, limit int64, current int64) (bool, error) {
+	if err := ub.data.db.Table("withdraw_platform_daily").Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&WithdrawPlatformDaily{BusinessDate: date, Coin: coin, Amount: current}).Error; nil != err {
+		return false, errors.New(500, "WITHDRAW PLATFORM DAILY ERROR", err.Error())
+	}
+
+	res := ub.data.DB(ctx).Table("withdraw_platform_daily").
+		Where("business_date=? and coin=?", date, coin).
+		Where("amount+?<=?", amount, limit).
+		Updates(map[string]interface{}{"amount": gorm.Expr("amount+?", amount), "updated_at": time.Now()})
+	if nil != res.Error {
+		return false, errors.New(500, "WITHDRAW PLATFORM DAILY ERROR", res.Error.Error())
+	}
+
+	return 1 == res.RowsAffected, nil
+}
+
+// ReleaseWithdrawPlatformDaily 事务中使用，提现失败时退回占用的额度，未开启平台上限时没有计数行，不做处理
+func (ub UserBalanceRepo) ReleaseWithdrawPlatformDaily(ctx context.Context, date string, coin string, amount int64) error {
+	if err := ub.data.DB(ctx).Table("withdraw_platform_daily").
+		Where("business_date=? and coin=?", date, coin).
+		Updates(map[string]interface{}{"amount": gorm.Expr("greatest(amount-?, 0)", amount), "updated_at": time.Now()}).Error; nil != err {
+		return errors.New(500, "WITHDRAW PLATFORM DAILY ERROR", err.Error())
+	}
+
+	return nil
+}
+
+// LockUserBalance 事务中使用，锁定用户余额 .
+func (ub UserBalanceRepo) LockUserBalance(ctx context.Context, userId int64) (*biz.UserBalance, error) {
+	var userBalance UserBalance
+	if err := ub.data.DB(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("user_id=?", userId).Table("user_balance").First(&userBalance).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.NotFound("USER_BALANCE_NOT_FOUND", "user balance not found")
+		}
+
+		return nil, errors.New(500, "USER BALANCE ERROR", err.Error())
+	}
+
+	return &biz.UserBalance{
+		ID:          userBalance.ID,
+		UserId:      userBalance.UserId,
+		BalanceUsdt: userBalance.BalanceUsdt,
+		BalanceDhb:  userBalance.BalanceDhb,
+	}, nil
+}
+
+// GetUserWithdrawUsage start 之后用户提现的笔数和金额 .
+func (ub UserBalanceRepo) GetUserWithdrawUsage(ctx context.Context, userId int64, coinType string, start time.Time) (*biz.WithdrawUsage, error) {
+	var usage struct {
+		Count  int64
+		Amount int64
+	}
+	if err := ub.data.DB(ctx).Table("withdraw").
+		Where("user_id=? and type=?", userId, coinType).
+		Where("status<>?", "fail").
+		Where("created_at>=?", start).
+		Select("count(*) as count, coalesce(sum(amount), 0) as amount").Take(&usage).Error; err != nil {
+		return nil, errors.New(500, "WITHDRAW ERROR", err.Error())
+	}
+
+	return &biz.WithdrawUsage{
+		Count:  usage.Count,
+		Amount: usage.Amount,
+	}, nil
+}
+
+// GetUserRecommendHistoryLast 最近一次修改推荐人，没有返回 nil .
+func (ur *UserRecommendRepo) GetUserRecommendHistoryLast(ctx context.Context, userId int64) (*biz.UserRecommendHistory, error) {
+	var userRecommendHistory UserRecommendHistory
+	if err := ur.data.db.Table("user_recommend_history").
+		Where("user_id=?", userId).Order("id desc").First(&userRecommendHistory).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, errors.New(500, "USER RECOMMEND HISTORY ERROR", err.Error())
+	}
+
+	return &biz.UserRecommendHistory{
+		ID:                  userRecommendHistory.ID,
+		UserId:              userRecommendHistory.UserId,
+		OriginRecommendCode: userRecommendHistory.OriginRecommendCode,
+		RecommendCode:       userRecommendHistory.RecommendCode,
+		OriginRecommendId:   userRecommendHistory.OriginRecommendId,
+		RecommendId:         userRecommendHistory.RecommendId,
+		TeamNum:             userRecommendHistory.TeamNum,
+		CreatedAt:           userRecommendHistory.CreatedAt,
+	}, nil
+}
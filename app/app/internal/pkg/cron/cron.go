@@ -0,0 +1,160 @@
+package cron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Schedule 五段式 cron 表达式：分 时 日 月 周，支持 * , - /
+type Schedule struct {
+	minute uint64
+	hour   uint64
+	dom    uint64
+	month  uint64
+	dow    uint64
+	anyDom bool
+	anyDow bool
+}
+
+type bounds struct {
+	min, max int
+}
+
+var (
+	minuteBounds = bounds{0, 59}
+	hourBounds   = bounds{0, 23}
+	domBounds    = bounds{1, 31}
+	monthBounds  = bounds{1, 12}
+	dowBounds    = bounds{0, 7} // 0 和 7 都是周日
+)
+
+// Parse 解析表达式，另支持 @daily @hourly
+func Parse(spec string) (*Schedule, error) {
+	switch strings.TrimSpace(spec) {
+	case "@daily":
+		spec = "0 0 * * *"
+	case "@hourly":
+		spec = "0 * * * *"
+	}
+
+	fields := strings.Fields(spec)
+	if 5 != len(fields) {
+		return nil, fmt.Errorf("cron: expected 5 fields, got %d in %q", len(fields), spec)
+	}
+
+	var (
+		s   = &Schedule{}
+		err error
+	)
+	if s.minute, err = parseField(fields[0], minuteBounds); nil != err {
+		return nil, err
+	}
+	if s.hour, err = parseField(fields[1], hourBounds); nil != err {
+		return nil, err
+	}
+	if s.dom, err = parseField(fields[2], domBounds); nil != err {
+		return nil, err
+	}
+	if s.month, err = parseField(fields[3], monthBounds); nil != err {
+		return nil, err
+	}
+	if s.dow, err = parseField(fields[4], dowBounds); nil != err {
+		return nil, err
+	}
+	if 0 != s.dow&(1<<7) {
+		s.dow |= 1
+	}
+	s.anyDom = "*" == fields[2]
+	s.anyDow = "*" == fields[4]
+
+	return s, nil
+}
+
+func parseField(field string, b bounds) (uint64, error) {
+	var res uint64
+	for _, part := range strings.Split(field, ",") {
+		var (
+			step     = 1
+			start    = b.min
+			end      = b.max
+			rangeStr = part
+			err      error
+		)
+
+		if i := strings.Index(part, "/"); 0 <= i {
+			rangeStr = part[:i]
+			if step, err = strconv.Atoi(part[i+1:]); nil != err || 0 >= step {
+				return 0, fmt.Errorf("cron: invalid step in %q", part)
+			}
+		}
+
+		if "*" != rangeStr {
+			if i := strings.Index(rangeStr, "-"); 0 <= i {
+				if start, err = strconv.Atoi(rangeStr[:i]); nil != err {
+					return 0, fmt.Errorf("cron: invalid range in %q", part)
+				}
+				if end, err = strconv.Atoi(rangeStr[i+1:]); nil != err {
+					return 0, fmt.Errorf("cron: invalid range in %q", part)
+				}
+			} else {
+				if start, err = strconv.Atoi(rangeStr); nil != err {
+					return 0, fmt.Errorf("cron: invalid value in %q", part)
+				}
+				if rangeStr == part {
+					end = start
+				}
+			}
+		}
+
+		if start < b.min || end > b.max || start > end {
+			return 0, fmt.Errorf("cron: %q out of range [%d, %d]", part, b.min, b.max)
+		}
+		for i := start; i <= end; i += step {
+			res |= 1 << uint(i)
+		}
+	}
+
+	return res, nil
+}
+
+// Next t 之后的下一次触发时间，按 t 的时区计算，找不到时返回零值
+func (s *Schedule) Next(t time.Time) time.Time {
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(5, 0, 0)
+
+	for t.Before(limit) {
+		if 0 == s.month&(1<<uint(t.Month())) {
+			t = time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location()).AddDate(0, 1, 0)
+			continue
+		}
+		if !s.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location()).AddDate(0, 0, 1)
+			continue
+		}
+		if 0 == s.hour&(1<<uint(t.Hour())) {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, t.Location()).Add(time.Hour)
+			continue
+		}
+		if 0 == s.minute&(1<<uint(t.Minute())) {
+			t = t.Add(time.Minute)
+			continue
+		}
+
+		return t
+	}
+
+	return time.Time{}
+}
+
+// dayMatches 日和周都限定时满足其一即可，与标准 cron 一致
+func (s *Schedule) dayMatches(t time.Time) bool {
+	domMatch := 0 != s.dom&(1<<uint(t.Day()))
+	dowMatch := 0 != s.dow&(1<<uint(t.Weekday()))
+	if s.anyDom || s.anyDow {
+		return domMatch && dowMatch
+	}
+
+	return domMatch || dowMatch
+}
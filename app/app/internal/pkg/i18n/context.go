@@ -0,0 +1,30 @@
+package i18n
+
+import "context"
+
+type localeKey struct{}
+
+// holder 请求开始时按请求头确定语言，jwt 验证后可以换成用户设置的语言
+type holder struct {
+	locale *Locale
+}
+
+// NewContext .
+func NewContext(ctx context.Context, l *Locale) context.Context {
+	return context.WithValue(ctx, localeKey{}, &holder{locale: l})
+}
+
+// SetLocale 修改当前请求的语言，外层中间件翻译错误时使用修改后的语言
+func SetLocale(ctx context.Context, l *Locale) {
+	if h, ok := ctx.Value(localeKey{}).(*holder); ok && nil != l {
+		h.locale = l
+	}
+}
+
+// FromContext 没有设置时返回 nil，nil 的格式化方法使用默认格式
+func FromContext(ctx context.Context) *Locale {
+	if h, ok := ctx.Value(localeKey{}).(*holder); ok {
+		return h.locale
+	}
+	return nil
+}
@@ -0,0 +1,325 @@
+package i18n
+
+import (
+	"embed"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/errors"
+	"gopkg.in/yaml.v3"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// 内置语言
+const (
+	ZhCN = "zh-CN"
+	En   = "en"
+)
+
+// defaultTimeLayout 没有配置时的时间格式，和接口原来的格式一致
+const defaultTimeLayout = "2006-01-02 15:04:05"
+
+//go:embed locales/*.yaml
+var builtin embed.FS
+
+// Locale 语言包，Messages 的 key 为错误 reason，{name} 替换为错误 metadata 中的值，
+// 金额和时间只在错误信息中按语言格式化，接口字段和 metadata 保持原格式
+type Locale struct {
+	Tag        string            `yaml:"tag"`
+	TimeLayout string            `yaml:"time_layout"`
+	Decimal    string            `yaml:"decimal"` // 小数点
+	Group      string            `yaml:"group"`   // 千分位，为空不分组
+	Native     bool              `yaml:"native"`  // 业务错误信息就是这种语言，原文不是纯英文时保留原文
+	Messages   map[string]string `yaml:"messages"`
+}
+
+// Catalog 全部语言包
+type Catalog struct {
+	locales map[string]*Locale // key 为小写的 tag
+	def     *Locale
+}
+
+// NewCatalog 加载内置语言包，dir 中的 yaml 文件覆盖或增加语言，文件中没有 tag 时以文件名为 tag
+func NewCatalog(dir string, defaultLocale string) (*Catalog, error) {
+	c := &Catalog{locales: make(map[string]*Locale, 0)}
+
+	files, err := builtin.ReadDir("locales")
+	if nil != err {
+		return nil, err
+	}
+	for _, v := range files {
+		data, err := builtin.ReadFile("locales/" + v.Name())
+		if nil != err {
+			return nil, err
+		}
+		if err = c.add(v.Name(), data); nil != err {
+			return nil, err
+		}
+	}
+	if err = c.checkKeys(); nil != err {
+		return nil, err
+	}
+
+	if "" != dir {
+		names, err := filepath.Glob(filepath.Join(dir, "*.yaml"))
+		if nil != err {
+			return nil, err
+		}
+		for _, name := range names {
+			data, err := os.ReadFile(name)
+			if nil != err {
+				return nil, err
+			}
+			if err = c.add(filepath.Base(name), data); nil != err {
+				return nil, err
+			}
+		}
+	}
+
+	if "" == defaultLocale {
+		defaultLocale = ZhCN
+	}
+	def, ok := c.Get(defaultLocale)
+	if !ok {
+		return nil, fmt.Errorf("i18n: default locale %q not found", defaultLocale)
+	}
+	c.def = def
+
+	return c, nil
+}
+
+func (c *Catalog) add(name string, data []byte) error {
+	var head struct {
+		Tag string `yaml:"tag"`
+	}
+	if err := yaml.Unmarshal(data, &head); nil != err {
+		return fmt.Errorf("i18n: %s: %v", name, err)
+	}
+	if "" == head.Tag {
+		head.Tag = strings.TrimSuffix(name, filepath.Ext(name))
+	}
+
+	// 已有的语言在原来的基础上覆盖
+	l := &Locale{Tag: head.Tag, Messages: make(map[string]string, 0)}
+	if old, ok := c.locales[strings.ToLower(head.Tag)]; ok {
+		*l = *old
+		l.Messages = make(map[string]string, len(old.Messages))
+		for k, v := range old.Messages {
+			l.Messages[k] = v
+		}
+	}
+	if err := yaml.Unmarshal(data, l); nil != err {
+		return fmt.Errorf("i18n: %s: %v", name, err)
+	}
+	l.Tag = head.Tag
+
+	c.locales[strings.ToLower(l.Tag)] = l
+	return nil
+}
+
+// checkKeys 内置语言包的 key 必须一致，缺少翻译时加载失败
+func (c *Catalog) checkKeys() error {
+	keys := make(map[string]bool, 0)
+	for _, l := range c.locales {
+		for k := range l.Messages {
+			keys[k] = true
+		}
+	}
+
+	for _, tag := range c.Tags() {
+		missing := make([]string, 0)
+		for k := range keys {
+			if _, ok := c.locales[strings.ToLower(tag)].Messages[k]; !ok {
+				missing = append(missing, k)
+			}
+		}
+		if 0 < len(missing) {
+			sort.Strings(missing)
+			return fmt.Errorf("i18n: %s missing %s", tag, strings.Join(missing, ","))
+		}
+	}
+
+	return nil
+}
+
+// Default 默认语言
+func (c *Catalog) Default() *Locale {
+	return c.def
+}
+
+// Get 按 tag 查找，不区分大小写
+func (c *Catalog) Get(tag string) (*Locale, bool) {
+	l, ok := c.locales[strings.ToLower(strings.TrimSpace(tag))]
+	return l, ok
+}
+
+// Tags 支持的语言
+func (c *Catalog) Tags() []string {
+	res := make([]string, 0, len(c.locales))
+	for _, v := range c.locales {
+		res = append(res, v.Tag)
+	}
+	sort.Strings(res)
+	return res
+}
+
+// Match 按 Accept-Language 的权重选择语言，先完整匹配，再按主语言匹配，如 zh-TW 使用 zh-CN，没有匹配时为默认语言
+func (c *Catalog) Match(acceptLanguage string) *Locale {
+	type tag struct {
+		lang string
+		q    float64
+	}
+
+	tags := make([]*tag, 0)
+	for _, v := range strings.Split(acceptLanguage, ",") {
+		tmp := strings.Split(strings.TrimSpace(v), ";")
+		if "" == tmp[0] {
+			continue
+		}
+
+		q := float64(1)
+		for _, p := range tmp[1:] {
+			p = strings.TrimSpace(p)
+			if strings.HasPrefix(p, "q=") {
+				q, _ = strconv.ParseFloat(p[2:], 64)
+			}
+		}
+		if 0 >= q {
+			continue
+		}
+		tags = append(tags, &tag{lang: strings.ToLower(tmp[0]), q: q})
+	}
+	sort.SliceStable(tags, func(i, j int) bool {
+		return tags[i].q > tags[j].q
+	})
+
+	for _, v := range tags {
+		if "*" == v.lang {
+			return c.def
+		}
+		if l, ok := c.locales[v.lang]; ok {
+			return l
+		}
+		if l := c.matchPrimary(v.lang); nil != l {
+			return l
+		}
+	}
+
+	return c.def
+}
+
+func (c *Catalog) matchPrimary(lang string) *Locale {
+	primary := strings.Split(lang, "-")[0]
+	if l, ok := c.locales[primary]; ok {
+		return l
+	}
+
+	// 默认语言优先，其余按 tag 排序保证结果稳定
+	if primary == strings.Split(strings.ToLower(c.def.Tag), "-")[0] {
+		return c.def
+	}
+	for _, tag := range c.Tags() {
+		if primary == strings.Split(strings.ToLower(tag), "-")[0] {
+			return c.locales[strings.ToLower(tag)]
+		}
+	}
+	return nil
+}
+
+// LocalizeError 翻译错误信息，reason、code 和 metadata 不变，没有翻译时保留原文
+func (l *Locale) LocalizeError(err error) error {
+	if nil == err || nil == l {
+		return err
+	}
+
+	e := errors.FromError(err)
+	tpl, ok := l.Messages[e.Reason]
+	if !ok {
+		return err
+	}
+	if l.Native && !isASCII(e.Message) { // 原文更具体
+		return err
+	}
+
+	res := errors.Clone(e)
+	res.Message = l.format(tpl, e.Metadata)
+	return res
+}
+
+// display metadata 中的金额和时间按语言展示，其余原样
+func (l *Locale) display(v string) string {
+	if _, err := strconv.ParseFloat(v, 64); nil == err && !strings.ContainsAny(v, "eEnN") {
+		return l.FormatNumber(v)
+	}
+	if t, err := time.Parse(defaultTimeLayout, v); nil == err {
+		return l.FormatTime(t)
+	}
+	return v
+}
+
+// FormatTime 时区由调用方转换
+func (l *Locale) FormatTime(t time.Time) string {
+	if nil == l || "" == l.TimeLayout {
+		return t.Format(defaultTimeLayout)
+	}
+	return t.Format(l.TimeLayout)
+}
+
+// FormatNumber 格式化已经保留好小数位的数字，如 -1234.50
+func (l *Locale) FormatNumber(s string) string {
+	if nil == l {
+		return s
+	}
+
+	sign := ""
+	if strings.HasPrefix(s, "-") {
+		sign, s = "-", s[1:]
+	}
+	integer, fraction := s, ""
+	if i := strings.Index(s, "."); 0 <= i {
+		integer, fraction = s[:i], s[i+1:]
+	}
+
+	if "" != l.Group && 3 < len(integer) {
+		var b strings.Builder
+		first := len(integer) % 3
+		if 0 == first {
+			first = 3
+		}
+		b.WriteString(integer[:first])
+		for i := first; i < len(integer); i += 3 {
+			b.WriteString(l.Group)
+			b.WriteString(integer[i : i+3])
+		}
+		integer = b.String()
+	}
+
+	if "" == fraction {
+		return sign + integer
+	}
+	decimal := l.Decimal
+	if "" == decimal {
+		decimal = "."
+	}
+	return sign + integer + decimal + fraction
+}
+
+func (l *Locale) format(tpl string, args map[string]string) string {
+	for k, v := range args {
+		tpl = strings.ReplaceAll(tpl, "{"+k+"}", l.display(v))
+	}
+	return tpl
+}
+
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,99 @@
+package i18n
+
+import (
+	"github.com/go-kratos/kratos/v2/errors"
+	"sort"
+	"testing"
+)
+
+func TestBuiltinLocalesHaveSameKeys(t *testing.T) {
+	c, err := NewCatalog("", "")
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	zh, _ := c.Get(ZhCN)
+	en, _ := c.Get(En)
+	if len(zh.Messages) != len(en.Messages) {
+		t.Fatalf("zh-CN has %d keys, en has %d", len(zh.Messages), len(en.Messages))
+	}
+	for k := range en.Messages {
+		if _, ok := zh.Messages[k]; !ok {
+			t.Errorf("zh-CN missing %s", k)
+		}
+	}
+}
+
+func TestCheckKeys(t *testing.T) {
+	tests := []struct {
+		name    string
+		files   map[string]string
+		wantErr bool
+	}{
+		{
+			name: "same keys",
+			files: map[string]string{
+				"a.yaml": "messages:\n  A: a\n  B: b\n",
+				"b.yaml": "messages:\n  A: a\n  B: b\n",
+			},
+		},
+		{
+			name: "missing key",
+			files: map[string]string{
+				"a.yaml": "messages:\n  A: a\n  B: b\n",
+				"b.yaml": "messages:\n  A: a\n",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Catalog{locales: make(map[string]*Locale, 0)}
+			names := make([]string, 0)
+			for name := range tt.files {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			for _, name := range names {
+				if err := c.add(name, []byte(tt.files[name])); nil != err {
+					t.Fatal(err)
+				}
+			}
+
+			if err := c.checkKeys(); (nil != err) != tt.wantErr {
+				t.Errorf("checkKeys() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLocalizeError(t *testing.T) {
+	c, err := NewCatalog("", "")
+	if nil != err {
+		t.Fatal(err)
+	}
+	zh, _ := c.Get(ZhCN)
+	en, _ := c.Get(En)
+
+	tests := []struct {
+		name   string
+		locale *Locale
+		err    *errors.Error
+		want   string
+	}{
+		{"zh keeps chinese", zh, errors.New(400, "PARAM_INVALID", "地址备注错误"), "地址备注错误"},
+		{"zh translates english", zh, errors.New(401, "UNAUTHORIZED", "JWT token is missing"), "无效TOKEN"},
+		{"en", en, errors.New(400, "WITHDRAW_AMOUNT_MIN", "最小提现金额:1000.00").
+			WithMetadata(map[string]string{"min": "1000.00"}), "Minimum withdrawal amount: 1,000.00"},
+		{"unknown reason", en, errors.New(400, "NOT_DEFINED", "原文"), "原文"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := tt.locale.LocalizeError(tt.err)
+			if got := errors.FromError(e).Message; got != tt.want {
+				t.Errorf("LocalizeError() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package pdf
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// A4 纵向，单位 pt
+const (
+	pageWidth    = 595
+	pageHeight   = 842
+	margin       = 40
+	fontSize     = 8
+	lineHeight   = 12
+	linesPerPage = (pageHeight - 2*margin) / lineHeight
+)
+
+// 1 目录，2 页面树，3 字体，之后每页一个页面对象和一个内容流
+const (
+	objCatalog = 1
+	objPages   = 2
+	objFont    = 3
+)
+
+// Document 纯文本 PDF，只用内置 Courier 字体，非 ASCII 字符输出为 ?
+// 写满一页就输出到 w，内存中只保留当前页
+type Document struct {
+	w       io.Writer
+	n       int   // 已写入字节数
+	offsets []int // 下标为对象编号减一
+	lines   []string
+	pages   int
+	err     error
+}
+
+// New 写入文件头和字体，最后必须调用 Close
+func New(w io.Writer) *Document {
+	d := &Document{w: w, offsets: make([]int, objFont)}
+	d.write("%%PDF-1.4\n")
+	d.object(objFont, "<< /Type /Font /Subtype /Type1 /BaseFont /Courier /Encoding /WinAnsiEncoding >>")
+	return d
+}
+
+func (d *Document) write(format string, a ...interface{}) {
+	if nil != d.err {
+		return
+	}
+	n, err := fmt.Fprintf(d.w, format, a...)
+	d.n += n
+	d.err = err
+}
+
+func (d *Document) object(num int, body string) {
+	for len(d.offsets) < num {
+		d.offsets = append(d.offsets, 0)
+	}
+	d.offsets[num-1] = d.n
+	d.write("%d 0 obj\n%s\nendobj\n", num, body)
+}
+
+// Line 追加一行，写满一页自动换页
+func (d *Document) Line(format string, a ...interface{}) {
+	if linesPerPage <= len(d.lines) {
+		d.flush()
+	}
+	d.lines = append(d.lines, fmt.Sprintf(format, a...))
+}
+
+// flush 输出当前页
+func (d *Document) flush() {
+	var content strings.Builder
+	fmt.Fprintf(&content, "BT /F1 %d Tf %d TL %d %d Td\n", fontSize, lineHeight, margin, pageHeight-margin)
+	for _, line := range d.lines {
+		fmt.Fprintf(&content, "(%s) Tj T*\n", escape(line))
+	}
+	content.WriteString("ET")
+
+	page := 4 + 2*d.pages
+	d.object(page, fmt.Sprintf("<< /Type /Page /Parent %d 0 R /MediaBox [0 0 %d %d] /Resources << /Font << /F1 %d 0 R >> >> /Contents %d 0 R >>", objPages, pageWidth, pageHeight, objFont, page+1))
+	d.object(page+1, fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", content.Len(), content.String()))
+	d.lines = d.lines[:0]
+	d.pages++
+}
+
+// escape 转义括号和反斜杠，替换非 ASCII 字符
+func escape(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case '(' == r || ')' == r || '\\' == r:
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case 32 > r || 126 < r:
+			b.WriteByte('?')
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// Close 输出最后一页、页面树、目录和交叉引用表，不关闭 w
+func (d *Document) Close() error {
+	if 0 < len(d.lines) || 0 == d.pages {
+		d.flush()
+	}
+
+	kids := make([]string, 0, d.pages)
+	for i := 0; i < d.pages; i++ {
+		kids = append(kids, fmt.Sprintf("%d 0 R", 4+2*i))
+	}
+	d.object(objPages, fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), d.pages))
+	d.object(objCatalog, fmt.Sprintf("<< /Type /Catalog /Pages %d 0 R >>", objPages))
+
+	xref := d.n
+	d.write("xref\n0 %d\n0000000000 65535 f \n", len(d.offsets)+1)
+	for _, v := range d.offsets {
+		d.write("%010d 00000 n \n", v)
+	}
+	d.write("trailer\n<< /Size %d /Root %d 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(d.offsets)+1, objCatalog, xref)
+
+	return d.err
+}
@@ -0,0 +1,109 @@
+package server
+
+import (
+	"context"
+	"dhb/app/app/internal/biz"
+	"dhb/app/app/internal/conf"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/log"
+	"os"
+	"time"
+)
+
+// EventServer 领域事件转发，多节点部署时通过租约选出一个主节点转发
+type EventServer struct {
+	c      *conf.Events
+	bus    *biz.EventBus
+	owner  string
+	poll   time.Duration
+	lease  time.Duration
+	cancel context.CancelFunc
+	done   chan struct{}
+	log    *log.Helper
+}
+
+// NewEventServer new an event relay server.
+func NewEventServer(c *conf.Events, bus *biz.EventBus, logger log.Logger) *EventServer {
+	hostname, _ := os.Hostname()
+	s := &EventServer{
+		c:     c,
+		bus:   bus,
+		owner: fmt.Sprintf("%s-%d", hostname, os.Getpid()),
+		poll:  time.Second,
+		lease: 30 * time.Second,
+		log:   log.NewHelper(logger),
+	}
+	if nil != c.GetPoll() && 0 < c.GetPoll().AsDuration() {
+		s.poll = c.GetPoll().AsDuration()
+	}
+	if nil != c.GetLease() && 0 < c.GetLease().AsDuration() {
+		s.lease = c.GetLease().AsDuration()
+	}
+
+	return s
+}
+
+// Start 实现 transport.Server
+func (s *EventServer) Start(ctx context.Context) error {
+	if !s.c.GetEnable() {
+		return nil
+	}
+
+	ctx, s.cancel = context.WithCancel(context.Background())
+	s.done = make(chan struct{})
+	go s.loop(ctx)
+	return nil
+}
+
+// Stop 实现 transport.Server
+func (s *EventServer) Stop(ctx context.Context) error {
+	if nil == s.cancel {
+		return nil
+	}
+
+	s.cancel()
+	select {
+	case <-s.done:
+	case <-ctx.Done():
+	}
+
+	s.bus.Resign(context.Background(), s.owner)
+	return nil
+}
+
+func (s *EventServer) loop(ctx context.Context) {
+	defer close(s.done)
+
+	var (
+		leader  bool
+		renewAt time.Time
+		ticker  = time.NewTicker(s.poll)
+	)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			if !now.Before(renewAt) {
+				isLeader := s.bus.Lead(ctx, s.owner, s.lease)
+				if isLeader && !leader {
+					s.log.Infof("event relay: %s became leader", s.owner)
+				}
+				leader, renewAt = isLeader, now.Add(s.lease/3)
+			}
+			if !leader {
+				continue
+			}
+
+			// 有积压时连续转发，直到追上
+			for nil == ctx.Err() {
+				n, err := s.bus.Relay(ctx, time.Now())
+				if nil != err || 0 >= n || time.Now().After(renewAt) {
+					break
+				}
+			}
+		}
+	}
+}
@@ -1,17 +1,22 @@
 package server
 
 import (
+	"crypto/tls"
+	v1 "dhb/app/app/api"
+	"dhb/app/app/internal/biz"
 	"dhb/app/app/internal/conf"
-	"github.com/go-kratos/kratos/v2/middleware/recovery"
+	"dhb/app/app/internal/pkg/i18n"
+	"dhb/app/app/internal/service"
+	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/transport/grpc"
 )
 
 // NewGRPCServer new a gRPC server.
-func NewGRPCServer(c *conf.Server) *grpc.Server {
+// 和 http 使用同一套中间件，健康检查和反射服务由 kratos 默认注册
+func NewGRPCServer(c *conf.Server, app *service.AppService, limiter *biz.RateLimiter, catalog *i18n.Catalog, uuc *biz.UserUseCase, logger log.Logger) (*grpc.Server, error) {
 	var opts = []grpc.ServerOption{
-		grpc.Middleware(
-			recovery.Recovery(),
-		),
+		grpc.Middleware(NewMiddleware(limiter, catalog, uuc)...),
+		grpc.Logger(logger),
 	}
 	if c.Grpc.Network != "" {
 		opts = append(opts, grpc.Network(c.Grpc.Network))
@@ -22,7 +27,18 @@ func NewGRPCServer(c *conf.Server) *grpc.Server {
 	if c.Grpc.Timeout != nil {
 		opts = append(opts, grpc.Timeout(c.Grpc.Timeout.AsDuration()))
 	}
+	if c.Grpc.TlsCert != "" && c.Grpc.TlsKey != "" {
+		cert, err := tls.LoadX509KeyPair(c.Grpc.TlsCert, c.Grpc.TlsKey)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, grpc.TLSConfig(&tls.Config{
+			Certificates: []tls.Certificate{cert},
+			MinVersion:   tls.VersionTLS12,
+		}))
+	}
 	srv := grpc.NewServer(opts...)
+	v1.RegisterAppServer(srv, app)
 
-	return srv
+	return srv, nil
 }
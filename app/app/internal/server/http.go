@@ -3,8 +3,11 @@ package server
 import (
 	"context"
 	v1 "dhb/app/app/api"
+	"dhb/app/app/internal/biz"
 	"dhb/app/app/internal/conf"
+	"dhb/app/app/internal/pkg/i18n"
 	"dhb/app/app/internal/service"
+	"github.com/go-kratos/kratos/v2/middleware"
 	"github.com/go-kratos/kratos/v2/middleware/auth/jwt"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
 	"github.com/go-kratos/kratos/v2/middleware/selector"
@@ -16,18 +19,11 @@ import (
 )
 
 // NewHTTPServer new an HTTP server.
-func NewHTTPServer(c *conf.Server, app *service.AppService, logger log.Logger) *http.Server {
+func NewHTTPServer(c *conf.Server, app *service.AppService, push *service.PushService, limiter *biz.RateLimiter, catalog *i18n.Catalog, uuc *biz.UserUseCase, logger log.Logger) *http.Server {
 	var opts = []http.ServerOption{
-		http.Middleware(
-			recovery.Recovery(),
-			selector.Server( // jwt 验证
-				jwt.Server(func(token *jwt2.Token) (interface{}, error) {
-					return []byte("5485c6f09a1a9bf5edeb841d85e09250"), nil
-				}, jwt.WithSigningMethod(jwt2.SigningMethodHS256)),
-			).Match(NewWhiteListMatcher()).Build(),
-		),
+		http.Middleware(NewMiddleware(limiter, catalog, uuc)...),
 		http.Filter(handlers.CORS(
-			handlers.AllowedHeaders([]string{"X-Requested-With", "Content-Type", "Authorization"}),
+			handlers.AllowedHeaders([]string{"X-Requested-With", "Content-Type", "Authorization", "Accept-Language"}),
 			handlers.AllowedMethods([]string{"GET", "POST", "PUT", "HEAD", "OPTIONS"}),
 			handlers.AllowedOrigins([]string{"*"}),
 		)),
@@ -43,9 +39,27 @@ func NewHTTPServer(c *conf.Server, app *service.AppService, logger log.Logger) *
 	}
 	srv := http.NewServer(opts...)
 	v1.RegisterAppHTTPServer(srv, app)
+	srv.HandleFunc("/api/statement/download", app.DownloadStatement)
+	srv.HandleFunc("/api/app_server/push", push.Stream)
 	return srv
 }
 
+// NewMiddleware http 和 grpc 共用的中间件
+func NewMiddleware(limiter *biz.RateLimiter, catalog *i18n.Catalog, uuc *biz.UserUseCase) []middleware.Middleware {
+	return []middleware.Middleware{
+		Localize(catalog),
+		recovery.Recovery(),
+		IpRateLimit(limiter),
+		selector.Server( // jwt 验证
+			jwt.Server(func(token *jwt2.Token) (interface{}, error) {
+				return []byte("5485c6f09a1a9bf5edeb841d85e09250"), nil
+			}, jwt.WithSigningMethod(jwt2.SigningMethodHS256)),
+		).Match(NewWhiteListMatcher()).Build(),
+		UserLocale(catalog, uuc),
+		RateLimit(limiter),
+	}
+}
+
 // NewWhiteListMatcher 设置白名单，不需要 token 验证的接口
 func NewWhiteListMatcher() selector.MatchFunc {
 	whiteList := make(map[string]struct{})
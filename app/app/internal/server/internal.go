@@ -0,0 +1,210 @@
+package server
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	v1 "dhb/app/app/api"
+	"dhb/app/app/internal/biz"
+	"dhb/app/app/internal/conf"
+	"dhb/app/app/internal/service"
+	"encoding/hex"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/middleware/recovery"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/go-kratos/kratos/v2/transport/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/protobuf/proto"
+	"os"
+	"strconv"
+	"time"
+)
+
+// InternalServer 内部服务，单独端口，地址为空时不启动
+type InternalServer struct {
+	srv *grpc.Server
+}
+
+// NewInternalServer new an internal gRPC server.
+func NewInternalServer(c *conf.Internal, s *service.InternalService, iuc *biz.InternalUseCase, logger log.Logger) (*InternalServer, error) {
+	res := &InternalServer{}
+	if "" == c.GetAddr() {
+		return res, nil
+	}
+
+	// 必须启用一种认证方式，不允许两种都不配置
+	switch internalAuthMode(c) {
+	case "mtls":
+		if "" == c.TlsCert || "" == c.TlsKey || "" == c.TlsClientCa {
+			return nil, fmt.Errorf("internal: mtls requires tls_cert, tls_key and tls_client_ca")
+		}
+		if 0 == len(c.GetClients()) {
+			return nil, fmt.Errorf("internal: mtls requires clients")
+		}
+	case "hmac":
+		if 0 == len(internalSecrets(c)) {
+			return nil, fmt.Errorf("internal: hmac requires clients with secret")
+		}
+	default:
+		return nil, fmt.Errorf("internal: unknown auth %q", c.GetAuth())
+	}
+
+	var opts = []grpc.ServerOption{
+		grpc.Middleware(
+			recovery.Recovery(),
+			InternalAuth(c, iuc),
+		),
+		grpc.Address(c.Addr),
+		grpc.Logger(logger),
+	}
+	if c.Network != "" {
+		opts = append(opts, grpc.Network(c.Network))
+	}
+	if c.Timeout != nil {
+		opts = append(opts, grpc.Timeout(c.Timeout.AsDuration()))
+	}
+	if c.TlsCert != "" && c.TlsKey != "" {
+		cert, err := tls.LoadX509KeyPair(c.TlsCert, c.TlsKey)
+		if err != nil {
+			return nil, err
+		}
+		tlsConf := &tls.Config{
+			Certificates: []tls.Certificate{cert},
+			MinVersion:   tls.VersionTLS12,
+		}
+		if "mtls" == internalAuthMode(c) {
+			ca, err := os.ReadFile(c.TlsClientCa)
+			if err != nil {
+				return nil, err
+			}
+			pool := x509.NewCertPool()
+			if !pool.AppendCertsFromPEM(ca) {
+				return nil, fmt.Errorf("internal: invalid client ca %s", c.TlsClientCa)
+			}
+			tlsConf.ClientCAs = pool
+			tlsConf.ClientAuth = tls.RequireAndVerifyClientCert
+		}
+		opts = append(opts, grpc.TLSConfig(tlsConf))
+	}
+
+	res.srv = grpc.NewServer(opts...)
+	v1.RegisterInternalAppServer(res.srv, s)
+
+	return res, nil
+}
+
+func (s *InternalServer) Start(ctx context.Context) error {
+	if nil == s.srv {
+		return nil
+	}
+	return s.srv.Start(ctx)
+}
+
+func (s *InternalServer) Stop(ctx context.Context) error {
+	if nil == s.srv {
+		return nil
+	}
+	return s.srv.Stop(ctx)
+}
+
+func internalAuthMode(c *conf.Internal) string {
+	if "" == c.GetAuth() {
+		return "hmac"
+	}
+	return c.GetAuth()
+}
+
+func internalSecrets(c *conf.Internal) map[string]string {
+	secrets := make(map[string]string, 0)
+	for _, v := range c.GetClients() {
+		if "" != v.Id && "" != v.Secret {
+			secrets[v.Id] = v.Secret
+		}
+	}
+	return secrets
+}
+
+// InternalAuth 调用方认证，auth 为 mtls 时客户端证书 CN 必须是配置的调用方 id；
+// 为 hmac 时校验请求头 x-caller-id、x-timestamp、x-nonce、x-signature，
+// 签名为 hex(hmac_sha256(secret, "id:timestamp:nonce:operation:hex(sha256(body))"))，
+// body 为请求消息的 protobuf 确定性编码，nonce 在时间窗口内只能使用一次
+func InternalAuth(c *conf.Internal, iuc *biz.InternalUseCase) middleware.Middleware {
+	mode := internalAuthMode(c)
+	secrets := internalSecrets(c)
+	callers := make(map[string]bool, 0)
+	for _, v := range c.GetClients() {
+		callers[v.Id] = true
+	}
+	skew := 5 * time.Minute
+	if nil != c.GetSkew() && 0 < c.GetSkew().AsDuration() {
+		skew = c.GetSkew().AsDuration()
+	}
+
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			if "mtls" == mode {
+				p, ok := peer.FromContext(ctx)
+				if !ok {
+					return nil, v1.ErrorCallerUnauthorized("调用方未认证")
+				}
+				info, ok := p.AuthInfo.(credentials.TLSInfo)
+				if !ok || 0 == len(info.State.VerifiedChains) {
+					return nil, v1.ErrorCallerUnauthorized("调用方未认证")
+				}
+				cn := info.State.VerifiedChains[0][0].Subject.CommonName
+				if !callers[cn] {
+					return nil, v1.ErrorCallerUnauthorized("调用方未认证")
+				}
+				return handler(service.NewCallerContext(ctx, cn), req)
+			}
+
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return nil, v1.ErrorCallerUnauthorized("调用方未认证")
+			}
+			id := tr.RequestHeader().Get("x-caller-id")
+			timestamp := tr.RequestHeader().Get("x-timestamp")
+			nonce := tr.RequestHeader().Get("x-nonce")
+			sign := tr.RequestHeader().Get("x-signature")
+
+			secret, ok := secrets[id]
+			if !ok {
+				return nil, v1.ErrorCallerUnauthorized("调用方未认证")
+			}
+			ts, err := strconv.ParseInt(timestamp, 10, 64)
+			if nil != err || skew < time.Since(time.Unix(ts, 0)).Abs() {
+				return nil, v1.ErrorCallerUnauthorized("签名已过期")
+			}
+			if "" == nonce || 64 < len(nonce) {
+				return nil, v1.ErrorCallerUnauthorized("签名错误")
+			}
+
+			var body []byte
+			if m, ok := req.(proto.Message); ok {
+				body, err = proto.MarshalOptions{Deterministic: true}.Marshal(m)
+				if nil != err {
+					return nil, v1.ErrorCallerUnauthorized("签名错误")
+				}
+			}
+			bodyHash := sha256.Sum256(body)
+
+			mac := hmac.New(sha256.New, []byte(secret))
+			mac.Write([]byte(id + ":" + timestamp + ":" + nonce + ":" + tr.Operation() + ":" + hex.EncodeToString(bodyHash[:])))
+			if !hmac.Equal([]byte(sign), []byte(hex.EncodeToString(mac.Sum(nil)))) {
+				return nil, v1.ErrorCallerUnauthorized("签名错误")
+			}
+
+			// 时间戳前后都允许 skew，nonce 保留两倍时间
+			if !iuc.UseNonce(ctx, id, nonce, 2*skew) {
+				return nil, v1.ErrorCallerUnauthorized("签名已使用")
+			}
+
+			return handler(service.NewCallerContext(ctx, id), req)
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package server
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	v1 "dhb/app/app/api"
+	"dhb/app/app/internal/biz"
+	"dhb/app/app/internal/conf"
+	"dhb/app/app/internal/service"
+	"encoding/hex"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport"
+	"google.golang.org/protobuf/proto"
+	"strconv"
+	"testing"
+	"time"
+)
+
+const testInternalOperation = "/api.InternalApp/GetUser"
+
+type testHeader map[string]string
+
+func (h testHeader) Get(key string) string        { return h[key] }
+func (h testHeader) Set(key string, value string) { h[key] = value }
+func (h testHeader) Keys() []string {
+	keys := make([]string, 0, len(h))
+	for k := range h {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+type testTransport struct {
+	header testHeader
+}
+
+func (t *testTransport) Kind() transport.Kind            { return transport.KindGRPC }
+func (t *testTransport) Endpoint() string                { return "" }
+func (t *testTransport) Operation() string               { return testInternalOperation }
+func (t *testTransport) RequestHeader() transport.Header { return t.header }
+func (t *testTransport) ReplyHeader() transport.Header   { return testHeader{} }
+
+type testNonceRepo struct {
+	biz.InternalTransferRepo
+	used map[string]bool
+}
+
+func (r *testNonceRepo) UseNonce(ctx context.Context, caller string, nonce string, ttl time.Duration) (bool, error) {
+	if r.used[caller+":"+nonce] {
+		return false, nil
+	}
+	r.used[caller+":"+nonce] = true
+	return true, nil
+}
+
+func testInternalSign(secret string, id string, timestamp string, nonce string, req proto.Message) string {
+	body, _ := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	bodyHash := sha256.Sum256(body)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(id + ":" + timestamp + ":" + nonce + ":" + testInternalOperation + ":" + hex.EncodeToString(bodyHash[:])))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestInternalAuthHmac(t *testing.T) {
+	c := &conf.Internal{Clients: []*conf.Internal_Client{{Id: "ops", Secret: "s3cret"}}}
+	iuc := biz.NewInternalUseCase(nil, nil, nil, nil, &testNonceRepo{used: map[string]bool{}}, nil, nil, nil, nil, log.DefaultLogger)
+	auth := InternalAuth(c, iuc)(func(ctx context.Context, req interface{}) (interface{}, error) {
+		return service.CallerFromContext(ctx), nil
+	})
+
+	req := &v1.InternalGetUserRequest{Id: 7}
+	now := strconv.FormatInt(time.Now().Unix(), 10)
+	old := strconv.FormatInt(time.Now().Add(-10*time.Minute).Unix(), 10)
+	tests := []struct {
+		name      string
+		id        string
+		timestamp string
+		nonce     string
+		sign      string
+		req       proto.Message
+		ok        bool
+	}{
+		{"valid", "ops", now, "n1", testInternalSign("s3cret", "ops", now, "n1", req), req, true},
+		{"nonce reused", "ops", now, "n1", testInternalSign("s3cret", "ops", now, "n1", req), req, false},
+		{"unknown caller", "dev", now, "n2", testInternalSign("s3cret", "dev", now, "n2", req), req, false},
+		{"wrong secret", "ops", now, "n3", testInternalSign("other", "ops", now, "n3", req), req, false},
+		{"body changed", "ops", now, "n4", testInternalSign("s3cret", "ops", now, "n4", req), &v1.InternalGetUserRequest{Id: 8}, false},
+		{"expired", "ops", old, "n5", testInternalSign("s3cret", "ops", old, "n5", req), req, false},
+		{"bad timestamp", "ops", "x", "n6", testInternalSign("s3cret", "ops", "x", "n6", req), req, false},
+		{"empty nonce", "ops", now, "", testInternalSign("s3cret", "ops", now, "", req), req, false},
+		{"no signature", "ops", now, "n7", "", req, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := transport.NewServerContext(context.Background(), &testTransport{header: testHeader{
+				"x-caller-id": tt.id,
+				"x-timestamp": tt.timestamp,
+				"x-nonce":     tt.nonce,
+				"x-signature": tt.sign,
+			}})
+			reply, err := auth(ctx, tt.req)
+			if tt.ok {
+				if nil != err || "ops" != reply {
+					t.Fatalf("InternalAuth() = %v, %v, want caller ops", reply, err)
+				}
+				return
+			}
+			if v1.ErrorReason_CALLER_UNAUTHORIZED.String() != errors.Reason(err) {
+				t.Errorf("InternalAuth() error = %v, want CALLER_UNAUTHORIZED", err)
+			}
+		})
+	}
+
+	if _, err := auth(context.Background(), req); v1.ErrorReason_CALLER_UNAUTHORIZED.String() != errors.Reason(err) {
+		t.Errorf("InternalAuth() without transport error = %v, want CALLER_UNAUTHORIZED", err)
+	}
+}
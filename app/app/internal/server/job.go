@@ -0,0 +1,202 @@
+package server
+
+import (
+	"context"
+	"dhb/app/app/internal/biz"
+	"dhb/app/app/internal/conf"
+	"dhb/app/app/internal/pkg/cron"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/log"
+	"os"
+	"sync"
+	"time"
+)
+
+// JobServer 定时任务调度，多节点部署时通过租约选出一个主节点执行。
+// 租约在单独的协程里续约，任务执行时间再长也不会影响续约，续约失败时取消正在执行的任务
+type JobServer struct {
+	c       *conf.Scheduler
+	juc     *biz.JobUseCase
+	day     *biz.BusinessDay
+	owner   string
+	lease   time.Duration
+	entries []*jobEntry
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+	elected chan struct{}
+	mu      sync.Mutex
+	leader  context.Context // 主节点期间有效，失去租约时取消
+	resign  context.CancelFunc
+	log     *log.Helper
+}
+
+type jobEntry struct {
+	name     string
+	schedule *cron.Schedule
+	next     time.Time
+}
+
+// NewJobServer new a job server.
+func NewJobServer(c *conf.Scheduler, juc *biz.JobUseCase, day *biz.BusinessDay, logger log.Logger) (*JobServer, error) {
+	hostname, _ := os.Hostname()
+	s := &JobServer{
+		c:       c,
+		juc:     juc,
+		day:     day,
+		owner:   fmt.Sprintf("%s-%d", hostname, os.Getpid()),
+		lease:   time.Minute,
+		elected: make(chan struct{}, 1),
+		log:     log.NewHelper(logger),
+	}
+	if nil != c.GetLease() && 0 < c.GetLease().AsDuration() {
+		s.lease = c.GetLease().AsDuration()
+	}
+
+	known := make(map[string]bool, 0)
+	for _, name := range juc.JobNames() {
+		known[name] = true
+	}
+	for _, v := range c.GetJobs() {
+		if !known[v.Name] {
+			return nil, fmt.Errorf("scheduler: unknown job %q", v.Name)
+		}
+
+		schedule, err := cron.Parse(v.Spec)
+		if nil != err {
+			return nil, fmt.Errorf("scheduler: job %q: %v", v.Name, err)
+		}
+		s.entries = append(s.entries, &jobEntry{name: v.Name, schedule: schedule})
+	}
+
+	return s, nil
+}
+
+// Start 实现 transport.Server
+func (s *JobServer) Start(ctx context.Context) error {
+	if !s.c.GetEnable() || 0 >= len(s.entries) {
+		return nil
+	}
+
+	ctx, s.cancel = context.WithCancel(context.Background())
+	now := time.Now().In(s.day.Location())
+	for _, e := range s.entries {
+		e.next = e.schedule.Next(now)
+	}
+
+	s.wg.Add(2)
+	go s.renew(ctx)
+	go s.loop(ctx)
+	return nil
+}
+
+// Stop 实现 transport.Server
+func (s *JobServer) Stop(ctx context.Context) error {
+	if nil == s.cancel {
+		return nil
+	}
+
+	s.cancel()
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-ctx.Done():
+	}
+
+	s.juc.Resign(context.Background(), s.owner)
+	return nil
+}
+
+// renew 竞选和续约，成为主节点时通知 loop 补跑，失去租约时取消主节点上下文
+func (s *JobServer) renew(ctx context.Context) {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(s.lease / 3)
+	defer ticker.Stop()
+
+	for {
+		// 续约超时按失去租约处理，避免数据库卡住时仍以为自己是主节点
+		leadCtx, cancel := context.WithTimeout(ctx, s.lease/3)
+		isLeader := s.juc.Lead(leadCtx, s.owner, s.lease)
+		cancel()
+
+		s.mu.Lock()
+		if isLeader && nil == s.leader {
+			s.log.Infof("scheduler: %s became leader", s.owner)
+			s.leader, s.resign = context.WithCancel(ctx)
+			select {
+			case s.elected <- struct{}{}:
+			default:
+			}
+		} else if !isLeader && nil != s.leader {
+			s.log.Warnf("scheduler: %s lost leader lease", s.owner)
+			s.resign()
+			s.leader, s.resign = nil, nil
+		}
+		s.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// leading 当前是主节点时返回主节点上下文，否则返回 nil
+func (s *JobServer) leading() context.Context {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.leader
+}
+
+func (s *JobServer) loop(ctx context.Context) {
+	defer s.wg.Done()
+
+	trigger := time.NewTicker(time.Minute)
+	defer trigger.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.elected:
+			if leader := s.leading(); nil != leader {
+				s.catchUp(leader)
+			}
+		case <-trigger.C:
+			now := time.Now().In(s.day.Location())
+			for _, e := range s.entries {
+				if e.next.IsZero() || now.Before(e.next) {
+					continue
+				}
+
+				e.next = e.schedule.Next(now)
+				if leader := s.leading(); nil != leader {
+					s.run(leader, e.name, now)
+				}
+			}
+		}
+	}
+}
+
+// catchUp 成为主节点时补跑错过的每日任务
+func (s *JobServer) catchUp(ctx context.Context) {
+	s.juc.Takeover(ctx, s.owner)
+
+	now := time.Now()
+	for _, e := range s.entries {
+		if s.juc.IsDaily(e.name) {
+			s.run(ctx, e.name, now)
+		}
+	}
+}
+
+func (s *JobServer) run(ctx context.Context, name string, now time.Time) {
+	if err := s.juc.RunJob(ctx, name, s.owner, now, s.c.GetCatchUpDays()); nil != err {
+		s.log.Errorf("scheduler: job %s: %v", name, err)
+	}
+}
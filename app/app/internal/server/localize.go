@@ -0,0 +1,55 @@
+package server
+
+import (
+	"context"
+	"dhb/app/app/internal/biz"
+	"dhb/app/app/internal/conf"
+	"dhb/app/app/internal/pkg/i18n"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/middleware/auth/jwt"
+	"github.com/go-kratos/kratos/v2/transport"
+	jwt2 "github.com/golang-jwt/jwt/v4"
+)
+
+// NewCatalog 语言包 .
+func NewCatalog(c *conf.I18N) (*i18n.Catalog, error) {
+	return i18n.NewCatalog(c.GetDir(), c.GetDefaultLocale())
+}
+
+// Localize 按 Accept-Language 确定语言并翻译错误信息，放在最外层，jwt 和限流的错误也要翻译
+func Localize(catalog *i18n.Catalog) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			l := catalog.Default()
+			if tr, ok := transport.FromServerContext(ctx); ok {
+				l = catalog.Match(tr.RequestHeader().Get("Accept-Language"))
+			}
+			ctx = i18n.NewContext(ctx, l)
+
+			reply, err := handler(ctx, req)
+			if nil != err {
+				return reply, i18n.FromContext(ctx).LocalizeError(err)
+			}
+			return reply, nil
+		}
+	}
+}
+
+// UserLocale 放在 jwt 之后，用户设置了语言时优先使用
+func UserLocale(catalog *i18n.Catalog, uuc *biz.UserUseCase) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			if claims, ok := jwt.FromContext(ctx); ok {
+				if c, ok := claims.(jwt2.MapClaims); ok {
+					if userId, ok := c["UserId"].(float64); ok && 0 < userId {
+						if l, ok := catalog.Get(uuc.GetUserLocale(ctx, int64(userId))); ok {
+							i18n.SetLocale(ctx, l)
+						}
+					}
+				}
+			}
+
+			return handler(ctx, req)
+		}
+	}
+}
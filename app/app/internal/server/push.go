@@ -0,0 +1,86 @@
+package server
+
+import (
+	"context"
+	"dhb/app/app/internal/biz"
+	"dhb/app/app/internal/conf"
+	"github.com/go-kratos/kratos/v2/log"
+	"time"
+)
+
+// PushServer 读取发件箱推送给本节点的连接，每个节点都运行
+type PushServer struct {
+	c      *conf.Push
+	hub    *biz.PushHub
+	poll   time.Duration
+	cancel context.CancelFunc
+	done   chan struct{}
+	log    *log.Helper
+}
+
+// NewPushServer new a push server.
+func NewPushServer(c *conf.Push, hub *biz.PushHub, logger log.Logger) *PushServer {
+	s := &PushServer{
+		c:    c,
+		hub:  hub,
+		poll: time.Second,
+		log:  log.NewHelper(logger),
+	}
+	if nil != c.GetPoll() && 0 < c.GetPoll().AsDuration() {
+		s.poll = c.GetPoll().AsDuration()
+	}
+
+	return s
+}
+
+// Start 实现 transport.Server
+func (s *PushServer) Start(ctx context.Context) error {
+	if !s.c.GetEnable() {
+		return nil
+	}
+
+	ctx, s.cancel = context.WithCancel(context.Background())
+	s.done = make(chan struct{})
+	go s.loop(ctx)
+	return nil
+}
+
+// Stop 实现 transport.Server
+func (s *PushServer) Stop(ctx context.Context) error {
+	if nil == s.cancel {
+		return nil
+	}
+
+	s.cancel()
+	select {
+	case <-s.done:
+	case <-ctx.Done():
+	}
+	return nil
+}
+
+func (s *PushServer) loop(ctx context.Context) {
+	defer close(s.done)
+
+	ticker := time.NewTicker(s.poll)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			// 有积压时连续推送，直到追上
+			for nil == ctx.Err() {
+				n, err := s.hub.Poll(ctx, time.Now())
+				if nil != err {
+					s.log.Errorf("push poll: %v", err)
+					break
+				}
+				if 0 >= n {
+					break
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,125 @@
+package server
+
+import (
+	"context"
+	v1 "dhb/app/app/api"
+	"dhb/app/app/internal/biz"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/middleware/auth/jwt"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/go-kratos/kratos/v2/transport/http"
+	jwt2 "github.com/golang-jwt/jwt/v4"
+	"google.golang.org/grpc/peer"
+	"math"
+	"net"
+	"strings"
+	"time"
+)
+
+// IpRateLimit 按 ip 的总限流，放在 jwt 之前，无效 token 的请求也计数
+func IpRateLimit(limiter *biz.RateLimiter) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return handler(ctx, req)
+			}
+
+			ip := clientIp(ctx, tr, limiter)
+			if "" == ip {
+				return handler(ctx, req)
+			}
+
+			if allowed, retryAfter := limiter.AllowIp(ctx, ip); !allowed {
+				return nil, tooManyRequests(tr, retryAfter)
+			}
+
+			return handler(ctx, req)
+		}
+	}
+}
+
+// RateLimit 限流，放在 jwt 之后，有 token 时按用户，白名单接口按 ip
+func RateLimit(limiter *biz.RateLimiter) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return handler(ctx, req)
+			}
+
+			subject := rateLimitSubject(ctx, tr, limiter)
+			if "" == subject {
+				return handler(ctx, req)
+			}
+
+			if allowed, retryAfter := limiter.Allow(ctx, tr.Operation(), subject); !allowed {
+				return nil, tooManyRequests(tr, retryAfter)
+			}
+
+			return handler(ctx, req)
+		}
+	}
+}
+
+func tooManyRequests(tr transport.Transporter, retryAfter time.Duration) error {
+	seconds := int64(math.Ceil(retryAfter.Seconds()))
+	if 1 > seconds {
+		seconds = 1
+	}
+	tr.ReplyHeader().Set("Retry-After", fmt.Sprintf("%d", seconds))
+	return v1.ErrorTooManyRequests("请求过于频繁，请稍后再试").
+		WithMetadata(map[string]string{"retry_after": fmt.Sprintf("%d", seconds)})
+}
+
+func rateLimitSubject(ctx context.Context, tr transport.Transporter, limiter *biz.RateLimiter) string {
+	if claims, ok := jwt.FromContext(ctx); ok {
+		if c, ok := claims.(jwt2.MapClaims); ok {
+			if userId, ok := c["UserId"].(float64); ok {
+				return fmt.Sprintf("user:%d", int64(userId))
+			}
+		}
+	}
+
+	if ip := clientIp(ctx, tr, limiter); "" != ip {
+		return "ip:" + ip
+	}
+	return ""
+}
+
+// clientIp 代理头可以伪造，只有部署在代理后面、直接连接的地址是配置的代理时才使用，
+// X-Forwarded-For 从右往左取第一个不是代理的地址，左边的部分由客户端填写，不可信
+func clientIp(ctx context.Context, tr transport.Transporter, limiter *biz.RateLimiter) string {
+	var addr string
+	if ht, ok := tr.(*http.Transport); ok {
+		addr = ht.Request().RemoteAddr
+	} else if p, ok := peer.FromContext(ctx); ok {
+		addr = p.Addr.String()
+	}
+	if host, _, err := net.SplitHostPort(addr); nil == err {
+		addr = host
+	}
+
+	remote := net.ParseIP(addr)
+	if !limiter.TrustProxy() || nil == remote || !limiter.TrustedProxy(remote) {
+		return addr
+	}
+
+	if forwarded := tr.RequestHeader().Get("X-Forwarded-For"); "" != forwarded {
+		hops := strings.Split(forwarded, ",")
+		for i := len(hops) - 1; i >= 0; i-- {
+			ip := net.ParseIP(strings.TrimSpace(hops[i]))
+			if nil == ip {
+				break // 格式错误，之前的部分不再可信
+			}
+			if 0 == i || !limiter.TrustedProxy(ip) {
+				return ip.String()
+			}
+		}
+	}
+	if realIp := net.ParseIP(strings.TrimSpace(tr.RequestHeader().Get("X-Real-IP"))); nil != realIp {
+		return realIp.String()
+	}
+	return addr
+}
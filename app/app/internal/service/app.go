@@ -7,6 +7,7 @@ import (
 	v1 "dhb/app/app/api"
 	"dhb/app/app/internal/biz"
 	"dhb/app/app/internal/conf"
+	"dhb/app/app/internal/pkg/i18n"
 	"dhb/app/app/internal/pkg/middleware/auth"
 	"encoding/json"
 	"fmt"
@@ -37,13 +38,23 @@ type AppService struct {
 
 	uuc *biz.UserUseCase
 	ruc *biz.RecordUseCase
+	auc *biz.AreaUseCase
+	juc *biz.JobUseCase
+	puc *biz.PositionUseCase
+	muc *biz.MatrixUseCase
+	suc *biz.StatementUseCase
+	wuc *biz.WebhookUseCase
+	cuc *biz.CacheUseCase
+	hub *biz.PushHub
 	log *log.Helper
 	ca  *conf.Auth
+
+	catalog *i18n.Catalog
 }
 
 // NewAppService new a service.
-func NewAppService(uuc *biz.UserUseCase, ruc *biz.RecordUseCase, logger log.Logger, ca *conf.Auth) *AppService {
-	return &AppService{uuc: uuc, ruc: ruc, log: log.NewHelper(logger), ca: ca}
+func NewAppService(uuc *biz.UserUseCase, ruc *biz.RecordUseCase, auc *biz.AreaUseCase, juc *biz.JobUseCase, puc *biz.PositionUseCase, muc *biz.MatrixUseCase, suc *biz.StatementUseCase, wuc *biz.WebhookUseCase, cuc *biz.CacheUseCase, hub *biz.PushHub, catalog *i18n.Catalog, logger log.Logger, ca *conf.Auth) *AppService {
+	return &AppService{uuc: uuc, ruc: ruc, auc: auc, juc: juc, puc: puc, muc: muc, suc: suc, wuc: wuc, cuc: cuc, hub: hub, catalog: catalog, log: log.NewHelper(logger), ca: ca}
 }
 
 // EthAuthorize ethAuthorize.
@@ -51,7 +62,7 @@ func (a *AppService) EthAuthorize(ctx context.Context, req *v1.EthAuthorizeReque
 	userAddress := req.SendBody.Address // 以太坊账户
 	if "" == userAddress || 20 > len(userAddress) ||
 		strings.EqualFold("0x000000000000000000000000000000000000dead", userAddress) {
-		return nil, errors.New(500, "AUTHORIZE_ERROR", "账户地址参数错误")
+		return nil, v1.ErrorAddressInvalid("账户地址参数错误")
 	}
 
 	// 验证
@@ -61,10 +72,10 @@ func (a *AppService) EthAuthorize(ctx context.Context, req *v1.EthAuthorizeReque
 	)
 	res, err = addressCheck(userAddress)
 	if nil != err {
-		return nil, errors.New(500, "AUTHORIZE_ERROR", "地址验证失败")
+		return nil, v1.ErrorChainUnavailable("地址验证失败")
 	}
 	if !res {
-		return nil, errors.New(500, "AUTHORIZE_ERROR", "地址格式错误")
+		return nil, v1.ErrorAddressInvalid("地址格式错误")
 	}
 
 	var (
@@ -72,7 +83,7 @@ func (a *AppService) EthAuthorize(ctx context.Context, req *v1.EthAuthorizeReque
 	)
 	res, addressFromSign = verifySig(req.SendBody.Sign, []byte(userAddress))
 	if !res || addressFromSign != userAddress {
-		return nil, errors.New(500, "AUTHORIZE_ERROR", "地址签名错误")
+		return nil, v1.ErrorSignatureInvalid("地址签名错误")
 	}
 
 	//var (
@@ -110,7 +121,7 @@ func (a *AppService) EthAuthorize(ctx context.Context, req *v1.EthAuthorizeReque
 	}
 	token, err := auth.CreateToken(claims, a.ca.JwtKey)
 	if err != nil {
-		return nil, errors.New(500, "AUTHORIZE_ERROR", "生成token失败")
+		return nil, v1.ErrorInternalError("生成token失败")
 	}
 
 	userInfoRsp := v1.EthAuthorizeReply{
@@ -131,7 +142,7 @@ func (a *AppService) UserArea(ctx context.Context, req *v1.UserAreaRequest) (*v1
 	if claims, ok := jwt.FromContext(ctx); ok {
 		c := claims.(jwt2.MapClaims)
 		if c["UserId"] == nil {
-			return nil, errors.New(500, "ERROR_TOKEN", "无效TOKEN")
+			return nil, v1.ErrorTokenInvalid("无效TOKEN")
 		}
 		userId = int64(c["UserId"].(float64))
 	}
@@ -148,7 +159,7 @@ func (a *AppService) UserInfo(ctx context.Context, req *v1.UserInfoRequest) (*v1
 	if claims, ok := jwt.FromContext(ctx); ok {
 		c := claims.(jwt2.MapClaims)
 		if c["UserId"] == nil {
-			return nil, errors.New(500, "ERROR_TOKEN", "无效TOKEN")
+			return nil, v1.ErrorTokenInvalid("无效TOKEN")
 		}
 		userId = int64(c["UserId"].(float64))
 	}
@@ -165,7 +176,7 @@ func (a *AppService) RecommendUpdate(ctx context.Context, req *v1.RecommendUpdat
 	if claims, ok := jwt.FromContext(ctx); ok {
 		c := claims.(jwt2.MapClaims)
 		if c["UserId"] == nil {
-			return nil, errors.New(500, "ERROR_TOKEN", "无效TOKEN")
+			return nil, v1.ErrorTokenInvalid("无效TOKEN")
 		}
 		userId = int64(c["UserId"].(float64))
 	}
@@ -185,7 +196,7 @@ func (a *AppService) RecommendUpdate(ctx context.Context, req *v1.RecommendUpdat
 	)
 	res, addressFromSign = verifySig(req.SendBody.Sign, []byte(user.Address))
 	if !res || addressFromSign != user.Address {
-		return nil, errors.New(500, "AUTHORIZE_ERROR", "地址签名错误")
+		return nil, v1.ErrorSignatureInvalid("地址签名错误")
 	}
 
 	return a.uuc.UpdateUserRecommend(ctx, &biz.User{
@@ -200,7 +211,7 @@ func (a *AppService) RewardList(ctx context.Context, req *v1.RewardListRequest)
 	if claims, ok := jwt.FromContext(ctx); ok {
 		c := claims.(jwt2.MapClaims)
 		if c["UserId"] == nil {
-			return nil, errors.New(500, "ERROR_TOKEN", "无效TOKEN")
+			return nil, v1.ErrorTokenInvalid("无效TOKEN")
 		}
 		userId = int64(c["UserId"].(float64))
 	}
@@ -216,12 +227,12 @@ func (a *AppService) RecommendRewardList(ctx context.Context, req *v1.RecommendR
 	if claims, ok := jwt.FromContext(ctx); ok {
 		c := claims.(jwt2.MapClaims)
 		if c["UserId"] == nil {
-			return nil, errors.New(500, "ERROR_TOKEN", "无效TOKEN")
+			return nil, v1.ErrorTokenInvalid("无效TOKEN")
 		}
 		userId = int64(c["UserId"].(float64))
 	}
 
-	return a.uuc.RecommendRewardList(ctx, &biz.User{
+	return a.uuc.RecommendRewardList(ctx, req, &biz.User{
 		ID: userId,
 	})
 }
@@ -232,12 +243,12 @@ func (a *AppService) FeeRewardList(ctx context.Context, req *v1.FeeRewardListReq
 	if claims, ok := jwt.FromContext(ctx); ok {
 		c := claims.(jwt2.MapClaims)
 		if c["UserId"] == nil {
-			return nil, errors.New(500, "ERROR_TOKEN", "无效TOKEN")
+			return nil, v1.ErrorTokenInvalid("无效TOKEN")
 		}
 		userId = int64(c["UserId"].(float64))
 	}
 
-	return a.uuc.FeeRewardList(ctx, &biz.User{
+	return a.uuc.FeeRewardList(ctx, req, &biz.User{
 		ID: userId,
 	})
 }
@@ -248,14 +259,14 @@ func (a *AppService) WithdrawList(ctx context.Context, req *v1.WithdrawListReque
 	if claims, ok := jwt.FromContext(ctx); ok {
 		c := claims.(jwt2.MapClaims)
 		if c["UserId"] == nil {
-			return nil, errors.New(500, "ERROR_TOKEN", "无效TOKEN")
+			return nil, v1.ErrorTokenInvalid("无效TOKEN")
 		}
 		userId = int64(c["UserId"].(float64))
 	}
 
-	return a.uuc.WithdrawList(ctx, &biz.User{
+	return a.uuc.WithdrawList(ctx, req, &biz.User{
 		ID: userId,
-	}, req.Type)
+	})
 }
 
 func (a *AppService) TradeList(ctx context.Context, req *v1.TradeListRequest) (*v1.TradeListReply, error) {
@@ -264,12 +275,12 @@ func (a *AppService) TradeList(ctx context.Context, req *v1.TradeListRequest) (*
 	if claims, ok := jwt.FromContext(ctx); ok {
 		c := claims.(jwt2.MapClaims)
 		if c["UserId"] == nil {
-			return nil, errors.New(500, "ERROR_TOKEN", "无效TOKEN")
+			return nil, v1.ErrorTokenInvalid("无效TOKEN")
 		}
 		userId = int64(c["UserId"].(float64))
 	}
 
-	return a.uuc.TradeList(ctx, &biz.User{
+	return a.uuc.TradeList(ctx, req, &biz.User{
 		ID: userId,
 	})
 }
@@ -280,14 +291,92 @@ func (a *AppService) TranList(ctx context.Context, req *v1.TranListRequest) (*v1
 	if claims, ok := jwt.FromContext(ctx); ok {
 		c := claims.(jwt2.MapClaims)
 		if c["UserId"] == nil {
-			return nil, errors.New(500, "ERROR_TOKEN", "无效TOKEN")
+			return nil, v1.ErrorTokenInvalid("无效TOKEN")
+		}
+		userId = int64(c["UserId"].(float64))
+	}
+
+	return a.uuc.TranList(ctx, req, &biz.User{
+		ID: userId,
+	})
+}
+
+// CreateStatement 生成对账单 .
+func (a *AppService) CreateStatement(ctx context.Context, req *v1.CreateStatementRequest) (*v1.CreateStatementReply, error) {
+	// 在上下文 context 中取出 claims 对象
+	var userId int64
+	if claims, ok := jwt.FromContext(ctx); ok {
+		c := claims.(jwt2.MapClaims)
+		if c["UserId"] == nil {
+			return nil, v1.ErrorTokenInvalid("无效TOKEN")
+		}
+		userId = int64(c["UserId"].(float64))
+	}
+
+	return a.suc.CreateStatement(ctx, req, &biz.User{
+		ID: userId,
+	})
+}
+
+// GetStatement 对账单状态和下载链接 .
+func (a *AppService) GetStatement(ctx context.Context, req *v1.GetStatementRequest) (*v1.CreateStatementReply, error) {
+	// 在上下文 context 中取出 claims 对象
+	var userId int64
+	if claims, ok := jwt.FromContext(ctx); ok {
+		c := claims.(jwt2.MapClaims)
+		if c["UserId"] == nil {
+			return nil, v1.ErrorTokenInvalid("无效TOKEN")
+		}
+		userId = int64(c["UserId"].(float64))
+	}
+
+	return a.suc.GetStatement(ctx, req, &biz.User{
+		ID: userId,
+	})
+}
+
+// DownloadStatement 对账单下载，靠链接签名鉴权，不走 jwt .
+func (a *AppService) DownloadStatement(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	id, _ := strconv.ParseInt(query.Get("id"), 10, 64)
+	expires, _ := strconv.ParseInt(query.Get("expires"), 10, 64)
+
+	name, content, err := a.suc.Download(r.Context(), id, expires, query.Get("sign"))
+	if nil != err {
+		e := errors.FromError(a.catalog.Match(r.Header.Get("Accept-Language")).LocalizeError(err))
+		http.Error(w, e.Message, int(e.Code))
+		return
+	}
+
+	contentType := "text/csv; charset=utf-8"
+	if strings.HasSuffix(name, ".pdf") {
+		contentType = "application/pdf"
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", name))
+	_, _ = w.Write(content)
+}
+
+// History 历史记录 .
+func (a *AppService) History(ctx context.Context, req *v1.HistoryRequest) (*v1.HistoryReply, error) {
+	// 在上下文 context 中取出 claims 对象
+	var userId int64
+	if claims, ok := jwt.FromContext(ctx); ok {
+		c := claims.(jwt2.MapClaims)
+		if c["UserId"] == nil {
+			return nil, v1.ErrorTokenInvalid("无效TOKEN")
 		}
 		userId = int64(c["UserId"].(float64))
 	}
 
-	return a.uuc.TranList(ctx, &biz.User{
+	return a.uuc.History(ctx, req, &biz.User{
 		ID: userId,
-	}, req.Type, req.Tran)
+	})
+}
+
+// FeePreview 手续费试算.
+func (a *AppService) FeePreview(ctx context.Context, req *v1.FeePreviewRequest) (*v1.FeePreviewReply, error) {
+	return a.uuc.FeePreview(ctx, req)
 }
 
 // PasswordChange withdraw.
@@ -307,7 +396,7 @@ func (a *AppService) Exchange(ctx context.Context, req *v1.ExchangeRequest) (*v1
 	if claims, ok := jwt.FromContext(ctx); ok {
 		c := claims.(jwt2.MapClaims)
 		if c["UserId"] == nil {
-			return nil, errors.New(500, "ERROR_TOKEN", "无效TOKEN")
+			return nil, v1.ErrorTokenInvalid("无效TOKEN")
 		}
 		//if c["Password"] == nil {
 		//	return nil, errors.New(403, "ERROR_TOKEN", "无效TOKEN")
@@ -330,7 +419,7 @@ func (a *AppService) Exchange(ctx context.Context, req *v1.ExchangeRequest) (*v1
 	)
 	res, addressFromSign = verifySig(req.SendBody.Sign, []byte(user.Address))
 	if !res || addressFromSign != user.Address {
-		return nil, errors.New(500, "AUTHORIZE_ERROR", "地址签名错误")
+		return nil, v1.ErrorSignatureInvalid("地址签名错误")
 	}
 
 	return a.uuc.Exchange(ctx, req, &biz.User{
@@ -338,6 +427,40 @@ func (a *AppService) Exchange(ctx context.Context, req *v1.ExchangeRequest) (*v1
 	})
 }
 
+// BuyLocation 购买套餐 .
+func (a *AppService) BuyLocation(ctx context.Context, req *v1.BuyLocationRequest) (*v1.BuyLocationReply, error) {
+	var (
+		userId int64
+		err    error
+	)
+	if claims, ok := jwt.FromContext(ctx); ok {
+		c := claims.(jwt2.MapClaims)
+		if c["UserId"] == nil {
+			return nil, v1.ErrorTokenInvalid("无效TOKEN")
+		}
+		userId = int64(c["UserId"].(float64))
+	}
+
+	var (
+		user *biz.User
+	)
+	user, err = a.uuc.GetUserByUserId(ctx, userId)
+	if nil != err {
+		return nil, err
+	}
+
+	var (
+		res             bool
+		addressFromSign string
+	)
+	res, addressFromSign = verifySig(req.SendBody.Sign, []byte(user.Address))
+	if !res || addressFromSign != user.Address {
+		return nil, v1.ErrorSignatureInvalid("地址签名错误")
+	}
+
+	return a.uuc.BuyLocation(ctx, req, user)
+}
+
 // Withdraw withdraw.
 func (a *AppService) Withdraw(ctx context.Context, req *v1.WithdrawRequest) (*v1.WithdrawReply, error) {
 	// 在上下文 context 中取出 claims 对象
@@ -350,7 +473,7 @@ func (a *AppService) Withdraw(ctx context.Context, req *v1.WithdrawRequest) (*v1
 	if claims, ok := jwt.FromContext(ctx); ok {
 		c := claims.(jwt2.MapClaims)
 		if c["UserId"] == nil {
-			return nil, errors.New(500, "ERROR_TOKEN", "无效TOKEN")
+			return nil, v1.ErrorTokenInvalid("无效TOKEN")
 		}
 		//if c["Password"] == nil {
 		//	return nil, errors.New(403, "ERROR_TOKEN", "无效TOKEN")
@@ -373,7 +496,7 @@ func (a *AppService) Withdraw(ctx context.Context, req *v1.WithdrawRequest) (*v1
 	)
 	res, addressFromSign = verifySig(req.SendBody.Sign, []byte(user.Address))
 	if !res || addressFromSign != user.Address {
-		return nil, errors.New(500, "AUTHORIZE_ERROR", "地址签名错误")
+		return nil, v1.ErrorSignatureInvalid("地址签名错误")
 	}
 
 	//if "" == req.SendBody.Password || 6 > len(req.SendBody.Password) {
@@ -384,6 +507,7 @@ func (a *AppService) Withdraw(ctx context.Context, req *v1.WithdrawRequest) (*v1
 
 	return a.uuc.Withdraw(ctx, req, &biz.User{
 		ID:       userId,
+		Address:  user.Address,
 		Password: tokenPassword,
 	}, "")
 }
@@ -398,10 +522,10 @@ func (a *AppService) Tran(ctx context.Context, req *v1.TranRequest) (*v1.TranRep
 	if claims, ok := jwt.FromContext(ctx); ok {
 		c := claims.(jwt2.MapClaims)
 		if c["UserId"] == nil {
-			return nil, errors.New(500, "ERROR_TOKEN", "无效TOKEN")
+			return nil, v1.ErrorTokenInvalid("无效TOKEN")
 		}
 		if c["Password"] == nil {
-			return nil, errors.New(403, "ERROR_TOKEN", "无效TOKEN")
+			return nil, v1.ErrorTokenInvalid("无效TOKEN")
 		}
 
 		userId = int64(c["UserId"].(float64))
@@ -409,7 +533,7 @@ func (a *AppService) Tran(ctx context.Context, req *v1.TranRequest) (*v1.TranRep
 	}
 
 	if "" == req.SendBody.Password || 6 > len(req.SendBody.Password) {
-		return nil, errors.New(500, "AUTHORIZE_ERROR", "账户密码必须大于6位")
+		return nil, v1.ErrorPasswordInvalid("账户密码必须大于6位")
 	}
 	// TODO 验证签名
 	password := fmt.Sprintf("%x", md5.Sum([]byte(req.SendBody.Password)))
@@ -436,32 +560,36 @@ func (a *AppService) GetTrade(ctx context.Context, req *v1.GetTradeRequest) (*v1
 	amountFloatCsd = amountFloat * 10000000000
 	amount, _ := strconv.ParseInt(strconv.FormatFloat(amountFloatCsd, 'f', -1, 64), 10, 64)
 	if 10000000000 > amount {
-		return nil, errors.New(500, "ERROR_TOKEN", "输入错误")
+		return nil, v1.ErrorAmountInvalid("输入错误")
 	}
 
-	csd, err = GetAmountOut(req.SendBody.Amount + "000000000000000000")
+	err = a.cuc.Price(ctx, "pancake", req.SendBody.Amount, &csd, func(ctx context.Context) (interface{}, error) {
+		return GetAmountOut(req.SendBody.Amount + "000000000000000000")
+	})
 	if nil != err {
 		fmt.Println(2)
-		return nil, errors.New(500, "ERROR_TOKEN", "查询币价错误")
+		return nil, v1.ErrorPriceUnavailable("查询币价错误")
 	}
 	lenValue := len(csd)
 	if 10 > lenValue {
-		return nil, errors.New(500, "ERROR_TOKEN", "币价过低")
+		return nil, v1.ErrorAmountTooSmall("币价过低")
 	}
 	tmpValue, _ = strconv.ParseInt(csd[0:lenValue-8], 10, 64)
 	if 0 == tmpValue {
-		return nil, errors.New(500, "ERROR_TOKEN", "币价过低")
+		return nil, v1.ErrorAmountTooSmall("币价过低")
 	}
 
-	hbs, err = requestHbsResult()
+	err = a.cuc.Price(ctx, "hbs", "usd", &hbs, func(ctx context.Context) (interface{}, error) {
+		return requestHbsResult()
+	})
 	if nil != err {
 		fmt.Println(1)
-		return nil, errors.New(500, "ERROR_TOKEN", "查询币价错误")
+		return nil, v1.ErrorPriceUnavailable("查询币价错误")
 	}
 	amountFloatHbs = amountFloat * 10
 	amountB = int64(amountFloatHbs / hbs * 10000000000)
 	if 0 >= amountB {
-		return nil, errors.New(500, "ERROR_TOKEN", "币价错误")
+		return nil, v1.ErrorPriceUnavailable("币价错误")
 	}
 
 	return &v1.GetTradeReply{
@@ -488,10 +616,10 @@ func (a *AppService) Trade(ctx context.Context, req *v1.WithdrawRequest) (*v1.Wi
 	if claims, ok := jwt.FromContext(ctx); ok {
 		c := claims.(jwt2.MapClaims)
 		if c["UserId"] == nil {
-			return nil, errors.New(500, "ERROR_TOKEN", "无效TOKEN")
+			return nil, v1.ErrorTokenInvalid("无效TOKEN")
 		}
 		if c["Password"] == nil {
-			return nil, errors.New(403, "ERROR_TOKEN", "无效TOKEN")
+			return nil, v1.ErrorTokenInvalid("无效TOKEN")
 		}
 
 		userId = int64(c["UserId"].(float64))
@@ -499,7 +627,7 @@ func (a *AppService) Trade(ctx context.Context, req *v1.WithdrawRequest) (*v1.Wi
 	}
 
 	if "" == req.SendBody.Password || 6 > len(req.SendBody.Password) {
-		return nil, errors.New(500, "AUTHORIZE_ERROR", "账户密码必须大于6位")
+		return nil, v1.ErrorPasswordInvalid("账户密码必须大于6位")
 	}
 	// TODO 验证签名
 	password := fmt.Sprintf("%x", md5.Sum([]byte(req.SendBody.Password)))
@@ -508,36 +636,39 @@ func (a *AppService) Trade(ctx context.Context, req *v1.WithdrawRequest) (*v1.Wi
 	amountFloatCsd = amountFloat * 10000000000
 	amount, _ := strconv.ParseInt(strconv.FormatFloat(amountFloatCsd, 'f', -1, 64), 10, 64)
 	if 10000000000 > amount {
-		return &v1.WithdrawReply{
-			Status: "fail",
-		}, nil
+		return nil, v1.ErrorAmountTooSmall("最小数量:%s", "1").
+			WithMetadata(map[string]string{"min": "1"})
 	}
 
 	//if 0 != amount%10 {
 	//	return nil, errors.New(500, "ERROR_TOKEN", "10的整数倍")
 	//}
 
-	csd, err = GetAmountOut(req.SendBody.Amount + "000000000000000000")
+	err = a.cuc.Price(ctx, "pancake", req.SendBody.Amount, &csd, func(ctx context.Context) (interface{}, error) {
+		return GetAmountOut(req.SendBody.Amount + "000000000000000000")
+	})
 	if nil != err {
-		return nil, errors.New(500, "ERROR_TOKEN", "查询币价错误")
+		return nil, v1.ErrorPriceUnavailable("查询币价错误")
 	}
 	lenValue := len(csd)
 	if 10 > lenValue {
-		return nil, errors.New(500, "ERROR_TOKEN", "币价过低")
+		return nil, v1.ErrorAmountTooSmall("币价过低")
 	}
 	tmpValue, _ = strconv.ParseInt(csd[0:lenValue-8], 10, 64)
 	if 0 == tmpValue {
-		return nil, errors.New(500, "ERROR_TOKEN", "币价过低")
+		return nil, v1.ErrorAmountTooSmall("币价过低")
 	}
 
-	hbs, err = requestHbsResult()
+	err = a.cuc.Price(ctx, "hbs", "usd", &hbs, func(ctx context.Context) (interface{}, error) {
+		return requestHbsResult()
+	})
 	if nil != err {
-		return nil, errors.New(500, "ERROR_TOKEN", "查询币价错误")
+		return nil, v1.ErrorPriceUnavailable("查询币价错误")
 	}
 	amountFloatHbs = amountFloat * 10
 	amountB = int64(amountFloatHbs / hbs * 10000000000)
 	if 0 >= amountB {
-		return nil, errors.New(500, "ERROR_TOKEN", "币价错误")
+		return nil, v1.ErrorPriceUnavailable("币价错误")
 	}
 
 	//csdTrade, err = GetAmountOut(strconv.FormatInt((amount+amount*10)/10000000000, 10) + "000000000000000000")
@@ -566,7 +697,7 @@ func (a *AppService) SetBalanceReward(ctx context.Context, req *v1.SetBalanceRew
 	if claims, ok := jwt.FromContext(ctx); ok {
 		c := claims.(jwt2.MapClaims)
 		if c["UserId"] == nil {
-			return nil, errors.New(500, "ERROR_TOKEN", "无效TOKEN")
+			return nil, v1.ErrorTokenInvalid("无效TOKEN")
 		}
 		userId = int64(c["UserId"].(float64))
 	}
@@ -576,6 +707,23 @@ func (a *AppService) SetBalanceReward(ctx context.Context, req *v1.SetBalanceRew
 	})
 }
 
+// BalanceRewardSummary .
+func (a *AppService) BalanceRewardSummary(ctx context.Context, req *v1.BalanceRewardSummaryRequest) (*v1.BalanceRewardSummaryReply, error) {
+	// 在上下文 context 中取出 claims 对象
+	var userId int64
+	if claims, ok := jwt.FromContext(ctx); ok {
+		c := claims.(jwt2.MapClaims)
+		if c["UserId"] == nil {
+			return nil, v1.ErrorTokenInvalid("无效TOKEN")
+		}
+		userId = int64(c["UserId"].(float64))
+	}
+
+	return a.uuc.BalanceRewardSummary(ctx, &biz.User{
+		ID: userId,
+	})
+}
+
 // DeleteBalanceReward .
 func (a *AppService) DeleteBalanceReward(ctx context.Context, req *v1.DeleteBalanceRewardRequest) (*v1.DeleteBalanceRewardReply, error) {
 	// 在上下文 context 中取出 claims 对象
@@ -583,7 +731,7 @@ func (a *AppService) DeleteBalanceReward(ctx context.Context, req *v1.DeleteBala
 	if claims, ok := jwt.FromContext(ctx); ok {
 		c := claims.(jwt2.MapClaims)
 		if c["UserId"] == nil {
-			return nil, errors.New(500, "ERROR_TOKEN", "无效TOKEN")
+			return nil, v1.ErrorTokenInvalid("无效TOKEN")
 		}
 		userId = int64(c["UserId"].(float64))
 	}
@@ -593,6 +741,333 @@ func (a *AppService) DeleteBalanceReward(ctx context.Context, req *v1.DeleteBala
 	})
 }
 
+// WithdrawAddressList .
+func (a *AppService) WithdrawAddressList(ctx context.Context, req *v1.WithdrawAddressListRequest) (*v1.WithdrawAddressListReply, error) {
+	// 在上下文 context 中取出 claims 对象
+	var userId int64
+	if claims, ok := jwt.FromContext(ctx); ok {
+		c := claims.(jwt2.MapClaims)
+		if c["UserId"] == nil {
+			return nil, v1.ErrorTokenInvalid("无效TOKEN")
+		}
+		userId = int64(c["UserId"].(float64))
+	}
+
+	return a.uuc.WithdrawAddressList(ctx, &biz.User{
+		ID: userId,
+	})
+}
+
+// WithdrawAddressCreate 添加提现地址，校验 EIP-55 格式并拒绝合约地址 .
+func (a *AppService) WithdrawAddressCreate(ctx context.Context, req *v1.WithdrawAddressCreateRequest) (*v1.WithdrawAddressCreateReply, error) {
+	// 在上下文 context 中取出 claims 对象
+	var (
+		err    error
+		userId int64
+		user   *biz.User
+	)
+	if claims, ok := jwt.FromContext(ctx); ok {
+		c := claims.(jwt2.MapClaims)
+		if c["UserId"] == nil {
+			return nil, v1.ErrorTokenInvalid("无效TOKEN")
+		}
+		userId = int64(c["UserId"].(float64))
+	}
+
+	user, err = a.uuc.GetUserByUserId(ctx, userId)
+	if nil != err {
+		return nil, err
+	}
+
+	res, addressFromSign := verifySig(req.SendBody.Sign, []byte(user.Address))
+	if !res || addressFromSign != user.Address {
+		return nil, v1.ErrorSignatureInvalid("地址签名错误")
+	}
+
+	address, ok := checksumAddress(req.SendBody.Address)
+	if !ok {
+		return nil, v1.ErrorAddressInvalid("地址格式或校验和错误")
+	}
+	if strings.EqualFold("0x000000000000000000000000000000000000dead", address) ||
+		strings.EqualFold("0x0000000000000000000000000000000000000000", address) {
+		return nil, v1.ErrorAddressInvalid("地址格式或校验和错误")
+	}
+
+	res, err = addressCheck(address)
+	if nil != err {
+		return nil, v1.ErrorChainUnavailable("地址验证失败")
+	}
+	if !res {
+		return nil, v1.ErrorAddressContract("不能使用合约地址")
+	}
+
+	return a.uuc.WithdrawAddressCreate(ctx, req.SendBody.Label, address, &biz.User{
+		ID: userId,
+	})
+}
+
+// WithdrawAddressDelete .
+func (a *AppService) WithdrawAddressDelete(ctx context.Context, req *v1.WithdrawAddressDeleteRequest) (*v1.WithdrawAddressDeleteReply, error) {
+	// 在上下文 context 中取出 claims 对象
+	var (
+		err    error
+		userId int64
+		user   *biz.User
+	)
+	if claims, ok := jwt.FromContext(ctx); ok {
+		c := claims.(jwt2.MapClaims)
+		if c["UserId"] == nil {
+			return nil, v1.ErrorTokenInvalid("无效TOKEN")
+		}
+		userId = int64(c["UserId"].(float64))
+	}
+
+	user, err = a.uuc.GetUserByUserId(ctx, userId)
+	if nil != err {
+		return nil, err
+	}
+
+	res, addressFromSign := verifySig(req.SendBody.Sign, []byte(user.Address))
+	if !res || addressFromSign != user.Address {
+		return nil, v1.ErrorSignatureInvalid("地址签名错误")
+	}
+
+	return a.uuc.WithdrawAddressDelete(ctx, req.SendBody.Id, &biz.User{
+		ID: userId,
+	})
+}
+
+// InviteCodeList .
+func (a *AppService) InviteCodeList(ctx context.Context, req *v1.InviteCodeListRequest) (*v1.InviteCodeListReply, error) {
+	// 在上下文 context 中取出 claims 对象
+	var userId int64
+	if claims, ok := jwt.FromContext(ctx); ok {
+		c := claims.(jwt2.MapClaims)
+		if c["UserId"] == nil {
+			return nil, v1.ErrorTokenInvalid("无效TOKEN")
+		}
+		userId = int64(c["UserId"].(float64))
+	}
+
+	return a.uuc.InviteCodeList(ctx, &biz.User{
+		ID: userId,
+	})
+}
+
+// InviteCodeCreate .
+func (a *AppService) InviteCodeCreate(ctx context.Context, req *v1.InviteCodeCreateRequest) (*v1.InviteCodeCreateReply, error) {
+	// 在上下文 context 中取出 claims 对象
+	var userId int64
+	if claims, ok := jwt.FromContext(ctx); ok {
+		c := claims.(jwt2.MapClaims)
+		if c["UserId"] == nil {
+			return nil, v1.ErrorTokenInvalid("无效TOKEN")
+		}
+		userId = int64(c["UserId"].(float64))
+	}
+
+	return a.uuc.InviteCodeCreate(ctx, req, &biz.User{
+		ID: userId,
+	})
+}
+
+// Locale .
+func (a *AppService) Locale(ctx context.Context, req *v1.LocaleRequest) (*v1.LocaleReply, error) {
+	// 在上下文 context 中取出 claims 对象
+	var userId int64
+	if claims, ok := jwt.FromContext(ctx); ok {
+		c := claims.(jwt2.MapClaims)
+		if c["UserId"] == nil {
+			return nil, v1.ErrorTokenInvalid("无效TOKEN")
+		}
+		userId = int64(c["UserId"].(float64))
+	}
+
+	return a.uuc.Locale(ctx, &biz.User{
+		ID: userId,
+	}, a.catalog.Tags())
+}
+
+// LocaleChange .
+func (a *AppService) LocaleChange(ctx context.Context, req *v1.LocaleChangeRequest) (*v1.LocaleChangeReply, error) {
+	// 在上下文 context 中取出 claims 对象
+	var userId int64
+	if claims, ok := jwt.FromContext(ctx); ok {
+		c := claims.(jwt2.MapClaims)
+		if c["UserId"] == nil {
+			return nil, v1.ErrorTokenInvalid("无效TOKEN")
+		}
+		userId = int64(c["UserId"].(float64))
+	}
+
+	locale := ""
+	if "" != req.SendBody.Locale {
+		l, ok := a.catalog.Get(req.SendBody.Locale)
+		if !ok {
+			return nil, v1.ErrorParamInvalid("不支持的语言")
+		}
+		locale = l.Tag
+	}
+
+	return a.uuc.LocaleChange(ctx, locale, &biz.User{
+		ID: userId,
+	})
+}
+
+// PushTicket 推送连接凭证 .
+func (a *AppService) PushTicket(ctx context.Context, req *v1.PushTicketRequest) (*v1.PushTicketReply, error) {
+	// 在上下文 context 中取出 claims 对象
+	var userId int64
+	if claims, ok := jwt.FromContext(ctx); ok {
+		c := claims.(jwt2.MapClaims)
+		if c["UserId"] == nil {
+			return nil, v1.ErrorTokenInvalid("无效TOKEN")
+		}
+		userId = int64(c["UserId"].(float64))
+	}
+	if 0 >= userId {
+		return nil, v1.ErrorTokenInvalid("无效TOKEN")
+	}
+
+	return a.hub.CreateTicket(ctx, userId)
+}
+
+// AdminInviteCodeCreate 后台发放根推荐码 .
+func (a *AppService) AdminInviteCodeCreate(ctx context.Context, req *v1.AdminInviteCodeCreateRequest) (*v1.AdminInviteCodeCreateReply, error) {
+	if !isAdmin(ctx) {
+		return nil, v1.ErrorForbidden("无权限")
+	}
+
+	return a.uuc.AdminInviteCodeCreate(ctx, req)
+}
+
+// AdminPasswordUpdate 后台重置用户密码 .
+func (a *AppService) AdminPasswordUpdate(ctx context.Context, req *v1.AdminPasswordUpdateRequest) (*v1.AdminPasswordUpdateReply, error) {
+	if !isAdmin(ctx) {
+		return nil, v1.ErrorForbidden("无权限")
+	}
+
+	if "" == req.SendBody.Password || 6 > len(req.SendBody.Password) {
+		return nil, v1.ErrorPasswordInvalid("账户密码必须大于6位")
+	}
+	password := fmt.Sprintf("%x", md5.Sum([]byte(req.SendBody.Password)))
+
+	return a.uuc.AdminPasswordUpdate(ctx, req.SendBody.UserId, password)
+}
+
+// AdminCommissionSimulate 模拟分佣 .
+func (a *AppService) AdminCommissionSimulate(ctx context.Context, req *v1.AdminCommissionSimulateRequest) (*v1.AdminCommissionSimulateReply, error) {
+	if !isAdmin(ctx) {
+		return nil, v1.ErrorForbidden("无权限")
+	}
+
+	return a.uuc.AdminCommissionSimulate(ctx, req)
+}
+
+// AdminAreaLevel 团队级别评定 .
+func (a *AppService) AdminAreaLevel(ctx context.Context, req *v1.AdminAreaLevelRequest) (*v1.AdminAreaLevelReply, error) {
+	if !isAdmin(ctx) {
+		return nil, v1.ErrorForbidden("无权限")
+	}
+
+	return a.auc.AdminAreaLevel(ctx, req)
+}
+
+// AdminJobRunList 定时任务执行记录 .
+func (a *AppService) AdminJobRunList(ctx context.Context, req *v1.AdminJobRunListRequest) (*v1.AdminJobRunListReply, error) {
+	if !isAdmin(ctx) {
+		return nil, v1.ErrorForbidden("无权限")
+	}
+
+	return a.juc.AdminJobRunList(ctx, req)
+}
+
+// AdminLocationTransitionList 仓位状态变更记录 .
+func (a *AppService) AdminLocationTransitionList(ctx context.Context, req *v1.AdminLocationTransitionListRequest) (*v1.AdminLocationTransitionListReply, error) {
+	if !isAdmin(ctx) {
+		return nil, v1.ErrorForbidden("无权限")
+	}
+
+	return a.puc.AdminLocationTransitionList(ctx, req)
+}
+
+// AdminMatrixReplay 排位回放校验 .
+func (a *AppService) AdminMatrixReplay(ctx context.Context, req *v1.AdminMatrixReplayRequest) (*v1.AdminMatrixReplayReply, error) {
+	if !isAdmin(ctx) {
+		return nil, v1.ErrorForbidden("无权限")
+	}
+
+	return a.muc.AdminMatrixReplay(ctx, req)
+}
+
+// AdminWebhookList webhook 订阅方 .
+func (a *AppService) AdminWebhookList(ctx context.Context, req *v1.AdminWebhookListRequest) (*v1.AdminWebhookListReply, error) {
+	if !isAdmin(ctx) {
+		return nil, v1.ErrorForbidden("无权限")
+	}
+
+	return a.wuc.AdminWebhookList(ctx, req)
+}
+
+// AdminWebhookCreate 添加 webhook 订阅方 .
+func (a *AppService) AdminWebhookCreate(ctx context.Context, req *v1.AdminWebhookCreateRequest) (*v1.AdminWebhookCreateReply, error) {
+	if !isAdmin(ctx) {
+		return nil, v1.ErrorForbidden("无权限")
+	}
+
+	return a.wuc.AdminWebhookCreate(ctx, req)
+}
+
+// AdminWebhookUpdate 修改 webhook 订阅方 .
+func (a *AppService) AdminWebhookUpdate(ctx context.Context, req *v1.AdminWebhookUpdateRequest) (*v1.AdminWebhookUpdateReply, error) {
+	if !isAdmin(ctx) {
+		return nil, v1.ErrorForbidden("无权限")
+	}
+
+	return a.wuc.AdminWebhookUpdate(ctx, req)
+}
+
+// AdminWebhookDeliveryList webhook 投递记录和死信 .
+func (a *AppService) AdminWebhookDeliveryList(ctx context.Context, req *v1.AdminWebhookDeliveryListRequest) (*v1.AdminWebhookDeliveryListReply, error) {
+	if !isAdmin(ctx) {
+		return nil, v1.ErrorForbidden("无权限")
+	}
+
+	return a.wuc.AdminWebhookDeliveryList(ctx, req)
+}
+
+// AdminWebhookReplay webhook 重放 .
+func (a *AppService) AdminWebhookReplay(ctx context.Context, req *v1.AdminWebhookReplayRequest) (*v1.AdminWebhookReplayReply, error) {
+	if !isAdmin(ctx) {
+		return nil, v1.ErrorForbidden("无权限")
+	}
+
+	return a.wuc.AdminWebhookReplay(ctx, req)
+}
+
+// AdminCacheStats 缓存命中率 .
+func (a *AppService) AdminCacheStats(ctx context.Context, req *v1.AdminCacheStatsRequest) (*v1.AdminCacheStatsReply, error) {
+	if !isAdmin(ctx) {
+		return nil, v1.ErrorForbidden("无权限")
+	}
+
+	return a.cuc.AdminCacheStats(ctx, req)
+}
+
+// isAdmin 后台接口仅限 UserType 为 admin 的 token
+func isAdmin(ctx context.Context) bool {
+	claims, ok := jwt.FromContext(ctx)
+	if !ok {
+		return false
+	}
+	c, ok := claims.(jwt2.MapClaims)
+	if !ok {
+		return false
+	}
+
+	return "admin" == c["UserType"]
+}
+
 func (a *AppService) AdminRewardList(ctx context.Context, req *v1.AdminRewardListRequest) (*v1.AdminRewardListReply, error) {
 	return a.uuc.AdminRewardList(ctx, req)
 }
@@ -609,7 +1084,12 @@ func (a *AppService) AdminWithdrawList(ctx context.Context, req *v1.AdminWithdra
 	return a.uuc.AdminWithdrawList(ctx, req)
 }
 
+// AdminWithdraw 提现审核、打款结果 .
 func (a *AppService) AdminWithdraw(ctx context.Context, req *v1.AdminWithdrawRequest) (*v1.AdminWithdrawReply, error) {
+	if !isAdmin(ctx) {
+		return nil, v1.ErrorForbidden("无权限")
+	}
+
 	return a.uuc.AdminWithdraw(ctx, req)
 }
 
@@ -864,6 +1344,16 @@ func addressCheck(addressParam string) (bool, error) {
 		return false, nil
 	}
 
+	contract, err := isContractAddress(addressParam)
+	if err != nil {
+		return false, err
+	}
+
+	return !contract, nil
+}
+
+// isContractAddress 链上有代码的是合约地址
+func isContractAddress(addressParam string) (bool, error) {
 	client, err := ethclient.Dial("https://bsc-dataseed4.binance.org/")
 	if err != nil {
 		return false, err
@@ -876,11 +1366,23 @@ func addressCheck(addressParam string) (bool, error) {
 		return false, err
 	}
 
-	if len(bytecode) > 0 {
-		return false, nil
+	return len(bytecode) > 0, nil
+}
+
+// checksumAddress EIP-55 校验，全小写或全大写视为未带校验和，大小写混合时必须和校验和一致，返回校验和格式
+func checksumAddress(addressParam string) (string, bool) {
+	re := regexp.MustCompile("^0x[0-9a-fA-F]{40}$")
+	if !re.MatchString(addressParam) {
+		return "", false
 	}
 
-	return true, nil
+	checksum := common.HexToAddress(addressParam).Hex()
+	hex := addressParam[2:]
+	if strings.ToLower(hex) == hex || strings.ToUpper(hex) == hex {
+		return checksum, true
+	}
+
+	return checksum, checksum == addressParam
 }
 
 //func checkSign() {
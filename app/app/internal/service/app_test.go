@@ -0,0 +1,32 @@
+package service
+
+import "testing"
+
+func TestChecksumAddress(t *testing.T) {
+	// EIP-55 示例地址
+	tests := []struct {
+		name    string
+		address string
+		want    string
+		ok      bool
+	}{
+		{"checksum", "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", true},
+		{"checksum 2", "0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359", "0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359", true},
+		{"lower", "0xdbf03b407c01e7cd3cbea99509d93f8dddc8c6fb", "0xdbF03B407c01E7cD3CBea99509d93f8DDDC8C6FB", true},
+		{"upper", "0xD1220A0CF47C7B9BE7A2E6BA89F429762E7B9ADB", "0xD1220A0cf47c7B9Be7A2E6BA89F429762e7b9aDb", true},
+		{"bad checksum", "0x5AAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", false},
+		{"no prefix", "5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", "", false},
+		{"short", "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeA", "", false},
+		{"not hex", "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeg", "", false},
+		{"empty", "", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := checksumAddress(tt.address)
+			if got != tt.want || ok != tt.ok {
+				t.Errorf("checksumAddress(%q) = %q, %v, want %q, %v", tt.address, got, ok, tt.want, tt.ok)
+			}
+		})
+	}
+}
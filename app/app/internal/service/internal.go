@@ -0,0 +1,62 @@
+package service
+
+import (
+	"context"
+	v1 "dhb/app/app/api"
+	"dhb/app/app/internal/biz"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// InternalService 内部服务接口，认证在 server 层完成
+type InternalService struct {
+	v1.UnimplementedInternalAppServer
+
+	iuc *biz.InternalUseCase
+	log *log.Helper
+}
+
+// NewInternalService new an internal service.
+func NewInternalService(iuc *biz.InternalUseCase, logger log.Logger) *InternalService {
+	return &InternalService{
+		iuc: iuc,
+		log: log.NewHelper(logger),
+	}
+}
+
+type callerKey struct{}
+
+// NewCallerContext 认证通过后写入调用方 id
+func NewCallerContext(ctx context.Context, caller string) context.Context {
+	return context.WithValue(ctx, callerKey{}, caller)
+}
+
+// CallerFromContext 取出调用方 id
+func CallerFromContext(ctx context.Context) string {
+	caller, _ := ctx.Value(callerKey{}).(string)
+	return caller
+}
+
+// GetUser .
+func (s *InternalService) GetUser(ctx context.Context, req *v1.InternalGetUserRequest) (*v1.InternalUserReply, error) {
+	return s.iuc.GetUser(ctx, req)
+}
+
+// GetBalance .
+func (s *InternalService) GetBalance(ctx context.Context, req *v1.InternalGetBalanceRequest) (*v1.InternalBalanceReply, error) {
+	return s.iuc.GetBalance(ctx, req)
+}
+
+// Credit 加余额 .
+func (s *InternalService) Credit(ctx context.Context, req *v1.InternalTransferRequest) (*v1.InternalTransferReply, error) {
+	return s.iuc.Transfer(ctx, CallerFromContext(ctx), req, false)
+}
+
+// Debit 减余额 .
+func (s *InternalService) Debit(ctx context.Context, req *v1.InternalTransferRequest) (*v1.InternalTransferReply, error) {
+	return s.iuc.Transfer(ctx, CallerFromContext(ctx), req, true)
+}
+
+// GetReferralTree .
+func (s *InternalService) GetReferralTree(ctx context.Context, req *v1.InternalReferralTreeRequest) (*v1.InternalReferralTreeReply, error) {
+	return s.iuc.GetReferralTree(ctx, req)
+}
@@ -0,0 +1,134 @@
+package service
+
+import (
+	"context"
+	v1 "dhb/app/app/api"
+	"dhb/app/app/internal/biz"
+	"dhb/app/app/internal/conf"
+	"dhb/app/app/internal/pkg/i18n"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	jwt2 "github.com/golang-jwt/jwt/v4"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// PushService 服务端推送，SSE 长连接
+type PushService struct {
+	hub       *biz.PushHub
+	ca        *conf.Auth
+	catalog   *i18n.Catalog
+	heartbeat time.Duration
+	log       *log.Helper
+}
+
+// NewPushService new a push service.
+func NewPushService(hub *biz.PushHub, ca *conf.Auth, c *conf.Push, catalog *i18n.Catalog, logger log.Logger) *PushService {
+	s := &PushService{
+		hub:       hub,
+		ca:        ca,
+		catalog:   catalog,
+		heartbeat: 25 * time.Second,
+		log:       log.NewHelper(logger),
+	}
+	if nil != c.GetHeartbeat() && 0 < c.GetHeartbeat().AsDuration() {
+		s.heartbeat = c.GetHeartbeat().AsDuration()
+	}
+
+	return s
+}
+
+// Stream 推送余额、分佣、提现状态和价格变化，事件消息的 id 为续传位置，重连时通过 Last-Event-ID 或 lastEventId 参数续传，
+// 同一事件可能重复推送，客户端按事件 id 去重 .
+// 浏览器 EventSource 不能设置请求头，先通过 PushTicket 获取一次性凭证放在 ticket 参数中，jwt 只从请求头读取
+func (p *PushService) Stream(w http.ResponseWriter, r *http.Request) {
+	userId, err := p.userId(r)
+	if nil != err {
+		e := errors.FromError(p.catalog.Match(r.Header.Get("Accept-Language")).LocalizeError(err))
+		http.Error(w, e.Message, int(e.Code))
+		return
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+
+	lastEventId, watermark := biz.ParsePushResumeToken(r.Header.Get("Last-Event-ID"))
+	if 0 >= lastEventId {
+		lastEventId, watermark = biz.ParsePushResumeToken(r.URL.Query().Get("lastEventId"))
+	}
+
+	// 请求上下文带有接口超时，长连接不能使用，断开通过写入失败发现
+	sub, err := p.hub.Subscribe(context.Background(), userId, lastEventId, watermark)
+	if nil != err {
+		e := errors.FromError(p.catalog.Match(r.Header.Get("Accept-Language")).LocalizeError(err))
+		http.Error(w, e.Message, int(e.Code))
+		return
+	}
+	defer p.hub.Unsubscribe(sub)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	if _, err = fmt.Fprint(w, "retry: 3000\n\n"); nil != err {
+		return
+	}
+	flusher.Flush()
+
+	heartbeat := time.NewTicker(p.heartbeat)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case m, ok := <-sub.C:
+			if !ok {
+				return // 消费太慢被断开，客户端重连续传
+			}
+			if 0 < m.Id {
+				_, err = fmt.Fprintf(w, "id: %s\n", m.ResumeToken())
+			}
+			if nil == err {
+				_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", m.Type, m.Data)
+			}
+		case <-heartbeat.C:
+			_, err = fmt.Fprint(w, ": ping\n\n")
+		}
+		if nil != err {
+			return
+		}
+		flusher.Flush()
+	}
+}
+
+// userId Authorization 请求头中和接口相同的 jwt，或者一次性凭证
+func (p *PushService) userId(r *http.Request) (int64, error) {
+	var token string
+	if auths := strings.SplitN(r.Header.Get("Authorization"), " ", 2); 2 == len(auths) && strings.EqualFold(auths[0], "Bearer") {
+		token = auths[1]
+	}
+	if "" == token {
+		return p.hub.UseTicket(r.Context(), r.URL.Query().Get("ticket"))
+	}
+
+	claims := jwt2.MapClaims{}
+	if _, err := jwt2.ParseWithClaims(token, claims, func(token *jwt2.Token) (interface{}, error) {
+		if token.Method != jwt2.SigningMethodHS256 {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+		return []byte(p.ca.JwtKey), nil
+	}); nil != err {
+		return 0, v1.ErrorTokenInvalid("无效TOKEN")
+	}
+
+	userId, ok := claims["UserId"].(float64)
+	if !ok || 0 >= userId {
+		return 0, v1.ErrorTokenInvalid("无效TOKEN")
+	}
+
+	return int64(userId), nil
+}